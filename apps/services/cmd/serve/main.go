@@ -10,6 +10,7 @@ import (
 	githubadapter "github.com/eser/aya.is/services/pkg/api/adapters/github"
 	"github.com/eser/aya.is/services/pkg/api/adapters/http"
 	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
 )
@@ -31,6 +32,8 @@ func main() {
 
 	process.Wait()
 	process.Shutdown()
+
+	appContext.StoryInteractionService.Close()
 }
 
 func startHTTPServer(process *processfx.Process, appContext *appcontext.AppContext) {
@@ -41,6 +44,11 @@ func startHTTPServer(process *processfx.Process, appContext *appcontext.AppConte
 			&appContext.Config.HTTP,
 			appContext.Logger,
 			appContext.Config.Features.DiscloseErrors,
+			http.Features{
+				AI:        appContext.Config.Features.AI,
+				Contact:   appContext.Config.Features.Contact,
+				Analytics: appContext.Config.Features.Analytics,
+			},
 			appContext.AuthService,
 			appContext.UserService,
 			appContext.ProfileService,
@@ -63,14 +71,21 @@ func startHTTPServer(process *processfx.Process, appContext *appcontext.AppConte
 				X:                      appContext.XProvider,
 				PKCEStore:              appContext.PKCEStore,
 				SiteImporter:           appContext.SiteImporterService,
+				LinkSync:               appContext.ProfileLinkSyncService,
 				PendingConnectionStore: profiles.NewPendingConnectionStore(),
 			},
 			buildTelegramProviders(appContext),
 			appContext.AIModels,
 			appContext.RuntimeStateService,
 			appContext.WorkerRegistry,
+			appContext.ProviderBudgets,
 			appContext.AuditService,
 			appContext.BulletinService,
+			appContext.ProfileMentionService,
+			appContext.WebhookService,
+			appContext.ConsistencyService,
+			appContext.WebmentionService,
+			&appContext.Config.Webmentions,
 		)
 		if err != nil {
 			appContext.Logger.ErrorContext(
@@ -117,6 +132,9 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 		idGen,
 	)
 
+	// On-demand profile resyncs run on the queue with the sync workers below
+	profileResyncHandler := workers.NewProfileResyncHandler(appContext.Logger)
+
 	// YouTube full sync worker
 	if appContext.Config.Workers.YouTubeSync.FullSyncEnabled {
 		fullSyncWorker := workers.NewYouTubeFullSyncWorker(
@@ -129,6 +147,8 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 			storyProcessor,
 		)
 
+		profileResyncHandler.AddSyncer(linksync.ResyncProviderYouTube, fullSyncWorker)
+
 		runner := workerfx.NewRunner(fullSyncWorker, appContext.Logger)
 		runner.SetStateKey("youtube.sync.full_sync_worker")
 		appContext.WorkerRegistry.Register(runner)
@@ -220,6 +240,8 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 			idGen,
 		)
 
+		profileResyncHandler.AddSyncer(linksync.ResyncProviderSpeakerDeck, speakerDeckSyncWorker)
+
 		runner := workerfx.NewRunner(speakerDeckSyncWorker, appContext.Logger)
 		runner.SetStateKey("speakerdeck.sync.full_sync_worker")
 		appContext.WorkerRegistry.Register(runner)
@@ -249,6 +271,8 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 			idGen,
 		)
 
+		profileResyncHandler.AddSyncer(linksync.ResyncProviderExternalSite, externalSiteSyncWorker)
+
 		runner := workerfx.NewRunner(externalSiteSyncWorker, appContext.Logger)
 		runner.SetStateKey("external-site.sync.full_sync_worker")
 		appContext.WorkerRegistry.Register(runner)
@@ -258,6 +282,17 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 		})
 	}
 
+	profileResyncHandler.RegisterHandlers(appContext.QueueRegistry)
+
+	// Bulk page auto-translations run on the queue, one item per locale
+	pageTranslationHandler := workers.NewPageTranslationHandler(
+		appContext.Logger,
+		appContext.ProfileService,
+		http.NewAIContentTranslator(appContext.AIModels),
+		appContext.ProfilePointsService,
+	)
+	pageTranslationHandler.RegisterHandlers(appContext.QueueRegistry)
+
 	// Queue worker
 	if appContext.Config.Workers.Queue.Enabled {
 		workerID := idGen()
@@ -336,6 +371,79 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 		})
 	}
 
+	// Webhook delivery log retention worker
+	if appContext.Config.Workers.WebhookRetention.Enabled {
+		webhookRetentionWorker := workers.NewWebhookRetentionWorker(
+			&appContext.Config.Workers.WebhookRetention,
+			appContext.Logger,
+			appContext.WebhookService,
+			appContext.RuntimeStateService,
+		)
+
+		runner := workerfx.NewRunner(webhookRetentionWorker, appContext.Logger)
+		runner.SetStateKey("webhooks.retention_worker")
+		appContext.WorkerRegistry.Register(runner)
+
+		process.StartGoroutine("webhook-retention-worker", func(ctx context.Context) error {
+			return runner.Run(ctx)
+		})
+	}
+
+	// Scheduled profile page publisher worker
+	if appContext.Config.Workers.PagePublisher.Enabled {
+		pagePublisherWorker := workers.NewPagePublisherWorker(
+			&appContext.Config.Workers.PagePublisher,
+			appContext.Logger,
+			appContext.ProfileService,
+			appContext.RuntimeStateService,
+		)
+
+		runner := workerfx.NewRunner(pagePublisherWorker, appContext.Logger)
+		runner.SetStateKey("profiles.page_publisher")
+		appContext.WorkerRegistry.Register(runner)
+
+		process.StartGoroutine("page-publisher-worker", func(ctx context.Context) error {
+			return runner.Run(ctx)
+		})
+	}
+
+	// Custom domain verification worker (pending and failed domains only)
+	if appContext.Config.Workers.CustomDomainVerify.Enabled {
+		customDomainVerifyWorker := workers.NewCustomDomainVerifyWorker(
+			&appContext.Config.Workers.CustomDomainVerify,
+			appContext.Logger,
+			appContext.ProfileService,
+			appContext.RuntimeStateService,
+		)
+
+		runner := workerfx.NewRunner(customDomainVerifyWorker, appContext.Logger)
+		runner.SetStateKey("profiles.custom_domain_verify")
+		appContext.WorkerRegistry.Register(runner)
+
+		process.StartGoroutine("custom-domain-verify-worker", func(ctx context.Context) error {
+			return runner.Run(ctx)
+		})
+	}
+
+	// Candidate voting deadline worker (resolves referrals and applications)
+	if appContext.Config.Workers.ReferralResolver.Enabled {
+		referralResolverWorker := workers.NewReferralResolverWorker(
+			&appContext.Config.Workers.ReferralResolver,
+			appContext.Logger,
+			appContext.ProfileService,
+			appContext.MailboxService,
+			appContext.RuntimeStateService,
+		)
+
+		runner := workerfx.NewRunner(referralResolverWorker, appContext.Logger)
+		runner.SetStateKey("referrals.resolver")
+		appContext.WorkerRegistry.Register(runner)
+
+		process.StartGoroutine("referral-resolver-worker", func(ctx context.Context) error {
+			return runner.Run(ctx)
+		})
+	}
+
 	// Custom domain sync worker (DNS verification + webserver sync)
 	if appContext.Config.Workers.DomainSync.Enabled {
 		domainSyncWorker := workers.NewDomainSyncWorker(
@@ -344,6 +452,7 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 			appContext.Repository,
 			appContext.WebserverSyncer,
 			&appContext.Config.Profiles.DNSVerification,
+			appContext.DNSResolver,
 			appContext.RuntimeStateService,
 		)
 
@@ -351,6 +460,9 @@ func startWorkers( //nolint:cyclop,funlen,maintidx
 		runner.SetStateKey("domain.sync.domain_sync_worker")
 		appContext.WorkerRegistry.Register(runner)
 
+		// Reconcile right away when owners change a domain that is being served
+		appContext.ProfileService.SetOnCustomDomainsChanged(runner.TriggerNow)
+
 		process.StartGoroutine("domain-sync-worker", func(ctx context.Context) error {
 			return runner.Run(ctx)
 		})
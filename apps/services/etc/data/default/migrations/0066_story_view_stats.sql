@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- Deduplicated view counters for stories. Views are deduplicated per viewer
+-- (user or IP) within a window in the application layer before incrementing.
+CREATE TABLE IF NOT EXISTS "story_view_stat" (
+  "story_id"   CHAR(26) NOT NULL PRIMARY KEY
+    CONSTRAINT "story_view_stat_story_id_fk" REFERENCES "story" ("id") ON DELETE CASCADE,
+  "view_count" BIGINT NOT NULL DEFAULT 0,
+  "updated_at" TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "story_view_stat";
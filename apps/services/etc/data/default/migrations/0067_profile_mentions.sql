@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- Profile mentions: "@slug" references to profiles inside story and page content.
+-- Rows are replaced per (source, locale) whenever the content is saved.
+CREATE TABLE IF NOT EXISTS "profile_mention" (
+  "id"                   CHAR(26) NOT NULL PRIMARY KEY,
+  "source_kind"          TEXT NOT NULL,
+  "source_id"            CHAR(26) NOT NULL,
+  "source_profile_id"    CHAR(26) NOT NULL
+    CONSTRAINT "profile_mention_source_profile_id_fk" REFERENCES "profile" ("id"),
+  "mentioned_profile_id" CHAR(26) NOT NULL
+    CONSTRAINT "profile_mention_mentioned_profile_id_fk" REFERENCES "profile" ("id"),
+  "locale_code"          CHAR(12) NOT NULL,
+  "created_at"           TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE UNIQUE INDEX "profile_mention_source_mentioned_uniq"
+  ON "profile_mention" ("source_kind", "source_id", "locale_code", "mentioned_profile_id");
+
+CREATE INDEX "profile_mention_mentioned_profile_id_idx"
+  ON "profile_mention" ("mentioned_profile_id", "created_at" DESC);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_mention_mentioned_profile_id_idx";
+DROP INDEX IF EXISTS "profile_mention_source_mentioned_uniq";
+DROP TABLE IF EXISTS "profile_mention";
@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- Recently viewed profiles per user, powering the quick-access list.
+-- The application layer keeps at most a configured number of rows per user.
+CREATE TABLE IF NOT EXISTS "user_recent_profile_view" (
+  "user_id"    CHAR(26) NOT NULL
+    CONSTRAINT "user_recent_profile_view_user_id_fk" REFERENCES "user" ("id") ON DELETE CASCADE,
+  "profile_id" CHAR(26) NOT NULL
+    CONSTRAINT "user_recent_profile_view_profile_id_fk" REFERENCES "profile" ("id") ON DELETE CASCADE,
+  "viewed_at"  TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  PRIMARY KEY ("user_id", "profile_id")
+);
+
+CREATE INDEX IF NOT EXISTS "user_recent_profile_view_user_id_viewed_at_idx"
+  ON "user_recent_profile_view" ("user_id", "viewed_at" DESC);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "user_recent_profile_view";
@@ -0,0 +1,42 @@
+-- +goose Up
+
+-- Delivery log of outbound webhooks, shared by every dispatcher.
+-- Rows older than the configured retention period are purged by a worker.
+CREATE TABLE IF NOT EXISTS "webhook_delivery" (
+  "id"                 CHAR(26) NOT NULL PRIMARY KEY,
+  "source"             TEXT NOT NULL,
+  "event_type"         TEXT NOT NULL,
+  "target_url"         TEXT NOT NULL,
+  "payload"            JSONB NOT NULL,
+  "status"             TEXT NOT NULL DEFAULT 'pending'
+    CONSTRAINT "webhook_delivery_status_check" CHECK ("status" IN ('pending', 'succeeded', 'failed')),
+  "attempt_count"      INTEGER NOT NULL DEFAULT 0,
+  "last_response_code" INTEGER,
+  "last_attempted_at"  TIMESTAMP WITH TIME ZONE,
+  "created_at"         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "webhook_delivery_created_at_idx"
+  ON "webhook_delivery" ("created_at");
+
+CREATE INDEX IF NOT EXISTS "webhook_delivery_source_status_idx"
+  ON "webhook_delivery" ("source", "status", "id" DESC);
+
+CREATE TABLE IF NOT EXISTS "webhook_delivery_attempt" (
+  "id"            CHAR(26) NOT NULL PRIMARY KEY,
+  "delivery_id"   CHAR(26) NOT NULL
+    CONSTRAINT "webhook_delivery_attempt_delivery_id_fk" REFERENCES "webhook_delivery" ("id") ON DELETE CASCADE,
+  "status"        TEXT NOT NULL,
+  "response_code" INTEGER,
+  "error_message" TEXT,
+  "duration_ms"   BIGINT NOT NULL DEFAULT 0,
+  "attempted_at"  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "webhook_delivery_attempt_delivery_id_idx"
+  ON "webhook_delivery_attempt" ("delivery_id", "attempted_at" DESC);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "webhook_delivery_attempt";
+DROP TABLE IF EXISTS "webhook_delivery";
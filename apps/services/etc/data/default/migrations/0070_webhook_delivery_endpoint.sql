@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- The dispatcher's endpoint a delivery went to, used to look up its signing
+-- secrets again when the delivery is retried.
+ALTER TABLE "webhook_delivery"
+  ADD COLUMN IF NOT EXISTS "endpoint_id" TEXT;
+
+-- +goose Down
+
+ALTER TABLE "webhook_delivery"
+  DROP COLUMN IF EXISTS "endpoint_id";
@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- The membership leading a team. It must also be a member of that team;
+-- the lead is cleared whenever that membership leaves the team.
+ALTER TABLE "profile_team"
+  ADD COLUMN IF NOT EXISTS "lead_membership_id" CHAR(26) REFERENCES "profile_membership" ("id");
+
+-- +goose Down
+
+ALTER TABLE "profile_team"
+  DROP COLUMN IF EXISTS "lead_membership_id";
@@ -0,0 +1,22 @@
+-- +goose Up
+
+-- Manual display order of a profile's resources. Featured resources are
+-- pinned above the rest; new resources are appended after the current last one.
+ALTER TABLE "profile_resource"
+  ADD COLUMN IF NOT EXISTS "order" INTEGER NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS "is_featured" BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Keep the previous newest-first listing as the initial manual order.
+UPDATE "profile_resource" pr
+SET "order" = ranked.position
+FROM (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY profile_id ORDER BY created_at DESC) AS position
+  FROM "profile_resource"
+) ranked
+WHERE ranked.id = pr.id;
+
+-- +goose Down
+
+ALTER TABLE "profile_resource"
+  DROP COLUMN IF EXISTS "is_featured",
+  DROP COLUMN IF EXISTS "order";
@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- Token a custom domain owner publishes as a TXT record to prove ownership.
+-- It stays the same until the domain's host name changes.
+ALTER TABLE "profile_custom_domain"
+  ADD COLUMN IF NOT EXISTS "verification_token" TEXT;
+
+UPDATE "profile_custom_domain"
+SET "verification_token" = md5(random()::TEXT || "id")
+WHERE "verification_token" IS NULL;
+
+ALTER TABLE "profile_custom_domain"
+  ALTER COLUMN "verification_token" SET NOT NULL;
+
+-- +goose Down
+
+ALTER TABLE "profile_custom_domain"
+  DROP COLUMN IF EXISTS "verification_token";
@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- Admin impersonation ("view as") sessions. An impersonation session logs in as
+-- the impersonated user and remembers the admin who started it, plus the admin's
+-- own session so it can be restored when impersonation ends.
+ALTER TABLE "session"
+  ADD COLUMN IF NOT EXISTS "impersonator_user_id" CHAR(26)
+    CONSTRAINT "session_impersonator_user_id_fk" REFERENCES "user";
+ALTER TABLE "session"
+  ADD COLUMN IF NOT EXISTS "impersonator_session_id" CHAR(26);
+
+CREATE INDEX IF NOT EXISTS "session_impersonator_user_id_index"
+  ON "session" ("impersonator_user_id")
+  WHERE "impersonator_user_id" IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "session_impersonator_user_id_index";
+
+ALTER TABLE "session" DROP COLUMN IF EXISTS "impersonator_session_id";
+ALTER TABLE "session" DROP COLUMN IF EXISTS "impersonator_user_id";
@@ -0,0 +1,31 @@
+-- +goose Up
+
+-- Admin-made reservations that hold a profile slug for an organization before it
+-- signs up. A reservation blocks the slug until it is claimed by a user whose
+-- email matches the verification email or domain, released, or expired.
+CREATE TABLE IF NOT EXISTS "profile_handle_reservation" (
+  "id"                    CHAR(26) NOT NULL PRIMARY KEY,
+  "slug"                  TEXT NOT NULL,
+  "verification_email"    TEXT,
+  "verification_domain"   TEXT,
+  "note"                  TEXT,
+  "reserved_by_user_id"   CHAR(26) NOT NULL
+    CONSTRAINT "profile_handle_reservation_reserved_by_user_id_fk" REFERENCES "user",
+  "expires_at"            TIMESTAMP WITH TIME ZONE NOT NULL,
+  "claimed_at"            TIMESTAMP WITH TIME ZONE,
+  "claimed_by_profile_id" CHAR(26)
+    CONSTRAINT "profile_handle_reservation_claimed_by_profile_id_fk" REFERENCES "profile",
+  "released_at"           TIMESTAMP WITH TIME ZONE,
+  "created_at"            TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  CONSTRAINT "profile_handle_reservation_verification_check"
+    CHECK ("verification_email" IS NOT NULL OR "verification_domain" IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS "profile_handle_reservation_open_slug_idx"
+  ON "profile_handle_reservation" ("slug", "expires_at" DESC)
+  WHERE "claimed_at" IS NULL AND "released_at" IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_handle_reservation_open_slug_idx";
+DROP TABLE IF EXISTS "profile_handle_reservation";
@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- Supports exporting audit events over a time range, paged by (created_at, id).
+CREATE INDEX IF NOT EXISTS "event_audit_created_at_idx"
+  ON "event_audit" ("created_at", "id");
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "event_audit_created_at_idx";
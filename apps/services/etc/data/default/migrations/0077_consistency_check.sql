@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Admin-requested scans for records left inconsistent by partially failed
+-- multi-step creates. Each row holds the findings of one run and whether the
+-- run was allowed to repair them.
+CREATE TABLE IF NOT EXISTS "consistency_check" (
+  "id"                   CHAR(26) NOT NULL PRIMARY KEY,
+  "requested_by_user_id" CHAR(26) NOT NULL
+    CONSTRAINT "consistency_check_requested_by_user_id_fk" REFERENCES "user",
+  "repair"               BOOLEAN NOT NULL DEFAULT FALSE,
+  "status"               TEXT NOT NULL DEFAULT 'pending',
+  "findings"             JSONB,
+  "error_message"        TEXT,
+  "created_at"           TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  "completed_at"         TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS "consistency_check_created_at_idx"
+  ON "consistency_check" ("created_at" DESC);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "consistency_check_created_at_idx";
+DROP TABLE IF EXISTS "consistency_check";
@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- Maintainers can close a question without answering it, e.g. when it was
+-- answered elsewhere or duplicates another question.
+ALTER TABLE "profile_question"
+ADD COLUMN "resolved_at" TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+
+ALTER TABLE "profile_question" DROP COLUMN IF EXISTS "resolved_at";
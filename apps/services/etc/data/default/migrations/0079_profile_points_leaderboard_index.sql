@@ -0,0 +1,11 @@
+-- +goose Up
+
+-- Serves the points leaderboard, which pages through approved profiles by
+-- (points DESC, id ASC).
+CREATE INDEX IF NOT EXISTS "profile_points_leaderboard_idx"
+  ON "profile" ("points" DESC, "id")
+  WHERE "approved_at" IS NOT NULL AND "deleted_at" IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_points_leaderboard_idx";
@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Points awarded for platform actions carry a key naming the action they
+-- reward (e.g. "page_created:<page id>"), so each action is credited once even
+-- when its event is seen again.
+ALTER TABLE "profile_point_transaction"
+ADD COLUMN "award_key" TEXT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS "profile_point_transaction_award_key_unique"
+  ON "profile_point_transaction" ("award_key")
+  WHERE "award_key" IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_point_transaction_award_key_unique";
+ALTER TABLE "profile_point_transaction" DROP COLUMN IF EXISTS "award_key";
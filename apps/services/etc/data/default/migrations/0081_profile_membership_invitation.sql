@@ -0,0 +1,33 @@
+-- +goose Up
+
+-- Invitations to join a profile. A maintainer invites a profile with a
+-- membership kind; the membership is only created once the invitee accepts.
+-- Invitations that are not answered before expires_at can no longer be accepted.
+CREATE TABLE IF NOT EXISTS "profile_membership_invitation" (
+  "id"                 CHAR(26) NOT NULL PRIMARY KEY,
+  "profile_id"         CHAR(26) NOT NULL
+    CONSTRAINT "profile_membership_invitation_profile_id_fk" REFERENCES "profile",
+  "invitee_profile_id" CHAR(26) NOT NULL
+    CONSTRAINT "profile_membership_invitation_invitee_profile_id_fk" REFERENCES "profile",
+  "kind"               TEXT NOT NULL,
+  "status"             TEXT NOT NULL DEFAULT 'pending',
+  "invited_by_user_id" CHAR(26) NOT NULL
+    CONSTRAINT "profile_membership_invitation_invited_by_user_id_fk" REFERENCES "user",
+  "expires_at"         TIMESTAMP WITH TIME ZONE NOT NULL,
+  "responded_at"       TIMESTAMP WITH TIME ZONE,
+  "created_at"         TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "profile_membership_invitation_pending_profile_idx"
+  ON "profile_membership_invitation" ("profile_id", "expires_at")
+  WHERE "status" = 'pending';
+
+CREATE INDEX IF NOT EXISTS "profile_membership_invitation_pending_invitee_idx"
+  ON "profile_membership_invitation" ("invitee_profile_id", "expires_at")
+  WHERE "status" = 'pending';
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_membership_invitation_pending_invitee_idx";
+DROP INDEX IF EXISTS "profile_membership_invitation_pending_profile_idx";
+DROP TABLE IF EXISTS "profile_membership_invitation";
@@ -0,0 +1,12 @@
+-- +goose Up
+
+-- Outcome of the last sync attempt of a managed link, so owners can see when
+-- syncing stopped (e.g. an expired token or revoked access).
+ALTER TABLE "profile_link" ADD COLUMN "sync_status" TEXT;
+ALTER TABLE "profile_link" ADD COLUMN "sync_error" TEXT;
+ALTER TABLE "profile_link" ADD COLUMN "sync_attempted_at" TIMESTAMP WITH TIME ZONE;
+
+-- +goose Down
+ALTER TABLE "profile_link" DROP COLUMN "sync_attempted_at";
+ALTER TABLE "profile_link" DROP COLUMN "sync_error";
+ALTER TABLE "profile_link" DROP COLUMN "sync_status";
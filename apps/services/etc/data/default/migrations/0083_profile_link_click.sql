@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- Clicks on profile links followed through the redirect endpoint. Anonymous
+-- clicks have no visitor; obvious bots are not recorded.
+CREATE TABLE IF NOT EXISTS "profile_link_click" (
+  "id"              CHAR(26) NOT NULL PRIMARY KEY,
+  "profile_link_id" CHAR(26) NOT NULL
+    CONSTRAINT "profile_link_click_profile_link_id_fk" REFERENCES "profile_link" ("id") ON DELETE CASCADE,
+  "visitor_user_id" CHAR(26),
+  "created_at"      TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "profile_link_click_profile_link_id_created_at_idx"
+  ON "profile_link_click" ("profile_link_id", "created_at");
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "profile_link_click";
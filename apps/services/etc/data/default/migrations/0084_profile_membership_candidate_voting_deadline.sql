@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- Candidates still in voting after their deadline are resolved by the referral
+-- resolver worker. NULL (candidates created before this) never expires.
+ALTER TABLE "profile_membership_candidate"
+  ADD COLUMN "voting_deadline" TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS "profile_membership_candidate_voting_deadline_idx"
+  ON "profile_membership_candidate" ("voting_deadline")
+  WHERE "status" = 'voting' AND "voting_deadline" IS NOT NULL AND "deleted_at" IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_membership_candidate_voting_deadline_idx";
+
+ALTER TABLE "profile_membership_candidate"
+  DROP COLUMN IF EXISTS "voting_deadline";
@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- Members opt in to having their stories shown in the organization's aggregated
+-- story list.
+ALTER TABLE "profile_membership"
+  ADD COLUMN "aggregate_stories" BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS "profile_membership_aggregate_stories_idx"
+  ON "profile_membership" ("profile_id")
+  WHERE "aggregate_stories" = TRUE AND "deleted_at" IS NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_membership_aggregate_stories_idx";
+
+ALTER TABLE "profile_membership"
+  DROP COLUMN IF EXISTS "aggregate_stories";
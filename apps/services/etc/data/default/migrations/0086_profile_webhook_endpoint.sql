@@ -0,0 +1,21 @@
+-- +goose Up
+
+-- Endpoints a profile has registered to receive its membership webhooks.
+-- Every delivery is signed with the endpoint's secret.
+CREATE TABLE IF NOT EXISTS "profile_webhook_endpoint" (
+  "id"         CHAR(26) NOT NULL PRIMARY KEY,
+  "profile_id" CHAR(26) NOT NULL
+    CONSTRAINT "profile_webhook_endpoint_profile_id_fk" REFERENCES "profile",
+  "target_url" TEXT NOT NULL,
+  "secret"     TEXT NOT NULL,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  "deleted_at" TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS "profile_webhook_endpoint_profile_id_idx"
+  ON "profile_webhook_endpoint" ("profile_id")
+  WHERE "deleted_at" IS NULL;
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "profile_webhook_endpoint";
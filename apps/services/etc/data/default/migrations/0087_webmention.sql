@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- Webmentions received for profiles served on custom domains. A mention is
+-- stored as pending and verified asynchronously by fetching its source; only
+-- verified mentions are listed. A repeated (source, target) pair is verified
+-- again, as senders re-send a mention when the source changes.
+CREATE TABLE IF NOT EXISTS "webmention" (
+  "id"          CHAR(26) NOT NULL PRIMARY KEY,
+  "profile_id"  CHAR(26) NOT NULL
+    CONSTRAINT "webmention_profile_id_fk" REFERENCES "profile",
+  "source_url"  TEXT NOT NULL,
+  "target_url"  TEXT NOT NULL,
+  "status"      TEXT NOT NULL DEFAULT 'pending'
+    CONSTRAINT "webmention_status_check" CHECK ("status" IN ('pending', 'verified', 'rejected')),
+  "verified_at" TIMESTAMP WITH TIME ZONE,
+  "created_at"  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  "updated_at"  TIMESTAMP WITH TIME ZONE,
+  CONSTRAINT "webmention_source_target_unique" UNIQUE ("source_url", "target_url")
+);
+
+CREATE INDEX IF NOT EXISTS "webmention_profile_verified_idx"
+  ON "webmention" ("profile_id", "id" DESC)
+  WHERE "status" = 'verified';
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "webmention";
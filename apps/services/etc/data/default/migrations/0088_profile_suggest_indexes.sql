@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Prefix indexes for search suggestions, which match the start of a profile's
+-- slug or of one of its titles. text_pattern_ops lets LIKE 'prefix%' use them
+-- regardless of the database collation.
+CREATE INDEX IF NOT EXISTS "profile_slug_prefix_idx"
+  ON "profile" ("slug" text_pattern_ops)
+  WHERE "deleted_at" IS NULL AND "approved_at" IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS "profile_tx_title_prefix_idx"
+  ON "profile_tx" (normalize_text("title") text_pattern_ops);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS "profile_tx_title_prefix_idx";
+DROP INDEX IF EXISTS "profile_slug_prefix_idx";
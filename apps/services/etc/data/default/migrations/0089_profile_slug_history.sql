@@ -0,0 +1,20 @@
+-- +goose Up
+
+-- Slugs a profile used before it was renamed. Requests for an old slug are
+-- redirected to the profile's current slug. A slug belongs to at most one
+-- profile's history and is removed from it when the profile takes it back.
+CREATE TABLE IF NOT EXISTS "profile_slug_history" (
+  "id"         CHAR(26) NOT NULL PRIMARY KEY,
+  "profile_id" CHAR(26) NOT NULL
+    CONSTRAINT "profile_slug_history_profile_id_fk" REFERENCES "profile",
+  "slug"       TEXT NOT NULL
+    CONSTRAINT "profile_slug_history_slug_unique" UNIQUE,
+  "created_at" TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS "profile_slug_history_profile_id_idx"
+  ON "profile_slug_history" ("profile_id");
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "profile_slug_history";
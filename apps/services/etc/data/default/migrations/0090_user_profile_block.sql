@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- Profiles a user blocked. Their content is left out of the user's followed
+-- feed, and the user is left out of their member suggestions.
+CREATE TABLE IF NOT EXISTS "user_profile_block" (
+  "user_id"    CHAR(26) NOT NULL
+    CONSTRAINT "user_profile_block_user_id_fk" REFERENCES "user" ("id") ON DELETE CASCADE,
+  "profile_id" CHAR(26) NOT NULL
+    CONSTRAINT "user_profile_block_profile_id_fk" REFERENCES "profile" ("id") ON DELETE CASCADE,
+  "created_at" TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+  PRIMARY KEY ("user_id", "profile_id")
+);
+
+CREATE INDEX IF NOT EXISTS "user_profile_block_profile_id_idx"
+  ON "user_profile_block" ("profile_id");
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "user_profile_block";
@@ -0,0 +1,37 @@
+-- +goose Up
+
+-- Reports of abusive profiles, pages and links, reviewed by admins in the
+-- moderation queue. reporter_key identifies the reporter, a user or a hashed
+-- IP address for anonymous reports, so that a reporter has at most one open
+-- report per reported entity.
+CREATE TABLE IF NOT EXISTS "profile_report" (
+  "id"                  CHAR(26) NOT NULL PRIMARY KEY,
+  "profile_id"          CHAR(26) NOT NULL
+    CONSTRAINT "profile_report_profile_id_fk" REFERENCES "profile",
+  "target_kind"         TEXT NOT NULL,
+  "target_id"           CHAR(26) NOT NULL,
+  "reporter_user_id"    CHAR(26)
+    CONSTRAINT "profile_report_reporter_user_id_fk" REFERENCES "user",
+  "reporter_key"        TEXT NOT NULL,
+  "reason"              TEXT NOT NULL,
+  "text"                TEXT,
+  "status"              TEXT NOT NULL DEFAULT 'open',
+  "resolved_by_user_id" CHAR(26)
+    CONSTRAINT "profile_report_resolved_by_user_id_fk" REFERENCES "user",
+  "resolved_at"         TIMESTAMP WITH TIME ZONE,
+  "created_at"          TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "profile_report_open_reporter_unique"
+  ON "profile_report" ("target_kind", "target_id", "reporter_key")
+  WHERE "status" = 'open';
+
+CREATE INDEX IF NOT EXISTS "profile_report_status_created_at_idx"
+  ON "profile_report" ("status", "created_at" DESC);
+
+CREATE INDEX IF NOT EXISTS "profile_report_reporter_key_created_at_idx"
+  ON "profile_report" ("reporter_key", "created_at");
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "profile_report";
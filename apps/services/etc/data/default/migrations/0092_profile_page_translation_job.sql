@@ -0,0 +1,32 @@
+-- +goose Up
+
+-- Bulk auto-translations of a profile page into its missing locales. Each
+-- locale is translated by its own event queue item, which records its outcome
+-- in profile_page_translation_job_result, so a job's progress survives
+-- restarts and can be read from any instance.
+CREATE TABLE IF NOT EXISTS "profile_page_translation_job" (
+  "id"            CHAR(26) NOT NULL PRIMARY KEY,
+  "page_id"       CHAR(26) NOT NULL
+    CONSTRAINT "profile_page_translation_job_page_id_fk" REFERENCES "profile_page",
+  "user_id"       CHAR(26) NOT NULL
+    CONSTRAINT "profile_page_translation_job_user_id_fk" REFERENCES "user",
+  "source_locale" CHAR(12) NOT NULL,
+  "total"         INTEGER NOT NULL,
+  "created_at"    TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS "profile_page_translation_job_result" (
+  "job_id"      CHAR(26) NOT NULL
+    CONSTRAINT "profile_page_translation_job_result_job_id_fk"
+      REFERENCES "profile_page_translation_job" ON DELETE CASCADE,
+  "locale_code" CHAR(12) NOT NULL,
+  "status"      TEXT NOT NULL,
+  "reason"      TEXT,
+  "created_at"  TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  PRIMARY KEY ("job_id", "locale_code")
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS "profile_page_translation_job_result";
+DROP TABLE IF EXISTS "profile_page_translation_job";
@@ -20,6 +20,10 @@ ON CONFLICT ("key") DO UPDATE SET value = sqlc.arg(value), updated_at = NOW();
 DELETE FROM "cache"
 WHERE key = sqlc.arg(key);
 
+-- name: RemoveFromCacheByPrefix :execrows
+DELETE FROM "cache"
+WHERE starts_with(key, sqlc.arg(prefix)::TEXT);
+
 -- name: RemoveAllFromCache :execrows
 DELETE FROM "cache";
 
@@ -0,0 +1,78 @@
+-- name: CreateConsistencyCheck :exec
+INSERT INTO "consistency_check" (
+  id,
+  requested_by_user_id,
+  repair,
+  status,
+  created_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(requested_by_user_id),
+  sqlc.arg(repair),
+  'pending',
+  NOW()
+);
+
+-- name: GetConsistencyCheckByID :one
+SELECT *
+FROM "consistency_check"
+WHERE id = sqlc.arg(id);
+
+-- name: ListConsistencyChecks :many
+SELECT *
+FROM "consistency_check"
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: FinishConsistencyCheck :execrows
+UPDATE "consistency_check"
+SET
+  status = sqlc.arg(status),
+  findings = sqlc.narg(findings),
+  error_message = sqlc.narg(error_message),
+  completed_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: ListIndividualProfilesWithoutOwnerMembership :many
+-- Individual profiles missing the self-owner membership created at signup,
+-- along with the user that has the profile as its individual profile (if any).
+SELECT
+  p.id AS profile_id,
+  u.id AS user_id
+FROM "profile" p
+  LEFT JOIN "user" u ON u.individual_profile_id = p.id AND u.deleted_at IS NULL
+WHERE p.kind = 'individual'
+  AND p.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_membership" pm
+    WHERE pm.profile_id = p.id
+      AND pm.member_profile_id = p.id
+      AND pm.kind = 'owner'
+      AND pm.deleted_at IS NULL
+  )
+ORDER BY p.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListProfilesWithoutTranslation :many
+SELECT p.id AS profile_id
+FROM "profile" p
+WHERE p.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_tx" pt
+    WHERE pt.profile_id = p.id
+  )
+ORDER BY p.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListUsersLinkedToMissingProfiles :many
+-- Users whose individual profile was deleted or no longer exists.
+SELECT
+  u.id AS user_id,
+  u.individual_profile_id AS profile_id
+FROM "user" u
+  LEFT JOIN "profile" p ON p.id = u.individual_profile_id
+WHERE u.deleted_at IS NULL
+  AND u.individual_profile_id IS NOT NULL
+  AND (p.id IS NULL OR p.deleted_at IS NOT NULL)
+ORDER BY u.id
+LIMIT sqlc.arg(limit_count);
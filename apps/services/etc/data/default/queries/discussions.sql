@@ -283,3 +283,38 @@ SELECT author_profile_id
 FROM "story"
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
+
+-- name: GetStoryDiscussionSettings :one
+-- Returns the per-story discussions flag together with the author profile's
+-- discussions module visibility.
+SELECT
+  s.feat_discussions,
+  s.author_profile_id,
+  p.slug AS author_profile_slug,
+  p.feature_discussions AS author_feature_discussions
+FROM "story" s
+LEFT JOIN "profile" p ON p.id = s.author_profile_id
+  AND p.deleted_at IS NULL
+WHERE s.id = sqlc.arg(id)
+  AND s.deleted_at IS NULL;
+
+-- name: GetProfileStoryIDBySlugs :one
+-- Returns a public or unlisted story that is authored by or published to the profile.
+SELECT s.id
+FROM "story" s
+INNER JOIN "profile" p ON p.slug = sqlc.arg(profile_slug)
+  AND p.deleted_at IS NULL
+WHERE s.slug = sqlc.arg(story_slug)
+  AND s.deleted_at IS NULL
+  AND s.visibility IN ('public', 'unlisted')
+  AND (
+    s.author_profile_id = p.id
+    OR EXISTS (
+      SELECT 1
+      FROM "story_publication" sp
+      WHERE sp.story_id = s.id
+        AND sp.profile_id = p.id
+        AND sp.deleted_at IS NULL
+    )
+  )
+LIMIT 1;
@@ -37,3 +37,18 @@ WHERE entity_type = sqlc.arg(entity_type)
   AND entity_id = sqlc.arg(entity_id)
 ORDER BY created_at DESC
 LIMIT sqlc.arg(limit_count);
+
+-- name: ListEventAuditInRange :many
+-- Pages through the audit events created in [from_time, to_time) in
+-- (created_at, id) order. Pass the last row of the previous page as the cursor.
+SELECT *
+FROM "event_audit"
+WHERE created_at >= sqlc.arg(from_time)
+  AND created_at < sqlc.arg(to_time)
+  AND (sqlc.narg(filter_event_type)::TEXT IS NULL OR event_type = sqlc.narg(filter_event_type)::TEXT)
+  AND (
+    sqlc.narg(after_created_at)::TIMESTAMPTZ IS NULL
+    OR (created_at, id) > (sqlc.narg(after_created_at)::TIMESTAMPTZ, sqlc.narg(after_id)::TEXT)
+  )
+ORDER BY created_at, id
+LIMIT sqlc.arg(limit_count);
@@ -80,3 +80,19 @@ FROM "event_queue"
 WHERE type = sqlc.arg(type)
 ORDER BY created_at DESC
 LIMIT sqlc.arg(limit_count);
+
+-- name: GetQueueItemByID :one
+SELECT *
+FROM "event_queue"
+WHERE id = sqlc.arg(id);
+
+-- name: GetActiveQueueItemByPayload :one
+-- Finds a pending or processing item whose payload contains the given one,
+-- so callers can avoid queueing the same work twice.
+SELECT *
+FROM "event_queue"
+WHERE type = sqlc.arg(type)
+  AND status IN ('pending', 'processing')
+  AND payload @> sqlc.arg(payload)::JSONB
+ORDER BY created_at DESC
+LIMIT 1;
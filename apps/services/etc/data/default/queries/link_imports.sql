@@ -12,6 +12,7 @@ FROM "profile_link" pl
   INNER JOIN "profile" p ON p.id = pl.profile_id
     AND p.deleted_at IS NULL
 WHERE pl.kind = sqlc.arg(kind)
+  AND (sqlc.narg(filter_profile_id)::TEXT IS NULL OR pl.profile_id = sqlc.narg(filter_profile_id)::TEXT)
   AND pl.is_managed = TRUE
   AND pl.auth_access_token IS NOT NULL
   AND pl.deleted_at IS NULL
@@ -31,6 +32,7 @@ FROM "profile_link" pl
   INNER JOIN "profile" p ON p.id = pl.profile_id
     AND p.deleted_at IS NULL
 WHERE pl.kind = sqlc.arg(kind)
+  AND (sqlc.narg(filter_profile_id)::TEXT IS NULL OR pl.profile_id = sqlc.narg(filter_profile_id)::TEXT)
   AND pl.is_managed = TRUE
   AND pl.deleted_at IS NULL
 ORDER BY pl.updated_at ASC NULLS FIRST
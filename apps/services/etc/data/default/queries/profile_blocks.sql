@@ -0,0 +1,40 @@
+-- name: InsertUserProfileBlock :execrows
+INSERT INTO "user_profile_block" (user_id, profile_id, created_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(profile_id), NOW())
+ON CONFLICT (user_id, profile_id) DO NOTHING;
+
+-- name: DeleteUserProfileBlock :execrows
+DELETE FROM "user_profile_block"
+WHERE user_id = sqlc.arg(user_id)
+  AND profile_id = sqlc.arg(profile_id);
+
+-- name: ListUserProfileBlocks :many
+SELECT
+  upb.created_at AS blocked_at,
+  p.id,
+  p.slug,
+  p.kind,
+  p.profile_picture_uri,
+  pt.title,
+  pt.description
+FROM "user_profile_block" upb
+  INNER JOIN "profile" p ON p.id = upb.profile_id
+    AND p.deleted_at IS NULL
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+  AND pt.locale_code = (
+    SELECT ptf.locale_code FROM "profile_tx" ptf
+    WHERE ptf.profile_id = p.id
+    ORDER BY CASE
+      WHEN ptf.locale_code = sqlc.arg(locale_code) THEN 0
+      WHEN ptf.locale_code = p.default_locale THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+WHERE upb.user_id = sqlc.arg(user_id)
+ORDER BY upb.created_at DESC;
+
+-- name: ListBlockedProfileIDsByUser :many
+SELECT profile_id
+FROM "user_profile_block"
+WHERE user_id = sqlc.arg(user_id);
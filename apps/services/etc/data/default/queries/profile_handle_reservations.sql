@@ -0,0 +1,40 @@
+-- name: GetActiveHandleReservationBySlug :one
+SELECT phr.id, phr.slug, phr.verification_email, phr.verification_domain, phr.note,
+       phr.reserved_by_user_id, phr.expires_at, phr.claimed_at, phr.claimed_by_profile_id,
+       phr.released_at, phr.created_at
+FROM "profile_handle_reservation" phr
+WHERE phr.slug = sqlc.arg(slug)
+  AND phr.claimed_at IS NULL
+  AND phr.released_at IS NULL
+  AND phr.expires_at > NOW()
+ORDER BY phr.expires_at DESC
+LIMIT 1;
+
+-- name: CreateHandleReservation :exec
+INSERT INTO "profile_handle_reservation" (
+  id, slug, verification_email, verification_domain, note, reserved_by_user_id, expires_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(slug),
+  sqlc.narg(verification_email),
+  sqlc.narg(verification_domain),
+  sqlc.narg(note),
+  sqlc.arg(reserved_by_user_id),
+  sqlc.arg(expires_at)
+);
+
+-- name: ReleaseHandleReservation :execrows
+UPDATE "profile_handle_reservation"
+SET released_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND claimed_at IS NULL
+  AND released_at IS NULL;
+
+-- name: ClaimHandleReservation :execrows
+UPDATE "profile_handle_reservation"
+SET
+  claimed_at = NOW(),
+  claimed_by_profile_id = sqlc.arg(claimed_by_profile_id)
+WHERE id = sqlc.arg(id)
+  AND claimed_at IS NULL
+  AND released_at IS NULL;
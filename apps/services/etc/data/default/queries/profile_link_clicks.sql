@@ -0,0 +1,18 @@
+-- name: InsertProfileLinkClick :exec
+INSERT INTO "profile_link_click" (id, profile_link_id, visitor_user_id, created_at)
+VALUES (
+  sqlc.arg(id),
+  sqlc.arg(profile_link_id),
+  sqlc.narg(visitor_user_id),
+  NOW()
+);
+
+-- name: CountProfileLinkClicksByDay :many
+SELECT
+  date_trunc('day', c.created_at, 'UTC')::TIMESTAMPTZ AS day,
+  COUNT(*) AS clicks
+FROM "profile_link_click" c
+WHERE c.profile_link_id = sqlc.arg(profile_link_id)
+  AND c.created_at >= sqlc.arg(since)
+GROUP BY day
+ORDER BY day ASC;
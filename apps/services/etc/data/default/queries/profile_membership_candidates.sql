@@ -1,6 +1,7 @@
 -- name: CreateProfileMembershipCandidate :one
 INSERT INTO "profile_membership_candidate" (
-  id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status, created_at
+  id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status,
+  voting_deadline, created_at
 ) VALUES (
   sqlc.arg(id),
   sqlc.arg(profile_id),
@@ -9,6 +10,7 @@ INSERT INTO "profile_membership_candidate" (
   sqlc.arg(source),
   sqlc.narg(applicant_message),
   'voting',
+  sqlc.narg(voting_deadline),
   NOW()
 ) RETURNING *;
 
@@ -169,6 +171,26 @@ WHERE id = sqlc.arg(id)
   AND profile_id = sqlc.arg(profile_id)
   AND deleted_at IS NULL;
 
+-- name: ListExpiredVotingCandidates :many
+SELECT pmc.id, pmc.profile_id, p.slug AS profile_slug, pmc.referred_profile_id, pmc.source,
+  pmc.voting_deadline
+FROM "profile_membership_candidate" pmc
+  INNER JOIN "profile" p ON p.id = pmc.profile_id AND p.deleted_at IS NULL
+WHERE pmc.status = 'voting'
+  AND pmc.voting_deadline IS NOT NULL
+  AND pmc.voting_deadline <= sqlc.arg(now)::TIMESTAMP WITH TIME ZONE
+  AND pmc.deleted_at IS NULL
+ORDER BY pmc.voting_deadline, pmc.id
+LIMIT sqlc.arg(limit_count);
+
+-- name: ResolveVotingCandidate :execrows
+UPDATE "profile_membership_candidate"
+SET status = sqlc.arg(status),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = 'voting'
+  AND deleted_at IS NULL;
+
 -- name: UpdateCandidateApplicantMessage :exec
 UPDATE "profile_membership_candidate"
 SET applicant_message = sqlc.arg(applicant_message),
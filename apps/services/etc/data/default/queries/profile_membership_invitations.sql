@@ -0,0 +1,43 @@
+-- name: CreateProfileMembershipInvitation :exec
+INSERT INTO "profile_membership_invitation" (
+  id, profile_id, invitee_profile_id, kind, invited_by_user_id, expires_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(profile_id),
+  sqlc.arg(invitee_profile_id),
+  sqlc.arg(kind),
+  sqlc.arg(invited_by_user_id),
+  sqlc.arg(expires_at)
+);
+
+-- name: GetProfileMembershipInvitationByID :one
+SELECT *
+FROM "profile_membership_invitation"
+WHERE id = sqlc.arg(id);
+
+-- name: GetPendingProfileMembershipInvitation :one
+SELECT *
+FROM "profile_membership_invitation"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND invitee_profile_id = sqlc.arg(invitee_profile_id)
+  AND status = 'pending'
+  AND expires_at > NOW()
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: ListPendingProfileMembershipInvitations :many
+-- Returns the unexpired pending invitations a profile has sent or received.
+SELECT *
+FROM "profile_membership_invitation"
+WHERE (profile_id = sqlc.arg(profile_id) OR invitee_profile_id = sqlc.arg(profile_id))
+  AND status = 'pending'
+  AND expires_at > NOW()
+ORDER BY created_at DESC;
+
+-- name: RespondToProfileMembershipInvitation :execrows
+UPDATE "profile_membership_invitation"
+SET
+  status = sqlc.arg(status),
+  responded_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = 'pending';
@@ -0,0 +1,142 @@
+-- name: ListProfilesBySlugsForMention :many
+-- Resolves mentioned slugs to active profiles.
+SELECT p.id, p.slug
+FROM "profile" p
+WHERE p.slug = ANY(string_to_array(sqlc.arg(slugs)::TEXT, ','))
+  AND p.deleted_at IS NULL;
+
+-- name: GetStoryAuthorProfileIDForMention :one
+SELECT s.author_profile_id
+FROM "story" s
+WHERE s.id = sqlc.arg(id)
+  AND s.deleted_at IS NULL
+LIMIT 1;
+
+-- name: GetProfilePageProfileIDForMention :one
+SELECT pp.profile_id
+FROM "profile_page" pp
+WHERE pp.id = sqlc.arg(id)
+  AND pp.deleted_at IS NULL
+LIMIT 1;
+
+-- name: ListProfileMentionsBySource :many
+-- Lists the profiles mentioned by a source in a specific locale.
+SELECT pm.mentioned_profile_id, p.slug
+FROM "profile_mention" pm
+  INNER JOIN "profile" p ON p.id = pm.mentioned_profile_id
+    AND p.deleted_at IS NULL
+WHERE pm.source_kind = sqlc.arg(source_kind)
+  AND pm.source_id = sqlc.arg(source_id)
+  AND pm.locale_code = sqlc.arg(locale_code)
+ORDER BY p.slug;
+
+-- name: DeleteProfileMentionsBySource :exec
+DELETE FROM "profile_mention"
+WHERE source_kind = sqlc.arg(source_kind)
+  AND source_id = sqlc.arg(source_id)
+  AND locale_code = sqlc.arg(locale_code);
+
+-- name: InsertProfileMention :exec
+INSERT INTO "profile_mention" (
+  id, source_kind, source_id, source_profile_id, mentioned_profile_id, locale_code, created_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(source_kind),
+  sqlc.arg(source_id),
+  sqlc.arg(source_profile_id),
+  sqlc.arg(mentioned_profile_id),
+  sqlc.arg(locale_code),
+  NOW()
+) ON CONFLICT (source_kind, source_id, locale_code, mentioned_profile_id) DO NOTHING;
+
+-- name: ListStoryMentionsOfProfile :many
+-- Lists public, published stories that mention a profile (one row per story).
+SELECT DISTINCT ON (s.id)
+  s.id AS source_id,
+  s.slug AS source_slug,
+  st.title AS source_title,
+  st.locale_code,
+  p.slug AS source_profile_slug,
+  pt.title AS source_profile_title,
+  pm.created_at
+FROM "profile_mention" pm
+  INNER JOIN "story" s ON s.id = pm.source_id
+    AND s.visibility = 'public'
+    AND s.deleted_at IS NULL
+  INNER JOIN "story_tx" st ON st.story_id = s.id
+    AND st.locale_code = (
+      SELECT stx.locale_code FROM "story_tx" stx
+      WHERE stx.story_id = s.id
+      ORDER BY CASE
+        WHEN stx.locale_code = sqlc.arg(locale_code) THEN 0
+        WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+  INNER JOIN "profile" p ON p.id = s.author_profile_id
+    AND p.deleted_at IS NULL
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+    AND pt.locale_code = (
+      SELECT ptx.locale_code FROM "profile_tx" ptx
+      WHERE ptx.profile_id = p.id
+      ORDER BY CASE
+        WHEN ptx.locale_code = sqlc.arg(locale_code) THEN 0
+        WHEN ptx.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+WHERE pm.mentioned_profile_id = sqlc.arg(mentioned_profile_id)
+  AND pm.source_kind = 'story'
+  AND EXISTS (
+    SELECT 1 FROM "story_publication" sp
+    WHERE sp.story_id = s.id
+      AND sp.deleted_at IS NULL
+  )
+ORDER BY s.id, pm.created_at DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListPageMentionsOfProfile :many
+-- Lists public, published profile pages that mention a profile (one row per page).
+SELECT DISTINCT ON (pp.id)
+  pp.id AS source_id,
+  pp.slug AS source_slug,
+  ppt.title AS source_title,
+  ppt.locale_code,
+  p.slug AS source_profile_slug,
+  pt.title AS source_profile_title,
+  pm.created_at
+FROM "profile_mention" pm
+  INNER JOIN "profile_page" pp ON pp.id = pm.source_id
+    AND pp.visibility = 'public'
+    AND pp.deleted_at IS NULL
+  INNER JOIN "profile" p ON p.id = pp.profile_id
+    AND p.deleted_at IS NULL
+  INNER JOIN "profile_page_tx" ppt ON ppt.profile_page_id = pp.id
+    AND ppt.locale_code = (
+      SELECT pptf.locale_code FROM "profile_page_tx" pptf
+      WHERE pptf.profile_page_id = pp.id
+      ORDER BY CASE
+        WHEN pptf.locale_code = sqlc.arg(locale_code) THEN 0
+        WHEN pptf.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+    AND pt.locale_code = (
+      SELECT ptx.locale_code FROM "profile_tx" ptx
+      WHERE ptx.profile_id = p.id
+      ORDER BY CASE
+        WHEN ptx.locale_code = sqlc.arg(locale_code) THEN 0
+        WHEN ptx.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+WHERE pm.mentioned_profile_id = sqlc.arg(mentioned_profile_id)
+  AND pm.source_kind = 'page'
+  AND (pp.published_at IS NULL OR pp.published_at <= NOW())
+ORDER BY pp.id, pm.created_at DESC
+LIMIT sqlc.arg(limit_count);
@@ -0,0 +1,105 @@
+-- name: MoveProfileLinks :execrows
+-- Moves the live links of the source profile to the target, after the target's
+-- own links, skipping links the target already has (same kind and remote ID or URI).
+UPDATE "profile_link" pl
+SET
+  profile_id = sqlc.arg(target_profile_id),
+  "order" = pl."order" + (
+    SELECT COALESCE(MAX(t."order"), 0)
+    FROM "profile_link" t
+    WHERE t.profile_id = sqlc.arg(target_profile_id)
+      AND t.deleted_at IS NULL
+  ),
+  updated_at = NOW()
+WHERE pl.profile_id = sqlc.arg(source_profile_id)
+  AND pl.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_link" t
+    WHERE t.profile_id = sqlc.arg(target_profile_id)
+      AND t.kind = pl.kind
+      AND t.deleted_at IS NULL
+      AND (t.remote_id = pl.remote_id OR t.uri = pl.uri)
+  );
+
+-- name: MoveProfilePages :execrows
+-- Moves the live pages of the source profile to the target, skipping pages whose
+-- slug the target already uses.
+UPDATE "profile_page" pp
+SET
+  profile_id = sqlc.arg(target_profile_id),
+  updated_at = NOW()
+WHERE pp.profile_id = sqlc.arg(source_profile_id)
+  AND pp.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_page" t
+    WHERE t.profile_id = sqlc.arg(target_profile_id)
+      AND t.slug = pp.slug
+  );
+
+-- name: MoveProfileResources :execrows
+-- Moves the live resources of the source profile to the target, skipping
+-- resources the target already has (same kind and remote ID).
+UPDATE "profile_resource" pr
+SET
+  profile_id = sqlc.arg(target_profile_id),
+  added_by_profile_id = CASE
+    WHEN pr.added_by_profile_id = sqlc.arg(source_profile_id) THEN sqlc.arg(target_profile_id)
+    ELSE pr.added_by_profile_id
+  END,
+  updated_at = NOW()
+WHERE pr.profile_id = sqlc.arg(source_profile_id)
+  AND pr.deleted_at IS NULL
+  AND (
+    pr.remote_id IS NULL
+    OR NOT EXISTS (
+      SELECT 1 FROM "profile_resource" t
+      WHERE t.profile_id = sqlc.arg(target_profile_id)
+        AND t.kind = pr.kind
+        AND t.remote_id = pr.remote_id
+        AND t.deleted_at IS NULL
+    )
+  );
+
+-- name: MoveProfileMembershipsAsMember :execrows
+-- Hands the source profile's memberships in other profiles to the target,
+-- skipping profiles the target is already a member of.
+UPDATE "profile_membership" pm
+SET
+  member_profile_id = sqlc.arg(target_profile_id),
+  updated_at = NOW()
+WHERE pm.member_profile_id = sqlc.arg(source_profile_id)
+  AND pm.profile_id <> sqlc.arg(source_profile_id)
+  AND pm.profile_id <> sqlc.arg(target_profile_id)
+  AND pm.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_membership" t
+    WHERE t.profile_id = pm.profile_id
+      AND t.member_profile_id = sqlc.arg(target_profile_id)
+      AND t.deleted_at IS NULL
+  );
+
+-- name: MoveProfileMembershipsOfProfile :execrows
+-- Moves the members of the source profile to the target, skipping members the
+-- target already has and the two profiles themselves.
+UPDATE "profile_membership" pm
+SET
+  profile_id = sqlc.arg(target_profile_id),
+  updated_at = NOW()
+WHERE pm.profile_id = sqlc.arg(source_profile_id)
+  AND pm.member_profile_id <> sqlc.arg(source_profile_id)
+  AND pm.member_profile_id <> sqlc.arg(target_profile_id)
+  AND pm.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_membership" t
+    WHERE t.profile_id = sqlc.arg(target_profile_id)
+      AND t.member_profile_id = pm.member_profile_id
+      AND t.deleted_at IS NULL
+  );
+
+-- name: RepointUserIndividualProfile :execrows
+UPDATE "user"
+SET
+  individual_profile_id = sqlc.arg(target_profile_id),
+  updated_at = NOW()
+WHERE individual_profile_id = sqlc.arg(source_profile_id)
+  AND deleted_at IS NULL;
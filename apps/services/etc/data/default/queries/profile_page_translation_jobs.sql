@@ -0,0 +1,45 @@
+-- name: InsertProfilePageTranslationJob :exec
+INSERT INTO "profile_page_translation_job" (
+  id,
+  page_id,
+  user_id,
+  source_locale,
+  total,
+  created_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(page_id),
+  sqlc.arg(user_id),
+  sqlc.arg(source_locale),
+  sqlc.arg(total),
+  NOW()
+);
+
+-- name: GetProfilePageTranslationJob :one
+SELECT *
+FROM "profile_page_translation_job"
+WHERE id = sqlc.arg(id);
+
+-- name: ListProfilePageTranslationJobResults :many
+SELECT *
+FROM "profile_page_translation_job_result"
+WHERE job_id = sqlc.arg(job_id)
+ORDER BY created_at, locale_code;
+
+-- name: InsertProfilePageTranslationJobResult :execrows
+-- Does nothing when the locale already has a result, so a retried queue item
+-- keeps the first recorded outcome.
+INSERT INTO "profile_page_translation_job_result" (
+  job_id,
+  locale_code,
+  status,
+  reason,
+  created_at
+) VALUES (
+  sqlc.arg(job_id),
+  sqlc.arg(locale_code),
+  sqlc.arg(status),
+  sqlc.narg(reason),
+  NOW()
+)
+ON CONFLICT (job_id, locale_code) DO NOTHING;
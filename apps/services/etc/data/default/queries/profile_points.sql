@@ -27,6 +27,32 @@ INSERT INTO "profile_point_transaction" (
   NOW()
 ) RETURNING *;
 
+-- name: RecordProfilePointAward :one
+-- Records an action award. Returns no row when the award key was already used.
+INSERT INTO "profile_point_transaction" (
+  id,
+  target_profile_id,
+  transaction_type,
+  triggering_event,
+  award_key,
+  description,
+  amount,
+  balance_after,
+  created_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(target_profile_id),
+  'GAIN',
+  sqlc.arg(triggering_event),
+  sqlc.arg(award_key),
+  sqlc.arg(description),
+  sqlc.arg(amount),
+  sqlc.arg(balance_after),
+  NOW()
+)
+ON CONFLICT (award_key) WHERE award_key IS NOT NULL DO NOTHING
+RETURNING *;
+
 -- name: AddPointsToProfile :execrows
 UPDATE "profile"
 SET
@@ -104,6 +104,31 @@ SET
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: UpdateProfileQuestionResolved :exec
+UPDATE "profile_question"
+SET
+  resolved_at = CASE
+    WHEN sqlc.arg(is_resolved)::BOOLEAN THEN COALESCE(resolved_at, NOW())
+    ELSE NULL
+  END,
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: GetProfileQuestionAuthorProfileID :one
+SELECT u.individual_profile_id
+FROM "profile_question" pq
+  INNER JOIN "user" u ON u.id = pq.author_user_id
+WHERE pq.id = sqlc.arg(id)
+  AND pq.deleted_at IS NULL;
+
+-- name: CountProfileQuestionsByAuthorSince :one
+SELECT COUNT(*) AS count
+FROM "profile_question"
+WHERE author_user_id = sqlc.arg(author_user_id)
+  AND created_at >= sqlc.arg(since)
+  AND deleted_at IS NULL;
+
 -- name: InsertProfileQuestionVote :one
 INSERT INTO "profile_question_vote" (
   id,
@@ -0,0 +1,54 @@
+-- name: UpsertRecentProfileView :execrows
+-- Records a profile view, skipping the viewer's own individual profile and
+-- profiles the viewer owns.
+INSERT INTO "user_recent_profile_view" (user_id, profile_id, viewed_at)
+SELECT u.id, sqlc.arg(profile_id), NOW()
+FROM "user" u
+WHERE u.id = sqlc.arg(user_id)
+  AND u.deleted_at IS NULL
+  AND u.individual_profile_id IS DISTINCT FROM sqlc.arg(profile_id)
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_membership" pm
+    WHERE pm.profile_id = sqlc.arg(profile_id)
+      AND pm.member_profile_id = u.individual_profile_id
+      AND pm.kind = 'owner'
+      AND pm.deleted_at IS NULL
+  )
+ON CONFLICT (user_id, profile_id) DO UPDATE SET viewed_at = EXCLUDED.viewed_at;
+
+-- name: TrimRecentProfileViews :execrows
+DELETE FROM "user_recent_profile_view" v
+WHERE v.user_id = sqlc.arg(user_id)
+  AND v.profile_id NOT IN (
+    SELECT urpv.profile_id FROM "user_recent_profile_view" urpv
+    WHERE urpv.user_id = sqlc.arg(user_id)
+    ORDER BY urpv.viewed_at DESC
+    LIMIT sqlc.arg(keep_count)::INT
+  );
+
+-- name: ListRecentProfileViews :many
+SELECT
+  urpv.viewed_at,
+  p.id,
+  p.slug,
+  p.kind,
+  p.profile_picture_uri,
+  pt.title,
+  pt.description
+FROM "user_recent_profile_view" urpv
+  INNER JOIN "profile" p ON p.id = urpv.profile_id
+    AND p.deleted_at IS NULL
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+  AND pt.locale_code = (
+    SELECT ptf.locale_code FROM "profile_tx" ptf
+    WHERE ptf.profile_id = p.id
+    ORDER BY CASE
+      WHEN ptf.locale_code = sqlc.arg(locale_code) THEN 0
+      WHEN ptf.locale_code = p.default_locale THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+WHERE urpv.user_id = sqlc.arg(user_id)
+ORDER BY urpv.viewed_at DESC
+LIMIT sqlc.arg(limit_count)::INT;
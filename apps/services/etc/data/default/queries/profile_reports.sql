@@ -0,0 +1,66 @@
+-- name: InsertProfileReport :execrows
+-- Does nothing when the reporter already has an open report on the entity.
+INSERT INTO "profile_report" (
+  id,
+  profile_id,
+  target_kind,
+  target_id,
+  reporter_user_id,
+  reporter_key,
+  reason,
+  text,
+  status,
+  created_at
+) VALUES (
+  sqlc.arg(id),
+  sqlc.arg(profile_id),
+  sqlc.arg(target_kind),
+  sqlc.arg(target_id),
+  sqlc.narg(reporter_user_id),
+  sqlc.arg(reporter_key),
+  sqlc.arg(reason),
+  sqlc.narg(text),
+  'open',
+  NOW()
+)
+ON CONFLICT (target_kind, target_id, reporter_key) WHERE status = 'open' DO NOTHING;
+
+-- name: GetOpenProfileReportByReporter :one
+SELECT
+  pr.*,
+  p.slug AS profile_slug
+FROM "profile_report" pr
+  INNER JOIN "profile" p ON p.id = pr.profile_id
+WHERE pr.target_kind = sqlc.arg(target_kind)
+  AND pr.target_id = sqlc.arg(target_id)
+  AND pr.reporter_key = sqlc.arg(reporter_key)
+  AND pr.status = 'open'
+LIMIT 1;
+
+-- name: CountProfileReportsByReporterSince :one
+SELECT COUNT(*)::INT AS count
+FROM "profile_report"
+WHERE reporter_key = sqlc.arg(reporter_key)
+  AND created_at >= sqlc.arg(since);
+
+-- name: ListProfileReportsByStatus :many
+-- The moderation queue: reports in a status, oldest first so that reports
+-- waiting the longest are reviewed first.
+SELECT
+  pr.*,
+  p.slug AS profile_slug
+FROM "profile_report" pr
+  INNER JOIN "profile" p ON p.id = pr.profile_id
+WHERE pr.status = sqlc.arg(status)
+ORDER BY pr.created_at ASC, pr.id ASC
+LIMIT sqlc.arg(limit_count)
+OFFSET sqlc.arg(offset_count);
+
+-- name: ResolveProfileReport :execrows
+UPDATE "profile_report"
+SET
+  status = sqlc.arg(status),
+  resolved_by_user_id = sqlc.arg(resolved_by_user_id),
+  resolved_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = 'open';
@@ -0,0 +1,31 @@
+-- name: UpdateProfileSlug :execrows
+UPDATE "profile"
+SET
+  slug = sqlc.arg(slug),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: InsertProfileSlugHistory :exec
+INSERT INTO "profile_slug_history" (id, profile_id, slug, created_at)
+VALUES (sqlc.arg(id), sqlc.arg(profile_id), sqlc.arg(slug), NOW());
+
+-- name: DeleteProfileSlugHistory :execrows
+DELETE FROM "profile_slug_history"
+WHERE slug = sqlc.arg(slug)
+  AND profile_id = sqlc.arg(profile_id);
+
+-- name: GetProfileSlugHistoryProfileID :one
+SELECT profile_id
+FROM "profile_slug_history"
+WHERE slug = sqlc.arg(slug)
+LIMIT 1;
+
+-- name: GetCurrentSlugByPreviousSlug :one
+-- Resolves a slug a profile used before to the slug the live profile has now.
+SELECT p.slug
+FROM "profile_slug_history" psh
+  INNER JOIN "profile" p ON p.id = psh.profile_id
+    AND p.deleted_at IS NULL
+WHERE psh.slug = sqlc.arg(slug)
+LIMIT 1;
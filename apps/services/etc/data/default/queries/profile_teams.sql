@@ -32,6 +32,12 @@ JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.delete
 WHERE pmt.profile_membership_id = sqlc.arg(profile_membership_id) AND pt.deleted_at IS NULL
 ORDER BY pt.name ASC;
 
+-- name: ListTeamsForMemberships :many
+SELECT pmt.profile_membership_id, pt.* FROM "profile_team" pt
+JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
+WHERE pmt.profile_membership_id = ANY(sqlc.arg(profile_membership_ids)::TEXT[]) AND pt.deleted_at IS NULL
+ORDER BY pt.name ASC;
+
 -- name: SetMembershipTeams_Delete :execrows
 UPDATE "profile_membership_team"
 SET deleted_at = NOW()
@@ -42,17 +48,61 @@ INSERT INTO "profile_membership_team" (id, profile_membership_id, profile_team_i
 VALUES (sqlc.arg(id), sqlc.arg(profile_membership_id), sqlc.arg(profile_team_id))
 RETURNING *;
 
+-- name: CountProfileMembershipsByIDs :one
+SELECT COUNT(*) FROM "profile_membership"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND id = ANY(sqlc.arg(ids)::TEXT[])
+  AND deleted_at IS NULL;
+
+-- name: AddTeamToMembership :execrows
+INSERT INTO "profile_membership_team" (id, profile_membership_id, profile_team_id)
+VALUES (sqlc.arg(id), sqlc.arg(profile_membership_id), sqlc.arg(profile_team_id))
+ON CONFLICT (profile_membership_id, profile_team_id) WHERE deleted_at IS NULL DO NOTHING;
+
+-- name: RemoveTeamFromMemberships :execrows
+UPDATE "profile_membership_team"
+SET deleted_at = NOW()
+WHERE profile_team_id = sqlc.arg(profile_team_id)
+  AND profile_membership_id = ANY(sqlc.arg(profile_membership_ids)::TEXT[])
+  AND deleted_at IS NULL;
+
 -- name: ListProfileTeamsWithMemberCount :many
 SELECT pt.*,
+  lm.member_profile_id AS lead_member_profile_id,
   COUNT(DISTINCT pmt.id) AS member_count,
   COUNT(DISTINCT prt.id) AS resource_count
 FROM "profile_team" pt
+LEFT JOIN "profile_membership" lm ON lm.id = pt.lead_membership_id AND lm.deleted_at IS NULL
 LEFT JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 LEFT JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 WHERE pt.profile_id = sqlc.arg(profile_id) AND pt.deleted_at IS NULL
-GROUP BY pt.id
+GROUP BY pt.id, lm.member_profile_id
 ORDER BY pt.name ASC;
 
+-- name: IsMembershipInProfileTeam :one
+SELECT EXISTS(
+  SELECT 1 FROM "profile_membership_team"
+  WHERE profile_team_id = sqlc.arg(profile_team_id)
+    AND profile_membership_id = sqlc.arg(profile_membership_id)
+    AND deleted_at IS NULL
+) AS is_member;
+
+-- name: SetProfileTeamLead :execrows
+UPDATE "profile_team"
+SET lead_membership_id = sqlc.narg(lead_membership_id)
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL;
+
+-- name: ClearStaleProfileTeamLeads :execrows
+UPDATE "profile_team" pt
+SET lead_membership_id = NULL
+WHERE pt.lead_membership_id = ANY(sqlc.arg(profile_membership_ids)::TEXT[])
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_membership_team" pmt
+    WHERE pmt.profile_team_id = pt.id
+      AND pmt.profile_membership_id = pt.lead_membership_id
+      AND pmt.deleted_at IS NULL
+  );
+
 -- name: CountProfileTeamResources :one
 SELECT COUNT(*) FROM "profile_resource_team"
 WHERE profile_team_id = sqlc.arg(profile_team_id) AND deleted_at IS NULL;
@@ -63,6 +113,12 @@ JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_
 WHERE prt.profile_resource_id = sqlc.arg(profile_resource_id) AND pt.deleted_at IS NULL
 ORDER BY pt.name ASC;
 
+-- name: ListTeamsForResources :many
+SELECT prt.profile_resource_id, pt.* FROM "profile_team" pt
+JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
+WHERE prt.profile_resource_id = ANY(sqlc.arg(profile_resource_ids)::TEXT[]) AND pt.deleted_at IS NULL
+ORDER BY pt.name ASC;
+
 -- name: SetResourceTeams_Delete :execrows
 UPDATE "profile_resource_team"
 SET deleted_at = NOW()
@@ -21,7 +21,8 @@ SELECT EXISTS(
 -- name: GetCustomDomainByDomain :one
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 WHERE pcd.domain = sqlc.arg(domain)
 LIMIT 1;
@@ -29,20 +30,22 @@ LIMIT 1;
 -- name: ListCustomDomainsByProfileID :many
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 WHERE pcd.profile_id = sqlc.arg(profile_id)
 ORDER BY pcd.created_at;
 
 -- name: CreateCustomDomain :exec
-INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale)
-VALUES (sqlc.arg(id), sqlc.arg(profile_id), sqlc.arg(domain), sqlc.narg(default_locale));
+INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale, verification_token)
+VALUES (sqlc.arg(id), sqlc.arg(profile_id), sqlc.arg(domain), sqlc.narg(default_locale), sqlc.arg(verification_token));
 
 -- name: UpdateCustomDomain :execrows
 UPDATE "profile_custom_domain"
 SET
   domain = sqlc.arg(domain),
   default_locale = sqlc.narg(default_locale),
+  verification_token = COALESCE(sqlc.narg(verification_token), verification_token),
   updated_at = NOW()
 WHERE id = sqlc.arg(id);
 
@@ -53,14 +56,16 @@ WHERE id = sqlc.arg(id);
 -- name: ListAllCustomDomains :many
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 ORDER BY pcd.created_at;
 
 -- name: ListVerifiedCustomDomains :many
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 WHERE pcd.verification_status IN ('verified', 'expired')
 ORDER BY pcd.created_at;
@@ -145,12 +150,44 @@ WHERE (sqlc.narg(filter_kind)::TEXT IS NULL OR p.kind = ANY(string_to_array(sqlc
   AND (sqlc.narg(filter_q)::TEXT IS NULL
        OR normalize_text(pt.title) LIKE '%' || normalize_text(sqlc.narg(filter_q)::TEXT) || '%'
        OR normalize_text(pt.description) LIKE '%' || normalize_text(sqlc.narg(filter_q)::TEXT) || '%')
+  AND (sqlc.narg(filter_hiring)::BOOLEAN IS NULL
+       OR COALESCE(p.properties->'hiring'->'is_hiring' = 'true'::JSONB, FALSE) = sqlc.narg(filter_hiring)::BOOLEAN)
+  AND (sqlc.narg(filter_available)::BOOLEAN IS NULL
+       OR (p.properties->'availability'->>'status' IS NOT NULL
+           AND COALESCE((p.properties->'availability'->>'expires_at')::TIMESTAMPTZ > NOW(), TRUE))
+          = sqlc.narg(filter_available)::BOOLEAN)
   AND p.approved_at IS NOT NULL
   AND p.deleted_at IS NULL
 ORDER BY md5(p.id || sqlc.arg(seed))
 LIMIT sqlc.arg(page_limit)
 OFFSET sqlc.arg(page_offset);
 
+-- name: ListProfilesByPoints :many
+-- Highest points first, keyset-paginated on (points, profile id).
+SELECT sqlc.embed(p), sqlc.embed(pt)
+FROM "profile" p
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+  AND pt.locale_code = (
+    SELECT ptf.locale_code FROM "profile_tx" ptf
+    WHERE ptf.profile_id = p.id
+    ORDER BY CASE
+      WHEN ptf.locale_code = sqlc.arg(locale_code) THEN 0
+      WHEN ptf.locale_code = p.default_locale THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+WHERE (sqlc.narg(filter_kind)::TEXT IS NULL OR p.kind = sqlc.narg(filter_kind)::TEXT)
+  AND (
+    sqlc.narg(cursor_points)::INTEGER IS NULL
+    OR p.points < sqlc.narg(cursor_points)::INTEGER
+    OR (p.points = sqlc.narg(cursor_points)::INTEGER AND p.id > sqlc.narg(cursor_profile_id)::CHAR(26))
+  )
+  AND p.approved_at IS NOT NULL
+  AND p.deleted_at IS NULL
+ORDER BY p.points DESC, p.id ASC
+LIMIT sqlc.arg(limit_count);
+
 -- name: GetProfileFeatureRelationsVisibility :one
 SELECT feature_relations
 FROM "profile"
@@ -167,6 +204,20 @@ WHERE id = sqlc.arg(id)
 INSERT INTO "profile" (id, slug, kind, default_locale, profile_picture_uri, pronouns, properties, approved_at)
 VALUES (sqlc.arg(id), sqlc.arg(slug), sqlc.arg(kind), sqlc.arg(default_locale), sqlc.narg(profile_picture_uri), sqlc.narg(pronouns), sqlc.narg(properties), NOW());
 
+-- name: GetProfileDefaultLocale :one
+SELECT default_locale
+FROM "profile"
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: UpdateProfileDefaultLocale :execrows
+UPDATE "profile"
+SET
+  default_locale = sqlc.arg(default_locale),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
 -- name: CreateProfileTx :exec
 INSERT INTO "profile_tx" (profile_id, locale_code, title, description, properties)
 VALUES (sqlc.arg(profile_id), sqlc.arg(locale_code), sqlc.arg(title), sqlc.arg(description), sqlc.narg(properties));
@@ -201,6 +252,11 @@ SET
 WHERE profile_id = sqlc.arg(profile_id)
   AND locale_code = sqlc.arg(locale_code);
 
+-- name: DeleteProfileTx :execrows
+DELETE FROM "profile_tx"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND locale_code = sqlc.arg(locale_code);
+
 -- name: UpsertProfileTx :exec
 INSERT INTO "profile_tx" (profile_id, locale_code, title, description, properties)
 VALUES (sqlc.arg(profile_id), sqlc.arg(locale_code), sqlc.arg(title), sqlc.arg(description), sqlc.narg(properties))
@@ -262,6 +318,19 @@ SET deleted_at = NOW()
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: RestoreProfile :execrows
+UPDATE "profile"
+SET deleted_at = NULL
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NOT NULL;
+
+-- name: GetDeletedProfileIDBySlug :one
+SELECT id
+FROM "profile"
+WHERE slug = sqlc.arg(slug)
+  AND deleted_at IS NOT NULL
+LIMIT 1;
+
 -- name: ListProfileLinksForKind :many
 SELECT
   pl.*,
@@ -288,6 +357,19 @@ WHERE pl.kind = sqlc.arg(kind)
   AND pl.deleted_at IS NULL
 ORDER BY pl."order";
 
+-- name: ListProfileLinksForPostImport :many
+SELECT
+  pl.id,
+  pl.profile_id,
+  pl.remote_id,
+  pl.auth_access_token
+FROM "profile_link" pl
+  INNER JOIN "profile" p ON p.id = pl.profile_id
+    AND p.deleted_at IS NULL
+WHERE pl.kind = sqlc.arg(kind)
+  AND pl.deleted_at IS NULL
+ORDER BY pl.profile_id, pl."order";
+
 -- name: ListProfilePagesByProfileID :many
 SELECT pp.*, ppt.*,
   p_added.slug as added_by_slug,
@@ -411,6 +493,13 @@ SELECT EXISTS(
     AND slug = sqlc.arg(page_slug)
 ) AS exists;
 
+-- name: ListPageSlugUsagesByProfileID :many
+-- Returns the pages, deleted ones included, that use any of the given slugs.
+SELECT id, slug, deleted_at
+FROM "profile_page"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND slug = ANY(sqlc.arg(page_slugs)::TEXT[]);
+
 -- name: GetProfilePage :one
 SELECT *
 FROM "profile_page"
@@ -467,6 +556,25 @@ SET
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: ListDueDraftProfilePages :many
+SELECT id, profile_id, slug, published_at
+FROM "profile_page"
+WHERE visibility = 'draft'
+  AND published_at IS NOT NULL
+  AND published_at <= sqlc.arg(now)::TIMESTAMP WITH TIME ZONE
+  AND deleted_at IS NULL
+ORDER BY published_at, id
+LIMIT sqlc.arg(limit_count);
+
+-- name: PublishDraftProfilePage :execrows
+UPDATE "profile_page"
+SET
+  visibility = 'public',
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND visibility = 'draft'
+  AND deleted_at IS NULL;
+
 -- name: UpdateProfilePageTx :execrows
 UPDATE "profile_page_tx"
 SET
@@ -499,11 +607,28 @@ DELETE FROM "profile_page_tx"
 WHERE profile_page_id = sqlc.arg(profile_page_id)
   AND locale_code = sqlc.arg(locale_code);
 
+-- name: DeleteProfilePageTxsByProfileLocale :execrows
+DELETE FROM "profile_page_tx"
+WHERE locale_code = sqlc.arg(locale_code)
+  AND profile_page_id IN (
+    SELECT pp.id FROM "profile_page" pp
+    WHERE pp.profile_id = sqlc.arg(profile_id)
+  );
+
 -- name: ListProfilePageTxLocales :many
 SELECT locale_code FROM "profile_page_tx"
 WHERE profile_page_id = sqlc.arg(profile_page_id)
 ORDER BY locale_code;
 
+-- name: ListProfilePageTxsByProfileLocale :many
+SELECT ppt.profile_page_id, pp.slug, ppt.title, ppt.summary, ppt.content
+FROM "profile_page_tx" ppt
+  INNER JOIN "profile_page" pp ON pp.id = ppt.profile_page_id
+WHERE pp.profile_id = sqlc.arg(profile_id)
+  AND pp.deleted_at IS NULL
+  AND ppt.locale_code = sqlc.arg(locale_code)
+ORDER BY pp."order", pp.id;
+
 -- name: DeleteProfilePage :execrows
 UPDATE "profile_page"
 SET deleted_at = NOW()
@@ -566,6 +691,31 @@ FROM "profile_link" pl
 WHERE pl.id = sqlc.arg(id)
   AND pl.deleted_at IS NULL;
 
+-- name: ListProfileLinksByProfileIDForEditing :many
+SELECT
+  pl.*,
+  COALESCE(plt.locale_code, p.default_locale) as locale_code,
+  COALESCE(plt.title, pl.kind) as title,
+  COALESCE(plt.icon, '') as icon,
+  COALESCE(plt."group", '') as "group",
+  COALESCE(plt.description, '') as description
+FROM "profile_link" pl
+  INNER JOIN "profile" p ON p.id = pl.profile_id
+  LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
+    AND plt.locale_code = (
+      SELECT pltf.locale_code FROM "profile_link_tx" pltf
+      WHERE pltf.profile_link_id = pl.id
+      ORDER BY CASE
+        WHEN pltf.locale_code = sqlc.arg(locale_code) THEN 0
+        WHEN pltf.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+WHERE pl.profile_id = sqlc.arg(profile_id)
+  AND pl.deleted_at IS NULL
+ORDER BY pl."order";
+
 -- name: CreateProfileLink :one
 INSERT INTO "profile_link" (
   id,
@@ -737,6 +887,36 @@ WHERE (normalize_text(pt.title) LIKE '%' || normalize_text(sqlc.arg(query)) || '
 ORDER BY rank DESC
 LIMIT sqlc.arg(limit_count);
 
+-- name: SuggestProfiles :many
+SELECT
+  p.slug,
+  p.kind,
+  p.profile_picture_uri,
+  pt.title
+FROM "profile" p
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+    AND pt.locale_code = (
+      SELECT ptf.locale_code FROM "profile_tx" ptf
+      WHERE ptf.profile_id = p.id
+      ORDER BY CASE
+        WHEN ptf.locale_code = sqlc.arg(locale_code) THEN 0
+        WHEN ptf.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+WHERE p.approved_at IS NOT NULL
+  AND p.deleted_at IS NULL
+  AND (
+    p.slug LIKE sqlc.arg(prefix)::TEXT || '%'
+    OR p.id IN (
+      SELECT ptm.profile_id FROM "profile_tx" ptm
+      WHERE normalize_text(ptm.title) LIKE normalize_text(sqlc.arg(prefix)::TEXT) || '%'
+    )
+  )
+ORDER BY (p.slug LIKE sqlc.arg(prefix)::TEXT || '%') DESC, LENGTH(p.slug), p.slug
+LIMIT sqlc.arg(limit_count);
+
 -- name: SearchProfilePages :many
 SELECT
   pp.id,
@@ -817,6 +997,19 @@ SET
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: ListProfileLinkIDsByProfileID :many
+SELECT id FROM "profile_link"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND deleted_at IS NULL;
+
+-- name: UpdateProfileLinkOrder :execrows
+UPDATE "profile_link"
+SET
+  "order" = sqlc.arg(link_order),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
 -- name: GetMaxProfileLinkOrder :one
 SELECT COALESCE(MAX("order"), 0) as max_order
 FROM "profile_link"
@@ -925,6 +1118,13 @@ SET
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: UpdateProfileMembershipAggregateStories :execrows
+UPDATE "profile_membership"
+SET
+  aggregate_stories = sqlc.arg(aggregate_stories)
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
 -- name: DeleteProfileMembership :execrows
 UPDATE "profile_membership"
 SET
@@ -981,6 +1181,12 @@ WHERE u.deleted_at IS NULL
       AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
       AND pm.kind NOT IN ('follower', 'sponsor')
   )
+  -- Users who blocked the profile are not suggested to it.
+  AND NOT EXISTS (
+    SELECT 1 FROM "user_profile_block" upb
+    WHERE upb.user_id = u.id
+      AND upb.profile_id = sqlc.arg(profile_id)
+  )
 ORDER BY u.name ASC
 LIMIT 10;
 
@@ -1119,7 +1325,9 @@ FROM "profile_link" pl
     )
 WHERE pl.profile_id = sqlc.arg(profile_id)
   AND pl.deleted_at IS NULL
-ORDER BY pl."order";
+ORDER BY pl."order", pl.id
+LIMIT sqlc.narg(page_limit)
+OFFSET sqlc.arg(page_offset);
 
 -- name: GetProfileLinkTx :one
 SELECT *
@@ -1155,6 +1363,23 @@ SET
 WHERE profile_link_id = sqlc.arg(profile_link_id)
   AND locale_code = sqlc.arg(locale_code);
 
+-- name: ListProfileLinkTxsByProfileLocale :many
+SELECT plt.*
+FROM "profile_link_tx" plt
+  INNER JOIN "profile_link" pl ON pl.id = plt.profile_link_id
+WHERE pl.profile_id = sqlc.arg(profile_id)
+  AND pl.deleted_at IS NULL
+  AND plt.locale_code = sqlc.arg(locale_code)
+ORDER BY pl."order", pl.id;
+
+-- name: DeleteProfileLinkTxsByProfileLocale :execrows
+DELETE FROM "profile_link_tx"
+WHERE locale_code = sqlc.arg(locale_code)
+  AND profile_link_id IN (
+    SELECT pl.id FROM "profile_link" pl
+    WHERE pl.profile_id = sqlc.arg(profile_id)
+  );
+
 -- name: UpsertProfileLinkTx :exec
 INSERT INTO "profile_link_tx" (
   profile_link_id,
@@ -1190,7 +1415,21 @@ LEFT JOIN "profile_tx" pt_added ON pt_added.profile_id = p.id
   AND pt_added.locale_code = p.default_locale
 WHERE pr.profile_id = sqlc.arg(profile_id)
   AND pr.deleted_at IS NULL
-ORDER BY pr.created_at DESC;
+  AND (sqlc.narg(filter_kind)::TEXT IS NULL OR pr.kind = sqlc.narg(filter_kind)::TEXT)
+  AND (
+    sqlc.narg(filter_team_id)::TEXT IS NULL
+    OR EXISTS (
+      SELECT 1 FROM "profile_resource_team" prt
+      WHERE prt.profile_resource_id = pr.id
+        AND prt.profile_team_id = sqlc.narg(filter_team_id)::TEXT
+        AND prt.deleted_at IS NULL
+    )
+  )
+ORDER BY
+  CASE WHEN sqlc.arg(sort_by)::TEXT = 'manual' THEN pr.is_featured END DESC,
+  CASE WHEN sqlc.arg(sort_by)::TEXT = 'manual' THEN pr."order" END ASC,
+  CASE WHEN sqlc.arg(sort_by)::TEXT = 'alphabetical' THEN LOWER(pr.title) END ASC,
+  pr.created_at DESC;
 
 -- name: GetProfileResourceByID :one
 SELECT * FROM "profile_resource"
@@ -1207,12 +1446,19 @@ WHERE profile_id = sqlc.arg(profile_id)
 -- name: CreateProfileResource :one
 INSERT INTO "profile_resource" (
   id, profile_id, kind, is_managed, remote_id, public_id, url,
-  title, description, properties, added_by_profile_id, created_at
+  title, description, properties, added_by_profile_id, "order", created_at
 ) VALUES (
   sqlc.arg(id), sqlc.arg(profile_id), sqlc.arg(kind), sqlc.arg(is_managed),
   sqlc.arg(remote_id), sqlc.arg(public_id), sqlc.arg(url),
   sqlc.arg(title), sqlc.arg(description), sqlc.arg(properties),
-  sqlc.arg(added_by_profile_id), NOW()
+  sqlc.arg(added_by_profile_id),
+  (
+    SELECT COALESCE(MAX(existing."order"), 0) + 1
+    FROM "profile_resource" existing
+    WHERE existing.profile_id = sqlc.arg(profile_id)
+      AND existing.deleted_at IS NULL
+  ),
+  NOW()
 ) RETURNING *;
 
 -- name: SoftDeleteProfileResource :execrows
@@ -1221,6 +1467,32 @@ SET deleted_at = NOW()
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: GetDeletedProfileResourceByID :one
+SELECT * FROM "profile_resource"
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NOT NULL;
+
+-- name: RestoreProfileResource :execrows
+UPDATE "profile_resource"
+SET deleted_at = NULL,
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NOT NULL;
+
+-- name: ListProfileResourceIDsByProfileID :many
+SELECT id FROM "profile_resource"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND deleted_at IS NULL;
+
+-- name: UpdateProfileResourceOrder :execrows
+UPDATE "profile_resource"
+SET
+  "order" = sqlc.arg(resource_order),
+  is_featured = sqlc.arg(is_featured),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
 -- name: UpdateProfileResourceProperties :execrows
 UPDATE "profile_resource"
 SET
@@ -1252,6 +1524,24 @@ WHERE pr.kind = 'github_repo'
 ORDER BY pr.created_at ASC
 LIMIT sqlc.arg(batch_size);
 
+-- name: ListOrganizationGitHubLinksForSync :many
+SELECT
+  pl.id as link_id,
+  pl.profile_id,
+  pl.public_id as account_login,
+  pl.auth_access_token
+FROM "profile_link" pl
+  INNER JOIN "profile" p ON p.id = pl.profile_id
+    AND p.kind = 'organization'
+    AND p.deleted_at IS NULL
+WHERE pl.kind = 'github'
+  AND pl.is_managed = true
+  AND pl.public_id IS NOT NULL
+  AND pl.auth_access_token IS NOT NULL
+  AND pl.deleted_at IS NULL
+ORDER BY pl.created_at ASC
+LIMIT sqlc.arg(batch_size);
+
 -- name: GetManagedGitHubLinkByProfileID :one
 SELECT id, profile_id, auth_access_token, auth_access_token_scope
 FROM "profile_link"
@@ -1262,6 +1552,16 @@ WHERE profile_id = sqlc.arg(profile_id)
   AND deleted_at IS NULL
 LIMIT 1;
 
+-- name: ListManagedProfileLinkAccounts :many
+SELECT kind, remote_id
+FROM "profile_link"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND kind = ANY(sqlc.arg(kinds)::TEXT[])
+  AND is_managed = true
+  AND remote_id IS NOT NULL
+  AND deleted_at IS NULL
+ORDER BY "order", created_at;
+
 -- name: UpdateProfileMembershipProperties :execrows
 UPDATE "profile_membership"
 SET
@@ -1318,6 +1618,16 @@ SET
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
 
+-- name: UpdateProfileLinkSyncStatus :execrows
+-- Records the outcome of a sync attempt. sync_error is NULL after a success.
+UPDATE "profile_link"
+SET
+  sync_status = sqlc.arg(sync_status),
+  sync_error = sqlc.narg(sync_error),
+  sync_attempted_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
 -- name: ListOnlineProfileLinks :many
 SELECT
   pl.id,
@@ -1370,3 +1680,44 @@ WHERE is_online = TRUE
   AND kind = sqlc.arg(kind)
   AND deleted_at IS NULL
   AND updated_at < sqlc.arg(stale_threshold);
+
+-- name: GetProfileCounts :one
+-- Counts what an anonymous visitor can see on a profile.
+SELECT
+  (
+    SELECT COUNT(*) FROM "profile_membership" pm
+      INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+        AND mp.kind IN ('organization', 'individual')
+        AND mp.approved_at IS NOT NULL
+        AND mp.deleted_at IS NULL
+    WHERE pm.profile_id = sqlc.arg(profile_id)
+      AND pm.kind != 'follower'
+      AND pm.deleted_at IS NULL
+  ) AS member_count,
+  (
+    SELECT COUNT(*) FROM "profile_membership" pm
+      INNER JOIN "profile" op ON op.id = pm.profile_id
+        AND op.kind IN ('organization', 'product')
+        AND op.approved_at IS NOT NULL
+        AND op.deleted_at IS NULL
+    WHERE pm.member_profile_id = sqlc.arg(profile_id)
+      AND pm.kind != 'follower'
+      AND pm.deleted_at IS NULL
+  ) AS contribution_count,
+  (
+    SELECT COUNT(*) FROM "profile_resource" pr
+    WHERE pr.profile_id = sqlc.arg(profile_id)
+      AND pr.deleted_at IS NULL
+  ) AS resource_count,
+  (
+    SELECT COUNT(*) FROM "profile_link" pl
+    WHERE pl.profile_id = sqlc.arg(profile_id)
+      AND pl.visibility = 'public'
+      AND pl.deleted_at IS NULL
+  ) AS link_count,
+  (
+    SELECT COUNT(*) FROM "profile_page" pp
+    WHERE pp.profile_id = sqlc.arg(profile_id)
+      AND pp.visibility = 'public'
+      AND pp.deleted_at IS NULL
+  ) AS page_count;
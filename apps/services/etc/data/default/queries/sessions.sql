@@ -14,7 +14,9 @@ SELECT
   updated_at,
   oauth_provider,
   oauth_access_token,
-  oauth_token_scope
+  oauth_token_scope,
+  impersonator_user_id,
+  impersonator_session_id
 FROM
   session
 WHERE
@@ -37,7 +39,9 @@ INSERT INTO
     updated_at,
     oauth_provider,
     oauth_access_token,
-    oauth_token_scope
+    oauth_token_scope,
+    impersonator_user_id,
+    impersonator_session_id
   )
 VALUES
   (
@@ -55,7 +59,9 @@ VALUES
     sqlc.arg(updated_at),
     sqlc.arg(oauth_provider),
     sqlc.arg(oauth_access_token),
-    sqlc.arg(oauth_token_scope)
+    sqlc.arg(oauth_token_scope),
+    sqlc.narg(impersonator_user_id),
+    sqlc.narg(impersonator_session_id)
   );
 
 -- name: ListSessionsByUserID :many
@@ -74,7 +80,9 @@ SELECT
   updated_at,
   oauth_provider,
   oauth_access_token,
-  oauth_token_scope
+  oauth_token_scope,
+  impersonator_user_id,
+  impersonator_session_id
 FROM
   session
 WHERE
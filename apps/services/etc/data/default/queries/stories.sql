@@ -702,6 +702,196 @@ WHERE
   AND (sqlc.narg(filter_author_profile_id)::CHAR(26) IS NULL OR s.author_profile_id = sqlc.narg(filter_author_profile_id)::CHAR(26))
 ORDER BY (s.properties->>'activity_time_start') DESC NULLS LAST;
 
+-- name: ListFollowedStoriesFeed :many
+-- Lists public, published stories authored by or published to profiles the viewer follows.
+-- Newest first, keyset-paginated on (first publication time, story id).
+SELECT
+  sqlc.embed(s),
+  sqlc.embed(st),
+  sqlc.embed(p1),
+  sqlc.embed(p1t),
+  pb.publications,
+  fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+FROM "story" s
+  INNER JOIN LATERAL (
+    SELECT MIN(sp0.published_at) AS first_published_at
+    FROM story_publication sp0
+    WHERE sp0.story_id = s.id
+      AND sp0.published_at IS NOT NULL
+      AND sp0.deleted_at IS NULL
+  ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+  INNER JOIN "story_tx" st ON st.story_id = s.id
+  AND st.locale_code = (
+    SELECT stx.locale_code FROM "story_tx" stx
+    WHERE stx.story_id = s.id
+    ORDER BY CASE
+      WHEN stx.locale_code = sqlc.arg(locale_code) THEN 0
+      WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+  LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+  AND p1.approved_at IS NOT NULL
+  AND p1.deleted_at IS NULL
+  INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+  AND p1t.locale_code = (
+    SELECT ptx.locale_code FROM "profile_tx" ptx
+    WHERE ptx.profile_id = p1.id
+    ORDER BY CASE WHEN ptx.locale_code = sqlc.arg(locale_code) THEN 0 ELSE 1 END
+    LIMIT 1
+  )
+  LEFT JOIN LATERAL (
+    SELECT JSONB_AGG(
+      JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+    ) AS "publications"
+    FROM story_publication sp
+      INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+      AND p2.approved_at IS NOT NULL
+      AND p2.deleted_at IS NULL
+      INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+      AND p2t.locale_code = (
+        SELECT ptx2.locale_code FROM "profile_tx" ptx2
+        WHERE ptx2.profile_id = p2.id
+        ORDER BY CASE WHEN ptx2.locale_code = sqlc.arg(locale_code) THEN 0 ELSE 1 END
+        LIMIT 1
+      )
+    WHERE sp.story_id = s.id
+      AND sp.deleted_at IS NULL
+  ) pb ON TRUE
+WHERE
+  pb.publications IS NOT NULL
+  AND s.visibility = 'public'
+  AND s.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM "profile_membership" pm
+      INNER JOIN "user" u ON u.individual_profile_id = pm.member_profile_id
+    WHERE u.id = sqlc.arg(viewer_user_id)
+      AND pm.kind = 'follower'
+      AND pm.deleted_at IS NULL
+      AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+      AND (
+        pm.profile_id = s.author_profile_id
+        OR EXISTS (
+          SELECT 1 FROM story_publication sp5
+          WHERE sp5.story_id = s.id
+            AND sp5.profile_id = pm.profile_id
+            AND sp5.deleted_at IS NULL
+        )
+      )
+  )
+  AND (
+    sqlc.narg(cursor_published_at)::TIMESTAMP WITH TIME ZONE IS NULL
+    OR (fp.first_published_at, s.id) < (sqlc.narg(cursor_published_at)::TIMESTAMP WITH TIME ZONE, sqlc.narg(cursor_story_id)::CHAR(26))
+  )
+ORDER BY fp.first_published_at DESC, s.id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: ListStoryAggregationMemberProfileIDs :many
+-- Individual members of a profile who opted in to its aggregated story list.
+SELECT pm.member_profile_id::CHAR(26) AS member_profile_id
+FROM "profile_membership" pm
+  INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+  AND mp.kind = 'individual'
+  AND mp.approved_at IS NOT NULL
+  AND mp.deleted_at IS NULL
+WHERE pm.profile_id = sqlc.arg(profile_id)
+  AND pm.aggregate_stories = TRUE
+  AND pm.kind != 'follower'
+  AND pm.deleted_at IS NULL
+  AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+ORDER BY pm.member_profile_id;
+
+-- name: ListAggregatedStoriesForViewer :many
+-- Lists published stories authored by or published to any of the given profiles.
+-- Visibility follows ListStoriesOfPublicationForViewer: private stories only for
+-- authorized viewers, unlisted stories never.
+-- Newest first, keyset-paginated on (first publication time, story id).
+SELECT
+  sqlc.embed(s),
+  sqlc.embed(st),
+  sqlc.embed(p1),
+  sqlc.embed(p1t),
+  pb.publications,
+  fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+FROM "story" s
+  INNER JOIN LATERAL (
+    SELECT MIN(sp0.published_at) AS first_published_at
+    FROM story_publication sp0
+    WHERE sp0.story_id = s.id
+      AND sp0.published_at IS NOT NULL
+      AND sp0.deleted_at IS NULL
+  ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+  INNER JOIN "story_tx" st ON st.story_id = s.id
+  AND st.locale_code = (
+    SELECT stx.locale_code FROM "story_tx" stx
+    WHERE stx.story_id = s.id
+    ORDER BY CASE
+      WHEN stx.locale_code = sqlc.arg(locale_code) THEN 0
+      WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+  LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+  AND p1.approved_at IS NOT NULL
+  AND p1.deleted_at IS NULL
+  INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+  AND p1t.locale_code = (
+    SELECT ptx.locale_code FROM "profile_tx" ptx
+    WHERE ptx.profile_id = p1.id
+    ORDER BY CASE WHEN ptx.locale_code = sqlc.arg(locale_code) THEN 0 ELSE 1 END
+    LIMIT 1
+  )
+  LEFT JOIN LATERAL (
+    SELECT JSONB_AGG(
+      JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+    ) AS "publications"
+    FROM story_publication sp
+      INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+      AND p2.approved_at IS NOT NULL
+      AND p2.deleted_at IS NULL
+      INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+      AND p2t.locale_code = (
+        SELECT ptx2.locale_code FROM "profile_tx" ptx2
+        WHERE ptx2.profile_id = p2.id
+        ORDER BY CASE WHEN ptx2.locale_code = sqlc.arg(locale_code) THEN 0 ELSE 1 END
+        LIMIT 1
+      )
+    WHERE sp.story_id = s.id
+      AND sp.deleted_at IS NULL
+  ) pb ON TRUE
+  LEFT JOIN "user" u ON u.id = sqlc.narg(viewer_user_id)::CHAR(26)
+  LEFT JOIN "profile_membership" pm ON s.author_profile_id = pm.profile_id
+    AND pm.member_profile_id = u.individual_profile_id
+    AND pm.deleted_at IS NULL
+    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+WHERE
+  pb.publications IS NOT NULL
+  AND s.visibility != 'unlisted'
+  AND (
+    s.visibility = 'public'
+    OR u.kind = 'admin'
+    OR s.author_profile_id = u.individual_profile_id
+    OR pm.kind IN ('owner', 'lead', 'maintainer')
+  )
+  AND s.deleted_at IS NULL
+  AND (
+    s.author_profile_id = ANY(sqlc.arg(profile_ids)::TEXT[])
+    OR EXISTS (
+      SELECT 1 FROM story_publication sp5
+      WHERE sp5.story_id = s.id
+        AND sp5.profile_id = ANY(sqlc.arg(profile_ids)::TEXT[])
+        AND sp5.deleted_at IS NULL
+    )
+  )
+  AND (
+    sqlc.narg(cursor_published_at)::TIMESTAMP WITH TIME ZONE IS NULL
+    OR (fp.first_published_at, s.id) < (sqlc.narg(cursor_published_at)::TIMESTAMP WITH TIME ZONE, sqlc.narg(cursor_story_id)::CHAR(26))
+  )
+ORDER BY fp.first_published_at DESC, s.id DESC
+LIMIT sqlc.arg(limit_count);
+
 -- name: GetUserMembershipForProfile :one
 -- Returns the membership kind a user has for a specific profile.
 -- Used to verify a user has access to publish to a target profile.
@@ -79,3 +79,17 @@ FROM "story_interaction"
 WHERE story_id = sqlc.arg(story_id)
   AND deleted_at IS NULL
 GROUP BY kind;
+
+-- name: IncrementStoryViewCount :exec
+-- Increments the deduplicated view counter of a story.
+INSERT INTO "story_view_stat" (story_id, view_count, updated_at)
+VALUES (sqlc.arg(story_id), 1, NOW())
+ON CONFLICT (story_id)
+DO UPDATE SET view_count = "story_view_stat".view_count + 1, updated_at = NOW();
+
+-- name: GetStoryViewCount :one
+-- Returns the deduplicated view counter of a story (0 when never viewed).
+SELECT COALESCE(
+  (SELECT view_count FROM "story_view_stat" WHERE story_id = sqlc.arg(story_id)),
+  0
+)::BIGINT AS view_count;
@@ -101,3 +101,16 @@ UPDATE "user"
 SET deleted_at = NOW()
 WHERE id = sqlc.arg(id)
   AND deleted_at IS NULL;
+
+-- name: GetProfileKindForIndividualLink :one
+SELECT kind
+FROM "profile"
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: GetUserIDByIndividualProfileID :one
+SELECT id
+FROM "user"
+WHERE individual_profile_id = sqlc.arg(individual_profile_id)
+  AND deleted_at IS NULL
+LIMIT 1;
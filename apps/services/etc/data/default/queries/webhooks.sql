@@ -0,0 +1,89 @@
+-- name: CreateWebhookDelivery :exec
+INSERT INTO "webhook_delivery" (id, source, endpoint_id, event_type, target_url, payload, status, created_at)
+VALUES (
+  sqlc.arg(id),
+  sqlc.arg(source),
+  sqlc.narg(endpoint_id),
+  sqlc.arg(event_type),
+  sqlc.arg(target_url),
+  sqlc.arg(payload),
+  sqlc.arg(status),
+  sqlc.arg(created_at)
+);
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO "webhook_delivery_attempt" (id, delivery_id, status, response_code, error_message, duration_ms, attempted_at)
+VALUES (
+  sqlc.arg(id),
+  sqlc.arg(delivery_id),
+  sqlc.arg(status),
+  sqlc.narg(response_code),
+  sqlc.narg(error_message),
+  sqlc.arg(duration_ms),
+  sqlc.arg(attempted_at)
+);
+
+-- name: UpdateWebhookDeliveryAfterAttempt :execrows
+UPDATE "webhook_delivery"
+SET
+  status = sqlc.arg(status),
+  attempt_count = attempt_count + 1,
+  last_response_code = sqlc.narg(last_response_code),
+  last_attempted_at = sqlc.arg(last_attempted_at)
+WHERE id = sqlc.arg(id);
+
+-- name: GetWebhookDeliveryByID :one
+SELECT *
+FROM "webhook_delivery"
+WHERE id = sqlc.arg(id)
+LIMIT 1;
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT *
+FROM "webhook_delivery_attempt"
+WHERE delivery_id = sqlc.arg(delivery_id)
+ORDER BY attempted_at DESC, id DESC;
+
+-- name: ListWebhookDeliveries :many
+SELECT *
+FROM "webhook_delivery"
+WHERE (sqlc.narg(filter_source)::TEXT IS NULL OR source = sqlc.narg(filter_source)::TEXT)
+  AND (sqlc.narg(filter_status)::TEXT IS NULL OR status = sqlc.narg(filter_status)::TEXT)
+  AND (sqlc.narg(before_id)::TEXT IS NULL OR id < sqlc.narg(before_id)::TEXT)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
+
+-- name: DeleteWebhookDeliveriesCreatedBefore :execrows
+DELETE FROM "webhook_delivery"
+WHERE created_at < sqlc.arg(cutoff);
+
+-- name: CreateProfileWebhookEndpoint :exec
+INSERT INTO "profile_webhook_endpoint" (id, profile_id, target_url, secret, created_at)
+VALUES (
+  sqlc.arg(id),
+  sqlc.arg(profile_id),
+  sqlc.arg(target_url),
+  sqlc.arg(secret),
+  sqlc.arg(created_at)
+);
+
+-- name: ListProfileWebhookEndpoints :many
+SELECT id, profile_id, target_url, created_at
+FROM "profile_webhook_endpoint"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND deleted_at IS NULL
+ORDER BY created_at, id;
+
+-- name: GetProfileWebhookEndpointSecret :one
+SELECT secret
+FROM "profile_webhook_endpoint"
+WHERE id = sqlc.arg(id)
+  AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: DeleteProfileWebhookEndpoint :execrows
+UPDATE "profile_webhook_endpoint"
+SET deleted_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND profile_id = sqlc.arg(profile_id)
+  AND deleted_at IS NULL;
@@ -0,0 +1,41 @@
+-- name: UpsertWebmention :one
+-- Stores a received mention as pending, or puts an already known
+-- (source, target) pair back to pending so it is verified again.
+INSERT INTO "webmention" (id, profile_id, source_url, target_url, status, created_at)
+VALUES (
+  sqlc.arg(id),
+  sqlc.arg(profile_id),
+  sqlc.arg(source_url),
+  sqlc.arg(target_url),
+  'pending',
+  NOW()
+)
+ON CONFLICT (source_url, target_url) DO UPDATE
+SET
+  profile_id = EXCLUDED.profile_id,
+  status = 'pending',
+  updated_at = NOW()
+RETURNING *;
+
+-- name: GetWebmentionByID :one
+SELECT *
+FROM "webmention"
+WHERE id = sqlc.arg(id)
+LIMIT 1;
+
+-- name: UpdateWebmentionStatus :execrows
+UPDATE "webmention"
+SET
+  status = sqlc.arg(status),
+  verified_at = sqlc.narg(verified_at),
+  updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: ListVerifiedWebmentionsByProfileID :many
+SELECT *
+FROM "webmention"
+WHERE profile_id = sqlc.arg(profile_id)
+  AND status = 'verified'
+  AND (sqlc.narg(before_id)::TEXT IS NULL OR id < sqlc.narg(before_id)::TEXT)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit_count);
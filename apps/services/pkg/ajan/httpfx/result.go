@@ -12,6 +12,8 @@ type Result struct { //nolint:errname
 	InnerBody []byte
 
 	InnerStatusCode int
+
+	InnerWritten bool
 }
 
 func (r Result) StatusCode() int {
@@ -25,3 +27,8 @@ func (r Result) Body() []byte {
 func (r Result) RedirectToURI() string {
 	return r.InnerRedirectToURI
 }
+
+// IsWritten reports whether the handler has already written the response itself.
+func (r Result) IsWritten() bool {
+	return r.InnerWritten
+}
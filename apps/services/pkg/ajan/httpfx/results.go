@@ -50,19 +50,34 @@ func SetDiscloseErrors(enabled bool) {
 	discloseErrors = enabled
 }
 
+// WithErrorCode wraps an error message and a stable, machine-readable code in a
+// JSON response with {"error": "message", "code": "code"} format.
+func WithErrorCode(code string, message string) ResultOption {
+	return WithJSON(map[string]string{"error": message, "code": code})
+}
+
 // WithSanitizedError logs the full error server-side and returns a generic
 // error message to the client, preventing internal details from leaking.
 // When discloseErrors is enabled, the real error message is returned instead.
 func WithSanitizedError(err error) ResultOption {
+	return WithErrorMessage(sanitizeError(err))
+}
+
+// WithSanitizedErrorCode is WithSanitizedError with a machine-readable code.
+func WithSanitizedErrorCode(code string, err error) ResultOption {
+	return WithErrorCode(code, sanitizeError(err))
+}
+
+func sanitizeError(err error) string {
 	slog.Error("request error",
 		slog.String("scope_name", "httpfx_results"),
 		slog.Any("error", err))
 
 	if discloseErrors {
-		return WithErrorMessage(err.Error())
+		return err.Error()
 	}
 
-	return WithErrorMessage("an error occurred")
+	return "an error occurred"
 }
 
 func WithJSON(body any) ResultOption {
@@ -89,6 +104,7 @@ func (r *Results) Ok(options ...ResultOption) Result {
 		InnerStatusCode:    http.StatusNoContent,
 		InnerRedirectToURI: "",
 		InnerBody:          make([]byte, 0),
+		InnerWritten:       false,
 	}
 
 	for _, option := range options {
@@ -105,6 +121,7 @@ func (r *Results) Accepted(options ...ResultOption) Result {
 		InnerStatusCode:    http.StatusAccepted,
 		InnerRedirectToURI: "",
 		InnerBody:          make([]byte, 0),
+		InnerWritten:       false,
 	}
 
 	for _, option := range options {
@@ -121,6 +138,7 @@ func (r *Results) NotFound(options ...ResultOption) Result {
 		InnerStatusCode:    http.StatusNotFound,
 		InnerRedirectToURI: "",
 		InnerBody:          []byte("Not Found"),
+		InnerWritten:       false,
 	}
 
 	for _, option := range options {
@@ -137,6 +155,7 @@ func (r *Results) Unauthorized(options ...ResultOption) Result {
 		InnerStatusCode:    http.StatusUnauthorized,
 		InnerRedirectToURI: "",
 		InnerBody:          make([]byte, 0),
+		InnerWritten:       false,
 	}
 
 	for _, option := range options {
@@ -153,6 +172,7 @@ func (r *Results) BadRequest(options ...ResultOption) Result {
 		InnerStatusCode:    http.StatusBadRequest,
 		InnerRedirectToURI: "",
 		InnerBody:          []byte("Bad Request"),
+		InnerWritten:       false,
 	}
 
 	for _, option := range options {
@@ -169,6 +189,7 @@ func (r *Results) Error(statusCode int, options ...ResultOption) Result {
 		InnerStatusCode:    statusCode,
 		InnerRedirectToURI: "",
 		InnerBody:          make([]byte, 0),
+		InnerWritten:       false,
 	}
 
 	for _, option := range options {
@@ -186,6 +207,7 @@ func (r *Results) Bytes(body []byte) Result {
 		InnerStatusCode:    http.StatusOK,
 		InnerRedirectToURI: "",
 		InnerBody:          body,
+		InnerWritten:       false,
 	}
 }
 
@@ -196,6 +218,7 @@ func (r *Results) PlainText(body []byte) Result {
 		InnerStatusCode:    http.StatusOK,
 		InnerRedirectToURI: "",
 		InnerBody:          body,
+		InnerWritten:       false,
 	}
 }
 
@@ -222,6 +245,7 @@ func (r *Results) JSON(body any) Result {
 		InnerStatusCode:    http.StatusOK,
 		InnerRedirectToURI: "",
 		InnerBody:          encoded,
+		InnerWritten:       false,
 	}
 }
 
@@ -232,6 +256,20 @@ func (r *Results) Redirect(uri string) Result {
 		InnerStatusCode:    http.StatusTemporaryRedirect,
 		InnerRedirectToURI: uri,
 		InnerBody:          make([]byte, 0),
+		InnerWritten:       false,
+	}
+}
+
+// Written is returned by handlers that have already written the response
+// themselves (e.g. server-sent events), so the router doesn't write it again.
+func (r *Results) Written() Result {
+	return Result{
+		Result: okResult.New(),
+
+		InnerStatusCode:    http.StatusOK,
+		InnerRedirectToURI: "",
+		InnerBody:          make([]byte, 0),
+		InnerWritten:       true,
 	}
 }
 
@@ -245,5 +283,6 @@ func (r *Results) Abort() Result {
 		InnerStatusCode:    http.StatusNotImplemented,
 		InnerRedirectToURI: "",
 		InnerBody:          []byte("Not Implemented"),
+		InnerWritten:       false,
 	}
 }
@@ -149,3 +149,16 @@ func TestResult_WithBody(t *testing.T) {
 
 	assert.Equal(t, newBody, result.Body())
 }
+
+func TestResults_ErrorWithErrorCode(t *testing.T) {
+	t.Parallel()
+
+	results := &httpfx.Results{}
+	result := results.Error(
+		http.StatusNotFound,
+		httpfx.WithErrorCode("profile_not_found", "profile not found"),
+	)
+
+	assert.Equal(t, http.StatusNotFound, result.StatusCode())
+	assert.JSONEq(t, `{"error":"profile not found","code":"profile_not_found"}`, string(result.Body()))
+}
@@ -180,6 +180,11 @@ func (r *Router) Route(pattern string, handlers ...Handler) *Route { //nolint:fu
 
 		result := allHandlers[0](ctx)
 
+		// The handler has already written the response (e.g. a stream)
+		if result.IsWritten() {
+			return
+		}
+
 		// Handle redirect responses
 		if result.RedirectToURI() != "" {
 			responseWriter.Header().Set(
@@ -176,3 +176,28 @@ func TestRouter_RouteWithMiddleware(t *testing.T) {
 	assert.Equal(t, "test", w.Body.String())
 	assert.Equal(t, "middleware", w.Header().Get("X-Test"))
 }
+
+func TestRouter_RouteWithWrittenResult(t *testing.T) {
+	t.Parallel()
+
+	router := httpfx.NewRouter("/api")
+	require.NotNil(t, router)
+
+	handler := func(ctx *httpfx.Context) httpfx.Result {
+		ctx.ResponseWriter.WriteHeader(http.StatusAccepted)
+		_, _ = ctx.ResponseWriter.Write([]byte("streamed"))
+
+		return ctx.Results.Written()
+	}
+
+	route := router.Route("GET /test", handler)
+	require.NotNil(t, route)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	w := httptest.NewRecorder() //nolint:varnamelen
+
+	route.MuxHandlerFunc(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Equal(t, "streamed", w.Body.String())
+}
@@ -0,0 +1,115 @@
+package i18nfx
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const hoursPerHalfDay = 12
+
+// arabicIndicDigits replaces ASCII digits with the Arabic-Indic digits used by
+// the "ar" locale.
+var arabicIndicDigits = strings.NewReplacer( //nolint:gochecknoglobals
+	"0", "٠", "1", "١", "2", "٢", "3", "٣", "4", "٤",
+	"5", "٥", "6", "٦", "7", "٧", "8", "٨", "9", "٩",
+)
+
+// shortMonths holds the abbreviated month names of locales that spell out months.
+var shortMonths = map[string][12]string{ //nolint:gochecknoglobals
+	"en": {"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+	"tr": {"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+	"fr": {
+		"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	"es": {"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+	"it": {"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+	"nl": {"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+	"ru": {
+		"янв.", "февр.", "мар.", "апр.", "мая", "июн.",
+		"июл.", "авг.", "сент.", "окт.", "нояб.", "дек.",
+	},
+}
+
+// dateTimeFormatters render a medium date with a short time, following each
+// locale's CLDR conventions.
+var dateTimeFormatters = map[string]func(t time.Time) string{ //nolint:gochecknoglobals
+	"en": func(t time.Time) string {
+		hour, period := twelveHour(t, "AM", "PM")
+
+		return fmt.Sprintf("%s %d, %d, %d:%02d %s",
+			shortMonth("en", t), t.Day(), t.Year(), hour, t.Minute(), period)
+	},
+	"tr": spelledDate("tr", "%d %s %d %s"),
+	"fr": spelledDate("fr", "%d %s %d, %s"),
+	"es": spelledDate("es", "%d %s %d, %s"),
+	"it": spelledDate("it", "%d %s %d, %s"),
+	"nl": spelledDate("nl", "%d %s %d, %s"),
+	"ru": spelledDate("ru", "%d %s %d г., %s"),
+	"de": func(t time.Time) string {
+		return fmt.Sprintf("%02d.%02d.%d, %s", t.Day(), int(t.Month()), t.Year(), t.Format("15:04"))
+	},
+	"pt-PT": func(t time.Time) string {
+		return fmt.Sprintf("%02d/%02d/%d, %s", t.Day(), int(t.Month()), t.Year(), t.Format("15:04"))
+	},
+	"ja": func(t time.Time) string {
+		return fmt.Sprintf("%d/%02d/%02d %s", t.Year(), int(t.Month()), t.Day(), t.Format("15:04"))
+	},
+	"zh-CN": func(t time.Time) string {
+		return fmt.Sprintf("%d年%d月%d日 %s", t.Year(), int(t.Month()), t.Day(), t.Format("15:04"))
+	},
+	"ko": func(t time.Time) string {
+		hour, period := twelveHour(t, "오전", "오후")
+
+		return fmt.Sprintf("%d. %d. %d. %s %d:%02d",
+			t.Year(), int(t.Month()), t.Day(), period, hour, t.Minute())
+	},
+	"ar": func(t time.Time) string {
+		hour, period := twelveHour(t, "ص", "م")
+
+		// Right-to-left marks keep the date parts in order inside RTL text.
+		formatted := fmt.Sprintf("%d‏/%d‏/%d، %d:%02d %s",
+			t.Day(), int(t.Month()), t.Year(), hour, t.Minute(), period)
+
+		return arabicIndicDigits.Replace(formatted)
+	},
+}
+
+// FormatDateTime renders t in UTC as a display string for the locale, e.g.
+// "Oct 17, 2026, 3:04 PM" for "en" or "17 Eki 2026 15:04" for "tr".
+// Unknown locales fall back to "en".
+func FormatDateTime(t time.Time, locale string) string {
+	formatter, ok := dateTimeFormatters[locale]
+	if !ok {
+		formatter = dateTimeFormatters["en"]
+	}
+
+	return formatter(t.UTC())
+}
+
+// spelledDate formats "<day> <month> <year> <time>" with an abbreviated month
+// name and a 24-hour time, laid out by format.
+func spelledDate(locale string, format string) func(t time.Time) string {
+	return func(t time.Time) string {
+		return fmt.Sprintf(format, t.Day(), shortMonth(locale, t), t.Year(), t.Format("15:04"))
+	}
+}
+
+func shortMonth(locale string, t time.Time) string {
+	return shortMonths[locale][t.Month()-1]
+}
+
+// twelveHour returns the hour on a 12-hour clock and its period label.
+func twelveHour(t time.Time, am string, pm string) (int, string) {
+	hour := t.Hour() % hoursPerHalfDay
+	if hour == 0 {
+		hour = hoursPerHalfDay
+	}
+
+	if t.Hour() < hoursPerHalfDay {
+		return hour, am
+	}
+
+	return hour, pm
+}
@@ -0,0 +1,48 @@
+package i18nfx_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/i18nfx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDateTime(t *testing.T) {
+	t.Parallel()
+
+	afternoon := time.Date(2026, time.October, 7, 15, 4, 0, 0, time.UTC)
+	midnight := time.Date(2026, time.January, 2, 0, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		locale string
+		time   time.Time
+		want   string
+	}{
+		{name: "English", locale: "en", time: afternoon, want: "Oct 7, 2026, 3:04 PM"},
+		{name: "English midnight", locale: "en", time: midnight, want: "Jan 2, 2026, 12:30 AM"},
+		{name: "Turkish", locale: "tr", time: afternoon, want: "7 Eki 2026 15:04"},
+		{name: "German", locale: "de", time: afternoon, want: "07.10.2026, 15:04"},
+		{name: "Japanese", locale: "ja", time: afternoon, want: "2026/10/07 15:04"},
+		{name: "Korean", locale: "ko", time: afternoon, want: "2026. 10. 7. 오후 3:04"},
+		{name: "Chinese", locale: "zh-CN", time: afternoon, want: "2026年10月7日 15:04"},
+		{name: "Arabic", locale: "ar", time: afternoon, want: "٧‏/١٠‏/٢٠٢٦، ٣:٠٤ م"},
+		{name: "Arabic morning", locale: "ar", time: midnight, want: "٢‏/١‏/٢٠٢٦، ١٢:٣٠ ص"},
+		{name: "Unknown locale", locale: "xx", time: afternoon, want: "Oct 7, 2026, 3:04 PM"},
+		{
+			name:   "Converted to UTC",
+			locale: "tr",
+			time:   afternoon.In(time.FixedZone("UTC+3", 3*60*60)),
+			want:   "7 Eki 2026 15:04",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, i18nfx.FormatDateTime(tt.time, tt.locale))
+		})
+	}
+}
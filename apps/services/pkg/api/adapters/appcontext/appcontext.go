@@ -19,25 +19,32 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/adapters/arcade"
 	"github.com/eser/aya.is/services/pkg/api/adapters/auth_tokens"
 	"github.com/eser/aya.is/services/pkg/api/adapters/coolify"
+	"github.com/eser/aya.is/services/pkg/api/adapters/dnsresolver"
 	"github.com/eser/aya.is/services/pkg/api/adapters/externalsite"
 	"github.com/eser/aya.is/services/pkg/api/adapters/github"
 	"github.com/eser/aya.is/services/pkg/api/adapters/linkedin"
+	"github.com/eser/aya.is/services/pkg/api/adapters/nginx"
 	profilesadapter "github.com/eser/aya.is/services/pkg/api/adapters/profiles"
+	"github.com/eser/aya.is/services/pkg/api/adapters/providerbudget"
 	"github.com/eser/aya.is/services/pkg/api/adapters/resend"
 	"github.com/eser/aya.is/services/pkg/api/adapters/s3client"
 	"github.com/eser/aya.is/services/pkg/api/adapters/speakerdeck"
 	"github.com/eser/aya.is/services/pkg/api/adapters/storage"
 	telegramadapter "github.com/eser/aya.is/services/pkg/api/adapters/telegram"
 	"github.com/eser/aya.is/services/pkg/api/adapters/unsplash"
+	webhookadapter "github.com/eser/aya.is/services/pkg/api/adapters/webhooks"
+	webmentionadapter "github.com/eser/aya.is/services/pkg/api/adapters/webmentions"
 	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
 	xadapter "github.com/eser/aya.is/services/pkg/api/adapters/x"
 	"github.com/eser/aya.is/services/pkg/api/adapters/youtube"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	bulletinbiz "github.com/eser/aya.is/services/pkg/api/business/bulletin"
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
 	"github.com/eser/aya.is/services/pkg/api/business/discussions"
 	"github.com/eser/aya.is/services/pkg/api/business/events"
 	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
@@ -53,6 +60,8 @@ import (
 	telegrambiz "github.com/eser/aya.is/services/pkg/api/business/telegram"
 	"github.com/eser/aya.is/services/pkg/api/business/uploads"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
 	_ "github.com/lib/pq"
 	"github.com/pressly/goose/v3"
 )
@@ -67,7 +76,8 @@ type AppContext struct {
 	Config *AppConfig
 	Logger *logfx.Logger
 
-	HTTPClient *httpclient.Client
+	HTTPClient      *httpclient.Client
+	ProviderBudgets *providerbudget.Budgets
 
 	Connections *connfx.Registry
 	AIModels    *aifx.Registry
@@ -102,6 +112,7 @@ type AppContext struct {
 	ProfileService             *profiles.Service
 	ProfilePointsService       *profile_points.Service
 	ProfileQuestionsService    *profile_questions.Service
+	ProfileMentionService      *profile_mentions.Service
 	DiscussionsService         *discussions.Service
 	MailboxService             *mailbox.Service
 	StoryService               *stories.Service
@@ -120,9 +131,13 @@ type AppContext struct {
 	WorkerRegistry             *workerfx.Registry
 	BulletinService            *bulletinbiz.Service
 	StorySummarizer            *aiadapter.StorySummarizer
+	WebhookService             *webhooks.Service
+	ConsistencyService         *consistency.Service
+	WebmentionService          *webmentions.Service
 
 	// Infrastructure
 	WebserverSyncer profiles.WebserverSyncer
+	DNSResolver     profiles.DNSResolver
 }
 
 func New() *AppContext {
@@ -142,9 +157,10 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 		return fmt.Errorf("%w: %w", ErrInitFailed, err)
 	}
 
-	// Validate required secrets
-	if a.Config.Auth.JwtSecret == "" {
-		return fmt.Errorf("%w: %w", ErrInitFailed, ErrJWTSecretMissing)
+	// Validate the configuration, including required secrets
+	err = a.Config.Validate()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInitFailed, err)
 	}
 
 	// ----------------------------------------------------
@@ -171,6 +187,11 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 		httpclient.WithConfig(&a.Config.HTTPClient),
 	)
 
+	// Calls to provider APIs, from workers and on-demand fetches alike, share
+	// one request budget per provider. Retried attempts count as well.
+	a.ProviderBudgets = providerbudget.New(&a.Config.ProviderBudgets)
+	providerbudget.Install(a.HTTPClient, a.ProviderBudgets)
+
 	// ----------------------------------------------------
 	// Adapter: Connections
 	// ----------------------------------------------------
@@ -228,6 +249,8 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 		return fmt.Errorf("%w: %w", ErrInitFailed, err)
 	}
 
+	a.Repository.SetCacheConfig(a.Config.Data.Cache)
+
 	// Run database migrations
 	migrationsDir := a.Config.Data.MigrationsPath
 
@@ -314,10 +337,21 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 	a.StoryService = stories.NewService(a.Logger, &a.Config.Stories, a.Repository, a.AuditService)
 	a.StoryInteractionService = story_interactions.NewService(
 		a.Logger,
+		&a.Config.StoryInteractions,
 		a.Repository,
 		story_interactions.DefaultIDGenerator,
 		a.AuditService,
 	)
+	a.ProfileMentionService = profile_mentions.NewService(
+		a.Logger,
+		&a.Config.ProfileMentions,
+		a.Repository,
+		a.AuditService,
+		profile_mentions.DefaultIDGenerator,
+	)
+	a.ProfileService.SetMentionSyncer(a.ProfileMentionService)
+	a.ProfileService.SetQueueService(a.QueueService)
+	a.StoryService.SetMentionSyncer(a.ProfileMentionService)
 	a.StoryDateProposalService = story_date_proposals.NewService(
 		a.Logger,
 		a.Repository,
@@ -334,13 +368,19 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 	)
 	a.ProfilePointsService = profile_points.NewService(
 		a.Logger,
+		&a.Config.ProfilePoints,
 		a.Repository,
 		profile_points.DefaultIDGenerator,
 		a.AuditService,
 	)
 
+	// Credit configured points awards for audited actions through the queue
+	a.ProfilePointsService.SetQueueService(a.QueueService)
+	a.AuditService.AddOnRecorded(a.ProfilePointsService.HandleAuditRecorded)
+
 	a.ProfileQuestionsService = profile_questions.NewService(
 		a.Logger,
+		&a.Config.ProfileQuestions,
 		a.Repository,
 		a.ProfileService,
 		a.AuditService,
@@ -364,7 +404,7 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 
 	// UploadService (only if S3 client is available)
 	if a.S3Client != nil {
-		a.UploadService = uploads.NewService(a.Logger, a.S3Client)
+		a.UploadService = uploads.NewService(a.Logger, &a.Config.Uploads, a.S3Client, nil)
 	}
 
 	a.AuthService = auth.NewService(
@@ -398,6 +438,7 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 	a.ProfileLinkSyncService = linksync.NewService(
 		a.Logger,
 		a.Repository,
+		a.QueueService,
 		idGen,
 	)
 
@@ -410,8 +451,28 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 	a.ProfileResourceSyncService = resourcesync.NewService(
 		a.Logger,
 		storage.NewResourceSyncRepository(a.Repository),
+		resourcesync.DefaultIDGenerator,
 	)
 
+	a.WebhookService = webhooks.NewService(
+		a.Logger,
+		&a.Config.Webhooks,
+		a.Repository,
+		webhookadapter.NewSender(&a.Config.Webhooks),
+		webhooks.DefaultIDGenerator,
+	)
+
+	// Send membership events to the endpoints profiles registered for them
+	a.WebhookService.RegisterSecretResolver(
+		webhooks.SourceMembership,
+		a.WebhookService.ResolveEndpointSecrets,
+	)
+	a.WebhookService.SetQueueService(a.QueueService)
+	a.AuditService.AddOnRecorded(a.WebhookService.HandleAuditRecorded)
+
+	webhookMembershipHandler := workers.NewWebhookMembershipHandler(a.Logger, a.WebhookService)
+	webhookMembershipHandler.RegisterHandlers(a.QueueRegistry)
+
 	// Register points event handler
 	pointsEventHandler := workers.NewPointsEventHandler(
 		a.Logger,
@@ -419,6 +480,37 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 	)
 	pointsEventHandler.RegisterHandlers(a.QueueRegistry)
 
+	a.ConsistencyService = consistency.NewService(
+		a.Logger,
+		a.Repository,
+		a.QueueService,
+		a.AuditService,
+		consistency.DefaultIDGenerator,
+	)
+
+	// Register consistency check handler
+	consistencyCheckHandler := workers.NewConsistencyCheckHandler(
+		a.Logger,
+		a.ConsistencyService,
+	)
+	consistencyCheckHandler.RegisterHandlers(a.QueueRegistry)
+
+	a.WebmentionService = webmentions.NewService(
+		a.Logger,
+		a.Repository,
+		a.ProfileService,
+		webmentionadapter.NewFetcher(&a.Config.Webmentions),
+		a.QueueService,
+		webmentions.DefaultIDGenerator,
+	)
+
+	// Register webmention verification handler
+	webmentionVerifyHandler := workers.NewWebmentionVerifyHandler(
+		a.Logger,
+		a.WebmentionService,
+	)
+	webmentionVerifyHandler.RegisterHandlers(a.QueueRegistry)
+
 	a.RuntimeStateService = runtime_states.NewService(a.Logger, a.Repository)
 	a.WorkerRegistry = workerfx.NewRegistry()
 
@@ -582,6 +674,20 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 		return fmt.Errorf("%w: initializing localizer: %w", ErrInitFailed, err)
 	}
 
+	// Mention notifications — delivered as mailbox messages.
+	if a.Config.ProfileMentions.NotifyMentioned {
+		a.ProfileMentionService.SetOnMentioned(
+			profilesadapter.NewMentionNotifier(a.MailboxService, a.Localizer, a.Logger),
+		)
+	}
+
+	// Answer notifications — delivered to the asker as mailbox messages.
+	if a.Config.ProfileQuestions.NotifyAnswered {
+		a.ProfileQuestionsService.SetOnAnswered(
+			profilesadapter.NewQuestionAnswerNotifier(a.MailboxService, a.Logger),
+		)
+	}
+
 	// ----------------------------------------------------
 	// Bulletin Service (optional — requires at least one channel)
 	// ----------------------------------------------------
@@ -662,9 +768,20 @@ func (a *AppContext) Init(ctx context.Context) error { //nolint:funlen,gocognit,
 	}
 
 	// ----------------------------------------------------
-	// Infrastructure: Webserver Syncer (Coolify)
+	// Infrastructure: Webserver Syncer (Coolify or nginx)
+	// ----------------------------------------------------
+	switch a.Config.WebserverSyncer {
+	case WebserverSyncerNginx:
+		a.WebserverSyncer = nginx.New(&a.Config.Nginx, a.Logger)
+	default:
+		a.WebserverSyncer = coolify.NewClient(&a.Config.Coolify, a.Logger)
+	}
+
+	// ----------------------------------------------------
+	// Infrastructure: DNS Resolver (custom domain verification)
 	// ----------------------------------------------------
-	a.WebserverSyncer = coolify.NewClient(&a.Config.Coolify, a.Logger)
+	a.DNSResolver = dnsresolver.New(&a.Config.DNSResolver)
+	a.ProfileService.SetDNSResolver(a.DNSResolver)
 
 	// ----------------------------------------------------
 	// Auth Providers (adapters)
@@ -4,26 +4,44 @@ import (
 	"github.com/eser/aya.is/services/pkg/ajan"
 	"github.com/eser/aya.is/services/pkg/api/adapters/arcade"
 	"github.com/eser/aya.is/services/pkg/api/adapters/coolify"
+	"github.com/eser/aya.is/services/pkg/api/adapters/dnsresolver"
+	"github.com/eser/aya.is/services/pkg/api/adapters/nginx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/providerbudget"
 	"github.com/eser/aya.is/services/pkg/api/adapters/resend"
 	"github.com/eser/aya.is/services/pkg/api/adapters/s3client"
+	"github.com/eser/aya.is/services/pkg/api/adapters/storage"
 	telegramadapter "github.com/eser/aya.is/services/pkg/api/adapters/telegram"
 	"github.com/eser/aya.is/services/pkg/api/adapters/unsplash"
 	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	bulletinbiz "github.com/eser/aya.is/services/pkg/api/business/bulletin"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/api/business/protection"
 	"github.com/eser/aya.is/services/pkg/api/business/sessions"
 	"github.com/eser/aya.is/services/pkg/api/business/stories"
+	"github.com/eser/aya.is/services/pkg/api/business/story_interactions"
+	"github.com/eser/aya.is/services/pkg/api/business/uploads"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
 )
 
 type DataConfig struct {
 	MigrationsPath string `conf:"migration_path" default:"etc/data/default/migrations"`
 	SeedFilePath   string `conf:"seed_file_path" default:"etc/data/default/seed/seed.sql"`
+
+	Cache storage.CacheConfig `conf:"cache"`
 }
 
 type FeatureFlags struct {
 	DiscloseErrors bool `conf:"disclose_errors" default:"false"` // show real error messages in HTTP responses
+
+	// Route modules; disabled modules don't register their HTTP endpoints.
+	AI        bool `conf:"ai"        default:"true"`
+	Contact   bool `conf:"contact"   default:"true"`
+	Analytics bool `conf:"analytics" default:"true"`
 }
 
 type ExternalsConfig struct {
@@ -32,23 +50,41 @@ type ExternalsConfig struct {
 	Resend   resend.Config   `conf:"resend"`
 }
 
+// Webserver syncers that keep the web server's domain list in step with the
+// verified custom domains.
+const (
+	WebserverSyncerCoolify = "coolify"
+	WebserverSyncerNginx   = "nginx"
+)
+
 type AppConfig struct {
 	ajan.BaseConfig
 
 	Auth      auth.Config        `conf:"auth"`
 	Externals ExternalsConfig    `conf:"externals"`
 	S3        s3client.Config    `conf:"s3"`
+	Uploads   uploads.Config     `conf:"uploads"`
 	Profiles  profiles.Config    `conf:"profiles"`
 	Data      DataConfig         `conf:"data"`
 	Bulletin  bulletinbiz.Config `conf:"bulletin"`
 	Stories   stories.Config     `conf:"stories"`
 	SiteURI   string             `conf:"site_uri"  default:"http://localhost:8080"`
 
-	Telegram   telegramadapter.Config `conf:"telegram"`
-	Coolify    coolify.Config         `conf:"coolify"`
-	Workers    workers.Config         `conf:"workers"`
-	Protection protection.Config      `conf:"protection"`
-	Sessions   sessions.Config        `conf:"sessions"`
+	Telegram          telegramadapter.Config    `conf:"telegram"`
+	WebserverSyncer   string                    `conf:"webserver_syncer"   default:"coolify"`
+	Coolify           coolify.Config            `conf:"coolify"`
+	Nginx             nginx.Config              `conf:"nginx"`
+	DNSResolver       dnsresolver.Config        `conf:"dns_resolver"`
+	Workers           workers.Config            `conf:"workers"`
+	Protection        protection.Config         `conf:"protection"`
+	Sessions          sessions.Config           `conf:"sessions"`
+	StoryInteractions story_interactions.Config `conf:"story_interactions"`
+	ProfileMentions   profile_mentions.Config   `conf:"profile_mentions"`
+	ProfileQuestions  profile_questions.Config  `conf:"profile_questions"`
+	ProfilePoints     profile_points.Config     `conf:"profile_points"`
+	Webhooks          webhooks.Config           `conf:"webhooks"`
+	Webmentions       webmentions.Config        `conf:"webmentions"`
+	ProviderBudgets   providerbudget.Config     `conf:"provider_budgets"`
 
 	Features FeatureFlags `conf:"features"`
 }
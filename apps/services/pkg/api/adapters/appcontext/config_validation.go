@@ -0,0 +1,202 @@
+package appcontext
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// forbiddenSlugRegex matches the slugs profiles can have; reserved slugs must match too.
+var forbiddenSlugRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// Validate checks the loaded configuration and reports every problem at once,
+// so misconfiguration fails the deployment at startup instead of at first use.
+func (c *AppConfig) Validate() error {
+	var problems []error
+
+	addProblem := func(format string, args ...any) {
+		problems = append(problems, fmt.Errorf(format, args...))
+	}
+
+	// Required secrets
+	if c.Auth.JwtSecret == "" {
+		problems = append(problems, ErrJWTSecretMissing)
+	}
+
+	// URLs
+	if !isHTTPURL(c.SiteURI) {
+		addProblem("site_uri must be an http(s) URL, got %q", c.SiteURI)
+	}
+
+	for _, origin := range c.Auth.GetCorsPublicOrigins() {
+		if origin != "*" && !isHTTPURL(origin) {
+			addProblem("auth.cors_public_origins contains an invalid origin: %q", origin)
+		}
+	}
+
+	for _, prefix := range c.Profiles.GetAllowedURIPrefixes() {
+		if !isHTTPURL(prefix) {
+			addProblem("profiles.allowed_uri_prefixes contains an invalid URL: %q", prefix)
+		}
+	}
+
+	for _, slug := range slices.Sorted(maps.Keys(c.Profiles.GetForbiddenSlugs())) {
+		if !forbiddenSlugRegex.MatchString(slug) {
+			addProblem("profiles.forbidden_slugs contains an invalid slug: %q", slug)
+		}
+	}
+
+	supportedLocales := c.Profiles.GetSupportedLocales()
+	for _, code := range supportedLocales {
+		if !profiles.IsWellFormedLocaleCode(code) {
+			addProblem("profiles.supported_locales contains an invalid locale code: %q", code)
+		}
+	}
+
+	if !slices.Contains(supportedLocales, profiles.FallbackLocaleCode) {
+		addProblem("profiles.supported_locales must include %q", profiles.FallbackLocaleCode)
+	}
+
+	// Telegram
+	if c.Telegram.Enabled {
+		if c.Telegram.BotToken == "" {
+			addProblem("telegram.bot_token is required when telegram is enabled")
+		}
+
+		if !c.Telegram.UsePolling && c.Telegram.WebhookURL != "" {
+			if !isHTTPURL(c.Telegram.WebhookURL) {
+				addProblem("telegram.webhook_url must be an http(s) URL, got %q", c.Telegram.WebhookURL)
+			}
+
+			if c.Telegram.WebhookSecret == "" {
+				addProblem("telegram.webhook_secret is required when a webhook is used")
+			}
+		}
+	}
+
+	// Webserver syncer
+	switch c.WebserverSyncer {
+	case WebserverSyncerCoolify:
+	case WebserverSyncerNginx:
+		if c.Nginx.ConfigPath == "" {
+			addProblem("nginx.config_path is required when webserver_syncer is nginx")
+		}
+
+		if c.Nginx.UpstreamURL != "" && !isHTTPURL(c.Nginx.UpstreamURL) {
+			addProblem("nginx.upstream_url must be an http(s) URL, got %q", c.Nginx.UpstreamURL)
+		}
+	default:
+		addProblem("webserver_syncer must be coolify or nginx, got %q", c.WebserverSyncer)
+	}
+
+	// Webhooks
+	if c.Webhooks.RequestTimeout <= 0 {
+		addProblem("webhooks.request_timeout must be positive, got %s", c.Webhooks.RequestTimeout)
+	}
+
+	if c.Webhooks.RetentionPeriod <= 0 {
+		addProblem("webhooks.retention_period must be positive, got %s", c.Webhooks.RetentionPeriod)
+	}
+
+	// Workers
+	intervals := c.workerIntervals()
+
+	for _, name := range slices.Sorted(maps.Keys(intervals)) {
+		if intervals[name] <= 0 {
+			addProblem("workers.%s must be positive, got %s", name, intervals[name])
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("%w:\n%w", ErrInvalidConfig, errors.Join(problems...))
+}
+
+// workerIntervals lists the intervals of enabled workers by config key.
+func (c *AppConfig) workerIntervals() map[string]time.Duration {
+	workers := &c.Workers
+	intervals := map[string]time.Duration{}
+
+	if workers.DomainSync.Enabled {
+		intervals["domain_sync.sync_interval"] = workers.DomainSync.SyncInterval
+	}
+
+	if workers.YouTubeSync.FullSyncEnabled || workers.YouTubeSync.IncrementalSyncEnabled {
+		intervals["youtube_sync.check_interval"] = workers.YouTubeSync.CheckInterval
+		intervals["youtube_sync.full_sync_interval"] = workers.YouTubeSync.FullSyncInterval
+		intervals["youtube_sync.incremental_sync_interval"] = workers.YouTubeSync.IncrementalSyncInterval
+	}
+
+	if workers.YouTubeLiveStatus.Enabled {
+		intervals["youtube_live_status.check_interval"] = workers.YouTubeLiveStatus.CheckInterval
+		intervals["youtube_live_status.sync_interval"] = workers.YouTubeLiveStatus.SyncInterval
+	}
+
+	if workers.GitHubSync.Enabled {
+		intervals["github_sync.check_interval"] = workers.GitHubSync.CheckInterval
+		intervals["github_sync.full_sync_interval"] = workers.GitHubSync.FullSyncInterval
+	}
+
+	if workers.SpeakerDeckSync.FullSyncEnabled {
+		intervals["speakerdeck_sync.check_interval"] = workers.SpeakerDeckSync.CheckInterval
+		intervals["speakerdeck_sync.full_sync_interval"] = workers.SpeakerDeckSync.FullSyncInterval
+	}
+
+	if workers.ExternalSiteSync.FullSyncEnabled {
+		intervals["external_site_sync.check_interval"] = workers.ExternalSiteSync.CheckInterval
+		intervals["external_site_sync.full_sync_interval"] = workers.ExternalSiteSync.FullSyncInterval
+	}
+
+	if workers.StorySummaries.Enabled {
+		intervals["story_summaries.check_interval"] = workers.StorySummaries.CheckInterval
+	}
+
+	if workers.Queue.Enabled {
+		intervals["queue.poll_interval"] = workers.Queue.PollInterval
+	}
+
+	if workers.TelegramBot.Enabled {
+		intervals["telegram_bot.poll_interval"] = workers.TelegramBot.PollInterval
+	}
+
+	if workers.Bulletin.Enabled {
+		intervals["bulletin.check_interval"] = workers.Bulletin.CheckInterval
+	}
+
+	if workers.WebhookRetention.Enabled {
+		intervals["webhook_retention.check_interval"] = workers.WebhookRetention.CheckInterval
+	}
+
+	if workers.PagePublisher.Enabled {
+		intervals["page_publisher.check_interval"] = workers.PagePublisher.CheckInterval
+	}
+
+	if workers.CustomDomainVerify.Enabled {
+		intervals["custom_domain_verify.check_interval"] = workers.CustomDomainVerify.CheckInterval
+	}
+
+	if workers.ReferralResolver.Enabled {
+		intervals["referral_resolver.check_interval"] = workers.ReferralResolver.CheckInterval
+	}
+
+	return intervals
+}
+
+func isHTTPURL(value string) bool {
+	parsed, err := url.ParseRequestURI(value)
+	if err != nil {
+		return false
+	}
+
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
@@ -0,0 +1,149 @@
+package appcontext_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/configfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/appcontext"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newValidConfig(t *testing.T) *appcontext.AppConfig {
+	t.Helper()
+
+	config := &appcontext.AppConfig{} //nolint:exhaustruct
+
+	err := configfx.NewConfigManager().LoadDefaults(config)
+	require.NoError(t, err)
+
+	config.Auth.JwtSecret = "secret"
+	config.SiteURI = "https://aya.is"
+
+	return config
+}
+
+func TestAppConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults with required secrets are valid", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, newValidConfig(t).Validate())
+	})
+
+	t.Run("missing jwt secret", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.Auth.JwtSecret = ""
+
+		err := config.Validate()
+
+		assert.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.ErrorIs(t, err, appcontext.ErrJWTSecretMissing)
+	})
+
+	t.Run("invalid uri prefixes and forbidden slugs", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.Profiles.AllowedURIPrefixes = "https://objects.aya.is/,not-a-url"
+		config.Profiles.ForbiddenSlugs = "admin,Bad Slug"
+
+		err := config.Validate()
+
+		require.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.Contains(t, err.Error(), `invalid URL: "not-a-url"`)
+		assert.Contains(t, err.Error(), `invalid slug: "Bad Slug"`)
+	})
+
+	t.Run("invalid supported locales", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.Profiles.SupportedLocales = "de,tr_TR,Fr"
+
+		err := config.Validate()
+
+		require.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.Contains(t, err.Error(), `invalid locale code: "tr_TR"`)
+		assert.Contains(t, err.Error(), `invalid locale code: "Fr"`)
+		assert.Contains(t, err.Error(), `supported_locales must include "en"`)
+	})
+
+	t.Run("enabled telegram webhook without secrets", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.Telegram.Enabled = true
+		config.Telegram.UsePolling = false
+		config.Telegram.WebhookURL = "https://api.aya.is/telegram/webhook"
+		config.Telegram.BotToken = ""
+		config.Telegram.WebhookSecret = ""
+
+		err := config.Validate()
+
+		require.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "telegram.bot_token is required")
+		assert.Contains(t, err.Error(), "telegram.webhook_secret is required")
+	})
+
+	t.Run("non-positive worker intervals are aggregated", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.Workers.DomainSync.Enabled = true
+		config.Workers.DomainSync.SyncInterval = 0
+		config.Workers.Queue.Enabled = true
+		config.Workers.Queue.PollInterval = -time.Second
+		config.Workers.PagePublisher.Enabled = true
+		config.Workers.PagePublisher.CheckInterval = 0
+		config.Workers.ReferralResolver.Enabled = true
+		config.Workers.ReferralResolver.CheckInterval = -time.Minute
+
+		err := config.Validate()
+
+		require.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "workers.domain_sync.sync_interval must be positive")
+		assert.Contains(t, err.Error(), "workers.queue.poll_interval must be positive")
+		assert.Contains(t, err.Error(), "workers.page_publisher.check_interval must be positive")
+		assert.Contains(t, err.Error(), "workers.referral_resolver.check_interval must be positive")
+	})
+
+	t.Run("intervals of disabled workers are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.Workers.Bulletin.Enabled = false
+		config.Workers.Bulletin.CheckInterval = 0
+
+		assert.NoError(t, config.Validate())
+	})
+
+	t.Run("unknown webserver syncer", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.WebserverSyncer = "apache"
+
+		err := config.Validate()
+
+		require.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.Contains(t, err.Error(), `webserver_syncer must be coolify or nginx, got "apache"`)
+	})
+
+	t.Run("nginx syncer without config path", func(t *testing.T) {
+		t.Parallel()
+
+		config := newValidConfig(t)
+		config.WebserverSyncer = appcontext.WebserverSyncerNginx
+		config.Nginx.ConfigPath = ""
+
+		err := config.Validate()
+
+		require.ErrorIs(t, err, appcontext.ErrInvalidConfig)
+		assert.Contains(t, err.Error(), "nginx.config_path is required")
+	})
+}
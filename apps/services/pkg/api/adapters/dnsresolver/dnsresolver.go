@@ -0,0 +1,195 @@
+package dnsresolver
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+const defaultDNSPort = "53"
+
+// Config holds configuration for the resolver used by custom domain verification.
+type Config struct {
+	// Nameservers is a comma separated list of "host[:port]" servers to query.
+	// When empty the system resolver is used.
+	Nameservers   string        `conf:"nameservers"`
+	LookupTimeout time.Duration `conf:"lookup_timeout" default:"3s"`
+	CacheTTL      time.Duration `conf:"cache_ttl"      default:"30s"`
+}
+
+// Resolver implements profiles.DNSResolver on top of another resolver, bounding
+// every lookup by a timeout and caching answers for a short while. Answers and
+// "not found" results are cached; timeouts and server failures are not.
+type Resolver struct {
+	upstream profiles.DNSResolver
+	now      func() time.Time
+	entries  map[string]cacheEntry
+	timeout  time.Duration
+	ttl      time.Duration
+	mu       sync.Mutex
+}
+
+type cacheEntry struct {
+	expiresAt time.Time
+	err       error
+	values    []string
+}
+
+// New creates a resolver querying the configured nameservers.
+func New(config *Config) *Resolver {
+	return NewWithUpstream(newUpstream(config.Nameservers), config.LookupTimeout, config.CacheTTL)
+}
+
+// NewWithUpstream creates a resolver that bounds and caches the lookups of upstream.
+// A zero timeout or ttl disables the timeout or the cache.
+func NewWithUpstream(upstream profiles.DNSResolver, timeout, ttl time.Duration) *Resolver {
+	return &Resolver{ //nolint:exhaustruct // mu zero value is valid
+		upstream: upstream,
+		now:      time.Now,
+		entries:  make(map[string]cacheEntry),
+		timeout:  timeout,
+		ttl:      ttl,
+	}
+}
+
+// LookupHost returns the addresses of host.
+func (r *Resolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	return r.lookup(ctx, "host:"+host, func(ctx context.Context) ([]string, error) {
+		return r.upstream.LookupHost(ctx, host)
+	})
+}
+
+// LookupCNAME returns the canonical name of host.
+func (r *Resolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	values, err := r.lookup(ctx, "cname:"+host, func(ctx context.Context) ([]string, error) {
+		cname, err := r.upstream.LookupCNAME(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{cname}, nil
+	})
+	if err != nil || len(values) == 0 {
+		return "", err
+	}
+
+	return values[0], nil
+}
+
+// LookupTXT returns the TXT records of name.
+func (r *Resolver) LookupTXT(ctx context.Context, name string) ([]string, error) {
+	return r.lookup(ctx, "txt:"+name, func(ctx context.Context) ([]string, error) {
+		return r.upstream.LookupTXT(ctx, name)
+	})
+}
+
+func (r *Resolver) lookup(
+	ctx context.Context,
+	key string,
+	fn func(ctx context.Context) ([]string, error),
+) ([]string, error) {
+	if entry, ok := r.cached(key); ok {
+		return entry.values, entry.err
+	}
+
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
+		defer cancel()
+	}
+
+	values, err := fn(ctx)
+
+	if err == nil || profiles.IsDNSNotFound(err) {
+		r.store(key, values, err)
+	}
+
+	return values, err
+}
+
+func (r *Resolver) cached(key string) (cacheEntry, bool) {
+	if r.ttl <= 0 {
+		return cacheEntry{}, false //nolint:exhaustruct
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok || !r.now().Before(entry.expiresAt) {
+		return cacheEntry{}, false //nolint:exhaustruct
+	}
+
+	return entry, true
+}
+
+func (r *Resolver) store(key string, values []string, err error) {
+	if r.ttl <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+
+	// Drop expired answers so the map only holds live entries.
+	for k, entry := range r.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(r.entries, k)
+		}
+	}
+
+	r.entries[key] = cacheEntry{
+		expiresAt: now.Add(r.ttl),
+		err:       err,
+		values:    values,
+	}
+}
+
+// newUpstream returns the system resolver, or one that queries the given
+// nameservers in turn when any are configured.
+func newUpstream(nameservers string) *net.Resolver {
+	servers := parseNameservers(nameservers)
+	if len(servers) == 0 {
+		return net.DefaultResolver
+	}
+
+	var next atomic.Uint64
+
+	return &net.Resolver{ //nolint:exhaustruct
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			server := servers[next.Add(1)%uint64(len(servers))]
+
+			var dialer net.Dialer
+
+			return dialer.DialContext(ctx, network, server)
+		},
+	}
+}
+
+func parseNameservers(nameservers string) []string {
+	servers := make([]string, 0)
+
+	for server := range strings.SplitSeq(nameservers, ",") {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+
+		if _, _, err := net.SplitHostPort(server); err != nil {
+			server = net.JoinHostPort(strings.Trim(server, "[]"), defaultDNSPort)
+		}
+
+		servers = append(servers, server)
+	}
+
+	return servers
+}
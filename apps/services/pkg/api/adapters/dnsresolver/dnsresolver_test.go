@@ -0,0 +1,122 @@
+package dnsresolver_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/adapters/dnsresolver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errServerFailure = errors.New("server misbehaving")
+
+// stubUpstream counts lookups and answers them with fixed results. When block is
+// set, lookups wait for the context to be done instead.
+type stubUpstream struct {
+	hosts map[string][]string
+	err   error
+	calls int
+	block bool
+}
+
+func (u *stubUpstream) LookupHost(ctx context.Context, host string) ([]string, error) {
+	u.calls++
+
+	if u.block {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+
+	if u.err != nil {
+		return nil, u.err
+	}
+
+	if ips, ok := u.hosts[host]; ok {
+		return ips, nil
+	}
+
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true} //nolint:exhaustruct
+}
+
+func (u *stubUpstream) LookupCNAME(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func (u *stubUpstream) LookupTXT(_ context.Context, _ string) ([]string, error) {
+	return nil, nil
+}
+
+func TestResolver_Timeout(t *testing.T) {
+	t.Parallel()
+
+	upstream := &stubUpstream{block: true} //nolint:exhaustruct
+	resolver := dnsresolver.NewWithUpstream(upstream, 20*time.Millisecond, time.Minute)
+
+	started := time.Now()
+	_, err := resolver.LookupHost(t.Context(), "slow.example.com")
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(started), time.Second)
+
+	// Timeouts are not cached.
+	_, err = resolver.LookupHost(t.Context(), "slow.example.com")
+
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 2, upstream.calls)
+}
+
+func TestResolver_CachesAnswers(t *testing.T) {
+	t.Parallel()
+
+	upstream := &stubUpstream{ //nolint:exhaustruct
+		hosts: map[string][]string{"blog.example.com": {"192.0.2.1"}},
+	}
+	resolver := dnsresolver.NewWithUpstream(upstream, time.Second, time.Minute)
+
+	for range 2 {
+		ips, err := resolver.LookupHost(t.Context(), "blog.example.com")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"192.0.2.1"}, ips)
+	}
+
+	assert.Equal(t, 1, upstream.calls)
+}
+
+func TestResolver_CachesNXDOMAIN(t *testing.T) {
+	t.Parallel()
+
+	upstream := &stubUpstream{} //nolint:exhaustruct
+	resolver := dnsresolver.NewWithUpstream(upstream, time.Second, time.Minute)
+
+	for range 2 {
+		_, err := resolver.LookupHost(t.Context(), "missing.example.com")
+
+		var dnsErr *net.DNSError
+
+		require.ErrorAs(t, err, &dnsErr)
+		assert.True(t, dnsErr.IsNotFound)
+	}
+
+	assert.Equal(t, 1, upstream.calls)
+}
+
+func TestResolver_DoesNotCacheFailures(t *testing.T) {
+	t.Parallel()
+
+	upstream := &stubUpstream{err: errServerFailure} //nolint:exhaustruct
+	resolver := dnsresolver.NewWithUpstream(upstream, time.Second, time.Minute)
+
+	for range 2 {
+		_, err := resolver.LookupHost(t.Context(), "blog.example.com")
+
+		require.ErrorIs(t, err, errServerFailure)
+	}
+
+	assert.Equal(t, 2, upstream.calls)
+}
@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpclient"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 )
 
 // Sentinel errors for GitHub API responses.
@@ -90,7 +91,11 @@ func fetchRepoInfo(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+		return nil, linksync.NewProviderError(
+			fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	var info repoInfoResponse
@@ -134,7 +139,11 @@ func fetchRepoTree(
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode)
+		return nil, linksync.NewProviderError(
+			fmt.Errorf("%w: %d", ErrUnexpectedStatus, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	var tree treeResponse
@@ -273,7 +282,11 @@ func fetchRawFile(
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("%w: %s (status %d)", ErrFetchFailed, filePath, resp.StatusCode)
+		return "", linksync.NewProviderError(
+			fmt.Errorf("%w: %s (status %d)", ErrFetchFailed, filePath, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -17,6 +17,7 @@ import (
 
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 )
 
 // Sentinel errors.
@@ -417,6 +418,71 @@ func (c *Client) FetchUserRepos(
 	return repos, nil
 }
 
+// FetchOrgRepos fetches the public repositories of a GitHub organization,
+// most recently pushed first.
+func (c *Client) FetchOrgRepos(
+	ctx context.Context,
+	accessToken string,
+	org string,
+	page int,
+	perPage int,
+) ([]*GitHubRepoInfo, error) {
+	reqURL := fmt.Sprintf(
+		"https://api.github.com/orgs/%s/repos?type=public&sort=pushed&per_page=%d&page=%d",
+		url.PathEscape(org),
+		perPage,
+		page,
+	)
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		reqURL,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToFetchRepos, err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-Github-Api-Version", "2022-11-28")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "Failed to fetch organization repos",
+			slog.String("error", err.Error()),
+			slog.String("org", org))
+
+		return nil, fmt.Errorf("%w: %w", ErrFailedToFetchRepos, err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, _ := io.ReadAll(resp.Body)
+	c.checkRateLimit(ctx, resp)
+
+	if resp.StatusCode != http.StatusOK {
+		c.logger.ErrorContext(ctx, "Organization repos request failed",
+			slog.Int("status", resp.StatusCode),
+			slog.String("response", string(body)))
+
+		return nil, linksync.NewProviderError(
+			fmt.Errorf("%w: status %d", ErrFailedToFetchRepos, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
+	}
+
+	var repos []*GitHubRepoInfo
+
+	unmarshalErr := json.Unmarshal(body, &repos)
+	if unmarshalErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToFetchRepos, unmarshalErr)
+	}
+
+	return repos, nil
+}
+
 // FetchRepoContributors fetches contributors for a specific GitHub repository.
 func (c *Client) FetchRepoContributors(
 	ctx context.Context,
@@ -463,7 +529,11 @@ func (c *Client) FetchRepoContributors(
 			slog.Int("status", resp.StatusCode),
 			slog.String("response", string(body)))
 
-		return nil, fmt.Errorf("%w: status %d", ErrFailedToFetchRepoInfo, resp.StatusCode)
+		return nil, linksync.NewProviderError(
+			fmt.Errorf("%w: status %d", ErrFailedToFetchRepoInfo, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	var contributors []*GitHubContributorInfo
@@ -522,7 +592,11 @@ func (c *Client) FetchRepoInfo(
 			slog.Int("status", resp.StatusCode),
 			slog.String("response", string(body)))
 
-		return nil, fmt.Errorf("%w: status %d", ErrFailedToFetchRepoInfo, resp.StatusCode)
+		return nil, linksync.NewProviderError(
+			fmt.Errorf("%w: status %d", ErrFailedToFetchRepoInfo, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	var repoInfo GitHubRepoInfo
@@ -6,6 +6,9 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
 )
 
+// orgReposPerPage is the page size of organization repository listings (GitHub's maximum).
+const orgReposPerPage = 100
+
 // ResourceFetcherAdapter adapts github.Client to implement workers.GitHubResourceFetcher.
 type ResourceFetcherAdapter struct {
 	client *Client
@@ -45,6 +48,36 @@ func (a *ResourceFetcherAdapter) FetchRepoInfo(
 	}, nil
 }
 
+// FetchOrgRepos fetches the public repositories of an organization. Only the
+// most recently pushed page is fetched; that is where new repositories show up.
+func (a *ResourceFetcherAdapter) FetchOrgRepos(
+	ctx context.Context,
+	accessToken string,
+	org string,
+) ([]*workers.GitHubRepoInfoResult, error) {
+	repos, err := a.client.FetchOrgRepos(ctx, accessToken, org, 1, orgReposPerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*workers.GitHubRepoInfoResult, len(repos))
+	for i, info := range repos {
+		results[i] = &workers.GitHubRepoInfoResult{
+			ID:          info.ID,
+			FullName:    info.FullName,
+			Name:        info.Name,
+			Description: info.Description,
+			HTMLURL:     info.HTMLURL,
+			Language:    info.Language,
+			Stars:       info.Stars,
+			Forks:       info.Forks,
+			Private:     info.Private,
+		}
+	}
+
+	return results, nil
+}
+
 // FetchRepoContributors fetches contributors for a repository.
 func (a *ResourceFetcherAdapter) FetchRepoContributors(
 	ctx context.Context,
@@ -0,0 +1,73 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/eser/aya.is/services/pkg/ajan/aifx"
+)
+
+// AIOperation identifies an AI-backed feature.
+type AIOperation string
+
+const (
+	AIOperationTranslate  AIOperation = "translate"
+	AIOperationGenerateCV AIOperation = "generate_cv"
+	AIOperationSummarize  AIOperation = "summarize"
+)
+
+// AIOperations lists every AI operation reported by the capabilities endpoint.
+var AIOperations = []AIOperation{ //nolint:gochecknoglobals
+	AIOperationTranslate,
+	AIOperationGenerateCV,
+	AIOperationSummarize,
+}
+
+// AICapabilities answers which AI operations the configured models can serve.
+// Checks only inspect the registry, so they are cheap enough to run before
+// spending points on a request.
+type AICapabilities struct {
+	aiModels *aifx.Registry
+}
+
+// NewAICapabilities creates a new AICapabilities. A nil registry reports
+// every operation as unavailable.
+func NewAICapabilities(aiModels *aifx.Registry) *AICapabilities {
+	return &AICapabilities{aiModels: aiModels}
+}
+
+// AIAvailable reports whether the given operation can be served.
+func (c *AICapabilities) AIAvailable(operation AIOperation) bool {
+	if c.aiModels == nil {
+		return false
+	}
+
+	model := c.aiModels.GetDefault()
+	if model == nil {
+		return false
+	}
+
+	capabilities := model.GetCapabilities()
+
+	switch operation {
+	case AIOperationTranslate, AIOperationGenerateCV:
+		return slices.Contains(capabilities, aifx.CapabilityTextGeneration)
+	case AIOperationSummarize:
+		// Summaries are produced through the batch API by the story summaries worker.
+		_, isBatchCapable := model.(aifx.BatchCapableModel)
+
+		return isBatchCapable && slices.Contains(capabilities, aifx.CapabilityBatchProcessing)
+	default:
+		return false
+	}
+}
+
+// Snapshot reports the availability of every AI operation.
+func (c *AICapabilities) Snapshot() map[AIOperation]bool {
+	result := make(map[AIOperation]bool, len(AIOperations))
+
+	for _, operation := range AIOperations {
+		result[operation] = c.AIAvailable(operation)
+	}
+
+	return result
+}
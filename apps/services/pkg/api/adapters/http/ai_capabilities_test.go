@@ -0,0 +1,36 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/aifx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAICapabilities_Unconfigured(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		aiModels *aifx.Registry
+	}{
+		{name: "nil registry", aiModels: nil},
+		{name: "registry without models", aiModels: aifx.NewRegistry()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			capabilities := httpadapter.NewAICapabilities(tt.aiModels)
+
+			for _, operation := range httpadapter.AIOperations {
+				assert.False(t, capabilities.AIAvailable(operation), operation)
+			}
+
+			snapshot := capabilities.Snapshot()
+			assert.Len(t, snapshot, len(httpadapter.AIOperations))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package http
+
+// AIPrompt holds the locale-specific parts of the AI prompts.
+// Instructions are written in the target language itself, which keeps models
+// from drifting back to English for non-English targets.
+type AIPrompt struct {
+	LanguageName      string // full English name of the language
+	OutputInstruction string // "write the output in X", in the target language
+	CVExperience      string // CV section headings, in the target language
+	CVEducation       string
+	CVCertificates    string
+}
+
+// aiPromptCatalog maps supported locale codes to their prompt parts.
+// Using full language names prevents LLMs from defaulting to English when given short codes.
+var aiPromptCatalog = map[string]AIPrompt{ //nolint:gochecknoglobals
+	"ar": {
+		LanguageName:      "Arabic",
+		OutputInstruction: "اكتب المخرجات بالكامل باللغة العربية.",
+		CVExperience:      "الخبرة",
+		CVEducation:       "التعليم",
+		CVCertificates:    "الشهادات",
+	},
+	"de": {
+		LanguageName:      "German",
+		OutputInstruction: "Schreibe die gesamte Ausgabe auf Deutsch.",
+		CVExperience:      "Berufserfahrung",
+		CVEducation:       "Ausbildung",
+		CVCertificates:    "Zertifikate",
+	},
+	"en": {
+		LanguageName:      "English",
+		OutputInstruction: "Write the entire output in English.",
+		CVExperience:      "Experience",
+		CVEducation:       "Education",
+		CVCertificates:    "Certificates",
+	},
+	"es": {
+		LanguageName:      "Spanish",
+		OutputInstruction: "Escribe toda la respuesta en español.",
+		CVExperience:      "Experiencia",
+		CVEducation:       "Formación",
+		CVCertificates:    "Certificaciones",
+	},
+	"fr": {
+		LanguageName:      "French",
+		OutputInstruction: "Rédige l'intégralité de la réponse en français.",
+		CVExperience:      "Expérience",
+		CVEducation:       "Formation",
+		CVCertificates:    "Certifications",
+	},
+	"it": {
+		LanguageName:      "Italian",
+		OutputInstruction: "Scrivi l'intero output in italiano.",
+		CVExperience:      "Esperienza",
+		CVEducation:       "Formazione",
+		CVCertificates:    "Certificazioni",
+	},
+	"ja": {
+		LanguageName:      "Japanese",
+		OutputInstruction: "出力はすべて日本語で書いてください。",
+		CVExperience:      "職歴",
+		CVEducation:       "学歴",
+		CVCertificates:    "資格",
+	},
+	"ko": {
+		LanguageName:      "Korean",
+		OutputInstruction: "모든 출력을 한국어로 작성하세요.",
+		CVExperience:      "경력",
+		CVEducation:       "학력",
+		CVCertificates:    "자격증",
+	},
+	"nl": {
+		LanguageName:      "Dutch",
+		OutputInstruction: "Schrijf de volledige uitvoer in het Nederlands.",
+		CVExperience:      "Werkervaring",
+		CVEducation:       "Opleiding",
+		CVCertificates:    "Certificaten",
+	},
+	"pt-PT": {
+		LanguageName:      "Portuguese (Portugal)",
+		OutputInstruction: "Escreve todo o resultado em português europeu.",
+		CVExperience:      "Experiência",
+		CVEducation:       "Formação",
+		CVCertificates:    "Certificações",
+	},
+	"ru": {
+		LanguageName:      "Russian",
+		OutputInstruction: "Напиши весь ответ на русском языке.",
+		CVExperience:      "Опыт работы",
+		CVEducation:       "Образование",
+		CVCertificates:    "Сертификаты",
+	},
+	"tr": {
+		LanguageName:      "Turkish",
+		OutputInstruction: "Çıktının tamamını Türkçe yaz.",
+		CVExperience:      "Deneyim",
+		CVEducation:       "Eğitim",
+		CVCertificates:    "Sertifikalar",
+	},
+	"zh-CN": {
+		LanguageName:      "Chinese (Simplified)",
+		OutputInstruction: "请用简体中文撰写全部输出内容。",
+		CVExperience:      "工作经历",
+		CVEducation:       "教育背景",
+		CVCertificates:    "证书",
+	},
+}
+
+// AIPromptForLocale returns the prompt parts for a locale code.
+// Unknown locales fall back to English instructions naming the locale code itself.
+func AIPromptForLocale(locale string) AIPrompt {
+	if prompt, ok := aiPromptCatalog[locale]; ok {
+		return prompt
+	}
+
+	fallback := aiPromptCatalog["en"]
+	fallback.LanguageName = locale
+	fallback.OutputInstruction = "Write the entire output in " + locale + "."
+
+	return fallback
+}
+
+// languageNameForLocale returns the full language name for a locale code.
+// Falls back to the locale code itself if not found.
+func languageNameForLocale(locale string) string {
+	return AIPromptForLocale(locale).LanguageName
+}
@@ -0,0 +1,79 @@
+package http_test
+
+import (
+	"testing"
+
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAIPromptForLocale(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		locale           string
+		wantLanguageName string
+		wantInstruction  string
+		wantExperience   string
+	}{
+		{
+			locale:           "en",
+			wantLanguageName: "English",
+			wantInstruction:  "Write the entire output in English.",
+			wantExperience:   "Experience",
+		},
+		{
+			locale:           "tr",
+			wantLanguageName: "Turkish",
+			wantInstruction:  "Çıktının tamamını Türkçe yaz.",
+			wantExperience:   "Deneyim",
+		},
+		{
+			locale:           "pt-PT",
+			wantLanguageName: "Portuguese (Portugal)",
+			wantInstruction:  "Escreve todo o resultado em português europeu.",
+			wantExperience:   "Experiência",
+		},
+		{
+			locale:           "zh-CN",
+			wantLanguageName: "Chinese (Simplified)",
+			wantInstruction:  "请用简体中文撰写全部输出内容。",
+			wantExperience:   "工作经历",
+		},
+		{
+			locale:           "xx",
+			wantLanguageName: "xx",
+			wantInstruction:  "Write the entire output in xx.",
+			wantExperience:   "Experience",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			t.Parallel()
+
+			prompt := httpadapter.AIPromptForLocale(tt.locale)
+
+			assert.Equal(t, tt.wantLanguageName, prompt.LanguageName)
+			assert.Equal(t, tt.wantInstruction, prompt.OutputInstruction)
+			assert.Equal(t, tt.wantExperience, prompt.CVExperience)
+		})
+	}
+}
+
+func TestAIPromptForLocale_CoversSupportedLocales(t *testing.T) {
+	t.Parallel()
+
+	english := httpadapter.AIPromptForLocale("en")
+
+	for locale := range profiles.DefaultSupportedLocaleCodes() {
+		prompt := httpadapter.AIPromptForLocale(locale)
+
+		assert.NotEqual(t, locale, prompt.LanguageName, "missing catalog entry for %s", locale)
+
+		if locale != "en" {
+			assert.NotEqual(t, english.OutputInstruction, prompt.OutputInstruction, locale)
+		}
+	}
+}
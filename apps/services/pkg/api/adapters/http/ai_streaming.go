@@ -0,0 +1,179 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+// Server-sent event names used by streaming AI routes.
+const (
+	sseEventDelta  = "delta"
+	sseEventCommit = "commit"
+	sseEventError  = "error"
+)
+
+// acceptsEventStream reports whether the client asked for server-sent events.
+func acceptsEventStream(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
+
+// sseWriter writes server-sent events. The stream headers are only sent with
+// the first event, so failures that happen before anything was streamed can
+// still be answered with a regular HTTP error response.
+type sseWriter struct {
+	responseWriter http.ResponseWriter
+	controller     *http.ResponseController
+	started        bool
+}
+
+func newSSEWriter(responseWriter http.ResponseWriter) *sseWriter {
+	return &sseWriter{
+		responseWriter: responseWriter,
+		controller:     http.NewResponseController(responseWriter),
+		started:        false,
+	}
+}
+
+// Send writes a single event with a JSON-encoded payload and flushes it.
+func (w *sseWriter) Send(event string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("encoding %s event: %w", event, err)
+	}
+
+	if !w.started {
+		header := w.responseWriter.Header()
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-cache")
+		header.Set("X-Accel-Buffering", "no")
+		w.responseWriter.WriteHeader(http.StatusOK)
+
+		w.started = true
+	}
+
+	_, err = fmt.Fprintf(w.responseWriter, "event: %s\ndata: %s\n\n", event, data)
+	if err != nil {
+		return fmt.Errorf("writing %s event: %w", event, err)
+	}
+
+	return w.controller.Flush() //nolint:wrapcheck
+}
+
+// streamCVPageGeneration generates a CV page while streaming the model output
+// as "delta" events, then emits a "commit" event with the created page.
+// Points are only deducted once generation succeeded.
+func streamCVPageGeneration(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	profileService *profiles.Service,
+	profilePointsService *profile_points.Service,
+	generator profiles.StreamingContentGenerator,
+	params profiles.GenerateCVPageParams,
+) httpfx.Result {
+	stream := newSSEWriter(ctx.ResponseWriter)
+
+	page, err := profileService.GenerateCVPageStreaming(
+		ctx.Request.Context(),
+		params,
+		generator,
+		profilePointsService,
+		func(delta string) {
+			sendErr := stream.Send(sseEventDelta, map[string]string{"text": delta})
+			if sendErr != nil {
+				logger.Debug("Failed to stream CV delta", slog.String("error", sendErr.Error()))
+			}
+		},
+	)
+
+	if err != nil {
+		// Nothing was streamed yet, so a regular error response can still be sent.
+		if !stream.started {
+			return generateCVErrorResult(ctx, logger, err, params.ProfileSlug)
+		}
+
+		logger.Error(
+			"Failed to generate CV page",
+			slog.String("error", err.Error()),
+			slog.String("slug", params.ProfileSlug),
+		)
+
+		_ = stream.Send(sseEventError, map[string]string{"error": "CV generation failed"})
+
+		return ctx.Results.Written()
+	}
+
+	_ = stream.Send(sseEventCommit, map[string]any{"data": page, "error": nil})
+
+	return ctx.Results.Written()
+}
+
+// generateCVErrorResult maps CV generation errors to HTTP responses.
+func generateCVErrorResult(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	err error,
+	slug string,
+) httpfx.Result {
+	if errors.Is(err, profiles.ErrUnauthorized) {
+		return ctx.Results.Error(
+			http.StatusForbidden,
+			httpfx.WithErrorMessage("You do not have permission to edit this profile"),
+		)
+	}
+
+	if errors.Is(err, profile_points.ErrInsufficientPoints) {
+		return ctx.Results.Error(
+			http.StatusPaymentRequired,
+			httpfx.WithErrorMessage(
+				"Insufficient points for content generation (requires 5 points)",
+			),
+		)
+	}
+
+	if errors.Is(err, profiles.ErrRateLimited) {
+		return ctx.Results.Error(
+			http.StatusTooManyRequests,
+			httpfx.WithErrorMessage("Too many content generations, try again later"),
+		)
+	}
+
+	if errors.Is(err, ErrAITranslationNotAvailable) {
+		return ctx.Results.Error(
+			http.StatusServiceUnavailable,
+			httpfx.WithErrorMessage("AI content generation not available"),
+		)
+	}
+
+	if errors.Is(err, profiles.ErrContentTooLong) {
+		return ctx.Results.Error(
+			http.StatusUnprocessableEntity,
+			httpfx.WithErrorMessage("Profile data is too long for content generation"),
+		)
+	}
+
+	if errors.Is(err, profiles.ErrNoLinkedInLinkFound) {
+		return ctx.Results.BadRequest(
+			httpfx.WithErrorMessage("No LinkedIn link found on this profile"),
+		)
+	}
+
+	logger.Error(
+		"Failed to generate CV page",
+		slog.String("error", err.Error()),
+		slog.String("slug", slug),
+	)
+
+	return ctx.Results.Error(
+		http.StatusInternalServerError,
+		httpfx.WithSanitizedError(err),
+	)
+}
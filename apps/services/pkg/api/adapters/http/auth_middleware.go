@@ -3,6 +3,8 @@ package http
 import (
 	"context"
 	"log/slog"
+	"net/http"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
@@ -11,6 +13,15 @@ import (
 
 const ContextKeySessionID httpfx.ContextKey = "session_id"
 
+const (
+	// HeaderImpersonationConfirm must be "true" for a mutating request to go through
+	// while an admin is impersonating a user.
+	HeaderImpersonationConfirm = "X-Impersonation-Confirm"
+	// HeaderImpersonatedBy flags responses served to an impersonation session with
+	// the impersonating admin's user ID.
+	HeaderImpersonatedBy = "X-Impersonated-By"
+)
+
 // AuthMiddleware resolves the caller's session from the request.
 // Tries the session cookie first, then falls back to the Authorization Bearer
 // token. This enables cross-domain scenarios where the cookie is not available
@@ -35,6 +46,13 @@ func AuthMiddleware(authService *auth.Service, userService *users.Service) httpf
 			return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Unauthorized"))
 		}
 
+		if session.IsImpersonation() {
+			result, allowed := guardImpersonatedRequest(ctx, userService, session)
+			if !allowed {
+				return result
+			}
+		}
+
 		// Update last activity and user agent
 		userAgent := ctx.Request.Header.Get("User-Agent")
 
@@ -58,3 +76,50 @@ func AuthMiddleware(authService *auth.Service, userService *users.Service) httpf
 		return ctx.Next()
 	}
 }
+
+// guardImpersonatedRequest audits a request made while an admin impersonates a user
+// and decides whether it may proceed. Impersonation is read-mostly: safe methods
+// pass, mutations need an explicit confirmation header, and expired impersonation
+// sessions are rejected.
+func guardImpersonatedRequest(
+	ctx *httpfx.Context,
+	userService *users.Service,
+	session *users.Session,
+) (httpfx.Result, bool) {
+	if session.IsImpersonationExpired(time.Now()) {
+		return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Impersonation session expired")), false
+	}
+
+	ctx.ResponseWriter.Header().Set(HeaderImpersonatedBy, *session.ImpersonatorUserID)
+
+	blocked := isUnconfirmedImpersonatedChange(ctx.Request)
+
+	userService.RecordImpersonatedRequest(
+		ctx.Request.Context(),
+		session,
+		ctx.Request.Method,
+		ctx.Request.URL.Path,
+		blocked,
+	)
+
+	if blocked {
+		return ctx.Results.Error(
+			http.StatusForbidden,
+			httpfx.WithErrorMessage(
+				"Changes while impersonating require the "+HeaderImpersonationConfirm+" header",
+			),
+		), false
+	}
+
+	return httpfx.Result{}, true //nolint:exhaustruct
+}
+
+// isUnconfirmedImpersonatedChange reports whether an impersonated request is a
+// mutation sent without the confirmation header.
+func isUnconfirmedImpersonatedChange(r *http.Request) bool {
+	return !isSafeMethod(r.Method) && r.Header.Get(HeaderImpersonationConfirm) != "true"
+}
+
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
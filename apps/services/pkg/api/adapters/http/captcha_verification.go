@@ -0,0 +1,56 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx/middlewares"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
+)
+
+// HeaderCaptchaToken carries the CAPTCHA token of a public write request.
+const HeaderCaptchaToken = "X-Captcha-Token"
+
+// verifyCaptcha checks the request's CAPTCHA token for the endpoint before it
+// is processed. ok is false with the response to send when the check fails.
+func verifyCaptcha(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	protectionService *protection.Service,
+	endpoint protection.CaptchaEndpoint,
+) (httpfx.Result, bool) {
+	err := protectionService.VerifyCaptcha(
+		ctx.Request.Context(),
+		endpoint,
+		ctx.Request.Header.Get(HeaderCaptchaToken),
+		middlewares.GetClientAddrs(ctx.Request),
+	)
+	if err == nil {
+		return httpfx.Result{}, true //nolint:exhaustruct
+	}
+
+	switch {
+	case errors.Is(err, protection.ErrCaptchaRequired):
+		return ctx.Results.Error(
+			http.StatusBadRequest,
+			httpfx.WithErrorCode(string(ErrorCodeCaptchaRequired), "CAPTCHA token is required"),
+		), false
+	case errors.Is(err, protection.ErrCaptchaInvalid):
+		return ctx.Results.Error(
+			http.StatusForbidden,
+			httpfx.WithErrorCode(string(ErrorCodeCaptchaInvalid), "CAPTCHA verification failed"),
+		), false
+	}
+
+	logger.ErrorContext(ctx.Request.Context(), "CAPTCHA verification failed",
+		slog.String("error", err.Error()),
+		slog.String("endpoint", string(endpoint)))
+
+	return ctx.Results.Error(
+		http.StatusServiceUnavailable,
+		httpfx.WithErrorMessage("CAPTCHA verification is unavailable, try again later"),
+	), false
+}
@@ -0,0 +1,153 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/stretchr/testify/assert"
+)
+
+// rejectingCaptchaVerifier rejects every token.
+type rejectingCaptchaVerifier struct{}
+
+func (rejectingCaptchaVerifier) Verify(_ context.Context, _ string, _ string) (bool, error) {
+	return false, nil
+}
+
+// activeSessionRepository serves an active session of the user "jane".
+type activeSessionRepository struct {
+	users.Repository
+}
+
+func (r *activeSessionRepository) GetSessionByID(_ context.Context, id string) (*users.Session, error) {
+	userID := "jane"
+
+	return &users.Session{ //nolint:exhaustruct
+		ID:             id,
+		Status:         users.SessionStatusActive,
+		LoggedInUserID: &userID,
+	}, nil
+}
+
+func (r *activeSessionRepository) UpdateSessionActivity(_ context.Context, _ string, _ *string) error {
+	return nil
+}
+
+func TestReportRoutes_RequireCaptchaWhenConfigured(t *testing.T) {
+	t.Parallel()
+
+	config := &protection.Config{ //nolint:exhaustruct
+		Captcha: protection.CaptchaConfig{Endpoints: "report"},
+	}
+	protectionService := protection.NewService(nil, config, nil, nil)
+	protectionService.SetCaptchaVerifier(rejectingCaptchaVerifier{})
+
+	routes := httpfx.NewRouter("/")
+	httpadapter.RegisterHTTPRoutesForProfileReports(routes, nil, nil, nil, nil, protectionService)
+
+	tests := map[string]struct {
+		token    string
+		status   int
+		expected string
+	}{
+		"missing token": {
+			status:   http.StatusBadRequest,
+			expected: `{"error":"CAPTCHA token is required","code":"captcha_required"}`,
+		},
+		"rejected token": {
+			token:    "forged",
+			status:   http.StatusForbidden,
+			expected: `{"error":"CAPTCHA verification failed","code":"captcha_invalid"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"/en/profiles/acme/_report",
+				strings.NewReader(`{"reason":"spam"}`),
+			)
+			if tt.token != "" {
+				req.Header.Set(httpadapter.HeaderCaptchaToken, tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			routes.GetMux().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.JSONEq(t, tt.expected, rec.Body.String())
+		})
+	}
+}
+
+func TestEnvelopeRoutes_RequireCaptchaWhenConfigured(t *testing.T) {
+	t.Parallel()
+
+	config := &protection.Config{ //nolint:exhaustruct
+		Captcha: protection.CaptchaConfig{Endpoints: "contact"},
+	}
+	protectionService := protection.NewService(nil, config, nil, nil)
+	protectionService.SetCaptchaVerifier(rejectingCaptchaVerifier{})
+
+	userService := users.NewService(nil, &activeSessionRepository{}, nil) //nolint:exhaustruct
+	authConfig := &auth.Config{CookieName: "aya_session"}                 //nolint:exhaustruct
+	authService := auth.NewService(nil, nil, authConfig, userService, nil)
+
+	// The envelope is never created: the mailbox service is not even set.
+	routes := httpfx.NewRouter("/")
+	httpadapter.RegisterHTTPRoutesForProfileEnvelopes(
+		routes, nil, authService, userService, nil, nil, nil, protectionService,
+	)
+
+	tests := map[string]struct {
+		token    string
+		status   int
+		expected string
+	}{
+		"missing token": {
+			status:   http.StatusBadRequest,
+			expected: `{"error":"CAPTCHA token is required","code":"captcha_required"}`,
+		},
+		"rejected token": {
+			token:    "forged",
+			status:   http.StatusForbidden,
+			expected: `{"error":"CAPTCHA verification failed","code":"captcha_invalid"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"/en/profiles/acme/_envelopes",
+				strings.NewReader(`{"kind":"invitation","target_profile_id":"jane","message":"Hi"}`),
+			)
+			req.AddCookie(&http.Cookie{Name: "aya_session", Value: "session-1"}) //nolint:exhaustruct
+
+			if tt.token != "" {
+				req.Header.Set(httpadapter.HeaderCaptchaToken, tt.token)
+			}
+
+			rec := httptest.NewRecorder()
+			routes.GetMux().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.status, rec.Code)
+			assert.JSONEq(t, tt.expected, rec.Body.String())
+		})
+	}
+}
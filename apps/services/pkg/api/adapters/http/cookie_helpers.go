@@ -98,7 +98,9 @@ func GetSessionIDFromRequest(r *http.Request, authService *auth.Service) string
 }
 
 // GetViewerUserID extracts the logged-in user's ID from the request session.
-// Returns nil for anonymous/unauthenticated requests.
+// Returns nil for anonymous/unauthenticated requests. Impersonated requests are
+// audited like under AuthMiddleware, and an unconfirmed change made while
+// impersonating is treated as anonymous.
 func GetViewerUserID(
 	r *http.Request,
 	authService *auth.Service,
@@ -114,6 +116,20 @@ func GetViewerUserID(
 		return nil
 	}
 
+	if session.IsImpersonationExpired(time.Now()) {
+		return nil
+	}
+
+	if session.IsImpersonation() {
+		blocked := isUnconfirmedImpersonatedChange(r)
+
+		userService.RecordImpersonatedRequest(r.Context(), session, r.Method, r.URL.Path, blocked)
+
+		if blocked {
+			return nil
+		}
+	}
+
 	return session.LoggedInUserID
 }
 
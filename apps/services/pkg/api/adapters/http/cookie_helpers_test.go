@@ -0,0 +1,105 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// viewerSessionRepository serves the impersonation session "imp-session" of
+// the user "jane", started by the admin "admin".
+type viewerSessionRepository struct {
+	users.Repository
+}
+
+func (r *viewerSessionRepository) GetSessionByID(_ context.Context, id string) (*users.Session, error) {
+	userID := "jane"
+	impersonatorID := "admin"
+	expiresAt := time.Now().Add(time.Hour)
+
+	return &users.Session{ //nolint:exhaustruct
+		ID:                 id,
+		LoggedInUserID:     &userID,
+		ImpersonatorUserID: &impersonatorID,
+		ExpiresAt:          &expiresAt,
+	}, nil
+}
+
+// viewerAuditRepository keeps every recorded audit entry.
+type viewerAuditRepository struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *viewerAuditRepository) InsertAudit(_ context.Context, _ string, params events.AuditParams) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+func TestGetViewerUserID_GuardsImpersonatedChanges(t *testing.T) {
+	t.Parallel()
+
+	jane := "jane"
+
+	tests := map[string]struct {
+		method   string
+		confirm  bool
+		expected *string
+		event    events.EventType
+	}{
+		"read": {
+			method:   http.MethodGet,
+			expected: &jane,
+			event:    events.ImpersonationRequest,
+		},
+		"unconfirmed change": {
+			method: http.MethodPost,
+			event:  events.ImpersonationRequestBlocked,
+		},
+		"confirmed change": {
+			method:   http.MethodPost,
+			confirm:  true,
+			expected: &jane,
+			event:    events.ImpersonationRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			auditRepo := &viewerAuditRepository{} //nolint:exhaustruct
+			userService := users.NewService(
+				nil,
+				&viewerSessionRepository{}, //nolint:exhaustruct
+				events.NewAuditService(nil, auditRepo, func() string { return "audit" }, nil),
+			)
+			authConfig := &auth.Config{CookieName: "aya_session"} //nolint:exhaustruct
+			authService := auth.NewService(nil, nil, authConfig, userService, nil)
+
+			req := httptest.NewRequestWithContext(t.Context(), tt.method, "/en/profiles/acme/_report", nil)
+			req.AddCookie(&http.Cookie{Name: "aya_session", Value: "imp-session"}) //nolint:exhaustruct
+
+			if tt.confirm {
+				req.Header.Set(httpadapter.HeaderImpersonationConfirm, "true")
+			}
+
+			assert.Equal(t, tt.expected, httpadapter.GetViewerUserID(req, authService, userService))
+
+			require.Len(t, auditRepo.entries, 1)
+			assert.Equal(t, tt.event, auditRepo.entries[0].EventType)
+			assert.Equal(t, "admin", *auditRepo.entries[0].ActorID)
+		})
+	}
+}
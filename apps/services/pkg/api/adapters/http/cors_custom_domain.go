@@ -1,9 +1,11 @@
 package http
 
 import (
+	"context"
 	"net/http"
 	"net/url"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
@@ -11,17 +13,85 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 )
 
+// corsOriginClass classifies a request origin for CORS purposes.
+type corsOriginClass int
+
+const (
+	corsOriginDenied       corsOriginClass = iota
+	corsOriginFirstParty                   // config-defined origins, credentialed
+	corsOriginCustomDomain                 // profile custom domains, credentialed
+	corsOriginPublic                       // public tier, never credentialed
+)
+
+// credentialed reports whether responses to this origin class may carry credentials.
+func (c corsOriginClass) credentialed() bool {
+	return c == corsOriginFirstParty || c == corsOriginCustomDomain
+}
+
+// String returns the name of the origin class.
+func (c corsOriginClass) String() string {
+	switch c {
+	case corsOriginFirstParty:
+		return "first_party"
+	case corsOriginCustomDomain:
+		return "custom_domain"
+	case corsOriginPublic:
+		return "public"
+	case corsOriginDenied:
+		return "denied"
+	}
+
+	return "denied"
+}
+
+// CorsOriginResolution explains how the CORS middleware treats an origin.
+type CorsOriginResolution struct {
+	Origin       string `json:"origin"`
+	Domain       string `json:"domain"`
+	Match        string `json:"match"`
+	Reason       string `json:"reason"`
+	Allowed      bool   `json:"allowed"`
+	Credentialed bool   `json:"credentialed"`
+}
+
+// CustomDomainChecker reports whether a domain belongs to a profile.
+type CustomDomainChecker func(ctx context.Context, domain string) bool
+
 // CorsMiddlewareWithCustomDomains validates origins against:
 // 1. Config-defined allowed origins (from auth.Config)
-// 2. Database custom_domain field (cached at repository layer).
+// 2. Database custom_domain field (cached at repository layer)
+// 3. Config-defined public origins, which are allowed without credentials.
 func CorsMiddlewareWithCustomDomains(
 	authConfig *auth.Config,
 	profileService *profiles.Service,
+) httpfx.Handler {
+	return NewCorsMiddleware(authConfig, NewCustomDomainChecker(profileService))
+}
+
+// NewCustomDomainChecker checks domains against the profiles' custom domains.
+func NewCustomDomainChecker(profileService *profiles.Service) CustomDomainChecker {
+	return func(ctx context.Context, domain string) bool {
+		// GetByCustomDomain is cached at repository layer
+		profile, _, _ := profileService.GetByCustomDomain(
+			ctx,
+			"en", // locale doesn't matter for domain check
+			domain,
+		)
+
+		return profile != nil
+	}
+}
+
+// NewCorsMiddleware creates the CORS middleware with the given custom domain checker.
+func NewCorsMiddleware(
+	authConfig *auth.Config,
+	isCustomDomain CustomDomainChecker,
 ) httpfx.Handler {
 	// Parse config values once at startup
-	allowedOrigins := authConfig.GetCorsAllowedOrigins()
 	allowedHeaders := strings.Join(authConfig.GetCorsAllowedHeaders(), ", ")
 	allowedMethods := strings.Join(authConfig.GetCorsAllowedMethods(), ", ")
+	preflightMaxAge := strconv.Itoa(int(authConfig.CorsPreflightMaxAge.Seconds()))
+	classifyOrigin := newCorsOriginClassifier(authConfig, isCustomDomain)
 
 	return func(ctx *httpfx.Context) httpfx.Result {
 		headers := ctx.ResponseWriter.Header()
@@ -38,34 +108,24 @@ func CorsMiddlewareWithCustomDomains(
 			return ctx.Next()
 		}
 
-		// Check config-defined origins first (fast path)
-		allowed := slices.Contains(allowedOrigins, requestOrigin)
-
-		// If not in config list, check custom domains in database
-		if !allowed {
-			domain := extractDomainFromOrigin(requestOrigin, true) // strip www. for DB lookup
-			if domain != "" {
-				// GetByCustomDomain is cached at repository layer
-				profile, _, _ := profileService.GetByCustomDomain(
-					ctx.Request.Context(),
-					"en", // locale doesn't matter for domain check
-					domain,
-				)
-				if profile != nil {
-					allowed = true
-				}
-			}
-		}
+		originClass := classifyOrigin(ctx.Request.Context(), requestOrigin)
 
-		if allowed {
+		if originClass != corsOriginDenied {
 			headers.Set("Access-Control-Allow-Origin", requestOrigin)
-			headers.Set("Access-Control-Allow-Credentials", "true")
 			headers.Set("Access-Control-Allow-Headers", allowedHeaders)
 			headers.Set("Access-Control-Allow-Methods", allowedMethods)
+
+			if originClass.credentialed() {
+				headers.Set("Access-Control-Allow-Credentials", "true")
+			}
 		}
 
 		// Handle preflight
 		if ctx.Request.Method == http.MethodOptions {
+			if originClass != corsOriginDenied && authConfig.CorsPreflightMaxAge > 0 {
+				headers.Set("Access-Control-Max-Age", preflightMaxAge)
+			}
+
 			return ctx.Results.Ok()
 		}
 
@@ -73,19 +133,82 @@ func CorsMiddlewareWithCustomDomains(
 	}
 }
 
+// newCorsOriginClassifier returns the function that classifies request origins.
+func newCorsOriginClassifier(
+	authConfig *auth.Config,
+	isCustomDomain CustomDomainChecker,
+) func(ctx context.Context, requestOrigin string) corsOriginClass {
+	allowedOrigins := authConfig.GetCorsAllowedOrigins()
+	publicOrigins := authConfig.GetCorsPublicOrigins()
+	allowAnyPublicOrigin := slices.Contains(publicOrigins, "*")
+
+	return func(ctx context.Context, requestOrigin string) corsOriginClass {
+		// Check config-defined origins first (fast path)
+		if slices.Contains(allowedOrigins, requestOrigin) {
+			return corsOriginFirstParty
+		}
+
+		// If not in config list, check custom domains in database
+		// The lookup resolves a www host to its apex domain when it serves the alias
+		domain := extractDomainFromOrigin(requestOrigin)
+		if domain != "" && isCustomDomain(ctx, domain) {
+			return corsOriginCustomDomain
+		}
+
+		if allowAnyPublicOrigin || slices.Contains(publicOrigins, requestOrigin) {
+			return corsOriginPublic
+		}
+
+		return corsOriginDenied
+	}
+}
+
+// NewCorsOriginResolver returns a function that explains how the CORS
+// middleware treats an origin, using the same classification.
+func NewCorsOriginResolver(
+	authConfig *auth.Config,
+	isCustomDomain CustomDomainChecker,
+) func(ctx context.Context, origin string) CorsOriginResolution {
+	classifyOrigin := newCorsOriginClassifier(authConfig, isCustomDomain)
+
+	return func(ctx context.Context, origin string) CorsOriginResolution {
+		originClass := classifyOrigin(ctx, origin)
+		domain := extractDomainFromOrigin(origin)
+
+		var reason string
+
+		switch originClass {
+		case corsOriginFirstParty:
+			reason = "origin is listed in the allowed origins config"
+		case corsOriginCustomDomain:
+			reason = "domain is a custom domain of a profile"
+		case corsOriginPublic:
+			reason = "origin is allowed as a public origin, without credentials"
+		case corsOriginDenied:
+			reason = "origin is not in the config and its domain is not a custom domain"
+			if domain == "" {
+				reason = "origin has no domain"
+			}
+		}
+
+		return CorsOriginResolution{
+			Origin:       origin,
+			Domain:       domain,
+			Match:        originClass.String(),
+			Reason:       reason,
+			Allowed:      originClass != corsOriginDenied,
+			Credentialed: originClass.credentialed(),
+		}
+	}
+}
+
 // extractDomainFromOrigin extracts domain from origin URL.
-// e.g., "https://eser.dev:443" -> "eser.dev"
-// If stripWWW is true, "www." prefix is removed.
-func extractDomainFromOrigin(origin string, stripWWW bool) string {
+// e.g., "https://www.eser.dev:443" -> "www.eser.dev"
+func extractDomainFromOrigin(origin string) string {
 	parsed, err := url.Parse(origin)
 	if err != nil {
 		return ""
 	}
 
-	host := parsed.Hostname() // strips port
-	if stripWWW {
-		return strings.TrimPrefix(host, "www.")
-	}
-
-	return host
+	return parsed.Hostname() // strips port
 }
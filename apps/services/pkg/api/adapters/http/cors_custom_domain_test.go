@@ -0,0 +1,162 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// isCustomExampleDomain stands in for the profile service lookup, which serves
+// "custom.example" on both its apex and www hosts.
+func isCustomExampleDomain(_ context.Context, domain string) bool {
+	return domain == "custom.example" || domain == "www.custom.example"
+}
+
+func serveWithCors(
+	t *testing.T,
+	authConfig *auth.Config,
+	method string,
+	origin string,
+) *httptest.ResponseRecorder {
+	t.Helper()
+
+	router := httpfx.NewRouter("/")
+	router.Use(httpadapter.NewCorsMiddleware(authConfig, isCustomExampleDomain))
+
+	route := router.Route(method+" /test", func(ctx *httpfx.Context) httpfx.Result {
+		return ctx.Results.PlainText([]byte("ok"))
+	})
+	require.NotNil(t, route)
+
+	req := httptest.NewRequest(method, "/test", nil)
+	req.Header.Set("Origin", origin)
+
+	recorder := httptest.NewRecorder()
+	route.MuxHandlerFunc(recorder, req)
+
+	return recorder
+}
+
+func TestCorsMiddleware_OriginClasses(t *testing.T) {
+	t.Parallel()
+
+	authConfig := &auth.Config{ //nolint:exhaustruct
+		CorsAllowedOrigins:  "https://aya.is",
+		CorsPublicOrigins:   "https://public.example",
+		CorsAllowedHeaders:  "Content-Type",
+		CorsAllowedMethods:  "GET,POST",
+		CorsPreflightMaxAge: 10 * time.Minute,
+	}
+
+	tests := []struct {
+		name            string
+		origin          string
+		wantAllowed     bool
+		wantCredentials bool
+	}{
+		{name: "first-party origin", origin: "https://aya.is", wantAllowed: true, wantCredentials: true},
+		{name: "custom domain", origin: "https://custom.example", wantAllowed: true, wantCredentials: true},
+		{name: "custom domain www", origin: "https://www.custom.example", wantAllowed: true, wantCredentials: true},
+		{name: "public origin", origin: "https://public.example", wantAllowed: true, wantCredentials: false},
+		{name: "unknown origin", origin: "https://evil.example", wantAllowed: false, wantCredentials: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := serveWithCors(t, authConfig, http.MethodGet, tt.origin)
+			headers := recorder.Header()
+
+			if tt.wantAllowed {
+				assert.Equal(t, tt.origin, headers.Get("Access-Control-Allow-Origin"))
+			} else {
+				assert.Empty(t, headers.Get("Access-Control-Allow-Origin"))
+			}
+
+			if tt.wantCredentials {
+				assert.Equal(t, "true", headers.Get("Access-Control-Allow-Credentials"))
+			} else {
+				assert.Empty(t, headers.Get("Access-Control-Allow-Credentials"))
+			}
+		})
+	}
+}
+
+func TestCorsMiddleware_PublicWildcard(t *testing.T) {
+	t.Parallel()
+
+	authConfig := &auth.Config{ //nolint:exhaustruct
+		CorsAllowedOrigins: "https://aya.is",
+		CorsPublicOrigins:  "*",
+	}
+
+	recorder := serveWithCors(t, authConfig, http.MethodGet, "https://anyone.example")
+
+	assert.Equal(t, "https://anyone.example", recorder.Header().Get("Access-Control-Allow-Origin"))
+	assert.Empty(t, recorder.Header().Get("Access-Control-Allow-Credentials"))
+}
+
+func TestCorsMiddleware_PreflightMaxAge(t *testing.T) {
+	t.Parallel()
+
+	authConfig := &auth.Config{ //nolint:exhaustruct
+		CorsAllowedOrigins:  "https://aya.is",
+		CorsPreflightMaxAge: 10 * time.Minute,
+	}
+
+	recorder := serveWithCors(t, authConfig, http.MethodOptions, "https://aya.is")
+	assert.Equal(t, "600", recorder.Header().Get("Access-Control-Max-Age"))
+
+	recorder = serveWithCors(t, authConfig, http.MethodOptions, "https://evil.example")
+	assert.Empty(t, recorder.Header().Get("Access-Control-Max-Age"))
+}
+
+func TestCorsOriginResolver_MatchesMiddleware(t *testing.T) {
+	t.Parallel()
+
+	authConfig := &auth.Config{ //nolint:exhaustruct
+		CorsAllowedOrigins: "https://aya.is",
+		CorsPublicOrigins:  "https://public.example",
+	}
+
+	resolve := httpadapter.NewCorsOriginResolver(authConfig, isCustomExampleDomain)
+
+	tests := []struct {
+		origin           string
+		wantDomain       string
+		wantMatch        string
+		wantAllowed      bool
+		wantCredentialed bool
+	}{
+		{"https://aya.is", "aya.is", "first_party", true, true},
+		{"https://www.custom.example:443", "www.custom.example", "custom_domain", true, true},
+		{"https://custom.example", "custom.example", "custom_domain", true, true},
+		{"https://public.example", "public.example", "public", true, false},
+		{"https://evil.example", "evil.example", "denied", false, false},
+		{"not an origin", "", "denied", false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.origin, func(t *testing.T) {
+			t.Parallel()
+
+			resolution := resolve(t.Context(), tt.origin)
+
+			assert.Equal(t, tt.origin, resolution.Origin)
+			assert.Equal(t, tt.wantDomain, resolution.Domain)
+			assert.Equal(t, tt.wantMatch, resolution.Match)
+			assert.Equal(t, tt.wantAllowed, resolution.Allowed)
+			assert.Equal(t, tt.wantCredentialed, resolution.Credentialed)
+			assert.NotEmpty(t, resolution.Reason)
+		})
+	}
+}
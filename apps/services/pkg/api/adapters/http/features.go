@@ -0,0 +1,19 @@
+package http
+
+// Features toggles optional route modules. Disabled modules don't register
+// their endpoints at all, so requests to them get a 404 instead of reaching a
+// half-working handler. This makes it safe to ship a feature dark.
+type Features struct {
+	AI        bool // AI generation and auto-translation endpoints
+	Contact   bool // profile envelope (contact) endpoints
+	Analytics bool // profile visit recording
+}
+
+// AllFeatures returns a Features value with every module enabled.
+func AllFeatures() Features {
+	return Features{
+		AI:        true,
+		Contact:   true,
+		Analytics: true,
+	}
+}
@@ -0,0 +1,109 @@
+package http_test
+
+import (
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	generateCVRoute    = "POST /{locale}/profiles/{slug}/_pages/generate-cv"
+	autoTranslateRoute = "POST /{locale}/profiles/{slug}/_pages/{pageId}/translations/{targetLocale}/auto-translate"
+	profileRoute       = "GET /{locale}/profiles/{slug}"
+
+	storyAutoTranslateRoute = "POST /{locale}/profiles/{slug}/_stories/{storyId}/translations/{targetLocale}/auto-translate"
+	storiesRoute            = "GET /{locale}/stories"
+)
+
+func registeredProfileRoutes(features httpadapter.Features) map[string]bool {
+	routes := httpfx.NewRouter("/")
+
+	httpadapter.RegisterHTTPRoutesForProfiles(
+		routes,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		features,
+	)
+
+	patterns := make(map[string]bool)
+	for _, route := range routes.GetRoutes() {
+		patterns[route.Pattern.Str] = true
+	}
+
+	return patterns
+}
+
+func TestRegisterHTTPRoutesForProfiles_FeatureGating(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all features enabled", func(t *testing.T) {
+		t.Parallel()
+
+		patterns := registeredProfileRoutes(httpadapter.AllFeatures())
+
+		assert.True(t, patterns[profileRoute])
+		assert.True(t, patterns[generateCVRoute])
+		assert.True(t, patterns[autoTranslateRoute])
+	})
+
+	t.Run("AI disabled", func(t *testing.T) {
+		t.Parallel()
+
+		features := httpadapter.AllFeatures()
+		features.AI = false
+
+		patterns := registeredProfileRoutes(features)
+
+		assert.True(t, patterns[profileRoute])
+		assert.False(t, patterns[generateCVRoute])
+		assert.False(t, patterns[autoTranslateRoute])
+	})
+}
+
+func registeredStoryRoutes(features httpadapter.Features) map[string]bool {
+	routes := httpfx.NewRouter("/")
+
+	httpadapter.RegisterHTTPRoutesForStories(routes, nil, nil, nil, nil, nil, nil, nil, features)
+
+	patterns := make(map[string]bool)
+	for _, route := range routes.GetRoutes() {
+		patterns[route.Pattern.Str] = true
+	}
+
+	return patterns
+}
+
+func TestRegisterHTTPRoutesForStories_FeatureGating(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all features enabled", func(t *testing.T) {
+		t.Parallel()
+
+		patterns := registeredStoryRoutes(httpadapter.AllFeatures())
+
+		assert.True(t, patterns[storiesRoute])
+		assert.True(t, patterns[storyAutoTranslateRoute])
+	})
+
+	t.Run("AI disabled", func(t *testing.T) {
+		t.Parallel()
+
+		features := httpadapter.AllFeatures()
+		features.AI = false
+
+		patterns := registeredStoryRoutes(features)
+
+		assert.True(t, patterns[storiesRoute])
+		assert.False(t, patterns[storyAutoTranslateRoute])
+	})
+}
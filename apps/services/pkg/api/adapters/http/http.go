@@ -12,13 +12,16 @@ import (
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
 	mcpadapter "github.com/eser/aya.is/services/pkg/api/adapters/mcp"
+	"github.com/eser/aya.is/services/pkg/api/adapters/providerbudget"
 	telegramadapter "github.com/eser/aya.is/services/pkg/api/adapters/telegram"
 	"github.com/eser/aya.is/services/pkg/api/adapters/unsplash"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	bulletinbiz "github.com/eser/aya.is/services/pkg/api/business/bulletin"
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
 	"github.com/eser/aya.is/services/pkg/api/business/discussions"
 	"github.com/eser/aya.is/services/pkg/api/business/events"
 	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
@@ -32,6 +35,8 @@ import (
 	telegrambiz "github.com/eser/aya.is/services/pkg/api/business/telegram"
 	"github.com/eser/aya.is/services/pkg/api/business/uploads"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
 )
 
 // TelegramProviders holds Telegram bot components (nil when Telegram is disabled).
@@ -47,6 +52,7 @@ func Run( //nolint:funlen
 	config *httpfx.Config,
 	logger *logfx.Logger,
 	discloseErrors bool,
+	features Features,
 	authService *auth.Service,
 	userService *users.Service,
 	profileService *profiles.Service,
@@ -67,8 +73,14 @@ func Run( //nolint:funlen
 	aiModels *aifx.Registry,
 	runtimeStatesService *runtime_states.Service,
 	workerRegistry *workerfx.Registry,
+	providerBudgets *providerbudget.Budgets,
 	auditService *events.AuditService,
 	bulletinService *bulletinbiz.Service,
+	profileMentionService *profile_mentions.Service,
+	webhookService *webhooks.Service,
+	consistencyService *consistency.Service,
+	webmentionService *webmentions.Service,
+	webmentionConfig *webmentions.Config,
 ) (func(), error) {
 	httpfx.SetDiscloseErrors(discloseErrors)
 
@@ -87,6 +99,8 @@ func Run( //nolint:funlen
 		CorsMiddlewareWithCustomDomains(authService.Config, profileService), //nolint:contextcheck
 	)
 	routes.Use(middlewares.MetricsMiddleware(httpService.InnerMetrics)) //nolint:contextcheck
+	routes.Use(ProfileSlugRedirectMiddleware(profileService))
+	routes.Use(SupportedLocalesMiddleware(profileService))
 
 	// mcp adapter (must be registered before OPTIONS wildcard to avoid pattern conflict)
 	mcpadapter.RegisterMCPRoutes(routes, profileService, storyService, storySeriesService)
@@ -136,6 +150,23 @@ func Run( //nolint:funlen
 		uploadService,
 		unsplashClient,
 	)
+	RegisterHTTPRoutesForTools( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		unsplashClient,
+	)
+
+	aiCapabilities := NewAICapabilities(nil)
+	if features.AI {
+		aiCapabilities = NewAICapabilities(aiModels)
+	}
+
+	RegisterHTTPRoutesForMeta( //nolint:contextcheck
+		routes,
+		aiCapabilities,
+	)
 	RegisterHTTPRoutesForProfiles( //nolint:contextcheck
 		routes,
 		logger,
@@ -147,6 +178,8 @@ func Run( //nolint:funlen
 		aiModels,
 		bulletinService,
 		auditService,
+		profileMentionService,
+		features,
 	)
 	RegisterHTTPRoutesForProfilePoints( //nolint:contextcheck
 		routes,
@@ -164,6 +197,12 @@ func Run( //nolint:funlen
 		storyService,
 		profilePointsService,
 		aiModels,
+		profileMentionService,
+		features,
+	)
+	RegisterHTTPRoutesForProfileMentions( //nolint:contextcheck
+		routes,
+		profileMentionService,
 	)
 	// Share Wizard AI routes are temporarily disabled — they will be enabled
 	// once profile points integration is in place to avoid spending AI credits.
@@ -196,6 +235,21 @@ func Run( //nolint:funlen
 		baseURI,
 		auditService,
 	)
+	RegisterHTTPRoutesForProfileResync( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+		profileLinkProviders.LinkSync,
+	)
+	RegisterHTTPRoutesForProfileLinkClicks( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
 	RegisterHTTPRoutesForProfileResources( //nolint:contextcheck
 		routes,
 		logger,
@@ -204,6 +258,13 @@ func Run( //nolint:funlen
 		profileService,
 		profileLinkProviders,
 	)
+	RegisterHTTPRoutesForProfileDomains( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
 	RegisterHTTPRoutesForProfileMemberships( //nolint:contextcheck
 		routes,
 		logger,
@@ -211,6 +272,42 @@ func Run( //nolint:funlen
 		userService,
 		profileService,
 	)
+	RegisterHTTPRoutesForProfileWebhooks( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+		webhookService,
+	)
+	RegisterHTTPRoutesForProfileWebmentions( //nolint:contextcheck
+		routes,
+		logger,
+		webmentionConfig,
+		webmentionService,
+	)
+	RegisterHTTPRoutesForProfileTranslations( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
+	RegisterHTTPRoutesForProfileBlocks( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
+	RegisterHTTPRoutesForProfileReports( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+		protectionService,
+	)
 	RegisterHTTPRoutesForProfileTeams( //nolint:contextcheck
 		routes,
 		logger,
@@ -225,6 +322,7 @@ func Run( //nolint:funlen
 		userService,
 		profileService,
 		mailboxService,
+		protectionService,
 	)
 	RegisterHTTPRoutesForProfileQuestions( //nolint:contextcheck
 		routes,
@@ -248,15 +346,18 @@ func Run( //nolint:funlen
 		telegramServiceForEnvelopes = telegramProviders.Service
 	}
 
-	RegisterHTTPRoutesForProfileEnvelopes( //nolint:contextcheck
-		routes,
-		logger,
-		authService,
-		userService,
-		profileService,
-		mailboxService,
-		telegramServiceForEnvelopes,
-	)
+	if features.Contact {
+		RegisterHTTPRoutesForProfileEnvelopes( //nolint:contextcheck
+			routes,
+			logger,
+			authService,
+			userService,
+			profileService,
+			mailboxService,
+			telegramServiceForEnvelopes,
+			protectionService,
+		)
+	}
 	RegisterHTTPRoutesForMailbox( //nolint:contextcheck
 		routes,
 		logger,
@@ -311,6 +412,61 @@ func Run( //nolint:funlen
 		userService,
 		runtimeStatesService,
 		workerRegistry,
+		providerBudgets,
+	)
+	RegisterHTTPRoutesForAdminWebhooks( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		webhookService,
+	)
+	RegisterHTTPRoutesForAdminImpersonation( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+	)
+	RegisterHTTPRoutesForAdminUsers( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+	)
+	RegisterHTTPRoutesForAdminConsistency( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		consistencyService,
+	)
+	RegisterHTTPRoutesForAdminAudit( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		auditService,
+	)
+	RegisterHTTPRoutesForAdminHandles( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
+	RegisterHTTPRoutesForAdminReports( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
+	RegisterHTTPRoutesForAdminCustomDomains( //nolint:contextcheck
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
 	)
 
 	if bulletinService != nil {
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"net/url"
 	"strings"
 
@@ -9,13 +10,38 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 )
 
+// ContextKeySupportedLocales holds the set of locales the platform serves.
+const ContextKeySupportedLocales httpfx.ContextKey = "supported_locales"
+
+// SupportedLocalesMiddleware makes validateLocale check requests against the
+// locales the profile service was configured with.
+func SupportedLocalesMiddleware(profileService *profiles.Service) httpfx.Handler {
+	supportedLocales := profileService.SupportedLocaleCodes()
+
+	return func(ctx *httpfx.Context) httpfx.Result {
+		ctx.UpdateContext(context.WithValue(
+			ctx.Request.Context(),
+			ContextKeySupportedLocales,
+			supportedLocales,
+		))
+
+		return ctx.Next()
+	}
+}
+
 // validateLocale extracts the locale path parameter and validates it against
-// the list of supported locales. Returns the locale string and true if valid,
-// or an empty string and false with a BadRequest result if invalid.
+// the list of supported locales, the default ones unless the request went
+// through SupportedLocalesMiddleware. Returns the locale string and true if
+// valid, or an empty string and false with a BadRequest result if invalid.
 func validateLocale(ctx *httpfx.Context) (string, bool) {
 	locale := ctx.Request.PathValue("locale")
 
-	if !profiles.IsValidLocale(locale) {
+	supportedLocales, ok := ctx.Request.Context().Value(ContextKeySupportedLocales).(map[string]bool)
+	if !ok {
+		supportedLocales = profiles.DefaultSupportedLocaleCodes()
+	}
+
+	if !supportedLocales[locale] {
 		return "", false
 	}
 
@@ -0,0 +1,68 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportedLocalesMiddleware_UsesConfiguredLocales(t *testing.T) {
+	t.Parallel()
+
+	profileService := profiles.NewService(
+		nil,
+		&profiles.Config{SupportedLocales: "en,tr"}, //nolint:exhaustruct
+		nil,
+		nil,
+	)
+
+	config := &protection.Config{ //nolint:exhaustruct
+		Captcha: protection.CaptchaConfig{Endpoints: "report"},
+	}
+	protectionService := protection.NewService(nil, config, nil, nil)
+
+	routes := httpfx.NewRouter("/")
+	routes.Use(httpadapter.SupportedLocalesMiddleware(profileService))
+	httpadapter.RegisterHTTPRoutesForProfileReports(routes, nil, nil, nil, nil, protectionService)
+
+	// A supported locale gets past the locale check to the CAPTCHA check.
+	tests := map[string]struct {
+		locale   string
+		expected string
+	}{
+		"configured locale": {
+			locale:   "tr",
+			expected: `{"error":"CAPTCHA token is required","code":"captcha_required"}`,
+		},
+		"default locale left out of the config": {
+			locale:   "fr",
+			expected: `{"error":"unsupported locale"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodPost,
+				"/"+tt.locale+"/profiles/acme/_report",
+				strings.NewReader(`{"reason":"spam"}`),
+			)
+
+			rec := httptest.NewRecorder()
+			routes.GetMux().ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.JSONEq(t, tt.expected, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/i18nfx"
+)
+
+const (
+	formatQueryParam      = "format"
+	formatLocalized       = "localized"
+	localizedFieldSuffix  = "_localized"
+	localizedDirectionKey = "dir"
+)
+
+// localizedTimestampKeys are the timestamp fields that get a localized display
+// string when a response is requested with ?format=localized.
+var localizedTimestampKeys = map[string]bool{ //nolint:gochecknoglobals
+	"created_at":   true,
+	"updated_at":   true,
+	"published_at": true,
+}
+
+// wantsLocalizedFormat reports whether the request opted into localized display strings.
+func wantsLocalizedFormat(ctx *httpfx.Context) bool {
+	return ctx.Request.URL.Query().Get(formatQueryParam) == formatLocalized
+}
+
+// jsonWithOptionalLocalizedFormat responds with the response as is. When the request
+// opted in, each key timestamp gets a "<key>_localized" display string next to its
+// ISO value, and the response carries the text direction of the locale.
+func jsonWithOptionalLocalizedFormat(
+	ctx *httpfx.Context,
+	localeCode string,
+	response any,
+) httpfx.Result {
+	if !wantsLocalizedFormat(ctx) {
+		return ctx.Results.JSON(response)
+	}
+
+	localized, err := WithLocalizedTimestamps(response, localeCode)
+	if err != nil {
+		return ctx.Results.JSON(response)
+	}
+
+	localized[localizedDirectionKey] = i18nfx.Dir(localeCode)
+
+	return ctx.Results.JSON(localized)
+}
+
+// WithLocalizedTimestamps returns a copy of the JSON form of value where every
+// key timestamp, at any depth, has a localized display string alongside it.
+func WithLocalizedTimestamps(value any, localeCode string) (map[string]any, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode response: %w", err)
+	}
+
+	var decoded map[string]any
+
+	err = json.Unmarshal(encoded, &decoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	addLocalizedTimestamps(decoded, localeCode)
+
+	return decoded, nil
+}
+
+func addLocalizedTimestamps(node any, localeCode string) {
+	switch typed := node.(type) {
+	case map[string]any:
+		for key, child := range typed {
+			if localizedTimestampKeys[key] {
+				if raw, ok := child.(string); ok {
+					parsed, err := time.Parse(time.RFC3339, raw)
+					if err == nil {
+						typed[key+localizedFieldSuffix] = i18nfx.FormatDateTime(parsed, localeCode)
+					}
+				}
+
+				continue
+			}
+
+			addLocalizedTimestamps(child, localeCode)
+		}
+	case []any:
+		for _, child := range typed {
+			addLocalizedTimestamps(child, localeCode)
+		}
+	}
+}
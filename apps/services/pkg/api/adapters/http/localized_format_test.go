@@ -0,0 +1,89 @@
+package http_test
+
+import (
+	"testing"
+	"time"
+
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/stories"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithLocalizedTimestamps(t *testing.T) {
+	t.Parallel()
+
+	publishedAt := time.Date(2026, time.October, 7, 15, 4, 0, 0, time.UTC)
+	record := map[string]any{
+		"id":           "story-1",
+		"created_at":   publishedAt,
+		"published_at": publishedAt,
+		"updated_at":   nil,
+		"author": map[string]any{
+			"created_at": publishedAt,
+		},
+		"publications": []map[string]any{
+			{"published_at": publishedAt},
+		},
+	}
+
+	tests := map[string]struct {
+		locale   string
+		expected string
+	}{
+		"english": {locale: "en", expected: "Oct 7, 2026, 3:04 PM"},
+		"turkish": {locale: "tr", expected: "7 Eki 2026 15:04"},
+		"arabic":  {locale: "ar", expected: "٧‏/١٠‏/٢٠٢٦، ٣:٠٤ م"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			localized, err := httpadapter.WithLocalizedTimestamps(
+				cursors.WrapResponseWithCursor(record, nil),
+				tt.locale,
+			)
+			require.NoError(t, err)
+
+			data, ok := localized["data"].(map[string]any)
+			require.True(t, ok)
+
+			// The ISO value is kept for machine use.
+			assert.Equal(t, "2026-10-07T15:04:00Z", data["published_at"])
+			assert.Equal(t, tt.expected, data["published_at_localized"])
+			assert.Equal(t, tt.expected, data["created_at_localized"])
+			assert.NotContains(t, data, "updated_at_localized")
+			assert.NotContains(t, data, "id_localized")
+
+			author, ok := data["author"].(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, author["created_at_localized"])
+
+			publications, ok := data["publications"].([]any)
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, publications[0].(map[string]any)["published_at_localized"])
+		})
+	}
+}
+
+func TestWithLocalizedTimestamps_Story(t *testing.T) {
+	t.Parallel()
+
+	publishedAt := time.Date(2026, time.October, 7, 15, 4, 0, 0, time.UTC)
+	story := &stories.Story{ //nolint:exhaustruct
+		ID:        "story-1",
+		CreatedAt: publishedAt,
+	}
+
+	localized, err := httpadapter.WithLocalizedTimestamps(
+		cursors.WrapResponseWithCursor(story, nil),
+		"ja",
+	)
+	require.NoError(t, err)
+
+	data, ok := localized["data"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "2026/10/07 15:04", data["created_at_localized"])
+}
@@ -0,0 +1,163 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+// ErrorCode is a stable, machine-readable identifier sent next to the error
+// message, so that clients can branch on the kind of failure instead of
+// parsing a message meant for display.
+type ErrorCode string
+
+const (
+	ErrorCodeProfileNotFound            ErrorCode = "profile_not_found"
+	ErrorCodeInsufficientAccess         ErrorCode = "insufficient_access"
+	ErrorCodeInvalidInput               ErrorCode = "invalid_input"
+	ErrorCodeInvalidCursor              ErrorCode = "invalid_cursor"
+	ErrorCodeSlugTaken                  ErrorCode = "slug_taken"
+	ErrorCodeSlugReserved               ErrorCode = "slug_reserved"
+	ErrorCodeFeatureDisabled            ErrorCode = "feature_disabled"
+	ErrorCodeLocaleNotFound             ErrorCode = "locale_not_found"
+	ErrorCodeDefaultLocaleProtected     ErrorCode = "default_locale_protected"
+	ErrorCodeDefaultLocaleNotTranslated ErrorCode = "default_locale_not_translated"
+	ErrorCodeTranslationLocaleLimit     ErrorCode = "translation_locale_limit"
+	ErrorCodeProfileStillLinked         ErrorCode = "profile_still_linked"
+	ErrorCodeRateLimited                ErrorCode = "rate_limited"
+	ErrorCodeContentTooLong             ErrorCode = "content_too_long"
+	ErrorCodeReportTargetNotFound       ErrorCode = "report_target_not_found"
+	ErrorCodeReportNotFound             ErrorCode = "report_not_found"
+	ErrorCodeCaptchaRequired            ErrorCode = "captcha_required"
+	ErrorCodeCaptchaInvalid             ErrorCode = "captcha_invalid"
+	ErrorCodeInternal                   ErrorCode = "internal_error"
+)
+
+// profileErrorMapping ties a profiles sentinel error to its response. An empty
+// message sends the sanitized error instead.
+type profileErrorMapping struct {
+	target  error
+	code    ErrorCode
+	message string
+	status  int
+}
+
+// profileErrorMappings is checked in order; the first sentinel the error wraps wins.
+var profileErrorMappings = []profileErrorMapping{ //nolint:gochecknoglobals
+	{profiles.ErrProfileNotFound, ErrorCodeProfileNotFound, "profile not found", http.StatusNotFound},
+	{
+		profiles.ErrInsufficientAccess,
+		ErrorCodeInsufficientAccess,
+		"You do not have permission to edit this profile",
+		http.StatusForbidden,
+	},
+	{
+		profiles.ErrUnauthorized,
+		ErrorCodeInsufficientAccess,
+		"You do not have permission to edit this profile",
+		http.StatusForbidden,
+	},
+	{profiles.ErrInvalidInput, ErrorCodeInvalidInput, "", http.StatusBadRequest},
+	{profiles.ErrInvalidURI, ErrorCodeInvalidInput, "", http.StatusBadRequest},
+	{profiles.ErrInvalidLeaderboardCursor, ErrorCodeInvalidCursor, "invalid cursor", http.StatusBadRequest},
+	{profiles.ErrInvalidLeaderboardKind, ErrorCodeInvalidInput, "filter_kind is invalid", http.StatusBadRequest},
+	{profiles.ErrHandleReserved, ErrorCodeSlugReserved, "Slug is reserved", http.StatusBadRequest},
+	{profiles.ErrSlugUnavailable, ErrorCodeSlugTaken, "", http.StatusConflict},
+	{
+		profiles.ErrLinksNotEnabled,
+		ErrorCodeFeatureDisabled,
+		"links feature is not enabled for this profile",
+		http.StatusNotFound,
+	},
+	{
+		profiles.ErrRelationsNotEnabled,
+		ErrorCodeFeatureDisabled,
+		"relations feature is not enabled for this profile",
+		http.StatusNotFound,
+	},
+	{profiles.ErrLocaleNotFound, ErrorCodeLocaleNotFound, "", http.StatusNotFound},
+	{profiles.ErrCannotDeleteDefaultLocale, ErrorCodeDefaultLocaleProtected, "", http.StatusConflict},
+	{
+		profiles.ErrDefaultLocaleNotTranslated,
+		ErrorCodeDefaultLocaleNotTranslated,
+		"",
+		http.StatusUnprocessableEntity,
+	},
+	{
+		profiles.ErrTooManyTranslationLocales,
+		ErrorCodeTranslationLocaleLimit,
+		"Translation locale limit reached",
+		http.StatusUnprocessableEntity,
+	},
+	{profiles.ErrProfileStillLinked, ErrorCodeProfileStillLinked, "", http.StatusConflict},
+	{
+		profiles.ErrRateLimited,
+		ErrorCodeRateLimited,
+		"Too many AI requests, try again later",
+		http.StatusTooManyRequests,
+	},
+	{
+		profiles.ErrContentTooLong,
+		ErrorCodeContentTooLong,
+		"Content is too long for auto-translation",
+		http.StatusUnprocessableEntity,
+	},
+	{
+		profiles.ErrReportTargetNotFound,
+		ErrorCodeReportTargetNotFound,
+		"reported content not found",
+		http.StatusNotFound,
+	},
+	{profiles.ErrProfileReportNotFound, ErrorCodeReportNotFound, "", http.StatusNotFound},
+	{
+		profiles.ErrTooManyReports,
+		ErrorCodeRateLimited,
+		"Too many reports, try again later",
+		http.StatusTooManyRequests,
+	},
+}
+
+// ProfileErrorCode returns the code and HTTP status a profiles error is
+// answered with. Errors without a mapped sentinel are internal errors.
+func ProfileErrorCode(err error) (ErrorCode, int) {
+	mapping := findProfileErrorMapping(err)
+	if mapping == nil {
+		return ErrorCodeInternal, http.StatusInternalServerError
+	}
+
+	return mapping.code, mapping.status
+}
+
+// profileErrorResult answers a profiles error with the status, code and
+// message mapped to its sentinel. ok is false for unmapped errors, which the
+// caller logs and answers itself.
+func profileErrorResult(ctx *httpfx.Context, err error) (httpfx.Result, bool) {
+	mapping := findProfileErrorMapping(err)
+	if mapping == nil {
+		return httpfx.Result{}, false //nolint:exhaustruct
+	}
+
+	if mapping.message == "" {
+		return ctx.Results.Error(
+			mapping.status,
+			httpfx.WithSanitizedErrorCode(string(mapping.code), err),
+		), true
+	}
+
+	return ctx.Results.Error(
+		mapping.status,
+		httpfx.WithErrorCode(string(mapping.code), mapping.message),
+	), true
+}
+
+func findProfileErrorMapping(err error) *profileErrorMapping {
+	for i := range profileErrorMappings {
+		if errors.Is(err, profileErrorMappings[i].target) {
+			return &profileErrorMappings[i]
+		}
+	}
+
+	return nil
+}
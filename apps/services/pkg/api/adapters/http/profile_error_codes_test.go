@@ -0,0 +1,54 @@
+package http_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileErrorCode(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err    error
+		code   httpadapter.ErrorCode
+		status int
+	}{
+		"insufficient access": {
+			err:    fmt.Errorf("%w: user is not a maintainer", profiles.ErrInsufficientAccess),
+			code:   "insufficient_access",
+			status: http.StatusForbidden,
+		},
+		"profile not found": {
+			err:    fmt.Errorf("%w(slug: ghost)", profiles.ErrProfileNotFound),
+			code:   "profile_not_found",
+			status: http.StatusNotFound,
+		},
+		"AI rate limit": {
+			err:    fmt.Errorf("%w(profile_id: acme)", profiles.ErrRateLimited),
+			code:   "rate_limited",
+			status: http.StatusTooManyRequests,
+		},
+		"unmapped error": {
+			err:    errors.New("connection reset"), //nolint:err113
+			code:   "internal_error",
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			code, status := httpadapter.ProfileErrorCode(test.err)
+
+			assert.Equal(t, test.code, code)
+			assert.Equal(t, test.status, status)
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+)
+
+// sparseProfileRepository knows a single profile, "untranslated", which has no
+// translation in any locale. Every other slug does not exist.
+type sparseProfileRepository struct {
+	profiles.Repository
+}
+
+func (r *sparseProfileRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug == "untranslated" {
+		return "untranslated-profile", nil
+	}
+
+	return "", nil
+}
+
+func (r *sparseProfileRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	_ string,
+) (*profiles.Profile, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func TestProfileRoutes_MissingProfileReturnsNotFound(t *testing.T) {
+	t.Parallel()
+
+	routes := httpfx.NewRouter("/")
+	profileService := profiles.NewService(nil, nil, &sparseProfileRepository{}, nil) //nolint:exhaustruct
+
+	httpadapter.RegisterHTTPRoutesForProfiles(
+		routes,
+		nil,
+		nil,
+		nil,
+		profileService,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		httpadapter.AllFeatures(),
+	)
+
+	tests := map[string]string{
+		"unknown slug":             "/tr/profiles/does-not-exist",
+		"profile with no locale":   "/tr/profiles/untranslated",
+		"page slug check on ghost": "/en/profiles/does-not-exist/pages/about/_check",
+	}
+
+	for name, path := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+			routes.GetMux().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+			assert.JSONEq(t, `{"error":"profile not found","code":"profile_not_found"}`, recorder.Body.String())
+		})
+	}
+}
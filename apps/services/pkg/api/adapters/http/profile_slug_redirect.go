@@ -0,0 +1,73 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+// ProfileSlugRedirectMiddleware answers a GET or HEAD request that found no
+// profile at /profiles/{slug} with a permanent redirect, when the slug is one
+// the profile used before it was renamed.
+func ProfileSlugRedirectMiddleware(profileService *profiles.Service) httpfx.Handler {
+	return func(ctx *httpfx.Context) httpfx.Result {
+		result := ctx.Next()
+
+		if result.StatusCode() != http.StatusNotFound ||
+			(ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead) {
+			return result
+		}
+
+		slug := ctx.Request.PathValue("slug")
+		if slug == "" {
+			return result
+		}
+
+		segments := strings.Split(ctx.Request.URL.Path, "/")
+		slugIndex := profileSlugSegmentIndex(segments, slug)
+
+		if slugIndex == -1 {
+			return result
+		}
+
+		currentSlug, err := profileService.GetProfileSlugRedirect(ctx.Request.Context(), slug)
+		if err != nil {
+			slog.WarnContext(ctx.Request.Context(), "Profile slug redirect lookup failed",
+				slog.String("error", err.Error()),
+				slog.String("slug", slug))
+
+			return result
+		}
+
+		if currentSlug == "" {
+			return result
+		}
+
+		segments[slugIndex] = currentSlug
+
+		location := strings.Join(segments, "/")
+		if ctx.Request.URL.RawQuery != "" {
+			location += "?" + ctx.Request.URL.RawQuery
+		}
+
+		redirect := ctx.Results.Redirect(location)
+		redirect.InnerStatusCode = http.StatusMovedPermanently
+
+		return redirect
+	}
+}
+
+// profileSlugSegmentIndex returns the index of the path segment holding the
+// profile slug, the one right after "profiles", or -1 when there is none.
+func profileSlugSegmentIndex(segments []string, slug string) int {
+	for i := 1; i < len(segments); i++ {
+		if segments[i-1] == "profiles" && segments[i] == slug {
+			return i
+		}
+	}
+
+	return -1
+}
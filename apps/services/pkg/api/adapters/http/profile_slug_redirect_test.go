@@ -0,0 +1,92 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	httpadapter "github.com/eser/aya.is/services/pkg/api/adapters/http"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+)
+
+// renamedProfileRepository knows that the profile now at "acme-corp" was
+// called "acme" before.
+type renamedProfileRepository struct {
+	profiles.Repository
+}
+
+func (r *renamedProfileRepository) GetCurrentSlugByPreviousSlug(
+	_ context.Context,
+	slug string,
+) (string, error) {
+	if slug == "acme" {
+		return "acme-corp", nil
+	}
+
+	return "", nil
+}
+
+func TestProfileSlugRedirectMiddleware(t *testing.T) {
+	t.Parallel()
+
+	profileService := profiles.NewService(nil, nil, &renamedProfileRepository{}, nil) //nolint:exhaustruct
+
+	routes := httpfx.NewRouter("/")
+	routes.Use(httpadapter.ProfileSlugRedirectMiddleware(profileService))
+
+	notFound := func(ctx *httpfx.Context) httpfx.Result {
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+	}
+
+	routes.Route("GET /{locale}/profiles/{slug}", notFound)
+	routes.Route("GET /{locale}/profiles/{slug}/pages/{pageSlug}", notFound)
+	routes.Route("POST /{locale}/profiles/{slug}/_follow", notFound)
+	routes.Route("GET /{locale}/stories/{slug}", notFound)
+
+	tests := map[string]struct {
+		method   string
+		path     string
+		location string
+	}{
+		"profile": {
+			method:   http.MethodGet,
+			path:     "/en/profiles/acme",
+			location: "/en/profiles/acme-corp",
+		},
+		"keeps the query": {
+			method:   http.MethodGet,
+			path:     "/en/profiles/acme?tab=pages",
+			location: "/en/profiles/acme-corp?tab=pages",
+		},
+		"nested page": {
+			method:   http.MethodGet,
+			path:     "/tr/profiles/acme/pages/acme",
+			location: "/tr/profiles/acme-corp/pages/acme",
+		},
+		"never used slug":      {method: http.MethodGet, path: "/en/profiles/initech"},
+		"write request":        {method: http.MethodPost, path: "/en/profiles/acme/_follow"},
+		"story with same slug": {method: http.MethodGet, path: "/en/stories/acme"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+			routes.GetMux().ServeHTTP(recorder, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if tt.location == "" {
+				assert.Equal(t, http.StatusNotFound, recorder.Code)
+				assert.Empty(t, recorder.Header().Get("Location"))
+
+				return
+			}
+
+			assert.Equal(t, http.StatusMovedPermanently, recorder.Code)
+			assert.Equal(t, tt.location, recorder.Header().Get("Location"))
+		})
+	}
+}
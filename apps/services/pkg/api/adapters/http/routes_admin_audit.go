@@ -0,0 +1,199 @@
+package http
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+const (
+	// auditExportWriteTimeout bounds how long a single export may keep streaming.
+	auditExportWriteTimeout = 30 * time.Minute
+	// auditExportFlushEvery is how many lines are buffered before flushing to the client.
+	auditExportFlushEvery = 200
+)
+
+var errInvalidAuditExportTime = errors.New("must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+
+func RegisterHTTPRoutesForAdminAudit( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	auditService *events.AuditService,
+) {
+	// Export audit events as NDJSON (admin only)
+	routes.
+		Route(
+			"GET /admin/audit/export",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				query := ctx.Request.URL.Query()
+
+				from, err := parseAuditExportTime(query.Get("from"), false)
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("from " + err.Error()))
+				}
+
+				to, err := parseAuditExportTime(query.Get("to"), true)
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("to " + err.Error()))
+				}
+
+				if !to.After(from) {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("from must be before to"))
+				}
+
+				filter := events.AuditRangeFilter{From: from, To: to, EventType: nil}
+				if eventType := query.Get("event_type"); eventType != "" {
+					typed := events.EventType(eventType)
+					filter.EventType = &typed
+				}
+
+				rc := http.NewResponseController(ctx.ResponseWriter)
+				_ = rc.SetWriteDeadline(time.Now().Add(auditExportWriteTimeout))
+
+				return streamAuditExport(ctx, logger, auditService, filter, user.ID)
+			},
+		).
+		HasSummary("Export audit events").
+		HasDescription(
+			"Stream the audit events created in [from, to) as newline-delimited JSON. " +
+				"from and to accept RFC 3339 timestamps or YYYY-MM-DD dates; a date given as to " +
+				"includes that whole day. event_type narrows the export to one event type. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+}
+
+// parseAuditExportTime parses an export bound. Dates are taken as UTC midnight;
+// an end date is moved to the following midnight so that the day is included.
+func parseAuditExportTime(value string, isEnd bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, errors.New("is required") //nolint:err113
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return parsed, nil
+	}
+
+	parsed, err = time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, errInvalidAuditExportTime
+	}
+
+	if isEnd {
+		parsed = parsed.AddDate(0, 0, 1)
+	}
+
+	return parsed, nil
+}
+
+// streamAuditExport writes each audit entry as one JSON line. Headers are only
+// sent with the first line, so a failure before anything was written is still
+// answered with a regular error response.
+func streamAuditExport(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	auditService *events.AuditService,
+	filter events.AuditRangeFilter,
+	adminUserID string,
+) httpfx.Result {
+	controller := http.NewResponseController(ctx.ResponseWriter)
+	buffered := bufio.NewWriter(ctx.ResponseWriter)
+	encoder := json.NewEncoder(buffered)
+	written := 0
+
+	err := auditService.ExportRange(ctx.Request.Context(), filter, func(entry *events.AuditEntry) error {
+		if written == 0 {
+			header := ctx.ResponseWriter.Header()
+			header.Set("Content-Type", "application/x-ndjson")
+			header.Set("Content-Disposition", fmt.Sprintf(
+				`attachment; filename="audit-%s-%s.ndjson"`,
+				filter.From.UTC().Format("20060102T150405Z"),
+				filter.To.UTC().Format("20060102T150405Z"),
+			))
+			header.Set("Cache-Control", "no-store")
+			header.Set("X-Accel-Buffering", "no")
+			ctx.ResponseWriter.WriteHeader(http.StatusOK)
+		}
+
+		encodeErr := encoder.Encode(entry)
+		if encodeErr != nil {
+			return fmt.Errorf("writing audit entry %s: %w", entry.ID, encodeErr)
+		}
+
+		written++
+
+		if written%auditExportFlushEvery == 0 {
+			flushErr := buffered.Flush()
+			if flushErr != nil {
+				return fmt.Errorf("flushing audit export: %w", flushErr)
+			}
+
+			return controller.Flush() //nolint:wrapcheck
+		}
+
+		return nil
+	})
+
+	if err != nil && written == 0 {
+		if errors.Is(err, events.ErrInvalidAuditRange) {
+			return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+		}
+
+		logger.Error("failed to export audit events", slog.String("error", err.Error()))
+
+		return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+	}
+
+	if written == 0 {
+		// An empty range is a valid, empty export
+		header := ctx.ResponseWriter.Header()
+		header.Set("Content-Type", "application/x-ndjson")
+		header.Set("Cache-Control", "no-store")
+		ctx.ResponseWriter.WriteHeader(http.StatusOK)
+	}
+
+	if err != nil {
+		// Headers are already out; mark the export as incomplete on its last line
+		logger.Error("audit export interrupted",
+			slog.String("error", err.Error()),
+			slog.Int("written", written))
+
+		_ = encoder.Encode(map[string]string{"error": "export interrupted"})
+	}
+
+	_ = buffered.Flush()
+	_ = controller.Flush()
+
+	logger.Info("audit events exported",
+		slog.String("admin_user_id", adminUserID),
+		slog.Time("from", filter.From),
+		slog.Time("to", filter.To),
+		slog.Int("count", written))
+
+	return ctx.Results.Written()
+}
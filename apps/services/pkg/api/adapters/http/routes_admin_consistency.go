@@ -0,0 +1,176 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+const (
+	defaultConsistencyCheckListLimit = 20
+	maxConsistencyCheckListLimit     = 100
+)
+
+func RegisterHTTPRoutesForAdminConsistency( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	consistencyService *consistency.Service,
+) {
+	// Queue a consistency check (admin only)
+	routes.
+		Route(
+			"POST /admin/consistency-checks",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				var requestBody struct {
+					Repair bool `json:"repair"`
+				}
+
+				if ctx.Request.ContentLength != 0 {
+					err = json.NewDecoder(ctx.Request.Body).Decode(&requestBody)
+					if err != nil {
+						return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+					}
+				}
+
+				check, err := consistencyService.RequestCheck(
+					ctx.Request.Context(),
+					user.ID,
+					requestBody.Repair,
+				)
+				if err != nil {
+					logger.Error("failed to request consistency check", "error", err)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  check,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Request consistency check").
+		HasDescription(
+			"Queues a scan for records left inconsistent by partially failed creates. " +
+				"With repair set, safely fixable findings are repaired and audited. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+
+	// List recent consistency checks (admin only)
+	routes.
+		Route(
+			"GET /admin/consistency-checks",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				limit := defaultConsistencyCheckListLimit
+
+				if limitStr := ctx.Request.URL.Query().Get("limit"); limitStr != "" {
+					parsed, parseErr := strconv.Atoi(limitStr)
+					if parseErr != nil || parsed < 1 || parsed > maxConsistencyCheckListLimit {
+						return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid limit"))
+					}
+
+					limit = parsed
+				}
+
+				checks, err := consistencyService.ListChecks(ctx.Request.Context(), limit)
+				if err != nil {
+					logger.Error("failed to list consistency checks", "error", err)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  checks,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("List consistency checks").
+		HasDescription("Lists the most recent consistency checks with their findings. Admin only.").
+		HasResponse(http.StatusOK)
+
+	// Get a consistency check report (admin only)
+	routes.
+		Route(
+			"GET /admin/consistency-checks/{id}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				checkID := ctx.Request.PathValue("id")
+
+				check, err := consistencyService.GetCheck(ctx.Request.Context(), checkID)
+				if err != nil {
+					if errors.Is(err, consistency.ErrCheckNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("Consistency check not found"))
+					}
+
+					logger.Error("failed to get consistency check", "error", err, "check_id", checkID)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  check,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Get consistency check").
+		HasDescription("Returns the status and findings of a consistency check. Admin only.").
+		HasResponse(http.StatusOK)
+}
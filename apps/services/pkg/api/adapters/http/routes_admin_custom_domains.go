@@ -0,0 +1,103 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+type adminCustomDomainProfileResponse struct {
+	ID    string `json:"id"`
+	Slug  string `json:"slug"`
+	Title string `json:"title"`
+}
+
+type adminCustomDomainResolveResponse struct {
+	Profile      *adminCustomDomainProfileResponse `json:"profile"`
+	CustomDomain *profiles.ProfileCustomDomain     `json:"custom_domain"`
+	CorsOriginResolution
+}
+
+func RegisterHTTPRoutesForAdminCustomDomains( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	resolveOrigin := NewCorsOriginResolver(
+		authService.Config,
+		NewCustomDomainChecker(profileService),
+	)
+
+	// Explain how the CORS middleware treats an origin (admin only)
+	routes.
+		Route(
+			"GET /admin/custom-domains/_resolve",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				origin := ctx.Request.URL.Query().Get("origin")
+				if origin == "" {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("origin is required"))
+				}
+
+				response := adminCustomDomainResolveResponse{
+					CorsOriginResolution: resolveOrigin(ctx.Request.Context(), origin),
+					Profile:              nil,
+					CustomDomain:         nil,
+				}
+
+				// Report the domain's profile even when the config matched first
+				if response.Domain != "" {
+					profile, customDomain, err := profileService.GetByCustomDomain(
+						ctx.Request.Context(),
+						"en",
+						response.Domain,
+					)
+					if err != nil {
+						logger.Error("failed to look up custom domain",
+							"error", err, "domain", response.Domain)
+
+						return ctx.Results.Error(
+							http.StatusInternalServerError,
+							httpfx.WithSanitizedError(err),
+						)
+					}
+
+					if profile != nil {
+						response.Profile = &adminCustomDomainProfileResponse{
+							ID:    profile.ID,
+							Slug:  profile.Slug,
+							Title: profile.Title,
+						}
+						response.CustomDomain = customDomain
+					}
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  response,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Resolve custom domain origin").
+		HasDescription("Explain whether the CORS middleware allows an origin, and which " +
+			"profile its domain belongs to. Admin only.").
+		HasResponse(http.StatusOK)
+}
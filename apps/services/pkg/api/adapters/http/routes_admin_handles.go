@@ -0,0 +1,138 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+func RegisterHTTPRoutesForAdminHandles( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	// Reserve a profile handle for an organization (admin only)
+	routes.
+		Route(
+			"POST /admin/handles",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				var requestBody struct {
+					VerificationEmail  *string `json:"verification_email"`
+					VerificationDomain *string `json:"verification_domain"`
+					Note               *string `json:"note"`
+					Slug               string  `json:"slug"`
+				}
+
+				err = json.NewDecoder(ctx.Request.Body).Decode(&requestBody)
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+				}
+
+				reservation, err := profileService.ReserveHandle(
+					ctx.Request.Context(),
+					profiles.ReserveHandleParams{
+						VerificationEmail:  requestBody.VerificationEmail,
+						VerificationDomain: requestBody.VerificationDomain,
+						Note:               requestBody.Note,
+						AdminUserID:        user.ID,
+						Slug:               requestBody.Slug,
+					},
+				)
+				if err != nil {
+					status := handleReservationErrorStatus(err)
+					if status == http.StatusInternalServerError {
+						logger.Error("failed to reserve handle", "error", err)
+					}
+
+					return ctx.Results.Error(status, httpfx.WithSanitizedError(err))
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  reservation,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Reserve profile handle").
+		HasDescription(
+			"Reserve a profile slug for an organization until a user with the verification email or domain claims it. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+
+	// Release a profile handle reservation (admin only)
+	routes.
+		Route(
+			"DELETE /admin/handles/{slug}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				slug := ctx.Request.PathValue("slug")
+				if slug == "" {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("slug is required"))
+				}
+
+				err = profileService.ReleaseHandle(ctx.Request.Context(), user.ID, slug)
+				if err != nil {
+					status := handleReservationErrorStatus(err)
+					if status == http.StatusInternalServerError {
+						logger.Error("failed to release handle", "error", err, "slug", slug)
+					}
+
+					return ctx.Results.Error(status, httpfx.WithSanitizedError(err))
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  map[string]any{"released": true},
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Release profile handle").
+		HasDescription("Release the active reservation on a profile slug. Admin only.").
+		HasResponse(http.StatusOK)
+}
+
+func handleReservationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, profiles.ErrInvalidHandleReservation):
+		return http.StatusBadRequest
+	case errors.Is(err, profiles.ErrHandleTaken), errors.Is(err, profiles.ErrHandleAlreadyReserved):
+		return http.StatusConflict
+	case errors.Is(err, profiles.ErrHandleReservationNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
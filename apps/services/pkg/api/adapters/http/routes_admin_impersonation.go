@@ -0,0 +1,173 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+func RegisterHTTPRoutesForAdminImpersonation( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+) {
+	// Start impersonating a user (admin only)
+	routes.
+		Route(
+			"POST /admin/impersonate/{userId}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+				if !ok {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Unauthorized"))
+				}
+
+				adminSession, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+				if err != nil || adminSession == nil {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Session invalid"))
+				}
+
+				targetUserID := ctx.Request.PathValue("userId")
+				if targetUserID == "" {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("userId is required"))
+				}
+
+				session, err := userService.StartImpersonation(
+					ctx.Request.Context(),
+					adminSession,
+					targetUserID,
+					authService.Config.ImpersonationTTL,
+				)
+				if err != nil {
+					status := impersonationErrorStatus(err)
+					if status == http.StatusInternalServerError {
+						logger.Error("failed to start impersonation", "error", err)
+					}
+
+					return ctx.Results.Error(status, httpfx.WithSanitizedError(err))
+				}
+
+				token, err := authService.GenerateSessionTokenUntil(session.ID, *session.ExpiresAt)
+				if err != nil {
+					logger.Error("failed to generate impersonation token", "error", err)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				// The cookie outlives the impersonation session so that the end
+				// endpoint can still restore the admin session after it expires.
+				cookieExpiresAt := *session.ExpiresAt
+				if adminSession.ExpiresAt != nil && adminSession.ExpiresAt.After(cookieExpiresAt) {
+					cookieExpiresAt = *adminSession.ExpiresAt
+				}
+
+				SetSessionCookie(ctx.ResponseWriter, session.ID, cookieExpiresAt, authService.Config)
+
+				return ctx.Results.JSON(map[string]any{
+					"data": map[string]any{
+						"session_id":           session.ID,
+						"token":                token,
+						"expires_at":           session.ExpiresAt.Format(time.RFC3339),
+						"impersonated_user_id": *session.LoggedInUserID,
+						"impersonator_user_id": *session.ImpersonatorUserID,
+					},
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Start impersonating a user").
+		HasDescription(
+			"Issues a short-lived session that views the site as the given user. " +
+				"Every request made with it is audited with both user IDs, and changes " +
+				"require the " + HeaderImpersonationConfirm + " header. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+
+	// End impersonation and restore the admin session. Not behind AuthMiddleware so
+	// that an expired impersonation session can still be ended.
+	routes.
+		Route(
+			"POST /admin/impersonate/_end",
+			func(ctx *httpfx.Context) httpfx.Result {
+				sessionID := GetSessionIDFromRequest(ctx.Request, authService)
+				if sessionID == "" {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Unauthorized"))
+				}
+
+				session, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+				if err != nil || session == nil || session.Status != users.SessionStatusActive {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Session invalid"))
+				}
+
+				adminSession, err := userService.EndImpersonation(ctx.Request.Context(), session)
+				if errors.Is(err, users.ErrImpersonatorSessionEnded) {
+					ClearSessionCookie(ctx.ResponseWriter, authService.Config)
+
+					return ctx.Results.JSON(map[string]any{
+						"data": map[string]any{
+							"restored": false,
+						},
+						"error": nil,
+					})
+				}
+
+				if err != nil {
+					status := impersonationErrorStatus(err)
+					if status == http.StatusInternalServerError {
+						logger.Error("failed to end impersonation", "error", err)
+					}
+
+					return ctx.Results.Error(status, httpfx.WithSanitizedError(err))
+				}
+
+				token, expiresAt, err := authService.GenerateSessionToken(adminSession.ID)
+				if err != nil {
+					logger.Error("failed to generate session token", "error", err)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				SetSessionCookie(ctx.ResponseWriter, adminSession.ID, expiresAt, authService.Config)
+
+				return ctx.Results.JSON(map[string]any{
+					"data": map[string]any{
+						"restored":   true,
+						"session_id": adminSession.ID,
+						"token":      token,
+						"expires_at": expiresAt.Format(time.RFC3339),
+					},
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("End impersonation").
+		HasDescription("Ends the current impersonation session and restores the admin session.").
+		HasResponse(http.StatusOK)
+}
+
+func impersonationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, users.ErrImpersonationForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, users.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, users.ErrCannotImpersonate):
+		return http.StatusConflict
+	case errors.Is(err, users.ErrNotImpersonating):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
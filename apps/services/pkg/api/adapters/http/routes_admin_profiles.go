@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -49,11 +50,12 @@ func RegisterHTTPRoutesForAdminProfiles( //nolint:gocognit,cyclop,funlen,maintid
 
 				filterKind := query.Get("kind")
 
+				// The service clamps the limit and applies its default.
 				limitStr := query.Get("limit")
-				limit := 50
+				limit := 0
 				if limitStr != "" {
 					parsed, parseErr := strconv.Atoi(limitStr)
-					if parseErr == nil && parsed > 0 && parsed <= 100 {
+					if parseErr == nil {
 						limit = parsed
 					}
 				}
@@ -261,4 +263,78 @@ func RegisterHTTPRoutesForAdminProfiles( //nolint:gocognit,cyclop,funlen,maintid
 		HasSummary("Add points to profile").
 		HasDescription("Add points directly to a profile. Admin only.").
 		HasResponse(http.StatusOK)
+
+	// Merge a duplicate profile into another (admin only)
+	routes.
+		Route(
+			"POST /admin/profiles/{slug}/merge",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				var body struct {
+					TargetSlug string `json:"target_slug"`
+				}
+
+				err = json.NewDecoder(ctx.Request.Body).Decode(&body)
+				if err != nil || body.TargetSlug == "" {
+					return ctx.Results.BadRequest(
+						httpfx.WithErrorMessage("target_slug is required"),
+					)
+				}
+
+				slug := ctx.Request.PathValue("slug")
+
+				result, err := profileService.MergeProfiles(
+					ctx.Request.Context(),
+					user.ID,
+					slug,
+					body.TargetSlug,
+				)
+				if err != nil {
+					switch {
+					case errors.Is(err, profiles.ErrProfileNotFound):
+						return ctx.Results.NotFound(httpfx.WithSanitizedError(err))
+					case errors.Is(err, profiles.ErrCannotMergeProfileIntoItself),
+						errors.Is(err, profiles.ErrProfileKindMismatch):
+						return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+					case errors.Is(err, profiles.ErrProfilesLinkedToOtherUsers):
+						return ctx.Results.Error(http.StatusConflict, httpfx.WithSanitizedError(err))
+					}
+
+					logger.Error(
+						"failed to merge profiles",
+						"error", err,
+						"source_slug", slug,
+						"target_slug", body.TargetSlug,
+					)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  result,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Merge profiles").
+		HasDescription(
+			"Move the links, pages, resources and memberships of a duplicate profile " +
+				"to the target profile and delete the duplicate. Admin only.",
+		).
+		HasResponse(http.StatusOK)
 }
@@ -0,0 +1,156 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// RegisterHTTPRoutesForAdminReports registers the moderation queue of reported
+// profiles, pages and links.
+func RegisterHTTPRoutesForAdminReports( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	// List reports (admin only)
+	routes.
+		Route(
+			"GET /admin/reports",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				query := ctx.Request.URL.Query()
+
+				status := profiles.ProfileReportStatusOpen
+				if statusParam := query.Get("status"); statusParam != "" {
+					status = profiles.ProfileReportStatus(statusParam)
+				}
+
+				limit := 0
+				if limitStr := query.Get("limit"); limitStr != "" {
+					parsed, parseErr := strconv.Atoi(limitStr)
+					if parseErr == nil {
+						limit = parsed
+					}
+				}
+
+				offset := 0
+				if offsetStr := query.Get("offset"); offsetStr != "" {
+					parsed, parseErr := strconv.Atoi(offsetStr)
+					if parseErr == nil {
+						offset = parsed
+					}
+				}
+
+				reports, err := profileService.ListProfileReports(
+					ctx.Request.Context(),
+					status,
+					limit,
+					offset,
+				)
+				if err != nil {
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
+					}
+
+					logger.ErrorContext(ctx.Request.Context(), "failed to list reports",
+						slog.String("error", err.Error()))
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Failed to list reports"),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  reports,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("List reports").
+		HasDescription(
+			"List reported profiles, pages and links in a status (open by default), oldest first. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+
+	// Resolve a report (admin only)
+	routes.
+		Route(
+			"PUT /admin/reports/{id}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				var requestBody struct {
+					Status string `json:"status"`
+				}
+
+				err = ctx.ParseJSONBody(&requestBody)
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+				}
+
+				reportID := ctx.Request.PathValue("id")
+
+				err = profileService.ResolveProfileReport(
+					ctx.Request.Context(),
+					user.ID,
+					reportID,
+					profiles.ProfileReportStatus(requestBody.Status),
+				)
+				if err != nil {
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
+					}
+
+					logger.ErrorContext(ctx.Request.Context(), "failed to resolve report",
+						slog.String("error", err.Error()),
+						slog.String("report_id", reportID))
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Failed to resolve report"),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  map[string]any{"id": reportID, "status": requestBody.Status},
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Resolve report").
+		HasDescription("Close an open report as resolved or dismissed. Admin only.").
+		HasResponse(http.StatusOK)
+}
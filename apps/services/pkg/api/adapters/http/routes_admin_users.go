@@ -0,0 +1,149 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+func RegisterHTTPRoutesForAdminUsers( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+) {
+	// Link a user to an existing individual profile (admin only)
+	routes.
+		Route(
+			"PUT /admin/users/{userId}/individual-profile",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				userID := ctx.Request.PathValue("userId")
+				if userID == "" {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("userId is required"))
+				}
+
+				var requestBody struct {
+					ProfileID string `json:"profile_id"`
+				}
+
+				err = json.NewDecoder(ctx.Request.Body).Decode(&requestBody)
+				if err != nil || requestBody.ProfileID == "" {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("profile_id is required"))
+				}
+
+				err = userService.RelinkIndividualProfile(
+					ctx.Request.Context(),
+					user.ID,
+					userID,
+					requestBody.ProfileID,
+				)
+				if err != nil {
+					status := individualProfileErrorStatus(err)
+					if status == http.StatusInternalServerError {
+						logger.Error("failed to relink individual profile",
+							"error", err, "user_id", userID, "profile_id", requestBody.ProfileID)
+					}
+
+					return ctx.Results.Error(status, httpfx.WithSanitizedError(err))
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data": map[string]any{
+						"user_id":               userID,
+						"individual_profile_id": requestBody.ProfileID,
+					},
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Relink individual profile").
+		HasDescription(
+			"Points a user at an existing individual profile that no other user owns. " +
+				"Used to repair accounts left inconsistent by a failed profile creation. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+
+	// Clear the individual profile of a user (admin only)
+	routes.
+		Route(
+			"DELETE /admin/users/{userId}/individual-profile",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				userID := ctx.Request.PathValue("userId")
+				if userID == "" {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("userId is required"))
+				}
+
+				err = userService.UnlinkIndividualProfile(ctx.Request.Context(), user.ID, userID)
+				if err != nil {
+					status := individualProfileErrorStatus(err)
+					if status == http.StatusInternalServerError {
+						logger.Error("failed to unlink individual profile",
+							"error", err, "user_id", userID)
+					}
+
+					return ctx.Results.Error(status, httpfx.WithSanitizedError(err))
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data": map[string]any{
+						"user_id":               userID,
+						"individual_profile_id": nil,
+					},
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Unlink individual profile").
+		HasDescription(
+			"Clears the individual profile of a user so that profile creation can be run again. " +
+				"The profile itself is left untouched. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+}
+
+func individualProfileErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, users.ErrIndividualProfileAdminOnly):
+		return http.StatusForbidden
+	case errors.Is(err, users.ErrUserNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, users.ErrInvalidIndividualProfile):
+		return http.StatusBadRequest
+	case errors.Is(err, users.ErrIndividualProfileInUse),
+		errors.Is(err, users.ErrNoIndividualProfile):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
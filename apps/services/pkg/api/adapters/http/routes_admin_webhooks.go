@@ -0,0 +1,185 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+func RegisterHTTPRoutesForAdminWebhooks( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	webhookService *webhooks.Service,
+) {
+	// List webhook deliveries
+	routes.
+		Route(
+			"GET /admin/webhooks/deliveries",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				query := ctx.Request.URL.Query()
+				filter := webhooks.DeliveryFilter{Source: nil, Status: nil}
+
+				if source := query.Get("source"); source != "" {
+					filter.Source = &source
+				}
+
+				if statusParam := query.Get("status"); statusParam != "" {
+					status := webhooks.DeliveryStatus(statusParam)
+					if status != webhooks.DeliveryStatusPending &&
+						status != webhooks.DeliveryStatusSucceeded &&
+						status != webhooks.DeliveryStatusFailed {
+						return ctx.Results.BadRequest(httpfx.WithErrorMessage("status is invalid"))
+					}
+
+					filter.Status = &status
+				}
+
+				cursor := cursors.NewCursorFromRequest(ctx.Request)
+
+				deliveries, err := webhookService.ListDeliveries(
+					ctx.Request.Context(),
+					filter,
+					cursor,
+				)
+				if err != nil {
+					logger.Error(
+						"failed to list webhook deliveries",
+						"error", err,
+					)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(deliveries)
+			},
+		).
+		HasSummary("List webhook deliveries").
+		HasDescription(
+			"List outbound webhook deliveries, newest first. " +
+				"Filter with the source and status query parameters. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+
+	// Get webhook delivery with its attempts
+	routes.
+		Route(
+			"GET /admin/webhooks/deliveries/{id}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				deliveryID := ctx.Request.PathValue("id")
+
+				delivery, err := webhookService.GetDelivery(ctx.Request.Context(), deliveryID)
+				if err != nil {
+					if errors.Is(err, webhooks.ErrDeliveryNotFound) {
+						return ctx.Results.NotFound(
+							httpfx.WithErrorMessage("webhook delivery not found"),
+						)
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  delivery,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Get webhook delivery").
+		HasDescription("Get a webhook delivery with the log of its attempts. Admin only.").
+		HasResponse(http.StatusOK)
+
+	// Redeliver a failed webhook
+	routes.
+		Route(
+			"POST /admin/webhooks/deliveries/{id}/redeliver",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				deliveryID := ctx.Request.PathValue("id")
+
+				delivery, err := webhookService.Redeliver(ctx.Request.Context(), deliveryID)
+				if err != nil && !errors.Is(err, webhooks.ErrDeliveryFailed) {
+					if errors.Is(err, webhooks.ErrDeliveryNotFound) {
+						return ctx.Results.NotFound(
+							httpfx.WithErrorMessage("webhook delivery not found"),
+						)
+					}
+
+					if errors.Is(err, webhooks.ErrDeliveryNotFailed) {
+						return ctx.Results.Error(
+							http.StatusConflict,
+							httpfx.WithErrorMessage("only failed webhook deliveries can be redelivered"),
+						)
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				// A failed attempt is still a completed redelivery; its outcome is in the delivery.
+				return ctx.Results.JSON(map[string]any{
+					"data":  delivery,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Redeliver webhook").
+		HasDescription(
+			"Make a new attempt at a failed webhook delivery. " +
+				"The response holds the delivery with the outcome of the attempt. Admin only.",
+		).
+		HasResponse(http.StatusOK)
+}
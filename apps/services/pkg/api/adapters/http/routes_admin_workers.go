@@ -9,6 +9,7 @@ import (
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/providerbudget"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
@@ -34,6 +35,7 @@ func RegisterHTTPRoutesForAdminWorkers( //nolint:gocognit,cyclop,funlen
 	userService *users.Service,
 	runtimeStates *runtime_states.Service,
 	workerRegistry *workerfx.Registry,
+	providerBudgets *providerbudget.Budgets,
 ) {
 	// List all workers with status
 	routes.
@@ -116,6 +118,34 @@ func RegisterHTTPRoutesForAdminWorkers( //nolint:gocognit,cyclop,funlen
 		HasDescription("List all background workers with their status. Admin only.").
 		HasResponse(http.StatusOK)
 
+	// Current provider request budgets
+	routes.
+		Route(
+			"GET /admin/workers/budgets",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				user, err := getUserFromContext(ctx, userService)
+				if err != nil {
+					return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+				}
+
+				if user.Kind != userKindAdmin {
+					return ctx.Results.Error(
+						http.StatusForbidden,
+						httpfx.WithErrorMessage("Admin access required"),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  providerBudgets.Statuses(),
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("List provider request budgets").
+		HasDescription("List the remaining request budget of each provider API. Admin only.").
+		HasResponse(http.StatusOK)
+
 	// Toggle worker enable/disable
 	routes.
 		Route(
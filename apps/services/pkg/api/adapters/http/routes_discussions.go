@@ -45,6 +45,17 @@ func RegisterHTTPRoutesForDiscussions( //nolint:funlen,cyclop,maintidx
 		},
 	).HasDescription("List discussion comments for a profile")
 
+	// List top-level comments for the discussion of a story within a profile.
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/stories/{storySlug}/_discussions",
+		func(ctx *httpfx.Context) httpfx.Result {
+			return listDiscussionComments(
+				ctx, logger, authService, userService, profileService, discussionsService,
+				"profile_story",
+			)
+		},
+	).HasDescription("List discussion comments for a story of a profile")
+
 	// List replies to a comment.
 	routes.Route(
 		"GET /{locale}/discussions/comments/{commentId}/replies",
@@ -108,6 +119,48 @@ func RegisterHTTPRoutesForDiscussions( //nolint:funlen,cyclop,maintidx
 		},
 	).HasDescription("Create a comment on a profile discussion")
 
+	// Create a comment on the discussion of a story within a profile (followers+).
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/stories/{storySlug}/_discussions",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			return createDiscussionComment(
+				ctx, logger, userService, discussionsService, "profile_story",
+			)
+		},
+	).HasDescription("Create a comment on the discussion of a story of a profile")
+
+	// Delete a comment of a story discussion (author or maintainer+).
+	routes.Route(
+		"DELETE /{locale}/profiles/{slug}/stories/{storySlug}/_discussions/{commentId}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			commentID := ctx.Request.PathValue("commentId")
+
+			err = discussionsService.DeleteStoryComment(
+				ctx.Request.Context(),
+				discussions.DeleteStoryCommentParams{
+					CommentID:   commentID,
+					UserID:      user.ID,
+					ProfileSlug: ctx.Request.PathValue("slug"),
+					StorySlug:   ctx.Request.PathValue("storySlug"),
+				},
+			)
+			if err != nil {
+				return handleMutationError(ctx, logger, err, "delete", commentID)
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data": map[string]string{"status": "ok"},
+			})
+		},
+	).HasDescription("Delete a comment of a story discussion (author or maintainer+)")
+
 	// Edit a comment (author only).
 	routes.Route(
 		"PUT /{locale}/discussions/comments/{commentId}",
@@ -287,7 +340,7 @@ func listDiscussionComments(
 	userService *users.Service,
 	profileService *profiles.Service,
 	discussionsService *discussions.Service,
-	entityType string, // "story" or "profile"
+	entityType string, // "story", "profile_story" or "profile"
 ) httpfx.Result {
 	localeParam, localeOk := validateLocale(ctx)
 	if !localeOk {
@@ -312,6 +365,12 @@ func listDiscussionComments(
 			ctx.Request.Context(),
 			slugParam,
 		)
+	case "profile_story":
+		thread, err = discussionsService.GetOrCreateThreadByProfileStory(
+			ctx.Request.Context(),
+			slugParam,
+			ctx.Request.PathValue("storySlug"),
+		)
 	default:
 		thread, err = discussionsService.GetOrCreateThreadByProfileSlug(
 			ctx.Request.Context(),
@@ -345,7 +404,7 @@ func createDiscussionComment(
 	logger *logfx.Logger,
 	userService *users.Service,
 	discussionsService *discussions.Service,
-	entityType string, // "story" or "profile"
+	entityType string, // "story", "profile_story" or "profile"
 ) httpfx.Result {
 	user, err := getUserFromContext(ctx, userService)
 	if err != nil {
@@ -381,6 +440,10 @@ func createDiscussionComment(
 	switch entityType {
 	case "story":
 		params.StorySlug = &slugParam
+	case "profile_story":
+		storySlug := ctx.Request.PathValue("storySlug")
+		params.StorySlug = &storySlug
+		params.ProfileSlug = &slugParam
 	default:
 		params.ProfileSlug = &slugParam
 	}
@@ -549,6 +612,14 @@ func handleDiscussionError(
 		)
 	}
 
+	if errors.Is(err, discussions.ErrProfileNotFound) {
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+	}
+
+	if errors.Is(err, discussions.ErrStoryNotFound) {
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("story not found"))
+	}
+
 	if errors.Is(err, discussions.ErrThreadNotFound) {
 		return ctx.Results.NotFound(httpfx.WithErrorMessage("thread not found"))
 	}
@@ -579,6 +650,21 @@ func handleCreateCommentError(
 		)
 	}
 
+	if errors.Is(err, discussions.ErrProfileNotFound) {
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+	}
+
+	if errors.Is(err, discussions.ErrStoryNotFound) {
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("story not found"))
+	}
+
+	if errors.Is(err, discussions.ErrInsufficientPermission) {
+		return ctx.Results.Error(
+			http.StatusForbidden,
+			httpfx.WithErrorMessage("insufficient permission"),
+		)
+	}
+
 	if errors.Is(err, discussions.ErrThreadLocked) {
 		return ctx.Results.Error(
 			http.StatusForbidden,
@@ -619,7 +705,8 @@ func handleMutationError(
 	entityID string,
 ) httpfx.Result {
 	if errors.Is(err, discussions.ErrCommentNotFound) ||
-		errors.Is(err, discussions.ErrThreadNotFound) {
+		errors.Is(err, discussions.ErrThreadNotFound) ||
+		errors.Is(err, discussions.ErrStoryNotFound) {
 		return ctx.Results.NotFound(httpfx.WithErrorMessage("not found"))
 	}
 
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/webhooksig"
+)
+
+// linkKindsCacheControl lets clients and CDNs reuse the link kind list; it only
+// changes with a deploy.
+const linkKindsCacheControl = "public, max-age=3600"
+
+func RegisterHTTPRoutesForMeta( //nolint:funlen
+	routes *httpfx.Router,
+	aiCapabilities *AICapabilities,
+) {
+	routes.
+		Route("GET /{locale}/_meta/ai-capabilities", func(ctx *httpfx.Context) httpfx.Result {
+			_, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  aiCapabilities.Snapshot(),
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Get AI capabilities").
+		HasDescription("Reports which AI features are available on this deployment.").
+		HasResponse(http.StatusOK)
+
+	routes.
+		Route("GET /{locale}/_meta/webhook-signature", func(ctx *httpfx.Context) httpfx.Result {
+			_, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  webhooksig.CurrentScheme(),
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Get webhook signature scheme").
+		HasDescription(
+			"Describes how outbound webhooks are signed so receivers can verify them.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.
+		Route("GET /{locale}/_meta/link-kinds", func(ctx *httpfx.Context) httpfx.Result {
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+
+			ctx.ResponseWriter.Header().Set("Cache-Control", linkKindsCacheControl)
+
+			wrappedResponse := map[string]any{
+				"data":  profiles.ListLinkKinds(localeParam),
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Get link kinds").
+		HasDescription(
+			"Lists the supported profile link kinds with localized names, icons and URL patterns.",
+		).
+		HasResponse(http.StatusOK)
+}
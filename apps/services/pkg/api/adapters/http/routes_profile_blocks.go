@@ -0,0 +1,122 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// RegisterHTTPRoutesForProfileBlocks registers the current user's block list.
+func RegisterHTTPRoutesForProfileBlocks(
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	routes.Route(
+		"GET /{locale}/me/_blocks",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			blocks, err := profileService.ListBlockedProfiles(ctx.Request.Context(), localeParam, user.ID)
+			if err != nil {
+				return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  blocks,
+				"error": nil,
+			})
+		}).
+		HasSummary("List Blocked Profiles").
+		HasDescription("List the profiles the current user blocked, most recent first.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"PUT /{locale}/me/_blocks/{slug}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			err = profileService.BlockProfile(ctx.Request.Context(), user.ID, slugParam)
+			if err != nil {
+				return profileBlockErrorResult(ctx, logger, err, user.ID, slugParam, "Blocking profile failed")
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]any{"slug": slugParam, "blocked": true},
+				"error": nil,
+			})
+		}).
+		HasSummary("Block Profile").
+		HasDescription(
+			"Block a profile for the current user. Stories authored by or published to it are " +
+				"left out of the user's followed feed, and the user is not suggested as its member.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"DELETE /{locale}/me/_blocks/{slug}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			err = profileService.UnblockProfile(ctx.Request.Context(), user.ID, slugParam)
+			if err != nil {
+				return profileBlockErrorResult(ctx, logger, err, user.ID, slugParam, "Unblocking profile failed")
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]any{"slug": slugParam, "blocked": false},
+				"error": nil,
+			})
+		}).
+		HasSummary("Unblock Profile").
+		HasDescription("Remove a profile from the current user's block list.").
+		HasResponse(http.StatusOK)
+}
+
+func profileBlockErrorResult(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	err error,
+	userID string,
+	slug string,
+	message string,
+) httpfx.Result {
+	if result, ok := profileErrorResult(ctx, err); ok {
+		return result
+	}
+
+	logger.ErrorContext(ctx.Request.Context(), message,
+		slog.String("error", err.Error()),
+		slog.String("user_id", userID),
+		slog.String("slug", slug))
+
+	return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithErrorMessage(message))
+}
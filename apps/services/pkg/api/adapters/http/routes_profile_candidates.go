@@ -1,18 +1,18 @@
 package http
 
 import (
-	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"log/slog"
 	"net/http"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	profilesadapter "github.com/eser/aya.is/services/pkg/api/adapters/profiles"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
 )
 
@@ -24,7 +24,59 @@ func RegisterHTTPRoutesForProfileCandidates( //nolint:gocognit,gocyclo,cyclop,fu
 	userService *users.Service,
 	profileService *profiles.Service,
 	mailboxService *mailbox.Service,
+	protectionService *protection.Service,
 ) {
+	// List referrals awaiting the current user's vote across all their profiles
+	routes.Route(
+		"GET /{locale}/me/referrals-to-vote",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.Error(
+					http.StatusBadRequest,
+					httpfx.WithErrorMessage("Invalid locale"),
+				)
+			}
+
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			groups, err := profileService.ListReferralsAwaitingVote(
+				ctx.Request.Context(),
+				localeParam,
+				*session.LoggedInUserID,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to list referrals awaiting vote",
+					slog.String("error", err.Error()))
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithSanitizedError(err),
+				)
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  groups,
+				"error": nil,
+			})
+		},
+	).HasDescription("List referrals awaiting the current user's vote, grouped by profile")
+
 	// List candidates for a profile (member+ only)
 	routes.Route(
 		"GET /{locale}/profiles/{slug}/_candidates",
@@ -315,7 +367,7 @@ func RegisterHTTPRoutesForProfileCandidates( //nolint:gocognit,gocyclo,cyclop,fu
 		"PATCH /{locale}/profiles/{slug}/_candidates/{id}/status",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
-			localeParam, localeOk := validateLocale(ctx)
+			_, localeOk := validateLocale(ctx)
 			if !localeOk {
 				return ctx.Results.Error(
 					http.StatusBadRequest,
@@ -386,12 +438,11 @@ func RegisterHTTPRoutesForProfileCandidates( //nolint:gocognit,gocyclo,cyclop,fu
 
 			// When transitioning to invitation_pending_response, send a mailbox invitation.
 			if newStatus == profiles.CandidateStatusInvitationPendingResponse {
-				sendErr := sendCandidateInvitation(
+				sendErr := profilesadapter.SendCandidateInvitation(
 					ctx.Request.Context(),
 					logger,
 					profileService,
 					mailboxService,
-					localeParam,
 					slugParam,
 					idParam,
 				)
@@ -545,6 +596,15 @@ func RegisterHTTPRoutesForProfileCandidates( //nolint:gocognit,gocyclo,cyclop,fu
 		"POST /{locale}/profiles/{slug}/_candidates/apply",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
+			if result, ok := verifyCaptcha(
+				ctx,
+				logger,
+				protectionService,
+				protection.CaptchaEndpointApplication,
+			); !ok {
+				return result
+			}
+
 			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
 			if !ok {
 				return ctx.Results.Error(
@@ -714,60 +774,3 @@ func RegisterHTTPRoutesForProfileCandidates( //nolint:gocognit,gocyclo,cyclop,fu
 		},
 	).HasDescription("Get form responses for a candidate")
 }
-
-// sendCandidateInvitation sends a mailbox invitation to the referred profile.
-func sendCandidateInvitation(
-	ctx context.Context,
-	logger *logfx.Logger,
-	profileService *profiles.Service,
-	mailboxService *mailbox.Service,
-	localeCode string,
-	profileSlug string,
-	candidateID string,
-) error {
-	_ = localeCode
-
-	candidate, err := profileService.GetCandidateByID(ctx, candidateID)
-	if err != nil {
-		return fmt.Errorf("failed to get candidate: %w", err)
-	}
-
-	profile, profileErr := profileService.GetBySlugInternal(ctx, profileSlug)
-	if profileErr != nil {
-		return fmt.Errorf("failed to get profile: %w", profileErr)
-	}
-
-	message := "You have been invited to join " + profile.Title
-	props := mailbox.InvitationProperties{
-		InvitationKind:   mailbox.InvitationKindProfileJoin,
-		TelegramChatID:   0,
-		GroupProfileSlug: "",
-		GroupName:        "",
-		InviteLink:       nil,
-		CandidateID:      candidateID,
-		ProfileID:        candidate.ProfileID,
-		ProfileSlug:      profileSlug,
-	}
-
-	_, envelopeErr := mailboxService.SendSystemEnvelope(ctx, &mailbox.SendMessageParams{
-		SenderProfileID:    candidate.ProfileID,
-		TargetProfileID:    candidate.ReferredProfileID,
-		SenderUserID:       nil,
-		Kind:               mailbox.KindInvitation,
-		ConversationTitle:  "Membership Invitation",
-		Message:            &message,
-		Properties:         props,
-		ReplyToID:          nil,
-		SenderProfileTitle: profile.Title,
-		Locale:             "",
-	})
-	if envelopeErr != nil {
-		return fmt.Errorf("failed to send envelope: %w", envelopeErr)
-	}
-
-	logger.InfoContext(ctx, "Candidate invitation sent",
-		slog.String("candidate_id", candidateID),
-		slog.String("referred_profile_id", candidate.ReferredProfileID))
-
-	return nil
-}
@@ -0,0 +1,351 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+func RegisterHTTPRoutesForProfileDomains(
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_domains",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			domains, err := profileService.ListCustomDomains(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+			)
+			if err != nil {
+				return customDomainErrorResult(ctx, logger, err, "Failed to list custom domains")
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  domains,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("List Profile Custom Domains").
+		HasDescription("List custom domains of a profile with verification status and expected DNS target.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_domains",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			var requestBody struct {
+				DefaultLocale *string `json:"default_locale"`
+				Domain        string  `json:"domain"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			domain, err := profileService.CreateCustomDomain(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				requestBody.Domain,
+				requestBody.DefaultLocale,
+			)
+			if err != nil {
+				return customDomainErrorResult(ctx, logger, err, "Failed to create custom domain")
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  domain,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Create Profile Custom Domain").
+		HasDescription("Add a custom domain to the profile. The domain stays pending until DNS is verified.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"PATCH /{locale}/profiles/{slug}/_domains/{id}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			domainIDParam := ctx.Request.PathValue("id")
+
+			var requestBody struct {
+				DefaultLocale *string `json:"default_locale"`
+				Domain        string  `json:"domain"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			domain, err := profileService.UpdateCustomDomain(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				domainIDParam,
+				requestBody.Domain,
+				requestBody.DefaultLocale,
+			)
+			if err != nil {
+				return customDomainErrorResult(ctx, logger, err, "Failed to update custom domain")
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  domain,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Update Profile Custom Domain").
+		HasDescription("Update a custom domain. Changing the host name resets its verification.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"DELETE /{locale}/profiles/{slug}/_domains/{id}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			domainIDParam := ctx.Request.PathValue("id")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			err := profileService.DeleteCustomDomain(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				domainIDParam,
+			)
+			if err != nil {
+				return customDomainErrorResult(ctx, logger, err, "Failed to delete custom domain")
+			}
+
+			wrappedResponse := map[string]any{
+				"data": map[string]any{
+					"success": true,
+					"message": "Custom domain deleted successfully",
+				},
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Delete Profile Custom Domain").
+		HasDescription("Remove a custom domain from the profile.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_domains/{id}/_verify",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			domainIDParam := ctx.Request.PathValue("id")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			result, err := profileService.VerifyCustomDomainNow(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				domainIDParam,
+			)
+			if err != nil {
+				return customDomainErrorResult(ctx, logger, err, "Failed to verify custom domain")
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  result,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Verify Profile Custom Domain Now").
+		HasDescription("Check the DNS of a custom domain immediately and return the verification result.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_domains/{id}/_dns",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			domainIDParam := ctx.Request.PathValue("id")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			result, err := profileService.GetCustomDomainDNSInstructions(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				domainIDParam,
+			)
+			if err != nil {
+				return customDomainErrorResult(
+					ctx,
+					logger,
+					err,
+					"Failed to get custom domain DNS instructions",
+				)
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  result,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Get Profile Custom Domain DNS Instructions").
+		HasDescription("List the DNS records to create for a custom domain to be verified.").
+		HasResponse(http.StatusOK)
+}
+
+// customDomainErrorResult maps custom domain service errors to HTTP results.
+func customDomainErrorResult(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	err error,
+	message string,
+) httpfx.Result {
+	switch {
+	case errors.Is(err, profiles.ErrProfileNotFound):
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("Profile not found"))
+	case errors.Is(err, profiles.ErrCustomDomainNotFound):
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("Custom domain not found"))
+	case errors.Is(err, profiles.ErrInvalidCustomDomain):
+		return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid domain name"))
+	case errors.Is(err, profiles.ErrCustomDomainVerifyRateLimited):
+		return ctx.Results.Error(
+			http.StatusTooManyRequests,
+			httpfx.WithErrorMessage("Please wait before verifying this domain again"),
+		)
+	case errors.Is(err, profiles.ErrCustomDomainTaken):
+		return ctx.Results.Error(
+			http.StatusConflict,
+			httpfx.WithErrorMessage("This domain is already in use"),
+		)
+	case errors.Is(err, profiles.ErrInsufficientAccess), errors.Is(err, profiles.ErrUnauthorized):
+		return ctx.Results.Error(
+			http.StatusForbidden,
+			httpfx.WithErrorMessage("You do not have permission to manage domains of this profile"),
+		)
+	}
+
+	logger.ErrorContext(ctx.Request.Context(), message,
+		slog.String("error", err.Error()),
+		slog.String("slug", ctx.Request.PathValue("slug")))
+
+	return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithErrorMessage(message))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
 	telegrambiz "github.com/eser/aya.is/services/pkg/api/business/telegram"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
 )
@@ -21,6 +22,7 @@ func RegisterHTTPRoutesForProfileEnvelopes( //nolint:funlen,cyclop,gocognit,gocy
 	profileService *profiles.Service,
 	mailboxService *mailbox.Service,
 	telegramService *telegrambiz.Service,
+	protectionService *protection.Service,
 ) {
 	// GET /{locale}/profiles/{slug}/_envelopes — list envelopes (inbox)
 	routes.
@@ -97,6 +99,15 @@ func RegisterHTTPRoutesForProfileEnvelopes( //nolint:funlen,cyclop,gocognit,gocy
 			"POST /{locale}/profiles/{slug}/_envelopes",
 			AuthMiddleware(authService, userService),
 			func(ctx *httpfx.Context) httpfx.Result {
+				if result, ok := verifyCaptcha(
+					ctx,
+					logger,
+					protectionService,
+					protection.CaptchaEndpointContact,
+				); !ok {
+					return result
+				}
+
 				localeParam, localeOk := validateLocale(ctx)
 				if !localeOk {
 					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// RegisterHTTPRoutesForProfileLinkClicks registers the link redirect that
+// counts clicks, and the click stats shown to profile maintainers.
+func RegisterHTTPRoutesForProfileLinkClicks( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	routes.
+		Route(
+			"GET /{locale}/profiles/{slug}/links/{linkId}/go",
+			func(ctx *httpfx.Context) httpfx.Result {
+				localeParam := ctx.Request.PathValue("locale")
+				slugParam := ctx.Request.PathValue("slug")
+				linkIDParam := ctx.Request.PathValue("linkId")
+
+				uri, err := profileService.GetLinkRedirectURI(
+					ctx.Request.Context(),
+					localeParam,
+					slugParam,
+					linkIDParam,
+				)
+				if err != nil {
+					if errors.Is(err, profiles.ErrLinkNotFound) ||
+						errors.Is(err, profiles.ErrProfileNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("link not found"))
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				// A failed count must not keep the visitor from the link
+				viewerUserID := GetViewerUserID(ctx.Request, authService, userService)
+
+				err = profileService.RecordLinkClick(
+					ctx.Request.Context(),
+					linkIDParam,
+					viewerUserID,
+					ctx.Request.UserAgent(),
+				)
+				if err != nil {
+					logger.ErrorContext(ctx.Request.Context(), "Failed to record link click",
+						slog.String("link_id", linkIDParam),
+						slog.Any("error", err))
+				}
+
+				result := ctx.Results.Redirect(uri)
+				result.InnerStatusCode = http.StatusFound
+
+				return result
+			},
+		).
+		HasSummary("Follow profile link").
+		HasDescription("Count a click on a public profile link and redirect to its URI. " +
+			"Clicks from obvious bots are not counted.").
+		HasResponse(http.StatusFound)
+
+	routes.
+		Route(
+			"GET /{locale}/profiles/{slug}/_links/{linkId}/clicks",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				slugParam := ctx.Request.PathValue("slug")
+				linkIDParam := ctx.Request.PathValue("linkId")
+
+				sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+				if !ok {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Session ID not found"))
+				}
+
+				session, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+				if err != nil || session == nil || session.LoggedInUserID == nil {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Invalid session"))
+				}
+
+				stats, err := profileService.GetLinkClickStats(
+					ctx.Request.Context(),
+					*session.LoggedInUserID,
+					slugParam,
+					linkIDParam,
+				)
+				if err != nil {
+					if errors.Is(err, profiles.ErrInsufficientAccess) {
+						return ctx.Results.Error(http.StatusForbidden,
+							httpfx.WithErrorMessage("You do not have permission to view link stats"))
+					}
+
+					if errors.Is(err, profiles.ErrLinkNotFound) ||
+						errors.Is(err, profiles.ErrProfileNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("link not found"))
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  stats,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Get profile link click stats").
+		HasDescription("Get the daily clicks on a profile link over the last 30 days. " +
+			"Maintainer only.").
+		HasResponse(http.StatusOK)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/adapters/youtube"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/api/business/siteimporter"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
@@ -42,6 +43,7 @@ type ProfileLinkProviders struct {
 	LinkedIn               *linkedin.Provider
 	X                      *xadapter.Provider
 	SiteImporter           *siteimporter.Service
+	LinkSync               *linksync.Service
 	PendingConnectionStore *profiles.PendingConnectionStore
 	PKCEStore              *profiles.PKCEStore
 }
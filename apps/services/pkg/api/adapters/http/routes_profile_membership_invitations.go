@@ -0,0 +1,219 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// invitationErrorStatus maps membership invitation errors to HTTP status codes.
+func invitationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, profiles.ErrProfileNotFound),
+		errors.Is(err, profiles.ErrInvitationNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, profiles.ErrInsufficientAccess),
+		errors.Is(err, profiles.ErrCannotAssignHigherRole):
+		return http.StatusForbidden
+	case errors.Is(err, profiles.ErrInvitationExpired):
+		return http.StatusGone
+	case errors.Is(err, profiles.ErrInvitationNotPending),
+		errors.Is(err, profiles.ErrInvitationAlreadyPending),
+		errors.Is(err, profiles.ErrAlreadyMember):
+		return http.StatusConflict
+	case errors.Is(err, profiles.ErrInvalidMembershipKind),
+		errors.Is(err, profiles.ErrInvalidInput):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// registerHTTPRoutesForProfileMembershipInvitations registers the routes for
+// inviting profiles to become members and for answering those invitations.
+func registerHTTPRoutesForProfileMembershipInvitations( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	// List pending invitations the profile has sent or received
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_invitations",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			invitations, err := profileService.ListPendingInvitations(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+			)
+			if err != nil {
+				return ctx.Results.Error(invitationErrorStatus(err), httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  invitations,
+				"error": nil,
+			})
+		},
+	).HasDescription("List pending membership invitations of a profile")
+
+	// Invite a profile to become a member
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_invitations",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			var input struct {
+				MemberProfileID string `json:"member_profile_id"`
+				Kind            string `json:"kind"`
+			}
+
+			err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			if input.MemberProfileID == "" || input.Kind == "" {
+				return ctx.Results.BadRequest(
+					httpfx.WithErrorMessage("member_profile_id and kind are required"),
+				)
+			}
+
+			invitation, err := profileService.InviteMembership(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				user.Kind,
+				user.IndividualProfileID,
+				slugParam,
+				input.MemberProfileID,
+				input.Kind,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to invite membership",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam))
+
+				return ctx.Results.Error(invitationErrorStatus(err), httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  invitation,
+				"error": nil,
+			})
+		},
+	).HasDescription("Invite a profile to become a member of a profile")
+
+	respond := func(accept bool) httpfx.Handler {
+		return func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			invitationID := ctx.Request.PathValue("id")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			respondFn := profileService.DeclineInvitation
+			if accept {
+				respondFn = profileService.AcceptInvitation
+			}
+
+			invitation, err := respondFn(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				invitationID,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to respond to membership invitation",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam),
+					slog.String("invitation_id", invitationID))
+
+				return ctx.Results.Error(invitationErrorStatus(err), httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  invitation,
+				"error": nil,
+			})
+		}
+	}
+
+	// Accept an invitation (invitee)
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_invitations/{id}/accept",
+		AuthMiddleware(authService, userService),
+		respond(true),
+	).HasDescription("Accept a membership invitation")
+
+	// Decline an invitation (invitee)
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_invitations/{id}/decline",
+		AuthMiddleware(authService, userService),
+		respond(false),
+	).HasDescription("Decline a membership invitation")
+}
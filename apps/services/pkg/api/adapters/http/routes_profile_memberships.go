@@ -310,6 +310,82 @@ func RegisterHTTPRoutesForProfileMemberships( //nolint:gocognit,gocyclo,cyclop,f
 		},
 	).HasDescription("Update a membership's access level")
 
+	// Opt a membership in to or out of the organization's aggregated story list
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_memberships/{id}/aggregate-stories",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			membershipID := ctx.Request.PathValue("id")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			var input struct {
+				Enabled *bool `json:"enabled"`
+			}
+
+			err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+			if err != nil || input.Enabled == nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("enabled is required"))
+			}
+
+			err = profileService.SetMembershipStoryAggregation(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				user.Kind,
+				user.IndividualProfileID,
+				slugParam,
+				membershipID,
+				*input.Enabled,
+			)
+			if err != nil {
+				statusCode := http.StatusInternalServerError
+
+				switch {
+				case errors.Is(err, profiles.ErrProfileNotFound),
+					errors.Is(err, profiles.ErrMembershipNotFound):
+					statusCode = http.StatusNotFound
+				case errors.Is(err, profiles.ErrInsufficientAccess):
+					statusCode = http.StatusForbidden
+				default:
+					logger.ErrorContext(ctx.Request.Context(), "Failed to update story aggregation",
+						slog.String("error", err.Error()),
+						slog.String("slug", slugParam),
+						slog.String("membershipID", membershipID))
+				}
+
+				return ctx.Results.Error(statusCode, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]bool{"aggregate_stories": *input.Enabled},
+				"error": nil,
+			})
+		},
+	).HasDescription("Opt a membership in to or out of the aggregated story list")
+
 	// Delete membership
 	routes.Route(
 		"DELETE /{locale}/profiles/{slug}/_memberships/{id}",
@@ -372,6 +448,83 @@ func RegisterHTTPRoutesForProfileMemberships( //nolint:gocognit,gocyclo,cyclop,f
 		},
 	).HasDescription("Delete a membership from a profile")
 
+	// Transfer profile ownership
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_transfer-ownership",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			var input struct {
+				MembershipID string `json:"membership_id"`
+				DemoteSelf   bool   `json:"demote_self"`
+			}
+
+			err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			if input.MembershipID == "" {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("membership_id is required"))
+			}
+
+			err = profileService.TransferProfileOwnership(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				input.MembershipID,
+				input.DemoteSelf,
+			)
+			if err != nil {
+				statusCode := http.StatusInternalServerError
+
+				switch {
+				case errors.Is(err, profiles.ErrInsufficientAccess):
+					statusCode = http.StatusForbidden
+				case errors.Is(err, profiles.ErrProfileNotFound),
+					errors.Is(err, profiles.ErrMembershipNotFound):
+					statusCode = http.StatusNotFound
+				case errors.Is(err, profiles.ErrCannotTransferIndividualProfile),
+					errors.Is(err, profiles.ErrCannotRemoveLastOwner),
+					errors.Is(err, profiles.ErrAlreadyOwner),
+					errors.Is(err, profiles.ErrInvalidMembershipKind):
+					statusCode = http.StatusBadRequest
+				default:
+					logger.ErrorContext(ctx.Request.Context(), "Failed to transfer profile ownership",
+						slog.String("error", err.Error()),
+						slog.String("slug", slugParam),
+						slog.String("membershipID", input.MembershipID))
+				}
+
+				return ctx.Results.Error(statusCode, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]string{"status": "ok"},
+				"error": nil,
+			})
+		},
+	).HasDescription(
+		"Make a member an owner of the profile. Pass demote_self to step down to maintainer.",
+	)
+
 	// Follow a profile (self-service)
 	routes.Route(
 		"POST /{locale}/profiles/{slug}/_follow",
@@ -483,4 +636,12 @@ func RegisterHTTPRoutesForProfileMemberships( //nolint:gocognit,gocyclo,cyclop,f
 			})
 		},
 	).HasDescription("Unfollow a profile")
+
+	registerHTTPRoutesForProfileMembershipInvitations(
+		routes,
+		logger,
+		authService,
+		userService,
+		profileService,
+	)
 }
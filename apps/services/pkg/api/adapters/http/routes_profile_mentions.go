@@ -0,0 +1,74 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+func RegisterHTTPRoutesForProfileMentions(
+	routes *httpfx.Router,
+	profileMentionService *profile_mentions.Service,
+) {
+	routes.
+		Route(
+			"GET /{locale}/profiles/{slug}/_mentions",
+			func(ctx *httpfx.Context) httpfx.Result {
+				localeParam, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+				slugParam := ctx.Request.PathValue("slug")
+
+				records, err := profileMentionService.ListMentionsOfProfile(
+					ctx.Request.Context(),
+					localeParam,
+					slugParam,
+				)
+				if err != nil {
+					if errors.Is(err, profile_mentions.ErrProfileNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				wrappedResponse := cursors.WrapResponseWithCursor(records, nil)
+
+				return ctx.Results.JSON(wrappedResponse)
+			},
+		).
+		HasSummary("List profile mentions").
+		HasDescription("List public stories and pages that mention the profile.").
+		HasResponse(http.StatusOK)
+}
+
+// renderContentMentions rewrites resolved mentions in content into profile links.
+func renderContentMentions(
+	ctx context.Context,
+	profileMentionService *profile_mentions.Service,
+	sourceKind string,
+	sourceID string,
+	localeCode string,
+	content string,
+) string {
+	if profileMentionService == nil || content == "" {
+		return content
+	}
+
+	return profileMentionService.RenderMentionLinks(
+		ctx,
+		sourceKind,
+		sourceID,
+		strings.TrimSpace(localeCode),
+		content,
+	)
+}
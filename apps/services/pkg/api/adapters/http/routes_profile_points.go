@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
@@ -81,6 +82,10 @@ func RegisterHTTPRoutesForProfilePoints( //nolint:cyclop,funlen
 					slugParam,
 				)
 				if err != nil {
+					if errors.Is(err, profiles.ErrProfileNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+					}
+
 					return ctx.Results.Error(
 						http.StatusInternalServerError,
 						httpfx.WithSanitizedError(err),
@@ -69,6 +69,10 @@ func RegisterHTTPRoutesForProfileQuestions( //nolint:funlen,gocognit,cyclop,main
 				nil,
 			)
 			if err != nil {
+				if errors.Is(err, profile_questions.ErrProfileNotFound) {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+				}
+
 				if errors.Is(err, profile_questions.ErrQANotEnabled) {
 					return ctx.Results.Error(
 						http.StatusNotFound,
@@ -129,6 +133,10 @@ func RegisterHTTPRoutesForProfileQuestions( //nolint:funlen,gocognit,cyclop,main
 				},
 			)
 			if err != nil {
+				if errors.Is(err, profile_questions.ErrProfileNotFound) {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+				}
+
 				if errors.Is(err, profile_questions.ErrQANotEnabled) {
 					return ctx.Results.Error(
 						http.StatusNotFound,
@@ -141,6 +149,13 @@ func RegisterHTTPRoutesForProfileQuestions( //nolint:funlen,gocognit,cyclop,main
 					return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
 				}
 
+				if errors.Is(err, profile_questions.ErrRateLimitExceeded) {
+					return ctx.Results.Error(
+						http.StatusTooManyRequests,
+						httpfx.WithErrorMessage("too many questions asked, try again later"),
+					)
+				}
+
 				logger.ErrorContext(ctx.Request.Context(), "Failed to create question",
 					slog.String("error", err.Error()),
 					slog.String("slug", slugParam))
@@ -179,6 +194,10 @@ func RegisterHTTPRoutesForProfileQuestions( //nolint:funlen,gocognit,cyclop,main
 				},
 			)
 			if err != nil {
+				if errors.Is(err, profile_questions.ErrProfileNotFound) {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+				}
+
 				if errors.Is(err, profile_questions.ErrQANotEnabled) {
 					return ctx.Results.Error(
 						http.StatusNotFound,
@@ -261,21 +280,51 @@ func RegisterHTTPRoutesForProfileQuestions( //nolint:funlen,gocognit,cyclop,main
 				},
 			)
 			if err != nil {
-				if errors.Is(err, profile_questions.ErrInsufficientPermission) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("insufficient permission"),
-					)
-				}
+				return handleQuestionModerationError(
+					ctx, logger, err, "Failed to hide question", slugParam, questionID,
+				)
+			}
 
-				logger.ErrorContext(ctx.Request.Context(), "Failed to hide question",
-					slog.String("error", err.Error()),
-					slog.String("slug", slugParam),
-					slog.String("questionID", questionID))
+			return ctx.Results.JSON(map[string]any{
+				"data": map[string]string{"status": "ok"},
+			})
+		},
+	).HasDescription("Hide or unhide a Q&A question (maintainer+ access)")
 
-				return ctx.Results.Error(
-					http.StatusInternalServerError,
-					httpfx.WithSanitizedError(err),
+	// Resolve/reopen a question without answering it (requires maintainer+ access)
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_questions/{id}/resolve",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			questionID := ctx.Request.PathValue("id")
+
+			var body struct {
+				IsResolved bool `json:"is_resolved"`
+			}
+
+			err = json.NewDecoder(ctx.Request.Body).Decode(&body)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("invalid request body"))
+			}
+
+			err = profileQuestionsService.ResolveQuestion(
+				ctx.Request.Context(),
+				profile_questions.ResolveQuestionParams{
+					ProfileSlug: slugParam,
+					QuestionID:  questionID,
+					UserID:      user.ID,
+					IsResolved:  body.IsResolved,
+				},
+			)
+			if err != nil {
+				return handleQuestionModerationError(
+					ctx, logger, err, "Failed to resolve question", slugParam, questionID,
 				)
 			}
 
@@ -283,7 +332,39 @@ func RegisterHTTPRoutesForProfileQuestions( //nolint:funlen,gocognit,cyclop,main
 				"data": map[string]string{"status": "ok"},
 			})
 		},
-	).HasDescription("Hide or unhide a Q&A question (maintainer+ access)")
+	).HasDescription("Resolve or reopen a Q&A question (maintainer+ access)")
+}
+
+// handleQuestionModerationError maps business errors from maintainer actions on a
+// question to HTTP responses.
+func handleQuestionModerationError(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	err error,
+	operation, slugParam, questionID string,
+) httpfx.Result {
+	if errors.Is(err, profile_questions.ErrInsufficientPermission) {
+		return ctx.Results.Error(
+			http.StatusForbidden,
+			httpfx.WithErrorMessage("insufficient permission"),
+		)
+	}
+
+	if errors.Is(err, profile_questions.ErrProfileNotFound) ||
+		errors.Is(err, profile_questions.ErrQANotEnabled) ||
+		errors.Is(err, profile_questions.ErrQuestionNotFound) {
+		return ctx.Results.NotFound(httpfx.WithErrorMessage("question not found"))
+	}
+
+	logger.ErrorContext(ctx.Request.Context(), operation,
+		slog.String("error", err.Error()),
+		slog.String("slug", slugParam),
+		slog.String("questionID", questionID))
+
+	return ctx.Results.Error(
+		http.StatusInternalServerError,
+		httpfx.WithSanitizedError(err),
+	)
 }
 
 const answerModeEdit = "edit"
@@ -311,6 +392,11 @@ func questionAnswerHandler( //nolint:funlen
 			return ctx.Results.BadRequest(httpfx.WithErrorMessage(noProfileMsg))
 		}
 
+		localeParam, localeOk := validateLocale(ctx)
+		if !localeOk {
+			return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+		}
+
 		slugParam := ctx.Request.PathValue("slug")
 		questionID := ctx.Request.PathValue("id")
 
@@ -337,6 +423,7 @@ func questionAnswerHandler( //nolint:funlen
 			AnswerContent:     body.AnswerContent,
 			AnswerURI:         body.AnswerURI,
 			AnswerKind:        body.AnswerKind,
+			Locale:            localeParam,
 		}
 
 		if mode == answerModeEdit {
@@ -369,7 +456,9 @@ func handleQuestionAnswerError(
 		)
 	}
 
-	if errors.Is(err, profile_questions.ErrQuestionNotFound) {
+	if errors.Is(err, profile_questions.ErrProfileNotFound) ||
+		errors.Is(err, profile_questions.ErrQANotEnabled) ||
+		errors.Is(err, profile_questions.ErrQuestionNotFound) {
 		return ctx.Results.NotFound(httpfx.WithErrorMessage("question not found"))
 	}
 
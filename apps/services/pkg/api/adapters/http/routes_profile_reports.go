@@ -0,0 +1,132 @@
+package http
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx/middlewares"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// RegisterHTTPRoutesForProfileReports registers the endpoints that report
+// abusive profiles, pages and links to the moderation queue. Reporting does
+// not require a session; anonymous reporters are told apart by IP address.
+// The endpoints require a CAPTCHA token when "report" is a configured
+// CAPTCHA endpoint.
+func RegisterHTTPRoutesForProfileReports(
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+	protectionService *protection.Service,
+) {
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_report",
+		func(ctx *httpfx.Context) httpfx.Result {
+			return handleProfileReport(
+				ctx, logger, authService, userService, profileService, protectionService,
+				profiles.ReportTargetProfile, "",
+			)
+		}).
+		HasSummary("Report Profile").
+		HasDescription(
+			"Report an abusive profile to the moderation queue with a reason and optional text. " +
+				"Reporting the same profile again while the report is open returns that report.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/pages/{pageSlug}/_report",
+		func(ctx *httpfx.Context) httpfx.Result {
+			return handleProfileReport(
+				ctx, logger, authService, userService, profileService, protectionService,
+				profiles.ReportTargetPage, ctx.Request.PathValue("pageSlug"),
+			)
+		}).
+		HasSummary("Report Profile Page").
+		HasDescription("Report an abusive profile page to the moderation queue.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/links/{linkId}/_report",
+		func(ctx *httpfx.Context) httpfx.Result {
+			return handleProfileReport(
+				ctx, logger, authService, userService, profileService, protectionService,
+				profiles.ReportTargetLink, ctx.Request.PathValue("linkId"),
+			)
+		}).
+		HasSummary("Report Profile Link").
+		HasDescription("Report an abusive profile link to the moderation queue.").
+		HasResponse(http.StatusOK)
+}
+
+// handleProfileReport is the shared handler of the report endpoints.
+func handleProfileReport(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+	protectionService *protection.Service,
+	targetKind profiles.ReportTargetKind,
+	targetSlug string,
+) httpfx.Result {
+	localeParam, localeOk := validateLocale(ctx)
+	if !localeOk {
+		return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+	}
+
+	if result, ok := verifyCaptcha(ctx, logger, protectionService, protection.CaptchaEndpointReport); !ok {
+		return result
+	}
+
+	var requestBody struct {
+		Text   *string `json:"text"`
+		Reason string  `json:"reason"`
+	}
+
+	err := ctx.ParseJSONBody(&requestBody)
+	if err != nil {
+		return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+	}
+
+	slugParam := ctx.Request.PathValue("slug")
+
+	report, err := profileService.Report(ctx.Request.Context(), profiles.ReportParams{
+		ReporterUserID: GetViewerUserID(ctx.Request, authService, userService),
+		Text:           requestBody.Text,
+		ReporterIPHash: protection.HashIP(middlewares.GetClientAddrs(ctx.Request)),
+		LocaleCode:     localeParam,
+		ProfileSlug:    slugParam,
+		TargetKind:     targetKind,
+		TargetSlug:     targetSlug,
+		Reason:         profiles.ReportReason(requestBody.Reason),
+	})
+	if err != nil {
+		if result, ok := profileErrorResult(ctx, err); ok {
+			return result
+		}
+
+		logger.ErrorContext(ctx.Request.Context(), "Reporting failed",
+			slog.String("error", err.Error()),
+			slog.String("slug", slugParam),
+			slog.String("target_kind", string(targetKind)),
+			slog.String("target", targetSlug))
+
+		return ctx.Results.Error(
+			http.StatusInternalServerError,
+			httpfx.WithErrorMessage("Failed to file report"),
+		)
+	}
+
+	return ctx.Results.JSON(map[string]any{
+		"data":  report,
+		"error": nil,
+	})
+}
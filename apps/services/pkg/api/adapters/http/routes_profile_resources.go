@@ -2,6 +2,7 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -60,22 +61,45 @@ func RegisterHTTPRoutesForProfileResources( //nolint:gocognit,gocyclo,cyclop,fun
 				)
 			}
 
+			query := ctx.Request.URL.Query()
+
+			filter := profiles.ProfileResourceFilter{
+				Kind:   nil,
+				TeamID: nil,
+				Sort:   profiles.ResourceSortMode(query.Get("sort")),
+			}
+
+			if kind := query.Get("kind"); kind != "" {
+				filter.Kind = &kind
+			}
+
+			if teamID := query.Get("team"); teamID != "" {
+				filter.TeamID = &teamID
+			}
+
 			resources, err := profileService.ListProfileResources(
 				ctx.Request.Context(),
 				localeParam,
 				*session.LoggedInUserID,
 				user.Kind,
 				slugParam,
+				filter,
 			)
 			if err != nil {
 				logger.ErrorContext(ctx.Request.Context(), "Failed to list profile resources",
 					slog.String("error", err.Error()),
 					slog.String("slug", slugParam))
 
-				return ctx.Results.Error(
-					http.StatusInternalServerError,
-					httpfx.WithSanitizedError(err),
-				)
+				statusCode := http.StatusInternalServerError
+
+				switch {
+				case errors.Is(err, profiles.ErrInvalidInput):
+					statusCode = http.StatusBadRequest
+				case errors.Is(err, profiles.ErrProfileNotFound):
+					statusCode = http.StatusNotFound
+				}
+
+				return ctx.Results.Error(statusCode, httpfx.WithSanitizedError(err))
 			}
 
 			return ctx.Results.JSON(map[string]any{
@@ -84,7 +108,8 @@ func RegisterHTTPRoutesForProfileResources( //nolint:gocognit,gocyclo,cyclop,fun
 			})
 		}).
 		HasSummary("List Profile Resources").
-		HasDescription("List all resources for a profile.").
+		HasDescription("List the resources of a profile, optionally filtered by ?kind= and ?team= " +
+			"and ordered by ?sort=manual|recent|alphabetical (manual by default).").
 		HasResponse(http.StatusOK)
 
 	// List accessible GitHub repositories for adding as resources
@@ -408,6 +433,85 @@ func RegisterHTTPRoutesForProfileResources( //nolint:gocognit,gocyclo,cyclop,fun
 		HasDescription("Assign teams to a resource.").
 		HasResponse(http.StatusOK)
 
+	// Reorder profile resources
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_resources/_reorder",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			_, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+			slugParam := ctx.Request.PathValue("slug")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			var input struct {
+				Items []profiles.ResourceOrderItem `json:"items"`
+			}
+
+			err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			err = profileService.ReorderProfileResources(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				user.Kind,
+				slugParam,
+				input.Items,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to reorder profile resources",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam))
+
+				statusCode := http.StatusInternalServerError
+
+				switch {
+				case errors.Is(err, profiles.ErrInsufficientAccess):
+					statusCode = http.StatusForbidden
+				case errors.Is(err, profiles.ErrProfileNotFound):
+					statusCode = http.StatusNotFound
+				case errors.Is(err, profiles.ErrInvalidInput):
+					statusCode = http.StatusBadRequest
+				}
+
+				return ctx.Results.Error(statusCode, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]string{"status": "ok"},
+				"error": nil,
+			})
+		}).
+		HasSummary("Reorder Profile Resources").
+		HasDescription("Set the display order and featured flags of every resource of a profile.").
+		HasResponse(http.StatusOK)
+
 	// Delete profile resource
 	routes.Route(
 		"DELETE /{locale}/profiles/{slug}/_resources/{id}",
@@ -472,4 +576,77 @@ func RegisterHTTPRoutesForProfileResources( //nolint:gocognit,gocyclo,cyclop,fun
 		HasSummary("Delete Profile Resource").
 		HasDescription("Remove a resource from a profile.").
 		HasResponse(http.StatusOK)
+
+	// Restore a soft-deleted profile resource
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_resources/{id}/_restore",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			_, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+			slugParam := ctx.Request.PathValue("slug")
+			resourceID := ctx.Request.PathValue("id")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			resource, err := profileService.RestoreProfileResource(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				user.Kind,
+				slugParam,
+				resourceID,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to restore profile resource",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam),
+					slog.String("resource_id", resourceID))
+
+				statusCode := http.StatusInternalServerError
+
+				switch {
+				case errors.Is(err, profiles.ErrUnauthorized):
+					statusCode = http.StatusForbidden
+				case errors.Is(err, profiles.ErrProfileNotFound),
+					errors.Is(err, profiles.ErrResourceNotFound):
+					statusCode = http.StatusNotFound
+				case errors.Is(err, profiles.ErrDuplicateRecord):
+					statusCode = http.StatusConflict
+				}
+
+				return ctx.Results.Error(statusCode, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  resource,
+				"error": nil,
+			})
+		}).
+		HasSummary("Restore Profile Resource").
+		HasDescription("Restore a removed resource unless another resource has taken its remote ID.").
+		HasResponse(http.StatusOK)
 }
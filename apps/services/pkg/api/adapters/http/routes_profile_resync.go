@@ -0,0 +1,154 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// RegisterHTTPRoutesForProfileResync registers the routes that let profile
+// maintainers sync their managed links without waiting for the next interval.
+func RegisterHTTPRoutesForProfileResync( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+	syncService *linksync.Service,
+) {
+	// resolveProfileID returns the ID of the profile in the path when the
+	// session user maintains it, or the result to respond with otherwise.
+	resolveProfileID := func(ctx *httpfx.Context) (string, *httpfx.Result) {
+		slugParam := ctx.Request.PathValue("slug")
+
+		sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+		if !ok {
+			result := ctx.Results.Unauthorized(httpfx.WithErrorMessage("Session ID not found"))
+
+			return "", &result
+		}
+
+		session, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+		if err != nil || session == nil || session.LoggedInUserID == nil {
+			result := ctx.Results.Unauthorized(httpfx.WithErrorMessage("Invalid session"))
+
+			return "", &result
+		}
+
+		canEdit, err := profileService.HasUserAccessToProfile(
+			ctx.Request.Context(), *session.LoggedInUserID, slugParam,
+			profiles.MembershipKindMaintainer,
+		)
+		if err != nil {
+			result := ctx.Results.Error(
+				http.StatusInternalServerError,
+				httpfx.WithSanitizedError(err),
+			)
+
+			return "", &result
+		}
+
+		if !canEdit {
+			result := ctx.Results.Error(http.StatusForbidden,
+				httpfx.WithErrorMessage("You do not have permission to resync this profile"))
+
+			return "", &result
+		}
+
+		profileID, err := profileService.GetProfileIDBySlug(ctx.Request.Context(), slugParam)
+		if err != nil {
+			result := ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+
+			return "", &result
+		}
+
+		return profileID, nil
+	}
+
+	routes.
+		Route(
+			"POST /{locale}/profiles/{slug}/_resync/{provider}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				profileID, failure := resolveProfileID(ctx)
+				if failure != nil {
+					return *failure
+				}
+
+				job, err := syncService.RequestProfileResync(
+					ctx.Request.Context(),
+					profileID,
+					ctx.Request.PathValue("provider"),
+				)
+				if err != nil {
+					if errors.Is(err, linksync.ErrUnsupportedResyncProvider) {
+						return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+					}
+
+					logger.Error("failed to request profile resync", "error", err)
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  job,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Resync profile links").
+		HasDescription(
+			"Queue an immediate sync of the profile's managed links of a provider. " +
+				"Returns the job, or the one already pending for the same provider. Maintainer only.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.
+		Route(
+			"GET /{locale}/profiles/{slug}/_resync/{provider}/{jobId}",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				profileID, failure := resolveProfileID(ctx)
+				if failure != nil {
+					return *failure
+				}
+
+				job, err := syncService.GetProfileResync(
+					ctx.Request.Context(),
+					profileID,
+					ctx.Request.PathValue("jobId"),
+				)
+				if err != nil {
+					if errors.Is(err, linksync.ErrResyncJobNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("resync job not found"))
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				if job.Provider != ctx.Request.PathValue("provider") {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("resync job not found"))
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  job,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Get profile resync status").
+		HasDescription("Get the status of a profile resync job. Maintainer only.").
+		HasResponse(http.StatusOK)
+}
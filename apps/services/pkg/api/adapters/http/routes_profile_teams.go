@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -312,4 +313,229 @@ func RegisterHTTPRoutesForProfileTeams( //nolint:gocognit,cyclop,funlen,maintidx
 			})
 		},
 	).HasDescription("Set teams for a membership")
+
+	// Add a team to several memberships at once
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_teams/{teamId}/members",
+		AuthMiddleware(authService, userService),
+		bulkTeamMembershipsHandler(
+			logger,
+			userService,
+			"Failed to add team to memberships",
+			profileService.AddTeamToMemberships,
+		),
+	).HasDescription("Add a team to several memberships")
+
+	// Remove a team from several memberships at once
+	routes.Route(
+		"DELETE /{locale}/profiles/{slug}/_teams/{teamId}/members",
+		AuthMiddleware(authService, userService),
+		bulkTeamMembershipsHandler(
+			logger,
+			userService,
+			"Failed to remove team from memberships",
+			profileService.RemoveTeamFromMemberships,
+		),
+	).HasDescription("Remove a team from several memberships")
+
+	// Set team lead
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_teams/{teamId}/lead",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			teamID := ctx.Request.PathValue("teamId")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			var input struct {
+				MembershipID string `json:"membership_id"`
+			}
+
+			err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			if input.MembershipID == "" {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("membership_id is required"))
+			}
+
+			err = profileService.SetTeamLead(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				teamID,
+				input.MembershipID,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to set team lead",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam),
+					slog.String("teamID", teamID))
+
+				return ctx.Results.Error(teamLeadErrorStatus(err), httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]string{"status": "ok"},
+				"error": nil,
+			})
+		},
+	).HasDescription("Designate a team member as the team lead")
+
+	// Clear team lead
+	routes.Route(
+		"DELETE /{locale}/profiles/{slug}/_teams/{teamId}/lead",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			teamID := ctx.Request.PathValue("teamId")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			err := profileService.ClearTeamLead(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				teamID,
+			)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to clear team lead",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam),
+					slog.String("teamID", teamID))
+
+				return ctx.Results.Error(teamLeadErrorStatus(err), httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]string{"status": "ok"},
+				"error": nil,
+			})
+		},
+	).HasDescription("Clear the lead of a team")
+}
+
+// teamLeadErrorStatus maps team lead errors to HTTP status codes.
+func teamLeadErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, profiles.ErrInsufficientAccess):
+		return http.StatusForbidden
+	case errors.Is(err, profiles.ErrProfileNotFound),
+		errors.Is(err, profiles.ErrTeamNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, profiles.ErrTeamLeadNotMember):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, profiles.ErrInvalidInput):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// bulkTeamMembershipsHandler builds the handler shared by the bulk team membership routes.
+// The body is {"membership_ids": [...]}; the response reports how many memberships changed.
+func bulkTeamMembershipsHandler(
+	logger *logfx.Logger,
+	userService *users.Service,
+	failureMessage string,
+	apply func(
+		ctx context.Context,
+		userID string,
+		profileSlug string,
+		teamID string,
+		membershipIDs []string,
+	) (int64, error),
+) httpfx.Handler {
+	return func(ctx *httpfx.Context) httpfx.Result {
+		sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+		if !ok {
+			return ctx.Results.Error(
+				http.StatusInternalServerError,
+				httpfx.WithErrorMessage("Session ID not found in context"),
+			)
+		}
+
+		slugParam := ctx.Request.PathValue("slug")
+		teamID := ctx.Request.PathValue("teamId")
+
+		session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+		if sessionErr != nil {
+			return ctx.Results.Error(
+				http.StatusInternalServerError,
+				httpfx.WithErrorMessage("Failed to get session information"),
+			)
+		}
+
+		var input struct {
+			MembershipIDs []string `json:"membership_ids"`
+		}
+
+		err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+		if err != nil {
+			return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+		}
+
+		affected, err := apply(
+			ctx.Request.Context(),
+			*session.LoggedInUserID,
+			slugParam,
+			teamID,
+			input.MembershipIDs,
+		)
+		if err != nil {
+			logger.ErrorContext(ctx.Request.Context(), failureMessage,
+				slog.String("error", err.Error()),
+				slog.String("slug", slugParam),
+				slog.String("teamID", teamID))
+
+			statusCode := http.StatusInternalServerError
+
+			switch {
+			case errors.Is(err, profiles.ErrInsufficientAccess):
+				statusCode = http.StatusForbidden
+			case errors.Is(err, profiles.ErrProfileNotFound),
+				errors.Is(err, profiles.ErrTeamNotFound):
+				statusCode = http.StatusNotFound
+			case errors.Is(err, profiles.ErrInvalidInput):
+				statusCode = http.StatusBadRequest
+			}
+
+			return ctx.Results.Error(statusCode, httpfx.WithSanitizedError(err))
+		}
+
+		return ctx.Results.JSON(map[string]any{
+			"data":  map[string]any{"affected": affected},
+			"error": nil,
+		})
+	}
 }
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+// maxTranslationFileBytes caps the size of an imported translation file.
+const maxTranslationFileBytes = 5 << 20
+
+// RegisterHTTPRoutesForProfileTranslations registers the bulk export and
+// import of profile translations in XLIFF and JSON files.
+func RegisterHTTPRoutesForProfileTranslations(
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+) {
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_translations/export",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			query := ctx.Request.URL.Query()
+
+			format, err := profiles.ParseTranslationFormat(query.Get("format"))
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+			}
+
+			bundle, err := profileService.ExportTranslations(
+				ctx.Request.Context(),
+				user.ID,
+				slugParam,
+				query.Get("source"),
+				query.Get("target"),
+			)
+			if err != nil {
+				return translationFileErrorResult(ctx, logger, err, user.ID, slugParam,
+					"Profile translation export failed")
+			}
+
+			data, err := profiles.EncodeTranslationBundle(bundle, format)
+			if err != nil {
+				return translationFileErrorResult(ctx, logger, err, user.ID, slugParam,
+					"Profile translation export failed")
+			}
+
+			header := ctx.ResponseWriter.Header()
+			header.Set("Content-Type", format.ContentType())
+			header.Set("Content-Disposition", fmt.Sprintf(
+				`attachment; filename="%s-%s-%s.%s"`,
+				slugParam,
+				bundle.SourceLocale,
+				bundle.TargetLocale,
+				format.FileExtension(),
+			))
+			header.Set("Cache-Control", "no-store")
+
+			return ctx.Results.Bytes(data)
+		}).
+		HasSummary("Export Profile Translations").
+		HasDescription(
+			"Download every translatable string of a profile, its pages and its links for a " +
+				"source/target locale pair. format is xliff (XLIFF 1.2) or json; source defaults to " +
+				"the profile's default locale. Requires maintainer access.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_translations/import",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			format, err := profiles.ParseTranslationFormat(ctx.Request.URL.Query().Get("format"))
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+			}
+
+			data, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxTranslationFileBytes+1))
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			if len(data) > maxTranslationFileBytes {
+				return ctx.Results.Error(
+					http.StatusRequestEntityTooLarge,
+					httpfx.WithErrorMessage("Translation file is too large"),
+				)
+			}
+
+			bundle, err := profiles.DecodeTranslationBundle(data, format)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+			}
+
+			result, err := profileService.ImportTranslations(
+				ctx.Request.Context(),
+				user.ID,
+				user.Kind,
+				slugParam,
+				bundle,
+			)
+			if err != nil {
+				return translationFileErrorResult(ctx, logger, err, user.ID, slugParam,
+					"Profile translation import failed")
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  result,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Import Profile Translations").
+		HasDescription(
+			"Apply a translated XLIFF or JSON file, as produced by the export endpoint, to the " +
+				"target locale of a profile. The file structure is validated first; the result then " +
+				"reports each string as applied, skipped or failed. Requires maintainer access.",
+		).
+		HasResponse(http.StatusOK)
+}
+
+func translationFileErrorResult(
+	ctx *httpfx.Context,
+	logger *logfx.Logger,
+	err error,
+	userID string,
+	slug string,
+	message string,
+) httpfx.Result {
+	switch {
+	case errors.Is(err, profiles.ErrInsufficientAccess),
+		errors.Is(err, profiles.ErrUnauthorized):
+		return ctx.Results.Error(http.StatusForbidden, httpfx.WithSanitizedError(err))
+	case errors.Is(err, profiles.ErrProfileNotFound):
+		return ctx.Results.NotFound(httpfx.WithSanitizedError(err))
+	case errors.Is(err, profiles.ErrInvalidInput),
+		errors.Is(err, profiles.ErrInvalidTranslationFile):
+		return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+	}
+
+	logger.ErrorContext(ctx.Request.Context(), message,
+		slog.String("error", err.Error()),
+		slog.String("user_id", userID),
+		slog.String("slug", slug))
+
+	return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithErrorMessage(message))
+}
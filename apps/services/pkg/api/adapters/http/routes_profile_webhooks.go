@@ -0,0 +1,186 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+)
+
+// RegisterHTTPRoutesForProfileWebhooks registers the routes for managing the
+// webhook endpoints a profile's membership events are sent to.
+func RegisterHTTPRoutesForProfileWebhooks( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+	webhookService *webhooks.Service,
+) {
+	// resolveProfile returns the ID of the profile in the path once the user is
+	// known to maintain it, or the result to respond with instead.
+	resolveProfile := func(ctx *httpfx.Context) (string, *httpfx.Result) {
+		user, err := getUserFromContext(ctx, userService)
+		if err != nil {
+			result := ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+
+			return "", &result
+		}
+
+		slugParam := ctx.Request.PathValue("slug")
+
+		hasAccess, err := profileService.HasUserAccessToProfile(
+			ctx.Request.Context(),
+			user.ID,
+			slugParam,
+			profiles.MembershipKindMaintainer,
+		)
+		if err != nil {
+			result := ctx.Results.Error(
+				http.StatusInternalServerError,
+				httpfx.WithErrorMessage("Failed to check profile access"),
+			)
+
+			return "", &result
+		}
+
+		if !hasAccess {
+			result := ctx.Results.Error(
+				http.StatusForbidden,
+				httpfx.WithErrorMessage("Maintainer access required"),
+			)
+
+			return "", &result
+		}
+
+		profileID, err := profileService.GetProfileIDBySlug(ctx.Request.Context(), slugParam)
+		if err != nil {
+			result := ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+
+			return "", &result
+		}
+
+		return profileID, nil
+	}
+
+	// List webhook endpoints
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_webhooks",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			profileID, failure := resolveProfile(ctx)
+			if failure != nil {
+				return *failure
+			}
+
+			endpoints, err := webhookService.ListProfileEndpoints(ctx.Request.Context(), profileID)
+			if err != nil {
+				logger.ErrorContext(ctx.Request.Context(), "Failed to list webhook endpoints",
+					slog.String("error", err.Error()),
+					slog.String("profile_id", profileID))
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to list webhook endpoints"),
+				)
+			}
+
+			if endpoints == nil {
+				endpoints = []*webhooks.Endpoint{}
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  endpoints,
+				"error": nil,
+			})
+		},
+	).HasDescription("List the webhook endpoints of a profile")
+
+	// Register a webhook endpoint
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_webhooks",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			profileID, failure := resolveProfile(ctx)
+			if failure != nil {
+				return *failure
+			}
+
+			var input struct {
+				TargetURL string `json:"target_url"`
+			}
+
+			err := json.NewDecoder(ctx.Request.Body).Decode(&input)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			endpoint, err := webhookService.CreateProfileEndpoint(
+				ctx.Request.Context(),
+				profileID,
+				input.TargetURL,
+			)
+			if err != nil {
+				if errors.Is(err, webhooks.ErrInvalidEndpointURL) {
+					return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Failed to create webhook endpoint",
+					slog.String("error", err.Error()),
+					slog.String("profile_id", profileID))
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to create webhook endpoint"),
+				)
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  endpoint,
+				"error": nil,
+			})
+		},
+	).HasDescription("Register a webhook endpoint; the response holds its signing secret")
+
+	// Remove a webhook endpoint
+	routes.Route(
+		"DELETE /{locale}/profiles/{slug}/_webhooks/{id}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			profileID, failure := resolveProfile(ctx)
+			if failure != nil {
+				return *failure
+			}
+
+			endpointID := ctx.Request.PathValue("id")
+
+			err := webhookService.DeleteProfileEndpoint(ctx.Request.Context(), profileID, endpointID)
+			if err != nil {
+				if errors.Is(err, webhooks.ErrEndpointNotFound) {
+					return ctx.Results.NotFound(httpfx.WithSanitizedError(err))
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Failed to delete webhook endpoint",
+					slog.String("error", err.Error()),
+					slog.String("endpoint_id", endpointID))
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to delete webhook endpoint"),
+				)
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]string{"status": "ok"},
+				"error": nil,
+			})
+		},
+	).HasDescription("Remove a webhook endpoint of a profile")
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx/middlewares"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+// RegisterHTTPRoutesForProfileWebmentions registers the webmention receiving
+// endpoint of profiles and the listing of their verified mentions.
+func RegisterHTTPRoutesForProfileWebmentions(
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	config *webmentions.Config,
+	webmentionService *webmentions.Service,
+) {
+	// Receive a webmention
+	routes.
+		Route(
+			"POST /{locale}/profiles/{slug}/_webmention",
+			middlewares.RateLimitMiddleware(
+				middlewares.WithRateLimiterRequestsPerMinute(config.RateLimitPerMinute),
+			),
+			func(ctx *httpfx.Context) httpfx.Result {
+				err := ctx.Request.ParseForm()
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+				}
+
+				mention, err := webmentionService.Receive(
+					ctx.Request.Context(),
+					ctx.Request.PathValue("slug"),
+					ctx.Request.PostFormValue("source"),
+					ctx.Request.PostFormValue("target"),
+				)
+				if err != nil {
+					switch {
+					case errors.Is(err, webmentions.ErrProfileNotFound):
+						return ctx.Results.NotFound(httpfx.WithSanitizedError(err))
+					case errors.Is(err, webmentions.ErrInvalidSource),
+						errors.Is(err, webmentions.ErrInvalidTarget),
+						errors.Is(err, webmentions.ErrSameSourceAndTarget),
+						errors.Is(err, webmentions.ErrTargetNotOwned):
+						return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+					}
+
+					logger.ErrorContext(ctx.Request.Context(), "Failed to receive webmention",
+						slog.String("error", err.Error()),
+						slog.String("slug", ctx.Request.PathValue("slug")))
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Failed to receive webmention"),
+					)
+				}
+
+				return ctx.Results.Accepted(httpfx.WithJSON(map[string]any{
+					"data":  mention,
+					"error": nil,
+				}))
+			},
+		).
+		HasSummary("Receive webmention").
+		HasDescription(
+			"Webmention receiver. Takes form-encoded source and target; the target must be " +
+				"a page on one of the profile's custom domains. The source is verified asynchronously.",
+		).
+		HasResponse(http.StatusAccepted)
+
+	// List verified webmentions
+	routes.
+		Route(
+			"GET /{locale}/profiles/{slug}/_webmentions",
+			func(ctx *httpfx.Context) httpfx.Result {
+				cursor := cursors.NewCursorFromRequest(ctx.Request)
+
+				mentions, err := webmentionService.ListVerified(
+					ctx.Request.Context(),
+					ctx.Request.PathValue("slug"),
+					cursor,
+				)
+				if err != nil {
+					if errors.Is(err, webmentions.ErrProfileNotFound) {
+						return ctx.Results.NotFound(httpfx.WithSanitizedError(err))
+					}
+
+					logger.ErrorContext(ctx.Request.Context(), "Failed to list webmentions",
+						slog.String("error", err.Error()),
+						slog.String("slug", ctx.Request.PathValue("slug")))
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Failed to list webmentions"),
+					)
+				}
+
+				return ctx.Results.JSON(mentions)
+			},
+		).
+		HasSummary("List profile webmentions").
+		HasDescription("List the verified webmentions of a profile, newest first.").
+		HasResponse(http.StatusOK)
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	bulletinbiz "github.com/eser/aya.is/services/pkg/api/business/bulletin"
 	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/api/business/stories"
@@ -23,18 +25,13 @@ import (
 	"github.com/eser/aya.is/services/pkg/lib/cursors"
 )
 
-// Slug validation regex for pages.
-var pageSlugRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
-
 // Profile validation constants.
 const (
 	maxTitleLength       = 100
-	maxPageTitleLength   = 200
 	maxDescriptionLength = 500
 	minSlugLength        = 2
 	maxSlugLength        = 50
 	maxSummaryDuration   = 90
-	errMsgUnauthorized   = "unauthorized"
 
 	visitWindowMinutes = 15
 )
@@ -50,6 +47,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 	aiModels *aifx.Registry,
 	bulletinService *bulletinbiz.Service,
 	auditService *events.AuditService,
+	profileMentionService *profile_mentions.Service,
+	features Features,
 ) {
 	routes.
 		Route("GET /{locale}/profiles", func(ctx *httpfx.Context) httpfx.Result {
@@ -72,8 +71,20 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				}
 			}
 
+			if hiring := ctx.Request.URL.Query().Get("hiring"); hiring != "" {
+				cursor.Filters[profiles.FilterHiring] = hiring
+			}
+
+			if available := ctx.Request.URL.Query().Get("available"); available != "" {
+				cursor.Filters[profiles.FilterAvailable] = available
+			}
+
 			records, err := profileService.List(ctx.Request.Context(), localeParam, cursor)
 			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
 					httpfx.WithSanitizedError(err),
@@ -83,7 +94,96 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			return ctx.Results.JSON(records)
 		}).
 		HasSummary("List profiles").
-		HasDescription("List profiles.").
+		HasDescription(
+			"List profiles. Pass hiring=true to only list profiles that are hiring, " +
+				"available=true to only list profiles with an unexpired availability status.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.
+		Route("GET /{locale}/leaderboard", func(ctx *httpfx.Context) httpfx.Result {
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+
+			cursor := cursors.NewCursorFromRequest(ctx.Request)
+
+			records, err := profileService.GetPointsLeaderboard(
+				ctx.Request.Context(),
+				localeParam,
+				cursor.Filters["kind"],
+				cursor,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithSanitizedError(err),
+				)
+			}
+
+			return ctx.Results.JSON(records)
+		}).
+		HasSummary("Points leaderboard").
+		HasDescription(
+			"List profiles by points, highest first. Pass filter_kind to limit it to one profile kind " +
+				"and the returned cursor as the offset query parameter to get the next page.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.
+		Route(
+			"GET /{locale}/me/_recent",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				localeParam, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+
+				sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+				if !ok {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Session ID not found in context"),
+					)
+				}
+
+				session, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+				if err != nil {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Failed to get session information"),
+					)
+				}
+
+				records, err := profileService.ListRecentlyViewedProfiles(
+					ctx.Request.Context(),
+					localeParam,
+					*session.LoggedInUserID,
+				)
+				if err != nil {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(map[string]any{
+					"data":  records,
+					"error": nil,
+				})
+			},
+		).
+		HasSummary("Recently viewed profiles").
+		HasDescription(
+			"List the profiles the current user viewed recently, most recent first. " +
+				"The user's own profiles are not included.",
+		).
 		HasResponse(http.StatusOK)
 
 	routes.
@@ -108,6 +208,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				viewerUserID,
 			)
 			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
 					httpfx.WithSanitizedError(err),
@@ -115,7 +219,7 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			}
 
 			// Record profile visit (deduplicated per 15-minute window)
-			if record != nil {
+			if record != nil && features.Analytics {
 				go func() {
 					sessionID := GetSessionIDFromRequest(ctx.Request, authService)
 					actorKey := sessionID
@@ -155,9 +259,25 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				}()
 			}
 
+			// Remember the profile in the viewer's recently viewed list
+			if record != nil && viewerUserID != nil {
+				recordCtx := context.WithoutCancel(ctx.Request.Context())
+				userID := *viewerUserID
+
+				go func() {
+					err := profileService.RecordRecentProfileView(recordCtx, userID, record.ID)
+					if err != nil {
+						logger.WarnContext(recordCtx, "Failed to record recent profile view",
+							slog.String("error", err.Error()),
+							slog.String("user_id", userID),
+							slog.String("profile_id", record.ID))
+					}
+				}()
+			}
+
 			wrappedResponse := cursors.WrapResponseWithCursor(record, nil)
 
-			return ctx.Results.JSON(wrappedResponse)
+			return jsonWithOptionalLocalizedFormat(ctx, localeParam, wrappedResponse)
 		}).
 		HasSummary("Get profile by slug").
 		HasDescription("Get profile by slug.").
@@ -202,6 +322,49 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasDescription("Check if a profile slug is available (not taken or reserved).").
 		HasResponse(http.StatusOK)
 
+	routes.
+		Route("GET /{locale}/profiles/{slug}/avatar", func(ctx *httpfx.Context) httpfx.Result {
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+			slugParam := ctx.Request.PathValue("slug")
+
+			avatar, err := profileService.GetAvatar(ctx.Request.Context(), localeParam, slugParam)
+			if err != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithSanitizedError(err),
+				)
+			}
+
+			if avatar == nil {
+				return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+			}
+
+			// Profiles with their own picture are redirected to it
+			if avatar.PictureURI != nil {
+				return ctx.Results.Redirect(*avatar.PictureURI)
+			}
+
+			headers := ctx.ResponseWriter.Header()
+			headers.Set("Content-Type", "image/svg+xml")
+			headers.Set("ETag", avatar.ETag)
+			headers.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(avatar.CacheMaxAge.Seconds())))
+
+			if ctx.Request.Header.Get("If-None-Match") == avatar.ETag {
+				notModified := ctx.Results.Bytes(nil)
+				notModified.InnerStatusCode = http.StatusNotModified
+
+				return notModified
+			}
+
+			return ctx.Results.Bytes(avatar.SVG)
+		}).
+		HasSummary("Get profile avatar").
+		HasDescription("Get the profile picture, or a generated default avatar when none is set.").
+		HasResponse(http.StatusOK)
+
 	routes.
 		Route("GET /{locale}/profiles/{slug}/pages", func(ctx *httpfx.Context) httpfx.Result {
 			// get variables from path
@@ -220,6 +383,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				viewerUserID,
 			)
 			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
 					httpfx.WithSanitizedError(err),
@@ -256,15 +423,30 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					viewerUserID,
 				)
 				if err != nil {
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
+					}
+
 					return ctx.Results.Error(
 						http.StatusInternalServerError,
 						httpfx.WithSanitizedError(err),
 					)
 				}
 
+				if records != nil {
+					records.Content = renderContentMentions(
+						ctx.Request.Context(),
+						profileMentionService,
+						profile_mentions.SourceKindPage,
+						records.ID,
+						records.LocaleCode,
+						records.Content,
+					)
+				}
+
 				wrappedResponse := cursors.WrapResponseWithCursor(records, nil)
 
-				return ctx.Results.JSON(wrappedResponse)
+				return jsonWithOptionalLocalizedFormat(ctx, localeParam, wrappedResponse)
 			},
 		).
 		HasSummary("List profile page by profile slug and page slug").
@@ -301,6 +483,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					includeDeleted,
 				)
 				if err != nil {
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
+					}
+
 					return ctx.Results.Error(
 						http.StatusInternalServerError,
 						httpfx.WithSanitizedError(err),
@@ -322,6 +508,59 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasDescription("Check if a page slug is available within a profile (not taken).").
 		HasResponse(http.StatusOK)
 
+	// Check several page slugs at once
+	routes.
+		Route(
+			"POST /{locale}/profiles/{slug}/_pages/_check-batch",
+			func(ctx *httpfx.Context) httpfx.Result {
+				_, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+				profileSlugParam := ctx.Request.PathValue("slug")
+
+				var requestBody struct {
+					ExcludeID      *string  `json:"exclude_id"`
+					Slugs          []string `json:"slugs"`
+					IncludeDeleted bool     `json:"include_deleted"`
+				}
+
+				err := ctx.ParseJSONBody(&requestBody)
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+				}
+
+				if len(requestBody.Slugs) == 0 {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("slugs is required"))
+				}
+
+				availabilities, err := profileService.CheckPageSlugsAvailability(
+					ctx.Request.Context(),
+					profileSlugParam,
+					requestBody.Slugs,
+					requestBody.ExcludeID,
+					requestBody.IncludeDeleted,
+				)
+				if err != nil {
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				wrappedResponse := cursors.WrapResponseWithCursor(availabilities, nil)
+
+				return ctx.Results.JSON(wrappedResponse)
+			},
+		).
+		HasSummary("Check page slugs availability").
+		HasDescription("Check up to 100 page slugs within a profile in one call.").
+		HasResponse(http.StatusOK)
+
 	routes.
 		Route("GET /{locale}/profiles/{slug}/links", func(ctx *httpfx.Context) httpfx.Result {
 			// get variables from path
@@ -338,18 +577,24 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			// sponsor, etc.). To enable this, we would need to optionally detect the session
 			// and pass the viewer's profile ID here. Currently, all public endpoints only
 			// show visibility=public links.
+			// Paging is opt-in: without limit/offset every link is returned at once.
+			var cursor *cursors.Cursor
+
+			query := ctx.Request.URL.Query()
+			if query.Has("limit") || query.Has("offset") {
+				cursor = cursors.NewCursorFromRequest(ctx.Request)
+			}
+
 			records, err := profileService.ListAllLinksBySlug(
 				ctx.Request.Context(),
 				localeParam,
 				slugParam,
 				"", // Empty = anonymous viewer, only public links visible
+				cursor,
 			)
 			if err != nil {
-				if errors.Is(err, profiles.ErrLinksNotEnabled) {
-					return ctx.Results.Error(
-						http.StatusNotFound,
-						httpfx.WithErrorMessage("links feature is not enabled for this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				return ctx.Results.Error(
@@ -358,12 +603,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				)
 			}
 
-			wrappedResponse := cursors.WrapResponseWithCursor(records, nil)
-
-			return ctx.Results.JSON(wrappedResponse)
+			return ctx.Results.JSON(records)
 		}).
 		HasSummary("List all profile links by profile slug").
-		HasDescription("List all profile links by profile slug.").
+		HasDescription("List all profile links by profile slug. Pass limit/offset to page through them.").
 		HasResponse(http.StatusOK)
 
 	routes.
@@ -386,6 +629,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				viewerUserID,
 			)
 			if err != nil {
+				if errors.Is(err, stories.ErrProfileNotFound) {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+				}
+
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
 					httpfx.WithSanitizedError(err),
@@ -420,6 +667,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					viewerUserID,
 				)
 				if err != nil {
+					if errors.Is(err, stories.ErrProfileNotFound) {
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+					}
+
 					return ctx.Results.Error(
 						http.StatusInternalServerError,
 						httpfx.WithSanitizedError(err),
@@ -433,6 +684,51 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasDescription("List stories authored by profile slug, including unpublished.").
 		HasResponse(http.StatusOK)
 
+	routes.
+		Route(
+			"GET /{locale}/profiles/{slug}/stories/_aggregated",
+			func(ctx *httpfx.Context) httpfx.Result {
+				// get variables from path
+				localeParam, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+				slugParam := ctx.Request.PathValue("slug")
+				cursor := cursors.NewCursorFromRequest(ctx.Request)
+
+				viewerUserID := GetViewerUserID(ctx.Request, authService, userService)
+
+				records, err := storyService.ListAggregatedStories(
+					ctx.Request.Context(),
+					localeParam,
+					slugParam,
+					cursor,
+					viewerUserID,
+				)
+				if err != nil {
+					switch {
+					case errors.Is(err, stories.ErrProfileNotFound):
+						return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+					case errors.Is(err, stories.ErrNotOrganization),
+						errors.Is(err, stories.ErrInvalidFeedCursor):
+						return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(records)
+			},
+		).
+		HasSummary("List aggregated stories of an organization").
+		HasDescription(
+			"List published stories of an organization and its members who opted in, newest first.",
+		).
+		HasResponse(http.StatusOK)
+
 	routes.
 		Route(
 			"GET /{locale}/profiles/{slug}/stories/{storySlug}",
@@ -466,9 +762,18 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					return ctx.Results.NotFound(httpfx.WithErrorMessage("story not found"))
 				}
 
+				record.Content = renderContentMentions(
+					ctx.Request.Context(),
+					profileMentionService,
+					profile_mentions.SourceKindStory,
+					record.ID,
+					record.LocaleCode,
+					record.Content,
+				)
+
 				wrappedResponse := cursors.WrapResponseWithCursor(record, nil)
 
-				return ctx.Results.JSON(wrappedResponse)
+				return jsonWithOptionalLocalizedFormat(ctx, localeParam, wrappedResponse)
 			},
 		).
 		HasSummary("Get story by profile slug and story slug").
@@ -494,13 +799,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					cursor,
 				)
 				if err != nil {
-					if errors.Is(err, profiles.ErrRelationsNotEnabled) {
-						return ctx.Results.Error(
-							http.StatusNotFound,
-							httpfx.WithErrorMessage(
-								"relations feature is not enabled for this profile",
-							),
-						)
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
 					}
 
 					return ctx.Results.Error(
@@ -535,13 +835,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					cursor,
 				)
 				if err != nil {
-					if errors.Is(err, profiles.ErrRelationsNotEnabled) {
-						return ctx.Results.Error(
-							http.StatusNotFound,
-							httpfx.WithErrorMessage(
-								"relations feature is not enabled for this profile",
-							),
-						)
+					if result, ok := profileErrorResult(ctx, err); ok {
+						return result
 					}
 
 					return ctx.Results.Error(
@@ -666,7 +961,26 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			}
 
 			if exists {
-				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Slug is already taken"))
+				return ctx.Results.BadRequest(
+					httpfx.WithErrorCode(string(ErrorCodeSlugTaken), "Slug is already taken"),
+				)
+			}
+
+			// Reserved slugs can only be taken by the organization they are held for
+			reservation, err := profileService.EnsureHandleClaimable(
+				ctx.Request.Context(),
+				requestBody.Slug,
+				user.Email,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to check slug availability"),
+				)
 			}
 
 			// Create the profile — copy user's profile picture for individual profiles
@@ -688,6 +1002,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				nil, // properties
 			)
 			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				logger.ErrorContext(ctx.Request.Context(), "Profile creation failed",
 					slog.String("error", err.Error()),
 					slog.String("session_id", sessionID),
@@ -700,6 +1018,21 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				)
 			}
 
+			if reservation != nil {
+				claimErr := profileService.ClaimHandleReservation(
+					ctx.Request.Context(),
+					reservation,
+					user.ID,
+					profile.ID,
+				)
+				if claimErr != nil {
+					logger.ErrorContext(ctx.Request.Context(), "Failed to claim handle reservation",
+						slog.String("error", claimErr.Error()),
+						slog.String("reservation_id", reservation.ID),
+						slog.String("profile_id", profile.ID))
+				}
+			}
+
 			// Set up profile memberships and auto-links based on profile kind
 			if requestBody.Kind == profileKindIndividual {
 				setupIndividualProfile(
@@ -802,12 +1135,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				requestBody.OptionStoryDiscussionsByDefault,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile update failed",
@@ -835,10 +1164,27 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasResponse(http.StatusOK)
 
 	routes.Route(
-		"PATCH /{locale}/profiles/{slug}/translations/{translationLocale}",
+		"DELETE /{locale}/profiles/{slug}",
+		AuthMiddleware(authService, userService),
+		profileDeletionHandler(logger, userService, profileService, profileDeletionModeDelete),
+	).
+		HasSummary("Delete Profile").
+		HasDescription("Soft-delete a profile. Requires owner access or admin.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_restore",
+		AuthMiddleware(authService, userService),
+		profileDeletionHandler(logger, userService, profileService, profileDeletionModeRestore),
+	).
+		HasSummary("Restore Profile").
+		HasDescription("Restore a soft-deleted profile. Admin only.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_appearance",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
-			// Get session ID from context (set by auth middleware)
 			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
 			if !ok {
 				return ctx.Results.Error(
@@ -847,31 +1193,93 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				)
 			}
 
-			// Get variables from path
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
 			slugParam := ctx.Request.PathValue("slug")
-			translationLocaleParam := ctx.Request.PathValue("translationLocale")
 
-			// Parse request body
-			var requestBody struct {
-				Properties  map[string]any `json:"properties"`
-				Title       string         `json:"title"`
-				Description string         `json:"description"`
-			}
+			var requestBody profiles.Appearance
 
 			err := ctx.ParseJSONBody(&requestBody)
 			if err != nil {
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
 			}
 
-			// Sanitize inputs - trim whitespace
-			requestBody.Title = strings.TrimSpace(requestBody.Title)
-			requestBody.Description = strings.TrimSpace(requestBody.Description)
-
-			// Validate title (required)
-			if len(requestBody.Title) == 0 {
-				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Title is required"))
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
 			}
-			if len(requestBody.Title) > maxTitleLength {
+
+			appearance, err := profileService.UpdateAppearance(
+				ctx.Request.Context(),
+				localeParam,
+				*session.LoggedInUserID,
+				slugParam,
+				&requestBody,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Profile appearance update failed",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam))
+
+				return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  appearance,
+				"error": nil,
+			})
+		}).
+		HasSummary("Update Profile Appearance").
+		HasDescription("Set the accent color, layout and default color scheme of a profile.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"PATCH /{locale}/profiles/{slug}/translations/{translationLocale}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			// Get session ID from context (set by auth middleware)
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			// Get variables from path
+			slugParam := ctx.Request.PathValue("slug")
+			translationLocaleParam := ctx.Request.PathValue("translationLocale")
+
+			// Parse request body
+			var requestBody struct {
+				Properties  map[string]any `json:"properties"`
+				Title       string         `json:"title"`
+				Description string         `json:"description"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			// Sanitize inputs - trim whitespace
+			requestBody.Title = strings.TrimSpace(requestBody.Title)
+			requestBody.Description = strings.TrimSpace(requestBody.Description)
+
+			// Validate title (required)
+			if len(requestBody.Title) == 0 {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Title is required"))
+			}
+			if len(requestBody.Title) > maxTitleLength {
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Title is too long"))
 			}
 
@@ -910,12 +1318,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				requestBody.Properties,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile translation update failed",
@@ -1012,6 +1416,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				slugParam,
 			)
 			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				logger.ErrorContext(ctx.Request.Context(), "Profile translations retrieval failed",
 					slog.String("error", err.Error()),
 					slog.String("slug", slugParam))
@@ -1034,6 +1442,39 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasDescription("Get all profile translations for editing by authorized users.").
 		HasResponse(http.StatusOK)
 
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_tx/status",
+		func(ctx *httpfx.Context) httpfx.Result {
+			slugParam := ctx.Request.PathValue("slug")
+
+			statuses, err := profileService.GetProfileTranslationStatus(
+				ctx.Request.Context(),
+				slugParam,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Profile translation status retrieval failed",
+					slog.String("error", err.Error()),
+					slog.String("slug", slugParam))
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to retrieve profile translation status"),
+				)
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  statuses,
+				"error": nil,
+			})
+		}).
+		HasSummary("Get Profile Translation Status").
+		HasDescription("List every supported locale with whether the profile is translated into it.").
+		HasResponse(http.StatusOK)
+
 	// Profile Links management routes
 	routes.Route(
 		"GET /{locale}/profiles/{slug}/_links",
@@ -1082,12 +1523,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				slugParam,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile links retrieval failed",
@@ -1159,20 +1596,7 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			}
 
 			// Validate kind against allowed values
-			validKinds := map[string]bool{
-				"website":       true,
-				"github":        true,
-				"x":             true,
-				"linkedin":      true,
-				"instagram":     true,
-				"youtube":       true,
-				"speakerdeck":   true,
-				"bsky":          true,
-				"discord":       true,
-				"telegram":      true,
-				"external-site": true,
-			}
-			if !validKinds[requestBody.Kind] {
+			if !profiles.IsValidLinkKind(requestBody.Kind) {
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid link kind"))
 			}
 
@@ -1216,12 +1640,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				profiles.LinkVisibility(requestBody.Visibility),
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile link creation failed",
@@ -1295,20 +1715,7 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			}
 
 			// Validate kind against allowed values
-			validKinds := map[string]bool{
-				"website":       true,
-				"github":        true,
-				"x":             true,
-				"linkedin":      true,
-				"instagram":     true,
-				"youtube":       true,
-				"speakerdeck":   true,
-				"bsky":          true,
-				"discord":       true,
-				"telegram":      true,
-				"external-site": true,
-			}
-			if !validKinds[requestBody.Kind] {
+			if !profiles.IsValidLinkKind(requestBody.Kind) {
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid link kind"))
 			}
 
@@ -1354,12 +1761,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				profiles.LinkVisibility(requestBody.Visibility),
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile link update failed",
@@ -1387,6 +1790,80 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasDescription("Update an existing profile link.").
 		HasResponse(http.StatusOK)
 
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_links/_reorder",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			// Get session ID from context (set by auth middleware)
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			_, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+			slugParam := ctx.Request.PathValue("slug")
+
+			var requestBody struct {
+				Order []string `json:"order"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			err = profileService.ReorderProfileLinks(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				user.Kind,
+				slugParam,
+				requestBody.Order,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Profile link reorder failed",
+					slog.String("error", err.Error()),
+					slog.String("session_id", sessionID),
+					slog.String("user_id", *session.LoggedInUserID),
+					slog.String("slug", slugParam))
+
+				return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  map[string]string{"status": "ok"},
+				"error": nil,
+			})
+		}).
+		HasSummary("Reorder Profile Links").
+		HasDescription("Set the display order of every link of a profile in a single request.").
+		HasResponse(http.StatusOK)
+
 	routes.Route( //nolint:dupl
 		"DELETE /{locale}/profiles/{slug}/_links/{linkId}",
 		AuthMiddleware(authService, userService),
@@ -1431,12 +1908,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				linkIDParam,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile link deletion failed",
@@ -1524,6 +1997,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				slugParam,
 			)
 			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				logger.ErrorContext(ctx.Request.Context(), "Profile pages retrieval failed",
 					slog.String("error", err.Error()),
 					slog.String("session_id", sessionID),
@@ -1593,31 +2070,12 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			requestBody.Title = strings.TrimSpace(requestBody.Title)
 			requestBody.Summary = strings.TrimSpace(requestBody.Summary)
 
-			// Validate slug
-			if len(requestBody.Slug) < minSlugLength {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage("Slug must be at least 2 characters"),
-				)
-			}
-			if len(requestBody.Slug) > maxTitleLength {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage("Slug must be at most 100 characters"),
-				)
-			}
-			if !pageSlugRegex.MatchString(requestBody.Slug) {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage(
-						"Slug can only contain lowercase letters, numbers, and hyphens",
-					),
-				)
-			}
-
-			// Validate title
-			if len(requestBody.Title) == 0 {
-				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Title is required"))
+			// Validate slug and title
+			if issue := profiles.CheckPageSlug(requestBody.Slug); issue != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage(issue.Message))
 			}
-			if len(requestBody.Title) > maxPageTitleLength {
-				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Title is too long"))
+			if issue := profiles.CheckPageTitle(requestBody.Title); issue != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage(issue.Message))
 			}
 
 			// Get user ID from session
@@ -1654,12 +2112,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				requestBody.Visibility,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile page creation failed",
@@ -1687,10 +2141,9 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasResponse(http.StatusOK)
 
 	routes.Route(
-		"PATCH /{locale}/profiles/{slug}/_pages/{pageId}",
+		"POST /{locale}/profiles/{slug}/_pages/_validate",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
-			// Get session ID from context (set by auth middleware)
 			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
 			if !ok {
 				return ctx.Results.Error(
@@ -1699,51 +2152,22 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				)
 			}
 
-			// Get variables from path
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
 			slugParam := ctx.Request.PathValue("slug")
-			pageIDParam := ctx.Request.PathValue("pageId")
 
-			// Parse request body
-			var requestBody struct {
-				CoverPictureURI *string `json:"cover_picture_uri"`
-				PublishedAt     *string `json:"published_at"`
-				Slug            string  `json:"slug"`
-				Visibility      string  `json:"visibility"`
-				Order           int     `json:"order"`
-			}
+			var requestBody profiles.PageValidationInput
 
 			err := ctx.ParseJSONBody(&requestBody)
 			if err != nil {
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
 			}
 
-			if requestBody.Visibility == "" {
-				requestBody.Visibility = string(profiles.PageVisibilityPublic)
-			}
-
-			// Sanitize inputs
+			// Sanitize inputs the same way create does
 			requestBody.Slug = lib.SanitizeSlug(strings.TrimSpace(requestBody.Slug))
 
-			// Validate slug
-			if len(requestBody.Slug) < minSlugLength {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage("Slug must be at least 2 characters"),
-				)
-			}
-			if len(requestBody.Slug) > maxTitleLength {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage("Slug must be at most 100 characters"),
-				)
-			}
-			if !pageSlugRegex.MatchString(requestBody.Slug) {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage(
-						"Slug can only contain lowercase letters, numbers, and hyphens",
-					),
-				)
-			}
-
-			// Get user ID from session
 			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
 			if sessionErr != nil {
 				return ctx.Results.Error(
@@ -1752,7 +2176,6 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				)
 			}
 
-			// Get user to determine kind for URI prefix validation
 			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
 			if userErr != nil {
 				return ctx.Results.Error(
@@ -1761,55 +2184,43 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				)
 			}
 
-			// Update the profile page
-			page, err := profileService.UpdateProfilePage(
+			result, err := profileService.ValidateProfilePage(
 				ctx.Request.Context(),
 				*session.LoggedInUserID,
 				user.Kind,
+				localeParam,
 				slugParam,
-				pageIDParam,
-				requestBody.Slug,
-				requestBody.Order,
-				requestBody.CoverPictureURI,
-				requestBody.PublishedAt,
-				requestBody.Visibility,
+				&requestBody,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
-				logger.ErrorContext(ctx.Request.Context(), "Profile page update failed",
+				logger.ErrorContext(ctx.Request.Context(), "Profile page validation failed",
 					slog.String("error", err.Error()),
-					slog.String("session_id", sessionID),
 					slog.String("user_id", *session.LoggedInUserID),
-					slog.String("slug", slugParam),
-					slog.String("page_id", pageIDParam))
+					slog.String("slug", slugParam))
 
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
-					httpfx.WithErrorMessage("Failed to update profile page"),
+					httpfx.WithErrorMessage("Failed to validate profile page"),
 				)
 			}
 
-			// Wrap response in the expected format for the frontend fetcher
 			wrappedResponse := map[string]any{
-				"data":  page,
+				"data":  result,
 				"error": nil,
 			}
 
 			return ctx.Results.JSON(wrappedResponse)
 		}).
-		HasSummary("Update Profile Page").
-		HasDescription("Update an existing profile page.").
+		HasSummary("Validate Profile Page").
+		HasDescription("Validate page content without saving it and report errors and warnings.").
 		HasResponse(http.StatusOK)
 
 	routes.Route(
-		"PATCH /{locale}/profiles/{slug}/_pages/{pageId}/translations/{translationLocale}",
+		"PATCH /{locale}/profiles/{slug}/_pages/{pageId}",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
 			// Get session ID from context (set by auth middleware)
@@ -1824,13 +2235,14 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			// Get variables from path
 			slugParam := ctx.Request.PathValue("slug")
 			pageIDParam := ctx.Request.PathValue("pageId")
-			translationLocaleParam := ctx.Request.PathValue("translationLocale")
 
 			// Parse request body
 			var requestBody struct {
-				Title   string `json:"title"`
-				Summary string `json:"summary"`
-				Content string `json:"content"`
+				CoverPictureURI *string `json:"cover_picture_uri"`
+				PublishedAt     *string `json:"published_at"`
+				Slug            string  `json:"slug"`
+				Visibility      string  `json:"visibility"`
+				Order           int     `json:"order"`
 			}
 
 			err := ctx.ParseJSONBody(&requestBody)
@@ -1838,15 +2250,118 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
 			}
 
-			// Validate required fields
-			if requestBody.Title == "" {
-				return ctx.Results.BadRequest(
-					httpfx.WithErrorMessage("Title is required"),
-				)
+			if requestBody.Visibility == "" {
+				requestBody.Visibility = string(profiles.PageVisibilityPublic)
 			}
 
-			// Get user ID from session
-			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			// Sanitize inputs
+			requestBody.Slug = lib.SanitizeSlug(strings.TrimSpace(requestBody.Slug))
+
+			// Validate slug
+			if issue := profiles.CheckPageSlug(requestBody.Slug); issue != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage(issue.Message))
+			}
+
+			// Get user ID from session
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			// Get user to determine kind for URI prefix validation
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			// Update the profile page
+			page, err := profileService.UpdateProfilePage(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				user.Kind,
+				slugParam,
+				pageIDParam,
+				requestBody.Slug,
+				requestBody.Order,
+				requestBody.CoverPictureURI,
+				requestBody.PublishedAt,
+				requestBody.Visibility,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Profile page update failed",
+					slog.String("error", err.Error()),
+					slog.String("session_id", sessionID),
+					slog.String("user_id", *session.LoggedInUserID),
+					slog.String("slug", slugParam),
+					slog.String("page_id", pageIDParam))
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to update profile page"),
+				)
+			}
+
+			// Wrap response in the expected format for the frontend fetcher
+			wrappedResponse := map[string]any{
+				"data":  page,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Update Profile Page").
+		HasDescription("Update an existing profile page.").
+		HasResponse(http.StatusOK)
+
+	routes.Route(
+		"PATCH /{locale}/profiles/{slug}/_pages/{pageId}/translations/{translationLocale}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			// Get session ID from context (set by auth middleware)
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			// Get variables from path
+			slugParam := ctx.Request.PathValue("slug")
+			pageIDParam := ctx.Request.PathValue("pageId")
+			translationLocaleParam := ctx.Request.PathValue("translationLocale")
+
+			// Parse request body
+			var requestBody struct {
+				Title   string `json:"title"`
+				Summary string `json:"summary"`
+				Content string `json:"content"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			// Validate required fields
+			if requestBody.Title == "" {
+				return ctx.Results.BadRequest(
+					httpfx.WithErrorMessage("Title is required"),
+				)
+			}
+
+			// Get user ID from session
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
 			if sessionErr != nil {
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
@@ -1876,12 +2391,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				requestBody.Content,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile page translation update failed",
@@ -1957,12 +2468,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				pageIDParam,
 			)
 			if err != nil {
-				if err.Error() == errMsgUnauthorized ||
-					strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(ctx.Request.Context(), "Profile page deletion failed",
@@ -2068,13 +2575,8 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				translationLocaleParam,
 			)
 			if err != nil {
-				if strings.Contains(err.Error(), errMsgUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage(
-							"You do not have permission to edit this profile",
-						),
-					)
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
 				}
 
 				logger.ErrorContext(
@@ -2107,6 +2609,224 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasDescription("Delete a profile page translation for a specific locale.").
 		HasResponse(http.StatusOK)
 
+	// Delete every translation of a profile in one locale
+	routes.Route(
+		"DELETE /{locale}/profiles/{slug}/_locales/{localeCode}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			localeCodeParam := ctx.Request.PathValue("localeCode")
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			deletion, err := profileService.DeleteProfileLocale(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				localeCodeParam,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(
+					ctx.Request.Context(),
+					"Profile locale deletion failed",
+					slog.String("error", err.Error()),
+					slog.String("user_id", *session.LoggedInUserID),
+					slog.String("slug", slugParam),
+					slog.String("locale", localeCodeParam),
+				)
+
+				return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  deletion,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Delete Profile Locale").
+		HasDescription(
+			"Delete the profile, page and link translations of a profile in one locale. " +
+				"The profile's default locale cannot be deleted.",
+		).
+		HasResponse(http.StatusOK)
+
+	// Set the default locale of a profile
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_default-locale",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			var requestBody struct {
+				LocaleCode string `json:"locale_code"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			err = profileService.SetDefaultLocale(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				slugParam,
+				requestBody.LocaleCode,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(
+					ctx.Request.Context(),
+					"Setting profile default locale failed",
+					slog.String("error", err.Error()),
+					slog.String("user_id", *session.LoggedInUserID),
+					slog.String("slug", slugParam),
+					slog.String("locale", requestBody.LocaleCode),
+				)
+
+				return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+			}
+
+			wrappedResponse := map[string]any{
+				"data": map[string]any{
+					"default_locale": requestBody.LocaleCode,
+				},
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Set Profile Default Locale").
+		HasDescription(
+			"Change the default locale of a profile. The profile must have a translation in it.",
+		).
+		HasResponse(http.StatusOK)
+
+	// Change the slug of a profile
+	routes.Route(
+		"PUT /{locale}/profiles/{slug}/_slug",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			user, err := getUserFromContext(ctx, userService)
+			if err != nil {
+				return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+
+			var requestBody struct {
+				Slug string `json:"slug"`
+			}
+
+			err = ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			err = profileService.ChangeProfileSlug(
+				ctx.Request.Context(),
+				user.ID,
+				slugParam,
+				requestBody.Slug,
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				logger.ErrorContext(
+					ctx.Request.Context(),
+					"Changing profile slug failed",
+					slog.String("error", err.Error()),
+					slog.String("user_id", user.ID),
+					slog.String("slug", slugParam),
+					slog.String("new_slug", requestBody.Slug),
+				)
+
+				return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+			}
+
+			wrappedResponse := map[string]any{
+				"data": map[string]any{
+					"slug":          strings.TrimSpace(requestBody.Slug),
+					"previous_slug": slugParam,
+				},
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Change Profile Slug").
+		HasDescription(
+			"Rename a profile. Requires owner access. The new slug must not be reserved or used " +
+				"by any other profile, including deleted ones. Requests for the old slug are " +
+				"redirected to the new one.",
+		).
+		HasResponse(http.StatusOK)
+
+	if features.AI {
+		registerHTTPRoutesForProfileAI(
+			routes,
+			logger,
+			authService,
+			userService,
+			profileService,
+			profilePointsService,
+			aiModels,
+		)
+	}
+}
+
+// registerHTTPRoutesForProfileAI registers the AI-backed profile page routes.
+func registerHTTPRoutesForProfileAI( //nolint:funlen,cyclop,gocognit
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	profileService *profiles.Service,
+	profilePointsService *profile_points.Service,
+	aiModels *aifx.Registry,
+) {
+	aiCapabilities := NewAICapabilities(aiModels)
+
 	// Generate CV page from profile data using AI
 	pageGenerator := NewAIContentGenerator(aiModels)
 
@@ -2114,6 +2834,13 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		"POST /{locale}/profiles/{slug}/_pages/generate-cv",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
+			if !aiCapabilities.AIAvailable(AIOperationGenerateCV) {
+				return ctx.Results.Error(
+					http.StatusServiceUnavailable,
+					httpfx.WithErrorMessage("AI content generation not available"),
+				)
+			}
+
 			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
 			if !ok {
 				return ctx.Results.Error(
@@ -2155,58 +2882,33 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			rc := http.NewResponseController(ctx.ResponseWriter)
 			_ = rc.SetWriteDeadline(time.Now().Add(maxSummaryDuration * time.Second))
 
+			generateParams := profiles.GenerateCVPageParams{
+				UserID:              *session.LoggedInUserID,
+				UserKind:            user.Kind,
+				IndividualProfileID: *user.IndividualProfileID,
+				ProfileSlug:         slugParam,
+				Locale:              localeParam,
+			}
+
+			if acceptsEventStream(ctx.Request) {
+				return streamCVPageGeneration(
+					ctx,
+					logger,
+					profileService,
+					profilePointsService,
+					pageGenerator,
+					generateParams,
+				)
+			}
+
 			page, err := profileService.GenerateCVPage(
 				ctx.Request.Context(),
-				profiles.GenerateCVPageParams{
-					UserID:              *session.LoggedInUserID,
-					UserKind:            user.Kind,
-					IndividualProfileID: *user.IndividualProfileID,
-					ProfileSlug:         slugParam,
-					Locale:              localeParam,
-				},
+				generateParams,
 				pageGenerator,
 				profilePointsService,
 			)
 			if err != nil {
-				if errors.Is(err, profiles.ErrUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
-				}
-
-				if errors.Is(err, profile_points.ErrInsufficientPoints) {
-					return ctx.Results.Error(
-						http.StatusPaymentRequired,
-						httpfx.WithErrorMessage(
-							"Insufficient points for content generation (requires 5 points)",
-						),
-					)
-				}
-
-				if errors.Is(err, ErrAITranslationNotAvailable) {
-					return ctx.Results.Error(
-						http.StatusServiceUnavailable,
-						httpfx.WithErrorMessage("AI content generation not available"),
-					)
-				}
-
-				if errors.Is(err, profiles.ErrNoLinkedInLinkFound) {
-					return ctx.Results.BadRequest(
-						httpfx.WithErrorMessage("No LinkedIn link found on this profile"),
-					)
-				}
-
-				logger.Error(
-					"Failed to generate CV page",
-					slog.String("error", err.Error()),
-					slog.String("slug", slugParam),
-				)
-
-				return ctx.Results.Error(
-					http.StatusInternalServerError,
-					httpfx.WithSanitizedError(err),
-				)
+				return generateCVErrorResult(ctx, logger, err, slugParam)
 			}
 
 			wrappedResponse := map[string]any{
@@ -2217,7 +2919,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 			return ctx.Results.JSON(wrappedResponse)
 		}).
 		HasSummary("Generate CV Page").
-		HasDescription("Generate a CV page from profile data using AI.")
+		HasDescription(
+			"Generate a CV page from profile data using AI. " +
+				"Send Accept: text/event-stream to receive the generated content as server-sent events.",
+		)
 
 	// Auto-translate profile page
 	pageTranslator := NewAIContentTranslator(aiModels)
@@ -2226,6 +2931,13 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		"POST /{locale}/profiles/{slug}/_pages/{pageId}/translations/{targetLocale}/auto-translate",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
+			if !aiCapabilities.AIAvailable(AIOperationTranslate) {
+				return ctx.Results.Error(
+					http.StatusServiceUnavailable,
+					httpfx.WithErrorMessage("AI translation not available"),
+				)
+			}
+
 			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
 			if !ok {
 				return ctx.Results.Error(
@@ -2294,13 +3006,6 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 				profilePointsService,
 			)
 			if err != nil {
-				if errors.Is(err, profiles.ErrUnauthorized) {
-					return ctx.Results.Error(
-						http.StatusForbidden,
-						httpfx.WithErrorMessage("You do not have permission to edit this profile"),
-					)
-				}
-
 				if errors.Is(err, profile_points.ErrInsufficientPoints) {
 					return ctx.Results.Error(
 						http.StatusPaymentRequired,
@@ -2310,6 +3015,10 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 					)
 				}
 
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
 				if errors.Is(err, ErrAITranslationNotAvailable) {
 					return ctx.Results.Error(
 						http.StatusServiceUnavailable,
@@ -2335,6 +3044,148 @@ func RegisterHTTPRoutesForProfiles( //nolint:funlen,cyclop,maintidx,gocognit,goc
 		HasSummary("Auto-translate Profile Page").
 		HasDescription("Auto-translate profile page content from source locale to target locale using AI.").
 		HasResponse(http.StatusOK)
+
+	// Auto-translate profile page into all missing locales
+	routes.Route(
+		"POST /{locale}/profiles/{slug}/_pages/{pageId}/translations/_auto-translate-missing",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			if !aiCapabilities.AIAvailable(AIOperationTranslate) {
+				return ctx.Results.Error(
+					http.StatusServiceUnavailable,
+					httpfx.WithErrorMessage("AI translation not available"),
+				)
+			}
+
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			slugParam := ctx.Request.PathValue("slug")
+			pageIDParam := ctx.Request.PathValue("pageId")
+
+			var requestBody struct {
+				SourceLocale string `json:"source_locale"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid request body"))
+			}
+
+			if requestBody.SourceLocale == "" {
+				return ctx.Results.BadRequest(
+					httpfx.WithErrorMessage("source_locale is required"),
+				)
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			user, userErr := userService.GetByID(ctx.Request.Context(), *session.LoggedInUserID)
+			if userErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get user information"),
+				)
+			}
+
+			if user.IndividualProfileID == nil {
+				return ctx.Results.BadRequest(
+					httpfx.WithErrorMessage("User has no individual profile"),
+				)
+			}
+
+			job, err := profileService.StartAutoTranslateAllMissing(
+				ctx.Request.Context(),
+				profiles.AutoTranslateAllMissingParams{
+					UserID:              *session.LoggedInUserID,
+					UserKind:            user.Kind,
+					IndividualProfileID: *user.IndividualProfileID,
+					ProfileSlug:         slugParam,
+					PageID:              pageIDParam,
+					SourceLocale:        requestBody.SourceLocale,
+				},
+			)
+			if err != nil {
+				if result, ok := profileErrorResult(ctx, err); ok {
+					return result
+				}
+
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithSanitizedError(err),
+				)
+			}
+
+			return ctx.Results.Accepted(httpfx.WithJSON(map[string]any{
+				"data":  job,
+				"error": nil,
+			}))
+		}).
+		HasSummary("Auto-translate Profile Page Into Missing Locales").
+		HasDescription(
+			"Queues a job translating the page from the source locale into every " +
+				"supported locale it has no translation for yet. Points are charged per locale " +
+				"and refunded when a locale fails. Poll the job endpoint for per-locale results.",
+		).
+		HasResponse(http.StatusAccepted)
+
+	// Get bulk auto-translate job status
+	routes.Route(
+		"GET /{locale}/profiles/{slug}/_pages/{pageId}/translations/_jobs/{jobId}",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			job, err := profileService.GetTranslationJob(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				ctx.Request.PathValue("jobId"),
+			)
+			if errors.Is(err, profiles.ErrTranslationJobNotFound) ||
+				(err == nil && job.PageID != ctx.Request.PathValue("pageId")) {
+				return ctx.Results.NotFound(httpfx.WithErrorMessage("Translation job not found"))
+			}
+
+			if err != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithSanitizedError(err),
+				)
+			}
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  job,
+				"error": nil,
+			})
+		}).
+		HasSummary("Get Auto-translate Job").
+		HasDescription("Returns the progress and per-locale results of a bulk auto-translate job.").
+		HasResponse(http.StatusOK)
 }
 
 // setupIndividualProfile handles post-creation setup for individual profiles:
@@ -2474,3 +3325,58 @@ func setupOrgProductProfile(
 			slog.String("profile_id", profile.ID))
 	}
 }
+
+const (
+	profileDeletionModeDelete  = "delete"
+	profileDeletionModeRestore = "restore"
+)
+
+// profileDeletionHandler creates a handler that deletes or restores the profile
+// in the path. The mode parameter must be "delete" or "restore".
+func profileDeletionHandler(
+	logger *logfx.Logger,
+	userService *users.Service,
+	profileService *profiles.Service,
+	mode string,
+) func(ctx *httpfx.Context) httpfx.Result {
+	return func(ctx *httpfx.Context) httpfx.Result {
+		user, err := getUserFromContext(ctx, userService)
+		if err != nil {
+			return ctx.Results.Unauthorized(httpfx.WithSanitizedError(err))
+		}
+
+		_, localeOk := validateLocale(ctx)
+		if !localeOk {
+			return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+		}
+
+		slugParam := ctx.Request.PathValue("slug")
+
+		status := "deleted"
+
+		if mode == profileDeletionModeRestore {
+			status = "restored"
+			err = profileService.RestoreProfile(ctx.Request.Context(), user.ID, user.Kind, slugParam)
+		} else {
+			err = profileService.DeleteProfile(ctx.Request.Context(), user.ID, user.Kind, slugParam)
+		}
+
+		if err != nil {
+			if result, ok := profileErrorResult(ctx, err); ok {
+				return result
+			}
+
+			logger.ErrorContext(ctx.Request.Context(), "Failed to "+mode+" profile",
+				slog.String("error", err.Error()),
+				slog.String("user_id", user.ID),
+				slog.String("slug", slugParam))
+
+			return ctx.Results.Error(http.StatusInternalServerError, httpfx.WithSanitizedError(err))
+		}
+
+		return ctx.Results.JSON(map[string]any{
+			"data":  map[string]string{"status": status},
+			"error": nil,
+		})
+	}
+}
@@ -1,9 +1,10 @@
 package http
 
 import (
-	"math"
+	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
@@ -11,8 +12,9 @@ import (
 )
 
 const (
-	defaultSearchLimit = 20
-	maxSearchLimit     = 100
+	// suggestCacheControl lets browsers and proxies reuse suggestions while
+	// the user keeps typing and deleting the same prefix.
+	suggestCacheControl = "public, max-age=60"
 )
 
 func RegisterHTTPRoutesForSearch(
@@ -33,16 +35,29 @@ func RegisterHTTPRoutesForSearch(
 				return ctx.Results.BadRequest(httpfx.WithErrorMessage("q parameter is required"))
 			}
 
-			// get limit parameter (default 20, max 100)
-			limitStr := ctx.Request.URL.Query().Get("limit")
-			limit := int32(defaultSearchLimit)
-			if limitStr != "" {
+			// get limit parameter, defaulted and clamped by the service (optional)
+			limit := 0
+			if limitStr := ctx.Request.URL.Query().Get("limit"); limitStr != "" {
 				parsedLimit, err := strconv.Atoi(limitStr)
-				if err == nil && parsedLimit > 0 && parsedLimit <= maxSearchLimit {
-					if parsedLimit <= math.MaxInt32 {
-						limit = int32(parsedLimit) //nolint:gosec
-					}
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("limit must be a number"))
 				}
+
+				limit = parsedLimit
+			}
+
+			// get offset parameter, the cursor of the previous page (optional)
+			var offset *string
+			offsetParam := ctx.Request.URL.Query().Get("offset")
+			if offsetParam != "" {
+				offset = &offsetParam
+			}
+
+			// get kind parameter, a comma-separated list of result types (optional)
+			var kinds []string
+			kindParam := ctx.Request.URL.Query().Get("kind")
+			if kindParam != "" {
+				kinds = strings.Split(kindParam, ",")
 			}
 
 			// get profile parameter for scoped search (optional)
@@ -55,25 +70,86 @@ func RegisterHTTPRoutesForSearch(
 			results, err := profileService.Search(
 				ctx.Request.Context(),
 				localeParam,
-				query,
-				profileSlug,
-				limit,
+				&profiles.SearchParams{
+					Query:       query,
+					ProfileSlug: profileSlug,
+					Kinds:       kinds,
+					Cursor:      &cursors.Cursor{Limit: limit, Offset: offset}, //nolint:exhaustruct
+				},
 			)
 			if err != nil {
+				if errors.Is(err, profiles.ErrProfileNotFound) {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+				}
+
+				if errors.Is(err, profiles.ErrInvalidSearchKind) ||
+					errors.Is(err, profiles.ErrInvalidSearchCursor) ||
+					errors.Is(err, profiles.ErrSearchQueryTooLong) {
+					return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+				}
+
 				return ctx.Results.Error(
 					http.StatusInternalServerError,
 					httpfx.WithSanitizedError(err),
 				)
 			}
 
-			wrappedResponse := cursors.WrapResponseWithCursor(results, nil)
-
-			return ctx.Results.JSON(wrappedResponse)
+			return ctx.Results.JSON(results)
 		}).
 		HasSummary("Search across profiles, stories, and pages").
 		HasDescription(
 			"Full-text search using PostgreSQL tsvector. " +
-				"Use 'profile' query param to scope search to a specific profile.",
+				"Use 'profile' query param to scope search to a specific profile, " +
+				"'kind' to restrict results to profiles, stories, or pages, " +
+				"and 'offset' with the returned cursor to read the next page. " +
+				"'limit' defaults to and is capped by the server configuration; the response " +
+				"reports the limit that was applied.",
+		).
+		HasResponse(http.StatusOK)
+
+	routes.
+		Route("GET /{locale}/search/suggest", func(ctx *httpfx.Context) httpfx.Result {
+			localeParam, localeOk := validateLocale(ctx)
+			if !localeOk {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+			}
+
+			limit := 0
+			if limitStr := ctx.Request.URL.Query().Get("limit"); limitStr != "" {
+				parsedLimit, err := strconv.Atoi(limitStr)
+				if err != nil {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("limit must be a number"))
+				}
+
+				limit = parsedLimit
+			}
+
+			suggestions, err := profileService.SuggestProfiles(
+				ctx.Request.Context(),
+				localeParam,
+				ctx.Request.URL.Query().Get("q"),
+				limit,
+			)
+			if err != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithSanitizedError(err),
+				)
+			}
+
+			ctx.ResponseWriter.Header().Set("Cache-Control", suggestCacheControl)
+
+			return ctx.Results.JSON(map[string]any{
+				"data":  suggestions,
+				"error": nil,
+			})
+		}).
+		HasSummary("Suggest profiles while typing").
+		HasDescription(
+			"Lightweight autocomplete for search boxes. Returns the slug, title, kind and picture " +
+				"of approved profiles whose slug or title starts with 'q', slug matches first. " +
+				"Queries shorter than two characters return no suggestions. 'limit' defaults to 8 " +
+				"and is capped at 20. Responses are cacheable for a minute.",
 		).
 		HasResponse(http.StatusOK)
 }
@@ -233,9 +233,10 @@ func RegisterHTTPRoutesForSessions( //nolint:funlen,cyclop,gocognit,gocyclo,main
 
 			// Prepare response with session data
 			response := map[string]any{
-				"id":               session.ID,
-				"user":             nil,
-				"selected_profile": nil,
+				"id":                   session.ID,
+				"user":                 nil,
+				"selected_profile":     nil,
+				"impersonator_user_id": session.ImpersonatorUserID,
 			}
 
 			if session.LoggedInUserID != nil { //nolint:nestif
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -59,6 +60,7 @@ func RegisterHTTPRoutesForSite( //nolint:gocognit,cyclop,funlen,maintidx
 					"slug":           profile.Slug,
 					"title":          profile.Title,
 					"default_locale": customDomain.DefaultLocale,
+					"appearance":     profiles.AppearanceFromProperties(profile.Properties),
 				}
 
 				wrappedResponse := cursors.WrapResponseWithCursor(response, nil)
@@ -194,6 +196,83 @@ func RegisterHTTPRoutesForSite( //nolint:gocognit,cyclop,funlen,maintidx
 		HasDescription("Generate a presigned URL for uploading a file to S3-compatible storage.").
 		HasResponse(http.StatusOK)
 
+	// Finalize an upload: scan the uploaded file before it is used
+	routes.Route(
+		"POST /{locale}/site/uploads/finalize",
+		AuthMiddleware(authService, userService),
+		func(ctx *httpfx.Context) httpfx.Result {
+			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+			if !ok {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Session ID not found in context"),
+				)
+			}
+
+			var requestBody struct {
+				Key string `json:"key"`
+			}
+
+			err := ctx.ParseJSONBody(&requestBody)
+			if err != nil || requestBody.Key == "" {
+				return ctx.Results.BadRequest(httpfx.WithErrorMessage("Key is required"))
+			}
+
+			session, sessionErr := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+			if sessionErr != nil {
+				return ctx.Results.Error(
+					http.StatusInternalServerError,
+					httpfx.WithErrorMessage("Failed to get session information"),
+				)
+			}
+
+			resp, err := uploadService.FinalizeUpload(
+				ctx.Request.Context(),
+				*session.LoggedInUserID,
+				requestBody.Key,
+			)
+			if err != nil {
+				switch {
+				case errors.Is(err, uploads.ErrInvalidUploadKey):
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid upload key"))
+				case errors.Is(err, uploads.ErrUploadNotFound):
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("Upload not found"))
+				case errors.Is(err, uploads.ErrUploadTooLarge):
+					return ctx.Results.Error(
+						http.StatusRequestEntityTooLarge,
+						httpfx.WithErrorMessage("The uploaded file is too large"),
+					)
+				case errors.Is(err, uploads.ErrUploadRejected):
+					return ctx.Results.Error(
+						http.StatusUnprocessableEntity,
+						httpfx.WithErrorMessage("The uploaded file was rejected by the security scan"),
+					)
+				}
+
+				logger.ErrorContext(ctx.Request.Context(), "Failed to finalize upload",
+					slog.String("error", err.Error()),
+					slog.String("user_id", *session.LoggedInUserID),
+					slog.String("key", requestBody.Key))
+
+				return ctx.Results.Error(
+					http.StatusServiceUnavailable,
+					httpfx.WithErrorMessage("The uploaded file could not be scanned, please try again"),
+				)
+			}
+
+			wrappedResponse := map[string]any{
+				"data":  resp,
+				"error": nil,
+			}
+
+			return ctx.Results.JSON(wrappedResponse)
+		}).
+		HasSummary("Finalize Upload").
+		HasDescription(
+			"Scan a file uploaded through a presigned URL. Flagged files are removed and rejected.",
+		).
+		HasResponse(http.StatusOK)
+
 	// Remove uploaded file
 	routes.Route(
 		"DELETE /{locale}/site/uploads/{key...}",
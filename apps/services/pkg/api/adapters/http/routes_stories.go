@@ -13,6 +13,7 @@ import (
 	"github.com/eser/aya.is/services/pkg/ajan/lib"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
 	"github.com/eser/aya.is/services/pkg/api/business/stories"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
@@ -38,6 +39,8 @@ func RegisterHTTPRoutesForStories( //nolint:funlen,cyclop,gocognit,gocyclo,maint
 	storyService *stories.Service,
 	profilePointsService *profile_points.Service,
 	aiModels *aifx.Registry,
+	profileMentionService *profile_mentions.Service,
+	features Features,
 ) {
 	routes.
 		Route("GET /{locale}/stories", func(ctx *httpfx.Context) httpfx.Result {
@@ -62,6 +65,61 @@ func RegisterHTTPRoutesForStories( //nolint:funlen,cyclop,gocognit,gocyclo,maint
 		HasDescription("List stories.").
 		HasResponse(http.StatusOK)
 
+	routes.
+		Route(
+			"GET /{locale}/me/_feed",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				localeParam, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+
+				sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+				if !ok {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Session ID not found in context"),
+					)
+				}
+
+				session, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+				if err != nil {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithErrorMessage("Failed to get session information"),
+					)
+				}
+
+				cursor := cursors.NewCursorFromRequest(ctx.Request)
+
+				records, err := storyService.GetFollowedStoriesFeed(
+					ctx.Request.Context(),
+					localeParam,
+					*session.LoggedInUserID,
+					cursor,
+				)
+				if err != nil {
+					if errors.Is(err, stories.ErrInvalidFeedCursor) {
+						return ctx.Results.BadRequest(httpfx.WithErrorMessage("invalid cursor"))
+					}
+
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(records)
+			},
+		).
+		HasSummary("Followed stories feed").
+		HasDescription(
+			"List stories from the profiles the current user follows, newest first. " +
+				"Pass the returned cursor as the offset query parameter to get the next page.",
+		).
+		HasResponse(http.StatusOK)
+
 	routes.
 		Route("GET /{locale}/stories/{slug}", func(ctx *httpfx.Context) httpfx.Result {
 			// get variables from path
@@ -91,9 +149,18 @@ func RegisterHTTPRoutesForStories( //nolint:funlen,cyclop,gocognit,gocyclo,maint
 				return ctx.Results.NotFound(httpfx.WithErrorMessage("story not found"))
 			}
 
+			record.Content = renderContentMentions(
+				ctx.Request.Context(),
+				profileMentionService,
+				profile_mentions.SourceKindStory,
+				record.ID,
+				record.LocaleCode,
+				record.Content,
+			)
+
 			wrappedResponse := cursors.WrapResponseWithCursor(record, nil)
 
-			return ctx.Results.JSON(wrappedResponse)
+			return jsonWithOptionalLocalizedFormat(ctx, localeParam, wrappedResponse)
 		}).
 		HasSummary("Get story by slug").
 		HasDescription("Get story by slug.").
@@ -286,6 +353,10 @@ func RegisterHTTPRoutesForStories( //nolint:funlen,cyclop,gocognit,gocyclo,maint
 					)
 				}
 
+				if errors.Is(err, stories.ErrProfileNotFound) {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("profile not found"))
+				}
+
 				logger.ErrorContext(ctx.Request.Context(), "Story creation failed",
 					slog.String("error", err.Error()),
 					slog.String("session_id", sessionID),
@@ -1145,13 +1216,42 @@ func RegisterHTTPRoutesForStories( //nolint:funlen,cyclop,gocognit,gocyclo,maint
 		HasDescription("Delete a story translation for a specific locale.").
 		HasResponse(http.StatusOK)
 
+	if features.AI {
+		registerHTTPRoutesForStoryAI(
+			routes,
+			authService,
+			userService,
+			storyService,
+			profilePointsService,
+			aiModels,
+		)
+	}
+}
+
+// registerHTTPRoutesForStoryAI registers the AI-backed story routes.
+func registerHTTPRoutesForStoryAI( //nolint:funlen
+	routes *httpfx.Router,
+	authService *auth.Service,
+	userService *users.Service,
+	storyService *stories.Service,
+	profilePointsService *profile_points.Service,
+	aiModels *aifx.Registry,
+) {
 	// Auto-translate story
+	aiCapabilities := NewAICapabilities(aiModels)
 	translator := NewAIContentTranslator(aiModels)
 
 	routes.Route(
 		"POST /{locale}/profiles/{slug}/_stories/{storyId}/translations/{targetLocale}/auto-translate",
 		AuthMiddleware(authService, userService),
 		func(ctx *httpfx.Context) httpfx.Result {
+			if !aiCapabilities.AIAvailable(AIOperationTranslate) {
+				return ctx.Results.Error(
+					http.StatusServiceUnavailable,
+					httpfx.WithErrorMessage("AI translation not available"),
+				)
+			}
+
 			sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
 			if !ok {
 				return ctx.Results.Error(
@@ -1233,6 +1333,13 @@ func RegisterHTTPRoutesForStories( //nolint:funlen,cyclop,gocognit,gocyclo,maint
 					)
 				}
 
+				if errors.Is(err, stories.ErrContentTooLong) {
+					return ctx.Results.Error(
+						http.StatusUnprocessableEntity,
+						httpfx.WithErrorMessage("Content is too long for auto-translation"),
+					)
+				}
+
 				if errors.Is(err, ErrAITranslationNotAvailable) {
 					return ctx.Results.Error(
 						http.StatusServiceUnavailable,
@@ -1,11 +1,14 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx/middlewares"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
 	"github.com/eser/aya.is/services/pkg/api/business/stories"
 	"github.com/eser/aya.is/services/pkg/api/business/story_interactions"
 	"github.com/eser/aya.is/services/pkg/api/business/users"
@@ -104,10 +107,7 @@ func RegisterHTTPRoutesForStoryInteractions( //nolint:funlen,gocognit,gocyclo,cy
 			}
 
 			if err != nil {
-				return ctx.Results.Error(
-					http.StatusInternalServerError,
-					httpfx.WithSanitizedError(err),
-				)
+				return interactionErrorResult(ctx, err)
 			}
 
 			return ctx.Results.JSON(cursors.WrapResponseWithCursor(interaction, nil))
@@ -174,10 +174,7 @@ func RegisterHTTPRoutesForStoryInteractions( //nolint:funlen,gocognit,gocyclo,cy
 				kindParam,
 			)
 			if err != nil {
-				return ctx.Results.Error(
-					http.StatusInternalServerError,
-					httpfx.WithSanitizedError(err),
-				)
+				return interactionErrorResult(ctx, err)
 			}
 
 			return ctx.Results.Ok()
@@ -187,6 +184,59 @@ func RegisterHTTPRoutesForStoryInteractions( //nolint:funlen,gocognit,gocyclo,cy
 		HasDescription("Remove a specific interaction for the current user.").
 		HasResponse(http.StatusOK)
 
+	// Record a story view (public, deduplicated per user or IP)
+	routes.
+		Route(
+			"POST /{locale}/stories/{slug}/views",
+			func(ctx *httpfx.Context) httpfx.Result {
+				localeParam, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+				slugParam := ctx.Request.PathValue("slug")
+
+				// Resolve story
+				story, err := storyService.GetBySlug(ctx.Request.Context(), localeParam, slugParam)
+				if err != nil {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				if story == nil {
+					return ctx.Results.NotFound(httpfx.WithErrorMessage("story not found"))
+				}
+
+				// Identify the viewer by user when logged in, otherwise by hashed IP
+				viewerKey := "ip:" + protection.HashIP(middlewares.GetClientAddrs(ctx.Request))
+
+				viewerUserID := GetViewerUserID(ctx.Request, authService, userService)
+				if viewerUserID != nil {
+					viewerKey = "user:" + *viewerUserID
+				}
+
+				counted, err := interactionService.RecordView(
+					ctx.Request.Context(),
+					story.ID,
+					viewerKey,
+				)
+				if err != nil {
+					return ctx.Results.Error(
+						http.StatusInternalServerError,
+						httpfx.WithSanitizedError(err),
+					)
+				}
+
+				return ctx.Results.JSON(
+					cursors.WrapResponseWithCursor(map[string]bool{"counted": counted}, nil),
+				)
+			},
+		).
+		HasSummary("Record story view").
+		HasDescription("Record a view of a story. Repeated views by the same viewer within the dedup window are ignored.").
+		HasResponse(http.StatusOK)
+
 	// List interactions with profiles (public)
 	routes.
 		Route(
@@ -348,3 +398,21 @@ func RegisterHTTPRoutesForStoryInteractions( //nolint:funlen,gocognit,gocyclo,cy
 		HasDescription("Get interaction counts grouped by kind for a story.").
 		HasResponse(http.StatusOK)
 }
+
+// interactionErrorResult maps story interaction errors to HTTP results.
+func interactionErrorResult(ctx *httpfx.Context, err error) httpfx.Result {
+	switch {
+	case errors.Is(err, story_interactions.ErrRateLimitExceeded):
+		return ctx.Results.Error(
+			http.StatusTooManyRequests,
+			httpfx.WithErrorMessage("Too many interactions. Please try again later."),
+		)
+	case errors.Is(err, story_interactions.ErrInvalidInteractionKind):
+		return ctx.Results.BadRequest(httpfx.WithErrorMessage("invalid interaction kind"))
+	default:
+		return ctx.Results.Error(
+			http.StatusInternalServerError,
+			httpfx.WithSanitizedError(err),
+		)
+	}
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/unsplash"
+	"github.com/eser/aya.is/services/pkg/api/business/auth"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+)
+
+const (
+	unsplashSearchDefaultPerPage = 20
+	unsplashSearchCacheMaxAge    = "private, max-age=300"
+)
+
+func RegisterHTTPRoutesForTools( //nolint:funlen
+	routes *httpfx.Router,
+	logger *logfx.Logger,
+	authService *auth.Service,
+	userService *users.Service,
+	unsplashClient *unsplash.Client,
+) {
+	var searchProxy *unsplash.SearchProxy
+	if unsplashClient != nil {
+		searchProxy = unsplash.NewSearchProxy(unsplashClient)
+	}
+
+	// Unsplash search proxy for cover images (authenticated, rate limited, cached)
+	routes.
+		Route(
+			"GET /{locale}/_tools/unsplash/search",
+			AuthMiddleware(authService, userService),
+			func(ctx *httpfx.Context) httpfx.Result {
+				_, localeOk := validateLocale(ctx)
+				if !localeOk {
+					return ctx.Results.BadRequest(httpfx.WithErrorMessage("unsupported locale"))
+				}
+
+				if searchProxy == nil {
+					return ctx.Results.Error(
+						http.StatusServiceUnavailable,
+						httpfx.WithErrorMessage("Image search is not configured"),
+					)
+				}
+
+				sessionID, ok := ctx.Request.Context().Value(ContextKeySessionID).(string)
+				if !ok {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Session ID not found in context"))
+				}
+
+				session, err := userService.GetSessionByID(ctx.Request.Context(), sessionID)
+				if err != nil || session == nil || session.LoggedInUserID == nil {
+					return ctx.Results.Unauthorized(httpfx.WithErrorMessage("Session not found"))
+				}
+
+				query := ctx.Request.URL.Query()
+
+				page := 1
+				if pageStr := query.Get("page"); pageStr != "" {
+					page, err = strconv.Atoi(pageStr)
+					if err != nil {
+						return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid page"))
+					}
+				}
+
+				perPage := unsplashSearchDefaultPerPage
+				if perPageStr := query.Get("per_page"); perPageStr != "" {
+					perPage, err = strconv.Atoi(perPageStr)
+					if err != nil {
+						return ctx.Results.BadRequest(httpfx.WithErrorMessage("Invalid per_page"))
+					}
+				}
+
+				result, err := searchProxy.Search(
+					ctx.Request.Context(),
+					*session.LoggedInUserID,
+					query.Get("q"),
+					page,
+					perPage,
+				)
+				if err != nil {
+					switch {
+					case errors.Is(err, unsplash.ErrInvalidSearchQuery),
+						errors.Is(err, unsplash.ErrInvalidSearchPaging):
+						return ctx.Results.BadRequest(httpfx.WithSanitizedError(err))
+					case errors.Is(err, unsplash.ErrSearchRateLimited):
+						return ctx.Results.Error(
+							http.StatusTooManyRequests,
+							httpfx.WithErrorMessage("Too many image searches, please wait a moment"),
+						)
+					}
+
+					logger.ErrorContext(ctx.Request.Context(), "Failed to search Unsplash",
+						slog.String("error", err.Error()),
+						slog.String("user_id", *session.LoggedInUserID))
+
+					return ctx.Results.Error(
+						http.StatusBadGateway,
+						httpfx.WithErrorMessage("Failed to search images"),
+					)
+				}
+
+				ctx.ResponseWriter.Header().Set("Cache-Control", unsplashSearchCacheMaxAge)
+
+				wrappedResponse := map[string]any{
+					"data":  result,
+					"error": nil,
+				}
+
+				return ctx.Results.JSON(wrappedResponse)
+			},
+		).
+		HasSummary("Search Unsplash Photos").
+		HasDescription(
+			"Search Unsplash for cover images through the server. Results are safe-search " +
+				"filtered, cached per query, rate limited per user and include attribution data.",
+		).
+		HasResponse(http.StatusOK)
+}
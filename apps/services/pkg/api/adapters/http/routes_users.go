@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/httpfx"
-	"github.com/eser/aya.is/services/pkg/ajan/lib"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/api/business/auth"
 	"github.com/eser/aya.is/services/pkg/api/business/events"
@@ -419,7 +418,8 @@ func RegisterHTTPRoutesForUsers( //nolint:funlen,cyclop,gocognit,gocyclo,maintid
 // upsertManagedGitHubLink creates or updates the managed GitHub profile link
 // for a user's individual profile. Called on login (to refresh tokens) and
 // on individual profile creation (to auto-create the link).
-// Token parameters come from the session's OAuth fields.
+// Token parameters come from the session's OAuth fields. Failures are logged
+// only, since they must not block the login or the profile creation.
 func upsertManagedGitHubLink(
 	ctx context.Context,
 	logger *logfx.Logger,
@@ -430,113 +430,30 @@ func upsertManagedGitHubLink(
 	accessToken string,
 	tokenScope string,
 ) {
-	uri := "https://github.com/" + githubHandle
-
-	// First check if ANY managed GitHub link already exists for this profile.
-	managedLink, _ := profileService.GetManagedGitHubLink(ctx, profileID)
-	if managedLink != nil {
-		updateExistingGitHubLink(
-			ctx, logger, profileService, managedLink.ID, profileID,
-			managedLink.AuthAccessTokenScope, githubHandle, uri, accessToken, tokenScope,
-		)
-
-		return
-	}
-
-	// Also check by remote_id in case there's a non-managed link with the same remote_id
-	existingLink, _ := profileService.GetProfileLinkByRemoteID(
-		ctx, profileID, "github", githubRemoteID,
-	)
-
-	if existingLink != nil {
-		updateExistingGitHubLink(
-			ctx, logger, profileService, existingLink.ID, profileID,
-			nil, githubHandle, uri, accessToken, tokenScope,
-		)
-
-		return
-	}
-
-	// Create new managed GitHub link
-	createNewGitHubLink(
-		ctx, logger, profileService, profileID, githubRemoteID,
-		githubHandle, uri, accessToken, tokenScope,
-	)
-}
-
-// updateExistingGitHubLink updates an existing GitHub link's OAuth tokens.
-// If existingScope is non-nil, it checks whether the update would downgrade scope.
-func updateExistingGitHubLink(
-	ctx context.Context,
-	logger *logfx.Logger,
-	profileService *profiles.Service,
-	linkID, profileID string,
-	existingScope *string,
-	githubHandle, uri, accessToken, tokenScope string,
-) {
-	if existingScope != nil && wouldDowngradeScope(*existingScope, tokenScope) {
-		logger.DebugContext(ctx,
-			"Skipping managed GitHub link update: existing scope is broader",
-			slog.String("profile_id", profileID),
-			slog.String("existing_scope", *existingScope),
-			slog.String("new_scope", tokenScope),
-		)
+	result, err := profileService.UpsertManagedGitHubLink(ctx, profiles.ManagedGitHubLinkParams{
+		ProfileID:   profileID,
+		RemoteID:    githubRemoteID,
+		Handle:      githubHandle,
+		AccessToken: accessToken,
+		TokenScope:  tokenScope,
+	})
+	if err != nil {
+		if errors.Is(err, profiles.ErrGitHubAccountLinkedElsewhere) {
+			logger.WarnContext(ctx, "GitHub account is already linked to another profile",
+				slog.String("profile_id", profileID),
+				slog.String("remote_id", githubRemoteID))
 
-		return
-	}
+			return
+		}
 
-	err := profileService.UpdateProfileLinkOAuthTokens(
-		ctx, linkID, "en", githubHandle, uri, "GitHub",
-		tokenScope, accessToken,
-		nil, // accessTokenExpiresAt — GitHub tokens don't expire
-		nil, // refreshToken
-	)
-	if err != nil {
-		logger.WarnContext(ctx, "Failed to update managed GitHub link tokens",
+		logger.WarnContext(ctx, "Failed to upsert managed GitHub link",
 			slog.String("profile_id", profileID),
 			slog.String("error", err.Error()))
-	} else {
-		logger.DebugContext(ctx, "Updated managed GitHub link tokens",
-			slog.String("profile_id", profileID))
-	}
-}
 
-// createNewGitHubLink creates a brand new managed GitHub profile link.
-func createNewGitHubLink(
-	ctx context.Context,
-	logger *logfx.Logger,
-	profileService *profiles.Service,
-	profileID, githubRemoteID, githubHandle, uri, accessToken, tokenScope string,
-) {
-	maxOrder, _ := profileService.GetMaxProfileLinkOrder(ctx, profileID)
-	linkID := lib.IDsGenerateUnique()
-
-	_, err := profileService.CreateOAuthProfileLink(
-		ctx, linkID, "github", profileID, maxOrder+1, "en",
-		githubRemoteID, githubHandle, uri, "GitHub", "github",
-		tokenScope, accessToken,
-		nil, // accessTokenExpiresAt
-		nil, // refreshToken
-		nil, // properties
-	)
-	if err != nil {
-		logger.WarnContext(ctx, "Failed to create managed GitHub link",
-			slog.String("profile_id", profileID),
-			slog.String("error", err.Error()))
-	} else {
-		logger.DebugContext(ctx, "Created managed GitHub link on login",
-			slog.String("profile_id", profileID))
+		return
 	}
-}
-
-// wouldDowngradeScope returns true if replacing existingScope with newScope
-// would lose important permissions (public_repo or read:org).
-func wouldDowngradeScope(existingScope, newScope string) bool {
-	existingHasPublicRepo := strings.Contains(existingScope, "public_repo")
-	newHasPublicRepo := strings.Contains(newScope, "public_repo")
-	existingHasReadOrg := strings.Contains(existingScope, "read:org")
-	newHasReadOrg := strings.Contains(newScope, "read:org")
 
-	return (existingHasPublicRepo && !newHasPublicRepo) ||
-		(existingHasReadOrg && !newHasReadOrg)
+	logger.DebugContext(ctx, "Managed GitHub link synced",
+		slog.String("profile_id", profileID),
+		slog.String("result", string(result)))
 }
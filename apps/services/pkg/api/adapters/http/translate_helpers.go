@@ -20,34 +20,6 @@ var (
 	ErrFailedToParseAIResponse   = errors.New("failed to parse AI translation response")
 )
 
-// localeToLanguageName maps locale codes to full language names for AI prompts.
-// Using full names prevents LLMs from defaulting to English when given short codes.
-var localeToLanguageName = map[string]string{ //nolint:gochecknoglobals
-	"ar":    "Arabic",
-	"de":    "German",
-	"en":    "English",
-	"es":    "Spanish",
-	"fr":    "French",
-	"it":    "Italian",
-	"ja":    "Japanese",
-	"ko":    "Korean",
-	"nl":    "Dutch",
-	"pt-PT": "Portuguese (Portugal)",
-	"ru":    "Russian",
-	"tr":    "Turkish",
-	"zh-CN": "Chinese (Simplified)",
-}
-
-// languageNameForLocale returns the full language name for a locale code.
-// Falls back to the locale code itself if not found.
-func languageNameForLocale(locale string) string {
-	if name, ok := localeToLanguageName[locale]; ok {
-		return name
-	}
-
-	return locale
-}
-
 type translationResult struct {
 	Title   string `json:"title"`
 	Summary string `json:"summary"`
@@ -81,11 +53,13 @@ func (t *AIContentTranslator) Translate( //nolint:funlen
 	}
 
 	sourceLang := languageNameForLocale(sourceLocale)
-	targetLang := languageNameForLocale(targetLocale)
+	targetPrompt := AIPromptForLocale(targetLocale)
+	targetLang := targetPrompt.LanguageName
 
 	prompt := fmt.Sprintf(
 		`Translate the following content from %s to %s.
 The output MUST be written entirely in %s.
+%s
 Return ONLY a valid JSON object with exactly these three keys: "title", "summary", "content".
 Do not include any other text, explanation, or markdown formatting.
 Preserve all markdown formatting in the content field.
@@ -99,6 +73,7 @@ Content:
 		sourceLang,
 		targetLang,
 		targetLang,
+		targetPrompt.OutputInstruction,
 		title,
 		summary,
 		content,
@@ -149,9 +124,45 @@ func NewAIContentGenerator(aiModels *aifx.Registry) *AIContentGenerator {
 	return &AIContentGenerator{aiModels: aiModels}
 }
 
+const cvSystemPrompt = "You are a professional CV/resume writer. " +
+	"Generate well-structured, professional CV content based on the provided profile information. " +
+	"Always respond with valid JSON only."
+
 // GenerateCV implements the ContentGenerator interface.
 // It generates a professional CV page from the user's profile data using AI.
-func (g *AIContentGenerator) GenerateCV( //nolint:cyclop,funlen
+func (g *AIContentGenerator) GenerateCV(
+	ctx context.Context,
+	locale string,
+	profileTitle string,
+	profileDescription string,
+	linkedInURL string,
+	links []*profiles.ProfileLinkBrief,
+	contributions []*profiles.ProfileMembership,
+) (string, string, string, error) {
+	if g.aiModels == nil {
+		return "", "", "", ErrAITranslationNotAvailable
+	}
+
+	model := g.aiModels.GetDefault()
+	if model == nil {
+		return "", "", "", ErrAITranslationNotAvailable
+	}
+
+	prompt := buildCVPrompt(locale, profileTitle, profileDescription, linkedInURL, links, contributions)
+
+	result, err := model.GenerateText(ctx, newCVGenerationOptions(prompt))
+	if err != nil {
+		logAIErrorClassification(ctx, "content generation", err)
+
+		return "", "", "", fmt.Errorf("%w: %w", ErrAIGenerationFailed, err)
+	}
+
+	return parseGeneratedContent(result.Text())
+}
+
+// StreamCV implements the StreamingContentGenerator interface.
+// It generates the same CV as GenerateCV, passing each text delta to onDelta as it arrives.
+func (g *AIContentGenerator) StreamCV(
 	ctx context.Context,
 	locale string,
 	profileTitle string,
@@ -159,6 +170,7 @@ func (g *AIContentGenerator) GenerateCV( //nolint:cyclop,funlen
 	linkedInURL string,
 	links []*profiles.ProfileLinkBrief,
 	contributions []*profiles.ProfileMembership,
+	onDelta func(delta string),
 ) (string, string, string, error) {
 	if g.aiModels == nil {
 		return "", "", "", ErrAITranslationNotAvailable
@@ -169,7 +181,71 @@ func (g *AIContentGenerator) GenerateCV( //nolint:cyclop,funlen
 		return "", "", "", ErrAITranslationNotAvailable
 	}
 
-	targetLang := languageNameForLocale(locale)
+	prompt := buildCVPrompt(locale, profileTitle, profileDescription, linkedInURL, links, contributions)
+
+	stream, err := model.StreamText(ctx, newCVGenerationOptions(prompt))
+	if err != nil {
+		logAIErrorClassification(ctx, "content generation", err)
+
+		return "", "", "", fmt.Errorf("%w: %w", ErrAIGenerationFailed, err)
+	}
+
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	var text strings.Builder
+
+	for stream.Next() {
+		event := stream.Current()
+		if event.Type != aifx.StreamEventContentDelta || event.TextDelta == "" {
+			continue
+		}
+
+		text.WriteString(event.TextDelta)
+		onDelta(event.TextDelta)
+	}
+
+	err = stream.Err()
+	if err != nil {
+		logAIErrorClassification(ctx, "content generation", err)
+
+		return "", "", "", fmt.Errorf("%w: %w", ErrAIGenerationFailed, err)
+	}
+
+	return parseGeneratedContent(text.String())
+}
+
+// newCVGenerationOptions returns the generation options for a CV prompt.
+func newCVGenerationOptions(prompt string) *aifx.GenerateTextOptions {
+	return &aifx.GenerateTextOptions{
+		Messages: []aifx.Message{
+			aifx.NewTextMessage(aifx.RoleUser, prompt),
+		},
+		Tools:          nil,
+		System:         cvSystemPrompt,
+		MaxTokens:      aiMaxTokens,
+		Temperature:    nil,
+		TopP:           nil,
+		StopWords:      nil,
+		ToolChoice:     "",
+		ResponseFormat: nil,
+		ThinkingBudget: nil,
+		SafetySettings: nil,
+		Extensions:     nil,
+	}
+}
+
+// buildCVPrompt builds the CV generation prompt from the profile data.
+func buildCVPrompt( //nolint:funlen
+	locale string,
+	profileTitle string,
+	profileDescription string,
+	linkedInURL string,
+	links []*profiles.ProfileLinkBrief,
+	contributions []*profiles.ProfileMembership,
+) string {
+	targetPrompt := AIPromptForLocale(locale)
 
 	// Build context from links
 	var linksContext strings.Builder
@@ -220,9 +296,10 @@ func (g *AIContentGenerator) GenerateCV( //nolint:cyclop,funlen
 		contributionsContext.WriteString("\n")
 	}
 
-	prompt := fmt.Sprintf(
+	return fmt.Sprintf(
 		`Based on the profile information provided below, generate a professional CV page in markdown.
 The output MUST be written entirely in %s.
+%s
 
 Profile Name: %s
 Profile Bio: %s
@@ -234,7 +311,7 @@ Organization Memberships and Contributions:
 %s
 Instructions:
 - Generate a professional CV/resume page in markdown format.
-- Structure the CV with ## Experience, ## Education, and ## Certificates sections.
+- Structure the CV with ## %s, ## %s, and ## %s sections.
 - For experience entries, use ### Role at Company format with date ranges and achievement bullet points.
 - Use all available context to generate realistic professional content.
 - The user will review and edit this draft afterward, so focus on providing a solid starting structure.
@@ -244,43 +321,24 @@ Instructions:
 Return ONLY a valid JSON object with exactly these three keys: "title", "summary", "content".
 Do not include any other text, explanation, or markdown formatting around the JSON.
 The "content" field should contain the full markdown CV.`,
-		targetLang,
+		targetPrompt.LanguageName,
+		targetPrompt.OutputInstruction,
 		profileTitle,
 		profileDescription,
 		linkedInURL,
 		linksContext.String(),
 		contributionsContext.String(),
+		targetPrompt.CVExperience,
+		targetPrompt.CVEducation,
+		targetPrompt.CVCertificates,
 	)
+}
 
-	result, err := model.GenerateText(ctx, &aifx.GenerateTextOptions{
-		Messages: []aifx.Message{
-			aifx.NewTextMessage(aifx.RoleUser, prompt),
-		},
-		Tools: nil,
-		System: "You are a professional CV/resume writer. " +
-			"Generate well-structured, professional CV content based on the provided profile information. " +
-			"Always respond with valid JSON only.",
-		MaxTokens:      aiMaxTokens,
-		Temperature:    nil,
-		TopP:           nil,
-		StopWords:      nil,
-		ToolChoice:     "",
-		ResponseFormat: nil,
-		ThinkingBudget: nil,
-		SafetySettings: nil,
-		Extensions:     nil,
-	})
-	if err != nil {
-		logAIErrorClassification(ctx, "content generation", err)
-
-		return "", "", "", fmt.Errorf("%w: %w", ErrAIGenerationFailed, err)
-	}
-
-	responseText := extractJSON(result.Text())
-
+// parseGeneratedContent parses the JSON object returned by the model.
+func parseGeneratedContent(text string) (string, string, string, error) {
 	var generated translationResult
 
-	err = json.Unmarshal([]byte(responseText), &generated)
+	err := json.Unmarshal([]byte(extractJSON(text)), &generated)
 	if err != nil {
 		return "", "", "", fmt.Errorf("%w: %w", ErrFailedToParseAIResponse, err)
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -67,16 +68,21 @@ func createSearchHandler(
 			limit = maxLimit
 		}
 
-		results, err := profileService.Search(ctx, locale, input.Query, input.Profile, int32(limit))
+		results, err := profileService.Search(ctx, locale, &profiles.SearchParams{
+			Query:       input.Query,
+			ProfileSlug: input.Profile,
+			Kinds:       nil,
+			Cursor:      cursors.NewCursor(limit, nil),
+		})
 		if err != nil {
 			return nil, searchOutput{}, fmt.Errorf("searching: %w", err)
 		}
 
 		output := searchOutput{
-			Results: make([]searchResultBrief, 0, len(results)),
+			Results: make([]searchResultBrief, 0, len(results.Data)),
 		}
 
-		for _, result := range results {
+		for _, result := range results.Data {
 			output.Results = append(output.Results, searchResultBrief{
 				Type:         result.Type,
 				Slug:         result.Slug,
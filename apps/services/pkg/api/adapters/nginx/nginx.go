@@ -0,0 +1,270 @@
+package nginx
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+)
+
+var (
+	ErrNotConfigured  = errors.New("nginx adapter not configured")
+	ErrInvalidDomain  = errors.New("invalid domain for nginx config")
+	ErrConfigRejected = errors.New("nginx rejected the generated config")
+	ErrCommandFailed  = errors.New("nginx command failed")
+)
+
+const configFileMode fs.FileMode = 0o644
+
+// domainRegex guards the generated config against anything but plain host names.
+var domainRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]*[a-z0-9])?$`)
+
+//go:embed server_blocks.conf.tmpl
+var defaultTemplate string
+
+// Config holds configuration for the nginx adapter.
+type Config struct {
+	// ConfigPath is the file the server blocks are written to; nginx must include it.
+	ConfigPath string `conf:"config_path" default:"/etc/nginx/conf.d/aya-custom-domains.conf"`
+	// TemplatePath optionally replaces the built-in server block template.
+	TemplatePath string `conf:"template_path"`
+	// UpstreamURL is where the server blocks proxy requests to.
+	UpstreamURL string `conf:"upstream_url" default:"http://127.0.0.1:3000"`
+	// TestCommand checks the configuration after it is written; a failure restores
+	// the previous file. Empty skips the check.
+	TestCommand string `conf:"test_command" default:"nginx -t"`
+	// ReloadCommand applies the configuration.
+	ReloadCommand  string        `conf:"reload_command"  default:"nginx -s reload"`
+	CommandTimeout time.Duration `conf:"command_timeout" default:"30s"`
+}
+
+// CommandRunner runs a command and returns its combined output.
+type CommandRunner func(ctx context.Context, name string, args ...string) ([]byte, error)
+
+// Syncer implements profiles.WebserverSyncer by rendering one nginx server block
+// per domain into a config file and reloading nginx.
+type Syncer struct {
+	config *Config
+	logger *logfx.Logger
+	runner CommandRunner
+}
+
+// templateData is what the server block template is rendered with.
+type templateData struct {
+	UpstreamURL string
+	Domains     []string
+}
+
+// New creates an nginx syncer that runs commands on the host.
+func New(config *Config, logger *logfx.Logger) *Syncer {
+	return NewWithRunner(config, logger, runCommand)
+}
+
+// NewWithRunner creates an nginx syncer that runs its test and reload commands
+// through runner.
+func NewWithRunner(config *Config, logger *logfx.Logger, runner CommandRunner) *Syncer {
+	return &Syncer{
+		config: config,
+		logger: logger,
+		runner: runner,
+	}
+}
+
+// GetCurrentDomains returns the domains in the server_name directives of the
+// config file. A missing file means no domains.
+func (s *Syncer) GetCurrentDomains(_ context.Context) ([]string, error) {
+	if s.config.ConfigPath == "" {
+		return nil, ErrNotConfigured
+	}
+
+	content, err := os.ReadFile(s.config.ConfigPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return []string{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading nginx config: %w", err)
+	}
+
+	return parseServerNames(content), nil
+}
+
+// UpdateDomains renders the server blocks for the domains, writes them to the
+// config file and runs the test command. When nginx rejects the new file the
+// previous one is restored.
+func (s *Syncer) UpdateDomains(ctx context.Context, domains []string) error {
+	if s.config.ConfigPath == "" {
+		return ErrNotConfigured
+	}
+
+	rendered, err := s.Render(domains)
+	if err != nil {
+		return err
+	}
+
+	previous, readErr := os.ReadFile(s.config.ConfigPath)
+	hadPrevious := readErr == nil
+
+	s.logger.WarnContext(ctx, "Updating nginx server blocks",
+		slog.String("config_path", s.config.ConfigPath),
+		slog.Int("domain_count", len(domains)))
+
+	err = writeFileAtomic(s.config.ConfigPath, rendered)
+	if err != nil {
+		return err
+	}
+
+	testErr := s.run(ctx, s.config.TestCommand)
+	if testErr == nil {
+		return nil
+	}
+
+	if hadPrevious {
+		err = writeFileAtomic(s.config.ConfigPath, previous)
+	} else {
+		err = os.Remove(s.config.ConfigPath)
+	}
+
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to restore nginx config",
+			slog.String("config_path", s.config.ConfigPath),
+			slog.Any("error", err))
+	}
+
+	return fmt.Errorf("%w: %w", ErrConfigRejected, testErr)
+}
+
+// RestartApplication reloads nginx so it picks up the written config.
+func (s *Syncer) RestartApplication(ctx context.Context) error {
+	return s.run(ctx, s.config.ReloadCommand)
+}
+
+// Render returns the config file content for the domains.
+func (s *Syncer) Render(domains []string) ([]byte, error) {
+	for _, domain := range domains {
+		if !domainRegex.MatchString(domain) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidDomain, domain)
+		}
+	}
+
+	source := defaultTemplate
+
+	if s.config.TemplatePath != "" {
+		custom, err := os.ReadFile(s.config.TemplatePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading nginx template: %w", err)
+		}
+
+		source = string(custom)
+	}
+
+	tmpl, err := template.New("server_blocks").Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nginx template: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	err = tmpl.Execute(&buf, templateData{
+		UpstreamURL: s.config.UpstreamURL,
+		Domains:     domains,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rendering nginx template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (s *Syncer) run(ctx context.Context, command string) error {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	if s.config.CommandTimeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, s.config.CommandTimeout)
+		defer cancel()
+	}
+
+	output, err := s.runner(ctx, fields[0], fields[1:]...)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w: %s", ErrCommandFailed, command, err,
+			strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+func runCommand(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, name, args...).CombinedOutput() //nolint:gosec // configured command
+}
+
+// parseServerNames collects the names of every server_name directive.
+func parseServerNames(content []byte) []string {
+	domains := make([]string, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		names, found := strings.CutPrefix(line, "server_name ")
+		if !found {
+			continue
+		}
+
+		names = strings.TrimSuffix(strings.TrimSpace(names), ";")
+		domains = append(domains, strings.Fields(names)...)
+	}
+
+	return domains
+}
+
+// writeFileAtomic replaces path with content through a rename, so nginx never
+// reads a half-written file.
+func writeFileAtomic(path string, content []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("writing nginx config: %w", err)
+	}
+
+	tmpPath := tmp.Name()
+
+	_, err = tmp.Write(content)
+	closeErr := tmp.Close()
+
+	if err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Chmod(tmpPath, configFileMode)
+	}
+
+	if err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmpPath)
+
+		return fmt.Errorf("writing nginx config: %w", err)
+	}
+
+	return nil
+}
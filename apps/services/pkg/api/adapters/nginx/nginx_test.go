@@ -0,0 +1,168 @@
+package nginx_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/nginx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRejected = errors.New("exit status 1")
+
+// recordingRunner records the commands it is asked to run and fails the ones
+// listed in failing.
+type recordingRunner struct {
+	commands []string
+	failing  map[string]bool
+	// seen holds the config file content at the time of each command.
+	seen       []string
+	configPath string
+}
+
+func (r *recordingRunner) run(_ context.Context, name string, args ...string) ([]byte, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	r.commands = append(r.commands, command)
+
+	content, _ := os.ReadFile(r.configPath)
+	r.seen = append(r.seen, string(content))
+
+	if r.failing[command] {
+		return []byte("nginx: [emerg] invalid config"), errRejected
+	}
+
+	return nil, nil
+}
+
+func newSyncer(t *testing.T, failing ...string) (*nginx.Syncer, *recordingRunner, string) {
+	t.Helper()
+
+	configPath := filepath.Join(t.TempDir(), "aya-custom-domains.conf")
+	runner := &recordingRunner{ //nolint:exhaustruct
+		failing:    map[string]bool{},
+		configPath: configPath,
+	}
+
+	for _, command := range failing {
+		runner.failing[command] = true
+	}
+
+	config := &nginx.Config{
+		ConfigPath:     configPath,
+		TemplatePath:   "",
+		UpstreamURL:    "http://127.0.0.1:3000",
+		TestCommand:    "nginx -t",
+		ReloadCommand:  "nginx -s reload",
+		CommandTimeout: 0,
+	}
+
+	return nginx.NewWithRunner(config, logfx.NewLogger(), runner.run), runner, configPath
+}
+
+func TestUpdateDomains(t *testing.T) {
+	t.Parallel()
+
+	syncer, runner, configPath := newSyncer(t)
+
+	err := syncer.UpdateDomains(t.Context(), []string{"example.com", "blog.example.org"})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(content), "server_name example.com;")
+	assert.Contains(t, string(content), "server_name blog.example.org;")
+	assert.Equal(t, 2, strings.Count(string(content), "proxy_pass http://127.0.0.1:3000;"))
+
+	// The dry run sees the new file before anything is reloaded.
+	assert.Equal(t, []string{"nginx -t"}, runner.commands)
+	assert.Equal(t, string(content), runner.seen[0])
+
+	domains, err := syncer.GetCurrentDomains(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com", "blog.example.org"}, domains)
+
+	require.NoError(t, syncer.RestartApplication(t.Context()))
+	assert.Equal(t, []string{"nginx -t", "nginx -s reload"}, runner.commands)
+}
+
+func TestUpdateDomains_RejectedConfigIsRestored(t *testing.T) {
+	t.Parallel()
+
+	syncer, _, configPath := newSyncer(t, "nginx -t")
+
+	previous := "server {\n    server_name old.example.com;\n}\n"
+	require.NoError(t, os.WriteFile(configPath, []byte(previous), 0o600))
+
+	err := syncer.UpdateDomains(t.Context(), []string{"example.com"})
+	require.ErrorIs(t, err, nginx.ErrConfigRejected)
+	assert.Contains(t, err.Error(), "invalid config")
+
+	content, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, previous, string(content))
+}
+
+func TestUpdateDomains_RejectedFirstConfigIsRemoved(t *testing.T) {
+	t.Parallel()
+
+	syncer, _, configPath := newSyncer(t, "nginx -t")
+
+	err := syncer.UpdateDomains(t.Context(), []string{"example.com"})
+	require.ErrorIs(t, err, nginx.ErrConfigRejected)
+
+	_, err = os.Stat(configPath)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestUpdateDomains_InvalidDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"directive injection": "example.com; include /etc/passwd",
+		"uppercase":           "Example.com",
+		"whitespace":          "example .com",
+		"empty":               "",
+	}
+
+	for name, domain := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			syncer, runner, configPath := newSyncer(t)
+
+			err := syncer.UpdateDomains(t.Context(), []string{"example.com", domain})
+			require.ErrorIs(t, err, nginx.ErrInvalidDomain)
+
+			assert.Empty(t, runner.commands)
+
+			_, err = os.Stat(configPath)
+			assert.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
+
+func TestGetCurrentDomains_MissingFile(t *testing.T) {
+	t.Parallel()
+
+	syncer, _, _ := newSyncer(t)
+
+	domains, err := syncer.GetCurrentDomains(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, domains)
+}
+
+func TestRestartApplication_Failure(t *testing.T) {
+	t.Parallel()
+
+	syncer, _, _ := newSyncer(t, "nginx -s reload")
+
+	err := syncer.RestartApplication(t.Context())
+	require.ErrorIs(t, err, nginx.ErrCommandFailed)
+}
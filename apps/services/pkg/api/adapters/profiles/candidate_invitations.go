@@ -0,0 +1,65 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
+	profilesbiz "github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+// SendCandidateInvitation sends a mailbox invitation to the referred profile.
+func SendCandidateInvitation(
+	ctx context.Context,
+	logger *logfx.Logger,
+	profileService *profilesbiz.Service,
+	mailboxService *mailbox.Service,
+	profileSlug string,
+	candidateID string,
+) error {
+	candidate, err := profileService.GetCandidateByID(ctx, candidateID)
+	if err != nil {
+		return fmt.Errorf("failed to get candidate: %w", err)
+	}
+
+	profile, profileErr := profileService.GetBySlugInternal(ctx, profileSlug)
+	if profileErr != nil {
+		return fmt.Errorf("failed to get profile: %w", profileErr)
+	}
+
+	message := "You have been invited to join " + profile.Title
+	props := mailbox.InvitationProperties{
+		InvitationKind:   mailbox.InvitationKindProfileJoin,
+		TelegramChatID:   0,
+		GroupProfileSlug: "",
+		GroupName:        "",
+		InviteLink:       nil,
+		CandidateID:      candidateID,
+		ProfileID:        candidate.ProfileID,
+		ProfileSlug:      profileSlug,
+	}
+
+	_, envelopeErr := mailboxService.SendSystemEnvelope(ctx, &mailbox.SendMessageParams{
+		SenderProfileID:    candidate.ProfileID,
+		TargetProfileID:    candidate.ReferredProfileID,
+		SenderUserID:       nil,
+		Kind:               mailbox.KindInvitation,
+		ConversationTitle:  "Membership Invitation",
+		Message:            &message,
+		Properties:         props,
+		ReplyToID:          nil,
+		SenderProfileTitle: profile.Title,
+		Locale:             "",
+	})
+	if envelopeErr != nil {
+		return fmt.Errorf("failed to send envelope: %w", envelopeErr)
+	}
+
+	logger.InfoContext(ctx, "Candidate invitation sent",
+		slog.String("candidate_id", candidateID),
+		slog.String("referred_profile_id", candidate.ReferredProfileID))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package profiles
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/i18nfx"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+)
+
+// NewMentionNotifier returns a callback that sends a mailbox message to a profile
+// when it is newly mentioned in a story or page.
+func NewMentionNotifier(
+	mailboxService *mailbox.Service,
+	localizer *i18nfx.Localizer,
+	logger *logfx.Logger,
+) profile_mentions.OnMentionedFunc {
+	return func(ctx context.Context, mention *profile_mentions.Mention) {
+		// Mailbox envelopes require a sender; stories without an author are skipped.
+		if mention.SourceProfileID == "" {
+			return
+		}
+
+		messageID := "MentionNotificationStory"
+		if mention.SourceKind == profile_mentions.SourceKindPage {
+			messageID = "MentionNotificationPage"
+		}
+
+		message := localizer.T(mention.LocaleCode, messageID)
+
+		_, err := mailboxService.SendSystemEnvelope(ctx, &mailbox.SendMessageParams{
+			SenderProfileID:    mention.SourceProfileID,
+			TargetProfileID:    mention.MentionedProfileID,
+			SenderUserID:       nil,
+			Kind:               mailbox.KindMessage,
+			ConversationTitle:  localizer.T(mention.LocaleCode, "MentionNotificationTitle"),
+			Message:            &message,
+			Properties:         nil,
+			ReplyToID:          nil,
+			SenderProfileTitle: "",
+			Locale:             mention.LocaleCode,
+		})
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to send mention notification",
+				slog.String("source_kind", mention.SourceKind),
+				slog.String("source_id", mention.SourceID),
+				slog.String("mentioned_profile_id", mention.MentionedProfileID),
+				slog.String("error", err.Error()))
+		}
+	}
+}
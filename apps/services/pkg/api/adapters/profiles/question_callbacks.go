@@ -0,0 +1,41 @@
+package profiles
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
+)
+
+// NewQuestionAnswerNotifier returns a callback that sends a mailbox message to the
+// asker when their question on a profile is answered.
+func NewQuestionAnswerNotifier(
+	mailboxService *mailbox.Service,
+	logger *logfx.Logger,
+) profile_questions.OnAnsweredFunc {
+	return func(ctx context.Context, answered *profile_questions.AnsweredQuestion) {
+		message := "Your question to " + answered.ProfileSlug + " has been answered."
+
+		_, err := mailboxService.SendSystemEnvelope(ctx, &mailbox.SendMessageParams{
+			SenderProfileID:    answered.ProfileID,
+			TargetProfileID:    answered.AuthorProfileID,
+			SenderUserID:       nil,
+			Kind:               mailbox.KindMessage,
+			ConversationTitle:  "Q&A",
+			Message:            &message,
+			Properties:         map[string]any{"question_id": answered.QuestionID},
+			ReplyToID:          nil,
+			SenderProfileTitle: "",
+			Locale:             answered.Locale,
+		})
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to send question answer notification",
+				slog.String("question_id", answered.QuestionID),
+				slog.String("profile_id", answered.ProfileID),
+				slog.String("author_profile_id", answered.AuthorProfileID),
+				slog.String("error", err.Error()))
+		}
+	}
+}
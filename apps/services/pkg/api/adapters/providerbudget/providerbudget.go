@@ -0,0 +1,197 @@
+// Package providerbudget shares one request budget per provider API across
+// everything that calls it — sync workers and on-demand fetches alike — so
+// together they stay within the provider's per-app quota.
+package providerbudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrBudgetExhausted = errors.New("provider request budget exhausted")
+
+// Providers with a budget.
+const (
+	ProviderGitHub      = "github"
+	ProviderYouTube     = "youtube"
+	ProviderSpeakerDeck = "speakerdeck"
+)
+
+// Config holds the request budget of each provider: a bucket of Burst tokens
+// that refills at PerHour tokens an hour. A zero PerHour leaves the provider
+// unlimited.
+type Config struct {
+	GitHubPerHour      int           `conf:"github_per_hour"      default:"4000"`
+	GitHubBurst        int           `conf:"github_burst"         default:"100"`
+	YouTubePerHour     int           `conf:"youtube_per_hour"     default:"400"`
+	YouTubeBurst       int           `conf:"youtube_burst"        default:"50"`
+	SpeakerDeckPerHour int           `conf:"speakerdeck_per_hour" default:"600"`
+	SpeakerDeckBurst   int           `conf:"speakerdeck_burst"    default:"20"`
+	MaxWait            time.Duration `conf:"max_wait"             default:"30s"`
+	Enabled            bool          `conf:"enabled"              default:"true"`
+}
+
+// Status is the current budget of a provider.
+type Status struct {
+	Provider  string  `json:"provider"`
+	Available float64 `json:"available"`
+	Burst     int     `json:"burst"`
+	PerHour   int     `json:"per_hour"`
+}
+
+// bucket is a token bucket refilled continuously at perHour tokens an hour.
+type bucket struct {
+	updatedAt time.Time
+	tokens    float64
+	burst     int
+	perHour   int
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.updatedAt)
+	if elapsed > 0 {
+		b.tokens = min(float64(b.burst), b.tokens+elapsed.Hours()*float64(b.perHour))
+	}
+
+	b.updatedAt = now
+}
+
+// untilNextToken is how long until the bucket holds a whole token again.
+func (b *bucket) untilNextToken() time.Duration {
+	return time.Duration((1 - b.tokens) / float64(b.perHour) * float64(time.Hour))
+}
+
+// Budgets tracks the request budget of each provider.
+type Budgets struct {
+	config  *Config
+	now     func() time.Time
+	buckets map[string]*bucket
+	mu      sync.Mutex
+}
+
+// New creates the provider budgets, each starting full.
+func New(config *Config) *Budgets {
+	return NewWithClock(config, time.Now)
+}
+
+// NewWithClock creates the provider budgets with the given clock.
+func NewWithClock(config *Config, now func() time.Time) *Budgets {
+	budgets := &Budgets{
+		config:  config,
+		now:     now,
+		buckets: map[string]*bucket{},
+		mu:      sync.Mutex{},
+	}
+
+	if config == nil || !config.Enabled {
+		return budgets
+	}
+
+	limits := map[string][2]int{
+		ProviderGitHub:      {config.GitHubPerHour, config.GitHubBurst},
+		ProviderYouTube:     {config.YouTubePerHour, config.YouTubeBurst},
+		ProviderSpeakerDeck: {config.SpeakerDeckPerHour, config.SpeakerDeckBurst},
+	}
+
+	for provider, limit := range limits {
+		perHour, burst := limit[0], max(limit[1], 1)
+		if perHour <= 0 {
+			continue
+		}
+
+		budgets.buckets[provider] = &bucket{
+			updatedAt: now(),
+			tokens:    float64(burst),
+			burst:     burst,
+			perHour:   perHour,
+		}
+	}
+
+	return budgets
+}
+
+// TryTake takes one request from the provider's budget without waiting. When
+// the budget is exhausted it returns false and how long until a request is
+// available. Providers without a budget are never limited.
+func (b *Budgets) TryTake(provider string) (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bucket, ok := b.buckets[provider]
+	if !ok {
+		return 0, true
+	}
+
+	bucket.refill(b.now())
+
+	if bucket.tokens < 1 {
+		return bucket.untilNextToken(), false
+	}
+
+	bucket.tokens--
+
+	return 0, true
+}
+
+// Take takes one request from the provider's budget, waiting for it to refill
+// up to the configured MaxWait. It returns ErrBudgetExhausted, along with how
+// long until a request is available, when the wait would be longer.
+func (b *Budgets) Take(ctx context.Context, provider string) (time.Duration, error) {
+	maxWait := time.Duration(0)
+	if b.config != nil {
+		maxWait = b.config.MaxWait
+	}
+
+	for {
+		retryAfter, ok := b.TryTake(provider)
+		if ok {
+			return 0, nil
+		}
+
+		if retryAfter > maxWait {
+			return retryAfter, fmt.Errorf("%w(provider: %s)", ErrBudgetExhausted, provider)
+		}
+
+		maxWait -= retryAfter
+
+		timer := time.NewTimer(retryAfter)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return 0, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Statuses returns the current budget of each limited provider.
+func (b *Budgets) Statuses() []*Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	statuses := make([]*Status, 0, len(b.buckets))
+
+	for provider, bucket := range b.buckets {
+		bucket.refill(b.now())
+
+		statuses = append(statuses, &Status{
+			Provider:  provider,
+			Available: bucket.tokens,
+			Burst:     bucket.burst,
+			PerHour:   bucket.perHour,
+		})
+	}
+
+	slices.SortFunc(statuses, func(a, b *Status) int {
+		return strings.Compare(a.Provider, b.Provider)
+	})
+
+	return statuses
+}
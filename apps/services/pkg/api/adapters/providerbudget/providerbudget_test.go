@@ -0,0 +1,183 @@
+package providerbudget_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpclient"
+	"github.com/eser/aya.is/services/pkg/api/adapters/providerbudget"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is a clock the tests move forward by hand.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newBudgets(clock *fakeClock) *providerbudget.Budgets {
+	return providerbudget.NewWithClock(&providerbudget.Config{ //nolint:exhaustruct
+		GitHubPerHour: 3600,
+		GitHubBurst:   2,
+		Enabled:       true,
+	}, clock.Now)
+}
+
+func TestBudgets_Exhaustion(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Now()}
+	budgets := newBudgets(clock)
+
+	for i := range 2 {
+		_, ok := budgets.TryTake(providerbudget.ProviderGitHub)
+		assert.True(t, ok, "request %d", i+1)
+	}
+
+	retryAfter, ok := budgets.TryTake(providerbudget.ProviderGitHub)
+	assert.False(t, ok)
+	assert.Equal(t, time.Second, retryAfter)
+
+	_, err := budgets.Take(t.Context(), providerbudget.ProviderGitHub)
+	require.ErrorIs(t, err, providerbudget.ErrBudgetExhausted)
+
+	// Providers without a budget are never limited.
+	_, ok = budgets.TryTake(providerbudget.ProviderYouTube)
+	assert.True(t, ok)
+}
+
+func TestBudgets_Refill(t *testing.T) {
+	t.Parallel()
+
+	clock := &fakeClock{now: time.Now()}
+	budgets := newBudgets(clock)
+
+	budgets.TryTake(providerbudget.ProviderGitHub)
+	budgets.TryTake(providerbudget.ProviderGitHub)
+
+	clock.now = clock.now.Add(time.Second)
+
+	_, ok := budgets.TryTake(providerbudget.ProviderGitHub)
+	assert.True(t, ok, "a second refills one request")
+
+	_, ok = budgets.TryTake(providerbudget.ProviderGitHub)
+	assert.False(t, ok)
+
+	// The budget refills up to its burst, no further.
+	clock.now = clock.now.Add(time.Hour)
+
+	statuses := budgets.Statuses()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, providerbudget.ProviderGitHub, statuses[0].Provider)
+	assert.InDelta(t, 2.0, statuses[0].Available, 0.001)
+}
+
+func TestTransport(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	base := roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		calls++
+
+		return httptest.NewRecorder().Result(), nil
+	})
+
+	clock := &fakeClock{now: time.Now()}
+	client := &http.Client{ //nolint:exhaustruct
+		Transport: providerbudget.NewTransport(base, newBudgets(clock)),
+	}
+
+	get := func(url string) *http.Response {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+
+		defer func() { _ = res.Body.Close() }()
+
+		return res
+	}
+
+	get("https://api.github.com/repos/eser/aya.is")
+	get("https://api.github.com/repos/eser/aya.is")
+
+	res := get("https://api.github.com/repos/eser/aya.is")
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, "1", res.Header.Get("Retry-After"))
+	assert.Equal(t, 2, calls, "an exhausted budget must not reach the provider")
+
+	// Hosts of other services are not budgeted.
+	res = get("https://example.com/")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 3, calls)
+}
+
+func TestInstall_CountsRetries(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	base := roundTripFunc(func(_ *http.Request) (*http.Response, error) {
+		calls++
+
+		res := httptest.NewRecorder().Result()
+		if calls == 1 {
+			res.StatusCode = http.StatusServiceUnavailable
+		}
+
+		return res, nil
+	})
+
+	client := httpclient.NewClient(
+		httpclient.WithRoundTripper(base),
+		httpclient.WithConfig(&httpclient.Config{ //nolint:exhaustruct
+			RetryStrategy: httpclient.RetryStrategyConfig{
+				Enabled:         true,
+				MaxAttempts:     2,
+				InitialInterval: time.Millisecond,
+				MaxInterval:     time.Millisecond,
+				Multiplier:      1,
+				RandomFactor:    0,
+			},
+			ServerErrorThreshold: httpclient.DefaultServerErrorThreshold,
+		}),
+	)
+
+	clock := &fakeClock{now: time.Now()}
+	providerbudget.Install(client, newBudgets(clock))
+
+	get := func() *http.Response {
+		req, err := http.NewRequestWithContext(
+			t.Context(), http.MethodGet, "https://api.github.com/repos/eser/aya.is", nil,
+		)
+		require.NoError(t, err)
+
+		res, err := client.Do(req)
+		require.NoError(t, err)
+
+		defer func() { _ = res.Body.Close() }()
+
+		return res
+	}
+
+	res := get()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, 2, calls)
+
+	// The retried request used up the whole budget.
+	res = get()
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,78 @@
+package providerbudget
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/httpclient"
+)
+
+// providerHosts maps the API hosts that count against a provider's budget.
+var providerHosts = map[string]string{ //nolint:gochecknoglobals
+	"api.github.com":         ProviderGitHub,
+	"www.googleapis.com":     ProviderYouTube,
+	"youtube.googleapis.com": ProviderYouTube,
+	"speakerdeck.com":        ProviderSpeakerDeck,
+}
+
+// Transport takes a request from the provider's budget before each call to a
+// provider API. When the budget is exhausted it answers with a
+// 429 Too Many Requests carrying a Retry-After header instead of calling the
+// provider, so callers handle it like the provider's own rate limiting.
+type Transport struct {
+	Base    http.RoundTripper
+	Budgets *Budgets
+}
+
+// Install places the provider budgets inside the client's retry layer, so every
+// attempt, retries included, takes a request from the provider's budget.
+func Install(client *httpclient.Client, budgets *Budgets) {
+	client.Transport.Transport = NewTransport(client.Transport.Transport, budgets)
+}
+
+// NewTransport wraps base with the provider budgets.
+func NewTransport(base http.RoundTripper, budgets *Budgets) *Transport {
+	return &Transport{
+		Base:    base,
+		Budgets: budgets,
+	}
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	provider, ok := providerHosts[strings.ToLower(req.URL.Hostname())]
+	if !ok {
+		return t.Base.RoundTrip(req)
+	}
+
+	retryAfter, err := t.Budgets.Take(req.Context(), provider)
+	if errors.Is(err, ErrBudgetExhausted) {
+		return exhaustedResponse(req, retryAfter), nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.Base.RoundTrip(req)
+}
+
+func exhaustedResponse(req *http.Request, retryAfter time.Duration) *http.Response {
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	header := http.Header{}
+	header.Set("Retry-After", strconv.Itoa(seconds))
+
+	return &http.Response{ //nolint:exhaustruct
+		Status:     "429 Too Many Requests",
+		StatusCode: http.StatusTooManyRequests,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(ErrBudgetExhausted.Error())),
+		Request:    req,
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/eser/aya.is/services/pkg/api/business/uploads"
 )
 
 var (
@@ -17,6 +19,7 @@ var (
 	ErrFailedToCreateClient = errors.New("failed to create S3 client")
 	ErrFailedToPresignURL   = errors.New("failed to generate presigned URL")
 	ErrFailedToRemoveObject = errors.New("failed to remove object")
+	ErrFailedToGetObject    = errors.New("failed to get object")
 )
 
 // Config holds the S3 client configuration.
@@ -123,6 +126,27 @@ func (c *Client) GeneratePresignedDownloadURL(
 	return presignedReq.URL, nil
 }
 
+// GetObject opens an object in the bucket for reading.
+func (c *Client) GetObject(
+	ctx context.Context,
+	key string,
+) (io.ReadCloser, *uploads.ObjectInfo, error) {
+	input := &s3.GetObjectInput{ //nolint:exhaustruct
+		Bucket: aws.String(c.bucketName),
+		Key:    aws.String(key),
+	}
+
+	output, err := c.s3Client.GetObject(ctx, input)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", ErrFailedToGetObject, err)
+	}
+
+	return output.Body, &uploads.ObjectInfo{
+		ContentType: aws.ToString(output.ContentType),
+		Size:        aws.ToInt64(output.ContentLength),
+	}, nil
+}
+
 // RemoveObject deletes an object from the bucket.
 func (c *Client) RemoveObject(ctx context.Context, key string) error {
 	input := &s3.DeleteObjectInput{ //nolint:exhaustruct
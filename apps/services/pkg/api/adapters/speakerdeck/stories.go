@@ -2,12 +2,14 @@ package speakerdeck
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 	"github.com/eser/aya.is/services/pkg/api/business/siteimporter"
 	"github.com/mmcdole/gofeed"
 	ext "github.com/mmcdole/gofeed/extensions"
@@ -30,7 +32,20 @@ func (p *Provider) FetchAll(
 		feed, err := p.parser.ParseURLWithContext(rssURL, ctx)
 		if err != nil {
 			if page == 1 {
-				return nil, fmt.Errorf("failed to parse RSS feed: %w", err)
+				err = fmt.Errorf("failed to parse RSS feed: %w", err)
+
+				// The feed has no Retry-After hint, but a rate-limited or failing
+				// SpeakerDeck still makes the sync worker back off.
+				var httpErr gofeed.HTTPError
+				if errors.As(err, &httpErr) {
+					return nil, &linksync.ProviderError{
+						Err:        err,
+						StatusCode: httpErr.StatusCode,
+						RetryAfter: 0,
+					}
+				}
+
+				return nil, err
 			}
 			// Later pages may 404 when there are no more items
 			break
@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/lib/caching"
+)
+
+// Cache key classes used by the repository.
+const (
+	CacheKeyProfileIDBySlug      = "profile_id_by_slug"
+	CacheKeyProfileSlugExists    = "profile_slug_exists"
+	CacheKeyStoryIDBySlug        = "story_id_by_slug"
+	CacheKeyCustomDomainByDomain = "custom_domain_by_domain"
+	CacheKeyUserBriefInfo        = "user_brief_info"
+	CacheKeyMembershipKind       = "membership_kind"
+	CacheKeyProfileCounts        = "profile_counts"
+	CacheKeyPointsLeaderboard    = "points_leaderboard"
+	CacheKeyProfileList          = "profile_list"
+)
+
+// CacheConfig holds the TTL of each cache key class.
+//
+// Defaults:
+//   - user_brief_info, membership_kind: 30s. They drive authorization
+//     decisions, so role or kind changes must take effect quickly.
+//   - profile_slug_exists: 1m. Not invalidated on writes, and a stale answer
+//     blocks or allows slug reservation.
+//   - custom_domain_by_domain: 2m. Not invalidated on writes.
+//   - profile_counts: 1m. Badge totals; not invalidated on writes, so they
+//     may trail the underlying lists briefly.
+//   - points_leaderboard: 1m. Only the top page is cached; points move slowly
+//     and the page is not invalidated on awards. Profile writes invalidate it.
+//   - profile_list: 30s. Pages of the profile list without a search query;
+//     invalidated on profile writes.
+//   - profile_id_by_slug, story_id_by_slug: 10m. Slug-to-ID mappings rarely
+//     change and are invalidated explicitly when they do.
+//   - everything else: 2m.
+type CacheConfig struct {
+	DefaultTTL              time.Duration `conf:"default_ttl"                 default:"2m"`
+	ProfileIDBySlugTTL      time.Duration `conf:"profile_id_by_slug_ttl"      default:"10m"`
+	ProfileSlugExistsTTL    time.Duration `conf:"profile_slug_exists_ttl"     default:"1m"`
+	StoryIDBySlugTTL        time.Duration `conf:"story_id_by_slug_ttl"        default:"10m"`
+	CustomDomainByDomainTTL time.Duration `conf:"custom_domain_by_domain_ttl" default:"2m"`
+	UserBriefInfoTTL        time.Duration `conf:"user_brief_info_ttl"         default:"30s"`
+	MembershipKindTTL       time.Duration `conf:"membership_kind_ttl"         default:"30s"`
+	ProfileCountsTTL        time.Duration `conf:"profile_counts_ttl"          default:"1m"`
+	PointsLeaderboardTTL    time.Duration `conf:"points_leaderboard_ttl"      default:"1m"`
+	ProfileListTTL          time.Duration `conf:"profile_list_ttl"            default:"30s"`
+}
+
+// DefaultCacheConfig returns the cache configuration with its documented defaults.
+func DefaultCacheConfig() CacheConfig {
+	return CacheConfig{
+		DefaultTTL:              DefaultCacheTTL,
+		ProfileIDBySlugTTL:      10 * time.Minute, //nolint:mnd
+		ProfileSlugExistsTTL:    1 * time.Minute,
+		StoryIDBySlugTTL:        10 * time.Minute, //nolint:mnd
+		CustomDomainByDomainTTL: 2 * time.Minute,  //nolint:mnd
+		UserBriefInfoTTL:        30 * time.Second, //nolint:mnd
+		MembershipKindTTL:       30 * time.Second, //nolint:mnd
+		ProfileCountsTTL:        1 * time.Minute,
+		PointsLeaderboardTTL:    1 * time.Minute,
+		ProfileListTTL:          30 * time.Second, //nolint:mnd
+	}
+}
+
+// TTLPolicy builds the per-class TTL policy for the repository cache.
+func (c CacheConfig) TTLPolicy() *caching.TTLPolicy {
+	return caching.NewTTLPolicy(c.DefaultTTL, map[string]time.Duration{
+		CacheKeyProfileIDBySlug:      c.ProfileIDBySlugTTL,
+		CacheKeyProfileSlugExists:    c.ProfileSlugExistsTTL,
+		CacheKeyStoryIDBySlug:        c.StoryIDBySlugTTL,
+		CacheKeyCustomDomainByDomain: c.CustomDomainByDomainTTL,
+		CacheKeyUserBriefInfo:        c.UserBriefInfoTTL,
+		CacheKeyMembershipKind:       c.MembershipKindTTL,
+		CacheKeyProfileCounts:        c.ProfileCountsTTL,
+		CacheKeyPointsLeaderboard:    c.PointsLeaderboardTTL,
+		CacheKeyProfileList:          c.ProfileListTTL,
+	})
+}
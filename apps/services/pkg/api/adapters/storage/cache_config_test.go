@@ -0,0 +1,46 @@
+package storage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/adapters/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultCacheConfig_TTLPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := storage.DefaultCacheConfig().TTLPolicy()
+
+	tests := map[string]time.Duration{
+		"user_brief_info:user-1":                30 * time.Second,
+		"membership_kind:profile-1:member-1":    30 * time.Second,
+		"profile_slug_exists:eser":              time.Minute,
+		"custom_domain_by_domain:eser.dev":      2 * time.Minute,
+		"profile_counts:profile-1":              time.Minute,
+		"points_leaderboard:en:individual:20":   time.Minute,
+		"profile_list:en:individual::seed:20:0": 30 * time.Second,
+		"profile_id_by_slug:eser":               10 * time.Minute,
+		"story_id_by_slug:hello-world":          10 * time.Minute,
+		"unclassified:key":                      storage.DefaultCacheTTL,
+	}
+
+	for key, expected := range tests {
+		assert.Equal(t, expected, policy.TTLFor(key), key)
+	}
+}
+
+func TestCacheConfig_TTLPolicy_Overrides(t *testing.T) {
+	t.Parallel()
+
+	config := storage.DefaultCacheConfig()
+	config.UserBriefInfoTTL = 5 * time.Second
+	config.DefaultTTL = time.Hour
+
+	policy := config.TTLPolicy()
+
+	assert.Equal(t, 5*time.Second, policy.TTLFor("user_brief_info:user-1"))
+	assert.Equal(t, time.Hour, policy.TTLFor("unclassified:key"))
+	assert.Equal(t, 10*time.Minute, policy.TTLFor("profile_id_by_slug:eser"))
+}
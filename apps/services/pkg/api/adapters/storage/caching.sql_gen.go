@@ -130,6 +130,27 @@ func (q *Queries) RemoveFromCache(ctx context.Context, arg RemoveFromCacheParams
 	return result.RowsAffected()
 }
 
+const removeFromCacheByPrefix = `-- name: RemoveFromCacheByPrefix :execrows
+DELETE FROM "cache"
+WHERE starts_with(key, $1::TEXT)
+`
+
+type RemoveFromCacheByPrefixParams struct {
+	Prefix string `db:"prefix" json:"prefix"`
+}
+
+// RemoveFromCacheByPrefix
+//
+//	DELETE FROM "cache"
+//	WHERE starts_with(key, $1::TEXT)
+func (q *Queries) RemoveFromCacheByPrefix(ctx context.Context, arg RemoveFromCacheByPrefixParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeFromCacheByPrefix, arg.Prefix)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setInCache = `-- name: SetInCache :execrows
 INSERT INTO "cache" (key, value, updated_at)
 VALUES ($1, $2, NOW())
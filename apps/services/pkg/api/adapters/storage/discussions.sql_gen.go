@@ -420,6 +420,59 @@ func (q *Queries) GetDiscussionVisibility(ctx context.Context, arg GetDiscussion
 	return feature_discussions, err
 }
 
+const getProfileStoryIDBySlugs = `-- name: GetProfileStoryIDBySlugs :one
+SELECT s.id
+FROM "story" s
+INNER JOIN "profile" p ON p.slug = $1
+  AND p.deleted_at IS NULL
+WHERE s.slug = $2
+  AND s.deleted_at IS NULL
+  AND s.visibility IN ('public', 'unlisted')
+  AND (
+    s.author_profile_id = p.id
+    OR EXISTS (
+      SELECT 1
+      FROM "story_publication" sp
+      WHERE sp.story_id = s.id
+        AND sp.profile_id = p.id
+        AND sp.deleted_at IS NULL
+    )
+  )
+LIMIT 1
+`
+
+type GetProfileStoryIDBySlugsParams struct {
+	ProfileSlug string `db:"profile_slug" json:"profile_slug"`
+	StorySlug   string `db:"story_slug" json:"story_slug"`
+}
+
+// Returns a public or unlisted story that is authored by or published to the profile.
+//
+//	SELECT s.id
+//	FROM "story" s
+//	INNER JOIN "profile" p ON p.slug = $1
+//	  AND p.deleted_at IS NULL
+//	WHERE s.slug = $2
+//	  AND s.deleted_at IS NULL
+//	  AND s.visibility IN ('public', 'unlisted')
+//	  AND (
+//	    s.author_profile_id = p.id
+//	    OR EXISTS (
+//	      SELECT 1
+//	      FROM "story_publication" sp
+//	      WHERE sp.story_id = s.id
+//	        AND sp.profile_id = p.id
+//	        AND sp.deleted_at IS NULL
+//	    )
+//	  )
+//	LIMIT 1
+func (q *Queries) GetProfileStoryIDBySlugs(ctx context.Context, arg GetProfileStoryIDBySlugsParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getProfileStoryIDBySlugs, arg.ProfileSlug, arg.StorySlug)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getStoryAuthorProfileID = `-- name: GetStoryAuthorProfileID :one
 SELECT author_profile_id
 FROM "story"
@@ -444,6 +497,55 @@ func (q *Queries) GetStoryAuthorProfileID(ctx context.Context, arg GetStoryAutho
 	return author_profile_id, err
 }
 
+const getStoryDiscussionSettings = `-- name: GetStoryDiscussionSettings :one
+SELECT
+  s.feat_discussions,
+  s.author_profile_id,
+  p.slug AS author_profile_slug,
+  p.feature_discussions AS author_feature_discussions
+FROM "story" s
+LEFT JOIN "profile" p ON p.id = s.author_profile_id
+  AND p.deleted_at IS NULL
+WHERE s.id = $1
+  AND s.deleted_at IS NULL
+`
+
+type GetStoryDiscussionSettingsParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+type GetStoryDiscussionSettingsRow struct {
+	FeatDiscussions          bool           `db:"feat_discussions" json:"feat_discussions"`
+	AuthorProfileID          sql.NullString `db:"author_profile_id" json:"author_profile_id"`
+	AuthorProfileSlug        sql.NullString `db:"author_profile_slug" json:"author_profile_slug"`
+	AuthorFeatureDiscussions sql.NullString `db:"author_feature_discussions" json:"author_feature_discussions"`
+}
+
+// Returns the per-story discussions flag together with the author profile's
+// discussions module visibility.
+//
+//	SELECT
+//	  s.feat_discussions,
+//	  s.author_profile_id,
+//	  p.slug AS author_profile_slug,
+//	  p.feature_discussions AS author_feature_discussions
+//	FROM "story" s
+//	LEFT JOIN "profile" p ON p.id = s.author_profile_id
+//	  AND p.deleted_at IS NULL
+//	WHERE s.id = $1
+//	  AND s.deleted_at IS NULL
+func (q *Queries) GetStoryDiscussionSettings(ctx context.Context, arg GetStoryDiscussionSettingsParams) (*GetStoryDiscussionSettingsRow, error) {
+	row := q.db.QueryRowContext(ctx, getStoryDiscussionSettings, arg.ID)
+	var i GetStoryDiscussionSettingsRow
+	err := row.Scan(
+		&i.FeatDiscussions,
+		&i.AuthorProfileID,
+		&i.AuthorProfileSlug,
+		&i.AuthorFeatureDiscussions,
+	)
+	return &i, err
+}
+
 const incrementDiscussionCommentReplyCount = `-- name: IncrementDiscussionCommentReplyCount :exec
 UPDATE "discussion_comment"
 SET
@@ -186,3 +186,80 @@ func (q *Queries) ListEventAuditByEntity(ctx context.Context, arg ListEventAudit
 	}
 	return items, nil
 }
+
+const listEventAuditInRange = `-- name: ListEventAuditInRange :many
+SELECT id, event_type, entity_type, entity_id, actor_id, actor_kind, session_id, payload, created_at
+FROM "event_audit"
+WHERE created_at >= $1
+  AND created_at < $2
+  AND ($3::TEXT IS NULL OR event_type = $3::TEXT)
+  AND (
+    $4::TIMESTAMPTZ IS NULL
+    OR (created_at, id) > ($4::TIMESTAMPTZ, $5::TEXT)
+  )
+ORDER BY created_at, id
+LIMIT $6
+`
+
+type ListEventAuditInRangeParams struct {
+	FromTime        time.Time      `db:"from_time" json:"from_time"`
+	ToTime          time.Time      `db:"to_time" json:"to_time"`
+	FilterEventType sql.NullString `db:"filter_event_type" json:"filter_event_type"`
+	AfterCreatedAt  sql.NullTime   `db:"after_created_at" json:"after_created_at"`
+	AfterID         sql.NullString `db:"after_id" json:"after_id"`
+	LimitCount      int32          `db:"limit_count" json:"limit_count"`
+}
+
+// Pages through the audit events created in [from_time, to_time) in
+// (created_at, id) order. Pass the last row of the previous page as the cursor.
+//
+//	SELECT id, event_type, entity_type, entity_id, actor_id, actor_kind, session_id, payload, created_at
+//	FROM "event_audit"
+//	WHERE created_at >= $1
+//	  AND created_at < $2
+//	  AND ($3::TEXT IS NULL OR event_type = $3::TEXT)
+//	  AND (
+//	    $4::TIMESTAMPTZ IS NULL
+//	    OR (created_at, id) > ($4::TIMESTAMPTZ, $5::TEXT)
+//	  )
+//	ORDER BY created_at, id
+//	LIMIT $6
+func (q *Queries) ListEventAuditInRange(ctx context.Context, arg ListEventAuditInRangeParams) ([]*EventAudit, error) {
+	rows, err := q.db.QueryContext(ctx, listEventAuditInRange,
+		arg.FromTime,
+		arg.ToTime,
+		arg.FilterEventType,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*EventAudit{}
+	for rows.Next() {
+		var i EventAudit
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.EntityType,
+			&i.EntityID,
+			&i.ActorID,
+			&i.ActorKind,
+			&i.SessionID,
+			&i.Payload,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
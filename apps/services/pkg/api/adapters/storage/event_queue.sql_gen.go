@@ -239,6 +239,92 @@ func (q *Queries) FailQueueItem(ctx context.Context, arg FailQueueItemParams) (i
 	return result.RowsAffected()
 }
 
+const getActiveQueueItemByPayload = `-- name: GetActiveQueueItemByPayload :one
+SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
+FROM "event_queue"
+WHERE type = $1
+  AND status IN ('pending', 'processing')
+  AND payload @> $2::JSONB
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type GetActiveQueueItemByPayloadParams struct {
+	Type    string                `db:"type" json:"type"`
+	Payload pqtype.NullRawMessage `db:"payload" json:"payload"`
+}
+
+// Finds a pending or processing item whose payload contains the given one,
+// so callers can avoid queueing the same work twice.
+//
+//	SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
+//	FROM "event_queue"
+//	WHERE type = $1
+//	  AND status IN ('pending', 'processing')
+//	  AND payload @> $2::JSONB
+//	ORDER BY created_at DESC
+//	LIMIT 1
+func (q *Queries) GetActiveQueueItemByPayload(ctx context.Context, arg GetActiveQueueItemByPayloadParams) (*EventQueue, error) {
+	row := q.db.QueryRowContext(ctx, getActiveQueueItemByPayload, arg.Type, arg.Payload)
+	var i EventQueue
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Payload,
+		&i.Status,
+		&i.RetryCount,
+		&i.MaxRetries,
+		&i.VisibleAt,
+		&i.VisibilityTimeoutSecs,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ErrorMessage,
+		&i.WorkerID,
+	)
+	return &i, err
+}
+
+const getQueueItemByID = `-- name: GetQueueItemByID :one
+SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
+FROM "event_queue"
+WHERE id = $1
+`
+
+type GetQueueItemByIDParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetQueueItemByID
+//
+//	SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
+//	FROM "event_queue"
+//	WHERE id = $1
+func (q *Queries) GetQueueItemByID(ctx context.Context, arg GetQueueItemByIDParams) (*EventQueue, error) {
+	row := q.db.QueryRowContext(ctx, getQueueItemByID, arg.ID)
+	var i EventQueue
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Payload,
+		&i.Status,
+		&i.RetryCount,
+		&i.MaxRetries,
+		&i.VisibleAt,
+		&i.VisibilityTimeoutSecs,
+		&i.StartedAt,
+		&i.CompletedAt,
+		&i.FailedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ErrorMessage,
+		&i.WorkerID,
+	)
+	return &i, err
+}
+
 const listQueueItemsByType = `-- name: ListQueueItemsByType :many
 SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
 FROM "event_queue"
@@ -331,16 +331,18 @@ FROM "profile_link" pl
   INNER JOIN "profile" p ON p.id = pl.profile_id
     AND p.deleted_at IS NULL
 WHERE pl.kind = $1
+  AND ($2::TEXT IS NULL OR pl.profile_id = $2::TEXT)
   AND pl.is_managed = TRUE
   AND pl.auth_access_token IS NOT NULL
   AND pl.deleted_at IS NULL
 ORDER BY pl.updated_at ASC NULLS FIRST
-LIMIT $2
+LIMIT $3
 `
 
 type ListManagedLinksForKindParams struct {
-	Kind       string `db:"kind" json:"kind"`
-	LimitCount int32  `db:"limit_count" json:"limit_count"`
+	Kind            string         `db:"kind" json:"kind"`
+	FilterProfileID sql.NullString `db:"filter_profile_id" json:"filter_profile_id"`
+	LimitCount      int32          `db:"limit_count" json:"limit_count"`
 }
 
 type ListManagedLinksForKindRow struct {
@@ -369,13 +371,14 @@ type ListManagedLinksForKindRow struct {
 //	  INNER JOIN "profile" p ON p.id = pl.profile_id
 //	    AND p.deleted_at IS NULL
 //	WHERE pl.kind = $1
+//	  AND ($2::TEXT IS NULL OR pl.profile_id = $2::TEXT)
 //	  AND pl.is_managed = TRUE
 //	  AND pl.auth_access_token IS NOT NULL
 //	  AND pl.deleted_at IS NULL
 //	ORDER BY pl.updated_at ASC NULLS FIRST
-//	LIMIT $2
+//	LIMIT $3
 func (q *Queries) ListManagedLinksForKind(ctx context.Context, arg ListManagedLinksForKindParams) ([]*ListManagedLinksForKindRow, error) {
-	rows, err := q.db.QueryContext(ctx, listManagedLinksForKind, arg.Kind, arg.LimitCount)
+	rows, err := q.db.QueryContext(ctx, listManagedLinksForKind, arg.Kind, arg.FilterProfileID, arg.LimitCount)
 	if err != nil {
 		return nil, err
 	}
@@ -418,15 +421,17 @@ FROM "profile_link" pl
   INNER JOIN "profile" p ON p.id = pl.profile_id
     AND p.deleted_at IS NULL
 WHERE pl.kind = $1
+  AND ($2::TEXT IS NULL OR pl.profile_id = $2::TEXT)
   AND pl.is_managed = TRUE
   AND pl.deleted_at IS NULL
 ORDER BY pl.updated_at ASC NULLS FIRST
-LIMIT $2
+LIMIT $3
 `
 
 type ListManagedLinksForKindPublicParams struct {
-	Kind       string `db:"kind" json:"kind"`
-	LimitCount int32  `db:"limit_count" json:"limit_count"`
+	Kind            string         `db:"kind" json:"kind"`
+	FilterProfileID sql.NullString `db:"filter_profile_id" json:"filter_profile_id"`
+	LimitCount      int32          `db:"limit_count" json:"limit_count"`
 }
 
 type ListManagedLinksForKindPublicRow struct {
@@ -451,12 +456,13 @@ type ListManagedLinksForKindPublicRow struct {
 //	  INNER JOIN "profile" p ON p.id = pl.profile_id
 //	    AND p.deleted_at IS NULL
 //	WHERE pl.kind = $1
+//	  AND ($2::TEXT IS NULL OR pl.profile_id = $2::TEXT)
 //	  AND pl.is_managed = TRUE
 //	  AND pl.deleted_at IS NULL
 //	ORDER BY pl.updated_at ASC NULLS FIRST
-//	LIMIT $2
+//	LIMIT $3
 func (q *Queries) ListManagedLinksForKindPublic(ctx context.Context, arg ListManagedLinksForKindPublicParams) ([]*ListManagedLinksForKindPublicRow, error) {
-	rows, err := q.db.QueryContext(ctx, listManagedLinksForKindPublic, arg.Kind, arg.LimitCount)
+	rows, err := q.db.QueryContext(ctx, listManagedLinksForKindPublic, arg.Kind, arg.FilterProfileID, arg.LimitCount)
 	if err != nil {
 		return nil, err
 	}
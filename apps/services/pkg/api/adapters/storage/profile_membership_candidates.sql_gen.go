@@ -13,7 +13,8 @@ import (
 
 const createProfileMembershipCandidate = `-- name: CreateProfileMembershipCandidate :one
 INSERT INTO "profile_membership_candidate" (
-  id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status, created_at
+  id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status,
+  voting_deadline, created_at
 ) VALUES (
   $1,
   $2,
@@ -22,8 +23,9 @@ INSERT INTO "profile_membership_candidate" (
   $5,
   $6,
   'voting',
+  $7,
   NOW()
-) RETURNING id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message
+) RETURNING id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline
 `
 
 type CreateProfileMembershipCandidateParams struct {
@@ -33,12 +35,14 @@ type CreateProfileMembershipCandidateParams struct {
 	ReferrerMembershipID sql.NullString `db:"referrer_membership_id" json:"referrer_membership_id"`
 	Source               string         `db:"source" json:"source"`
 	ApplicantMessage     sql.NullString `db:"applicant_message" json:"applicant_message"`
+	VotingDeadline       sql.NullTime   `db:"voting_deadline" json:"voting_deadline"`
 }
 
 // CreateProfileMembershipCandidate
 //
 //	INSERT INTO "profile_membership_candidate" (
-//	  id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status, created_at
+//	  id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status,
+//	  voting_deadline, created_at
 //	) VALUES (
 //	  $1,
 //	  $2,
@@ -47,8 +51,9 @@ type CreateProfileMembershipCandidateParams struct {
 //	  $5,
 //	  $6,
 //	  'voting',
+//	  $7,
 //	  NOW()
-//	) RETURNING id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message
+//	) RETURNING id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline
 func (q *Queries) CreateProfileMembershipCandidate(ctx context.Context, arg CreateProfileMembershipCandidateParams) (*ProfileMembershipCandidate, error) {
 	row := q.db.QueryRowContext(ctx, createProfileMembershipCandidate,
 		arg.ID,
@@ -57,6 +62,7 @@ func (q *Queries) CreateProfileMembershipCandidate(ctx context.Context, arg Crea
 		arg.ReferrerMembershipID,
 		arg.Source,
 		arg.ApplicantMessage,
+		arg.VotingDeadline,
 	)
 	var i ProfileMembershipCandidate
 	err := row.Scan(
@@ -71,6 +77,7 @@ func (q *Queries) CreateProfileMembershipCandidate(ctx context.Context, arg Crea
 		&i.DeletedAt,
 		&i.Source,
 		&i.ApplicantMessage,
+		&i.VotingDeadline,
 	)
 	return &i, err
 }
@@ -123,7 +130,7 @@ func (q *Queries) GetCandidateVoteBreakdown(ctx context.Context, arg GetCandidat
 }
 
 const getProfileMembershipCandidateByID = `-- name: GetProfileMembershipCandidateByID :one
-SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message FROM "profile_membership_candidate"
+SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline FROM "profile_membership_candidate"
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -133,7 +140,7 @@ type GetProfileMembershipCandidateByIDParams struct {
 
 // GetProfileMembershipCandidateByID
 //
-//	SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message FROM "profile_membership_candidate"
+//	SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline FROM "profile_membership_candidate"
 //	WHERE id = $1 AND deleted_at IS NULL
 func (q *Queries) GetProfileMembershipCandidateByID(ctx context.Context, arg GetProfileMembershipCandidateByIDParams) (*ProfileMembershipCandidate, error) {
 	row := q.db.QueryRowContext(ctx, getProfileMembershipCandidateByID, arg.ID)
@@ -150,12 +157,13 @@ func (q *Queries) GetProfileMembershipCandidateByID(ctx context.Context, arg Get
 		&i.DeletedAt,
 		&i.Source,
 		&i.ApplicantMessage,
+		&i.VotingDeadline,
 	)
 	return &i, err
 }
 
 const getProfileMembershipCandidateByProfileAndReferred = `-- name: GetProfileMembershipCandidateByProfileAndReferred :one
-SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message FROM "profile_membership_candidate"
+SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline FROM "profile_membership_candidate"
 WHERE profile_id = $1
   AND referred_profile_id = $2
   AND deleted_at IS NULL
@@ -168,7 +176,7 @@ type GetProfileMembershipCandidateByProfileAndReferredParams struct {
 
 // GetProfileMembershipCandidateByProfileAndReferred
 //
-//	SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message FROM "profile_membership_candidate"
+//	SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline FROM "profile_membership_candidate"
 //	WHERE profile_id = $1
 //	  AND referred_profile_id = $2
 //	  AND deleted_at IS NULL
@@ -187,6 +195,7 @@ func (q *Queries) GetProfileMembershipCandidateByProfileAndReferred(ctx context.
 		&i.DeletedAt,
 		&i.Source,
 		&i.ApplicantMessage,
+		&i.VotingDeadline,
 	)
 	return &i, err
 }
@@ -232,7 +241,7 @@ func (q *Queries) InsertCandidateTeam(ctx context.Context, arg InsertCandidateTe
 }
 
 const listCandidateTeams = `-- name: ListCandidateTeams :many
-SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 JOIN "profile_membership_candidate_team" pmrt
   ON pmrt.profile_team_id = pt.id AND pmrt.deleted_at IS NULL
 WHERE pmrt.candidate_id = $1
@@ -246,7 +255,7 @@ type ListCandidateTeamsParams struct {
 
 // ListCandidateTeams
 //
-//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 //	JOIN "profile_membership_candidate_team" pmrt
 //	  ON pmrt.profile_team_id = pt.id AND pmrt.deleted_at IS NULL
 //	WHERE pmrt.candidate_id = $1
@@ -268,6 +277,7 @@ func (q *Queries) ListCandidateTeams(ctx context.Context, arg ListCandidateTeams
 			&i.Description,
 			&i.CreatedAt,
 			&i.DeletedAt,
+			&i.LeadMembershipID,
 		); err != nil {
 			return nil, err
 		}
@@ -385,9 +395,78 @@ func (q *Queries) ListCandidateVotes(ctx context.Context, arg ListCandidateVotes
 	return items, nil
 }
 
+const listExpiredVotingCandidates = `-- name: ListExpiredVotingCandidates :many
+SELECT pmc.id, pmc.profile_id, p.slug AS profile_slug, pmc.referred_profile_id, pmc.source,
+  pmc.voting_deadline
+FROM "profile_membership_candidate" pmc
+  INNER JOIN "profile" p ON p.id = pmc.profile_id AND p.deleted_at IS NULL
+WHERE pmc.status = 'voting'
+  AND pmc.voting_deadline IS NOT NULL
+  AND pmc.voting_deadline <= $1::TIMESTAMP WITH TIME ZONE
+  AND pmc.deleted_at IS NULL
+ORDER BY pmc.voting_deadline, pmc.id
+LIMIT $2
+`
+
+type ListExpiredVotingCandidatesParams struct {
+	Now        time.Time `db:"now" json:"now"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+type ListExpiredVotingCandidatesRow struct {
+	ID                string       `db:"id" json:"id"`
+	ProfileID         string       `db:"profile_id" json:"profile_id"`
+	ProfileSlug       string       `db:"profile_slug" json:"profile_slug"`
+	ReferredProfileID string       `db:"referred_profile_id" json:"referred_profile_id"`
+	Source            string       `db:"source" json:"source"`
+	VotingDeadline    sql.NullTime `db:"voting_deadline" json:"voting_deadline"`
+}
+
+// ListExpiredVotingCandidates
+//
+//	SELECT pmc.id, pmc.profile_id, p.slug AS profile_slug, pmc.referred_profile_id, pmc.source,
+//	  pmc.voting_deadline
+//	FROM "profile_membership_candidate" pmc
+//	  INNER JOIN "profile" p ON p.id = pmc.profile_id AND p.deleted_at IS NULL
+//	WHERE pmc.status = 'voting'
+//	  AND pmc.voting_deadline IS NOT NULL
+//	  AND pmc.voting_deadline <= $1::TIMESTAMP WITH TIME ZONE
+//	  AND pmc.deleted_at IS NULL
+//	ORDER BY pmc.voting_deadline, pmc.id
+//	LIMIT $2
+func (q *Queries) ListExpiredVotingCandidates(ctx context.Context, arg ListExpiredVotingCandidatesParams) ([]*ListExpiredVotingCandidatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listExpiredVotingCandidates, arg.Now, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListExpiredVotingCandidatesRow{}
+	for rows.Next() {
+		var i ListExpiredVotingCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.ProfileSlug,
+			&i.ReferredProfileID,
+			&i.Source,
+			&i.VotingDeadline,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfileMembershipCandidatesByProfileID = `-- name: ListProfileMembershipCandidatesByProfileID :many
 SELECT
-  pmr.id, pmr.profile_id, pmr.referred_profile_id, pmr.referrer_membership_id, pmr.status, pmr.vote_count, pmr.created_at, pmr.updated_at, pmr.deleted_at, pmr.source, pmr.applicant_message,
+  pmr.id, pmr.profile_id, pmr.referred_profile_id, pmr.referrer_membership_id, pmr.status, pmr.vote_count, pmr.created_at, pmr.updated_at, pmr.deleted_at, pmr.source, pmr.applicant_message, pmr.voting_deadline,
   ref_p.slug AS referrer_profile_slug,
   ref_p.kind AS referrer_profile_kind,
   ref_p.profile_picture_uri AS referrer_profile_picture_uri,
@@ -469,6 +548,7 @@ type ListProfileMembershipCandidatesByProfileIDRow struct {
 	DeletedAt                 sql.NullTime   `db:"deleted_at" json:"deleted_at"`
 	Source                    string         `db:"source" json:"source"`
 	ApplicantMessage          sql.NullString `db:"applicant_message" json:"applicant_message"`
+	VotingDeadline            sql.NullTime   `db:"voting_deadline" json:"voting_deadline"`
 	ReferrerProfileSlug       sql.NullString `db:"referrer_profile_slug" json:"referrer_profile_slug"`
 	ReferrerProfileKind       sql.NullString `db:"referrer_profile_kind" json:"referrer_profile_kind"`
 	ReferrerProfilePictureURI sql.NullString `db:"referrer_profile_picture_uri" json:"referrer_profile_picture_uri"`
@@ -486,7 +566,7 @@ type ListProfileMembershipCandidatesByProfileIDRow struct {
 // ListProfileMembershipCandidatesByProfileID
 //
 //	SELECT
-//	  pmr.id, pmr.profile_id, pmr.referred_profile_id, pmr.referrer_membership_id, pmr.status, pmr.vote_count, pmr.created_at, pmr.updated_at, pmr.deleted_at, pmr.source, pmr.applicant_message,
+//	  pmr.id, pmr.profile_id, pmr.referred_profile_id, pmr.referrer_membership_id, pmr.status, pmr.vote_count, pmr.created_at, pmr.updated_at, pmr.deleted_at, pmr.source, pmr.applicant_message, pmr.voting_deadline,
 //	  ref_p.slug AS referrer_profile_slug,
 //	  ref_p.kind AS referrer_profile_kind,
 //	  ref_p.profile_picture_uri AS referrer_profile_picture_uri,
@@ -569,6 +649,7 @@ func (q *Queries) ListProfileMembershipCandidatesByProfileID(ctx context.Context
 			&i.DeletedAt,
 			&i.Source,
 			&i.ApplicantMessage,
+			&i.VotingDeadline,
 			&i.ReferrerProfileSlug,
 			&i.ReferrerProfileKind,
 			&i.ReferrerProfilePictureURI,
@@ -595,6 +676,36 @@ func (q *Queries) ListProfileMembershipCandidatesByProfileID(ctx context.Context
 	return items, nil
 }
 
+const resolveVotingCandidate = `-- name: ResolveVotingCandidate :execrows
+UPDATE "profile_membership_candidate"
+SET status = $1,
+    updated_at = NOW()
+WHERE id = $2
+  AND status = 'voting'
+  AND deleted_at IS NULL
+`
+
+type ResolveVotingCandidateParams struct {
+	Status string `db:"status" json:"status"`
+	ID     string `db:"id" json:"id"`
+}
+
+// ResolveVotingCandidate
+//
+//	UPDATE "profile_membership_candidate"
+//	SET status = $1,
+//	    updated_at = NOW()
+//	WHERE id = $2
+//	  AND status = 'voting'
+//	  AND deleted_at IS NULL
+func (q *Queries) ResolveVotingCandidate(ctx context.Context, arg ResolveVotingCandidateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, resolveVotingCandidate, arg.Status, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const softDeleteCandidate = `-- name: SoftDeleteCandidate :execrows
 UPDATE "profile_membership_candidate"
 SET deleted_at = NOW()
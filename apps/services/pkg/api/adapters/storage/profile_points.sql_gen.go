@@ -450,7 +450,7 @@ func (q *Queries) GetPendingAwardsStatsByEventType(ctx context.Context) ([]*GetP
 }
 
 const getProfilePointTransactionByID = `-- name: GetProfilePointTransactionByID :one
-SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 FROM "profile_point_transaction"
 WHERE id = $1
 `
@@ -461,7 +461,7 @@ type GetProfilePointTransactionByIDParams struct {
 
 // GetProfilePointTransactionByID
 //
-//	SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+//	SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 //	FROM "profile_point_transaction"
 //	WHERE id = $1
 func (q *Queries) GetProfilePointTransactionByID(ctx context.Context, arg GetProfilePointTransactionByIDParams) (*ProfilePointTransaction, error) {
@@ -477,6 +477,7 @@ func (q *Queries) GetProfilePointTransactionByID(ctx context.Context, arg GetPro
 		&i.Amount,
 		&i.BalanceAfter,
 		&i.CreatedAt,
+		&i.AwardKey,
 	)
 	return &i, err
 }
@@ -616,7 +617,7 @@ func (q *Queries) ListPendingAwardsByStatus(ctx context.Context, arg ListPending
 }
 
 const listProfilePointTransactionsByProfileID = `-- name: ListProfilePointTransactionsByProfileID :many
-SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 FROM "profile_point_transaction"
 WHERE target_profile_id = $1
 ORDER BY created_at DESC
@@ -630,7 +631,7 @@ type ListProfilePointTransactionsByProfileIDParams struct {
 
 // ListProfilePointTransactionsByProfileID
 //
-//	SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+//	SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 //	FROM "profile_point_transaction"
 //	WHERE target_profile_id = $1
 //	ORDER BY created_at DESC
@@ -654,6 +655,7 @@ func (q *Queries) ListProfilePointTransactionsByProfileID(ctx context.Context, a
 			&i.Amount,
 			&i.BalanceAfter,
 			&i.CreatedAt,
+			&i.AwardKey,
 		); err != nil {
 			return nil, err
 		}
@@ -668,6 +670,93 @@ func (q *Queries) ListProfilePointTransactionsByProfileID(ctx context.Context, a
 	return items, nil
 }
 
+const recordProfilePointAward = `-- name: RecordProfilePointAward :one
+INSERT INTO "profile_point_transaction" (
+  id,
+  target_profile_id,
+  transaction_type,
+  triggering_event,
+  award_key,
+  description,
+  amount,
+  balance_after,
+  created_at
+) VALUES (
+  $1,
+  $2,
+  'GAIN',
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  NOW()
+)
+ON CONFLICT (award_key) WHERE award_key IS NOT NULL DO NOTHING
+RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
+`
+
+type RecordProfilePointAwardParams struct {
+	ID              string         `db:"id" json:"id"`
+	TargetProfileID string         `db:"target_profile_id" json:"target_profile_id"`
+	TriggeringEvent sql.NullString `db:"triggering_event" json:"triggering_event"`
+	AwardKey        sql.NullString `db:"award_key" json:"award_key"`
+	Description     string         `db:"description" json:"description"`
+	Amount          int32          `db:"amount" json:"amount"`
+	BalanceAfter    int32          `db:"balance_after" json:"balance_after"`
+}
+
+// Records an action award. Returns no row when the award key was already used.
+//
+//	INSERT INTO "profile_point_transaction" (
+//	  id,
+//	  target_profile_id,
+//	  transaction_type,
+//	  triggering_event,
+//	  award_key,
+//	  description,
+//	  amount,
+//	  balance_after,
+//	  created_at
+//	) VALUES (
+//	  $1,
+//	  $2,
+//	  'GAIN',
+//	  $3,
+//	  $4,
+//	  $5,
+//	  $6,
+//	  $7,
+//	  NOW()
+//	)
+//	ON CONFLICT (award_key) WHERE award_key IS NOT NULL DO NOTHING
+//	RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
+func (q *Queries) RecordProfilePointAward(ctx context.Context, arg RecordProfilePointAwardParams) (*ProfilePointTransaction, error) {
+	row := q.db.QueryRowContext(ctx, recordProfilePointAward,
+		arg.ID,
+		arg.TargetProfileID,
+		arg.TriggeringEvent,
+		arg.AwardKey,
+		arg.Description,
+		arg.Amount,
+		arg.BalanceAfter,
+	)
+	var i ProfilePointTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.TargetProfileID,
+		&i.OriginProfileID,
+		&i.TransactionType,
+		&i.TriggeringEvent,
+		&i.Description,
+		&i.Amount,
+		&i.BalanceAfter,
+		&i.CreatedAt,
+		&i.AwardKey,
+	)
+	return &i, err
+}
+
 const recordProfilePointTransaction = `-- name: RecordProfilePointTransaction :one
 INSERT INTO "profile_point_transaction" (
   id,
@@ -689,7 +778,7 @@ INSERT INTO "profile_point_transaction" (
   $7,
   $8,
   NOW()
-) RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+) RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 `
 
 type RecordProfilePointTransactionParams struct {
@@ -725,7 +814,7 @@ type RecordProfilePointTransactionParams struct {
 //	  $7,
 //	  $8,
 //	  NOW()
-//	) RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+//	) RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 func (q *Queries) RecordProfilePointTransaction(ctx context.Context, arg RecordProfilePointTransactionParams) (*ProfilePointTransaction, error) {
 	row := q.db.QueryRowContext(ctx, recordProfilePointTransaction,
 		arg.ID,
@@ -748,6 +837,7 @@ func (q *Queries) RecordProfilePointTransaction(ctx context.Context, arg RecordP
 		&i.Amount,
 		&i.BalanceAfter,
 		&i.CreatedAt,
+		&i.AwardKey,
 	)
 	return &i, err
 }
@@ -11,6 +11,33 @@ import (
 	"time"
 )
 
+const countProfileQuestionsByAuthorSince = `-- name: CountProfileQuestionsByAuthorSince :one
+SELECT COUNT(*) AS count
+FROM "profile_question"
+WHERE author_user_id = $1
+  AND created_at >= $2
+  AND deleted_at IS NULL
+`
+
+type CountProfileQuestionsByAuthorSinceParams struct {
+	AuthorUserID string    `db:"author_user_id" json:"author_user_id"`
+	Since        time.Time `db:"since" json:"since"`
+}
+
+// CountProfileQuestionsByAuthorSince
+//
+//	SELECT COUNT(*) AS count
+//	FROM "profile_question"
+//	WHERE author_user_id = $1
+//	  AND created_at >= $2
+//	  AND deleted_at IS NULL
+func (q *Queries) CountProfileQuestionsByAuthorSince(ctx context.Context, arg CountProfileQuestionsByAuthorSinceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProfileQuestionsByAuthorSince, arg.AuthorUserID, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countProfileQuestionsByProfileID = `-- name: CountProfileQuestionsByProfileID :one
 SELECT COUNT(*) AS count
 FROM "profile_question"
@@ -143,7 +170,7 @@ func (q *Queries) GetProfileQAVisibility(ctx context.Context, arg GetProfileQAVi
 }
 
 const getProfileQuestion = `-- name: GetProfileQuestion :one
-SELECT id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at
+SELECT id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at, resolved_at
 FROM "profile_question"
 WHERE id = $1
   AND deleted_at IS NULL
@@ -155,7 +182,7 @@ type GetProfileQuestionParams struct {
 
 // GetProfileQuestion
 //
-//	SELECT id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at
+//	SELECT id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at, resolved_at
 //	FROM "profile_question"
 //	WHERE id = $1
 //	  AND deleted_at IS NULL
@@ -178,10 +205,37 @@ func (q *Queries) GetProfileQuestion(ctx context.Context, arg GetProfileQuestion
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ResolvedAt,
 	)
 	return &i, err
 }
 
+const getProfileQuestionAuthorProfileID = `-- name: GetProfileQuestionAuthorProfileID :one
+SELECT u.individual_profile_id
+FROM "profile_question" pq
+  INNER JOIN "user" u ON u.id = pq.author_user_id
+WHERE pq.id = $1
+  AND pq.deleted_at IS NULL
+`
+
+type GetProfileQuestionAuthorProfileIDParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetProfileQuestionAuthorProfileID
+//
+//	SELECT u.individual_profile_id
+//	FROM "profile_question" pq
+//	  INNER JOIN "user" u ON u.id = pq.author_user_id
+//	WHERE pq.id = $1
+//	  AND pq.deleted_at IS NULL
+func (q *Queries) GetProfileQuestionAuthorProfileID(ctx context.Context, arg GetProfileQuestionAuthorProfileIDParams) (sql.NullString, error) {
+	row := q.db.QueryRowContext(ctx, getProfileQuestionAuthorProfileID, arg.ID)
+	var individual_profile_id sql.NullString
+	err := row.Scan(&individual_profile_id)
+	return individual_profile_id, err
+}
+
 const getProfileQuestionVote = `-- name: GetProfileQuestionVote :one
 SELECT id, question_id, user_id, score, created_at
 FROM "profile_question_vote"
@@ -254,7 +308,7 @@ INSERT INTO "profile_question" (
   $4,
   $5,
   NOW()
-) RETURNING id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at
+) RETURNING id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at, resolved_at
 `
 
 type InsertProfileQuestionParams struct {
@@ -281,7 +335,7 @@ type InsertProfileQuestionParams struct {
 //	  $4,
 //	  $5,
 //	  NOW()
-//	) RETURNING id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at
+//	) RETURNING id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at, resolved_at
 func (q *Queries) InsertProfileQuestion(ctx context.Context, arg InsertProfileQuestionParams) (*ProfileQuestion, error) {
 	row := q.db.QueryRowContext(ctx, insertProfileQuestion,
 		arg.ID,
@@ -307,6 +361,7 @@ func (q *Queries) InsertProfileQuestion(ctx context.Context, arg InsertProfileQu
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ResolvedAt,
 	)
 	return &i, err
 }
@@ -369,7 +424,7 @@ func (q *Queries) InsertProfileQuestionVote(ctx context.Context, arg InsertProfi
 
 const listProfileQuestionsByProfileID = `-- name: ListProfileQuestionsByProfileID :many
 SELECT
-  pq.id, pq.profile_id, pq.author_user_id, pq.content, pq.answer_content, pq.answer_uri, pq.answer_kind, pq.answered_at, pq.answered_by, pq.is_anonymous, pq.is_hidden, pq.vote_count, pq.created_at, pq.updated_at, pq.deleted_at,
+  pq.id, pq.profile_id, pq.author_user_id, pq.content, pq.answer_content, pq.answer_uri, pq.answer_kind, pq.answered_at, pq.answered_by, pq.is_anonymous, pq.is_hidden, pq.vote_count, pq.created_at, pq.updated_at, pq.deleted_at, pq.resolved_at,
   u.individual_profile_id AS author_profile_id,
   ap.slug AS author_profile_slug,
   apt.title AS author_profile_title,
@@ -439,6 +494,7 @@ type ListProfileQuestionsByProfileIDRow struct {
 	CreatedAt              time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt              sql.NullTime   `db:"updated_at" json:"updated_at"`
 	DeletedAt              sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	ResolvedAt             sql.NullTime   `db:"resolved_at" json:"resolved_at"`
 	AuthorProfileID        sql.NullString `db:"author_profile_id" json:"author_profile_id"`
 	AuthorProfileSlug      sql.NullString `db:"author_profile_slug" json:"author_profile_slug"`
 	AuthorProfileTitle     sql.NullString `db:"author_profile_title" json:"author_profile_title"`
@@ -450,7 +506,7 @@ type ListProfileQuestionsByProfileIDRow struct {
 // ListProfileQuestionsByProfileID
 //
 //	SELECT
-//	  pq.id, pq.profile_id, pq.author_user_id, pq.content, pq.answer_content, pq.answer_uri, pq.answer_kind, pq.answered_at, pq.answered_by, pq.is_anonymous, pq.is_hidden, pq.vote_count, pq.created_at, pq.updated_at, pq.deleted_at,
+//	  pq.id, pq.profile_id, pq.author_user_id, pq.content, pq.answer_content, pq.answer_uri, pq.answer_kind, pq.answered_at, pq.answered_by, pq.is_anonymous, pq.is_hidden, pq.vote_count, pq.created_at, pq.updated_at, pq.deleted_at, pq.resolved_at,
 //	  u.individual_profile_id AS author_profile_id,
 //	  ap.slug AS author_profile_slug,
 //	  apt.title AS author_profile_title,
@@ -525,6 +581,7 @@ func (q *Queries) ListProfileQuestionsByProfileID(ctx context.Context, arg ListP
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ResolvedAt,
 			&i.AuthorProfileID,
 			&i.AuthorProfileSlug,
 			&i.AuthorProfileTitle,
@@ -615,3 +672,36 @@ func (q *Queries) UpdateProfileQuestionHidden(ctx context.Context, arg UpdatePro
 	_, err := q.db.ExecContext(ctx, updateProfileQuestionHidden, arg.IsHidden, arg.ID)
 	return err
 }
+
+const updateProfileQuestionResolved = `-- name: UpdateProfileQuestionResolved :exec
+UPDATE "profile_question"
+SET
+  resolved_at = CASE
+    WHEN $1::BOOLEAN THEN COALESCE(resolved_at, NOW())
+    ELSE NULL
+  END,
+  updated_at = NOW()
+WHERE id = $2
+  AND deleted_at IS NULL
+`
+
+type UpdateProfileQuestionResolvedParams struct {
+	IsResolved bool   `db:"is_resolved" json:"is_resolved"`
+	ID         string `db:"id" json:"id"`
+}
+
+// UpdateProfileQuestionResolved
+//
+//	UPDATE "profile_question"
+//	SET
+//	  resolved_at = CASE
+//	    WHEN $1::BOOLEAN THEN COALESCE(resolved_at, NOW())
+//	    ELSE NULL
+//	  END,
+//	  updated_at = NOW()
+//	WHERE id = $2
+//	  AND deleted_at IS NULL
+func (q *Queries) UpdateProfileQuestionResolved(ctx context.Context, arg UpdateProfileQuestionResolvedParams) error {
+	_, err := q.db.ExecContext(ctx, updateProfileQuestionResolved, arg.IsResolved, arg.ID)
+	return err
+}
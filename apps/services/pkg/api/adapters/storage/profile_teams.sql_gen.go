@@ -9,8 +9,95 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
+const addTeamToMembership = `-- name: AddTeamToMembership :execrows
+INSERT INTO "profile_membership_team" (id, profile_membership_id, profile_team_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (profile_membership_id, profile_team_id) WHERE deleted_at IS NULL DO NOTHING
+`
+
+type AddTeamToMembershipParams struct {
+	ID                  string `db:"id" json:"id"`
+	ProfileMembershipID string `db:"profile_membership_id" json:"profile_membership_id"`
+	ProfileTeamID       string `db:"profile_team_id" json:"profile_team_id"`
+}
+
+// AddTeamToMembership
+//
+//	INSERT INTO "profile_membership_team" (id, profile_membership_id, profile_team_id)
+//	VALUES ($1, $2, $3)
+//	ON CONFLICT (profile_membership_id, profile_team_id) WHERE deleted_at IS NULL DO NOTHING
+func (q *Queries) AddTeamToMembership(ctx context.Context, arg AddTeamToMembershipParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, addTeamToMembership, arg.ID, arg.ProfileMembershipID, arg.ProfileTeamID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const clearStaleProfileTeamLeads = `-- name: ClearStaleProfileTeamLeads :execrows
+UPDATE "profile_team" pt
+SET lead_membership_id = NULL
+WHERE pt.lead_membership_id = ANY($1::TEXT[])
+  AND NOT EXISTS (
+    SELECT 1 FROM "profile_membership_team" pmt
+    WHERE pmt.profile_team_id = pt.id
+      AND pmt.profile_membership_id = pt.lead_membership_id
+      AND pmt.deleted_at IS NULL
+  )
+`
+
+type ClearStaleProfileTeamLeadsParams struct {
+	ProfileMembershipIds []string `db:"profile_membership_ids" json:"profile_membership_ids"`
+}
+
+// ClearStaleProfileTeamLeads
+//
+//	UPDATE "profile_team" pt
+//	SET lead_membership_id = NULL
+//	WHERE pt.lead_membership_id = ANY($1::TEXT[])
+//	  AND NOT EXISTS (
+//	    SELECT 1 FROM "profile_membership_team" pmt
+//	    WHERE pmt.profile_team_id = pt.id
+//	      AND pmt.profile_membership_id = pt.lead_membership_id
+//	      AND pmt.deleted_at IS NULL
+//	  )
+func (q *Queries) ClearStaleProfileTeamLeads(ctx context.Context, arg ClearStaleProfileTeamLeadsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, clearStaleProfileTeamLeads, pq.Array(arg.ProfileMembershipIds))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const countProfileMembershipsByIDs = `-- name: CountProfileMembershipsByIDs :one
+SELECT COUNT(*) FROM "profile_membership"
+WHERE profile_id = $1
+  AND id = ANY($2::TEXT[])
+  AND deleted_at IS NULL
+`
+
+type CountProfileMembershipsByIDsParams struct {
+	ProfileID string   `db:"profile_id" json:"profile_id"`
+	Ids       []string `db:"ids" json:"ids"`
+}
+
+// CountProfileMembershipsByIDs
+//
+//	SELECT COUNT(*) FROM "profile_membership"
+//	WHERE profile_id = $1
+//	  AND id = ANY($2::TEXT[])
+//	  AND deleted_at IS NULL
+func (q *Queries) CountProfileMembershipsByIDs(ctx context.Context, arg CountProfileMembershipsByIDsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProfileMembershipsByIDs, arg.ProfileID, pq.Array(arg.Ids))
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countProfileTeamMembers = `-- name: CountProfileTeamMembers :one
 SELECT COUNT(*) FROM "profile_membership_team"
 WHERE profile_team_id = $1 AND deleted_at IS NULL
@@ -54,7 +141,7 @@ func (q *Queries) CountProfileTeamResources(ctx context.Context, arg CountProfil
 const createProfileTeam = `-- name: CreateProfileTeam :one
 INSERT INTO "profile_team" (id, profile_id, name, description)
 VALUES ($1, $2, $3, $4)
-RETURNING id, profile_id, name, description, created_at, deleted_at
+RETURNING id, profile_id, name, description, created_at, deleted_at, lead_membership_id
 `
 
 type CreateProfileTeamParams struct {
@@ -68,7 +155,7 @@ type CreateProfileTeamParams struct {
 //
 //	INSERT INTO "profile_team" (id, profile_id, name, description)
 //	VALUES ($1, $2, $3, $4)
-//	RETURNING id, profile_id, name, description, created_at, deleted_at
+//	RETURNING id, profile_id, name, description, created_at, deleted_at, lead_membership_id
 func (q *Queries) CreateProfileTeam(ctx context.Context, arg CreateProfileTeamParams) (*ProfileTeam, error) {
 	row := q.db.QueryRowContext(ctx, createProfileTeam,
 		arg.ID,
@@ -84,6 +171,7 @@ func (q *Queries) CreateProfileTeam(ctx context.Context, arg CreateProfileTeamPa
 		&i.Description,
 		&i.CreatedAt,
 		&i.DeletedAt,
+		&i.LeadMembershipID,
 	)
 	return &i, err
 }
@@ -112,7 +200,7 @@ func (q *Queries) DeleteProfileTeam(ctx context.Context, arg DeleteProfileTeamPa
 }
 
 const getProfileTeamByID = `-- name: GetProfileTeamByID :one
-SELECT id, profile_id, name, description, created_at, deleted_at FROM "profile_team"
+SELECT id, profile_id, name, description, created_at, deleted_at, lead_membership_id FROM "profile_team"
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -122,7 +210,7 @@ type GetProfileTeamByIDParams struct {
 
 // GetProfileTeamByID
 //
-//	SELECT id, profile_id, name, description, created_at, deleted_at FROM "profile_team"
+//	SELECT id, profile_id, name, description, created_at, deleted_at, lead_membership_id FROM "profile_team"
 //	WHERE id = $1 AND deleted_at IS NULL
 func (q *Queries) GetProfileTeamByID(ctx context.Context, arg GetProfileTeamByIDParams) (*ProfileTeam, error) {
 	row := q.db.QueryRowContext(ctx, getProfileTeamByID, arg.ID)
@@ -134,12 +222,42 @@ func (q *Queries) GetProfileTeamByID(ctx context.Context, arg GetProfileTeamByID
 		&i.Description,
 		&i.CreatedAt,
 		&i.DeletedAt,
+		&i.LeadMembershipID,
 	)
 	return &i, err
 }
 
+const isMembershipInProfileTeam = `-- name: IsMembershipInProfileTeam :one
+SELECT EXISTS(
+  SELECT 1 FROM "profile_membership_team"
+  WHERE profile_team_id = $1
+    AND profile_membership_id = $2
+    AND deleted_at IS NULL
+) AS is_member
+`
+
+type IsMembershipInProfileTeamParams struct {
+	ProfileTeamID       string `db:"profile_team_id" json:"profile_team_id"`
+	ProfileMembershipID string `db:"profile_membership_id" json:"profile_membership_id"`
+}
+
+// IsMembershipInProfileTeam
+//
+//	SELECT EXISTS(
+//	  SELECT 1 FROM "profile_membership_team"
+//	  WHERE profile_team_id = $1
+//	    AND profile_membership_id = $2
+//	    AND deleted_at IS NULL
+//	) AS is_member
+func (q *Queries) IsMembershipInProfileTeam(ctx context.Context, arg IsMembershipInProfileTeamParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isMembershipInProfileTeam, arg.ProfileTeamID, arg.ProfileMembershipID)
+	var is_member bool
+	err := row.Scan(&is_member)
+	return is_member, err
+}
+
 const listMembershipTeams = `-- name: ListMembershipTeams :many
-SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 WHERE pmt.profile_membership_id = $1 AND pt.deleted_at IS NULL
 ORDER BY pt.name ASC
@@ -151,7 +269,7 @@ type ListMembershipTeamsParams struct {
 
 // ListMembershipTeams
 //
-//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 //	JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 //	WHERE pmt.profile_membership_id = $1 AND pt.deleted_at IS NULL
 //	ORDER BY pt.name ASC
@@ -171,6 +289,7 @@ func (q *Queries) ListMembershipTeams(ctx context.Context, arg ListMembershipTea
 			&i.Description,
 			&i.CreatedAt,
 			&i.DeletedAt,
+			&i.LeadMembershipID,
 		); err != nil {
 			return nil, err
 		}
@@ -186,7 +305,7 @@ func (q *Queries) ListMembershipTeams(ctx context.Context, arg ListMembershipTea
 }
 
 const listProfileTeams = `-- name: ListProfileTeams :many
-SELECT id, profile_id, name, description, created_at, deleted_at FROM "profile_team"
+SELECT id, profile_id, name, description, created_at, deleted_at, lead_membership_id FROM "profile_team"
 WHERE profile_id = $1 AND deleted_at IS NULL
 ORDER BY name ASC
 `
@@ -197,7 +316,7 @@ type ListProfileTeamsParams struct {
 
 // ListProfileTeams
 //
-//	SELECT id, profile_id, name, description, created_at, deleted_at FROM "profile_team"
+//	SELECT id, profile_id, name, description, created_at, deleted_at, lead_membership_id FROM "profile_team"
 //	WHERE profile_id = $1 AND deleted_at IS NULL
 //	ORDER BY name ASC
 func (q *Queries) ListProfileTeams(ctx context.Context, arg ListProfileTeamsParams) ([]*ProfileTeam, error) {
@@ -216,6 +335,7 @@ func (q *Queries) ListProfileTeams(ctx context.Context, arg ListProfileTeamsPara
 			&i.Description,
 			&i.CreatedAt,
 			&i.DeletedAt,
+			&i.LeadMembershipID,
 		); err != nil {
 			return nil, err
 		}
@@ -231,14 +351,16 @@ func (q *Queries) ListProfileTeams(ctx context.Context, arg ListProfileTeamsPara
 }
 
 const listProfileTeamsWithMemberCount = `-- name: ListProfileTeamsWithMemberCount :many
-SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at,
+SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id,
+  lm.member_profile_id AS lead_member_profile_id,
   COUNT(DISTINCT pmt.id) AS member_count,
   COUNT(DISTINCT prt.id) AS resource_count
 FROM "profile_team" pt
+LEFT JOIN "profile_membership" lm ON lm.id = pt.lead_membership_id AND lm.deleted_at IS NULL
 LEFT JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 LEFT JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 WHERE pt.profile_id = $1 AND pt.deleted_at IS NULL
-GROUP BY pt.id
+GROUP BY pt.id, lm.member_profile_id
 ORDER BY pt.name ASC
 `
 
@@ -247,26 +369,30 @@ type ListProfileTeamsWithMemberCountParams struct {
 }
 
 type ListProfileTeamsWithMemberCountRow struct {
-	ID            string         `db:"id" json:"id"`
-	ProfileID     string         `db:"profile_id" json:"profile_id"`
-	Name          string         `db:"name" json:"name"`
-	Description   sql.NullString `db:"description" json:"description"`
-	CreatedAt     time.Time      `db:"created_at" json:"created_at"`
-	DeletedAt     sql.NullTime   `db:"deleted_at" json:"deleted_at"`
-	MemberCount   int64          `db:"member_count" json:"member_count"`
-	ResourceCount int64          `db:"resource_count" json:"resource_count"`
+	ID                  string         `db:"id" json:"id"`
+	ProfileID           string         `db:"profile_id" json:"profile_id"`
+	Name                string         `db:"name" json:"name"`
+	Description         sql.NullString `db:"description" json:"description"`
+	CreatedAt           time.Time      `db:"created_at" json:"created_at"`
+	DeletedAt           sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	LeadMembershipID    sql.NullString `db:"lead_membership_id" json:"lead_membership_id"`
+	LeadMemberProfileID sql.NullString `db:"lead_member_profile_id" json:"lead_member_profile_id"`
+	MemberCount         int64          `db:"member_count" json:"member_count"`
+	ResourceCount       int64          `db:"resource_count" json:"resource_count"`
 }
 
 // ListProfileTeamsWithMemberCount
 //
-//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at,
+//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id,
+//	  lm.member_profile_id AS lead_member_profile_id,
 //	  COUNT(DISTINCT pmt.id) AS member_count,
 //	  COUNT(DISTINCT prt.id) AS resource_count
 //	FROM "profile_team" pt
+//	LEFT JOIN "profile_membership" lm ON lm.id = pt.lead_membership_id AND lm.deleted_at IS NULL
 //	LEFT JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 //	LEFT JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 //	WHERE pt.profile_id = $1 AND pt.deleted_at IS NULL
-//	GROUP BY pt.id
+//	GROUP BY pt.id, lm.member_profile_id
 //	ORDER BY pt.name ASC
 func (q *Queries) ListProfileTeamsWithMemberCount(ctx context.Context, arg ListProfileTeamsWithMemberCountParams) ([]*ListProfileTeamsWithMemberCountRow, error) {
 	rows, err := q.db.QueryContext(ctx, listProfileTeamsWithMemberCount, arg.ProfileID)
@@ -284,6 +410,8 @@ func (q *Queries) ListProfileTeamsWithMemberCount(ctx context.Context, arg ListP
 			&i.Description,
 			&i.CreatedAt,
 			&i.DeletedAt,
+			&i.LeadMembershipID,
+			&i.LeadMemberProfileID,
 			&i.MemberCount,
 			&i.ResourceCount,
 		); err != nil {
@@ -301,7 +429,7 @@ func (q *Queries) ListProfileTeamsWithMemberCount(ctx context.Context, arg ListP
 }
 
 const listResourceTeams = `-- name: ListResourceTeams :many
-SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 WHERE prt.profile_resource_id = $1 AND pt.deleted_at IS NULL
 ORDER BY pt.name ASC
@@ -313,7 +441,7 @@ type ListResourceTeamsParams struct {
 
 // ListResourceTeams
 //
-//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+//	SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 //	JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 //	WHERE prt.profile_resource_id = $1 AND pt.deleted_at IS NULL
 //	ORDER BY pt.name ASC
@@ -333,6 +461,7 @@ func (q *Queries) ListResourceTeams(ctx context.Context, arg ListResourceTeamsPa
 			&i.Description,
 			&i.CreatedAt,
 			&i.DeletedAt,
+			&i.LeadMembershipID,
 		); err != nil {
 			return nil, err
 		}
@@ -347,6 +476,154 @@ func (q *Queries) ListResourceTeams(ctx context.Context, arg ListResourceTeamsPa
 	return items, nil
 }
 
+const listTeamsForMemberships = `-- name: ListTeamsForMemberships :many
+SELECT pmt.profile_membership_id, pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
+JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
+WHERE pmt.profile_membership_id = ANY($1::TEXT[]) AND pt.deleted_at IS NULL
+ORDER BY pt.name ASC
+`
+
+type ListTeamsForMembershipsParams struct {
+	ProfileMembershipIds []string `db:"profile_membership_ids" json:"profile_membership_ids"`
+}
+
+type ListTeamsForMembershipsRow struct {
+	ProfileMembershipID string         `db:"profile_membership_id" json:"profile_membership_id"`
+	ID                  string         `db:"id" json:"id"`
+	ProfileID           string         `db:"profile_id" json:"profile_id"`
+	Name                string         `db:"name" json:"name"`
+	Description         sql.NullString `db:"description" json:"description"`
+	CreatedAt           time.Time      `db:"created_at" json:"created_at"`
+	DeletedAt           sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	LeadMembershipID    sql.NullString `db:"lead_membership_id" json:"lead_membership_id"`
+}
+
+// ListTeamsForMemberships
+//
+//	SELECT pmt.profile_membership_id, pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
+//	JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
+//	WHERE pmt.profile_membership_id = ANY($1::TEXT[]) AND pt.deleted_at IS NULL
+//	ORDER BY pt.name ASC
+func (q *Queries) ListTeamsForMemberships(ctx context.Context, arg ListTeamsForMembershipsParams) ([]*ListTeamsForMembershipsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamsForMemberships, pq.Array(arg.ProfileMembershipIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListTeamsForMembershipsRow{}
+	for rows.Next() {
+		var i ListTeamsForMembershipsRow
+		if err := rows.Scan(
+			&i.ProfileMembershipID,
+			&i.ID,
+			&i.ProfileID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.LeadMembershipID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamsForResources = `-- name: ListTeamsForResources :many
+SELECT prt.profile_resource_id, pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
+JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
+WHERE prt.profile_resource_id = ANY($1::TEXT[]) AND pt.deleted_at IS NULL
+ORDER BY pt.name ASC
+`
+
+type ListTeamsForResourcesParams struct {
+	ProfileResourceIds []string `db:"profile_resource_ids" json:"profile_resource_ids"`
+}
+
+type ListTeamsForResourcesRow struct {
+	ProfileResourceID string         `db:"profile_resource_id" json:"profile_resource_id"`
+	ID                string         `db:"id" json:"id"`
+	ProfileID         string         `db:"profile_id" json:"profile_id"`
+	Name              string         `db:"name" json:"name"`
+	Description       sql.NullString `db:"description" json:"description"`
+	CreatedAt         time.Time      `db:"created_at" json:"created_at"`
+	DeletedAt         sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	LeadMembershipID  sql.NullString `db:"lead_membership_id" json:"lead_membership_id"`
+}
+
+// ListTeamsForResources
+//
+//	SELECT prt.profile_resource_id, pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
+//	JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
+//	WHERE prt.profile_resource_id = ANY($1::TEXT[]) AND pt.deleted_at IS NULL
+//	ORDER BY pt.name ASC
+func (q *Queries) ListTeamsForResources(ctx context.Context, arg ListTeamsForResourcesParams) ([]*ListTeamsForResourcesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTeamsForResources, pq.Array(arg.ProfileResourceIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListTeamsForResourcesRow{}
+	for rows.Next() {
+		var i ListTeamsForResourcesRow
+		if err := rows.Scan(
+			&i.ProfileResourceID,
+			&i.ID,
+			&i.ProfileID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.LeadMembershipID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeTeamFromMemberships = `-- name: RemoveTeamFromMemberships :execrows
+UPDATE "profile_membership_team"
+SET deleted_at = NOW()
+WHERE profile_team_id = $1
+  AND profile_membership_id = ANY($2::TEXT[])
+  AND deleted_at IS NULL
+`
+
+type RemoveTeamFromMembershipsParams struct {
+	ProfileTeamID        string   `db:"profile_team_id" json:"profile_team_id"`
+	ProfileMembershipIds []string `db:"profile_membership_ids" json:"profile_membership_ids"`
+}
+
+// RemoveTeamFromMemberships
+//
+//	UPDATE "profile_membership_team"
+//	SET deleted_at = NOW()
+//	WHERE profile_team_id = $1
+//	  AND profile_membership_id = ANY($2::TEXT[])
+//	  AND deleted_at IS NULL
+func (q *Queries) RemoveTeamFromMemberships(ctx context.Context, arg RemoveTeamFromMembershipsParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, removeTeamFromMemberships, arg.ProfileTeamID, pq.Array(arg.ProfileMembershipIds))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setMembershipTeams_Delete = `-- name: SetMembershipTeams_Delete :execrows
 UPDATE "profile_membership_team"
 SET deleted_at = NOW()
@@ -400,6 +677,30 @@ func (q *Queries) SetMembershipTeams_Insert(ctx context.Context, arg SetMembersh
 	return &i, err
 }
 
+const setProfileTeamLead = `-- name: SetProfileTeamLead :execrows
+UPDATE "profile_team"
+SET lead_membership_id = $1
+WHERE id = $2 AND deleted_at IS NULL
+`
+
+type SetProfileTeamLeadParams struct {
+	LeadMembershipID sql.NullString `db:"lead_membership_id" json:"lead_membership_id"`
+	ID               string         `db:"id" json:"id"`
+}
+
+// SetProfileTeamLead
+//
+//	UPDATE "profile_team"
+//	SET lead_membership_id = $1
+//	WHERE id = $2 AND deleted_at IS NULL
+func (q *Queries) SetProfileTeamLead(ctx context.Context, arg SetProfileTeamLeadParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setProfileTeamLead, arg.LeadMembershipID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setResourceTeams_Delete = `-- name: SetResourceTeams_Delete :execrows
 UPDATE "profile_resource_team"
 SET deleted_at = NOW()
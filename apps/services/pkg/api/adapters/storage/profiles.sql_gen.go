@@ -216,27 +216,29 @@ func (q *Queries) CountProfileOwners(ctx context.Context, arg CountProfileOwners
 }
 
 const createCustomDomain = `-- name: CreateCustomDomain :exec
-INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale)
-VALUES ($1, $2, $3, $4)
+INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale, verification_token)
+VALUES ($1, $2, $3, $4, $5)
 `
 
 type CreateCustomDomainParams struct {
-	ID            string         `db:"id" json:"id"`
-	ProfileID     string         `db:"profile_id" json:"profile_id"`
-	Domain        string         `db:"domain" json:"domain"`
-	DefaultLocale sql.NullString `db:"default_locale" json:"default_locale"`
+	ID                string         `db:"id" json:"id"`
+	ProfileID         string         `db:"profile_id" json:"profile_id"`
+	Domain            string         `db:"domain" json:"domain"`
+	DefaultLocale     sql.NullString `db:"default_locale" json:"default_locale"`
+	VerificationToken string         `db:"verification_token" json:"verification_token"`
 }
 
 // CreateCustomDomain
 //
-//	INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale)
-//	VALUES ($1, $2, $3, $4)
+//	INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale, verification_token)
+//	VALUES ($1, $2, $3, $4, $5)
 func (q *Queries) CreateCustomDomain(ctx context.Context, arg CreateCustomDomainParams) error {
 	_, err := q.db.ExecContext(ctx, createCustomDomain,
 		arg.ID,
 		arg.ProfileID,
 		arg.Domain,
 		arg.DefaultLocale,
+		arg.VerificationToken,
 	)
 	return err
 }
@@ -316,7 +318,7 @@ INSERT INTO "profile_link" (
   $18,
   $19,
   NOW()
-) RETURNING id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online
+) RETURNING id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online, sync_status, sync_error, sync_attempted_at
 `
 
 type CreateProfileLinkParams struct {
@@ -385,7 +387,7 @@ type CreateProfileLinkParams struct {
 //	  $18,
 //	  $19,
 //	  NOW()
-//	) RETURNING id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online
+//	) RETURNING id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online, sync_status, sync_error, sync_attempted_at
 func (q *Queries) CreateProfileLink(ctx context.Context, arg CreateProfileLinkParams) (*ProfileLink, error) {
 	row := q.db.QueryRowContext(ctx, createProfileLink,
 		arg.ID,
@@ -433,6 +435,9 @@ func (q *Queries) CreateProfileLink(ctx context.Context, arg CreateProfileLinkPa
 		&i.IsFeatured,
 		&i.AddedByProfileID,
 		&i.IsOnline,
+		&i.SyncStatus,
+		&i.SyncError,
+		&i.SyncAttemptedAt,
 	)
 	return &i, err
 }
@@ -686,13 +691,20 @@ func (q *Queries) CreateProfilePageTx(ctx context.Context, arg CreateProfilePage
 const createProfileResource = `-- name: CreateProfileResource :one
 INSERT INTO "profile_resource" (
   id, profile_id, kind, is_managed, remote_id, public_id, url,
-  title, description, properties, added_by_profile_id, created_at
+  title, description, properties, added_by_profile_id, "order", created_at
 ) VALUES (
   $1, $2, $3, $4,
   $5, $6, $7,
   $8, $9, $10,
-  $11, NOW()
-) RETURNING id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at
+  $11,
+  (
+    SELECT COALESCE(MAX(existing."order"), 0) + 1
+    FROM "profile_resource" existing
+    WHERE existing.profile_id = $2
+      AND existing.deleted_at IS NULL
+  ),
+  NOW()
+) RETURNING id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured
 `
 
 type CreateProfileResourceParams struct {
@@ -713,13 +725,20 @@ type CreateProfileResourceParams struct {
 //
 //	INSERT INTO "profile_resource" (
 //	  id, profile_id, kind, is_managed, remote_id, public_id, url,
-//	  title, description, properties, added_by_profile_id, created_at
+//	  title, description, properties, added_by_profile_id, "order", created_at
 //	) VALUES (
 //	  $1, $2, $3, $4,
 //	  $5, $6, $7,
 //	  $8, $9, $10,
-//	  $11, NOW()
-//	) RETURNING id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at
+//	  $11,
+//	  (
+//	    SELECT COALESCE(MAX(existing."order"), 0) + 1
+//	    FROM "profile_resource" existing
+//	    WHERE existing.profile_id = $2
+//	      AND existing.deleted_at IS NULL
+//	  ),
+//	  NOW()
+//	) RETURNING id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured
 func (q *Queries) CreateProfileResource(ctx context.Context, arg CreateProfileResourceParams) (*ProfileResource, error) {
 	row := q.db.QueryRowContext(ctx, createProfileResource,
 		arg.ID,
@@ -750,6 +769,8 @@ func (q *Queries) CreateProfileResource(ctx context.Context, arg CreateProfileRe
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Order,
+		&i.IsFeatured,
 	)
 	return &i, err
 }
@@ -828,6 +849,36 @@ func (q *Queries) DeleteProfileLink(ctx context.Context, arg DeleteProfileLinkPa
 	return result.RowsAffected()
 }
 
+const deleteProfileLinkTxsByProfileLocale = `-- name: DeleteProfileLinkTxsByProfileLocale :execrows
+DELETE FROM "profile_link_tx"
+WHERE locale_code = $1
+  AND profile_link_id IN (
+    SELECT pl.id FROM "profile_link" pl
+    WHERE pl.profile_id = $2
+  )
+`
+
+type DeleteProfileLinkTxsByProfileLocaleParams struct {
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+	ProfileID  string `db:"profile_id" json:"profile_id"`
+}
+
+// DeleteProfileLinkTxsByProfileLocale
+//
+//	DELETE FROM "profile_link_tx"
+//	WHERE locale_code = $1
+//	  AND profile_link_id IN (
+//	    SELECT pl.id FROM "profile_link" pl
+//	    WHERE pl.profile_id = $2
+//	  )
+func (q *Queries) DeleteProfileLinkTxsByProfileLocale(ctx context.Context, arg DeleteProfileLinkTxsByProfileLocaleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProfileLinkTxsByProfileLocale, arg.LocaleCode, arg.ProfileID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteProfileMembership = `-- name: DeleteProfileMembership :execrows
 UPDATE "profile_membership"
 SET
@@ -906,6 +957,60 @@ func (q *Queries) DeleteProfilePageTx(ctx context.Context, arg DeleteProfilePage
 	return result.RowsAffected()
 }
 
+const deleteProfilePageTxsByProfileLocale = `-- name: DeleteProfilePageTxsByProfileLocale :execrows
+DELETE FROM "profile_page_tx"
+WHERE locale_code = $1
+  AND profile_page_id IN (
+    SELECT pp.id FROM "profile_page" pp
+    WHERE pp.profile_id = $2
+  )
+`
+
+type DeleteProfilePageTxsByProfileLocaleParams struct {
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+	ProfileID  string `db:"profile_id" json:"profile_id"`
+}
+
+// DeleteProfilePageTxsByProfileLocale
+//
+//	DELETE FROM "profile_page_tx"
+//	WHERE locale_code = $1
+//	  AND profile_page_id IN (
+//	    SELECT pp.id FROM "profile_page" pp
+//	    WHERE pp.profile_id = $2
+//	  )
+func (q *Queries) DeleteProfilePageTxsByProfileLocale(ctx context.Context, arg DeleteProfilePageTxsByProfileLocaleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProfilePageTxsByProfileLocale, arg.LocaleCode, arg.ProfileID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteProfileTx = `-- name: DeleteProfileTx :execrows
+DELETE FROM "profile_tx"
+WHERE profile_id = $1
+  AND locale_code = $2
+`
+
+type DeleteProfileTxParams struct {
+	ProfileID  string `db:"profile_id" json:"profile_id"`
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+}
+
+// DeleteProfileTx
+//
+//	DELETE FROM "profile_tx"
+//	WHERE profile_id = $1
+//	  AND locale_code = $2
+func (q *Queries) DeleteProfileTx(ctx context.Context, arg DeleteProfileTxParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProfileTx, arg.ProfileID, arg.LocaleCode)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const findProfileLinkProfileByKindAndRemoteID = `-- name: FindProfileLinkProfileByKindAndRemoteID :one
 SELECT pl.profile_id
 FROM "profile_link" pl
@@ -1039,7 +1144,8 @@ func (q *Queries) GetAdminProfileBySlug(ctx context.Context, arg GetAdminProfile
 const getCustomDomainByDomain = `-- name: GetCustomDomainByDomain :one
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 WHERE pcd.domain = $1
 LIMIT 1
@@ -1060,6 +1166,7 @@ type GetCustomDomainByDomainRow struct {
 	ExpiredAt          sql.NullTime   `db:"expired_at" json:"expired_at"`
 	WebserverSynced    bool           `db:"webserver_synced" json:"webserver_synced"`
 	WwwPrefix          bool           `db:"www_prefix" json:"www_prefix"`
+	VerificationToken  string         `db:"verification_token" json:"verification_token"`
 	CreatedAt          time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt          sql.NullTime   `db:"updated_at" json:"updated_at"`
 }
@@ -1068,7 +1175,8 @@ type GetCustomDomainByDomainRow struct {
 //
 //	SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 //	       pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+//	       pcd.created_at, pcd.updated_at
 //	FROM "profile_custom_domain" pcd
 //	WHERE pcd.domain = $1
 //	LIMIT 1
@@ -1086,8 +1194,74 @@ func (q *Queries) GetCustomDomainByDomain(ctx context.Context, arg GetCustomDoma
 		&i.ExpiredAt,
 		&i.WebserverSynced,
 		&i.WwwPrefix,
+		&i.VerificationToken,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return &i, err
+}
+
+const getDeletedProfileIDBySlug = `-- name: GetDeletedProfileIDBySlug :one
+SELECT id
+FROM "profile"
+WHERE slug = $1
+  AND deleted_at IS NOT NULL
+LIMIT 1
+`
+
+type GetDeletedProfileIDBySlugParams struct {
+	Slug string `db:"slug" json:"slug"`
+}
+
+// GetDeletedProfileIDBySlug
+//
+//	SELECT id
+//	FROM "profile"
+//	WHERE slug = $1
+//	  AND deleted_at IS NOT NULL
+//	LIMIT 1
+func (q *Queries) GetDeletedProfileIDBySlug(ctx context.Context, arg GetDeletedProfileIDBySlugParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getDeletedProfileIDBySlug, arg.Slug)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getDeletedProfileResourceByID = `-- name: GetDeletedProfileResourceByID :one
+SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+`
+
+type GetDeletedProfileResourceByIDParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetDeletedProfileResourceByID
+//
+//	SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
+//	WHERE id = $1
+//	  AND deleted_at IS NOT NULL
+func (q *Queries) GetDeletedProfileResourceByID(ctx context.Context, arg GetDeletedProfileResourceByIDParams) (*ProfileResource, error) {
+	row := q.db.QueryRowContext(ctx, getDeletedProfileResourceByID, arg.ID)
+	var i ProfileResource
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Kind,
+		&i.IsManaged,
+		&i.RemoteID,
+		&i.PublicID,
+		&i.URL,
+		&i.Title,
+		&i.Description,
+		&i.Properties,
+		&i.AddedByProfileID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Order,
+		&i.IsFeatured,
 	)
 	return &i, err
 }
@@ -1353,6 +1527,136 @@ func (q *Queries) GetProfileByID(ctx context.Context, arg GetProfileByIDParams)
 	return &i, err
 }
 
+const getProfileCounts = `-- name: GetProfileCounts :one
+SELECT
+  (
+    SELECT COUNT(*) FROM "profile_membership" pm
+      INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+        AND mp.kind IN ('organization', 'individual')
+        AND mp.approved_at IS NOT NULL
+        AND mp.deleted_at IS NULL
+    WHERE pm.profile_id = $1
+      AND pm.kind != 'follower'
+      AND pm.deleted_at IS NULL
+  ) AS member_count,
+  (
+    SELECT COUNT(*) FROM "profile_membership" pm
+      INNER JOIN "profile" op ON op.id = pm.profile_id
+        AND op.kind IN ('organization', 'product')
+        AND op.approved_at IS NOT NULL
+        AND op.deleted_at IS NULL
+    WHERE pm.member_profile_id = $1
+      AND pm.kind != 'follower'
+      AND pm.deleted_at IS NULL
+  ) AS contribution_count,
+  (
+    SELECT COUNT(*) FROM "profile_resource" pr
+    WHERE pr.profile_id = $1
+      AND pr.deleted_at IS NULL
+  ) AS resource_count,
+  (
+    SELECT COUNT(*) FROM "profile_link" pl
+    WHERE pl.profile_id = $1
+      AND pl.visibility = 'public'
+      AND pl.deleted_at IS NULL
+  ) AS link_count,
+  (
+    SELECT COUNT(*) FROM "profile_page" pp
+    WHERE pp.profile_id = $1
+      AND pp.visibility = 'public'
+      AND pp.deleted_at IS NULL
+  ) AS page_count
+`
+
+type GetProfileCountsParams struct {
+	ProfileID string `db:"profile_id" json:"profile_id"`
+}
+
+type GetProfileCountsRow struct {
+	MemberCount       int64 `db:"member_count" json:"member_count"`
+	ContributionCount int64 `db:"contribution_count" json:"contribution_count"`
+	ResourceCount     int64 `db:"resource_count" json:"resource_count"`
+	LinkCount         int64 `db:"link_count" json:"link_count"`
+	PageCount         int64 `db:"page_count" json:"page_count"`
+}
+
+// Counts what an anonymous visitor can see on a profile.
+//
+//	SELECT
+//	  (
+//	    SELECT COUNT(*) FROM "profile_membership" pm
+//	      INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+//	        AND mp.kind IN ('organization', 'individual')
+//	        AND mp.approved_at IS NOT NULL
+//	        AND mp.deleted_at IS NULL
+//	    WHERE pm.profile_id = $1
+//	      AND pm.kind != 'follower'
+//	      AND pm.deleted_at IS NULL
+//	  ) AS member_count,
+//	  (
+//	    SELECT COUNT(*) FROM "profile_membership" pm
+//	      INNER JOIN "profile" op ON op.id = pm.profile_id
+//	        AND op.kind IN ('organization', 'product')
+//	        AND op.approved_at IS NOT NULL
+//	        AND op.deleted_at IS NULL
+//	    WHERE pm.member_profile_id = $1
+//	      AND pm.kind != 'follower'
+//	      AND pm.deleted_at IS NULL
+//	  ) AS contribution_count,
+//	  (
+//	    SELECT COUNT(*) FROM "profile_resource" pr
+//	    WHERE pr.profile_id = $1
+//	      AND pr.deleted_at IS NULL
+//	  ) AS resource_count,
+//	  (
+//	    SELECT COUNT(*) FROM "profile_link" pl
+//	    WHERE pl.profile_id = $1
+//	      AND pl.visibility = 'public'
+//	      AND pl.deleted_at IS NULL
+//	  ) AS link_count,
+//	  (
+//	    SELECT COUNT(*) FROM "profile_page" pp
+//	    WHERE pp.profile_id = $1
+//	      AND pp.visibility = 'public'
+//	      AND pp.deleted_at IS NULL
+//	  ) AS page_count
+func (q *Queries) GetProfileCounts(ctx context.Context, arg GetProfileCountsParams) (*GetProfileCountsRow, error) {
+	row := q.db.QueryRowContext(ctx, getProfileCounts, arg.ProfileID)
+	var i GetProfileCountsRow
+	err := row.Scan(
+		&i.MemberCount,
+		&i.ContributionCount,
+		&i.ResourceCount,
+		&i.LinkCount,
+		&i.PageCount,
+	)
+	return &i, err
+}
+
+const getProfileDefaultLocale = `-- name: GetProfileDefaultLocale :one
+SELECT default_locale
+FROM "profile"
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type GetProfileDefaultLocaleParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetProfileDefaultLocale
+//
+//	SELECT default_locale
+//	FROM "profile"
+//	WHERE id = $1
+//	  AND deleted_at IS NULL
+func (q *Queries) GetProfileDefaultLocale(ctx context.Context, arg GetProfileDefaultLocaleParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getProfileDefaultLocale, arg.ID)
+	var default_locale string
+	err := row.Scan(&default_locale)
+	return default_locale, err
+}
+
 const getProfileFeatureLinksVisibility = `-- name: GetProfileFeatureLinksVisibility :one
 SELECT feature_links
 FROM "profile"
@@ -1467,7 +1771,7 @@ func (q *Queries) GetProfileIdentifierByID(ctx context.Context, arg GetProfileId
 
 const getProfileLink = `-- name: GetProfileLink :one
 SELECT
-  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
   COALESCE(plt.locale_code, p.default_locale) as locale_code,
   COALESCE(plt.title, pl.kind) as title,
   COALESCE(plt.icon, '') as icon,
@@ -1519,6 +1823,9 @@ type GetProfileLinkRow struct {
 	IsFeatured                bool                  `db:"is_featured" json:"is_featured"`
 	AddedByProfileID          sql.NullString        `db:"added_by_profile_id" json:"added_by_profile_id"`
 	IsOnline                  bool                  `db:"is_online" json:"is_online"`
+	SyncStatus                sql.NullString        `db:"sync_status" json:"sync_status"`
+	SyncError                 sql.NullString        `db:"sync_error" json:"sync_error"`
+	SyncAttemptedAt           sql.NullTime          `db:"sync_attempted_at" json:"sync_attempted_at"`
 	LocaleCode                string                `db:"locale_code" json:"locale_code"`
 	Title                     string                `db:"title" json:"title"`
 	Icon                      string                `db:"icon" json:"icon"`
@@ -1529,7 +1836,7 @@ type GetProfileLinkRow struct {
 // GetProfileLink
 //
 //	SELECT
-//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
 //	  COALESCE(plt.locale_code, p.default_locale) as locale_code,
 //	  COALESCE(plt.title, pl.kind) as title,
 //	  COALESCE(plt.icon, '') as icon,
@@ -1577,6 +1884,9 @@ func (q *Queries) GetProfileLink(ctx context.Context, arg GetProfileLinkParams)
 		&i.IsFeatured,
 		&i.AddedByProfileID,
 		&i.IsOnline,
+		&i.SyncStatus,
+		&i.SyncError,
+		&i.SyncAttemptedAt,
 		&i.LocaleCode,
 		&i.Title,
 		&i.Icon,
@@ -1587,7 +1897,7 @@ func (q *Queries) GetProfileLink(ctx context.Context, arg GetProfileLinkParams)
 }
 
 const getProfileLinkByRemoteID = `-- name: GetProfileLinkByRemoteID :one
-SELECT id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online
+SELECT id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online, sync_status, sync_error, sync_attempted_at
 FROM "profile_link"
 WHERE profile_id = $1
   AND kind = $2
@@ -1605,7 +1915,7 @@ type GetProfileLinkByRemoteIDParams struct {
 
 // GetProfileLinkByRemoteID
 //
-//	SELECT id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online
+//	SELECT id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online, sync_status, sync_error, sync_attempted_at
 //	FROM "profile_link"
 //	WHERE profile_id = $1
 //	  AND kind = $2
@@ -1640,6 +1950,9 @@ func (q *Queries) GetProfileLinkByRemoteID(ctx context.Context, arg GetProfileLi
 		&i.IsFeatured,
 		&i.AddedByProfileID,
 		&i.IsOnline,
+		&i.SyncStatus,
+		&i.SyncError,
+		&i.SyncAttemptedAt,
 	)
 	return &i, err
 }
@@ -2298,7 +2611,7 @@ func (q *Queries) GetProfilePageByProfileIDAndSlugForViewer(ctx context.Context,
 }
 
 const getProfileResourceByID = `-- name: GetProfileResourceByID :one
-SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at FROM "profile_resource"
+SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
 WHERE id = $1
   AND deleted_at IS NULL
 `
@@ -2309,7 +2622,7 @@ type GetProfileResourceByIDParams struct {
 
 // GetProfileResourceByID
 //
-//	SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at FROM "profile_resource"
+//	SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
 //	WHERE id = $1
 //	  AND deleted_at IS NULL
 func (q *Queries) GetProfileResourceByID(ctx context.Context, arg GetProfileResourceByIDParams) (*ProfileResource, error) {
@@ -2330,12 +2643,14 @@ func (q *Queries) GetProfileResourceByID(ctx context.Context, arg GetProfileReso
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Order,
+		&i.IsFeatured,
 	)
 	return &i, err
 }
 
 const getProfileResourceByRemoteID = `-- name: GetProfileResourceByRemoteID :one
-SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at FROM "profile_resource"
+SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
 WHERE profile_id = $1
   AND kind = $2
   AND remote_id = $3
@@ -2350,7 +2665,7 @@ type GetProfileResourceByRemoteIDParams struct {
 
 // GetProfileResourceByRemoteID
 //
-//	SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at FROM "profile_resource"
+//	SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
 //	WHERE profile_id = $1
 //	  AND kind = $2
 //	  AND remote_id = $3
@@ -2373,6 +2688,8 @@ func (q *Queries) GetProfileResourceByRemoteID(ctx context.Context, arg GetProfi
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Order,
+		&i.IsFeatured,
 	)
 	return &i, err
 }
@@ -2626,7 +2943,8 @@ func (q *Queries) GetUserProfilePermissions(ctx context.Context, arg GetUserProf
 const listAllCustomDomains = `-- name: ListAllCustomDomains :many
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 ORDER BY pcd.created_at
 `
@@ -2642,6 +2960,7 @@ type ListAllCustomDomainsRow struct {
 	ExpiredAt          sql.NullTime   `db:"expired_at" json:"expired_at"`
 	WebserverSynced    bool           `db:"webserver_synced" json:"webserver_synced"`
 	WwwPrefix          bool           `db:"www_prefix" json:"www_prefix"`
+	VerificationToken  string         `db:"verification_token" json:"verification_token"`
 	CreatedAt          time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt          sql.NullTime   `db:"updated_at" json:"updated_at"`
 }
@@ -2650,7 +2969,8 @@ type ListAllCustomDomainsRow struct {
 //
 //	SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 //	       pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+//	       pcd.created_at, pcd.updated_at
 //	FROM "profile_custom_domain" pcd
 //	ORDER BY pcd.created_at
 func (q *Queries) ListAllCustomDomains(ctx context.Context) ([]*ListAllCustomDomainsRow, error) {
@@ -2673,6 +2993,7 @@ func (q *Queries) ListAllCustomDomains(ctx context.Context) ([]*ListAllCustomDom
 			&i.ExpiredAt,
 			&i.WebserverSynced,
 			&i.WwwPrefix,
+			&i.VerificationToken,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -2721,12 +3042,16 @@ FROM "profile_link" pl
     )
 WHERE pl.profile_id = $2
   AND pl.deleted_at IS NULL
-ORDER BY pl."order"
+ORDER BY pl."order", pl.id
+LIMIT $4
+OFFSET $3
 `
 
 type ListAllProfileLinksByProfileIDParams struct {
-	LocaleCode string `db:"locale_code" json:"locale_code"`
-	ProfileID  string `db:"profile_id" json:"profile_id"`
+	LocaleCode string        `db:"locale_code" json:"locale_code"`
+	ProfileID  string        `db:"profile_id" json:"profile_id"`
+	PageOffset int32         `db:"page_offset" json:"page_offset"`
+	PageLimit  sql.NullInt32 `db:"page_limit" json:"page_limit"`
 }
 
 type ListAllProfileLinksByProfileIDRow struct {
@@ -2780,9 +3105,16 @@ type ListAllProfileLinksByProfileIDRow struct {
 //	    )
 //	WHERE pl.profile_id = $2
 //	  AND pl.deleted_at IS NULL
-//	ORDER BY pl."order"
+//	ORDER BY pl."order", pl.id
+//	LIMIT $4
+//	OFFSET $3
 func (q *Queries) ListAllProfileLinksByProfileID(ctx context.Context, arg ListAllProfileLinksByProfileIDParams) ([]*ListAllProfileLinksByProfileIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listAllProfileLinksByProfileID, arg.LocaleCode, arg.ProfileID)
+	rows, err := q.db.QueryContext(ctx, listAllProfileLinksByProfileID,
+		arg.LocaleCode,
+		arg.ProfileID,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -2951,7 +3283,8 @@ func (q *Queries) ListAllProfilesForAdmin(ctx context.Context, arg ListAllProfil
 const listCustomDomainsByProfileID = `-- name: ListCustomDomainsByProfileID :many
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 WHERE pcd.profile_id = $1
 ORDER BY pcd.created_at
@@ -2972,6 +3305,7 @@ type ListCustomDomainsByProfileIDRow struct {
 	ExpiredAt          sql.NullTime   `db:"expired_at" json:"expired_at"`
 	WebserverSynced    bool           `db:"webserver_synced" json:"webserver_synced"`
 	WwwPrefix          bool           `db:"www_prefix" json:"www_prefix"`
+	VerificationToken  string         `db:"verification_token" json:"verification_token"`
 	CreatedAt          time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt          sql.NullTime   `db:"updated_at" json:"updated_at"`
 }
@@ -2980,7 +3314,8 @@ type ListCustomDomainsByProfileIDRow struct {
 //
 //	SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 //	       pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+//	       pcd.created_at, pcd.updated_at
 //	FROM "profile_custom_domain" pcd
 //	WHERE pcd.profile_id = $1
 //	ORDER BY pcd.created_at
@@ -3004,6 +3339,7 @@ func (q *Queries) ListCustomDomainsByProfileID(ctx context.Context, arg ListCust
 			&i.ExpiredAt,
 			&i.WebserverSynced,
 			&i.WwwPrefix,
+			&i.VerificationToken,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -3020,6 +3356,67 @@ func (q *Queries) ListCustomDomainsByProfileID(ctx context.Context, arg ListCust
 	return items, nil
 }
 
+const listDueDraftProfilePages = `-- name: ListDueDraftProfilePages :many
+SELECT id, profile_id, slug, published_at
+FROM "profile_page"
+WHERE visibility = 'draft'
+  AND published_at IS NOT NULL
+  AND published_at <= $1::TIMESTAMP WITH TIME ZONE
+  AND deleted_at IS NULL
+ORDER BY published_at, id
+LIMIT $2
+`
+
+type ListDueDraftProfilePagesParams struct {
+	Now        time.Time `db:"now" json:"now"`
+	LimitCount int32     `db:"limit_count" json:"limit_count"`
+}
+
+type ListDueDraftProfilePagesRow struct {
+	ID          string       `db:"id" json:"id"`
+	ProfileID   string       `db:"profile_id" json:"profile_id"`
+	Slug        string       `db:"slug" json:"slug"`
+	PublishedAt sql.NullTime `db:"published_at" json:"published_at"`
+}
+
+// ListDueDraftProfilePages
+//
+//	SELECT id, profile_id, slug, published_at
+//	FROM "profile_page"
+//	WHERE visibility = 'draft'
+//	  AND published_at IS NOT NULL
+//	  AND published_at <= $1::TIMESTAMP WITH TIME ZONE
+//	  AND deleted_at IS NULL
+//	ORDER BY published_at, id
+//	LIMIT $2
+func (q *Queries) ListDueDraftProfilePages(ctx context.Context, arg ListDueDraftProfilePagesParams) ([]*ListDueDraftProfilePagesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listDueDraftProfilePages, arg.Now, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListDueDraftProfilePagesRow{}
+	for rows.Next() {
+		var i ListDueDraftProfilePagesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Slug,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listFeaturedProfileLinksByProfileID = `-- name: ListFeaturedProfileLinksByProfileID :many
 SELECT
   pl.id,
@@ -3249,6 +3646,60 @@ func (q *Queries) ListGitHubResourcesForSync(ctx context.Context, arg ListGitHub
 	return items, nil
 }
 
+const listManagedProfileLinkAccounts = `-- name: ListManagedProfileLinkAccounts :many
+SELECT kind, remote_id
+FROM "profile_link"
+WHERE profile_id = $1
+  AND kind = ANY($2::TEXT[])
+  AND is_managed = true
+  AND remote_id IS NOT NULL
+  AND deleted_at IS NULL
+ORDER BY "order", created_at
+`
+
+type ListManagedProfileLinkAccountsParams struct {
+	ProfileID string   `db:"profile_id" json:"profile_id"`
+	Kinds     []string `db:"kinds" json:"kinds"`
+}
+
+type ListManagedProfileLinkAccountsRow struct {
+	Kind     string         `db:"kind" json:"kind"`
+	RemoteID sql.NullString `db:"remote_id" json:"remote_id"`
+}
+
+// ListManagedProfileLinkAccounts
+//
+//	SELECT kind, remote_id
+//	FROM "profile_link"
+//	WHERE profile_id = $1
+//	  AND kind = ANY($2::TEXT[])
+//	  AND is_managed = true
+//	  AND remote_id IS NOT NULL
+//	  AND deleted_at IS NULL
+//	ORDER BY "order", created_at
+func (q *Queries) ListManagedProfileLinkAccounts(ctx context.Context, arg ListManagedProfileLinkAccountsParams) ([]*ListManagedProfileLinkAccountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listManagedProfileLinkAccounts, arg.ProfileID, pq.Array(arg.Kinds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListManagedProfileLinkAccountsRow{}
+	for rows.Next() {
+		var i ListManagedProfileLinkAccountsRow
+		if err := rows.Scan(&i.Kind, &i.RemoteID); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOnlineProfileLinks = `-- name: ListOnlineProfileLinks :many
 SELECT
   pl.id,
@@ -3375,37 +3826,252 @@ func (q *Queries) ListOnlineProfileLinks(ctx context.Context, arg ListOnlineProf
 	return items, nil
 }
 
-const listProfileLinksByProfileID = `-- name: ListProfileLinksByProfileID :many
+const listOrganizationGitHubLinksForSync = `-- name: ListOrganizationGitHubLinksForSync :many
 SELECT
-  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
-  COALESCE(plt.locale_code, p.default_locale) as locale_code,
-  COALESCE(plt.title, pl.kind) as title,
-  COALESCE(plt.icon, '') as icon,
-  COALESCE(plt."group", '') as "group",
-  COALESCE(plt.description, '') as description,
-  p_added.slug as added_by_slug,
-  p_added.kind as added_by_kind,
-  COALESCE(pt_added.title, '') as added_by_title,
-  COALESCE(pt_added.description, '') as added_by_description,
-  p_added.profile_picture_uri as added_by_profile_picture_uri
+  pl.id as link_id,
+  pl.profile_id,
+  pl.public_id as account_login,
+  pl.auth_access_token
 FROM "profile_link" pl
   INNER JOIN "profile" p ON p.id = pl.profile_id
-  LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
-    AND plt.locale_code = (
-      SELECT pltf.locale_code FROM "profile_link_tx" pltf
-      WHERE pltf.profile_link_id = pl.id
-      ORDER BY CASE
-        WHEN pltf.locale_code = $1 THEN 0
-        WHEN pltf.locale_code = p.default_locale THEN 1
-        ELSE 2
-      END
-      LIMIT 1
-    )
-  LEFT JOIN "profile" p_added ON p_added.id = pl.added_by_profile_id AND p_added.deleted_at IS NULL
-  LEFT JOIN "profile_tx" pt_added ON pt_added.profile_id = p_added.id AND pt_added.locale_code = p_added.default_locale
-WHERE pl.profile_id = $2
+    AND p.kind = 'organization'
+    AND p.deleted_at IS NULL
+WHERE pl.kind = 'github'
+  AND pl.is_managed = true
+  AND pl.public_id IS NOT NULL
+  AND pl.auth_access_token IS NOT NULL
   AND pl.deleted_at IS NULL
-ORDER BY pl."order"
+ORDER BY pl.created_at ASC
+LIMIT $1
+`
+
+type ListOrganizationGitHubLinksForSyncParams struct {
+	BatchSize int32 `db:"batch_size" json:"batch_size"`
+}
+
+type ListOrganizationGitHubLinksForSyncRow struct {
+	LinkID          string         `db:"link_id" json:"link_id"`
+	ProfileID       string         `db:"profile_id" json:"profile_id"`
+	AccountLogin    sql.NullString `db:"account_login" json:"account_login"`
+	AuthAccessToken sql.NullString `db:"auth_access_token" json:"auth_access_token"`
+}
+
+// ListOrganizationGitHubLinksForSync
+//
+//	SELECT
+//	  pl.id as link_id,
+//	  pl.profile_id,
+//	  pl.public_id as account_login,
+//	  pl.auth_access_token
+//	FROM "profile_link" pl
+//	  INNER JOIN "profile" p ON p.id = pl.profile_id
+//	    AND p.kind = 'organization'
+//	    AND p.deleted_at IS NULL
+//	WHERE pl.kind = 'github'
+//	  AND pl.is_managed = true
+//	  AND pl.public_id IS NOT NULL
+//	  AND pl.auth_access_token IS NOT NULL
+//	  AND pl.deleted_at IS NULL
+//	ORDER BY pl.created_at ASC
+//	LIMIT $1
+func (q *Queries) ListOrganizationGitHubLinksForSync(ctx context.Context, arg ListOrganizationGitHubLinksForSyncParams) ([]*ListOrganizationGitHubLinksForSyncRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrganizationGitHubLinksForSync, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListOrganizationGitHubLinksForSyncRow{}
+	for rows.Next() {
+		var i ListOrganizationGitHubLinksForSyncRow
+		if err := rows.Scan(
+			&i.LinkID,
+			&i.ProfileID,
+			&i.AccountLogin,
+			&i.AuthAccessToken,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPageSlugUsagesByProfileID = `-- name: ListPageSlugUsagesByProfileID :many
+SELECT id, slug, deleted_at
+FROM "profile_page"
+WHERE profile_id = $1
+  AND slug = ANY($2::TEXT[])
+`
+
+type ListPageSlugUsagesByProfileIDParams struct {
+	ProfileID string   `db:"profile_id" json:"profile_id"`
+	PageSlugs []string `db:"page_slugs" json:"page_slugs"`
+}
+
+type ListPageSlugUsagesByProfileIDRow struct {
+	ID        string       `db:"id" json:"id"`
+	Slug      string       `db:"slug" json:"slug"`
+	DeletedAt sql.NullTime `db:"deleted_at" json:"deleted_at"`
+}
+
+// Returns the pages, deleted ones included, that use any of the given slugs.
+//
+//	SELECT id, slug, deleted_at
+//	FROM "profile_page"
+//	WHERE profile_id = $1
+//	  AND slug = ANY($2::TEXT[])
+func (q *Queries) ListPageSlugUsagesByProfileID(ctx context.Context, arg ListPageSlugUsagesByProfileIDParams) ([]*ListPageSlugUsagesByProfileIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPageSlugUsagesByProfileID, arg.ProfileID, pq.Array(arg.PageSlugs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListPageSlugUsagesByProfileIDRow{}
+	for rows.Next() {
+		var i ListPageSlugUsagesByProfileIDRow
+		if err := rows.Scan(&i.ID, &i.Slug, &i.DeletedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfileLinkIDsByProfileID = `-- name: ListProfileLinkIDsByProfileID :many
+SELECT id FROM "profile_link"
+WHERE profile_id = $1
+  AND deleted_at IS NULL
+`
+
+type ListProfileLinkIDsByProfileIDParams struct {
+	ProfileID string `db:"profile_id" json:"profile_id"`
+}
+
+// ListProfileLinkIDsByProfileID
+//
+//	SELECT id FROM "profile_link"
+//	WHERE profile_id = $1
+//	  AND deleted_at IS NULL
+func (q *Queries) ListProfileLinkIDsByProfileID(ctx context.Context, arg ListProfileLinkIDsByProfileIDParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileLinkIDsByProfileID, arg.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfileLinkTxsByProfileLocale = `-- name: ListProfileLinkTxsByProfileLocale :many
+SELECT plt.profile_link_id, plt.locale_code, plt.title, plt."group", plt.description, plt.icon
+FROM "profile_link_tx" plt
+  INNER JOIN "profile_link" pl ON pl.id = plt.profile_link_id
+WHERE pl.profile_id = $1
+  AND pl.deleted_at IS NULL
+  AND plt.locale_code = $2
+ORDER BY pl."order", pl.id
+`
+
+type ListProfileLinkTxsByProfileLocaleParams struct {
+	ProfileID  string `db:"profile_id" json:"profile_id"`
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+}
+
+// ListProfileLinkTxsByProfileLocale
+//
+//	SELECT plt.profile_link_id, plt.locale_code, plt.title, plt."group", plt.description, plt.icon
+//	FROM "profile_link_tx" plt
+//	  INNER JOIN "profile_link" pl ON pl.id = plt.profile_link_id
+//	WHERE pl.profile_id = $1
+//	  AND pl.deleted_at IS NULL
+//	  AND plt.locale_code = $2
+//	ORDER BY pl."order", pl.id
+func (q *Queries) ListProfileLinkTxsByProfileLocale(ctx context.Context, arg ListProfileLinkTxsByProfileLocaleParams) ([]*ProfileLinkTx, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileLinkTxsByProfileLocale, arg.ProfileID, arg.LocaleCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ProfileLinkTx{}
+	for rows.Next() {
+		var i ProfileLinkTx
+		if err := rows.Scan(
+			&i.ProfileLinkID,
+			&i.LocaleCode,
+			&i.Title,
+			&i.Group,
+			&i.Description,
+			&i.Icon,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfileLinksByProfileID = `-- name: ListProfileLinksByProfileID :many
+SELECT
+  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
+  COALESCE(plt.locale_code, p.default_locale) as locale_code,
+  COALESCE(plt.title, pl.kind) as title,
+  COALESCE(plt.icon, '') as icon,
+  COALESCE(plt."group", '') as "group",
+  COALESCE(plt.description, '') as description,
+  p_added.slug as added_by_slug,
+  p_added.kind as added_by_kind,
+  COALESCE(pt_added.title, '') as added_by_title,
+  COALESCE(pt_added.description, '') as added_by_description,
+  p_added.profile_picture_uri as added_by_profile_picture_uri
+FROM "profile_link" pl
+  INNER JOIN "profile" p ON p.id = pl.profile_id
+  LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
+    AND plt.locale_code = (
+      SELECT pltf.locale_code FROM "profile_link_tx" pltf
+      WHERE pltf.profile_link_id = pl.id
+      ORDER BY CASE
+        WHEN pltf.locale_code = $1 THEN 0
+        WHEN pltf.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+  LEFT JOIN "profile" p_added ON p_added.id = pl.added_by_profile_id AND p_added.deleted_at IS NULL
+  LEFT JOIN "profile_tx" pt_added ON pt_added.profile_id = p_added.id AND pt_added.locale_code = p_added.default_locale
+WHERE pl.profile_id = $2
+  AND pl.deleted_at IS NULL
+ORDER BY pl."order"
 `
 
 type ListProfileLinksByProfileIDParams struct {
@@ -3437,6 +4103,9 @@ type ListProfileLinksByProfileIDRow struct {
 	IsFeatured                bool                  `db:"is_featured" json:"is_featured"`
 	AddedByProfileID          sql.NullString        `db:"added_by_profile_id" json:"added_by_profile_id"`
 	IsOnline                  bool                  `db:"is_online" json:"is_online"`
+	SyncStatus                sql.NullString        `db:"sync_status" json:"sync_status"`
+	SyncError                 sql.NullString        `db:"sync_error" json:"sync_error"`
+	SyncAttemptedAt           sql.NullTime          `db:"sync_attempted_at" json:"sync_attempted_at"`
 	LocaleCode                string                `db:"locale_code" json:"locale_code"`
 	Title                     string                `db:"title" json:"title"`
 	Icon                      string                `db:"icon" json:"icon"`
@@ -3449,20 +4118,172 @@ type ListProfileLinksByProfileIDRow struct {
 	AddedByProfilePictureURI  sql.NullString        `db:"added_by_profile_picture_uri" json:"added_by_profile_picture_uri"`
 }
 
-// ListProfileLinksByProfileID
+// ListProfileLinksByProfileID
+//
+//	SELECT
+//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
+//	  COALESCE(plt.locale_code, p.default_locale) as locale_code,
+//	  COALESCE(plt.title, pl.kind) as title,
+//	  COALESCE(plt.icon, '') as icon,
+//	  COALESCE(plt."group", '') as "group",
+//	  COALESCE(plt.description, '') as description,
+//	  p_added.slug as added_by_slug,
+//	  p_added.kind as added_by_kind,
+//	  COALESCE(pt_added.title, '') as added_by_title,
+//	  COALESCE(pt_added.description, '') as added_by_description,
+//	  p_added.profile_picture_uri as added_by_profile_picture_uri
+//	FROM "profile_link" pl
+//	  INNER JOIN "profile" p ON p.id = pl.profile_id
+//	  LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
+//	    AND plt.locale_code = (
+//	      SELECT pltf.locale_code FROM "profile_link_tx" pltf
+//	      WHERE pltf.profile_link_id = pl.id
+//	      ORDER BY CASE
+//	        WHEN pltf.locale_code = $1 THEN 0
+//	        WHEN pltf.locale_code = p.default_locale THEN 1
+//	        ELSE 2
+//	      END
+//	      LIMIT 1
+//	    )
+//	  LEFT JOIN "profile" p_added ON p_added.id = pl.added_by_profile_id AND p_added.deleted_at IS NULL
+//	  LEFT JOIN "profile_tx" pt_added ON pt_added.profile_id = p_added.id AND pt_added.locale_code = p_added.default_locale
+//	WHERE pl.profile_id = $2
+//	  AND pl.deleted_at IS NULL
+//	ORDER BY pl."order"
+func (q *Queries) ListProfileLinksByProfileID(ctx context.Context, arg ListProfileLinksByProfileIDParams) ([]*ListProfileLinksByProfileIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileLinksByProfileID, arg.LocaleCode, arg.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProfileLinksByProfileIDRow{}
+	for rows.Next() {
+		var i ListProfileLinksByProfileIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Kind,
+			&i.Order,
+			&i.IsManaged,
+			&i.IsVerified,
+			&i.RemoteID,
+			&i.PublicID,
+			&i.URI,
+			&i.AuthProvider,
+			&i.AuthAccessTokenScope,
+			&i.AuthAccessToken,
+			&i.AuthAccessTokenExpiresAt,
+			&i.AuthRefreshToken,
+			&i.AuthRefreshTokenExpiresAt,
+			&i.Properties,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Visibility,
+			&i.IsFeatured,
+			&i.AddedByProfileID,
+			&i.IsOnline,
+			&i.SyncStatus,
+			&i.SyncError,
+			&i.SyncAttemptedAt,
+			&i.LocaleCode,
+			&i.Title,
+			&i.Icon,
+			&i.Group,
+			&i.Description,
+			&i.AddedBySlug,
+			&i.AddedByKind,
+			&i.AddedByTitle,
+			&i.AddedByDescription,
+			&i.AddedByProfilePictureURI,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfileLinksByProfileIDForEditing = `-- name: ListProfileLinksByProfileIDForEditing :many
+SELECT
+  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
+  COALESCE(plt.locale_code, p.default_locale) as locale_code,
+  COALESCE(plt.title, pl.kind) as title,
+  COALESCE(plt.icon, '') as icon,
+  COALESCE(plt."group", '') as "group",
+  COALESCE(plt.description, '') as description
+FROM "profile_link" pl
+  INNER JOIN "profile" p ON p.id = pl.profile_id
+  LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
+    AND plt.locale_code = (
+      SELECT pltf.locale_code FROM "profile_link_tx" pltf
+      WHERE pltf.profile_link_id = pl.id
+      ORDER BY CASE
+        WHEN pltf.locale_code = $1 THEN 0
+        WHEN pltf.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+WHERE pl.profile_id = $2
+  AND pl.deleted_at IS NULL
+ORDER BY pl."order"
+`
+
+type ListProfileLinksByProfileIDForEditingParams struct {
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+	ProfileID  string `db:"profile_id" json:"profile_id"`
+}
+
+type ListProfileLinksByProfileIDForEditingRow struct {
+	ID                        string                `db:"id" json:"id"`
+	ProfileID                 string                `db:"profile_id" json:"profile_id"`
+	Kind                      string                `db:"kind" json:"kind"`
+	Order                     int32                 `db:"order" json:"order"`
+	IsManaged                 bool                  `db:"is_managed" json:"is_managed"`
+	IsVerified                bool                  `db:"is_verified" json:"is_verified"`
+	RemoteID                  sql.NullString        `db:"remote_id" json:"remote_id"`
+	PublicID                  sql.NullString        `db:"public_id" json:"public_id"`
+	URI                       sql.NullString        `db:"uri" json:"uri"`
+	AuthProvider              sql.NullString        `db:"auth_provider" json:"auth_provider"`
+	AuthAccessTokenScope      sql.NullString        `db:"auth_access_token_scope" json:"auth_access_token_scope"`
+	AuthAccessToken           sql.NullString        `db:"auth_access_token" json:"auth_access_token"`
+	AuthAccessTokenExpiresAt  sql.NullTime          `db:"auth_access_token_expires_at" json:"auth_access_token_expires_at"`
+	AuthRefreshToken          sql.NullString        `db:"auth_refresh_token" json:"auth_refresh_token"`
+	AuthRefreshTokenExpiresAt sql.NullTime          `db:"auth_refresh_token_expires_at" json:"auth_refresh_token_expires_at"`
+	Properties                pqtype.NullRawMessage `db:"properties" json:"properties"`
+	CreatedAt                 time.Time             `db:"created_at" json:"created_at"`
+	UpdatedAt                 sql.NullTime          `db:"updated_at" json:"updated_at"`
+	DeletedAt                 sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	Visibility                string                `db:"visibility" json:"visibility"`
+	IsFeatured                bool                  `db:"is_featured" json:"is_featured"`
+	AddedByProfileID          sql.NullString        `db:"added_by_profile_id" json:"added_by_profile_id"`
+	IsOnline                  bool                  `db:"is_online" json:"is_online"`
+	SyncStatus                sql.NullString        `db:"sync_status" json:"sync_status"`
+	SyncError                 sql.NullString        `db:"sync_error" json:"sync_error"`
+	SyncAttemptedAt           sql.NullTime          `db:"sync_attempted_at" json:"sync_attempted_at"`
+	LocaleCode                string                `db:"locale_code" json:"locale_code"`
+	Title                     string                `db:"title" json:"title"`
+	Icon                      string                `db:"icon" json:"icon"`
+	Group                     string                `db:"group" json:"group"`
+	Description               string                `db:"description" json:"description"`
+}
+
+// ListProfileLinksByProfileIDForEditing
 //
 //	SELECT
-//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
 //	  COALESCE(plt.locale_code, p.default_locale) as locale_code,
 //	  COALESCE(plt.title, pl.kind) as title,
 //	  COALESCE(plt.icon, '') as icon,
 //	  COALESCE(plt."group", '') as "group",
-//	  COALESCE(plt.description, '') as description,
-//	  p_added.slug as added_by_slug,
-//	  p_added.kind as added_by_kind,
-//	  COALESCE(pt_added.title, '') as added_by_title,
-//	  COALESCE(pt_added.description, '') as added_by_description,
-//	  p_added.profile_picture_uri as added_by_profile_picture_uri
+//	  COALESCE(plt.description, '') as description
 //	FROM "profile_link" pl
 //	  INNER JOIN "profile" p ON p.id = pl.profile_id
 //	  LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
@@ -3476,20 +4297,18 @@ type ListProfileLinksByProfileIDRow struct {
 //	      END
 //	      LIMIT 1
 //	    )
-//	  LEFT JOIN "profile" p_added ON p_added.id = pl.added_by_profile_id AND p_added.deleted_at IS NULL
-//	  LEFT JOIN "profile_tx" pt_added ON pt_added.profile_id = p_added.id AND pt_added.locale_code = p_added.default_locale
 //	WHERE pl.profile_id = $2
 //	  AND pl.deleted_at IS NULL
 //	ORDER BY pl."order"
-func (q *Queries) ListProfileLinksByProfileID(ctx context.Context, arg ListProfileLinksByProfileIDParams) ([]*ListProfileLinksByProfileIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listProfileLinksByProfileID, arg.LocaleCode, arg.ProfileID)
+func (q *Queries) ListProfileLinksByProfileIDForEditing(ctx context.Context, arg ListProfileLinksByProfileIDForEditingParams) ([]*ListProfileLinksByProfileIDForEditingRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileLinksByProfileIDForEditing, arg.LocaleCode, arg.ProfileID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []*ListProfileLinksByProfileIDRow{}
+	items := []*ListProfileLinksByProfileIDForEditingRow{}
 	for rows.Next() {
-		var i ListProfileLinksByProfileIDRow
+		var i ListProfileLinksByProfileIDForEditingRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.ProfileID,
@@ -3514,16 +4333,14 @@ func (q *Queries) ListProfileLinksByProfileID(ctx context.Context, arg ListProfi
 			&i.IsFeatured,
 			&i.AddedByProfileID,
 			&i.IsOnline,
+			&i.SyncStatus,
+			&i.SyncError,
+			&i.SyncAttemptedAt,
 			&i.LocaleCode,
 			&i.Title,
 			&i.Icon,
 			&i.Group,
 			&i.Description,
-			&i.AddedBySlug,
-			&i.AddedByKind,
-			&i.AddedByTitle,
-			&i.AddedByDescription,
-			&i.AddedByProfilePictureURI,
 		); err != nil {
 			return nil, err
 		}
@@ -3540,7 +4357,7 @@ func (q *Queries) ListProfileLinksByProfileID(ctx context.Context, arg ListProfi
 
 const listProfileLinksForKind = `-- name: ListProfileLinksForKind :many
 SELECT
-  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
   COALESCE(plt.locale_code, p.default_locale) as locale_code,
   COALESCE(plt.title, pl.kind) as title,
   COALESCE(plt.icon, '') as icon,
@@ -3594,6 +4411,9 @@ type ListProfileLinksForKindRow struct {
 	IsFeatured                bool                  `db:"is_featured" json:"is_featured"`
 	AddedByProfileID          sql.NullString        `db:"added_by_profile_id" json:"added_by_profile_id"`
 	IsOnline                  bool                  `db:"is_online" json:"is_online"`
+	SyncStatus                sql.NullString        `db:"sync_status" json:"sync_status"`
+	SyncError                 sql.NullString        `db:"sync_error" json:"sync_error"`
+	SyncAttemptedAt           sql.NullTime          `db:"sync_attempted_at" json:"sync_attempted_at"`
 	LocaleCode                string                `db:"locale_code" json:"locale_code"`
 	Title                     string                `db:"title" json:"title"`
 	Icon                      string                `db:"icon" json:"icon"`
@@ -3604,7 +4424,7 @@ type ListProfileLinksForKindRow struct {
 // ListProfileLinksForKind
 //
 //	SELECT
-//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+//	  pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
 //	  COALESCE(plt.locale_code, p.default_locale) as locale_code,
 //	  COALESCE(plt.title, pl.kind) as title,
 //	  COALESCE(plt.icon, '') as icon,
@@ -3660,6 +4480,9 @@ func (q *Queries) ListProfileLinksForKind(ctx context.Context, arg ListProfileLi
 			&i.IsFeatured,
 			&i.AddedByProfileID,
 			&i.IsOnline,
+			&i.SyncStatus,
+			&i.SyncError,
+			&i.SyncAttemptedAt,
 			&i.LocaleCode,
 			&i.Title,
 			&i.Icon,
@@ -3679,9 +4502,75 @@ func (q *Queries) ListProfileLinksForKind(ctx context.Context, arg ListProfileLi
 	return items, nil
 }
 
+const listProfileLinksForPostImport = `-- name: ListProfileLinksForPostImport :many
+SELECT
+  pl.id,
+  pl.profile_id,
+  pl.remote_id,
+  pl.auth_access_token
+FROM "profile_link" pl
+  INNER JOIN "profile" p ON p.id = pl.profile_id
+    AND p.deleted_at IS NULL
+WHERE pl.kind = $1
+  AND pl.deleted_at IS NULL
+ORDER BY pl.profile_id, pl."order"
+`
+
+type ListProfileLinksForPostImportParams struct {
+	Kind string `db:"kind" json:"kind"`
+}
+
+type ListProfileLinksForPostImportRow struct {
+	ID              string         `db:"id" json:"id"`
+	ProfileID       string         `db:"profile_id" json:"profile_id"`
+	RemoteID        sql.NullString `db:"remote_id" json:"remote_id"`
+	AuthAccessToken sql.NullString `db:"auth_access_token" json:"auth_access_token"`
+}
+
+// ListProfileLinksForPostImport
+//
+//	SELECT
+//	  pl.id,
+//	  pl.profile_id,
+//	  pl.remote_id,
+//	  pl.auth_access_token
+//	FROM "profile_link" pl
+//	  INNER JOIN "profile" p ON p.id = pl.profile_id
+//	    AND p.deleted_at IS NULL
+//	WHERE pl.kind = $1
+//	  AND pl.deleted_at IS NULL
+//	ORDER BY pl.profile_id, pl."order"
+func (q *Queries) ListProfileLinksForPostImport(ctx context.Context, arg ListProfileLinksForPostImportParams) ([]*ListProfileLinksForPostImportRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileLinksForPostImport, arg.Kind)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProfileLinksForPostImportRow{}
+	for rows.Next() {
+		var i ListProfileLinksForPostImportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.RemoteID,
+			&i.AuthAccessToken,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfileMemberships = `-- name: ListProfileMemberships :many
 SELECT
-  pm.id, pm.profile_id, pm.member_profile_id, pm.kind, pm.properties, pm.started_at, pm.finished_at, pm.deleted_at,
+  pm.id, pm.profile_id, pm.member_profile_id, pm.kind, pm.properties, pm.started_at, pm.finished_at, pm.deleted_at, pm.aggregate_stories,
   p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
   p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
   p2.id, p2.slug, p2.kind, p2.profile_picture_uri, p2.pronouns, p2.properties, p2.created_at, p2.updated_at, p2.deleted_at, p2.approved_at, p2.points, p2.feature_relations, p2.feature_links, p2.default_locale, p2.feature_qa, p2.feature_discussions, p2.option_story_discussions_by_default, p2.feature_referrals, p2.feature_applications,
@@ -3744,7 +4633,7 @@ type ListProfileMembershipsRow struct {
 // ListProfileMemberships
 //
 //	SELECT
-//	  pm.id, pm.profile_id, pm.member_profile_id, pm.kind, pm.properties, pm.started_at, pm.finished_at, pm.deleted_at,
+//	  pm.id, pm.profile_id, pm.member_profile_id, pm.kind, pm.properties, pm.started_at, pm.finished_at, pm.deleted_at, pm.aggregate_stories,
 //	  p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
 //	  p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
 //	  p2.id, p2.slug, p2.kind, p2.profile_picture_uri, p2.pronouns, p2.properties, p2.created_at, p2.updated_at, p2.deleted_at, p2.approved_at, p2.points, p2.feature_relations, p2.feature_links, p2.default_locale, p2.feature_qa, p2.feature_discussions, p2.option_story_discussions_by_default, p2.feature_referrals, p2.feature_applications,
@@ -3810,6 +4699,7 @@ func (q *Queries) ListProfileMemberships(ctx context.Context, arg ListProfileMem
 			&i.ProfileMembership.StartedAt,
 			&i.ProfileMembership.FinishedAt,
 			&i.ProfileMembership.DeletedAt,
+			&i.ProfileMembership.AggregateStories,
 			&i.Profile.ID,
 			&i.Profile.Slug,
 			&i.Profile.Kind,
@@ -4068,6 +4958,67 @@ func (q *Queries) ListProfilePageTxLocales(ctx context.Context, arg ListProfileP
 	return items, nil
 }
 
+const listProfilePageTxsByProfileLocale = `-- name: ListProfilePageTxsByProfileLocale :many
+SELECT ppt.profile_page_id, pp.slug, ppt.title, ppt.summary, ppt.content
+FROM "profile_page_tx" ppt
+  INNER JOIN "profile_page" pp ON pp.id = ppt.profile_page_id
+WHERE pp.profile_id = $1
+  AND pp.deleted_at IS NULL
+  AND ppt.locale_code = $2
+ORDER BY pp."order", pp.id
+`
+
+type ListProfilePageTxsByProfileLocaleParams struct {
+	ProfileID  string `db:"profile_id" json:"profile_id"`
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+}
+
+type ListProfilePageTxsByProfileLocaleRow struct {
+	ProfilePageID string `db:"profile_page_id" json:"profile_page_id"`
+	Slug          string `db:"slug" json:"slug"`
+	Title         string `db:"title" json:"title"`
+	Summary       string `db:"summary" json:"summary"`
+	Content       string `db:"content" json:"content"`
+}
+
+// ListProfilePageTxsByProfileLocale
+//
+//	SELECT ppt.profile_page_id, pp.slug, ppt.title, ppt.summary, ppt.content
+//	FROM "profile_page_tx" ppt
+//	  INNER JOIN "profile_page" pp ON pp.id = ppt.profile_page_id
+//	WHERE pp.profile_id = $1
+//	  AND pp.deleted_at IS NULL
+//	  AND ppt.locale_code = $2
+//	ORDER BY pp."order", pp.id
+func (q *Queries) ListProfilePageTxsByProfileLocale(ctx context.Context, arg ListProfilePageTxsByProfileLocaleParams) ([]*ListProfilePageTxsByProfileLocaleRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfilePageTxsByProfileLocale, arg.ProfileID, arg.LocaleCode)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProfilePageTxsByProfileLocaleRow{}
+	for rows.Next() {
+		var i ListProfilePageTxsByProfileLocaleRow
+		if err := rows.Scan(
+			&i.ProfilePageID,
+			&i.Slug,
+			&i.Title,
+			&i.Summary,
+			&i.Content,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfilePagesByProfileID = `-- name: ListProfilePagesByProfileID :many
 SELECT pp.id, pp.profile_id, pp.slug, pp."order", pp.cover_picture_uri, pp.published_at, pp.created_at, pp.updated_at, pp.deleted_at, pp.added_by_profile_id, pp.visibility, ppt.profile_page_id, ppt.locale_code, ppt.title, ppt.summary, ppt.content, ppt.search_vector,
   p_added.slug as added_by_slug,
@@ -4352,9 +5303,47 @@ func (q *Queries) ListProfilePagesByProfileIDForViewer(ctx context.Context, arg
 	return items, nil
 }
 
+const listProfileResourceIDsByProfileID = `-- name: ListProfileResourceIDsByProfileID :many
+SELECT id FROM "profile_resource"
+WHERE profile_id = $1
+  AND deleted_at IS NULL
+`
+
+type ListProfileResourceIDsByProfileIDParams struct {
+	ProfileID string `db:"profile_id" json:"profile_id"`
+}
+
+// ListProfileResourceIDsByProfileID
+//
+//	SELECT id FROM "profile_resource"
+//	WHERE profile_id = $1
+//	  AND deleted_at IS NULL
+func (q *Queries) ListProfileResourceIDsByProfileID(ctx context.Context, arg ListProfileResourceIDsByProfileIDParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileResourceIDsByProfileID, arg.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProfileResourcesByProfileID = `-- name: ListProfileResourcesByProfileID :many
 SELECT
-  pr.id, pr.profile_id, pr.kind, pr.is_managed, pr.remote_id, pr.public_id, pr.url, pr.title, pr.description, pr.properties, pr.added_by_profile_id, pr.created_at, pr.updated_at, pr.deleted_at,
+  pr.id, pr.profile_id, pr.kind, pr.is_managed, pr.remote_id, pr.public_id, pr.url, pr.title, pr.description, pr.properties, pr.added_by_profile_id, pr.created_at, pr.updated_at, pr.deleted_at, pr."order", pr.is_featured,
   p.slug as added_by_slug,
   p.kind as added_by_kind,
   COALESCE(pt_added.title, '') as added_by_title,
@@ -4366,11 +5355,28 @@ LEFT JOIN "profile_tx" pt_added ON pt_added.profile_id = p.id
   AND pt_added.locale_code = p.default_locale
 WHERE pr.profile_id = $1
   AND pr.deleted_at IS NULL
-ORDER BY pr.created_at DESC
+  AND ($2::TEXT IS NULL OR pr.kind = $2::TEXT)
+  AND (
+    $3::TEXT IS NULL
+    OR EXISTS (
+      SELECT 1 FROM "profile_resource_team" prt
+      WHERE prt.profile_resource_id = pr.id
+        AND prt.profile_team_id = $3::TEXT
+        AND prt.deleted_at IS NULL
+    )
+  )
+ORDER BY
+  CASE WHEN $4::TEXT = 'manual' THEN pr.is_featured END DESC,
+  CASE WHEN $4::TEXT = 'manual' THEN pr."order" END ASC,
+  CASE WHEN $4::TEXT = 'alphabetical' THEN LOWER(pr.title) END ASC,
+  pr.created_at DESC
 `
 
 type ListProfileResourcesByProfileIDParams struct {
-	ProfileID string `db:"profile_id" json:"profile_id"`
+	ProfileID    string         `db:"profile_id" json:"profile_id"`
+	FilterKind   sql.NullString `db:"filter_kind" json:"filter_kind"`
+	FilterTeamID sql.NullString `db:"filter_team_id" json:"filter_team_id"`
+	SortBy       string         `db:"sort_by" json:"sort_by"`
 }
 
 type ListProfileResourcesByProfileIDRow struct {
@@ -4388,6 +5394,8 @@ type ListProfileResourcesByProfileIDRow struct {
 	CreatedAt                time.Time             `db:"created_at" json:"created_at"`
 	UpdatedAt                sql.NullTime          `db:"updated_at" json:"updated_at"`
 	DeletedAt                sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	Order                    int32                 `db:"order" json:"order"`
+	IsFeatured               bool                  `db:"is_featured" json:"is_featured"`
 	AddedBySlug              sql.NullString        `db:"added_by_slug" json:"added_by_slug"`
 	AddedByKind              sql.NullString        `db:"added_by_kind" json:"added_by_kind"`
 	AddedByTitle             string                `db:"added_by_title" json:"added_by_title"`
@@ -4398,7 +5406,7 @@ type ListProfileResourcesByProfileIDRow struct {
 // ListProfileResourcesByProfileID
 //
 //	SELECT
-//	  pr.id, pr.profile_id, pr.kind, pr.is_managed, pr.remote_id, pr.public_id, pr.url, pr.title, pr.description, pr.properties, pr.added_by_profile_id, pr.created_at, pr.updated_at, pr.deleted_at,
+//	  pr.id, pr.profile_id, pr.kind, pr.is_managed, pr.remote_id, pr.public_id, pr.url, pr.title, pr.description, pr.properties, pr.added_by_profile_id, pr.created_at, pr.updated_at, pr.deleted_at, pr."order", pr.is_featured,
 //	  p.slug as added_by_slug,
 //	  p.kind as added_by_kind,
 //	  COALESCE(pt_added.title, '') as added_by_title,
@@ -4410,9 +5418,28 @@ type ListProfileResourcesByProfileIDRow struct {
 //	  AND pt_added.locale_code = p.default_locale
 //	WHERE pr.profile_id = $1
 //	  AND pr.deleted_at IS NULL
-//	ORDER BY pr.created_at DESC
+//	  AND ($2::TEXT IS NULL OR pr.kind = $2::TEXT)
+//	  AND (
+//	    $3::TEXT IS NULL
+//	    OR EXISTS (
+//	      SELECT 1 FROM "profile_resource_team" prt
+//	      WHERE prt.profile_resource_id = pr.id
+//	        AND prt.profile_team_id = $3::TEXT
+//	        AND prt.deleted_at IS NULL
+//	    )
+//	  )
+//	ORDER BY
+//	  CASE WHEN $4::TEXT = 'manual' THEN pr.is_featured END DESC,
+//	  CASE WHEN $4::TEXT = 'manual' THEN pr."order" END ASC,
+//	  CASE WHEN $4::TEXT = 'alphabetical' THEN LOWER(pr.title) END ASC,
+//	  pr.created_at DESC
 func (q *Queries) ListProfileResourcesByProfileID(ctx context.Context, arg ListProfileResourcesByProfileIDParams) ([]*ListProfileResourcesByProfileIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listProfileResourcesByProfileID, arg.ProfileID)
+	rows, err := q.db.QueryContext(ctx, listProfileResourcesByProfileID,
+		arg.ProfileID,
+		arg.FilterKind,
+		arg.FilterTeamID,
+		arg.SortBy,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -4435,6 +5462,8 @@ func (q *Queries) ListProfileResourcesByProfileID(ctx context.Context, arg ListP
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Order,
+			&i.IsFeatured,
 			&i.AddedBySlug,
 			&i.AddedByKind,
 			&i.AddedByTitle,
@@ -4472,20 +5501,28 @@ WHERE ($2::TEXT IS NULL OR p.kind = ANY(string_to_array($2::TEXT, ',')))
   AND ($3::TEXT IS NULL
        OR normalize_text(pt.title) LIKE '%' || normalize_text($3::TEXT) || '%'
        OR normalize_text(pt.description) LIKE '%' || normalize_text($3::TEXT) || '%')
+  AND ($4::BOOLEAN IS NULL
+       OR COALESCE(p.properties->'hiring'->'is_hiring' = 'true'::JSONB, FALSE) = $4::BOOLEAN)
+  AND ($5::BOOLEAN IS NULL
+       OR (p.properties->'availability'->>'status' IS NOT NULL
+           AND COALESCE((p.properties->'availability'->>'expires_at')::TIMESTAMPTZ > NOW(), TRUE))
+          = $5::BOOLEAN)
   AND p.approved_at IS NOT NULL
   AND p.deleted_at IS NULL
-ORDER BY md5(p.id || $4)
-LIMIT $6
-OFFSET $5
+ORDER BY md5(p.id || $6)
+LIMIT $8
+OFFSET $7
 `
 
 type ListProfilesParams struct {
-	LocaleCode string         `db:"locale_code" json:"locale_code"`
-	FilterKind sql.NullString `db:"filter_kind" json:"filter_kind"`
-	FilterQ    sql.NullString `db:"filter_q" json:"filter_q"`
-	Seed       string         `db:"seed" json:"seed"`
-	PageOffset int32          `db:"page_offset" json:"page_offset"`
-	PageLimit  int32          `db:"page_limit" json:"page_limit"`
+	LocaleCode      string         `db:"locale_code" json:"locale_code"`
+	FilterKind      sql.NullString `db:"filter_kind" json:"filter_kind"`
+	FilterQ         sql.NullString `db:"filter_q" json:"filter_q"`
+	FilterHiring    sql.NullBool   `db:"filter_hiring" json:"filter_hiring"`
+	FilterAvailable sql.NullBool   `db:"filter_available" json:"filter_available"`
+	Seed            string         `db:"seed" json:"seed"`
+	PageOffset      int32          `db:"page_offset" json:"page_offset"`
+	PageLimit       int32          `db:"page_limit" json:"page_limit"`
 }
 
 type ListProfilesRow struct {
@@ -4512,27 +5549,154 @@ type ListProfilesRow struct {
 //	  AND ($3::TEXT IS NULL
 //	       OR normalize_text(pt.title) LIKE '%' || normalize_text($3::TEXT) || '%'
 //	       OR normalize_text(pt.description) LIKE '%' || normalize_text($3::TEXT) || '%')
+//	  AND ($4::BOOLEAN IS NULL
+//	       OR COALESCE(p.properties->'hiring'->'is_hiring' = 'true'::JSONB, FALSE) = $4::BOOLEAN)
+//	  AND ($5::BOOLEAN IS NULL
+//	       OR (p.properties->'availability'->>'status' IS NOT NULL
+//	           AND COALESCE((p.properties->'availability'->>'expires_at')::TIMESTAMPTZ > NOW(), TRUE))
+//	          = $5::BOOLEAN)
+//	  AND p.approved_at IS NOT NULL
+//	  AND p.deleted_at IS NULL
+//	ORDER BY md5(p.id || $6)
+//	LIMIT $8
+//	OFFSET $7
+func (q *Queries) ListProfiles(ctx context.Context, arg ListProfilesParams) ([]*ListProfilesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfiles,
+		arg.LocaleCode,
+		arg.FilterKind,
+		arg.FilterQ,
+		arg.FilterHiring,
+		arg.FilterAvailable,
+		arg.Seed,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProfilesRow{}
+	for rows.Next() {
+		var i ListProfilesRow
+		if err := rows.Scan(
+			&i.Profile.ID,
+			&i.Profile.Slug,
+			&i.Profile.Kind,
+			&i.Profile.ProfilePictureURI,
+			&i.Profile.Pronouns,
+			&i.Profile.Properties,
+			&i.Profile.CreatedAt,
+			&i.Profile.UpdatedAt,
+			&i.Profile.DeletedAt,
+			&i.Profile.ApprovedAt,
+			&i.Profile.Points,
+			&i.Profile.FeatureRelations,
+			&i.Profile.FeatureLinks,
+			&i.Profile.DefaultLocale,
+			&i.Profile.FeatureQa,
+			&i.Profile.FeatureDiscussions,
+			&i.Profile.OptionStoryDiscussionsByDefault,
+			&i.Profile.FeatureReferrals,
+			&i.Profile.FeatureApplications,
+			&i.ProfileTx.ProfileID,
+			&i.ProfileTx.LocaleCode,
+			&i.ProfileTx.Title,
+			&i.ProfileTx.Description,
+			&i.ProfileTx.Properties,
+			&i.ProfileTx.SearchVector,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProfilesByPoints = `-- name: ListProfilesByPoints :many
+SELECT p.id, p.slug, p.kind, p.profile_picture_uri, p.pronouns, p.properties, p.created_at, p.updated_at, p.deleted_at, p.approved_at, p.points, p.feature_relations, p.feature_links, p.default_locale, p.feature_qa, p.feature_discussions, p.option_story_discussions_by_default, p.feature_referrals, p.feature_applications, pt.profile_id, pt.locale_code, pt.title, pt.description, pt.properties, pt.search_vector
+FROM "profile" p
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+  AND pt.locale_code = (
+    SELECT ptf.locale_code FROM "profile_tx" ptf
+    WHERE ptf.profile_id = p.id
+    ORDER BY CASE
+      WHEN ptf.locale_code = $1 THEN 0
+      WHEN ptf.locale_code = p.default_locale THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+WHERE ($2::TEXT IS NULL OR p.kind = $2::TEXT)
+  AND (
+    $3::INTEGER IS NULL
+    OR p.points < $3::INTEGER
+    OR (p.points = $3::INTEGER AND p.id > $4::CHAR(26))
+  )
+  AND p.approved_at IS NOT NULL
+  AND p.deleted_at IS NULL
+ORDER BY p.points DESC, p.id ASC
+LIMIT $5
+`
+
+type ListProfilesByPointsParams struct {
+	LocaleCode      string         `db:"locale_code" json:"locale_code"`
+	FilterKind      sql.NullString `db:"filter_kind" json:"filter_kind"`
+	CursorPoints    sql.NullInt32  `db:"cursor_points" json:"cursor_points"`
+	CursorProfileID sql.NullString `db:"cursor_profile_id" json:"cursor_profile_id"`
+	LimitCount      int32          `db:"limit_count" json:"limit_count"`
+}
+
+type ListProfilesByPointsRow struct {
+	Profile   Profile   `db:"profile" json:"profile"`
+	ProfileTx ProfileTx `db:"profile_tx" json:"profile_tx"`
+}
+
+// Highest points first, keyset-paginated on (points, profile id).
+//
+//	SELECT p.id, p.slug, p.kind, p.profile_picture_uri, p.pronouns, p.properties, p.created_at, p.updated_at, p.deleted_at, p.approved_at, p.points, p.feature_relations, p.feature_links, p.default_locale, p.feature_qa, p.feature_discussions, p.option_story_discussions_by_default, p.feature_referrals, p.feature_applications, pt.profile_id, pt.locale_code, pt.title, pt.description, pt.properties, pt.search_vector
+//	FROM "profile" p
+//	  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+//	  AND pt.locale_code = (
+//	    SELECT ptf.locale_code FROM "profile_tx" ptf
+//	    WHERE ptf.profile_id = p.id
+//	    ORDER BY CASE
+//	      WHEN ptf.locale_code = $1 THEN 0
+//	      WHEN ptf.locale_code = p.default_locale THEN 1
+//	      ELSE 2
+//	    END
+//	    LIMIT 1
+//	  )
+//	WHERE ($2::TEXT IS NULL OR p.kind = $2::TEXT)
+//	  AND (
+//	    $3::INTEGER IS NULL
+//	    OR p.points < $3::INTEGER
+//	    OR (p.points = $3::INTEGER AND p.id > $4::CHAR(26))
+//	  )
 //	  AND p.approved_at IS NOT NULL
 //	  AND p.deleted_at IS NULL
-//	ORDER BY md5(p.id || $4)
-//	LIMIT $6
-//	OFFSET $5
-func (q *Queries) ListProfiles(ctx context.Context, arg ListProfilesParams) ([]*ListProfilesRow, error) {
-	rows, err := q.db.QueryContext(ctx, listProfiles,
+//	ORDER BY p.points DESC, p.id ASC
+//	LIMIT $5
+func (q *Queries) ListProfilesByPoints(ctx context.Context, arg ListProfilesByPointsParams) ([]*ListProfilesByPointsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfilesByPoints,
 		arg.LocaleCode,
 		arg.FilterKind,
-		arg.FilterQ,
-		arg.Seed,
-		arg.PageOffset,
-		arg.PageLimit,
+		arg.CursorPoints,
+		arg.CursorProfileID,
+		arg.LimitCount,
 	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []*ListProfilesRow{}
+	items := []*ListProfilesByPointsRow{}
 	for rows.Next() {
-		var i ListProfilesRow
+		var i ListProfilesByPointsRow
 		if err := rows.Scan(
 			&i.Profile.ID,
 			&i.Profile.Slug,
@@ -4576,7 +5740,8 @@ func (q *Queries) ListProfiles(ctx context.Context, arg ListProfilesParams) ([]*
 const listVerifiedCustomDomains = `-- name: ListVerifiedCustomDomains :many
 SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
        pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+       pcd.created_at, pcd.updated_at
 FROM "profile_custom_domain" pcd
 WHERE pcd.verification_status IN ('verified', 'expired')
 ORDER BY pcd.created_at
@@ -4593,6 +5758,7 @@ type ListVerifiedCustomDomainsRow struct {
 	ExpiredAt          sql.NullTime   `db:"expired_at" json:"expired_at"`
 	WebserverSynced    bool           `db:"webserver_synced" json:"webserver_synced"`
 	WwwPrefix          bool           `db:"www_prefix" json:"www_prefix"`
+	VerificationToken  string         `db:"verification_token" json:"verification_token"`
 	CreatedAt          time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt          sql.NullTime   `db:"updated_at" json:"updated_at"`
 }
@@ -4601,7 +5767,8 @@ type ListVerifiedCustomDomainsRow struct {
 //
 //	SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 //	       pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+//	       pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+//	       pcd.created_at, pcd.updated_at
 //	FROM "profile_custom_domain" pcd
 //	WHERE pcd.verification_status IN ('verified', 'expired')
 //	ORDER BY pcd.created_at
@@ -4625,6 +5792,7 @@ func (q *Queries) ListVerifiedCustomDomains(ctx context.Context) ([]*ListVerifie
 			&i.ExpiredAt,
 			&i.WebserverSynced,
 			&i.WwwPrefix,
+			&i.VerificationToken,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -4669,6 +5837,37 @@ func (q *Queries) MergeProfileMembershipProperties(ctx context.Context, arg Merg
 	return result.RowsAffected()
 }
 
+const publishDraftProfilePage = `-- name: PublishDraftProfilePage :execrows
+UPDATE "profile_page"
+SET
+  visibility = 'public',
+  updated_at = NOW()
+WHERE id = $1
+  AND visibility = 'draft'
+  AND deleted_at IS NULL
+`
+
+type PublishDraftProfilePageParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// PublishDraftProfilePage
+//
+//	UPDATE "profile_page"
+//	SET
+//	  visibility = 'public',
+//	  updated_at = NOW()
+//	WHERE id = $1
+//	  AND visibility = 'draft'
+//	  AND deleted_at IS NULL
+func (q *Queries) PublishDraftProfilePage(ctx context.Context, arg PublishDraftProfilePageParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, publishDraftProfilePage, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const removeProfile = `-- name: RemoveProfile :execrows
 UPDATE "profile"
 SET deleted_at = NOW()
@@ -4694,6 +5893,58 @@ func (q *Queries) RemoveProfile(ctx context.Context, arg RemoveProfileParams) (i
 	return result.RowsAffected()
 }
 
+const restoreProfile = `-- name: RestoreProfile :execrows
+UPDATE "profile"
+SET deleted_at = NULL
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+`
+
+type RestoreProfileParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// RestoreProfile
+//
+//	UPDATE "profile"
+//	SET deleted_at = NULL
+//	WHERE id = $1
+//	  AND deleted_at IS NOT NULL
+func (q *Queries) RestoreProfile(ctx context.Context, arg RestoreProfileParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, restoreProfile, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const restoreProfileResource = `-- name: RestoreProfileResource :execrows
+UPDATE "profile_resource"
+SET deleted_at = NULL,
+  updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NOT NULL
+`
+
+type RestoreProfileResourceParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// RestoreProfileResource
+//
+//	UPDATE "profile_resource"
+//	SET deleted_at = NULL,
+//	  updated_at = NOW()
+//	WHERE id = $1
+//	  AND deleted_at IS NOT NULL
+func (q *Queries) RestoreProfileResource(ctx context.Context, arg RestoreProfileResourceParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, restoreProfileResource, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const searchProfilePages = `-- name: SearchProfilePages :many
 SELECT
   pp.id,
@@ -5002,6 +6253,12 @@ WHERE u.deleted_at IS NULL
       AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
       AND pm.kind NOT IN ('follower', 'sponsor')
   )
+  -- Users who blocked the profile are not suggested to it.
+  AND NOT EXISTS (
+    SELECT 1 FROM "user_profile_block" upb
+    WHERE upb.user_id = u.id
+      AND upb.profile_id = $3
+  )
 ORDER BY u.name ASC
 LIMIT 10
 `
@@ -5062,6 +6319,12 @@ type SearchUsersForMembershipRow struct {
 //	      AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
 //	      AND pm.kind NOT IN ('follower', 'sponsor')
 //	  )
+//	  -- Users who blocked the profile are not suggested to it.
+//	  AND NOT EXISTS (
+//	    SELECT 1 FROM "user_profile_block" upb
+//	    WHERE upb.user_id = u.id
+//	      AND upb.profile_id = $3
+//	  )
 //	ORDER BY u.name ASC
 //	LIMIT 10
 func (q *Queries) SearchUsersForMembership(ctx context.Context, arg SearchUsersForMembershipParams) ([]*SearchUsersForMembershipRow, error) {
@@ -5142,19 +6405,123 @@ func (q *Queries) SoftDeleteProfileResource(ctx context.Context, arg SoftDeleteP
 	return result.RowsAffected()
 }
 
+const suggestProfiles = `-- name: SuggestProfiles :many
+SELECT
+  p.slug,
+  p.kind,
+  p.profile_picture_uri,
+  pt.title
+FROM "profile" p
+  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+    AND pt.locale_code = (
+      SELECT ptf.locale_code FROM "profile_tx" ptf
+      WHERE ptf.profile_id = p.id
+      ORDER BY CASE
+        WHEN ptf.locale_code = $1 THEN 0
+        WHEN ptf.locale_code = p.default_locale THEN 1
+        ELSE 2
+      END
+      LIMIT 1
+    )
+WHERE p.approved_at IS NOT NULL
+  AND p.deleted_at IS NULL
+  AND (
+    p.slug LIKE $2::TEXT || '%'
+    OR p.id IN (
+      SELECT ptm.profile_id FROM "profile_tx" ptm
+      WHERE normalize_text(ptm.title) LIKE normalize_text($2::TEXT) || '%'
+    )
+  )
+ORDER BY (p.slug LIKE $2::TEXT || '%') DESC, LENGTH(p.slug), p.slug
+LIMIT $3
+`
+
+type SuggestProfilesParams struct {
+	LocaleCode string `db:"locale_code" json:"locale_code"`
+	Prefix     string `db:"prefix" json:"prefix"`
+	LimitCount int32  `db:"limit_count" json:"limit_count"`
+}
+
+type SuggestProfilesRow struct {
+	Slug              string         `db:"slug" json:"slug"`
+	Kind              string         `db:"kind" json:"kind"`
+	ProfilePictureURI sql.NullString `db:"profile_picture_uri" json:"profile_picture_uri"`
+	Title             string         `db:"title" json:"title"`
+}
+
+// SuggestProfiles
+//
+//	SELECT
+//	  p.slug,
+//	  p.kind,
+//	  p.profile_picture_uri,
+//	  pt.title
+//	FROM "profile" p
+//	  INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+//	    AND pt.locale_code = (
+//	      SELECT ptf.locale_code FROM "profile_tx" ptf
+//	      WHERE ptf.profile_id = p.id
+//	      ORDER BY CASE
+//	        WHEN ptf.locale_code = $1 THEN 0
+//	        WHEN ptf.locale_code = p.default_locale THEN 1
+//	        ELSE 2
+//	      END
+//	      LIMIT 1
+//	    )
+//	WHERE p.approved_at IS NOT NULL
+//	  AND p.deleted_at IS NULL
+//	  AND (
+//	    p.slug LIKE $2::TEXT || '%'
+//	    OR p.id IN (
+//	      SELECT ptm.profile_id FROM "profile_tx" ptm
+//	      WHERE normalize_text(ptm.title) LIKE normalize_text($2::TEXT) || '%'
+//	    )
+//	  )
+//	ORDER BY (p.slug LIKE $2::TEXT || '%') DESC, LENGTH(p.slug), p.slug
+//	LIMIT $3
+func (q *Queries) SuggestProfiles(ctx context.Context, arg SuggestProfilesParams) ([]*SuggestProfilesRow, error) {
+	rows, err := q.db.QueryContext(ctx, suggestProfiles, arg.LocaleCode, arg.Prefix, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*SuggestProfilesRow{}
+	for rows.Next() {
+		var i SuggestProfilesRow
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Kind,
+			&i.ProfilePictureURI,
+			&i.Title,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateCustomDomain = `-- name: UpdateCustomDomain :execrows
 UPDATE "profile_custom_domain"
 SET
   domain = $1,
   default_locale = $2,
+  verification_token = COALESCE($3, verification_token),
   updated_at = NOW()
-WHERE id = $3
+WHERE id = $4
 `
 
 type UpdateCustomDomainParams struct {
-	Domain        string         `db:"domain" json:"domain"`
-	DefaultLocale sql.NullString `db:"default_locale" json:"default_locale"`
-	ID            string         `db:"id" json:"id"`
+	Domain            string         `db:"domain" json:"domain"`
+	DefaultLocale     sql.NullString `db:"default_locale" json:"default_locale"`
+	VerificationToken sql.NullString `db:"verification_token" json:"verification_token"`
+	ID                string         `db:"id" json:"id"`
 }
 
 // UpdateCustomDomain
@@ -5163,10 +6530,16 @@ type UpdateCustomDomainParams struct {
 //	SET
 //	  domain = $1,
 //	  default_locale = $2,
+//	  verification_token = COALESCE($3, verification_token),
 //	  updated_at = NOW()
-//	WHERE id = $3
+//	WHERE id = $4
 func (q *Queries) UpdateCustomDomain(ctx context.Context, arg UpdateCustomDomainParams) (int64, error) {
-	result, err := q.db.ExecContext(ctx, updateCustomDomain, arg.Domain, arg.DefaultLocale, arg.ID)
+	result, err := q.db.ExecContext(ctx, updateCustomDomain,
+		arg.Domain,
+		arg.DefaultLocale,
+		arg.VerificationToken,
+		arg.ID,
+	)
 	if err != nil {
 		return 0, err
 	}
@@ -5315,6 +6688,36 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (i
 	return result.RowsAffected()
 }
 
+const updateProfileDefaultLocale = `-- name: UpdateProfileDefaultLocale :execrows
+UPDATE "profile"
+SET
+  default_locale = $1,
+  updated_at = NOW()
+WHERE id = $2
+  AND deleted_at IS NULL
+`
+
+type UpdateProfileDefaultLocaleParams struct {
+	DefaultLocale string `db:"default_locale" json:"default_locale"`
+	ID            string `db:"id" json:"id"`
+}
+
+// UpdateProfileDefaultLocale
+//
+//	UPDATE "profile"
+//	SET
+//	  default_locale = $1,
+//	  updated_at = NOW()
+//	WHERE id = $2
+//	  AND deleted_at IS NULL
+func (q *Queries) UpdateProfileDefaultLocale(ctx context.Context, arg UpdateProfileDefaultLocaleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateProfileDefaultLocale, arg.DefaultLocale, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateProfileLink = `-- name: UpdateProfileLink :execrows
 UPDATE "profile_link"
 SET
@@ -5454,6 +6857,69 @@ func (q *Queries) UpdateProfileLinkOnlineStatus(ctx context.Context, arg UpdateP
 	return result.RowsAffected()
 }
 
+const updateProfileLinkOrder = `-- name: UpdateProfileLinkOrder :execrows
+UPDATE "profile_link"
+SET
+  "order" = $1,
+  updated_at = NOW()
+WHERE id = $2
+  AND deleted_at IS NULL
+`
+
+type UpdateProfileLinkOrderParams struct {
+	LinkOrder int32  `db:"link_order" json:"link_order"`
+	ID        string `db:"id" json:"id"`
+}
+
+// UpdateProfileLinkOrder
+//
+//	UPDATE "profile_link"
+//	SET
+//	  "order" = $1,
+//	  updated_at = NOW()
+//	WHERE id = $2
+//	  AND deleted_at IS NULL
+func (q *Queries) UpdateProfileLinkOrder(ctx context.Context, arg UpdateProfileLinkOrderParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateProfileLinkOrder, arg.LinkOrder, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateProfileLinkSyncStatus = `-- name: UpdateProfileLinkSyncStatus :execrows
+UPDATE "profile_link"
+SET
+  sync_status = $1,
+  sync_error = $2,
+  sync_attempted_at = NOW()
+WHERE id = $3
+  AND deleted_at IS NULL
+`
+
+type UpdateProfileLinkSyncStatusParams struct {
+	SyncStatus sql.NullString `db:"sync_status" json:"sync_status"`
+	SyncError  sql.NullString `db:"sync_error" json:"sync_error"`
+	ID         string         `db:"id" json:"id"`
+}
+
+// Records the outcome of a sync attempt. sync_error is NULL after a success.
+//
+//	UPDATE "profile_link"
+//	SET
+//	  sync_status = $1,
+//	  sync_error = $2,
+//	  sync_attempted_at = NOW()
+//	WHERE id = $3
+//	  AND deleted_at IS NULL
+func (q *Queries) UpdateProfileLinkSyncStatus(ctx context.Context, arg UpdateProfileLinkSyncStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateProfileLinkSyncStatus, arg.SyncStatus, arg.SyncError, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateProfileLinkTx = `-- name: UpdateProfileLinkTx :execrows
 UPDATE "profile_link_tx"
 SET
@@ -5527,6 +6993,34 @@ func (q *Queries) UpdateProfileMembership(ctx context.Context, arg UpdateProfile
 	return result.RowsAffected()
 }
 
+const updateProfileMembershipAggregateStories = `-- name: UpdateProfileMembershipAggregateStories :execrows
+UPDATE "profile_membership"
+SET
+  aggregate_stories = $1
+WHERE id = $2
+  AND deleted_at IS NULL
+`
+
+type UpdateProfileMembershipAggregateStoriesParams struct {
+	AggregateStories bool   `db:"aggregate_stories" json:"aggregate_stories"`
+	ID               string `db:"id" json:"id"`
+}
+
+// UpdateProfileMembershipAggregateStories
+//
+//	UPDATE "profile_membership"
+//	SET
+//	  aggregate_stories = $1
+//	WHERE id = $2
+//	  AND deleted_at IS NULL
+func (q *Queries) UpdateProfileMembershipAggregateStories(ctx context.Context, arg UpdateProfileMembershipAggregateStoriesParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateProfileMembershipAggregateStories, arg.AggregateStories, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateProfileMembershipProperties = `-- name: UpdateProfileMembershipProperties :execrows
 UPDATE "profile_membership"
 SET
@@ -5645,6 +7139,39 @@ func (q *Queries) UpdateProfilePageTx(ctx context.Context, arg UpdateProfilePage
 	return result.RowsAffected()
 }
 
+const updateProfileResourceOrder = `-- name: UpdateProfileResourceOrder :execrows
+UPDATE "profile_resource"
+SET
+  "order" = $1,
+  is_featured = $2,
+  updated_at = NOW()
+WHERE id = $3
+  AND deleted_at IS NULL
+`
+
+type UpdateProfileResourceOrderParams struct {
+	ResourceOrder int32  `db:"resource_order" json:"resource_order"`
+	IsFeatured    bool   `db:"is_featured" json:"is_featured"`
+	ID            string `db:"id" json:"id"`
+}
+
+// UpdateProfileResourceOrder
+//
+//	UPDATE "profile_resource"
+//	SET
+//	  "order" = $1,
+//	  is_featured = $2,
+//	  updated_at = NOW()
+//	WHERE id = $3
+//	  AND deleted_at IS NULL
+func (q *Queries) UpdateProfileResourceOrder(ctx context.Context, arg UpdateProfileResourceOrderParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateProfileResourceOrder, arg.ResourceOrder, arg.IsFeatured, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateProfileResourceProperties = `-- name: UpdateProfileResourceProperties :execrows
 UPDATE "profile_resource"
 SET
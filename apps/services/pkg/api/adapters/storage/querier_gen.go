@@ -42,6 +42,12 @@ type Querier interface {
 	//  WHERE id = $2
 	//    AND deleted_at IS NULL
 	AddPointsToProfile(ctx context.Context, arg AddPointsToProfileParams) (int64, error)
+	//AddTeamToMembership
+	//
+	//  INSERT INTO "profile_membership_team" (id, profile_membership_id, profile_team_id)
+	//  VALUES ($1, $2, $3)
+	//  ON CONFLICT (profile_membership_id, profile_team_id) WHERE deleted_at IS NULL DO NOTHING
+	AddTeamToMembership(ctx context.Context, arg AddTeamToMembershipParams) (int64, error)
 	//AdjustDiscussionCommentVoteScore
 	//
 	//  UPDATE "discussion_comment"
@@ -132,6 +138,16 @@ type Querier interface {
 	//    WHERE slug = $1
 	//  ) AS exists
 	CheckProfileSlugExistsIncludingDeleted(ctx context.Context, arg CheckProfileSlugExistsIncludingDeletedParams) (bool, error)
+	//ClaimHandleReservation
+	//
+	//  UPDATE "profile_handle_reservation"
+	//  SET
+	//    claimed_at = NOW(),
+	//    claimed_by_profile_id = $1
+	//  WHERE id = $2
+	//    AND claimed_at IS NULL
+	//    AND released_at IS NULL
+	ClaimHandleReservation(ctx context.Context, arg ClaimHandleReservationParams) (int64, error)
 	// CTE-based claim: atomically selects + locks + updates.
 	// Picks up both pending items that are due AND stale processing items
 	// past their visibility timeout (crash recovery built into the claim).
@@ -195,6 +211,18 @@ type Querier interface {
 	//    AND deleted_at IS NULL
 	//    AND updated_at < $3
 	ClearStaleOnlineLinks(ctx context.Context, arg ClearStaleOnlineLinksParams) (int64, error)
+	//ClearStaleProfileTeamLeads
+	//
+	//  UPDATE "profile_team" pt
+	//  SET lead_membership_id = NULL
+	//  WHERE pt.lead_membership_id = ANY($1::TEXT[])
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_membership_team" pmt
+	//      WHERE pmt.profile_team_id = pt.id
+	//        AND pmt.profile_membership_id = pt.lead_membership_id
+	//        AND pmt.deleted_at IS NULL
+	//    )
+	ClearStaleProfileTeamLeads(ctx context.Context, arg ClearStaleProfileTeamLeadsParams) (int64, error)
 	// Worker ID check prevents a timed-out worker from completing
 	// a job that was already re-claimed by another worker.
 	//
@@ -258,6 +286,24 @@ type Querier interface {
 	//    AND status = 'pending'
 	//    AND deleted_at IS NULL
 	CountPendingMailboxEnvelopes(ctx context.Context, arg CountPendingMailboxEnvelopesParams) (int32, error)
+	//CountProfileLinkClicksByDay
+	//
+	//  SELECT
+	//    date_trunc('day', c.created_at, 'UTC')::TIMESTAMPTZ AS day,
+	//    COUNT(*) AS clicks
+	//  FROM "profile_link_click" c
+	//  WHERE c.profile_link_id = $1
+	//    AND c.created_at >= $2
+	//  GROUP BY day
+	//  ORDER BY day ASC
+	CountProfileLinkClicksByDay(ctx context.Context, arg CountProfileLinkClicksByDayParams) ([]*CountProfileLinkClicksByDayRow, error)
+	//CountProfileMembershipsByIDs
+	//
+	//  SELECT COUNT(*) FROM "profile_membership"
+	//  WHERE profile_id = $1
+	//    AND id = ANY($2::TEXT[])
+	//    AND deleted_at IS NULL
+	CountProfileMembershipsByIDs(ctx context.Context, arg CountProfileMembershipsByIDsParams) (int64, error)
 	//CountProfileOwners
 	//
 	//  SELECT COUNT(*) as owner_count
@@ -267,6 +313,14 @@ type Querier interface {
 	//    AND pm.deleted_at IS NULL
 	//    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
 	CountProfileOwners(ctx context.Context, arg CountProfileOwnersParams) (int64, error)
+	//CountProfileQuestionsByAuthorSince
+	//
+	//  SELECT COUNT(*) AS count
+	//  FROM "profile_question"
+	//  WHERE author_user_id = $1
+	//    AND created_at >= $2
+	//    AND deleted_at IS NULL
+	CountProfileQuestionsByAuthorSince(ctx context.Context, arg CountProfileQuestionsByAuthorSinceParams) (int64, error)
 	//CountProfileQuestionsByProfileID
 	//
 	//  SELECT COUNT(*) AS count
@@ -274,6 +328,13 @@ type Querier interface {
 	//  WHERE profile_id = $1
 	//    AND deleted_at IS NULL
 	CountProfileQuestionsByProfileID(ctx context.Context, arg CountProfileQuestionsByProfileIDParams) (int64, error)
+	//CountProfileReportsByReporterSince
+	//
+	//  SELECT COUNT(*)::INT AS count
+	//  FROM "profile_report"
+	//  WHERE reporter_key = $1
+	//    AND created_at >= $2
+	CountProfileReportsByReporterSince(ctx context.Context, arg CountProfileReportsByReporterSinceParams) (int32, error)
 	//CountProfileTeamMembers
 	//
 	//  SELECT COUNT(*) FROM "profile_membership_team"
@@ -371,16 +432,46 @@ type Querier interface {
 	//    NOW()
 	//  ) RETURNING id, candidate_id, form_field_id, value, created_at
 	CreateCandidateResponse(ctx context.Context, arg CreateCandidateResponseParams) (*ProfileCandidateResponse, error)
+	//CreateConsistencyCheck
+	//
+	//  INSERT INTO "consistency_check" (
+	//    id,
+	//    requested_by_user_id,
+	//    repair,
+	//    status,
+	//    created_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    'pending',
+	//    NOW()
+	//  )
+	CreateConsistencyCheck(ctx context.Context, arg CreateConsistencyCheckParams) error
 	//CreateCustomDomain
 	//
-	//  INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale)
-	//  VALUES ($1, $2, $3, $4)
+	//  INSERT INTO "profile_custom_domain" (id, profile_id, domain, default_locale, verification_token)
+	//  VALUES ($1, $2, $3, $4, $5)
 	CreateCustomDomain(ctx context.Context, arg CreateCustomDomainParams) error
 	//CreateExternalCode
 	//
 	//  INSERT INTO "external_code" (id, code, external_system, properties, created_at, expires_at)
 	//  VALUES ($1, $2, $3, $4, NOW(), $5)
 	CreateExternalCode(ctx context.Context, arg CreateExternalCodeParams) error
+	//CreateHandleReservation
+	//
+	//  INSERT INTO "profile_handle_reservation" (
+	//    id, slug, verification_email, verification_domain, note, reserved_by_user_id, expires_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6,
+	//    $7
+	//  )
+	CreateHandleReservation(ctx context.Context, arg CreateHandleReservationParams) error
 	//CreateLinkImport
 	//
 	//  INSERT INTO "profile_link_import" (id, profile_link_id, remote_id, properties, created_at)
@@ -519,7 +610,7 @@ type Querier interface {
 	//    $18,
 	//    $19,
 	//    NOW()
-	//  ) RETURNING id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online
+	//  ) RETURNING id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online, sync_status, sync_error, sync_attempted_at
 	CreateProfileLink(ctx context.Context, arg CreateProfileLinkParams) (*ProfileLink, error)
 	//CreateProfileLinkTx
 	//
@@ -560,7 +651,8 @@ type Querier interface {
 	//CreateProfileMembershipCandidate
 	//
 	//  INSERT INTO "profile_membership_candidate" (
-	//    id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status, created_at
+	//    id, profile_id, referred_profile_id, referrer_membership_id, source, applicant_message, status,
+	//    voting_deadline, created_at
 	//  ) VALUES (
 	//    $1,
 	//    $2,
@@ -569,9 +661,23 @@ type Querier interface {
 	//    $5,
 	//    $6,
 	//    'voting',
+	//    $7,
 	//    NOW()
-	//  ) RETURNING id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message
+	//  ) RETURNING id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline
 	CreateProfileMembershipCandidate(ctx context.Context, arg CreateProfileMembershipCandidateParams) (*ProfileMembershipCandidate, error)
+	//CreateProfileMembershipInvitation
+	//
+	//  INSERT INTO "profile_membership_invitation" (
+	//    id, profile_id, invitee_profile_id, kind, invited_by_user_id, expires_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6
+	//  )
+	CreateProfileMembershipInvitation(ctx context.Context, arg CreateProfileMembershipInvitationParams) error
 	//CreateProfilePage
 	//
 	//  INSERT INTO "profile_page" (
@@ -616,25 +722,43 @@ type Querier interface {
 	//
 	//  INSERT INTO "profile_resource" (
 	//    id, profile_id, kind, is_managed, remote_id, public_id, url,
-	//    title, description, properties, added_by_profile_id, created_at
+	//    title, description, properties, added_by_profile_id, "order", created_at
 	//  ) VALUES (
 	//    $1, $2, $3, $4,
 	//    $5, $6, $7,
 	//    $8, $9, $10,
-	//    $11, NOW()
-	//  ) RETURNING id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at
+	//    $11,
+	//    (
+	//      SELECT COALESCE(MAX(existing."order"), 0) + 1
+	//      FROM "profile_resource" existing
+	//      WHERE existing.profile_id = $2
+	//        AND existing.deleted_at IS NULL
+	//    ),
+	//    NOW()
+	//  ) RETURNING id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured
 	CreateProfileResource(ctx context.Context, arg CreateProfileResourceParams) (*ProfileResource, error)
 	//CreateProfileTeam
 	//
 	//  INSERT INTO "profile_team" (id, profile_id, name, description)
 	//  VALUES ($1, $2, $3, $4)
-	//  RETURNING id, profile_id, name, description, created_at, deleted_at
+	//  RETURNING id, profile_id, name, description, created_at, deleted_at, lead_membership_id
 	CreateProfileTeam(ctx context.Context, arg CreateProfileTeamParams) (*ProfileTeam, error)
 	//CreateProfileTx
 	//
 	//  INSERT INTO "profile_tx" (profile_id, locale_code, title, description, properties)
 	//  VALUES ($1, $2, $3, $4, $5)
 	CreateProfileTx(ctx context.Context, arg CreateProfileTxParams) error
+	//CreateProfileWebhookEndpoint
+	//
+	//  INSERT INTO "profile_webhook_endpoint" (id, profile_id, target_url, secret, created_at)
+	//  VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5
+	//  )
+	CreateProfileWebhookEndpoint(ctx context.Context, arg CreateProfileWebhookEndpointParams) error
 	//CreateSession
 	//
 	//  INSERT INTO
@@ -653,7 +777,9 @@ type Querier interface {
 	//      updated_at,
 	//      oauth_provider,
 	//      oauth_access_token,
-	//      oauth_token_scope
+	//      oauth_token_scope,
+	//      impersonator_user_id,
+	//      impersonator_session_id
 	//    )
 	//  VALUES
 	//    (
@@ -671,7 +797,9 @@ type Querier interface {
 	//      $12,
 	//      $13,
 	//      $14,
-	//      $15
+	//      $15,
+	//      $16,
+	//      $17
 	//    )
 	CreateSession(ctx context.Context, arg CreateSessionParams) error
 	//CreateUser
@@ -715,6 +843,33 @@ type Querier interface {
 	//      $17
 	//    )
 	CreateUser(ctx context.Context, arg CreateUserParams) error
+	//CreateWebhookDelivery
+	//
+	//  INSERT INTO "webhook_delivery" (id, source, endpoint_id, event_type, target_url, payload, status, created_at)
+	//  VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6,
+	//    $7,
+	//    $8
+	//  )
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	//CreateWebhookDeliveryAttempt
+	//
+	//  INSERT INTO "webhook_delivery_attempt" (id, delivery_id, status, response_code, error_message, duration_ms, attempted_at)
+	//  VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6,
+	//    $7
+	//  )
+	CreateWebhookDeliveryAttempt(ctx context.Context, arg CreateWebhookDeliveryAttemptParams) error
 	//DeactivateApplicationForms
 	//
 	//  UPDATE "profile_application_form"
@@ -829,6 +984,15 @@ type Querier interface {
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	DeleteProfileLink(ctx context.Context, arg DeleteProfileLinkParams) (int64, error)
+	//DeleteProfileLinkTxsByProfileLocale
+	//
+	//  DELETE FROM "profile_link_tx"
+	//  WHERE locale_code = $1
+	//    AND profile_link_id IN (
+	//      SELECT pl.id FROM "profile_link" pl
+	//      WHERE pl.profile_id = $2
+	//    )
+	DeleteProfileLinkTxsByProfileLocale(ctx context.Context, arg DeleteProfileLinkTxsByProfileLocaleParams) (int64, error)
 	//DeleteProfileMembership
 	//
 	//  UPDATE "profile_membership"
@@ -838,6 +1002,13 @@ type Querier interface {
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	DeleteProfileMembership(ctx context.Context, arg DeleteProfileMembershipParams) (int64, error)
+	//DeleteProfileMentionsBySource
+	//
+	//  DELETE FROM "profile_mention"
+	//  WHERE source_kind = $1
+	//    AND source_id = $2
+	//    AND locale_code = $3
+	DeleteProfileMentionsBySource(ctx context.Context, arg DeleteProfileMentionsBySourceParams) error
 	//DeleteProfilePage
 	//
 	//  UPDATE "profile_page"
@@ -851,18 +1022,47 @@ type Querier interface {
 	//  WHERE profile_page_id = $1
 	//    AND locale_code = $2
 	DeleteProfilePageTx(ctx context.Context, arg DeleteProfilePageTxParams) (int64, error)
+	//DeleteProfilePageTxsByProfileLocale
+	//
+	//  DELETE FROM "profile_page_tx"
+	//  WHERE locale_code = $1
+	//    AND profile_page_id IN (
+	//      SELECT pp.id FROM "profile_page" pp
+	//      WHERE pp.profile_id = $2
+	//    )
+	DeleteProfilePageTxsByProfileLocale(ctx context.Context, arg DeleteProfilePageTxsByProfileLocaleParams) (int64, error)
 	//DeleteProfileQuestionVote
 	//
 	//  DELETE FROM "profile_question_vote"
 	//  WHERE question_id = $1
 	//    AND user_id = $2
 	DeleteProfileQuestionVote(ctx context.Context, arg DeleteProfileQuestionVoteParams) error
+	//DeleteProfileSlugHistory
+	//
+	//  DELETE FROM "profile_slug_history"
+	//  WHERE slug = $1
+	//    AND profile_id = $2
+	DeleteProfileSlugHistory(ctx context.Context, arg DeleteProfileSlugHistoryParams) (int64, error)
 	//DeleteProfileTeam
 	//
 	//  UPDATE "profile_team"
 	//  SET deleted_at = NOW()
 	//  WHERE id = $1 AND deleted_at IS NULL
 	DeleteProfileTeam(ctx context.Context, arg DeleteProfileTeamParams) (int64, error)
+	//DeleteProfileTx
+	//
+	//  DELETE FROM "profile_tx"
+	//  WHERE profile_id = $1
+	//    AND locale_code = $2
+	DeleteProfileTx(ctx context.Context, arg DeleteProfileTxParams) (int64, error)
+	//DeleteProfileWebhookEndpoint
+	//
+	//  UPDATE "profile_webhook_endpoint"
+	//  SET deleted_at = NOW()
+	//  WHERE id = $1
+	//    AND profile_id = $2
+	//    AND deleted_at IS NULL
+	DeleteProfileWebhookEndpoint(ctx context.Context, arg DeleteProfileWebhookEndpointParams) (int64, error)
 	// ============================================================
 	// Hard delete (admin only)
 	// ============================================================
@@ -893,6 +1093,17 @@ type Querier interface {
 	//  WHERE story_id = $1
 	//    AND locale_code = $2
 	DeleteStoryTx(ctx context.Context, arg DeleteStoryTxParams) (int64, error)
+	//DeleteUserProfileBlock
+	//
+	//  DELETE FROM "user_profile_block"
+	//  WHERE user_id = $1
+	//    AND profile_id = $2
+	DeleteUserProfileBlock(ctx context.Context, arg DeleteUserProfileBlockParams) (int64, error)
+	//DeleteWebhookDeliveriesCreatedBefore
+	//
+	//  DELETE FROM "webhook_delivery"
+	//  WHERE created_at < $1
+	DeleteWebhookDeliveriesCreatedBefore(ctx context.Context, arg DeleteWebhookDeliveriesCreatedBeforeParams) (int64, error)
 	//EditProfileQuestionAnswer
 	//
 	//  UPDATE "profile_question"
@@ -973,12 +1184,46 @@ type Querier interface {
 	//    AND pl.deleted_at IS NULL
 	//  LIMIT 1
 	FindProfileLinkProfileByKindAndRemoteID(ctx context.Context, arg FindProfileLinkProfileByKindAndRemoteIDParams) (string, error)
+	//FinishConsistencyCheck
+	//
+	//  UPDATE "consistency_check"
+	//  SET
+	//    status = $1,
+	//    findings = $2,
+	//    error_message = $3,
+	//    completed_at = NOW()
+	//  WHERE id = $4
+	FinishConsistencyCheck(ctx context.Context, arg FinishConsistencyCheckParams) (int64, error)
 	//GetActiveApplicationForm
 	//
 	//  SELECT id, profile_id, preset_key, is_active, responses_visibility, created_at, updated_at FROM "profile_application_form"
 	//  WHERE profile_id = $1
 	//    AND is_active = TRUE
 	GetActiveApplicationForm(ctx context.Context, arg GetActiveApplicationFormParams) (*ProfileApplicationForm, error)
+	//GetActiveHandleReservationBySlug
+	//
+	//  SELECT phr.id, phr.slug, phr.verification_email, phr.verification_domain, phr.note,
+	//         phr.reserved_by_user_id, phr.expires_at, phr.claimed_at, phr.claimed_by_profile_id,
+	//         phr.released_at, phr.created_at
+	//  FROM "profile_handle_reservation" phr
+	//  WHERE phr.slug = $1
+	//    AND phr.claimed_at IS NULL
+	//    AND phr.released_at IS NULL
+	//    AND phr.expires_at > NOW()
+	//  ORDER BY phr.expires_at DESC
+	//  LIMIT 1
+	GetActiveHandleReservationBySlug(ctx context.Context, arg GetActiveHandleReservationBySlugParams) (*ProfileHandleReservation, error)
+	// Finds a pending or processing item whose payload contains the given one,
+	// so callers can avoid queueing the same work twice.
+	//
+	//  SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
+	//  FROM "event_queue"
+	//  WHERE type = $1
+	//    AND status IN ('pending', 'processing')
+	//    AND payload @> $2::JSONB
+	//  ORDER BY created_at DESC
+	//  LIMIT 1
+	GetActiveQueueItemByPayload(ctx context.Context, arg GetActiveQueueItemByPayloadParams) (*EventQueue, error)
 	// Returns active bulletin subscriptions whose preferred_time matches the given UTC hour
 	// and whose last_bulletin_at respects the frequency-based cooldown.
 	//
@@ -1084,15 +1329,45 @@ type Querier interface {
 	//  GROUP BY score
 	//  ORDER BY score
 	GetCandidateVoteBreakdown(ctx context.Context, arg GetCandidateVoteBreakdownParams) ([]*GetCandidateVoteBreakdownRow, error)
+	//GetConsistencyCheckByID
+	//
+	//  SELECT id, requested_by_user_id, repair, status, findings, error_message, created_at, completed_at
+	//  FROM "consistency_check"
+	//  WHERE id = $1
+	GetConsistencyCheckByID(ctx context.Context, arg GetConsistencyCheckByIDParams) (*ConsistencyCheck, error)
+	// Resolves a slug a profile used before to the slug the live profile has now.
+	//
+	//  SELECT p.slug
+	//  FROM "profile_slug_history" psh
+	//    INNER JOIN "profile" p ON p.id = psh.profile_id
+	//      AND p.deleted_at IS NULL
+	//  WHERE psh.slug = $1
+	//  LIMIT 1
+	GetCurrentSlugByPreviousSlug(ctx context.Context, arg GetCurrentSlugByPreviousSlugParams) (string, error)
 	//GetCustomDomainByDomain
 	//
 	//  SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 	//         pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+	//         pcd.created_at, pcd.updated_at
 	//  FROM "profile_custom_domain" pcd
 	//  WHERE pcd.domain = $1
 	//  LIMIT 1
 	GetCustomDomainByDomain(ctx context.Context, arg GetCustomDomainByDomainParams) (*GetCustomDomainByDomainRow, error)
+	//GetDeletedProfileIDBySlug
+	//
+	//  SELECT id
+	//  FROM "profile"
+	//  WHERE slug = $1
+	//    AND deleted_at IS NOT NULL
+	//  LIMIT 1
+	GetDeletedProfileIDBySlug(ctx context.Context, arg GetDeletedProfileIDBySlugParams) (string, error)
+	//GetDeletedProfileResourceByID
+	//
+	//  SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
+	//  WHERE id = $1
+	//    AND deleted_at IS NOT NULL
+	GetDeletedProfileResourceByID(ctx context.Context, arg GetDeletedProfileResourceByIDParams) (*ProfileResource, error)
 	//GetDiscussionComment
 	//
 	//  SELECT
@@ -1403,6 +1678,19 @@ type Querier interface {
 	//    AND pm.deleted_at IS NULL
 	//    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
 	GetMembershipsByProfilePairs(ctx context.Context, arg GetMembershipsByProfilePairsParams) ([]*GetMembershipsByProfilePairsRow, error)
+	//GetOpenProfileReportByReporter
+	//
+	//  SELECT
+	//    pr.id, pr.profile_id, pr.target_kind, pr.target_id, pr.reporter_user_id, pr.reporter_key, pr.reason, pr.text, pr.status, pr.resolved_by_user_id, pr.resolved_at, pr.created_at,
+	//    p.slug AS profile_slug
+	//  FROM "profile_report" pr
+	//    INNER JOIN "profile" p ON p.id = pr.profile_id
+	//  WHERE pr.target_kind = $1
+	//    AND pr.target_id = $2
+	//    AND pr.reporter_key = $3
+	//    AND pr.status = 'open'
+	//  LIMIT 1
+	GetOpenProfileReportByReporter(ctx context.Context, arg GetOpenProfileReportByReporterParams) (*GetOpenProfileReportByReporterRow, error)
 	//GetPOWChallengeByID
 	//
 	//  SELECT
@@ -1449,6 +1737,17 @@ type Querier interface {
 	//  WHERE status = 'pending'
 	//  GROUP BY triggering_event
 	GetPendingAwardsStatsByEventType(ctx context.Context) ([]*GetPendingAwardsStatsByEventTypeRow, error)
+	//GetPendingProfileMembershipInvitation
+	//
+	//  SELECT id, profile_id, invitee_profile_id, kind, status, invited_by_user_id, expires_at, responded_at, created_at
+	//  FROM "profile_membership_invitation"
+	//  WHERE profile_id = $1
+	//    AND invitee_profile_id = $2
+	//    AND status = 'pending'
+	//    AND expires_at > NOW()
+	//  ORDER BY created_at DESC
+	//  LIMIT 1
+	GetPendingProfileMembershipInvitation(ctx context.Context, arg GetPendingProfileMembershipInvitationParams) (*ProfileMembershipInvitation, error)
 	//GetProfileByID
 	//
 	//  SELECT p.id, p.slug, p.kind, p.profile_picture_uri, p.pronouns, p.properties, p.created_at, p.updated_at, p.deleted_at, p.approved_at, p.points, p.feature_relations, p.feature_links, p.default_locale, p.feature_qa, p.feature_discussions, p.option_story_discussions_by_default, p.feature_referrals, p.feature_applications, pt.profile_id, pt.locale_code, pt.title, pt.description, pt.properties, pt.search_vector
@@ -1468,6 +1767,54 @@ type Querier interface {
 	//    AND p.deleted_at IS NULL
 	//  LIMIT 1
 	GetProfileByID(ctx context.Context, arg GetProfileByIDParams) (*GetProfileByIDRow, error)
+	// Counts what an anonymous visitor can see on a profile.
+	//
+	//  SELECT
+	//    (
+	//      SELECT COUNT(*) FROM "profile_membership" pm
+	//        INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+	//          AND mp.kind IN ('organization', 'individual')
+	//          AND mp.approved_at IS NOT NULL
+	//          AND mp.deleted_at IS NULL
+	//      WHERE pm.profile_id = $1
+	//        AND pm.kind != 'follower'
+	//        AND pm.deleted_at IS NULL
+	//    ) AS member_count,
+	//    (
+	//      SELECT COUNT(*) FROM "profile_membership" pm
+	//        INNER JOIN "profile" op ON op.id = pm.profile_id
+	//          AND op.kind IN ('organization', 'product')
+	//          AND op.approved_at IS NOT NULL
+	//          AND op.deleted_at IS NULL
+	//      WHERE pm.member_profile_id = $1
+	//        AND pm.kind != 'follower'
+	//        AND pm.deleted_at IS NULL
+	//    ) AS contribution_count,
+	//    (
+	//      SELECT COUNT(*) FROM "profile_resource" pr
+	//      WHERE pr.profile_id = $1
+	//        AND pr.deleted_at IS NULL
+	//    ) AS resource_count,
+	//    (
+	//      SELECT COUNT(*) FROM "profile_link" pl
+	//      WHERE pl.profile_id = $1
+	//        AND pl.visibility = 'public'
+	//        AND pl.deleted_at IS NULL
+	//    ) AS link_count,
+	//    (
+	//      SELECT COUNT(*) FROM "profile_page" pp
+	//      WHERE pp.profile_id = $1
+	//        AND pp.visibility = 'public'
+	//        AND pp.deleted_at IS NULL
+	//    ) AS page_count
+	GetProfileCounts(ctx context.Context, arg GetProfileCountsParams) (*GetProfileCountsRow, error)
+	//GetProfileDefaultLocale
+	//
+	//  SELECT default_locale
+	//  FROM "profile"
+	//  WHERE id = $1
+	//    AND deleted_at IS NULL
+	GetProfileDefaultLocale(ctx context.Context, arg GetProfileDefaultLocaleParams) (string, error)
 	//GetProfileFeatureLinksVisibility
 	//
 	//  SELECT feature_links
@@ -1498,10 +1845,17 @@ type Querier interface {
 	//    AND p.deleted_at IS NULL
 	//  LIMIT 1
 	GetProfileIdentifierByID(ctx context.Context, arg GetProfileIdentifierByIDParams) (*GetProfileIdentifierByIDRow, error)
+	//GetProfileKindForIndividualLink
+	//
+	//  SELECT kind
+	//  FROM "profile"
+	//  WHERE id = $1
+	//    AND deleted_at IS NULL
+	GetProfileKindForIndividualLink(ctx context.Context, arg GetProfileKindForIndividualLinkParams) (string, error)
 	//GetProfileLink
 	//
 	//  SELECT
-	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
 	//    COALESCE(plt.locale_code, p.default_locale) as locale_code,
 	//    COALESCE(plt.title, pl.kind) as title,
 	//    COALESCE(plt.icon, '') as icon,
@@ -1535,7 +1889,7 @@ type Querier interface {
 	GetProfileLinkByProfileIDAndTelegram(ctx context.Context, arg GetProfileLinkByProfileIDAndTelegramParams) (*GetProfileLinkByProfileIDAndTelegramRow, error)
 	//GetProfileLinkByRemoteID
 	//
-	//  SELECT id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online
+	//  SELECT id, profile_id, kind, "order", is_managed, is_verified, remote_id, public_id, uri, auth_provider, auth_access_token_scope, auth_access_token, auth_access_token_expires_at, auth_refresh_token, auth_refresh_token_expires_at, properties, created_at, updated_at, deleted_at, visibility, is_featured, added_by_profile_id, is_online, sync_status, sync_error, sync_attempted_at
 	//  FROM "profile_link"
 	//  WHERE profile_id = $1
 	//    AND kind = $2
@@ -1602,16 +1956,22 @@ type Querier interface {
 	GetProfileMembershipByProfileAndMember(ctx context.Context, arg GetProfileMembershipByProfileAndMemberParams) (*GetProfileMembershipByProfileAndMemberRow, error)
 	//GetProfileMembershipCandidateByID
 	//
-	//  SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message FROM "profile_membership_candidate"
+	//  SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline FROM "profile_membership_candidate"
 	//  WHERE id = $1 AND deleted_at IS NULL
 	GetProfileMembershipCandidateByID(ctx context.Context, arg GetProfileMembershipCandidateByIDParams) (*ProfileMembershipCandidate, error)
 	//GetProfileMembershipCandidateByProfileAndReferred
 	//
-	//  SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message FROM "profile_membership_candidate"
+	//  SELECT id, profile_id, referred_profile_id, referrer_membership_id, status, vote_count, created_at, updated_at, deleted_at, source, applicant_message, voting_deadline FROM "profile_membership_candidate"
 	//  WHERE profile_id = $1
 	//    AND referred_profile_id = $2
 	//    AND deleted_at IS NULL
 	GetProfileMembershipCandidateByProfileAndReferred(ctx context.Context, arg GetProfileMembershipCandidateByProfileAndReferredParams) (*ProfileMembershipCandidate, error)
+	//GetProfileMembershipInvitationByID
+	//
+	//  SELECT id, profile_id, invitee_profile_id, kind, status, invited_by_user_id, expires_at, responded_at, created_at
+	//  FROM "profile_membership_invitation"
+	//  WHERE id = $1
+	GetProfileMembershipInvitationByID(ctx context.Context, arg GetProfileMembershipInvitationByIDParams) (*ProfileMembershipInvitation, error)
 	//GetProfileMembershipsByMemberProfileID
 	//
 	//  SELECT
@@ -1728,9 +2088,23 @@ type Querier interface {
 	//    )
 	//  ORDER BY pp."order"
 	GetProfilePageByProfileIDAndSlugForViewer(ctx context.Context, arg GetProfilePageByProfileIDAndSlugForViewerParams) (*GetProfilePageByProfileIDAndSlugForViewerRow, error)
+	//GetProfilePageProfileIDForMention
+	//
+	//  SELECT pp.profile_id
+	//  FROM "profile_page" pp
+	//  WHERE pp.id = $1
+	//    AND pp.deleted_at IS NULL
+	//  LIMIT 1
+	GetProfilePageProfileIDForMention(ctx context.Context, arg GetProfilePageProfileIDForMentionParams) (string, error)
+	//GetProfilePageTranslationJob
+	//
+	//  SELECT id, page_id, user_id, source_locale, total, created_at
+	//  FROM "profile_page_translation_job"
+	//  WHERE id = $1
+	GetProfilePageTranslationJob(ctx context.Context, arg GetProfilePageTranslationJobParams) (*ProfilePageTranslationJob, error)
 	//GetProfilePointTransactionByID
 	//
-	//  SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+	//  SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 	//  FROM "profile_point_transaction"
 	//  WHERE id = $1
 	GetProfilePointTransactionByID(ctx context.Context, arg GetProfilePointTransactionByIDParams) (*ProfilePointTransaction, error)
@@ -1749,11 +2123,19 @@ type Querier interface {
 	GetProfileQAVisibility(ctx context.Context, arg GetProfileQAVisibilityParams) (string, error)
 	//GetProfileQuestion
 	//
-	//  SELECT id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at
+	//  SELECT id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at, resolved_at
 	//  FROM "profile_question"
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	GetProfileQuestion(ctx context.Context, arg GetProfileQuestionParams) (*ProfileQuestion, error)
+	//GetProfileQuestionAuthorProfileID
+	//
+	//  SELECT u.individual_profile_id
+	//  FROM "profile_question" pq
+	//    INNER JOIN "user" u ON u.id = pq.author_user_id
+	//  WHERE pq.id = $1
+	//    AND pq.deleted_at IS NULL
+	GetProfileQuestionAuthorProfileID(ctx context.Context, arg GetProfileQuestionAuthorProfileIDParams) (sql.NullString, error)
 	//GetProfileQuestionVote
 	//
 	//  SELECT id, question_id, user_id, score, created_at
@@ -1763,13 +2145,13 @@ type Querier interface {
 	GetProfileQuestionVote(ctx context.Context, arg GetProfileQuestionVoteParams) (*ProfileQuestionVote, error)
 	//GetProfileResourceByID
 	//
-	//  SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at FROM "profile_resource"
+	//  SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	GetProfileResourceByID(ctx context.Context, arg GetProfileResourceByIDParams) (*ProfileResource, error)
 	//GetProfileResourceByRemoteID
 	//
-	//  SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at FROM "profile_resource"
+	//  SELECT id, profile_id, kind, is_managed, remote_id, public_id, url, title, description, properties, added_by_profile_id, created_at, updated_at, deleted_at, "order", is_featured FROM "profile_resource"
 	//  WHERE profile_id = $1
 	//    AND kind = $2
 	//    AND remote_id = $3
@@ -1783,9 +2165,37 @@ type Querier interface {
 	//    AND deleted_at IS NULL
 	//  LIMIT 1
 	GetProfileSlugByIDForTelegram(ctx context.Context, arg GetProfileSlugByIDForTelegramParams) (string, error)
+	//GetProfileSlugHistoryProfileID
+	//
+	//  SELECT profile_id
+	//  FROM "profile_slug_history"
+	//  WHERE slug = $1
+	//  LIMIT 1
+	GetProfileSlugHistoryProfileID(ctx context.Context, arg GetProfileSlugHistoryProfileIDParams) (string, error)
+	// Returns a public or unlisted story that is authored by or published to the profile.
+	//
+	//  SELECT s.id
+	//  FROM "story" s
+	//  INNER JOIN "profile" p ON p.slug = $1
+	//    AND p.deleted_at IS NULL
+	//  WHERE s.slug = $2
+	//    AND s.deleted_at IS NULL
+	//    AND s.visibility IN ('public', 'unlisted')
+	//    AND (
+	//      s.author_profile_id = p.id
+	//      OR EXISTS (
+	//        SELECT 1
+	//        FROM "story_publication" sp
+	//        WHERE sp.story_id = s.id
+	//          AND sp.profile_id = p.id
+	//          AND sp.deleted_at IS NULL
+	//      )
+	//    )
+	//  LIMIT 1
+	GetProfileStoryIDBySlugs(ctx context.Context, arg GetProfileStoryIDBySlugsParams) (string, error)
 	//GetProfileTeamByID
 	//
-	//  SELECT id, profile_id, name, description, created_at, deleted_at FROM "profile_team"
+	//  SELECT id, profile_id, name, description, created_at, deleted_at, lead_membership_id FROM "profile_team"
 	//  WHERE id = $1 AND deleted_at IS NULL
 	GetProfileTeamByID(ctx context.Context, arg GetProfileTeamByIDParams) (*ProfileTeam, error)
 	//GetProfileTxByID
@@ -1794,6 +2204,14 @@ type Querier interface {
 	//  FROM "profile_tx" pt
 	//  WHERE pt.profile_id = $1
 	GetProfileTxByID(ctx context.Context, arg GetProfileTxByIDParams) ([]*GetProfileTxByIDRow, error)
+	//GetProfileWebhookEndpointSecret
+	//
+	//  SELECT secret
+	//  FROM "profile_webhook_endpoint"
+	//  WHERE id = $1
+	//    AND deleted_at IS NULL
+	//  LIMIT 1
+	GetProfileWebhookEndpointSecret(ctx context.Context, arg GetProfileWebhookEndpointSecretParams) (string, error)
 	//GetProfilesByIDs
 	//
 	//  SELECT p.id, p.slug, p.kind, p.profile_picture_uri, p.pronouns, p.properties, p.created_at, p.updated_at, p.deleted_at, p.approved_at, p.points, p.feature_relations, p.feature_links, p.default_locale, p.feature_qa, p.feature_discussions, p.option_story_discussions_by_default, p.feature_referrals, p.feature_applications, pt.profile_id, pt.locale_code, pt.title, pt.description, pt.properties, pt.search_vector
@@ -1812,6 +2230,12 @@ type Querier interface {
 	//  WHERE p.id = ANY($2::TEXT[])
 	//    AND p.deleted_at IS NULL
 	GetProfilesByIDs(ctx context.Context, arg GetProfilesByIDsParams) ([]*GetProfilesByIDsRow, error)
+	//GetQueueItemByID
+	//
+	//  SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
+	//  FROM "event_queue"
+	//  WHERE id = $1
+	GetQueueItemByID(ctx context.Context, arg GetQueueItemByIDParams) (*EventQueue, error)
 	//GetRuntimeState
 	//
 	//  SELECT key, value, updated_at
@@ -1836,7 +2260,9 @@ type Querier interface {
 	//    updated_at,
 	//    oauth_provider,
 	//    oauth_access_token,
-	//    oauth_token_scope
+	//    oauth_token_scope,
+	//    impersonator_user_id,
+	//    impersonator_session_id
 	//  FROM
 	//    session
 	//  WHERE
@@ -1887,6 +2313,14 @@ type Querier interface {
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	GetStoryAuthorProfileID(ctx context.Context, arg GetStoryAuthorProfileIDParams) (sql.NullString, error)
+	//GetStoryAuthorProfileIDForMention
+	//
+	//  SELECT s.author_profile_id
+	//  FROM "story" s
+	//  WHERE s.id = $1
+	//    AND s.deleted_at IS NULL
+	//  LIMIT 1
+	GetStoryAuthorProfileIDForMention(ctx context.Context, arg GetStoryAuthorProfileIDForMentionParams) (sql.NullString, error)
 	//GetStoryByID
 	//
 	//  SELECT
@@ -1952,6 +2386,20 @@ type Querier interface {
 	//  WHERE proposal_id = $1
 	//    AND voter_profile_id = $2
 	GetStoryDateProposalVote(ctx context.Context, arg GetStoryDateProposalVoteParams) (*StoryDateProposalVote, error)
+	// Returns the per-story discussions flag together with the author profile's
+	// discussions module visibility.
+	//
+	//  SELECT
+	//    s.feat_discussions,
+	//    s.author_profile_id,
+	//    p.slug AS author_profile_slug,
+	//    p.feature_discussions AS author_feature_discussions
+	//  FROM "story" s
+	//  LEFT JOIN "profile" p ON p.id = s.author_profile_id
+	//    AND p.deleted_at IS NULL
+	//  WHERE s.id = $1
+	//    AND s.deleted_at IS NULL
+	GetStoryDiscussionSettings(ctx context.Context, arg GetStoryDiscussionSettingsParams) (*GetStoryDiscussionSettingsRow, error)
 	//GetStoryFirstPublishedAt
 	//
 	//  SELECT MIN(published_at) as first_published_at
@@ -2113,6 +2561,13 @@ type Querier interface {
 	//    AND ss.deleted_at IS NULL
 	//  LIMIT 1
 	GetStorySeriesBySlug(ctx context.Context, arg GetStorySeriesBySlugParams) (*GetStorySeriesBySlugRow, error)
+	// Returns the deduplicated view counter of a story (0 when never viewed).
+	//
+	//  SELECT COALESCE(
+	//    (SELECT view_count FROM "story_view_stat" WHERE story_id = $1),
+	//    0
+	//  )::BIGINT AS view_count
+	GetStoryViewCount(ctx context.Context, arg GetStoryViewCountParams) (int64, error)
 	// Returns published story translations that have no AI summary yet.
 	//
 	//  SELECT
@@ -2185,6 +2640,14 @@ type Querier interface {
 	//    AND u.deleted_at IS NULL
 	//  LIMIT 1
 	GetUserEmailByIndividualProfileID(ctx context.Context, arg GetUserEmailByIndividualProfileIDParams) (sql.NullString, error)
+	//GetUserIDByIndividualProfileID
+	//
+	//  SELECT id
+	//  FROM "user"
+	//  WHERE individual_profile_id = $1
+	//    AND deleted_at IS NULL
+	//  LIMIT 1
+	GetUserIDByIndividualProfileID(ctx context.Context, arg GetUserIDByIndividualProfileIDParams) (string, error)
 	// Returns the membership kind a user has for a specific profile.
 	// Used to verify a user has access to publish to a target profile.
 	// Returns:
@@ -2224,6 +2687,20 @@ type Querier interface {
 	//    AND p.deleted_at IS NULL
 	//    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
 	GetUserProfilePermissions(ctx context.Context, arg GetUserProfilePermissionsParams) ([]*GetUserProfilePermissionsRow, error)
+	//GetWebhookDeliveryByID
+	//
+	//  SELECT id, source, event_type, target_url, payload, status, attempt_count, last_response_code, last_attempted_at, created_at, endpoint_id
+	//  FROM "webhook_delivery"
+	//  WHERE id = $1
+	//  LIMIT 1
+	GetWebhookDeliveryByID(ctx context.Context, arg GetWebhookDeliveryByIDParams) (*WebhookDelivery, error)
+	//GetWebmentionByID
+	//
+	//  SELECT id, profile_id, source_url, target_url, status, verified_at, created_at, updated_at
+	//  FROM "webmention"
+	//  WHERE id = $1
+	//  LIMIT 1
+	GetWebmentionByID(ctx context.Context, arg GetWebmentionByIDParams) (*Webmention, error)
 	//IncrementDiscussionCommentReplyCount
 	//
 	//  UPDATE "discussion_comment"
@@ -2251,6 +2728,13 @@ type Querier interface {
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	IncrementProfileQuestionVoteCount(ctx context.Context, arg IncrementProfileQuestionVoteCountParams) error
+	// Increments the deduplicated view counter of a story.
+	//
+	//  INSERT INTO "story_view_stat" (story_id, view_count, updated_at)
+	//  VALUES ($1, 1, NOW())
+	//  ON CONFLICT (story_id)
+	//  DO UPDATE SET view_count = "story_view_stat".view_count + 1, updated_at = NOW()
+	IncrementStoryViewCount(ctx context.Context, arg IncrementStoryViewCountParams) error
 	//InsertCandidateTeam
 	//
 	//  INSERT INTO "profile_membership_candidate_team" (
@@ -2346,6 +2830,66 @@ type Querier interface {
 	//    $9
 	//  ) ON CONFLICT (id) DO NOTHING
 	InsertEventAuditIdempotent(ctx context.Context, arg InsertEventAuditIdempotentParams) error
+	//InsertProfileLinkClick
+	//
+	//  INSERT INTO "profile_link_click" (id, profile_link_id, visitor_user_id, created_at)
+	//  VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    NOW()
+	//  )
+	InsertProfileLinkClick(ctx context.Context, arg InsertProfileLinkClickParams) error
+	//InsertProfileMention
+	//
+	//  INSERT INTO "profile_mention" (
+	//    id, source_kind, source_id, source_profile_id, mentioned_profile_id, locale_code, created_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6,
+	//    NOW()
+	//  ) ON CONFLICT (source_kind, source_id, locale_code, mentioned_profile_id) DO NOTHING
+	InsertProfileMention(ctx context.Context, arg InsertProfileMentionParams) error
+	//InsertProfilePageTranslationJob
+	//
+	//  INSERT INTO "profile_page_translation_job" (
+	//    id,
+	//    page_id,
+	//    user_id,
+	//    source_locale,
+	//    total,
+	//    created_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    NOW()
+	//  )
+	InsertProfilePageTranslationJob(ctx context.Context, arg InsertProfilePageTranslationJobParams) error
+	// Does nothing when the locale already has a result, so a retried queue item
+	// keeps the first recorded outcome.
+	//
+	//  INSERT INTO "profile_page_translation_job_result" (
+	//    job_id,
+	//    locale_code,
+	//    status,
+	//    reason,
+	//    created_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    NOW()
+	//  )
+	//  ON CONFLICT (job_id, locale_code) DO NOTHING
+	InsertProfilePageTranslationJobResult(ctx context.Context, arg InsertProfilePageTranslationJobResultParams) (int64, error)
 	//InsertProfileQuestion
 	//
 	//  INSERT INTO "profile_question" (
@@ -2362,7 +2906,7 @@ type Querier interface {
 	//    $4,
 	//    $5,
 	//    NOW()
-	//  ) RETURNING id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at
+	//  ) RETURNING id, profile_id, author_user_id, content, answer_content, answer_uri, answer_kind, answered_at, answered_by, is_anonymous, is_hidden, vote_count, created_at, updated_at, deleted_at, resolved_at
 	InsertProfileQuestion(ctx context.Context, arg InsertProfileQuestionParams) (*ProfileQuestion, error)
 	//InsertProfileQuestionVote
 	//
@@ -2380,13 +2924,45 @@ type Querier interface {
 	//    NOW()
 	//  ) RETURNING id, question_id, user_id, score, created_at
 	InsertProfileQuestionVote(ctx context.Context, arg InsertProfileQuestionVoteParams) (*ProfileQuestionVote, error)
-	//InsertStory
+	// Does nothing when the reporter already has an open report on the entity.
 	//
-	//  INSERT INTO "story" (
+	//  INSERT INTO "profile_report" (
 	//    id,
-	//    author_profile_id,
-	//    slug,
-	//    kind,
+	//    profile_id,
+	//    target_kind,
+	//    target_id,
+	//    reporter_user_id,
+	//    reporter_key,
+	//    reason,
+	//    text,
+	//    status,
+	//    created_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6,
+	//    $7,
+	//    $8,
+	//    'open',
+	//    NOW()
+	//  )
+	//  ON CONFLICT (target_kind, target_id, reporter_key) WHERE status = 'open' DO NOTHING
+	InsertProfileReport(ctx context.Context, arg InsertProfileReportParams) (int64, error)
+	//InsertProfileSlugHistory
+	//
+	//  INSERT INTO "profile_slug_history" (id, profile_id, slug, created_at)
+	//  VALUES ($1, $2, $3, NOW())
+	InsertProfileSlugHistory(ctx context.Context, arg InsertProfileSlugHistoryParams) error
+	//InsertStory
+	//
+	//  INSERT INTO "story" (
+	//    id,
+	//    author_profile_id,
+	//    slug,
+	//    kind,
 	//    story_picture_uri,
 	//    properties,
 	//    is_managed,
@@ -2488,6 +3064,21 @@ type Querier interface {
 	//    $6
 	//  )
 	InsertStoryTx(ctx context.Context, arg InsertStoryTxParams) error
+	//InsertUserProfileBlock
+	//
+	//  INSERT INTO "user_profile_block" (user_id, profile_id, created_at)
+	//  VALUES ($1, $2, NOW())
+	//  ON CONFLICT (user_id, profile_id) DO NOTHING
+	InsertUserProfileBlock(ctx context.Context, arg InsertUserProfileBlockParams) (int64, error)
+	//IsMembershipInProfileTeam
+	//
+	//  SELECT EXISTS(
+	//    SELECT 1 FROM "profile_membership_team"
+	//    WHERE profile_team_id = $1
+	//      AND profile_membership_id = $2
+	//      AND deleted_at IS NULL
+	//  ) AS is_member
+	IsMembershipInProfileTeam(ctx context.Context, arg IsMembershipInProfileTeamParams) (bool, error)
 	// Returns the is_managed flag for a specific story translation.
 	// Used to gate editing: managed translations cannot be modified by users.
 	//
@@ -2570,11 +3161,102 @@ type Querier interface {
 	//    AND ($2::CHAR(26) IS NULL OR s.author_profile_id = $2::CHAR(26))
 	//  ORDER BY (s.properties->>'activity_time_start') DESC NULLS LAST
 	ListActivityStories(ctx context.Context, arg ListActivityStoriesParams) ([]*ListActivityStoriesRow, error)
+	// Lists published stories authored by or published to any of the given profiles.
+	// Visibility follows ListStoriesOfPublicationForViewer: private stories only for
+	// authorized viewers, unlisted stories never.
+	// Newest first, keyset-paginated on (first publication time, story id).
+	//
+	//  SELECT
+	//    s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
+	//    st.story_id, st.locale_code, st.title, st.summary, st.content, st.search_vector, st.is_managed, st.summary_ai,
+	//    p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
+	//    p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
+	//    pb.publications,
+	//    fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+	//  FROM "story" s
+	//    INNER JOIN LATERAL (
+	//      SELECT MIN(sp0.published_at) AS first_published_at
+	//      FROM story_publication sp0
+	//      WHERE sp0.story_id = s.id
+	//        AND sp0.published_at IS NOT NULL
+	//        AND sp0.deleted_at IS NULL
+	//    ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+	//    INNER JOIN "story_tx" st ON st.story_id = s.id
+	//    AND st.locale_code = (
+	//      SELECT stx.locale_code FROM "story_tx" stx
+	//      WHERE stx.story_id = s.id
+	//      ORDER BY CASE
+	//        WHEN stx.locale_code = $1 THEN 0
+	//        WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+	//        ELSE 2
+	//      END
+	//      LIMIT 1
+	//    )
+	//    LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+	//    AND p1.approved_at IS NOT NULL
+	//    AND p1.deleted_at IS NULL
+	//    INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+	//    AND p1t.locale_code = (
+	//      SELECT ptx.locale_code FROM "profile_tx" ptx
+	//      WHERE ptx.profile_id = p1.id
+	//      ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
+	//      LIMIT 1
+	//    )
+	//    LEFT JOIN LATERAL (
+	//      SELECT JSONB_AGG(
+	//        JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+	//      ) AS "publications"
+	//      FROM story_publication sp
+	//        INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+	//        AND p2.approved_at IS NOT NULL
+	//        AND p2.deleted_at IS NULL
+	//        INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+	//        AND p2t.locale_code = (
+	//          SELECT ptx2.locale_code FROM "profile_tx" ptx2
+	//          WHERE ptx2.profile_id = p2.id
+	//          ORDER BY CASE WHEN ptx2.locale_code = $1 THEN 0 ELSE 1 END
+	//          LIMIT 1
+	//        )
+	//      WHERE sp.story_id = s.id
+	//        AND sp.deleted_at IS NULL
+	//    ) pb ON TRUE
+	//    LEFT JOIN "user" u ON u.id = $2::CHAR(26)
+	//    LEFT JOIN "profile_membership" pm ON s.author_profile_id = pm.profile_id
+	//      AND pm.member_profile_id = u.individual_profile_id
+	//      AND pm.deleted_at IS NULL
+	//      AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+	//  WHERE
+	//    pb.publications IS NOT NULL
+	//    AND s.visibility != 'unlisted'
+	//    AND (
+	//      s.visibility = 'public'
+	//      OR u.kind = 'admin'
+	//      OR s.author_profile_id = u.individual_profile_id
+	//      OR pm.kind IN ('owner', 'lead', 'maintainer')
+	//    )
+	//    AND s.deleted_at IS NULL
+	//    AND (
+	//      s.author_profile_id = ANY($3::TEXT[])
+	//      OR EXISTS (
+	//        SELECT 1 FROM story_publication sp5
+	//        WHERE sp5.story_id = s.id
+	//          AND sp5.profile_id = ANY($3::TEXT[])
+	//          AND sp5.deleted_at IS NULL
+	//      )
+	//    )
+	//    AND (
+	//      $4::TIMESTAMP WITH TIME ZONE IS NULL
+	//      OR (fp.first_published_at, s.id) < ($4::TIMESTAMP WITH TIME ZONE, $5::CHAR(26))
+	//    )
+	//  ORDER BY fp.first_published_at DESC, s.id DESC
+	//  LIMIT $6
+	ListAggregatedStoriesForViewer(ctx context.Context, arg ListAggregatedStoriesForViewerParams) ([]*ListAggregatedStoriesForViewerRow, error)
 	//ListAllCustomDomains
 	//
 	//  SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 	//         pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+	//         pcd.created_at, pcd.updated_at
 	//  FROM "profile_custom_domain" pcd
 	//  ORDER BY pcd.created_at
 	ListAllCustomDomains(ctx context.Context) ([]*ListAllCustomDomainsRow, error)
@@ -2611,7 +3293,9 @@ type Querier interface {
 	//      )
 	//  WHERE pl.profile_id = $2
 	//    AND pl.deleted_at IS NULL
-	//  ORDER BY pl."order"
+	//  ORDER BY pl."order", pl.id
+	//  LIMIT $4
+	//  OFFSET $3
 	ListAllProfileLinksByProfileID(ctx context.Context, arg ListAllProfileLinksByProfileIDParams) ([]*ListAllProfileLinksByProfileIDRow, error)
 	//ListAllProfilesForAdmin
 	//
@@ -2652,6 +3336,12 @@ type Querier interface {
 	//  WHERE form_id = $1
 	//  ORDER BY sort_order ASC
 	ListApplicationFormFields(ctx context.Context, arg ListApplicationFormFieldsParams) ([]*ProfileApplicationFormField, error)
+	//ListBlockedProfileIDsByUser
+	//
+	//  SELECT profile_id
+	//  FROM "user_profile_block"
+	//  WHERE user_id = $1
+	ListBlockedProfileIDsByUser(ctx context.Context, arg ListBlockedProfileIDsByUserParams) ([]string, error)
 	//ListCandidateResponses
 	//
 	//  SELECT
@@ -2667,7 +3357,7 @@ type Querier interface {
 	ListCandidateResponses(ctx context.Context, arg ListCandidateResponsesParams) ([]*ListCandidateResponsesRow, error)
 	//ListCandidateTeams
 	//
-	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 	//  JOIN "profile_membership_candidate_team" pmrt
 	//    ON pmrt.profile_team_id = pt.id AND pmrt.deleted_at IS NULL
 	//  WHERE pmrt.candidate_id = $1
@@ -2738,6 +3428,13 @@ type Querier interface {
 	//  LIMIT $7
 	//  OFFSET $6
 	ListChildDiscussionComments(ctx context.Context, arg ListChildDiscussionCommentsParams) ([]*ListChildDiscussionCommentsRow, error)
+	//ListConsistencyChecks
+	//
+	//  SELECT id, requested_by_user_id, repair, status, findings, error_message, created_at, completed_at
+	//  FROM "consistency_check"
+	//  ORDER BY created_at DESC, id DESC
+	//  LIMIT $1
+	ListConsistencyChecks(ctx context.Context, arg ListConsistencyChecksParams) ([]*ConsistencyCheck, error)
 	//ListConversationsForProfile
 	//
 	//  SELECT
@@ -2788,11 +3485,23 @@ type Querier interface {
 	//
 	//  SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 	//         pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+	//         pcd.created_at, pcd.updated_at
 	//  FROM "profile_custom_domain" pcd
 	//  WHERE pcd.profile_id = $1
 	//  ORDER BY pcd.created_at
 	ListCustomDomainsByProfileID(ctx context.Context, arg ListCustomDomainsByProfileIDParams) ([]*ListCustomDomainsByProfileIDRow, error)
+	//ListDueDraftProfilePages
+	//
+	//  SELECT id, profile_id, slug, published_at
+	//  FROM "profile_page"
+	//  WHERE visibility = 'draft'
+	//    AND published_at IS NOT NULL
+	//    AND published_at <= $1::TIMESTAMP WITH TIME ZONE
+	//    AND deleted_at IS NULL
+	//  ORDER BY published_at, id
+	//  LIMIT $2
+	ListDueDraftProfilePages(ctx context.Context, arg ListDueDraftProfilePagesParams) ([]*ListDueDraftProfilePagesRow, error)
 	//ListEnvelopesByConversation
 	//
 	//  SELECT
@@ -2818,6 +3527,34 @@ type Querier interface {
 	//  ORDER BY created_at DESC
 	//  LIMIT $3
 	ListEventAuditByEntity(ctx context.Context, arg ListEventAuditByEntityParams) ([]*EventAudit, error)
+	// Pages through the audit events created in [from_time, to_time) in
+	// (created_at, id) order. Pass the last row of the previous page as the cursor.
+	//
+	//  SELECT id, event_type, entity_type, entity_id, actor_id, actor_kind, session_id, payload, created_at
+	//  FROM "event_audit"
+	//  WHERE created_at >= $1
+	//    AND created_at < $2
+	//    AND ($3::TEXT IS NULL OR event_type = $3::TEXT)
+	//    AND (
+	//      $4::TIMESTAMPTZ IS NULL
+	//      OR (created_at, id) > ($4::TIMESTAMPTZ, $5::TEXT)
+	//    )
+	//  ORDER BY created_at, id
+	//  LIMIT $6
+	ListEventAuditInRange(ctx context.Context, arg ListEventAuditInRangeParams) ([]*EventAudit, error)
+	//ListExpiredVotingCandidates
+	//
+	//  SELECT pmc.id, pmc.profile_id, p.slug AS profile_slug, pmc.referred_profile_id, pmc.source,
+	//    pmc.voting_deadline
+	//  FROM "profile_membership_candidate" pmc
+	//    INNER JOIN "profile" p ON p.id = pmc.profile_id AND p.deleted_at IS NULL
+	//  WHERE pmc.status = 'voting'
+	//    AND pmc.voting_deadline IS NOT NULL
+	//    AND pmc.voting_deadline <= $1::TIMESTAMP WITH TIME ZONE
+	//    AND pmc.deleted_at IS NULL
+	//  ORDER BY pmc.voting_deadline, pmc.id
+	//  LIMIT $2
+	ListExpiredVotingCandidates(ctx context.Context, arg ListExpiredVotingCandidatesParams) ([]*ListExpiredVotingCandidatesRow, error)
 	//ListFeaturedProfileLinksByProfileID
 	//
 	//  SELECT
@@ -2854,6 +3591,91 @@ type Querier interface {
 	//    AND pl.deleted_at IS NULL
 	//  ORDER BY pl."order"
 	ListFeaturedProfileLinksByProfileID(ctx context.Context, arg ListFeaturedProfileLinksByProfileIDParams) ([]*ListFeaturedProfileLinksByProfileIDRow, error)
+	// Lists public, published stories authored by or published to profiles the viewer follows.
+	// Newest first, keyset-paginated on (first publication time, story id).
+	//
+	//  SELECT
+	//    s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
+	//    st.story_id, st.locale_code, st.title, st.summary, st.content, st.search_vector, st.is_managed, st.summary_ai,
+	//    p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
+	//    p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
+	//    pb.publications,
+	//    fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+	//  FROM "story" s
+	//    INNER JOIN LATERAL (
+	//      SELECT MIN(sp0.published_at) AS first_published_at
+	//      FROM story_publication sp0
+	//      WHERE sp0.story_id = s.id
+	//        AND sp0.published_at IS NOT NULL
+	//        AND sp0.deleted_at IS NULL
+	//    ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+	//    INNER JOIN "story_tx" st ON st.story_id = s.id
+	//    AND st.locale_code = (
+	//      SELECT stx.locale_code FROM "story_tx" stx
+	//      WHERE stx.story_id = s.id
+	//      ORDER BY CASE
+	//        WHEN stx.locale_code = $1 THEN 0
+	//        WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+	//        ELSE 2
+	//      END
+	//      LIMIT 1
+	//    )
+	//    LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+	//    AND p1.approved_at IS NOT NULL
+	//    AND p1.deleted_at IS NULL
+	//    INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+	//    AND p1t.locale_code = (
+	//      SELECT ptx.locale_code FROM "profile_tx" ptx
+	//      WHERE ptx.profile_id = p1.id
+	//      ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
+	//      LIMIT 1
+	//    )
+	//    LEFT JOIN LATERAL (
+	//      SELECT JSONB_AGG(
+	//        JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+	//      ) AS "publications"
+	//      FROM story_publication sp
+	//        INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+	//        AND p2.approved_at IS NOT NULL
+	//        AND p2.deleted_at IS NULL
+	//        INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+	//        AND p2t.locale_code = (
+	//          SELECT ptx2.locale_code FROM "profile_tx" ptx2
+	//          WHERE ptx2.profile_id = p2.id
+	//          ORDER BY CASE WHEN ptx2.locale_code = $1 THEN 0 ELSE 1 END
+	//          LIMIT 1
+	//        )
+	//      WHERE sp.story_id = s.id
+	//        AND sp.deleted_at IS NULL
+	//    ) pb ON TRUE
+	//  WHERE
+	//    pb.publications IS NOT NULL
+	//    AND s.visibility = 'public'
+	//    AND s.deleted_at IS NULL
+	//    AND EXISTS (
+	//      SELECT 1 FROM "profile_membership" pm
+	//        INNER JOIN "user" u ON u.individual_profile_id = pm.member_profile_id
+	//      WHERE u.id = $2
+	//        AND pm.kind = 'follower'
+	//        AND pm.deleted_at IS NULL
+	//        AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+	//        AND (
+	//          pm.profile_id = s.author_profile_id
+	//          OR EXISTS (
+	//            SELECT 1 FROM story_publication sp5
+	//            WHERE sp5.story_id = s.id
+	//              AND sp5.profile_id = pm.profile_id
+	//              AND sp5.deleted_at IS NULL
+	//          )
+	//        )
+	//    )
+	//    AND (
+	//      $3::TIMESTAMP WITH TIME ZONE IS NULL
+	//      OR (fp.first_published_at, s.id) < ($3::TIMESTAMP WITH TIME ZONE, $4::CHAR(26))
+	//    )
+	//  ORDER BY fp.first_published_at DESC, s.id DESC
+	//  LIMIT $5
+	ListFollowedStoriesFeed(ctx context.Context, arg ListFollowedStoriesFeedParams) ([]*ListFollowedStoriesFeedRow, error)
 	//ListGitHubResourcesForSync
 	//
 	//  SELECT
@@ -2907,6 +3729,26 @@ type Querier interface {
 	//  ORDER BY pli.created_at ASC
 	//  LIMIT $2
 	ListImportsWithExistingStories(ctx context.Context, arg ListImportsWithExistingStoriesParams) ([]*ListImportsWithExistingStoriesRow, error)
+	// Individual profiles missing the self-owner membership created at signup,
+	// along with the user that has the profile as its individual profile (if any).
+	//
+	//  SELECT
+	//    p.id AS profile_id,
+	//    u.id AS user_id
+	//  FROM "profile" p
+	//    LEFT JOIN "user" u ON u.individual_profile_id = p.id AND u.deleted_at IS NULL
+	//  WHERE p.kind = 'individual'
+	//    AND p.deleted_at IS NULL
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_membership" pm
+	//      WHERE pm.profile_id = p.id
+	//        AND pm.member_profile_id = p.id
+	//        AND pm.kind = 'owner'
+	//        AND pm.deleted_at IS NULL
+	//    )
+	//  ORDER BY p.id
+	//  LIMIT $1
+	ListIndividualProfilesWithoutOwnerMembership(ctx context.Context, arg ListIndividualProfilesWithoutOwnerMembershipParams) ([]*ListIndividualProfilesWithoutOwnerMembershipRow, error)
 	//ListLinkImportsForStoryCreation
 	//
 	//  SELECT
@@ -2981,11 +3823,12 @@ type Querier interface {
 	//    INNER JOIN "profile" p ON p.id = pl.profile_id
 	//      AND p.deleted_at IS NULL
 	//  WHERE pl.kind = $1
+	//    AND ($2::TEXT IS NULL OR pl.profile_id = $2::TEXT)
 	//    AND pl.is_managed = TRUE
 	//    AND pl.auth_access_token IS NOT NULL
 	//    AND pl.deleted_at IS NULL
 	//  ORDER BY pl.updated_at ASC NULLS FIRST
-	//  LIMIT $2
+	//  LIMIT $3
 	ListManagedLinksForKind(ctx context.Context, arg ListManagedLinksForKindParams) ([]*ListManagedLinksForKindRow, error)
 	// For non-OAuth managed links (e.g. SpeakerDeck) that don't require auth tokens.
 	//
@@ -3000,11 +3843,23 @@ type Querier interface {
 	//    INNER JOIN "profile" p ON p.id = pl.profile_id
 	//      AND p.deleted_at IS NULL
 	//  WHERE pl.kind = $1
+	//    AND ($2::TEXT IS NULL OR pl.profile_id = $2::TEXT)
 	//    AND pl.is_managed = TRUE
 	//    AND pl.deleted_at IS NULL
 	//  ORDER BY pl.updated_at ASC NULLS FIRST
-	//  LIMIT $2
+	//  LIMIT $3
 	ListManagedLinksForKindPublic(ctx context.Context, arg ListManagedLinksForKindPublicParams) ([]*ListManagedLinksForKindPublicRow, error)
+	//ListManagedProfileLinkAccounts
+	//
+	//  SELECT kind, remote_id
+	//  FROM "profile_link"
+	//  WHERE profile_id = $1
+	//    AND kind = ANY($2::TEXT[])
+	//    AND is_managed = true
+	//    AND remote_id IS NOT NULL
+	//    AND deleted_at IS NULL
+	//  ORDER BY "order", created_at
+	ListManagedProfileLinkAccounts(ctx context.Context, arg ListManagedProfileLinkAccountsParams) ([]*ListManagedProfileLinkAccountsRow, error)
 	//ListManagedTelegramLinks
 	//
 	//  SELECT
@@ -3023,7 +3878,7 @@ type Querier interface {
 	ListManagedTelegramLinks(ctx context.Context, arg ListManagedTelegramLinksParams) ([]*ListManagedTelegramLinksRow, error)
 	//ListMembershipTeams
 	//
-	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 	//  JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 	//  WHERE pmt.profile_membership_id = $1 AND pt.deleted_at IS NULL
 	//  ORDER BY pt.name ASC
@@ -3068,6 +3923,76 @@ type Querier interface {
 	//    AND pl.deleted_at IS NULL
 	//  ORDER BY pl.updated_at DESC
 	ListOnlineProfileLinks(ctx context.Context, arg ListOnlineProfileLinksParams) ([]*ListOnlineProfileLinksRow, error)
+	//ListOrganizationGitHubLinksForSync
+	//
+	//  SELECT
+	//    pl.id as link_id,
+	//    pl.profile_id,
+	//    pl.public_id as account_login,
+	//    pl.auth_access_token
+	//  FROM "profile_link" pl
+	//    INNER JOIN "profile" p ON p.id = pl.profile_id
+	//      AND p.kind = 'organization'
+	//      AND p.deleted_at IS NULL
+	//  WHERE pl.kind = 'github'
+	//    AND pl.is_managed = true
+	//    AND pl.public_id IS NOT NULL
+	//    AND pl.auth_access_token IS NOT NULL
+	//    AND pl.deleted_at IS NULL
+	//  ORDER BY pl.created_at ASC
+	//  LIMIT $1
+	ListOrganizationGitHubLinksForSync(ctx context.Context, arg ListOrganizationGitHubLinksForSyncParams) ([]*ListOrganizationGitHubLinksForSyncRow, error)
+	// Lists public, published profile pages that mention a profile (one row per page).
+	//
+	//  SELECT DISTINCT ON (pp.id)
+	//    pp.id AS source_id,
+	//    pp.slug AS source_slug,
+	//    ppt.title AS source_title,
+	//    ppt.locale_code,
+	//    p.slug AS source_profile_slug,
+	//    pt.title AS source_profile_title,
+	//    pm.created_at
+	//  FROM "profile_mention" pm
+	//    INNER JOIN "profile_page" pp ON pp.id = pm.source_id
+	//      AND pp.visibility = 'public'
+	//      AND pp.deleted_at IS NULL
+	//    INNER JOIN "profile" p ON p.id = pp.profile_id
+	//      AND p.deleted_at IS NULL
+	//    INNER JOIN "profile_page_tx" ppt ON ppt.profile_page_id = pp.id
+	//      AND ppt.locale_code = (
+	//        SELECT pptf.locale_code FROM "profile_page_tx" pptf
+	//        WHERE pptf.profile_page_id = pp.id
+	//        ORDER BY CASE
+	//          WHEN pptf.locale_code = $1 THEN 0
+	//          WHEN pptf.locale_code = p.default_locale THEN 1
+	//          ELSE 2
+	//        END
+	//        LIMIT 1
+	//      )
+	//    INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+	//      AND pt.locale_code = (
+	//        SELECT ptx.locale_code FROM "profile_tx" ptx
+	//        WHERE ptx.profile_id = p.id
+	//        ORDER BY CASE
+	//          WHEN ptx.locale_code = $1 THEN 0
+	//          WHEN ptx.locale_code = p.default_locale THEN 1
+	//          ELSE 2
+	//        END
+	//        LIMIT 1
+	//      )
+	//  WHERE pm.mentioned_profile_id = $2
+	//    AND pm.source_kind = 'page'
+	//    AND (pp.published_at IS NULL OR pp.published_at <= NOW())
+	//  ORDER BY pp.id, pm.created_at DESC
+	//  LIMIT $3
+	ListPageMentionsOfProfile(ctx context.Context, arg ListPageMentionsOfProfileParams) ([]*ListPageMentionsOfProfileRow, error)
+	// Returns the pages, deleted ones included, that use any of the given slugs.
+	//
+	//  SELECT id, slug, deleted_at
+	//  FROM "profile_page"
+	//  WHERE profile_id = $1
+	//    AND slug = ANY($2::TEXT[])
+	ListPageSlugUsagesByProfileID(ctx context.Context, arg ListPageSlugUsagesByProfileIDParams) ([]*ListPageSlugUsagesByProfileIDRow, error)
 	//ListPendingAwards
 	//
 	//  SELECT id, target_profile_id, triggering_event, description, amount, status, reviewed_by, reviewed_at, rejection_reason, metadata, created_at
@@ -3084,10 +4009,35 @@ type Querier interface {
 	//  ORDER BY created_at DESC
 	//  LIMIT $2
 	ListPendingAwardsByStatus(ctx context.Context, arg ListPendingAwardsByStatusParams) ([]*ProfilePointPendingAward, error)
+	// Returns the unexpired pending invitations a profile has sent or received.
+	//
+	//  SELECT id, profile_id, invitee_profile_id, kind, status, invited_by_user_id, expires_at, responded_at, created_at
+	//  FROM "profile_membership_invitation"
+	//  WHERE (profile_id = $1 OR invitee_profile_id = $1)
+	//    AND status = 'pending'
+	//    AND expires_at > NOW()
+	//  ORDER BY created_at DESC
+	ListPendingProfileMembershipInvitations(ctx context.Context, arg ListPendingProfileMembershipInvitationsParams) ([]*ProfileMembershipInvitation, error)
+	//ListProfileLinkIDsByProfileID
+	//
+	//  SELECT id FROM "profile_link"
+	//  WHERE profile_id = $1
+	//    AND deleted_at IS NULL
+	ListProfileLinkIDsByProfileID(ctx context.Context, arg ListProfileLinkIDsByProfileIDParams) ([]string, error)
+	//ListProfileLinkTxsByProfileLocale
+	//
+	//  SELECT plt.profile_link_id, plt.locale_code, plt.title, plt."group", plt.description, plt.icon
+	//  FROM "profile_link_tx" plt
+	//    INNER JOIN "profile_link" pl ON pl.id = plt.profile_link_id
+	//  WHERE pl.profile_id = $1
+	//    AND pl.deleted_at IS NULL
+	//    AND plt.locale_code = $2
+	//  ORDER BY pl."order", pl.id
+	ListProfileLinkTxsByProfileLocale(ctx context.Context, arg ListProfileLinkTxsByProfileLocaleParams) ([]*ProfileLinkTx, error)
 	//ListProfileLinksByProfileID
 	//
 	//  SELECT
-	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
 	//    COALESCE(plt.locale_code, p.default_locale) as locale_code,
 	//    COALESCE(plt.title, pl.kind) as title,
 	//    COALESCE(plt.icon, '') as icon,
@@ -3117,10 +4067,36 @@ type Querier interface {
 	//    AND pl.deleted_at IS NULL
 	//  ORDER BY pl."order"
 	ListProfileLinksByProfileID(ctx context.Context, arg ListProfileLinksByProfileIDParams) ([]*ListProfileLinksByProfileIDRow, error)
+	//ListProfileLinksByProfileIDForEditing
+	//
+	//  SELECT
+	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
+	//    COALESCE(plt.locale_code, p.default_locale) as locale_code,
+	//    COALESCE(plt.title, pl.kind) as title,
+	//    COALESCE(plt.icon, '') as icon,
+	//    COALESCE(plt."group", '') as "group",
+	//    COALESCE(plt.description, '') as description
+	//  FROM "profile_link" pl
+	//    INNER JOIN "profile" p ON p.id = pl.profile_id
+	//    LEFT JOIN "profile_link_tx" plt ON plt.profile_link_id = pl.id
+	//      AND plt.locale_code = (
+	//        SELECT pltf.locale_code FROM "profile_link_tx" pltf
+	//        WHERE pltf.profile_link_id = pl.id
+	//        ORDER BY CASE
+	//          WHEN pltf.locale_code = $1 THEN 0
+	//          WHEN pltf.locale_code = p.default_locale THEN 1
+	//          ELSE 2
+	//        END
+	//        LIMIT 1
+	//      )
+	//  WHERE pl.profile_id = $2
+	//    AND pl.deleted_at IS NULL
+	//  ORDER BY pl."order"
+	ListProfileLinksByProfileIDForEditing(ctx context.Context, arg ListProfileLinksByProfileIDForEditingParams) ([]*ListProfileLinksByProfileIDForEditingRow, error)
 	//ListProfileLinksForKind
 	//
 	//  SELECT
-	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online,
+	//    pl.id, pl.profile_id, pl.kind, pl."order", pl.is_managed, pl.is_verified, pl.remote_id, pl.public_id, pl.uri, pl.auth_provider, pl.auth_access_token_scope, pl.auth_access_token, pl.auth_access_token_expires_at, pl.auth_refresh_token, pl.auth_refresh_token_expires_at, pl.properties, pl.created_at, pl.updated_at, pl.deleted_at, pl.visibility, pl.is_featured, pl.added_by_profile_id, pl.is_online, pl.sync_status, pl.sync_error, pl.sync_attempted_at,
 	//    COALESCE(plt.locale_code, p.default_locale) as locale_code,
 	//    COALESCE(plt.title, pl.kind) as title,
 	//    COALESCE(plt.icon, '') as icon,
@@ -3144,10 +4120,24 @@ type Querier interface {
 	//    AND pl.deleted_at IS NULL
 	//  ORDER BY pl."order"
 	ListProfileLinksForKind(ctx context.Context, arg ListProfileLinksForKindParams) ([]*ListProfileLinksForKindRow, error)
+	//ListProfileLinksForPostImport
+	//
+	//  SELECT
+	//    pl.id,
+	//    pl.profile_id,
+	//    pl.remote_id,
+	//    pl.auth_access_token
+	//  FROM "profile_link" pl
+	//    INNER JOIN "profile" p ON p.id = pl.profile_id
+	//      AND p.deleted_at IS NULL
+	//  WHERE pl.kind = $1
+	//    AND pl.deleted_at IS NULL
+	//  ORDER BY pl.profile_id, pl."order"
+	ListProfileLinksForPostImport(ctx context.Context, arg ListProfileLinksForPostImportParams) ([]*ListProfileLinksForPostImportRow, error)
 	//ListProfileMembershipCandidatesByProfileID
 	//
 	//  SELECT
-	//    pmr.id, pmr.profile_id, pmr.referred_profile_id, pmr.referrer_membership_id, pmr.status, pmr.vote_count, pmr.created_at, pmr.updated_at, pmr.deleted_at, pmr.source, pmr.applicant_message,
+	//    pmr.id, pmr.profile_id, pmr.referred_profile_id, pmr.referrer_membership_id, pmr.status, pmr.vote_count, pmr.created_at, pmr.updated_at, pmr.deleted_at, pmr.source, pmr.applicant_message, pmr.voting_deadline,
 	//    ref_p.slug AS referrer_profile_slug,
 	//    ref_p.kind AS referrer_profile_kind,
 	//    ref_p.profile_picture_uri AS referrer_profile_picture_uri,
@@ -3213,7 +4203,7 @@ type Querier interface {
 	//ListProfileMemberships
 	//
 	//  SELECT
-	//    pm.id, pm.profile_id, pm.member_profile_id, pm.kind, pm.properties, pm.started_at, pm.finished_at, pm.deleted_at,
+	//    pm.id, pm.profile_id, pm.member_profile_id, pm.kind, pm.properties, pm.started_at, pm.finished_at, pm.deleted_at, pm.aggregate_stories,
 	//    p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
 	//    p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
 	//    p2.id, p2.slug, p2.kind, p2.profile_picture_uri, p2.pronouns, p2.properties, p2.created_at, p2.updated_at, p2.deleted_at, p2.approved_at, p2.points, p2.feature_relations, p2.feature_links, p2.default_locale, p2.feature_qa, p2.feature_discussions, p2.option_story_discussions_by_default, p2.feature_referrals, p2.feature_applications,
@@ -3297,12 +4287,40 @@ type Querier interface {
 	//    END,
 	//    pm.started_at ASC
 	ListProfileMembershipsForSettings(ctx context.Context, arg ListProfileMembershipsForSettingsParams) ([]*ListProfileMembershipsForSettingsRow, error)
+	// Lists the profiles mentioned by a source in a specific locale.
+	//
+	//  SELECT pm.mentioned_profile_id, p.slug
+	//  FROM "profile_mention" pm
+	//    INNER JOIN "profile" p ON p.id = pm.mentioned_profile_id
+	//      AND p.deleted_at IS NULL
+	//  WHERE pm.source_kind = $1
+	//    AND pm.source_id = $2
+	//    AND pm.locale_code = $3
+	//  ORDER BY p.slug
+	ListProfileMentionsBySource(ctx context.Context, arg ListProfileMentionsBySourceParams) ([]*ListProfileMentionsBySourceRow, error)
+	//ListProfilePageTranslationJobResults
+	//
+	//  SELECT job_id, locale_code, status, reason, created_at
+	//  FROM "profile_page_translation_job_result"
+	//  WHERE job_id = $1
+	//  ORDER BY created_at, locale_code
+	ListProfilePageTranslationJobResults(ctx context.Context, arg ListProfilePageTranslationJobResultsParams) ([]*ProfilePageTranslationJobResult, error)
 	//ListProfilePageTxLocales
 	//
 	//  SELECT locale_code FROM "profile_page_tx"
 	//  WHERE profile_page_id = $1
 	//  ORDER BY locale_code
 	ListProfilePageTxLocales(ctx context.Context, arg ListProfilePageTxLocalesParams) ([]string, error)
+	//ListProfilePageTxsByProfileLocale
+	//
+	//  SELECT ppt.profile_page_id, pp.slug, ppt.title, ppt.summary, ppt.content
+	//  FROM "profile_page_tx" ppt
+	//    INNER JOIN "profile_page" pp ON pp.id = ppt.profile_page_id
+	//  WHERE pp.profile_id = $1
+	//    AND pp.deleted_at IS NULL
+	//    AND ppt.locale_code = $2
+	//  ORDER BY pp."order", pp.id
+	ListProfilePageTxsByProfileLocale(ctx context.Context, arg ListProfilePageTxsByProfileLocaleParams) ([]*ListProfilePageTxsByProfileLocaleRow, error)
 	//ListProfilePagesByProfileID
 	//
 	//  SELECT pp.id, pp.profile_id, pp.slug, pp."order", pp.cover_picture_uri, pp.published_at, pp.created_at, pp.updated_at, pp.deleted_at, pp.added_by_profile_id, pp.visibility, ppt.profile_page_id, ppt.locale_code, ppt.title, ppt.summary, ppt.content, ppt.search_vector,
@@ -3372,7 +4390,7 @@ type Querier interface {
 	ListProfilePagesByProfileIDForViewer(ctx context.Context, arg ListProfilePagesByProfileIDForViewerParams) ([]*ListProfilePagesByProfileIDForViewerRow, error)
 	//ListProfilePointTransactionsByProfileID
 	//
-	//  SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+	//  SELECT id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 	//  FROM "profile_point_transaction"
 	//  WHERE target_profile_id = $1
 	//  ORDER BY created_at DESC
@@ -3381,7 +4399,7 @@ type Querier interface {
 	//ListProfileQuestionsByProfileID
 	//
 	//  SELECT
-	//    pq.id, pq.profile_id, pq.author_user_id, pq.content, pq.answer_content, pq.answer_uri, pq.answer_kind, pq.answered_at, pq.answered_by, pq.is_anonymous, pq.is_hidden, pq.vote_count, pq.created_at, pq.updated_at, pq.deleted_at,
+	//    pq.id, pq.profile_id, pq.author_user_id, pq.content, pq.answer_content, pq.answer_uri, pq.answer_kind, pq.answered_at, pq.answered_by, pq.is_anonymous, pq.is_hidden, pq.vote_count, pq.created_at, pq.updated_at, pq.deleted_at, pq.resolved_at,
 	//    u.individual_profile_id AS author_profile_id,
 	//    ap.slug AS author_profile_slug,
 	//    apt.title AS author_profile_title,
@@ -3427,10 +4445,29 @@ type Querier interface {
 	//    AND ($4::BOOLEAN = TRUE OR pq.is_hidden = FALSE)
 	//  ORDER BY pq.vote_count DESC, pq.created_at DESC
 	ListProfileQuestionsByProfileID(ctx context.Context, arg ListProfileQuestionsByProfileIDParams) ([]*ListProfileQuestionsByProfileIDRow, error)
+	// The moderation queue: reports in a status, oldest first so that reports
+	// waiting the longest are reviewed first.
+	//
+	//  SELECT
+	//    pr.id, pr.profile_id, pr.target_kind, pr.target_id, pr.reporter_user_id, pr.reporter_key, pr.reason, pr.text, pr.status, pr.resolved_by_user_id, pr.resolved_at, pr.created_at,
+	//    p.slug AS profile_slug
+	//  FROM "profile_report" pr
+	//    INNER JOIN "profile" p ON p.id = pr.profile_id
+	//  WHERE pr.status = $1
+	//  ORDER BY pr.created_at ASC, pr.id ASC
+	//  LIMIT $3
+	//  OFFSET $2
+	ListProfileReportsByStatus(ctx context.Context, arg ListProfileReportsByStatusParams) ([]*ListProfileReportsByStatusRow, error)
+	//ListProfileResourceIDsByProfileID
+	//
+	//  SELECT id FROM "profile_resource"
+	//  WHERE profile_id = $1
+	//    AND deleted_at IS NULL
+	ListProfileResourceIDsByProfileID(ctx context.Context, arg ListProfileResourceIDsByProfileIDParams) ([]string, error)
 	//ListProfileResourcesByProfileID
 	//
 	//  SELECT
-	//    pr.id, pr.profile_id, pr.kind, pr.is_managed, pr.remote_id, pr.public_id, pr.url, pr.title, pr.description, pr.properties, pr.added_by_profile_id, pr.created_at, pr.updated_at, pr.deleted_at,
+	//    pr.id, pr.profile_id, pr.kind, pr.is_managed, pr.remote_id, pr.public_id, pr.url, pr.title, pr.description, pr.properties, pr.added_by_profile_id, pr.created_at, pr.updated_at, pr.deleted_at, pr."order", pr.is_featured,
 	//    p.slug as added_by_slug,
 	//    p.kind as added_by_kind,
 	//    COALESCE(pt_added.title, '') as added_by_title,
@@ -3442,26 +4479,50 @@ type Querier interface {
 	//    AND pt_added.locale_code = p.default_locale
 	//  WHERE pr.profile_id = $1
 	//    AND pr.deleted_at IS NULL
-	//  ORDER BY pr.created_at DESC
+	//    AND ($2::TEXT IS NULL OR pr.kind = $2::TEXT)
+	//    AND (
+	//      $3::TEXT IS NULL
+	//      OR EXISTS (
+	//        SELECT 1 FROM "profile_resource_team" prt
+	//        WHERE prt.profile_resource_id = pr.id
+	//          AND prt.profile_team_id = $3::TEXT
+	//          AND prt.deleted_at IS NULL
+	//      )
+	//    )
+	//  ORDER BY
+	//    CASE WHEN $4::TEXT = 'manual' THEN pr.is_featured END DESC,
+	//    CASE WHEN $4::TEXT = 'manual' THEN pr."order" END ASC,
+	//    CASE WHEN $4::TEXT = 'alphabetical' THEN LOWER(pr.title) END ASC,
+	//    pr.created_at DESC
 	ListProfileResourcesByProfileID(ctx context.Context, arg ListProfileResourcesByProfileIDParams) ([]*ListProfileResourcesByProfileIDRow, error)
 	//ListProfileTeams
 	//
-	//  SELECT id, profile_id, name, description, created_at, deleted_at FROM "profile_team"
+	//  SELECT id, profile_id, name, description, created_at, deleted_at, lead_membership_id FROM "profile_team"
 	//  WHERE profile_id = $1 AND deleted_at IS NULL
 	//  ORDER BY name ASC
 	ListProfileTeams(ctx context.Context, arg ListProfileTeamsParams) ([]*ProfileTeam, error)
 	//ListProfileTeamsWithMemberCount
 	//
-	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at,
+	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id,
+	//    lm.member_profile_id AS lead_member_profile_id,
 	//    COUNT(DISTINCT pmt.id) AS member_count,
 	//    COUNT(DISTINCT prt.id) AS resource_count
 	//  FROM "profile_team" pt
+	//  LEFT JOIN "profile_membership" lm ON lm.id = pt.lead_membership_id AND lm.deleted_at IS NULL
 	//  LEFT JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
 	//  LEFT JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 	//  WHERE pt.profile_id = $1 AND pt.deleted_at IS NULL
-	//  GROUP BY pt.id
+	//  GROUP BY pt.id, lm.member_profile_id
 	//  ORDER BY pt.name ASC
 	ListProfileTeamsWithMemberCount(ctx context.Context, arg ListProfileTeamsWithMemberCountParams) ([]*ListProfileTeamsWithMemberCountRow, error)
+	//ListProfileWebhookEndpoints
+	//
+	//  SELECT id, profile_id, target_url, created_at
+	//  FROM "profile_webhook_endpoint"
+	//  WHERE profile_id = $1
+	//    AND deleted_at IS NULL
+	//  ORDER BY created_at, id
+	ListProfileWebhookEndpoints(ctx context.Context, arg ListProfileWebhookEndpointsParams) ([]*ListProfileWebhookEndpointsRow, error)
 	//ListProfiles
 	//
 	//  SELECT p.id, p.slug, p.kind, p.profile_picture_uri, p.pronouns, p.properties, p.created_at, p.updated_at, p.deleted_at, p.approved_at, p.points, p.feature_relations, p.feature_links, p.default_locale, p.feature_qa, p.feature_discussions, p.option_story_discussions_by_default, p.feature_referrals, p.feature_applications, pt.profile_id, pt.locale_code, pt.title, pt.description, pt.properties, pt.search_vector
@@ -3481,12 +4542,63 @@ type Querier interface {
 	//    AND ($3::TEXT IS NULL
 	//         OR normalize_text(pt.title) LIKE '%' || normalize_text($3::TEXT) || '%'
 	//         OR normalize_text(pt.description) LIKE '%' || normalize_text($3::TEXT) || '%')
+	//    AND ($4::BOOLEAN IS NULL
+	//         OR COALESCE(p.properties->'hiring'->'is_hiring' = 'true'::JSONB, FALSE) = $4::BOOLEAN)
+	//    AND ($5::BOOLEAN IS NULL
+	//         OR (p.properties->'availability'->>'status' IS NOT NULL
+	//             AND COALESCE((p.properties->'availability'->>'expires_at')::TIMESTAMPTZ > NOW(), TRUE))
+	//            = $5::BOOLEAN)
 	//    AND p.approved_at IS NOT NULL
 	//    AND p.deleted_at IS NULL
-	//  ORDER BY md5(p.id || $4)
-	//  LIMIT $6
-	//  OFFSET $5
+	//  ORDER BY md5(p.id || $6)
+	//  LIMIT $8
+	//  OFFSET $7
 	ListProfiles(ctx context.Context, arg ListProfilesParams) ([]*ListProfilesRow, error)
+	// Highest points first, keyset-paginated on (points, profile id).
+	//
+	//  SELECT p.id, p.slug, p.kind, p.profile_picture_uri, p.pronouns, p.properties, p.created_at, p.updated_at, p.deleted_at, p.approved_at, p.points, p.feature_relations, p.feature_links, p.default_locale, p.feature_qa, p.feature_discussions, p.option_story_discussions_by_default, p.feature_referrals, p.feature_applications, pt.profile_id, pt.locale_code, pt.title, pt.description, pt.properties, pt.search_vector
+	//  FROM "profile" p
+	//    INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+	//    AND pt.locale_code = (
+	//      SELECT ptf.locale_code FROM "profile_tx" ptf
+	//      WHERE ptf.profile_id = p.id
+	//      ORDER BY CASE
+	//        WHEN ptf.locale_code = $1 THEN 0
+	//        WHEN ptf.locale_code = p.default_locale THEN 1
+	//        ELSE 2
+	//      END
+	//      LIMIT 1
+	//    )
+	//  WHERE ($2::TEXT IS NULL OR p.kind = $2::TEXT)
+	//    AND (
+	//      $3::INTEGER IS NULL
+	//      OR p.points < $3::INTEGER
+	//      OR (p.points = $3::INTEGER AND p.id > $4::CHAR(26))
+	//    )
+	//    AND p.approved_at IS NOT NULL
+	//    AND p.deleted_at IS NULL
+	//  ORDER BY p.points DESC, p.id ASC
+	//  LIMIT $5
+	ListProfilesByPoints(ctx context.Context, arg ListProfilesByPointsParams) ([]*ListProfilesByPointsRow, error)
+	// Resolves mentioned slugs to active profiles.
+	//
+	//  SELECT p.id, p.slug
+	//  FROM "profile" p
+	//  WHERE p.slug = ANY(string_to_array($1::TEXT, ','))
+	//    AND p.deleted_at IS NULL
+	ListProfilesBySlugsForMention(ctx context.Context, arg ListProfilesBySlugsForMentionParams) ([]*ListProfilesBySlugsForMentionRow, error)
+	//ListProfilesWithoutTranslation
+	//
+	//  SELECT p.id AS profile_id
+	//  FROM "profile" p
+	//  WHERE p.deleted_at IS NULL
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_tx" pt
+	//      WHERE pt.profile_id = p.id
+	//    )
+	//  ORDER BY p.id
+	//  LIMIT $1
+	ListProfilesWithoutTranslation(ctx context.Context, arg ListProfilesWithoutTranslationParams) ([]string, error)
 	//ListQueueItemsByType
 	//
 	//  SELECT id, type, payload, status, retry_count, max_retries, visible_at, visibility_timeout_secs, started_at, completed_at, failed_at, created_at, updated_at, error_message, worker_id
@@ -3507,9 +4619,37 @@ type Querier interface {
 	//  WHERE mr.envelope_id = $1
 	//  ORDER BY mr.created_at
 	ListReactionsByEnvelope(ctx context.Context, arg ListReactionsByEnvelopeParams) ([]*ListReactionsByEnvelopeRow, error)
+	//ListRecentProfileViews
+	//
+	//  SELECT
+	//    urpv.viewed_at,
+	//    p.id,
+	//    p.slug,
+	//    p.kind,
+	//    p.profile_picture_uri,
+	//    pt.title,
+	//    pt.description
+	//  FROM "user_recent_profile_view" urpv
+	//    INNER JOIN "profile" p ON p.id = urpv.profile_id
+	//      AND p.deleted_at IS NULL
+	//    INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+	//    AND pt.locale_code = (
+	//      SELECT ptf.locale_code FROM "profile_tx" ptf
+	//      WHERE ptf.profile_id = p.id
+	//      ORDER BY CASE
+	//        WHEN ptf.locale_code = $1 THEN 0
+	//        WHEN ptf.locale_code = p.default_locale THEN 1
+	//        ELSE 2
+	//      END
+	//      LIMIT 1
+	//    )
+	//  WHERE urpv.user_id = $2
+	//  ORDER BY urpv.viewed_at DESC
+	//  LIMIT $3::INT
+	ListRecentProfileViews(ctx context.Context, arg ListRecentProfileViewsParams) ([]*ListRecentProfileViewsRow, error)
 	//ListResourceTeams
 	//
-	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at FROM "profile_team" pt
+	//  SELECT pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
 	//  JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
 	//  WHERE prt.profile_resource_id = $1 AND pt.deleted_at IS NULL
 	//  ORDER BY pt.name ASC
@@ -3538,7 +4678,9 @@ type Querier interface {
 	//    updated_at,
 	//    oauth_provider,
 	//    oauth_access_token,
-	//    oauth_token_scope
+	//    oauth_token_scope,
+	//    impersonator_user_id,
+	//    impersonator_session_id
 	//  FROM
 	//    session
 	//  WHERE
@@ -3856,6 +4998,21 @@ type Querier interface {
 	//    AND s.deleted_at IS NULL
 	//  ORDER BY COALESCE((SELECT MIN(sp4.published_at) FROM story_publication sp4 WHERE sp4.story_id = s.id AND sp4.deleted_at IS NULL), s.created_at) DESC
 	ListStoriesOfPublicationForViewer(ctx context.Context, arg ListStoriesOfPublicationForViewerParams) ([]*ListStoriesOfPublicationForViewerRow, error)
+	// Individual members of a profile who opted in to its aggregated story list.
+	//
+	//  SELECT pm.member_profile_id::CHAR(26) AS member_profile_id
+	//  FROM "profile_membership" pm
+	//    INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+	//    AND mp.kind = 'individual'
+	//    AND mp.approved_at IS NOT NULL
+	//    AND mp.deleted_at IS NULL
+	//  WHERE pm.profile_id = $1
+	//    AND pm.aggregate_stories = TRUE
+	//    AND pm.kind != 'follower'
+	//    AND pm.deleted_at IS NULL
+	//    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+	//  ORDER BY pm.member_profile_id
+	ListStoryAggregationMemberProfileIDs(ctx context.Context, arg ListStoryAggregationMemberProfileIDsParams) ([]string, error)
 	// Lists proposals for a story with proposer profile info and viewer's vote direction.
 	//
 	//  SELECT
@@ -3923,19 +5080,67 @@ type Querier interface {
 	//        ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
 	//        LIMIT 1
 	//      )
-	//  WHERE si.story_id = $2
-	//    AND si.deleted_at IS NULL
-	//    AND ($3::TEXT IS NULL OR si.kind = $3::TEXT)
-	//  ORDER BY si.created_at
-	ListStoryInteractions(ctx context.Context, arg ListStoryInteractionsParams) ([]*ListStoryInteractionsRow, error)
-	// Returns all active interactions a profile has on a specific story.
-	//
-	//  SELECT id, story_id, profile_id, kind, created_at, updated_at, deleted_at FROM "story_interaction"
-	//  WHERE story_id = $1
-	//    AND profile_id = $2
-	//    AND deleted_at IS NULL
-	//  ORDER BY created_at
-	ListStoryInteractionsForProfile(ctx context.Context, arg ListStoryInteractionsForProfileParams) ([]*StoryInteraction, error)
+	//  WHERE si.story_id = $2
+	//    AND si.deleted_at IS NULL
+	//    AND ($3::TEXT IS NULL OR si.kind = $3::TEXT)
+	//  ORDER BY si.created_at
+	ListStoryInteractions(ctx context.Context, arg ListStoryInteractionsParams) ([]*ListStoryInteractionsRow, error)
+	// Returns all active interactions a profile has on a specific story.
+	//
+	//  SELECT id, story_id, profile_id, kind, created_at, updated_at, deleted_at FROM "story_interaction"
+	//  WHERE story_id = $1
+	//    AND profile_id = $2
+	//    AND deleted_at IS NULL
+	//  ORDER BY created_at
+	ListStoryInteractionsForProfile(ctx context.Context, arg ListStoryInteractionsForProfileParams) ([]*StoryInteraction, error)
+	// Lists public, published stories that mention a profile (one row per story).
+	//
+	//  SELECT DISTINCT ON (s.id)
+	//    s.id AS source_id,
+	//    s.slug AS source_slug,
+	//    st.title AS source_title,
+	//    st.locale_code,
+	//    p.slug AS source_profile_slug,
+	//    pt.title AS source_profile_title,
+	//    pm.created_at
+	//  FROM "profile_mention" pm
+	//    INNER JOIN "story" s ON s.id = pm.source_id
+	//      AND s.visibility = 'public'
+	//      AND s.deleted_at IS NULL
+	//    INNER JOIN "story_tx" st ON st.story_id = s.id
+	//      AND st.locale_code = (
+	//        SELECT stx.locale_code FROM "story_tx" stx
+	//        WHERE stx.story_id = s.id
+	//        ORDER BY CASE
+	//          WHEN stx.locale_code = $1 THEN 0
+	//          WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+	//          ELSE 2
+	//        END
+	//        LIMIT 1
+	//      )
+	//    INNER JOIN "profile" p ON p.id = s.author_profile_id
+	//      AND p.deleted_at IS NULL
+	//    INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+	//      AND pt.locale_code = (
+	//        SELECT ptx.locale_code FROM "profile_tx" ptx
+	//        WHERE ptx.profile_id = p.id
+	//        ORDER BY CASE
+	//          WHEN ptx.locale_code = $1 THEN 0
+	//          WHEN ptx.locale_code = p.default_locale THEN 1
+	//          ELSE 2
+	//        END
+	//        LIMIT 1
+	//      )
+	//  WHERE pm.mentioned_profile_id = $2
+	//    AND pm.source_kind = 'story'
+	//    AND EXISTS (
+	//      SELECT 1 FROM "story_publication" sp
+	//      WHERE sp.story_id = s.id
+	//        AND sp.deleted_at IS NULL
+	//    )
+	//  ORDER BY s.id, pm.created_at DESC
+	//  LIMIT $3
+	ListStoryMentionsOfProfile(ctx context.Context, arg ListStoryMentionsOfProfileParams) ([]*ListStoryMentionsOfProfileRow, error)
 	//ListStoryPublicationProfileIDs
 	//
 	//  SELECT profile_id FROM "story_publication"
@@ -3994,6 +5199,20 @@ type Querier interface {
 	//  WHERE story_id = $1
 	//  ORDER BY locale_code
 	ListStoryTxLocales(ctx context.Context, arg ListStoryTxLocalesParams) ([]string, error)
+	//ListTeamsForMemberships
+	//
+	//  SELECT pmt.profile_membership_id, pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
+	//  JOIN "profile_membership_team" pmt ON pmt.profile_team_id = pt.id AND pmt.deleted_at IS NULL
+	//  WHERE pmt.profile_membership_id = ANY($1::TEXT[]) AND pt.deleted_at IS NULL
+	//  ORDER BY pt.name ASC
+	ListTeamsForMemberships(ctx context.Context, arg ListTeamsForMembershipsParams) ([]*ListTeamsForMembershipsRow, error)
+	//ListTeamsForResources
+	//
+	//  SELECT prt.profile_resource_id, pt.id, pt.profile_id, pt.name, pt.description, pt.created_at, pt.deleted_at, pt.lead_membership_id FROM "profile_team" pt
+	//  JOIN "profile_resource_team" prt ON prt.profile_team_id = pt.id AND prt.deleted_at IS NULL
+	//  WHERE prt.profile_resource_id = ANY($1::TEXT[]) AND pt.deleted_at IS NULL
+	//  ORDER BY pt.name ASC
+	ListTeamsForResources(ctx context.Context, arg ListTeamsForResourcesParams) ([]*ListTeamsForResourcesRow, error)
 	//ListTopLevelDiscussionComments
 	//
 	//  SELECT
@@ -4037,6 +5256,33 @@ type Querier interface {
 	//  LIMIT $7
 	//  OFFSET $6
 	ListTopLevelDiscussionComments(ctx context.Context, arg ListTopLevelDiscussionCommentsParams) ([]*ListTopLevelDiscussionCommentsRow, error)
+	//ListUserProfileBlocks
+	//
+	//  SELECT
+	//    upb.created_at AS blocked_at,
+	//    p.id,
+	//    p.slug,
+	//    p.kind,
+	//    p.profile_picture_uri,
+	//    pt.title,
+	//    pt.description
+	//  FROM "user_profile_block" upb
+	//    INNER JOIN "profile" p ON p.id = upb.profile_id
+	//      AND p.deleted_at IS NULL
+	//    INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+	//    AND pt.locale_code = (
+	//      SELECT ptf.locale_code FROM "profile_tx" ptf
+	//      WHERE ptf.profile_id = p.id
+	//      ORDER BY CASE
+	//        WHEN ptf.locale_code = $1 THEN 0
+	//        WHEN ptf.locale_code = p.default_locale THEN 1
+	//        ELSE 2
+	//      END
+	//      LIMIT 1
+	//    )
+	//  WHERE upb.user_id = $2
+	//  ORDER BY upb.created_at DESC
+	ListUserProfileBlocks(ctx context.Context, arg ListUserProfileBlocksParams) ([]*ListUserProfileBlocksRow, error)
 	//ListUsers
 	//
 	//  SELECT id, kind, name, email, phone, github_handle, github_remote_id, bsky_handle, bsky_remote_id, x_handle, x_remote_id, individual_profile_id, created_at, updated_at, deleted_at, apple_remote_id, profile_picture_uri
@@ -4044,15 +5290,56 @@ type Querier interface {
 	//  WHERE ($1::TEXT IS NULL OR kind = ANY(string_to_array($1::TEXT, ',')))
 	//    AND deleted_at IS NULL
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]*User, error)
+	// Users whose individual profile was deleted or no longer exists.
+	//
+	//  SELECT
+	//    u.id AS user_id,
+	//    u.individual_profile_id AS profile_id
+	//  FROM "user" u
+	//    LEFT JOIN "profile" p ON p.id = u.individual_profile_id
+	//  WHERE u.deleted_at IS NULL
+	//    AND u.individual_profile_id IS NOT NULL
+	//    AND (p.id IS NULL OR p.deleted_at IS NOT NULL)
+	//  ORDER BY u.id
+	//  LIMIT $1
+	ListUsersLinkedToMissingProfiles(ctx context.Context, arg ListUsersLinkedToMissingProfilesParams) ([]*ListUsersLinkedToMissingProfilesRow, error)
 	//ListVerifiedCustomDomains
 	//
 	//  SELECT pcd.id, pcd.profile_id, pcd.domain, pcd.default_locale,
 	//         pcd.verification_status, pcd.dns_verified_at, pcd.last_dns_check_at,
-	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.created_at, pcd.updated_at
+	//         pcd.expired_at, pcd.webserver_synced, pcd.www_prefix, pcd.verification_token,
+	//         pcd.created_at, pcd.updated_at
 	//  FROM "profile_custom_domain" pcd
 	//  WHERE pcd.verification_status IN ('verified', 'expired')
 	//  ORDER BY pcd.created_at
 	ListVerifiedCustomDomains(ctx context.Context) ([]*ListVerifiedCustomDomainsRow, error)
+	//ListVerifiedWebmentionsByProfileID
+	//
+	//  SELECT id, profile_id, source_url, target_url, status, verified_at, created_at, updated_at
+	//  FROM "webmention"
+	//  WHERE profile_id = $1
+	//    AND status = 'verified'
+	//    AND ($2::TEXT IS NULL OR id < $2::TEXT)
+	//  ORDER BY id DESC
+	//  LIMIT $3
+	ListVerifiedWebmentionsByProfileID(ctx context.Context, arg ListVerifiedWebmentionsByProfileIDParams) ([]*Webmention, error)
+	//ListWebhookDeliveries
+	//
+	//  SELECT id, source, event_type, target_url, payload, status, attempt_count, last_response_code, last_attempted_at, created_at, endpoint_id
+	//  FROM "webhook_delivery"
+	//  WHERE ($1::TEXT IS NULL OR source = $1::TEXT)
+	//    AND ($2::TEXT IS NULL OR status = $2::TEXT)
+	//    AND ($3::TEXT IS NULL OR id < $3::TEXT)
+	//  ORDER BY id DESC
+	//  LIMIT $4
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]*WebhookDelivery, error)
+	//ListWebhookDeliveryAttempts
+	//
+	//  SELECT id, delivery_id, status, response_code, error_message, duration_ms, attempted_at
+	//  FROM "webhook_delivery_attempt"
+	//  WHERE delivery_id = $1
+	//  ORDER BY attempted_at DESC, id DESC
+	ListWebhookDeliveryAttempts(ctx context.Context, arg ListWebhookDeliveryAttemptsParams) ([]*WebhookDeliveryAttempt, error)
 	//MarkLinkImportsDeletedExcept
 	//
 	//  UPDATE "profile_link_import"
@@ -4084,6 +5371,140 @@ type Querier interface {
 	//  WHERE id = $2
 	//    AND deleted_at IS NULL
 	MergeProfileMembershipProperties(ctx context.Context, arg MergeProfileMembershipPropertiesParams) (int64, error)
+	// Moves the live links of the source profile to the target, after the target's
+	// own links, skipping links the target already has (same kind and remote ID or URI).
+	//
+	//  UPDATE "profile_link" pl
+	//  SET
+	//    profile_id = $1,
+	//    "order" = pl."order" + (
+	//      SELECT COALESCE(MAX(t."order"), 0)
+	//      FROM "profile_link" t
+	//      WHERE t.profile_id = $1
+	//        AND t.deleted_at IS NULL
+	//    ),
+	//    updated_at = NOW()
+	//  WHERE pl.profile_id = $2
+	//    AND pl.deleted_at IS NULL
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_link" t
+	//      WHERE t.profile_id = $1
+	//        AND t.kind = pl.kind
+	//        AND t.deleted_at IS NULL
+	//        AND (t.remote_id = pl.remote_id OR t.uri = pl.uri)
+	//    )
+	MoveProfileLinks(ctx context.Context, arg MoveProfileLinksParams) (int64, error)
+	// Hands the source profile's memberships in other profiles to the target,
+	// skipping profiles the target is already a member of.
+	//
+	//  UPDATE "profile_membership" pm
+	//  SET
+	//    member_profile_id = $1,
+	//    updated_at = NOW()
+	//  WHERE pm.member_profile_id = $2
+	//    AND pm.profile_id <> $2
+	//    AND pm.profile_id <> $1
+	//    AND pm.deleted_at IS NULL
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_membership" t
+	//      WHERE t.profile_id = pm.profile_id
+	//        AND t.member_profile_id = $1
+	//        AND t.deleted_at IS NULL
+	//    )
+	MoveProfileMembershipsAsMember(ctx context.Context, arg MoveProfileMembershipsAsMemberParams) (int64, error)
+	// Moves the members of the source profile to the target, skipping members the
+	// target already has and the two profiles themselves.
+	//
+	//  UPDATE "profile_membership" pm
+	//  SET
+	//    profile_id = $1,
+	//    updated_at = NOW()
+	//  WHERE pm.profile_id = $2
+	//    AND pm.member_profile_id <> $2
+	//    AND pm.member_profile_id <> $1
+	//    AND pm.deleted_at IS NULL
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_membership" t
+	//      WHERE t.profile_id = $1
+	//        AND t.member_profile_id = pm.member_profile_id
+	//        AND t.deleted_at IS NULL
+	//    )
+	MoveProfileMembershipsOfProfile(ctx context.Context, arg MoveProfileMembershipsOfProfileParams) (int64, error)
+	// Moves the live pages of the source profile to the target, skipping pages whose
+	// slug the target already uses.
+	//
+	//  UPDATE "profile_page" pp
+	//  SET
+	//    profile_id = $1,
+	//    updated_at = NOW()
+	//  WHERE pp.profile_id = $2
+	//    AND pp.deleted_at IS NULL
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_page" t
+	//      WHERE t.profile_id = $1
+	//        AND t.slug = pp.slug
+	//    )
+	MoveProfilePages(ctx context.Context, arg MoveProfilePagesParams) (int64, error)
+	// Moves the live resources of the source profile to the target, skipping
+	// resources the target already has (same kind and remote ID).
+	//
+	//  UPDATE "profile_resource" pr
+	//  SET
+	//    profile_id = $1,
+	//    added_by_profile_id = CASE
+	//      WHEN pr.added_by_profile_id = $2 THEN $1
+	//      ELSE pr.added_by_profile_id
+	//    END,
+	//    updated_at = NOW()
+	//  WHERE pr.profile_id = $2
+	//    AND pr.deleted_at IS NULL
+	//    AND (
+	//      pr.remote_id IS NULL
+	//      OR NOT EXISTS (
+	//        SELECT 1 FROM "profile_resource" t
+	//        WHERE t.profile_id = $1
+	//          AND t.kind = pr.kind
+	//          AND t.remote_id = pr.remote_id
+	//          AND t.deleted_at IS NULL
+	//      )
+	//    )
+	MoveProfileResources(ctx context.Context, arg MoveProfileResourcesParams) (int64, error)
+	//PublishDraftProfilePage
+	//
+	//  UPDATE "profile_page"
+	//  SET
+	//    visibility = 'public',
+	//    updated_at = NOW()
+	//  WHERE id = $1
+	//    AND visibility = 'draft'
+	//    AND deleted_at IS NULL
+	PublishDraftProfilePage(ctx context.Context, arg PublishDraftProfilePageParams) (int64, error)
+	// Records an action award. Returns no row when the award key was already used.
+	//
+	//  INSERT INTO "profile_point_transaction" (
+	//    id,
+	//    target_profile_id,
+	//    transaction_type,
+	//    triggering_event,
+	//    award_key,
+	//    description,
+	//    amount,
+	//    balance_after,
+	//    created_at
+	//  ) VALUES (
+	//    $1,
+	//    $2,
+	//    'GAIN',
+	//    $3,
+	//    $4,
+	//    $5,
+	//    $6,
+	//    $7,
+	//    NOW()
+	//  )
+	//  ON CONFLICT (award_key) WHERE award_key IS NOT NULL DO NOTHING
+	//  RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
+	RecordProfilePointAward(ctx context.Context, arg RecordProfilePointAwardParams) (*ProfilePointTransaction, error)
 	//RecordProfilePointTransaction
 	//
 	//  INSERT INTO "profile_point_transaction" (
@@ -4106,7 +5527,7 @@ type Querier interface {
 	//    $7,
 	//    $8,
 	//    NOW()
-	//  ) RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at
+	//  ) RETURNING id, target_profile_id, origin_profile_id, transaction_type, triggering_event, description, amount, balance_after, created_at, award_key
 	RecordProfilePointTransaction(ctx context.Context, arg RecordProfilePointTransactionParams) (*ProfilePointTransaction, error)
 	//RejectPendingAward
 	//
@@ -4123,6 +5544,14 @@ type Querier interface {
 	//
 	//  SELECT pg_advisory_unlock($1::BIGINT) AS released
 	ReleaseAdvisoryLock(ctx context.Context, arg ReleaseAdvisoryLockParams) (bool, error)
+	//ReleaseHandleReservation
+	//
+	//  UPDATE "profile_handle_reservation"
+	//  SET released_at = NOW()
+	//  WHERE id = $1
+	//    AND claimed_at IS NULL
+	//    AND released_at IS NULL
+	ReleaseHandleReservation(ctx context.Context, arg ReleaseHandleReservationParams) (int64, error)
 	//RemoveAllFromCache
 	//
 	//  DELETE FROM "cache"
@@ -4137,6 +5566,11 @@ type Querier interface {
 	//  DELETE FROM "cache"
 	//  WHERE key = $1
 	RemoveFromCache(ctx context.Context, arg RemoveFromCacheParams) (int64, error)
+	//RemoveFromCacheByPrefix
+	//
+	//  DELETE FROM "cache"
+	//  WHERE starts_with(key, $1::TEXT)
+	RemoveFromCacheByPrefix(ctx context.Context, arg RemoveFromCacheByPrefixParams) (int64, error)
 	//RemoveMailboxReaction
 	//
 	//  DELETE FROM "mailbox_reaction"
@@ -4196,6 +5630,14 @@ type Querier interface {
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	RemoveStorySeries(ctx context.Context, arg RemoveStorySeriesParams) (int64, error)
+	//RemoveTeamFromMemberships
+	//
+	//  UPDATE "profile_membership_team"
+	//  SET deleted_at = NOW()
+	//  WHERE profile_team_id = $1
+	//    AND profile_membership_id = ANY($2::TEXT[])
+	//    AND deleted_at IS NULL
+	RemoveTeamFromMemberships(ctx context.Context, arg RemoveTeamFromMembershipsParams) (int64, error)
 	//RemoveUser
 	//
 	//  UPDATE "user"
@@ -4203,6 +5645,58 @@ type Querier interface {
 	//  WHERE id = $1
 	//    AND deleted_at IS NULL
 	RemoveUser(ctx context.Context, arg RemoveUserParams) (int64, error)
+	//RepointUserIndividualProfile
+	//
+	//  UPDATE "user"
+	//  SET
+	//    individual_profile_id = $1,
+	//    updated_at = NOW()
+	//  WHERE individual_profile_id = $2
+	//    AND deleted_at IS NULL
+	RepointUserIndividualProfile(ctx context.Context, arg RepointUserIndividualProfileParams) (int64, error)
+	//ResolveProfileReport
+	//
+	//  UPDATE "profile_report"
+	//  SET
+	//    status = $1,
+	//    resolved_by_user_id = $2,
+	//    resolved_at = NOW()
+	//  WHERE id = $3
+	//    AND status = 'open'
+	ResolveProfileReport(ctx context.Context, arg ResolveProfileReportParams) (int64, error)
+	//ResolveVotingCandidate
+	//
+	//  UPDATE "profile_membership_candidate"
+	//  SET status = $1,
+	//      updated_at = NOW()
+	//  WHERE id = $2
+	//    AND status = 'voting'
+	//    AND deleted_at IS NULL
+	ResolveVotingCandidate(ctx context.Context, arg ResolveVotingCandidateParams) (int64, error)
+	//RespondToProfileMembershipInvitation
+	//
+	//  UPDATE "profile_membership_invitation"
+	//  SET
+	//    status = $1,
+	//    responded_at = NOW()
+	//  WHERE id = $2
+	//    AND status = 'pending'
+	RespondToProfileMembershipInvitation(ctx context.Context, arg RespondToProfileMembershipInvitationParams) (int64, error)
+	//RestoreProfile
+	//
+	//  UPDATE "profile"
+	//  SET deleted_at = NULL
+	//  WHERE id = $1
+	//    AND deleted_at IS NOT NULL
+	RestoreProfile(ctx context.Context, arg RestoreProfileParams) (int64, error)
+	//RestoreProfileResource
+	//
+	//  UPDATE "profile_resource"
+	//  SET deleted_at = NULL,
+	//    updated_at = NOW()
+	//  WHERE id = $1
+	//    AND deleted_at IS NOT NULL
+	RestoreProfileResource(ctx context.Context, arg RestoreProfileResourceParams) (int64, error)
 	//SearchProfilePages
 	//
 	//  SELECT
@@ -4376,6 +5870,12 @@ type Querier interface {
 	//        AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
 	//        AND pm.kind NOT IN ('follower', 'sponsor')
 	//    )
+	//    -- Users who blocked the profile are not suggested to it.
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "user_profile_block" upb
+	//      WHERE upb.user_id = u.id
+	//        AND upb.profile_id = $3
+	//    )
 	//  ORDER BY u.name ASC
 	//  LIMIT 10
 	SearchUsersForMembership(ctx context.Context, arg SearchUsersForMembershipParams) ([]*SearchUsersForMembershipRow, error)
@@ -4405,6 +5905,12 @@ type Querier interface {
 	//    AND profile_id = $3
 	//    AND left_at IS NULL
 	SetParticipantArchived(ctx context.Context, arg SetParticipantArchivedParams) error
+	//SetProfileTeamLead
+	//
+	//  UPDATE "profile_team"
+	//  SET lead_membership_id = $1
+	//  WHERE id = $2 AND deleted_at IS NULL
+	SetProfileTeamLead(ctx context.Context, arg SetProfileTeamLeadParams) (int64, error)
 	//SetResourceTeams_Delete
 	//
 	//  UPDATE "profile_resource_team"
@@ -4482,6 +5988,37 @@ type Querier interface {
 	//    AND remote_id = $1
 	//    AND deleted_at IS NULL
 	SoftDeleteTelegramProfileLink(ctx context.Context, arg SoftDeleteTelegramProfileLinkParams) (int64, error)
+	//SuggestProfiles
+	//
+	//  SELECT
+	//    p.slug,
+	//    p.kind,
+	//    p.profile_picture_uri,
+	//    pt.title
+	//  FROM "profile" p
+	//    INNER JOIN "profile_tx" pt ON pt.profile_id = p.id
+	//      AND pt.locale_code = (
+	//        SELECT ptf.locale_code FROM "profile_tx" ptf
+	//        WHERE ptf.profile_id = p.id
+	//        ORDER BY CASE
+	//          WHEN ptf.locale_code = $1 THEN 0
+	//          WHEN ptf.locale_code = p.default_locale THEN 1
+	//          ELSE 2
+	//        END
+	//        LIMIT 1
+	//      )
+	//  WHERE p.approved_at IS NOT NULL
+	//    AND p.deleted_at IS NULL
+	//    AND (
+	//      p.slug LIKE $2::TEXT || '%'
+	//      OR p.id IN (
+	//        SELECT ptm.profile_id FROM "profile_tx" ptm
+	//        WHERE normalize_text(ptm.title) LIKE normalize_text($2::TEXT) || '%'
+	//      )
+	//    )
+	//  ORDER BY (p.slug LIKE $2::TEXT || '%') DESC, LENGTH(p.slug), p.slug
+	//  LIMIT $3
+	SuggestProfiles(ctx context.Context, arg SuggestProfilesParams) ([]*SuggestProfilesRow, error)
 	//TerminateSession
 	//
 	//  UPDATE
@@ -4493,6 +6030,17 @@ type Querier interface {
 	//    id = $1
 	//    AND logged_in_user_id = $2
 	TerminateSession(ctx context.Context, arg TerminateSessionParams) error
+	//TrimRecentProfileViews
+	//
+	//  DELETE FROM "user_recent_profile_view" v
+	//  WHERE v.user_id = $1
+	//    AND v.profile_id NOT IN (
+	//      SELECT urpv.profile_id FROM "user_recent_profile_view" urpv
+	//      WHERE urpv.user_id = $1
+	//      ORDER BY urpv.viewed_at DESC
+	//      LIMIT $2::INT
+	//    )
+	TrimRecentProfileViews(ctx context.Context, arg TrimRecentProfileViewsParams) (int64, error)
 	//TryAdvisoryLock
 	//
 	//  SELECT pg_try_advisory_lock($1::BIGINT) AS acquired
@@ -4559,8 +6107,9 @@ type Querier interface {
 	//  SET
 	//    domain = $1,
 	//    default_locale = $2,
+	//    verification_token = COALESCE($3, verification_token),
 	//    updated_at = NOW()
-	//  WHERE id = $3
+	//  WHERE id = $4
 	UpdateCustomDomain(ctx context.Context, arg UpdateCustomDomainParams) (int64, error)
 	//UpdateCustomDomainVerification
 	//
@@ -4710,6 +6259,15 @@ type Querier interface {
 	//  WHERE id = $11
 	//    AND deleted_at IS NULL
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (int64, error)
+	//UpdateProfileDefaultLocale
+	//
+	//  UPDATE "profile"
+	//  SET
+	//    default_locale = $1,
+	//    updated_at = NOW()
+	//  WHERE id = $2
+	//    AND deleted_at IS NULL
+	UpdateProfileDefaultLocale(ctx context.Context, arg UpdateProfileDefaultLocaleParams) (int64, error)
 	//UpdateProfileLink
 	//
 	//  UPDATE "profile_link"
@@ -4749,6 +6307,25 @@ type Querier interface {
 	//  WHERE id = $3
 	//    AND deleted_at IS NULL
 	UpdateProfileLinkOnlineStatus(ctx context.Context, arg UpdateProfileLinkOnlineStatusParams) (int64, error)
+	//UpdateProfileLinkOrder
+	//
+	//  UPDATE "profile_link"
+	//  SET
+	//    "order" = $1,
+	//    updated_at = NOW()
+	//  WHERE id = $2
+	//    AND deleted_at IS NULL
+	UpdateProfileLinkOrder(ctx context.Context, arg UpdateProfileLinkOrderParams) (int64, error)
+	// Records the outcome of a sync attempt. sync_error is NULL after a success.
+	//
+	//  UPDATE "profile_link"
+	//  SET
+	//    sync_status = $1,
+	//    sync_error = $2,
+	//    sync_attempted_at = NOW()
+	//  WHERE id = $3
+	//    AND deleted_at IS NULL
+	UpdateProfileLinkSyncStatus(ctx context.Context, arg UpdateProfileLinkSyncStatusParams) (int64, error)
 	//UpdateProfileLinkTokens
 	//
 	//  UPDATE "profile_link"
@@ -4779,6 +6356,14 @@ type Querier interface {
 	//  WHERE id = $2
 	//    AND deleted_at IS NULL
 	UpdateProfileMembership(ctx context.Context, arg UpdateProfileMembershipParams) (int64, error)
+	//UpdateProfileMembershipAggregateStories
+	//
+	//  UPDATE "profile_membership"
+	//  SET
+	//    aggregate_stories = $1
+	//  WHERE id = $2
+	//    AND deleted_at IS NULL
+	UpdateProfileMembershipAggregateStories(ctx context.Context, arg UpdateProfileMembershipAggregateStoriesParams) (int64, error)
 	//UpdateProfileMembershipProperties
 	//
 	//  UPDATE "profile_membership"
@@ -4832,6 +6417,28 @@ type Querier interface {
 	//  WHERE id = $2
 	//    AND deleted_at IS NULL
 	UpdateProfileQuestionHidden(ctx context.Context, arg UpdateProfileQuestionHiddenParams) error
+	//UpdateProfileQuestionResolved
+	//
+	//  UPDATE "profile_question"
+	//  SET
+	//    resolved_at = CASE
+	//      WHEN $1::BOOLEAN THEN COALESCE(resolved_at, NOW())
+	//      ELSE NULL
+	//    END,
+	//    updated_at = NOW()
+	//  WHERE id = $2
+	//    AND deleted_at IS NULL
+	UpdateProfileQuestionResolved(ctx context.Context, arg UpdateProfileQuestionResolvedParams) error
+	//UpdateProfileResourceOrder
+	//
+	//  UPDATE "profile_resource"
+	//  SET
+	//    "order" = $1,
+	//    is_featured = $2,
+	//    updated_at = NOW()
+	//  WHERE id = $3
+	//    AND deleted_at IS NULL
+	UpdateProfileResourceOrder(ctx context.Context, arg UpdateProfileResourceOrderParams) (int64, error)
 	//UpdateProfileResourceProperties
 	//
 	//  UPDATE "profile_resource"
@@ -4841,6 +6448,15 @@ type Querier interface {
 	//  WHERE id = $2
 	//    AND deleted_at IS NULL
 	UpdateProfileResourceProperties(ctx context.Context, arg UpdateProfileResourcePropertiesParams) (int64, error)
+	//UpdateProfileSlug
+	//
+	//  UPDATE "profile"
+	//  SET
+	//    slug = $1,
+	//    updated_at = NOW()
+	//  WHERE id = $2
+	//    AND deleted_at IS NULL
+	UpdateProfileSlug(ctx context.Context, arg UpdateProfileSlugParams) (int64, error)
 	//UpdateProfileTeam
 	//
 	//  UPDATE "profile_team"
@@ -4973,6 +6589,25 @@ type Querier interface {
 	//  WHERE id = $14
 	//    AND deleted_at IS NULL
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (int64, error)
+	//UpdateWebhookDeliveryAfterAttempt
+	//
+	//  UPDATE "webhook_delivery"
+	//  SET
+	//    status = $1,
+	//    attempt_count = attempt_count + 1,
+	//    last_response_code = $2,
+	//    last_attempted_at = $3
+	//  WHERE id = $4
+	UpdateWebhookDeliveryAfterAttempt(ctx context.Context, arg UpdateWebhookDeliveryAfterAttemptParams) (int64, error)
+	//UpdateWebmentionStatus
+	//
+	//  UPDATE "webmention"
+	//  SET
+	//    status = $1,
+	//    verified_at = $2,
+	//    updated_at = NOW()
+	//  WHERE id = $3
+	UpdateWebmentionStatus(ctx context.Context, arg UpdateWebmentionStatusParams) (int64, error)
 	// Creates or reactivates a subscription for a profile+channel combination.
 	//
 	//  INSERT INTO "bulletin_subscription" (
@@ -5062,6 +6697,24 @@ type Querier interface {
 	//    description = EXCLUDED.description,
 	//    properties = EXCLUDED.properties
 	UpsertProfileTx(ctx context.Context, arg UpsertProfileTxParams) error
+	// Records a profile view, skipping the viewer's own individual profile and
+	// profiles the viewer owns.
+	//
+	//  INSERT INTO "user_recent_profile_view" (user_id, profile_id, viewed_at)
+	//  SELECT u.id, $1, NOW()
+	//  FROM "user" u
+	//  WHERE u.id = $2
+	//    AND u.deleted_at IS NULL
+	//    AND u.individual_profile_id IS DISTINCT FROM $1
+	//    AND NOT EXISTS (
+	//      SELECT 1 FROM "profile_membership" pm
+	//      WHERE pm.profile_id = $1
+	//        AND pm.member_profile_id = u.individual_profile_id
+	//        AND pm.kind = 'owner'
+	//        AND pm.deleted_at IS NULL
+	//    )
+	//  ON CONFLICT (user_id, profile_id) DO UPDATE SET viewed_at = EXCLUDED.viewed_at
+	UpsertRecentProfileView(ctx context.Context, arg UpsertRecentProfileViewParams) (int64, error)
 	//UpsertSessionRateLimit
 	//
 	//  INSERT INTO
@@ -5137,6 +6790,25 @@ type Querier interface {
 	//    content = EXCLUDED.content,
 	//    is_managed = EXCLUDED.is_managed
 	UpsertStoryTx(ctx context.Context, arg UpsertStoryTxParams) error
+	// Stores a received mention as pending, or puts an already known
+	// (source, target) pair back to pending so it is verified again.
+	//
+	//  INSERT INTO "webmention" (id, profile_id, source_url, target_url, status, created_at)
+	//  VALUES (
+	//    $1,
+	//    $2,
+	//    $3,
+	//    $4,
+	//    'pending',
+	//    NOW()
+	//  )
+	//  ON CONFLICT (source_url, target_url) DO UPDATE
+	//  SET
+	//    profile_id = EXCLUDED.profile_id,
+	//    status = 'pending',
+	//    updated_at = NOW()
+	//  RETURNING id, profile_id, source_url, target_url, status, verified_at, created_at, updated_at
+	UpsertWebmention(ctx context.Context, arg UpsertWebmentionParams) (*Webmention, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -39,7 +39,10 @@ type Repository struct {
 	queries  *Queries
 	cache    *caching.Cache
 	logger   *logfx.Logger
-	cacheTTL time.Duration
+	cacheTTL *caching.TTLPolicy
+	tx       *sql.Tx // Set on repositories bound by WithTx
+
+	afterCommit *[]func(ctx context.Context) // Hooks run once the WithTx transaction commits
 }
 
 func NewRepositoryFromDefault(
@@ -79,7 +82,7 @@ func NewRepositoryFromNamed( //nolint:cyclop,funlen
 			db:       adapter.GetStdlibDB(), // For migrations
 			dbtx:     adapter,               // For queries
 			queries:  &Queries{db: adapter},
-			cacheTTL: DefaultCacheTTL,
+			cacheTTL: DefaultCacheConfig().TTLPolicy(),
 			logger:   logger,
 		}
 	case "postgres", "mysql", "sqlite":
@@ -98,7 +101,7 @@ func NewRepositoryFromNamed( //nolint:cyclop,funlen
 			db:       sqlDB,
 			dbtx:     sqlDB,
 			queries:  &Queries{db: sqlDB},
-			cacheTTL: DefaultCacheTTL,
+			cacheTTL: DefaultCacheConfig().TTLPolicy(),
 			logger:   logger,
 		}
 	default:
@@ -110,7 +113,7 @@ func NewRepositoryFromNamed( //nolint:cyclop,funlen
 			cachedMessage, err := repository.CacheGetSince(
 				ctx,
 				key,
-				time.Now().Add(-1*repository.cacheTTL),
+				time.Now().Add(-1*repository.cacheTTL.TTLFor(key)),
 			)
 			if err != nil {
 				return false, err
@@ -143,11 +146,19 @@ func NewRepositoryFromNamed( //nolint:cyclop,funlen
 		func(ctx context.Context, key string) error {
 			return repository.CacheRemove(ctx, key)
 		},
+		func(ctx context.Context, prefix string) error {
+			return repository.CacheRemoveByPrefix(ctx, prefix)
+		},
 	)
 
 	return repository, nil
 }
 
+// SetCacheConfig applies per-class cache TTLs to the repository.
+func (r *Repository) SetCacheConfig(config CacheConfig) {
+	r.cacheTTL = config.TTLPolicy()
+}
+
 func (r *Repository) RunMigrations(ctx context.Context, migrationsDir string) error {
 	r.logger.InfoContext(
 		ctx,
@@ -62,6 +62,12 @@ func (r *Repository) CacheRemove(ctx context.Context, key string) error {
 	return err
 }
 
+func (r *Repository) CacheRemoveByPrefix(ctx context.Context, prefix string) error {
+	_, err := r.queries.RemoveFromCacheByPrefix(ctx, RemoveFromCacheByPrefixParams{Prefix: prefix})
+
+	return err
+}
+
 func (r *Repository) CacheRemoveAll(ctx context.Context) error {
 	_, err := r.queries.RemoveAllFromCache(ctx)
 
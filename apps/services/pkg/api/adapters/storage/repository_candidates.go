@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/lib/vars"
@@ -27,6 +28,7 @@ func (r *Repository) CreateProfileMembershipCandidate(
 	referrerMembershipID *string,
 	source string,
 	applicantMessage *string,
+	votingDeadline *time.Time,
 ) (*profiles.ProfileMembershipCandidate, error) {
 	var referrerParam sql.NullString
 	if referrerMembershipID != nil {
@@ -47,6 +49,7 @@ func (r *Repository) CreateProfileMembershipCandidate(
 			ReferrerMembershipID: referrerParam,
 			Source:               source,
 			ApplicantMessage:     applicantMsgParam,
+			VotingDeadline:       vars.ToSQLNullTime(votingDeadline),
 		},
 	)
 	if err != nil {
@@ -70,6 +73,7 @@ func (r *Repository) CreateProfileMembershipCandidate(
 		ReferredProfile:      nil,
 		Teams:                nil,
 		FormResponses:        nil,
+		VotingDeadline:       vars.ToTimePtr(row.VotingDeadline),
 		CreatedAt:            row.CreatedAt,
 		UpdatedAt:            vars.ToTimePtr(row.UpdatedAt),
 	}, nil
@@ -106,6 +110,7 @@ func (r *Repository) GetProfileMembershipCandidateByID(
 		ReferredProfile:      nil,
 		Teams:                nil,
 		FormResponses:        nil,
+		VotingDeadline:       vars.ToTimePtr(row.VotingDeadline),
 		CreatedAt:            row.CreatedAt,
 		UpdatedAt:            vars.ToTimePtr(row.UpdatedAt),
 	}, nil
@@ -144,6 +149,7 @@ func (r *Repository) GetProfileMembershipCandidateByProfileAndReferred(
 		ReferredProfile:      nil,
 		Teams:                nil,
 		FormResponses:        nil,
+		VotingDeadline:       vars.ToTimePtr(row.VotingDeadline),
 		CreatedAt:            row.CreatedAt,
 		UpdatedAt:            vars.ToTimePtr(row.UpdatedAt),
 	}, nil
@@ -197,10 +203,11 @@ func candidateListRowToCandidate(
 			Title:             strings.TrimRight(row.ReferredProfileTitle, " "),
 			Description:       "",
 		},
-		Teams:         nil,
-		FormResponses: nil,
-		CreatedAt:     row.CreatedAt,
-		UpdatedAt:     vars.ToTimePtr(row.UpdatedAt),
+		Teams:          nil,
+		FormResponses:  nil,
+		VotingDeadline: vars.ToTimePtr(row.VotingDeadline),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      vars.ToTimePtr(row.UpdatedAt),
 	}
 }
 
@@ -360,8 +367,10 @@ func (r *Repository) ListCandidateTeams(
 
 				return nil
 			}(),
-			MemberCount:   0,
-			ResourceCount: 0,
+			LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+			LeadMemberProfileID: nil,
+			MemberCount:         0,
+			ResourceCount:       0,
 		})
 	}
 
@@ -381,6 +390,50 @@ func (r *Repository) UpdateCandidateStatus(
 	})
 }
 
+func (r *Repository) ListExpiredVotingCandidates(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*profiles.ExpiredVotingCandidate, error) {
+	rows, err := r.queries.ListExpiredVotingCandidates(ctx, ListExpiredVotingCandidatesParams{
+		Now:        now,
+		LimitCount: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*profiles.ExpiredVotingCandidate, len(rows))
+	for i, row := range rows {
+		result[i] = &profiles.ExpiredVotingCandidate{
+			VotingDeadline:    row.VotingDeadline.Time,
+			ID:                row.ID,
+			ProfileID:         row.ProfileID,
+			ProfileSlug:       row.ProfileSlug,
+			ReferredProfileID: row.ReferredProfileID,
+			Source:            row.Source,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Repository) ResolveVotingCandidate(
+	ctx context.Context,
+	candidateID string,
+	status profiles.CandidateStatus,
+) (bool, error) {
+	rowsAffected, err := r.queries.ResolveVotingCandidate(ctx, ResolveVotingCandidateParams{
+		Status: string(status),
+		ID:     candidateID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
 func (r *Repository) UpdateCandidateApplicantMessage(
 	ctx context.Context,
 	candidateID string,
@@ -0,0 +1,177 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+	"github.com/sqlc-dev/pqtype"
+)
+
+func (r *Repository) CreateConsistencyCheck(
+	ctx context.Context,
+	id string,
+	requestedByUserID string,
+	repair bool,
+) error {
+	return r.queries.CreateConsistencyCheck(ctx, CreateConsistencyCheckParams{
+		ID:                id,
+		RequestedByUserID: requestedByUserID,
+		Repair:            repair,
+	})
+}
+
+func (r *Repository) GetConsistencyCheckByID(
+	ctx context.Context,
+	id string,
+) (*consistency.Check, error) {
+	row, err := r.queries.GetConsistencyCheckByID(ctx, GetConsistencyCheckByIDParams{ID: id})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return rowToConsistencyCheck(row)
+}
+
+func (r *Repository) ListConsistencyChecks(
+	ctx context.Context,
+	limit int,
+) ([]*consistency.Check, error) {
+	rows, err := r.queries.ListConsistencyChecks(ctx, ListConsistencyChecksParams{
+		LimitCount: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*consistency.Check, len(rows))
+
+	for i, row := range rows {
+		result[i], err = rowToConsistencyCheck(row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Repository) FinishConsistencyCheck(
+	ctx context.Context,
+	id string,
+	status consistency.CheckStatus,
+	findings []*consistency.Finding,
+	errorMessage *string,
+) error {
+	findingsParam := pqtype.NullRawMessage{RawMessage: nil, Valid: false}
+
+	if findings != nil {
+		encoded, err := json.Marshal(findings)
+		if err != nil {
+			return err
+		}
+
+		findingsParam = pqtype.NullRawMessage{RawMessage: encoded, Valid: true}
+	}
+
+	rowsAffected, err := r.queries.FinishConsistencyCheck(ctx, FinishConsistencyCheckParams{
+		Status:       string(status),
+		Findings:     findingsParam,
+		ErrorMessage: vars.ToSQLNullString(errorMessage),
+		ID:           id,
+	})
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *Repository) ListIndividualProfilesWithoutOwnerMembership(
+	ctx context.Context,
+	limit int,
+) ([]*consistency.IndividualProfileMembershipGap, error) {
+	rows, err := r.queries.ListIndividualProfilesWithoutOwnerMembership(
+		ctx,
+		ListIndividualProfilesWithoutOwnerMembershipParams{LimitCount: int32(limit)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*consistency.IndividualProfileMembershipGap, len(rows))
+	for i, row := range rows {
+		result[i] = &consistency.IndividualProfileMembershipGap{
+			UserID:    vars.ToStringPtr(row.UserID),
+			ProfileID: row.ProfileID,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Repository) ListProfilesWithoutTranslation(
+	ctx context.Context,
+	limit int,
+) ([]string, error) {
+	return r.queries.ListProfilesWithoutTranslation(
+		ctx,
+		ListProfilesWithoutTranslationParams{LimitCount: int32(limit)},
+	)
+}
+
+func (r *Repository) ListUsersLinkedToMissingProfiles(
+	ctx context.Context,
+	limit int,
+) ([]*consistency.MissingProfileLink, error) {
+	rows, err := r.queries.ListUsersLinkedToMissingProfiles(
+		ctx,
+		ListUsersLinkedToMissingProfilesParams{LimitCount: int32(limit)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*consistency.MissingProfileLink, len(rows))
+	for i, row := range rows {
+		result[i] = &consistency.MissingProfileLink{
+			UserID:    row.UserID,
+			ProfileID: row.ProfileID.String,
+		}
+	}
+
+	return result, nil
+}
+
+func rowToConsistencyCheck(row *ConsistencyCheck) (*consistency.Check, error) {
+	var findings []*consistency.Finding
+
+	if row.Findings.Valid {
+		err := json.Unmarshal(row.Findings.RawMessage, &findings)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &consistency.Check{
+		CreatedAt:         row.CreatedAt,
+		CompletedAt:       vars.ToTimePtr(row.CompletedAt),
+		ErrorMessage:      vars.ToStringPtr(row.ErrorMessage),
+		ID:                row.ID,
+		RequestedByUserID: row.RequestedByUserID,
+		Status:            consistency.CheckStatus(row.Status),
+		Findings:          findings,
+		Repair:            row.Repair,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package storage //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newConsistencyTestRepository creates a repository over the tables the
+// consistency scans read, seeded with one record of every inconsistency next
+// to healthy ones.
+func newConsistencyTestRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	return newSQLiteTestRepository(t,
+		`CREATE TABLE profile (
+			id TEXT PRIMARY KEY,
+			kind TEXT NOT NULL,
+			deleted_at DATETIME
+		)`,
+		`CREATE TABLE profile_tx (
+			profile_id TEXT NOT NULL,
+			locale_code TEXT NOT NULL
+		)`,
+		`CREATE TABLE profile_membership (
+			id TEXT PRIMARY KEY,
+			profile_id TEXT NOT NULL,
+			member_profile_id TEXT,
+			kind TEXT NOT NULL,
+			deleted_at DATETIME
+		)`,
+		`CREATE TABLE "user" (
+			id TEXT PRIMARY KEY,
+			individual_profile_id TEXT,
+			deleted_at DATETIME
+		)`,
+		`CREATE TABLE consistency_check (
+			id TEXT PRIMARY KEY,
+			requested_by_user_id TEXT NOT NULL,
+			repair BOOLEAN NOT NULL DEFAULT FALSE,
+			status TEXT NOT NULL DEFAULT 'pending',
+			findings BLOB,
+			error_message TEXT,
+			created_at DATETIME NOT NULL,
+			completed_at DATETIME
+		)`,
+		// A healthy individual profile and its user
+		`INSERT INTO profile VALUES ('p-healthy', 'individual', NULL)`,
+		`INSERT INTO profile_tx VALUES ('p-healthy', 'en')`,
+		`INSERT INTO profile_membership VALUES ('m-1', 'p-healthy', 'p-healthy', 'owner', NULL)`,
+		`INSERT INTO "user" VALUES ('u-healthy', 'p-healthy', NULL)`,
+		// The signup linked the profile but failed to create the membership
+		`INSERT INTO profile VALUES ('p-no-membership', 'individual', NULL)`,
+		`INSERT INTO profile_tx VALUES ('p-no-membership', 'en')`,
+		`INSERT INTO "user" VALUES ('u-no-membership', 'p-no-membership', NULL)`,
+		// A removed owner membership does not count
+		`INSERT INTO profile VALUES ('p-removed-membership', 'individual', NULL)`,
+		`INSERT INTO profile_tx VALUES ('p-removed-membership', 'en')`,
+		`INSERT INTO profile_membership VALUES
+			('m-2', 'p-removed-membership', 'p-removed-membership', 'owner', '2026-01-01 00:00:00')`,
+		// Organizations don't have self-memberships
+		`INSERT INTO profile VALUES ('p-org', 'organization', NULL)`,
+		`INSERT INTO profile_tx VALUES ('p-org', 'en')`,
+		// The profile row was created but its translation wasn't
+		`INSERT INTO profile VALUES ('p-no-tx', 'organization', NULL)`,
+		// The user still points at a deleted profile, and another at one that is gone
+		`INSERT INTO profile VALUES ('p-deleted', 'individual', '2026-01-01 00:00:00')`,
+		`INSERT INTO "user" VALUES ('u-deleted-profile', 'p-deleted', NULL)`,
+		`INSERT INTO "user" VALUES ('u-missing-profile', 'p-missing', NULL)`,
+		// Deleted users are ignored
+		`INSERT INTO "user" VALUES ('u-deleted', 'p-missing', '2026-01-01 00:00:00')`,
+	)
+}
+
+func TestRepository_ConsistencyScans(t *testing.T) {
+	t.Parallel()
+
+	repo := newConsistencyTestRepository(t)
+
+	gaps, err := repo.ListIndividualProfilesWithoutOwnerMembership(t.Context(), 100)
+	require.NoError(t, err)
+	require.Len(t, gaps, 2)
+	assert.Equal(t, "p-no-membership", gaps[0].ProfileID)
+	require.NotNil(t, gaps[0].UserID)
+	assert.Equal(t, "u-no-membership", *gaps[0].UserID)
+	assert.Equal(t, "p-removed-membership", gaps[1].ProfileID)
+	assert.Nil(t, gaps[1].UserID)
+
+	untranslated, err := repo.ListProfilesWithoutTranslation(t.Context(), 100)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"p-no-tx"}, untranslated)
+
+	links, err := repo.ListUsersLinkedToMissingProfiles(t.Context(), 100)
+	require.NoError(t, err)
+	assert.Equal(t, []*consistency.MissingProfileLink{
+		{UserID: "u-deleted-profile", ProfileID: "p-deleted"},
+		{UserID: "u-missing-profile", ProfileID: "p-missing"},
+	}, links)
+}
+
+func TestRepository_ConsistencyCheckReport(t *testing.T) {
+	t.Parallel()
+
+	repo := newConsistencyTestRepository(t)
+
+	err := repo.CreateConsistencyCheck(t.Context(), "check-1", "admin-1", true)
+	require.NoError(t, err)
+
+	check, err := repo.GetConsistencyCheckByID(t.Context(), "check-1")
+	require.NoError(t, err)
+	require.NotNil(t, check)
+	assert.Equal(t, consistency.CheckStatusPending, check.Status)
+	assert.True(t, check.Repair)
+	assert.Nil(t, check.Findings)
+
+	profileID := "p-no-tx"
+	err = repo.FinishConsistencyCheck(t.Context(), "check-1", consistency.CheckStatusCompleted,
+		[]*consistency.Finding{{ //nolint:exhaustruct
+			ProfileID: &profileID,
+			Kind:      consistency.FindingProfileWithoutTranslation,
+		}}, nil)
+	require.NoError(t, err)
+
+	checks, err := repo.ListConsistencyChecks(t.Context(), 10)
+	require.NoError(t, err)
+	require.Len(t, checks, 1)
+	assert.Equal(t, consistency.CheckStatusCompleted, checks[0].Status)
+	assert.NotNil(t, checks[0].CompletedAt)
+	require.Len(t, checks[0].Findings, 1)
+	assert.Equal(t, consistency.FindingProfileWithoutTranslation, checks[0].Findings[0].Kind)
+	assert.Equal(t, "p-no-tx", *checks[0].Findings[0].ProfileID)
+
+	missing, err := repo.GetConsistencyCheckByID(t.Context(), "check-2")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
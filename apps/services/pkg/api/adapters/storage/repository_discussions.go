@@ -422,6 +422,56 @@ func (r *Repository) GetStoryAuthorProfileID(ctx context.Context, storyID string
 	return vars.ToStringPtr(result), nil
 }
 
+// GetStoryDiscussionSettings returns the discussion flags of a story and its author profile.
+func (r *Repository) GetStoryDiscussionSettings(
+	ctx context.Context,
+	storyID string,
+) (*discussions.StoryDiscussionSettings, error) {
+	row, err := r.queries.GetStoryDiscussionSettings(ctx, GetStoryDiscussionSettingsParams{
+		ID: storyID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	featureVisibility := discussionVisibilityPublic
+	if row.AuthorFeatureDiscussions.Valid {
+		featureVisibility = row.AuthorFeatureDiscussions.String
+	}
+
+	return &discussions.StoryDiscussionSettings{
+		AuthorProfileID:          vars.ToStringPtr(row.AuthorProfileID),
+		AuthorProfileSlug:        vars.ToStringPtr(row.AuthorProfileSlug),
+		AuthorFeatureDiscussions: featureVisibility,
+		FeatDiscussions:          row.FeatDiscussions,
+	}, nil
+}
+
+// GetProfileStoryIDBySlugs resolves a story slug within a profile to its ID.
+func (r *Repository) GetProfileStoryIDBySlugs(
+	ctx context.Context,
+	profileSlug string,
+	storySlug string,
+) (string, error) {
+	storyID, err := r.queries.GetProfileStoryIDBySlugs(ctx, GetProfileStoryIDBySlugsParams{
+		ProfileSlug: profileSlug,
+		StorySlug:   storySlug,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return storyID, nil
+}
+
 // rowToThread converts a DiscussionThread SQLC row to a domain Thread.
 func (r *Repository) rowToThread(row *DiscussionThread) *discussions.Thread {
 	return &discussions.Thread{
@@ -97,6 +97,44 @@ func (r *Repository) ListByEntity(
 	return result, nil
 }
 
+// ListInRange returns a page of audit entries created within the filter's range.
+func (r *Repository) ListInRange(
+	ctx context.Context,
+	filter events.AuditRangeFilter,
+	after *events.AuditCursor,
+	limit int,
+) ([]*events.AuditEntry, error) {
+	params := ListEventAuditInRangeParams{
+		FromTime:        filter.From,
+		ToTime:          filter.To,
+		FilterEventType: sql.NullString{}, //nolint:exhaustruct
+		AfterCreatedAt:  sql.NullTime{},   //nolint:exhaustruct
+		AfterID:         sql.NullString{}, //nolint:exhaustruct
+		LimitCount:      int32(limit),
+	}
+
+	if filter.EventType != nil {
+		params.FilterEventType = sql.NullString{String: string(*filter.EventType), Valid: true}
+	}
+
+	if after != nil {
+		params.AfterCreatedAt = sql.NullTime{Time: after.CreatedAt, Valid: true}
+		params.AfterID = sql.NullString{String: after.ID, Valid: true}
+	}
+
+	rows, err := r.queries.ListEventAuditInRange(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*events.AuditEntry, len(rows))
+	for i, row := range rows {
+		result[i] = r.rowToAuditEntry(row)
+	}
+
+	return result, nil
+}
+
 // rowToAuditEntry converts a database row to an AuditEntry domain object.
 func (r *Repository) rowToAuditEntry(row *EventAudit) *events.AuditEntry {
 	var payload map[string]any
@@ -83,6 +83,47 @@ func (r *Repository) Fail(
 	return err
 }
 
+// GetByID returns an item by its ID.
+func (r *Repository) GetByID(ctx context.Context, id string) (*events.QueueItem, error) {
+	row, err := r.queries.GetQueueItemByID(ctx, GetQueueItemByIDParams{ID: id})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return r.rowToQueueItem(row), nil
+}
+
+// GetActiveByPayload returns the latest pending or processing item of a type
+// whose payload contains the given one.
+func (r *Repository) GetActiveByPayload(
+	ctx context.Context,
+	itemType events.QueueItemType,
+	payload map[string]any,
+) (*events.QueueItem, error) {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling event queue payload: %w", err)
+	}
+
+	row, err := r.queries.GetActiveQueueItemByPayload(ctx, GetActiveQueueItemByPayloadParams{
+		Type:    string(itemType),
+		Payload: pqtype.NullRawMessage{RawMessage: payloadJSON, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return r.rowToQueueItem(row), nil
+}
+
 // ListByType returns items of a given type for audit/debugging.
 func (r *Repository) ListByType(
 	ctx context.Context,
@@ -10,19 +10,23 @@ import (
 	"time"
 
 	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/lib/vars"
 	"github.com/sqlc-dev/pqtype"
 )
 
-// ListManagedLinksForKind returns all managed, non-deleted links of a kind.
+// ListManagedLinksForKind returns all managed, non-deleted links of a kind,
+// optionally limited to one profile's links.
 func (r *Repository) ListManagedLinksForKind(
 	ctx context.Context,
 	kind string,
+	profileID *string,
 	limit int,
 ) ([]*linksync.ManagedLink, error) {
 	rows, err := r.queries.ListManagedLinksForKind(ctx, ListManagedLinksForKindParams{
-		Kind:       kind,
-		LimitCount: int32(limit),
+		Kind:            kind,
+		FilterProfileID: vars.ToSQLNullString(profileID),
+		LimitCount:      int32(limit),
 	})
 	if err != nil {
 		return nil, err
@@ -266,15 +270,18 @@ func (r *Repository) ListImportsWithExistingStories(
 	return result, nil
 }
 
-// ListManagedLinksForKindPublic returns managed, non-deleted links of a kind (no OAuth tokens required).
+// ListManagedLinksForKindPublic returns managed, non-deleted links of a kind (no OAuth tokens required),
+// optionally limited to one profile's links.
 func (r *Repository) ListManagedLinksForKindPublic(
 	ctx context.Context,
 	kind string,
+	profileID *string,
 	limit int,
 ) ([]*linksync.PublicManagedLink, error) {
 	rows, err := r.queries.ListManagedLinksForKindPublic(ctx, ListManagedLinksForKindPublicParams{
-		Kind:       kind,
-		LimitCount: int32(limit),
+		Kind:            kind,
+		FilterProfileID: vars.ToSQLNullString(profileID),
+		LimitCount:      int32(limit),
 	})
 	if err != nil {
 		return nil, err
@@ -347,6 +354,22 @@ func (r *Repository) ClearStaleOnlineLinks(
 	})
 }
 
+// UpdateLinkSyncStatus records the outcome of a sync attempt for a link.
+func (r *Repository) UpdateLinkSyncStatus(
+	ctx context.Context,
+	linkID string,
+	status string,
+	syncError *string,
+) error {
+	_, err := r.queries.UpdateProfileLinkSyncStatus(ctx, UpdateProfileLinkSyncStatusParams{
+		ID:         linkID,
+		SyncStatus: sql.NullString{String: status, Valid: true},
+		SyncError:  vars.ToSQLNullString(syncError),
+	})
+
+	return err
+}
+
 // rowToLinkImport converts a database row to a LinkImport domain object.
 func (r *Repository) rowToLinkImport(row *ProfileLinkImport) *linksync.LinkImport {
 	var properties map[string]any
@@ -371,3 +394,72 @@ func (r *Repository) rowToLinkImport(row *ProfileLinkImport) *linksync.LinkImpor
 		DeletedAt:     vars.ToTimePtr(row.DeletedAt),
 	}
 }
+
+// ListProfileLinksForPostImport lists the profile links of a kind whose recent
+// posts are imported, grouped by profile.
+func (r *Repository) ListProfileLinksForPostImport(
+	ctx context.Context,
+	kind string,
+) ([]*profiles.PostImportLink, error) {
+	rows, err := r.queries.ListProfileLinksForPostImport(
+		ctx,
+		ListProfileLinksForPostImportParams{Kind: kind},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]*profiles.PostImportLink, len(rows))
+	for i, row := range rows {
+		links[i] = &profiles.PostImportLink{
+			RemoteID:        vars.ToStringPtr(row.RemoteID),
+			AuthAccessToken: vars.ToStringPtr(row.AuthAccessToken),
+			ID:              row.ID,
+			ProfileID:       row.ProfileID,
+		}
+	}
+
+	return links, nil
+}
+
+// GetProfileLinkImportIDByRemoteID returns the ID of a link's import with the
+// given remote ID, or an empty string when there is none.
+func (r *Repository) GetProfileLinkImportIDByRemoteID(
+	ctx context.Context,
+	linkID string,
+	remoteID string,
+) (string, error) {
+	row, err := r.queries.GetLinkImportByRemoteID(ctx, GetLinkImportByRemoteIDParams{
+		ProfileLinkID: linkID,
+		RemoteID:      sql.NullString{String: remoteID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return row.ID, nil
+}
+
+// CreateProfileLinkImport creates an import of a profile link.
+func (r *Repository) CreateProfileLinkImport(
+	ctx context.Context,
+	id string,
+	linkID string,
+	remoteID string,
+	properties map[string]any,
+) error {
+	return r.CreateLinkImport(ctx, id, linkID, remoteID, properties)
+}
+
+// UpdateProfileLinkImport updates the properties of a profile link import.
+func (r *Repository) UpdateProfileLinkImport(
+	ctx context.Context,
+	id string,
+	properties map[string]any,
+) error {
+	return r.UpdateLinkImport(ctx, id, properties)
+}
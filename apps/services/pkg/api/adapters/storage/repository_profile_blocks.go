@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+// InsertUserProfileBlock blocks the profile for the user. Reports false when it
+// was blocked already.
+func (r *Repository) InsertUserProfileBlock(
+	ctx context.Context,
+	userID string,
+	profileID string,
+) (bool, error) {
+	affected, err := r.queries.InsertUserProfileBlock(ctx, InsertUserProfileBlockParams{
+		UserID:    userID,
+		ProfileID: profileID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// DeleteUserProfileBlock unblocks the profile for the user. Reports false when
+// it was not blocked.
+func (r *Repository) DeleteUserProfileBlock(
+	ctx context.Context,
+	userID string,
+	profileID string,
+) (bool, error) {
+	affected, err := r.queries.DeleteUserProfileBlock(ctx, DeleteUserProfileBlockParams{
+		UserID:    userID,
+		ProfileID: profileID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (r *Repository) ListUserProfileBlocks(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+) ([]*profiles.BlockedProfile, error) {
+	rows, err := r.queries.ListUserProfileBlocks(ctx, ListUserProfileBlocksParams{
+		LocaleCode: localeCode,
+		UserID:     userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := make([]*profiles.BlockedProfile, len(rows))
+	for i, row := range rows {
+		blocks[i] = &profiles.BlockedProfile{
+			Profile: &profiles.ProfileBrief{
+				ID:                row.ID,
+				Slug:              row.Slug,
+				Kind:              row.Kind,
+				ProfilePictureURI: vars.ToStringPtr(row.ProfilePictureURI),
+				Title:             row.Title,
+				Description:       row.Description,
+			},
+			BlockedAt: row.BlockedAt,
+		}
+	}
+
+	return blocks, nil
+}
+
+// ListBlockedProfileIDs returns the IDs of the profiles the user blocked.
+func (r *Repository) ListBlockedProfileIDs(ctx context.Context, userID string) ([]string, error) {
+	return r.queries.ListBlockedProfileIDsByUser(ctx, ListBlockedProfileIDsByUserParams{UserID: userID})
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+func (r *Repository) GetActiveHandleReservationBySlug(
+	ctx context.Context,
+	slug string,
+) (*profiles.HandleReservation, error) {
+	row, err := r.queries.GetActiveHandleReservationBySlug(
+		ctx,
+		GetActiveHandleReservationBySlugParams{Slug: slug},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return &profiles.HandleReservation{
+		ID:                 row.ID,
+		Slug:               row.Slug,
+		VerificationEmail:  vars.ToStringPtr(row.VerificationEmail),
+		VerificationDomain: vars.ToStringPtr(row.VerificationDomain),
+		Note:               vars.ToStringPtr(row.Note),
+		ReservedByUserID:   row.ReservedByUserID,
+		ExpiresAt:          row.ExpiresAt,
+		ClaimedAt:          vars.ToTimePtr(row.ClaimedAt),
+		ClaimedByProfileID: vars.ToStringPtr(row.ClaimedByProfileID),
+		ReleasedAt:         vars.ToTimePtr(row.ReleasedAt),
+		CreatedAt:          row.CreatedAt,
+	}, nil
+}
+
+func (r *Repository) CreateHandleReservation(
+	ctx context.Context,
+	reservation *profiles.HandleReservation,
+) error {
+	return r.queries.CreateHandleReservation(ctx, CreateHandleReservationParams{
+		ID:                 reservation.ID,
+		Slug:               reservation.Slug,
+		VerificationEmail:  vars.ToSQLNullString(reservation.VerificationEmail),
+		VerificationDomain: vars.ToSQLNullString(reservation.VerificationDomain),
+		Note:               vars.ToSQLNullString(reservation.Note),
+		ReservedByUserID:   reservation.ReservedByUserID,
+		ExpiresAt:          reservation.ExpiresAt,
+	})
+}
+
+func (r *Repository) ReleaseHandleReservation(ctx context.Context, id string) (int64, error) {
+	return r.queries.ReleaseHandleReservation(ctx, ReleaseHandleReservationParams{ID: id})
+}
+
+func (r *Repository) ClaimHandleReservation(
+	ctx context.Context,
+	id string,
+	profileID string,
+) (int64, error) {
+	return r.queries.ClaimHandleReservation(ctx, ClaimHandleReservationParams{
+		ID:                 id,
+		ClaimedByProfileID: sql.NullString{String: profileID, Valid: true},
+	})
+}
@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+// RecordLinkClick stores a click on a profile link.
+func (r *Repository) RecordLinkClick(
+	ctx context.Context,
+	id string,
+	linkID string,
+	visitorUserID *string,
+) error {
+	return r.queries.InsertProfileLinkClick(ctx, InsertProfileLinkClickParams{
+		ID:            id,
+		ProfileLinkID: linkID,
+		VisitorUserID: vars.ToSQLNullString(visitorUserID),
+	})
+}
+
+// CountLinkClicksByDay returns the clicks on a profile link per UTC day since
+// the given time, oldest first. Days without clicks are left out.
+func (r *Repository) CountLinkClicksByDay(
+	ctx context.Context,
+	linkID string,
+	since time.Time,
+) ([]*profiles.LinkClickDay, error) {
+	rows, err := r.queries.CountProfileLinkClicksByDay(ctx, CountProfileLinkClicksByDayParams{
+		ProfileLinkID: linkID,
+		Since:         since,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	days := make([]*profiles.LinkClickDay, len(rows))
+	for i, row := range rows {
+		days[i] = &profiles.LinkClickDay{
+			Day:    row.Day,
+			Clicks: row.Clicks,
+		}
+	}
+
+	return days, nil
+}
@@ -0,0 +1,44 @@
+package storage //nolint:testpackage
+
+import (
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextPageCursor(t *testing.T) {
+	t.Parallel()
+
+	// Three links read two at a time.
+	firstPage := nextPageCursor(cursors.NewCursor(2, nil), 0, 2)
+	require.NotNil(t, firstPage)
+	assert.Equal(t, "2", *firstPage)
+
+	followUp := cursors.NewCursor(2, firstPage)
+	secondPage := nextPageCursor(followUp, parsePageOffset(followUp), 1)
+	assert.Nil(t, secondPage)
+
+	tests := map[string]struct {
+		cursor   *cursors.Cursor
+		pageSize int
+	}{
+		"empty result": {
+			cursor:   cursors.NewCursor(2, nil),
+			pageSize: 0,
+		},
+		"without cursor": {
+			cursor:   nil,
+			pageSize: 3,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Nil(t, nextPageCursor(tt.cursor, 0, tt.pageSize))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+func (r *Repository) CreateMembershipInvitation(
+	ctx context.Context,
+	invitation *profiles.MembershipInvitation,
+) error {
+	return r.queries.CreateProfileMembershipInvitation(ctx, CreateProfileMembershipInvitationParams{
+		ID:               invitation.ID,
+		ProfileID:        invitation.ProfileID,
+		InviteeProfileID: invitation.InviteeProfileID,
+		Kind:             invitation.Kind,
+		InvitedByUserID:  invitation.InvitedByUserID,
+		ExpiresAt:        invitation.ExpiresAt,
+	})
+}
+
+func (r *Repository) GetMembershipInvitationByID(
+	ctx context.Context,
+	id string,
+) (*profiles.MembershipInvitation, error) {
+	row, err := r.queries.GetProfileMembershipInvitationByID(
+		ctx,
+		GetProfileMembershipInvitationByIDParams{ID: id},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return toMembershipInvitation(row), nil
+}
+
+func (r *Repository) GetPendingMembershipInvitation(
+	ctx context.Context,
+	profileID string,
+	inviteeProfileID string,
+) (*profiles.MembershipInvitation, error) {
+	row, err := r.queries.GetPendingProfileMembershipInvitation(
+		ctx,
+		GetPendingProfileMembershipInvitationParams{
+			ProfileID:        profileID,
+			InviteeProfileID: inviteeProfileID,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return toMembershipInvitation(row), nil
+}
+
+func (r *Repository) ListPendingMembershipInvitations(
+	ctx context.Context,
+	profileID string,
+) ([]*profiles.MembershipInvitation, error) {
+	rows, err := r.queries.ListPendingProfileMembershipInvitations(
+		ctx,
+		ListPendingProfileMembershipInvitationsParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*profiles.MembershipInvitation, len(rows))
+	for i, row := range rows {
+		invitations[i] = toMembershipInvitation(row)
+	}
+
+	return invitations, nil
+}
+
+func (r *Repository) RespondToMembershipInvitation(
+	ctx context.Context,
+	id string,
+	status string,
+) (int64, error) {
+	return r.queries.RespondToProfileMembershipInvitation(
+		ctx,
+		RespondToProfileMembershipInvitationParams{
+			Status: status,
+			ID:     id,
+		},
+	)
+}
+
+func toMembershipInvitation(row *ProfileMembershipInvitation) *profiles.MembershipInvitation {
+	return &profiles.MembershipInvitation{
+		CreatedAt:        row.CreatedAt,
+		ExpiresAt:        row.ExpiresAt,
+		RespondedAt:      vars.ToTimePtr(row.RespondedAt),
+		ID:               row.ID,
+		ProfileID:        row.ProfileID,
+		InviteeProfileID: row.InviteeProfileID,
+		Kind:             row.Kind,
+		Status:           row.Status,
+		InvitedByUserID:  row.InvitedByUserID,
+	}
+}
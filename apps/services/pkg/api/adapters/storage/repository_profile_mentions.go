@@ -0,0 +1,217 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+)
+
+// ListProfilesBySlugs resolves mentioned slugs to existing profiles.
+func (r *Repository) ListProfilesBySlugs(
+	ctx context.Context,
+	slugs []string,
+) ([]*profile_mentions.MentionedProfile, error) {
+	rows, err := r.queries.ListProfilesBySlugsForMention(ctx, ListProfilesBySlugsForMentionParams{
+		Slugs: strings.Join(slugs, ","),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*profile_mentions.MentionedProfile, len(rows))
+	for i, row := range rows {
+		result[i] = &profile_mentions.MentionedProfile{
+			ProfileID: row.ID,
+			Slug:      row.Slug,
+		}
+	}
+
+	return result, nil
+}
+
+// GetMentionSourceProfileID returns the profile owning a story (author) or page.
+func (r *Repository) GetMentionSourceProfileID(
+	ctx context.Context,
+	sourceKind string,
+	sourceID string,
+) (string, error) {
+	switch sourceKind {
+	case profile_mentions.SourceKindStory:
+		authorProfileID, err := r.queries.GetStoryAuthorProfileIDForMention(
+			ctx,
+			GetStoryAuthorProfileIDForMentionParams{ID: sourceID},
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+
+			return "", err
+		}
+
+		return authorProfileID.String, nil
+	case profile_mentions.SourceKindPage:
+		profileID, err := r.queries.GetProfilePageProfileIDForMention(
+			ctx,
+			GetProfilePageProfileIDForMentionParams{ID: sourceID},
+		)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return "", nil
+			}
+
+			return "", err
+		}
+
+		return profileID, nil
+	default:
+		return "", fmt.Errorf("%w: %s", profile_mentions.ErrInvalidSourceKind, sourceKind)
+	}
+}
+
+// ListMentionsBySource lists the profiles mentioned by a source in a locale.
+func (r *Repository) ListMentionsBySource(
+	ctx context.Context,
+	sourceKind string,
+	sourceID string,
+	localeCode string,
+) ([]*profile_mentions.MentionedProfile, error) {
+	rows, err := r.queries.ListProfileMentionsBySource(ctx, ListProfileMentionsBySourceParams{
+		SourceKind: sourceKind,
+		SourceID:   sourceID,
+		LocaleCode: localeCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*profile_mentions.MentionedProfile, len(rows))
+	for i, row := range rows {
+		result[i] = &profile_mentions.MentionedProfile{
+			ProfileID: row.MentionedProfileID,
+			Slug:      row.Slug,
+		}
+	}
+
+	return result, nil
+}
+
+// ReplaceMentionsBySource replaces the stored mentions of a source in a locale.
+func (r *Repository) ReplaceMentionsBySource(
+	ctx context.Context,
+	sourceKind string,
+	sourceID string,
+	localeCode string,
+	mentions []*profile_mentions.Mention,
+) error {
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning mention replace transaction: %w", err)
+	}
+
+	defer func() {
+		_ = dbTx.Rollback()
+	}()
+
+	queriesTx := r.queries.WithTx(dbTx)
+
+	err = queriesTx.DeleteProfileMentionsBySource(ctx, DeleteProfileMentionsBySourceParams{
+		SourceKind: sourceKind,
+		SourceID:   sourceID,
+		LocaleCode: localeCode,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, mention := range mentions {
+		err = queriesTx.InsertProfileMention(ctx, InsertProfileMentionParams{
+			ID:                 mention.ID,
+			SourceKind:         mention.SourceKind,
+			SourceID:           mention.SourceID,
+			SourceProfileID:    mention.SourceProfileID,
+			MentionedProfileID: mention.MentionedProfileID,
+			LocaleCode:         mention.LocaleCode,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dbTx.Commit()
+	if err != nil {
+		return fmt.Errorf("committing mention replace transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListStoryMentionsOfProfile lists public stories that mention a profile.
+func (r *Repository) ListStoryMentionsOfProfile(
+	ctx context.Context,
+	localeCode string,
+	mentionedProfileID string,
+	limit int,
+) ([]*profile_mentions.MentionListItem, error) {
+	rows, err := r.queries.ListStoryMentionsOfProfile(ctx, ListStoryMentionsOfProfileParams{
+		LocaleCode:         localeCode,
+		MentionedProfileID: mentionedProfileID,
+		LimitCount:         safeInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*profile_mentions.MentionListItem, len(rows))
+	for i, row := range rows {
+		result[i] = &profile_mentions.MentionListItem{
+			CreatedAt:          row.CreatedAt,
+			SourceKind:         profile_mentions.SourceKindStory,
+			SourceID:           row.SourceID,
+			SourceSlug:         row.SourceSlug,
+			SourceTitle:        row.SourceTitle,
+			SourceProfileSlug:  row.SourceProfileSlug,
+			SourceProfileTitle: row.SourceProfileTitle,
+			LocaleCode:         strings.TrimRight(row.LocaleCode, " "),
+		}
+	}
+
+	return result, nil
+}
+
+// ListPageMentionsOfProfile lists public pages that mention a profile.
+func (r *Repository) ListPageMentionsOfProfile(
+	ctx context.Context,
+	localeCode string,
+	mentionedProfileID string,
+	limit int,
+) ([]*profile_mentions.MentionListItem, error) {
+	rows, err := r.queries.ListPageMentionsOfProfile(ctx, ListPageMentionsOfProfileParams{
+		LocaleCode:         localeCode,
+		MentionedProfileID: mentionedProfileID,
+		LimitCount:         safeInt32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*profile_mentions.MentionListItem, len(rows))
+	for i, row := range rows {
+		result[i] = &profile_mentions.MentionListItem{
+			CreatedAt:          row.CreatedAt,
+			SourceKind:         profile_mentions.SourceKindPage,
+			SourceID:           row.SourceID,
+			SourceSlug:         row.SourceSlug,
+			SourceTitle:        row.SourceTitle,
+			SourceProfileSlug:  row.SourceProfileSlug,
+			SourceProfileTitle: row.SourceProfileTitle,
+			LocaleCode:         strings.TrimRight(row.LocaleCode, " "),
+		}
+	}
+
+	return result, nil
+}
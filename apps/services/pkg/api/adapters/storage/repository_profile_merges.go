@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+)
+
+func (r *Repository) MoveProfileLinks(
+	ctx context.Context,
+	sourceProfileID string,
+	targetProfileID string,
+) (int64, error) {
+	return r.queries.MoveProfileLinks(ctx, MoveProfileLinksParams{
+		TargetProfileID: targetProfileID,
+		SourceProfileID: sourceProfileID,
+	})
+}
+
+func (r *Repository) MoveProfilePages(
+	ctx context.Context,
+	sourceProfileID string,
+	targetProfileID string,
+) (int64, error) {
+	return r.queries.MoveProfilePages(ctx, MoveProfilePagesParams{
+		TargetProfileID: targetProfileID,
+		SourceProfileID: sourceProfileID,
+	})
+}
+
+func (r *Repository) MoveProfileResources(
+	ctx context.Context,
+	sourceProfileID string,
+	targetProfileID string,
+) (int64, error) {
+	return r.queries.MoveProfileResources(ctx, MoveProfileResourcesParams{
+		TargetProfileID: targetProfileID,
+		SourceProfileID: sourceProfileID,
+	})
+}
+
+// MoveProfileMemberships moves both sides of the source profile's memberships:
+// the ones it holds in other profiles and the members it has.
+func (r *Repository) MoveProfileMemberships(
+	ctx context.Context,
+	sourceProfileID string,
+	targetProfileID string,
+) (int64, error) {
+	asMember, err := r.queries.MoveProfileMembershipsAsMember(
+		ctx,
+		MoveProfileMembershipsAsMemberParams{
+			TargetProfileID: sql.NullString{String: targetProfileID, Valid: true},
+			SourceProfileID: sql.NullString{String: sourceProfileID, Valid: true},
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	ofProfile, err := r.queries.MoveProfileMembershipsOfProfile(
+		ctx,
+		MoveProfileMembershipsOfProfileParams{
+			TargetProfileID: targetProfileID,
+			SourceProfileID: sourceProfileID,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return asMember + ofProfile, nil
+}
+
+func (r *Repository) RepointUserIndividualProfile(
+	ctx context.Context,
+	sourceProfileID string,
+	targetProfileID string,
+) (int64, error) {
+	return r.queries.RepointUserIndividualProfile(ctx, RepointUserIndividualProfileParams{
+		TargetProfileID: sql.NullString{String: targetProfileID, Valid: true},
+		SourceProfileID: sql.NullString{String: sourceProfileID, Valid: true},
+	})
+}
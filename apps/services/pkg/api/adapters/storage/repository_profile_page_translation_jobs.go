@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+func (r *Repository) InsertProfilePageTranslationJob(
+	ctx context.Context,
+	job *profiles.TranslationJob,
+) error {
+	return r.queries.InsertProfilePageTranslationJob(ctx, InsertProfilePageTranslationJobParams{
+		ID:           job.ID,
+		PageID:       job.PageID,
+		UserID:       job.UserID,
+		SourceLocale: job.SourceLocale,
+		Total:        int32(job.Total), //nolint:gosec // bounded by the supported locales
+	})
+}
+
+// GetProfilePageTranslationJob returns the job with the results recorded so
+// far, or nil when there is no such job.
+func (r *Repository) GetProfilePageTranslationJob(
+	ctx context.Context,
+	id string,
+) (*profiles.TranslationJob, error) {
+	row, err := r.queries.GetProfilePageTranslationJob(ctx, GetProfilePageTranslationJobParams{
+		ID: id,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	rows, err := r.queries.ListProfilePageTranslationJobResults(
+		ctx,
+		ListProfilePageTranslationJobResultsParams{JobID: id},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &profiles.TranslationJob{
+		CreatedAt:    row.CreatedAt,
+		FinishedAt:   nil,
+		ID:           row.ID,
+		PageID:       row.PageID,
+		SourceLocale: strings.TrimRight(row.SourceLocale, " "),
+		Status:       profiles.TranslationJobRunning,
+		Results:      make([]profiles.LocaleTranslationResult, 0, len(rows)),
+		Total:        int(row.Total),
+		UserID:       row.UserID,
+	}
+
+	for _, result := range rows {
+		job.Results = append(job.Results, profiles.LocaleTranslationResult{
+			Reason:     vars.ToStringPtr(result.Reason),
+			LocaleCode: strings.TrimRight(result.LocaleCode, " "),
+			Status:     profiles.LocaleTranslationStatus(result.Status),
+		})
+
+		if job.FinishedAt == nil || result.CreatedAt.After(*job.FinishedAt) {
+			finishedAt := result.CreatedAt
+			job.FinishedAt = &finishedAt
+		}
+	}
+
+	if len(job.Results) < job.Total {
+		job.FinishedAt = nil
+	} else {
+		job.Status = profiles.TranslationJobCompleted
+	}
+
+	return job, nil
+}
+
+// InsertProfilePageTranslationJobResult records the outcome of a locale.
+// Reports false when the locale already has one.
+func (r *Repository) InsertProfilePageTranslationJobResult(
+	ctx context.Context,
+	jobID string,
+	result profiles.LocaleTranslationResult,
+) (bool, error) {
+	affected, err := r.queries.InsertProfilePageTranslationJobResult(
+		ctx,
+		InsertProfilePageTranslationJobResultParams{
+			JobID:      jobID,
+			LocaleCode: result.LocaleCode,
+			Status:     string(result.Status),
+			Reason:     vars.ToSQLNullString(result.Reason),
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
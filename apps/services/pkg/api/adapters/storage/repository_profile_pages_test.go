@@ -0,0 +1,130 @@
+package storage //nolint:testpackage
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"modernc.org/sqlite"
+)
+
+var registerSQLiteNowOnce sync.Once //nolint:gochecknoglobals
+
+// newSQLiteTestRepository creates a repository over an in-memory SQLite
+// database with the given schema, with NOW() provided so the generated queries
+// run as-is.
+func newSQLiteTestRepository(t *testing.T, schema ...string) *Repository {
+	t.Helper()
+
+	registerSQLiteNowOnce.Do(func() {
+		err := sqlite.RegisterScalarFunction(
+			"now",
+			0,
+			func(_ *sqlite.FunctionContext, _ []driver.Value) (driver.Value, error) {
+				return time.Now().UTC().Format(time.RFC3339Nano), nil
+			},
+		)
+		require.NoError(t, err)
+	})
+
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared&_time_format=sqlite")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	for _, statement := range schema {
+		_, err = db.ExecContext(t.Context(), statement)
+		require.NoError(t, err)
+	}
+
+	return &Repository{ //nolint:exhaustruct
+		db:      db,
+		dbtx:    db,
+		queries: New(db),
+	}
+}
+
+// newProfilePageTestRepository creates a repository over a profile_page table.
+func newProfilePageTestRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	return newSQLiteTestRepository(t, `CREATE TABLE profile_page (
+		id TEXT PRIMARY KEY,
+		profile_id TEXT NOT NULL,
+		slug TEXT NOT NULL,
+		"order" INTEGER NOT NULL,
+		cover_picture_uri TEXT,
+		published_at DATETIME,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME,
+		deleted_at DATETIME,
+		added_by_profile_id TEXT,
+		visibility TEXT NOT NULL
+	)`)
+}
+
+func TestRepository_ProfilePagePublishedAt(t *testing.T) {
+	t.Parallel()
+
+	repo := newProfilePageTestRepository(t)
+	scheduledAt := time.Now().UTC().Add(72 * time.Hour).Truncate(time.Second)
+	scheduled := scheduledAt.Format(time.RFC3339)
+
+	created, err := repo.CreateProfilePage(
+		t.Context(), "page-1", "about", "profile-1", 1, nil, &scheduled, nil, "public",
+	)
+	require.NoError(t, err)
+	require.NotNil(t, created.PublishedAt)
+	assert.True(t, scheduledAt.Equal(*created.PublishedAt))
+
+	page, err := repo.GetProfilePage(t.Context(), "page-1")
+	require.NoError(t, err)
+	require.NotNil(t, page.PublishedAt)
+	assert.True(t, scheduledAt.Equal(*page.PublishedAt))
+
+	// Rescheduling replaces the timestamp
+	rescheduledAt := scheduledAt.Add(24 * time.Hour)
+	rescheduled := rescheduledAt.In(time.FixedZone("UTC+3", 3*60*60)).Format(time.RFC3339)
+
+	err = repo.UpdateProfilePage(t.Context(), "page-1", "about", 1, nil, &rescheduled, "public")
+	require.NoError(t, err)
+
+	page, err = repo.GetProfilePage(t.Context(), "page-1")
+	require.NoError(t, err)
+	require.NotNil(t, page.PublishedAt)
+	assert.True(t, rescheduledAt.Equal(*page.PublishedAt))
+
+	// Clearing it unpublishes the page
+	err = repo.UpdateProfilePage(t.Context(), "page-1", "about", 1, nil, nil, "public")
+	require.NoError(t, err)
+
+	page, err = repo.GetProfilePage(t.Context(), "page-1")
+	require.NoError(t, err)
+	assert.Nil(t, page.PublishedAt)
+}
+
+func TestRepository_ProfilePageInvalidPublishedAt(t *testing.T) {
+	t.Parallel()
+
+	repo := newProfilePageTestRepository(t)
+	malformed := "next tuesday"
+
+	_, err := repo.CreateProfilePage(
+		t.Context(), "page-1", "about", "profile-1", 1, nil, &malformed, nil, "public",
+	)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+
+	page, err := repo.GetProfilePage(t.Context(), "page-1")
+	require.NoError(t, err)
+	assert.Nil(t, page)
+
+	err = repo.UpdateProfilePage(t.Context(), "page-1", "about", 1, nil, &malformed, "public")
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+}
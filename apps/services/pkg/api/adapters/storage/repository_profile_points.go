@@ -137,6 +137,71 @@ func (r *Repository) RecordTransaction( //nolint:cyclop,funlen
 	return r.rowToProfilePointTransaction(row), nil
 }
 
+// RecordAward credits an action award and records it in the ledger in one
+// transaction. It returns nil without changing the balance when the award key
+// was already used.
+func (r *Repository) RecordAward(
+	ctx context.Context,
+	transactionID string,
+	targetProfileID string,
+	triggeringEvent string,
+	awardKey string,
+	description string,
+	amount uint64,
+) (*profile_points.Transaction, error) {
+	var result *profile_points.Transaction
+
+	err := r.withTx(ctx, func(txRepo *Repository) error {
+		// Locks the profile row, so concurrent awards for the same key serialize
+		// on it before the key is checked.
+		rowsAffected, err := txRepo.queries.AddPointsToProfile(ctx, AddPointsToProfileParams{
+			ID:     targetProfileID,
+			Amount: int32(amount), //nolint:gosec
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return profile_points.ErrProfileNotFound
+		}
+
+		newBalance, err := txRepo.queries.GetProfilePoints(ctx, GetProfilePointsParams{
+			ProfileID: targetProfileID,
+		})
+		if err != nil {
+			return err
+		}
+
+		row, err := txRepo.queries.RecordProfilePointAward(ctx, RecordProfilePointAwardParams{
+			ID:              transactionID,
+			TargetProfileID: targetProfileID,
+			TriggeringEvent: sql.NullString{String: triggeringEvent, Valid: true},
+			AwardKey:        sql.NullString{String: awardKey, Valid: true},
+			Description:     description,
+			Amount:          int32(amount), //nolint:gosec
+			BalanceAfter:    newBalance,
+		})
+		if err != nil {
+			return err
+		}
+
+		result = r.rowToProfilePointTransaction(row)
+
+		return nil
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Already awarded; the rollback undid the balance change.
+		return nil, nil //nolint:nilnil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // ListTransactionsByProfileID returns transactions for a profile with pagination.
 func (r *Repository) ListTransactionsByProfileID(
 	ctx context.Context,
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
 	"github.com/eser/aya.is/services/pkg/lib/cursors"
@@ -141,6 +142,54 @@ func (r *Repository) UpdateHidden(ctx context.Context, questionID string, isHidd
 	})
 }
 
+// UpdateResolved marks a question resolved or reopens it.
+func (r *Repository) UpdateResolved(ctx context.Context, questionID string, isResolved bool) error {
+	return r.queries.UpdateProfileQuestionResolved(ctx, UpdateProfileQuestionResolvedParams{
+		IsResolved: isResolved,
+		ID:         questionID,
+	})
+}
+
+// GetQuestionAuthorProfileID returns the individual profile ID of the asker.
+func (r *Repository) GetQuestionAuthorProfileID(
+	ctx context.Context,
+	questionID string,
+) (*string, error) {
+	result, err := r.queries.GetProfileQuestionAuthorProfileID(
+		ctx,
+		GetProfileQuestionAuthorProfileIDParams{ID: questionID},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return vars.ToStringPtr(result), nil
+}
+
+// CountQuestionsByAuthorSince counts the questions a user asked since the given time.
+func (r *Repository) CountQuestionsByAuthorSince(
+	ctx context.Context,
+	userID string,
+	since time.Time,
+) (int, error) {
+	count, err := r.queries.CountProfileQuestionsByAuthorSince(
+		ctx,
+		CountProfileQuestionsByAuthorSinceParams{
+			AuthorUserID: userID,
+			Since:        since,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
 // InsertVote creates a new vote record and increments the question's vote count.
 func (r *Repository) InsertVote(
 	ctx context.Context,
@@ -269,6 +318,7 @@ func (r *Repository) rowToQuestion(row *ProfileQuestion) *profile_questions.Ques
 		AnsweredByProfileID:    vars.ToStringPtr(row.AnsweredBy),
 		AnsweredByProfileSlug:  nil,
 		AnsweredByProfileTitle: nil,
+		ResolvedAt:             vars.ToTimePtr(row.ResolvedAt),
 		VoteCount:              int(row.VoteCount),
 		IsAnonymous:            row.IsAnonymous,
 		IsHidden:               row.IsHidden,
@@ -296,6 +346,7 @@ func (r *Repository) listRowToQuestion(
 		AnsweredByProfileID:    vars.ToStringPtr(row.AnsweredBy),
 		AnsweredByProfileSlug:  vars.ToStringPtr(row.AnsweredByProfileSlug),
 		AnsweredByProfileTitle: vars.ToStringPtr(row.AnsweredByProfileTitle),
+		ResolvedAt:             vars.ToTimePtr(row.ResolvedAt),
 		VoteCount:              int(row.VoteCount),
 		IsAnonymous:            row.IsAnonymous,
 		IsHidden:               row.IsHidden,
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+// RecordRecentProfileView upserts the view and trims the user's list to keepCount
+// entries in a single transaction.
+func (r *Repository) RecordRecentProfileView(
+	ctx context.Context,
+	userID string,
+	profileID string,
+	keepCount int,
+) error {
+	return r.withTx(ctx, func(txRepo *Repository) error {
+		affected, err := txRepo.queries.UpsertRecentProfileView(ctx, UpsertRecentProfileViewParams{
+			ProfileID: profileID,
+			UserID:    userID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Own profiles are filtered out by the upsert; nothing to trim.
+		if affected == 0 {
+			return nil
+		}
+
+		_, err = txRepo.queries.TrimRecentProfileViews(ctx, TrimRecentProfileViewsParams{
+			UserID:    userID,
+			KeepCount: int32(keepCount),
+		})
+
+		return err
+	})
+}
+
+func (r *Repository) ListRecentProfileViews(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+	limit int,
+) ([]*profiles.RecentProfileView, error) {
+	rows, err := r.queries.ListRecentProfileViews(ctx, ListRecentProfileViewsParams{
+		LocaleCode: localeCode,
+		UserID:     userID,
+		LimitCount: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]*profiles.RecentProfileView, len(rows))
+	for i, row := range rows {
+		views[i] = &profiles.RecentProfileView{
+			Profile: &profiles.ProfileBrief{
+				ID:                row.ID,
+				Slug:              row.Slug,
+				Kind:              row.Kind,
+				ProfilePictureURI: vars.ToStringPtr(row.ProfilePictureURI),
+				Title:             row.Title,
+				Description:       row.Description,
+			},
+			ViewedAt: row.ViewedAt,
+		}
+	}
+
+	return views, nil
+}
@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+// InsertProfileReport files a report. Reports false when the reporter already
+// has an open report on the entity.
+func (r *Repository) InsertProfileReport(
+	ctx context.Context,
+	report *profiles.ProfileReport,
+) (bool, error) {
+	affected, err := r.queries.InsertProfileReport(ctx, InsertProfileReportParams{
+		ID:             report.ID,
+		ProfileID:      report.ProfileID,
+		TargetKind:     string(report.TargetKind),
+		TargetID:       report.TargetID,
+		ReporterUserID: vars.ToSQLNullString(report.ReporterUserID),
+		ReporterKey:    report.ReporterKey,
+		Reason:         string(report.Reason),
+		Text:           vars.ToSQLNullString(report.Text),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetOpenProfileReportByReporter returns the reporter's open report on the
+// entity, or nil when there is none.
+func (r *Repository) GetOpenProfileReportByReporter(
+	ctx context.Context,
+	targetKind profiles.ReportTargetKind,
+	targetID string,
+	reporterKey string,
+) (*profiles.ProfileReport, error) {
+	row, err := r.queries.GetOpenProfileReportByReporter(ctx, GetOpenProfileReportByReporterParams{
+		TargetKind:  string(targetKind),
+		TargetID:    targetID,
+		ReporterKey: reporterKey,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return &profiles.ProfileReport{
+		CreatedAt:        row.CreatedAt,
+		ReporterUserID:   vars.ToStringPtr(row.ReporterUserID),
+		Text:             vars.ToStringPtr(row.Text),
+		ResolvedByUserID: vars.ToStringPtr(row.ResolvedByUserID),
+		ResolvedAt:       vars.ToTimePtr(row.ResolvedAt),
+		ID:               row.ID,
+		ProfileID:        row.ProfileID,
+		ProfileSlug:      row.ProfileSlug,
+		TargetKind:       profiles.ReportTargetKind(row.TargetKind),
+		TargetID:         row.TargetID,
+		ReporterKey:      row.ReporterKey,
+		Reason:           profiles.ReportReason(row.Reason),
+		Status:           profiles.ProfileReportStatus(row.Status),
+	}, nil
+}
+
+func (r *Repository) CountProfileReportsByReporterSince(
+	ctx context.Context,
+	reporterKey string,
+	since time.Time,
+) (int, error) {
+	count, err := r.queries.CountProfileReportsByReporterSince(
+		ctx,
+		CountProfileReportsByReporterSinceParams{
+			ReporterKey: reporterKey,
+			Since:       since,
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (r *Repository) ListProfileReportsByStatus(
+	ctx context.Context,
+	status profiles.ProfileReportStatus,
+	limit int,
+	offset int,
+) ([]*profiles.ProfileReport, error) {
+	rows, err := r.queries.ListProfileReportsByStatus(ctx, ListProfileReportsByStatusParams{
+		Status:      string(status),
+		OffsetCount: int32(offset), //nolint:gosec
+		LimitCount:  int32(limit),  //nolint:gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*profiles.ProfileReport, 0, len(rows))
+	for _, row := range rows {
+		reports = append(reports, &profiles.ProfileReport{
+			CreatedAt:        row.CreatedAt,
+			ReporterUserID:   vars.ToStringPtr(row.ReporterUserID),
+			Text:             vars.ToStringPtr(row.Text),
+			ResolvedByUserID: vars.ToStringPtr(row.ResolvedByUserID),
+			ResolvedAt:       vars.ToTimePtr(row.ResolvedAt),
+			ID:               row.ID,
+			ProfileID:        row.ProfileID,
+			ProfileSlug:      row.ProfileSlug,
+			TargetKind:       profiles.ReportTargetKind(row.TargetKind),
+			TargetID:         row.TargetID,
+			ReporterKey:      row.ReporterKey,
+			Reason:           profiles.ReportReason(row.Reason),
+			Status:           profiles.ProfileReportStatus(row.Status),
+		})
+	}
+
+	return reports, nil
+}
+
+func (r *Repository) ResolveProfileReport(
+	ctx context.Context,
+	id string,
+	status profiles.ProfileReportStatus,
+	resolvedByUserID string,
+) (int64, error) {
+	return r.queries.ResolveProfileReport(ctx, ResolveProfileReportParams{
+		Status:           string(status),
+		ResolvedByUserID: sql.NullString{String: resolvedByUserID, Valid: true},
+		ID:               id,
+	})
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+)
+
+// UpdateProfileSlug renames a live profile. Reports false when it was not live.
+func (r *Repository) UpdateProfileSlug(ctx context.Context, id string, slug string) (bool, error) {
+	affected, err := r.queries.UpdateProfileSlug(ctx, UpdateProfileSlugParams{
+		Slug: slug,
+		ID:   id,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	r.invalidateProfileLists(ctx)
+
+	return affected > 0, nil
+}
+
+func (r *Repository) InsertProfileSlugHistory(
+	ctx context.Context,
+	id string,
+	profileID string,
+	slug string,
+) error {
+	return r.queries.InsertProfileSlugHistory(ctx, InsertProfileSlugHistoryParams{
+		ID:        id,
+		ProfileID: profileID,
+		Slug:      slug,
+	})
+}
+
+func (r *Repository) DeleteProfileSlugHistory(
+	ctx context.Context,
+	profileID string,
+	slug string,
+) (int64, error) {
+	return r.queries.DeleteProfileSlugHistory(ctx, DeleteProfileSlugHistoryParams{
+		Slug:      slug,
+		ProfileID: profileID,
+	})
+}
+
+// GetProfileSlugHistoryProfileID returns the profile that used the slug before,
+// or "" when no profile did.
+func (r *Repository) GetProfileSlugHistoryProfileID(ctx context.Context, slug string) (string, error) {
+	profileID, err := r.queries.GetProfileSlugHistoryProfileID(
+		ctx,
+		GetProfileSlugHistoryProfileIDParams{Slug: slug},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return profileID, nil
+}
+
+// GetCurrentSlugByPreviousSlug returns the current slug of the live profile
+// that used the given slug before, or "" when there is none.
+func (r *Repository) GetCurrentSlugByPreviousSlug(ctx context.Context, slug string) (string, error) {
+	currentSlug, err := r.queries.GetCurrentSlugByPreviousSlug(
+		ctx,
+		GetCurrentSlugByPreviousSlugParams{Slug: slug},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return currentSlug, nil
+}
@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/caching"
 	"github.com/eser/aya.is/services/pkg/lib/cursors"
 	"github.com/eser/aya.is/services/pkg/lib/vars"
 	"github.com/sqlc-dev/pqtype"
@@ -36,7 +38,7 @@ func (r *Repository) GetProfileIDBySlug(ctx context.Context, slug string) (strin
 
 	err := r.cache.Execute(
 		ctx,
-		"profile_id_by_slug:"+slug,
+		CacheKeyProfileIDBySlug+":"+slug,
 		&result,
 		func(ctx context.Context) (any, error) {
 			row, err := r.queries.GetProfileIDBySlug(ctx, GetProfileIDBySlugParams{Slug: slug})
@@ -55,6 +57,100 @@ func (r *Repository) GetProfileIDBySlug(ctx context.Context, slug string) (strin
 	return result, err //nolint:wrapcheck
 }
 
+// GetDeletedProfileIDBySlug resolves the slug of a soft-deleted profile to its ID.
+func (r *Repository) GetDeletedProfileIDBySlug(ctx context.Context, slug string) (string, error) {
+	id, err := r.queries.GetDeletedProfileIDBySlug(ctx, GetDeletedProfileIDBySlugParams{Slug: slug})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return id, nil
+}
+
+// invalidateProfileLists drops the cached profile list pages and leaderboards
+// after a write that can change them. Inside a transaction this happens once it
+// commits, so a concurrent read cannot cache the old rows again. On failure
+// they are left to expire.
+func (r *Repository) invalidateProfileLists(ctx context.Context) {
+	r.onCommit(ctx, func(ctx context.Context) {
+		for _, class := range []string{CacheKeyProfileList, CacheKeyPointsLeaderboard} {
+			err := r.cache.InvalidatePrefix(ctx, class+caching.KeyClassSeparator)
+			if err != nil {
+				r.logger.WarnContext(ctx, "Failed to invalidate profile list cache",
+					slog.String("class", class),
+					slog.Any("error", err))
+			}
+		}
+	})
+}
+
+func (r *Repository) InvalidateProfileSlugCache(ctx context.Context, slug string) error {
+	err := r.cache.Invalidate(ctx, CacheKeyProfileIDBySlug+":"+slug)
+	if err != nil {
+		return fmt.Errorf("invalidating profile slug cache: %w", err)
+	}
+
+	return nil
+}
+
+// SoftDeleteProfile marks a profile deleted. Reports false when it was not live.
+func (r *Repository) SoftDeleteProfile(ctx context.Context, id string) (bool, error) {
+	affected, err := r.queries.RemoveProfile(ctx, RemoveProfileParams{ID: id})
+	if err != nil {
+		return false, err
+	}
+
+	r.invalidateProfileLists(ctx)
+
+	return affected > 0, nil
+}
+
+// RestoreProfile clears the deletion mark of a profile. Reports false when it was not deleted.
+func (r *Repository) RestoreProfile(ctx context.Context, id string) (bool, error) {
+	affected, err := r.queries.RestoreProfile(ctx, RestoreProfileParams{ID: id})
+	if err != nil {
+		return false, err
+	}
+
+	r.invalidateProfileLists(ctx)
+
+	return affected > 0, nil
+}
+
+// GetProfileCounts returns the profile's badge totals, cached per profile.
+func (r *Repository) GetProfileCounts(
+	ctx context.Context,
+	profileID string,
+) (*profiles.ProfileCounts, error) {
+	var result profiles.ProfileCounts
+
+	err := r.cache.Execute(
+		ctx,
+		CacheKeyProfileCounts+":"+profileID,
+		&result,
+		func(ctx context.Context) (any, error) {
+			row, err := r.queries.GetProfileCounts(ctx, GetProfileCountsParams{ProfileID: profileID})
+			if err != nil {
+				return nil, err
+			}
+
+			return profiles.ProfileCounts{
+				Members:       &row.MemberCount,
+				Contributions: &row.ContributionCount,
+				Links:         &row.LinkCount,
+				Resources:     row.ResourceCount,
+				Pages:         row.PageCount,
+			}, nil
+		},
+	)
+
+	return &result, err //nolint:wrapcheck
+}
+
 // GetFeatureRelationsVisibility returns the relations module visibility for a profile.
 func (r *Repository) GetFeatureRelationsVisibility(
 	ctx context.Context,
@@ -104,7 +200,7 @@ func (r *Repository) CheckProfileSlugExists(ctx context.Context, slug string) (b
 
 	err := r.cache.Execute(
 		ctx,
-		"profile_slug_exists:"+slug,
+		CacheKeyProfileSlugExists+":"+slug,
 		&result,
 		func(ctx context.Context) (any, error) {
 			exists, err := r.queries.CheckProfileSlugExists(
@@ -156,6 +252,34 @@ func (r *Repository) CheckPageSlugExistsIncludingDeleted(
 	return exists, nil
 }
 
+func (r *Repository) ListPageSlugUsages(
+	ctx context.Context,
+	profileID string,
+	pageSlugs []string,
+) ([]*profiles.PageSlugUsage, error) {
+	rows, err := r.queries.ListPageSlugUsagesByProfileID(
+		ctx,
+		ListPageSlugUsagesByProfileIDParams{
+			ProfileID: profileID,
+			PageSlugs: pageSlugs,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make([]*profiles.PageSlugUsage, len(rows))
+	for i, row := range rows {
+		usages[i] = &profiles.PageSlugUsage{
+			PageID:  row.ID,
+			Slug:    row.Slug,
+			Deleted: row.DeletedAt.Valid,
+		}
+	}
+
+	return usages, nil
+}
+
 func (r *Repository) GetCustomDomainByDomain(
 	ctx context.Context,
 	domain string,
@@ -164,7 +288,7 @@ func (r *Repository) GetCustomDomainByDomain(
 
 	err := r.cache.Execute(
 		ctx,
-		"custom_domain_by_domain:"+domain,
+		CacheKeyCustomDomainByDomain+":"+domain,
 		&result,
 		func(ctx context.Context) (any, error) {
 			row, err := r.queries.GetCustomDomainByDomain(
@@ -190,6 +314,7 @@ func (r *Repository) GetCustomDomainByDomain(
 				ExpiredAt:          vars.ToTimePtr(row.ExpiredAt),
 				WebserverSynced:    row.WebserverSynced,
 				WwwPrefix:          row.WwwPrefix,
+				VerificationToken:  row.VerificationToken,
 				CreatedAt:          row.CreatedAt,
 				UpdatedAt:          vars.ToTimePtr(row.UpdatedAt),
 			}, nil
@@ -224,6 +349,7 @@ func (r *Repository) ListCustomDomainsByProfileID(
 			ExpiredAt:          vars.ToTimePtr(row.ExpiredAt),
 			WebserverSynced:    row.WebserverSynced,
 			WwwPrefix:          row.WwwPrefix,
+			VerificationToken:  row.VerificationToken,
 			CreatedAt:          row.CreatedAt,
 			UpdatedAt:          vars.ToTimePtr(row.UpdatedAt),
 		})
@@ -253,6 +379,7 @@ func (r *Repository) ListAllCustomDomains( //nolint:dupl
 			ExpiredAt:          vars.ToTimePtr(row.ExpiredAt),
 			WebserverSynced:    row.WebserverSynced,
 			WwwPrefix:          row.WwwPrefix,
+			VerificationToken:  row.VerificationToken,
 			CreatedAt:          row.CreatedAt,
 			UpdatedAt:          vars.ToTimePtr(row.UpdatedAt),
 		})
@@ -282,6 +409,7 @@ func (r *Repository) ListVerifiedCustomDomains( //nolint:dupl
 			ExpiredAt:          vars.ToTimePtr(row.ExpiredAt),
 			WebserverSynced:    row.WebserverSynced,
 			WwwPrefix:          row.WwwPrefix,
+			VerificationToken:  row.VerificationToken,
 			CreatedAt:          row.CreatedAt,
 			UpdatedAt:          vars.ToTimePtr(row.UpdatedAt),
 		})
@@ -329,12 +457,14 @@ func (r *Repository) CreateCustomDomain(
 	profileID string,
 	domain string,
 	defaultLocale *string,
+	verificationToken string,
 ) error {
 	return r.queries.CreateCustomDomain(ctx, CreateCustomDomainParams{
-		ID:            domainID,
-		ProfileID:     profileID,
-		Domain:        domain,
-		DefaultLocale: vars.ToSQLNullString(defaultLocale),
+		ID:                domainID,
+		ProfileID:         profileID,
+		Domain:            domain,
+		DefaultLocale:     vars.ToSQLNullString(defaultLocale),
+		VerificationToken: verificationToken,
 	})
 }
 
@@ -343,11 +473,13 @@ func (r *Repository) UpdateCustomDomain(
 	id string,
 	domain string,
 	defaultLocale *string,
+	verificationToken *string,
 ) error {
 	_, err := r.queries.UpdateCustomDomain(ctx, UpdateCustomDomainParams{
-		ID:            id,
-		Domain:        domain,
-		DefaultLocale: vars.ToSQLNullString(defaultLocale),
+		ID:                id,
+		Domain:            domain,
+		DefaultLocale:     vars.ToSQLNullString(defaultLocale),
+		VerificationToken: vars.ToSQLNullString(verificationToken),
 	})
 
 	return err
@@ -449,6 +581,18 @@ func parsePageOffset(cursor *cursors.Cursor) int32 {
 	return int32(parsed)
 }
 
+// nextPageCursor returns the offset of the page after the one just read, or nil
+// when the page was not full and there is nothing left to read.
+func nextPageCursor(cursor *cursors.Cursor, pageOffset int32, pageSize int) *string {
+	if cursor == nil || pageSize == 0 || pageSize != cursor.Limit {
+		return nil
+	}
+
+	nextOffset := strconv.Itoa(int(pageOffset) + cursor.Limit)
+
+	return &nextOffset
+}
+
 func mapListProfileRows(rows []*ListProfilesRow) []*profiles.Profile {
 	result := make([]*profiles.Profile, len(rows))
 	for i, row := range rows {
@@ -482,13 +626,14 @@ func mapListProfileRows(rows []*ListProfilesRow) []*profiles.Profile {
 	return result
 }
 
+// ListProfiles lists approved profiles in a seeded random order. Pages without
+// a search query are cached per locale, filters, seed and position; profile
+// writes invalidate them.
 func (r *Repository) ListProfiles(
 	ctx context.Context,
 	localeCode string,
 	cursor *cursors.Cursor,
 ) (cursors.Cursored[[]*profiles.Profile], error) {
-	var wrappedResponse cursors.Cursored[[]*profiles.Profile]
-
 	seed := cursor.Seed
 	if seed == "" {
 		seed = time.Now().UTC().Format("2006-01-02")
@@ -496,15 +641,54 @@ func (r *Repository) ListProfiles(
 
 	pageOffset := parsePageOffset(cursor)
 
+	// Search results vary too much to be worth caching
+	if cursor.Filters["q"] != "" {
+		return r.listProfiles(ctx, localeCode, cursor, seed, pageOffset)
+	}
+
+	var result cursors.Cursored[[]*profiles.Profile]
+
+	err := r.cache.Execute(
+		ctx,
+		strings.Join([]string{
+			CacheKeyProfileList,
+			localeCode,
+			cursor.Filters["kind"],
+			cursor.Filters[profiles.FilterHiring],
+			cursor.Filters[profiles.FilterAvailable],
+			seed,
+			strconv.Itoa(cursor.Limit),
+			strconv.Itoa(int(pageOffset)),
+		}, ":"),
+		&result,
+		func(ctx context.Context) (any, error) {
+			return r.listProfiles(ctx, localeCode, cursor, seed, pageOffset)
+		},
+	)
+
+	return result, err //nolint:wrapcheck
+}
+
+func (r *Repository) listProfiles(
+	ctx context.Context,
+	localeCode string,
+	cursor *cursors.Cursor,
+	seed string,
+	pageOffset int32,
+) (cursors.Cursored[[]*profiles.Profile], error) {
+	var wrappedResponse cursors.Cursored[[]*profiles.Profile]
+
 	rows, err := r.queries.ListProfiles(
 		ctx,
 		ListProfilesParams{
-			LocaleCode: localeCode,
-			FilterKind: vars.MapValueToNullString(cursor.Filters, "kind"),
-			FilterQ:    vars.MapValueToNullString(cursor.Filters, "q"),
-			Seed:       seed,
-			PageLimit:  int32(cursor.Limit),
-			PageOffset: pageOffset,
+			LocaleCode:      localeCode,
+			FilterKind:      vars.MapValueToNullString(cursor.Filters, "kind"),
+			FilterQ:         vars.MapValueToNullString(cursor.Filters, "q"),
+			FilterHiring:    vars.MapValueToNullBool(cursor.Filters, profiles.FilterHiring),
+			FilterAvailable: vars.MapValueToNullBool(cursor.Filters, profiles.FilterAvailable),
+			Seed:            seed,
+			PageLimit:       int32(cursor.Limit),
+			PageOffset:      pageOffset,
 		},
 	)
 	if err != nil {
@@ -514,14 +698,76 @@ func (r *Repository) ListProfiles(
 	result := mapListProfileRows(rows)
 	wrappedResponse.Data = result
 
-	if len(result) == cursor.Limit && len(result) > 0 {
-		nextOffset := strconv.Itoa(int(pageOffset) + cursor.Limit)
-		wrappedResponse.CursorPtr = &nextOffset
-	}
+	wrappedResponse.CursorPtr = nextPageCursor(cursor, pageOffset, len(result))
 
 	return wrappedResponse, nil
 }
 
+// ListProfilesByPoints lists approved profiles by points, starting after the
+// given keyset position. The first page is cached per locale, kind and limit.
+func (r *Repository) ListProfilesByPoints(
+	ctx context.Context,
+	localeCode string,
+	kind string,
+	after *profiles.LeaderboardCursor,
+	limit int,
+) ([]*profiles.Profile, error) {
+	if after != nil {
+		return r.listProfilesByPoints(ctx, localeCode, kind, after, limit)
+	}
+
+	var result []*profiles.Profile
+
+	err := r.cache.Execute(
+		ctx,
+		CacheKeyPointsLeaderboard+":"+localeCode+":"+kind+":"+strconv.Itoa(limit),
+		&result,
+		func(ctx context.Context) (any, error) {
+			return r.listProfilesByPoints(ctx, localeCode, kind, nil, limit)
+		},
+	)
+
+	return result, err //nolint:wrapcheck
+}
+
+func (r *Repository) listProfilesByPoints(
+	ctx context.Context,
+	localeCode string,
+	kind string,
+	after *profiles.LeaderboardCursor,
+	limit int,
+) ([]*profiles.Profile, error) {
+	params := ListProfilesByPointsParams{
+		LocaleCode:      localeCode,
+		FilterKind:      sql.NullString{String: kind, Valid: kind != ""},
+		CursorPoints:    sql.NullInt32{},
+		CursorProfileID: sql.NullString{},
+		LimitCount:      safeInt32(limit),
+	}
+
+	if after != nil {
+		params.CursorPoints = sql.NullInt32{Int32: safeInt32(int(after.Points)), Valid: true} //nolint:gosec
+		params.CursorProfileID = sql.NullString{String: after.ProfileID, Valid: true}
+	}
+
+	rows, err := r.queries.ListProfilesByPoints(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	listRows := make([]*ListProfilesRow, len(rows))
+	for i, row := range rows {
+		listRows[i] = &ListProfilesRow{Profile: row.Profile, ProfileTx: row.ProfileTx}
+	}
+
+	result := mapListProfileRows(listRows)
+	for i, row := range rows {
+		result[i].Points = uint64(max(row.Profile.Points, 0))
+	}
+
+	return result, nil
+}
+
 func (r *Repository) ListProfilePagesByProfileID(
 	ctx context.Context,
 	localeCode string,
@@ -977,11 +1223,20 @@ func (r *Repository) GetProfileMembershipsByMemberProfileID(
 			MemberProfile: nil,
 			Teams:         []*profiles.ProfileTeam{},
 		}
+	}
+
+	// Populate teams for all memberships at once
+	membershipIDs := make([]string, len(memberships))
+	for i, membership := range memberships {
+		membershipIDs[i] = membership.ID
+	}
 
-		// Populate teams for this membership
-		teams, teamsErr := r.ListMembershipTeams(ctx, row.MembershipID)
-		if teamsErr == nil && teams != nil {
-			memberships[i].Teams = teams
+	teamsByMembership, teamsErr := r.ListTeamsForMemberships(ctx, membershipIDs)
+	if teamsErr == nil {
+		for _, membership := range memberships {
+			if teams, ok := teamsByMembership[membership.ID]; ok {
+				membership.Teams = teams
+			}
 		}
 	}
 
@@ -1008,7 +1263,14 @@ func (r *Repository) CreateProfile(
 		Properties:        vars.ToSQLNullRawMessage(properties),
 	}
 
-	return r.queries.CreateProfile(ctx, params)
+	err := r.queries.CreateProfile(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	r.invalidateProfileLists(ctx)
+
+	return nil
 }
 
 func (r *Repository) CreateProfileTx(
@@ -1027,7 +1289,14 @@ func (r *Repository) CreateProfileTx(
 		Properties:  vars.ToSQLNullRawMessage(properties),
 	}
 
-	return r.queries.CreateProfileTx(ctx, params)
+	err := r.queries.CreateProfileTx(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	r.invalidateProfileLists(ctx)
+
+	return nil
 }
 
 func (r *Repository) UpdateProfile(
@@ -1063,6 +1332,8 @@ func (r *Repository) UpdateProfile(
 		return err
 	}
 
+	r.invalidateProfileLists(ctx)
+
 	return nil
 }
 
@@ -1087,9 +1358,94 @@ func (r *Repository) UpdateProfileTx(
 		return err
 	}
 
+	r.invalidateProfileLists(ctx)
+
+	return nil
+}
+
+// GetProfileDefaultLocale returns the default locale of the profile, or an empty
+// string when the profile does not exist.
+func (r *Repository) GetProfileDefaultLocale(ctx context.Context, profileID string) (string, error) {
+	locale, err := r.queries.GetProfileDefaultLocale(ctx, GetProfileDefaultLocaleParams{ID: profileID})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return locale, nil
+}
+
+func (r *Repository) UpdateProfileDefaultLocale(
+	ctx context.Context,
+	profileID string,
+	localeCode string,
+) error {
+	_, err := r.queries.UpdateProfileDefaultLocale(ctx, UpdateProfileDefaultLocaleParams{
+		DefaultLocale: localeCode,
+		ID:            profileID,
+	})
+	if err != nil {
+		return err
+	}
+
+	r.invalidateProfileLists(ctx)
+
 	return nil
 }
 
+func (r *Repository) DeleteProfileTx(
+	ctx context.Context,
+	profileID string,
+	localeCode string,
+) (int64, error) {
+	affected, err := r.queries.DeleteProfileTx(ctx, DeleteProfileTxParams{
+		ProfileID:  profileID,
+		LocaleCode: localeCode,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r.invalidateProfileLists(ctx)
+
+	return affected, nil
+}
+
+// DeleteProfilePageTxsByProfileLocale removes the translations in the locale from
+// every page of the profile.
+func (r *Repository) DeleteProfilePageTxsByProfileLocale(
+	ctx context.Context,
+	profileID string,
+	localeCode string,
+) (int64, error) {
+	return r.queries.DeleteProfilePageTxsByProfileLocale(
+		ctx,
+		DeleteProfilePageTxsByProfileLocaleParams{
+			ProfileID:  profileID,
+			LocaleCode: localeCode,
+		},
+	)
+}
+
+// DeleteProfileLinkTxsByProfileLocale removes the translations in the locale from
+// every link of the profile.
+func (r *Repository) DeleteProfileLinkTxsByProfileLocale(
+	ctx context.Context,
+	profileID string,
+	localeCode string,
+) (int64, error) {
+	return r.queries.DeleteProfileLinkTxsByProfileLocale(
+		ctx,
+		DeleteProfileLinkTxsByProfileLocaleParams{
+			ProfileID:  profileID,
+			LocaleCode: localeCode,
+		},
+	)
+}
+
 func (r *Repository) UpsertProfileTx(
 	ctx context.Context,
 	profileID string,
@@ -1111,6 +1467,8 @@ func (r *Repository) UpsertProfileTx(
 		return err
 	}
 
+	r.invalidateProfileLists(ctx)
+
 	return nil
 }
 
@@ -1137,7 +1495,7 @@ func (r *Repository) CreateProfileMembership(
 
 	// Invalidate cached membership-kind lookup so subsequent reads see the new membership.
 	if memberProfileID != nil {
-		_ = r.cache.Invalidate(ctx, "membership_kind:"+profileID+":"+*memberProfileID)
+		_ = r.cache.Invalidate(ctx, CacheKeyMembershipKind+":"+profileID+":"+*memberProfileID)
 	}
 
 	return nil
@@ -1181,15 +1539,24 @@ func (r *Repository) ListProfileMembershipsForSettings(
 			Teams: []*profiles.ProfileTeam{},
 		}
 
-		// Populate teams for this membership
-		teams, teamsErr := r.ListMembershipTeams(ctx, row.ID)
-		if teamsErr == nil && teams != nil {
-			membership.Teams = teams
-		}
-
 		result = append(result, membership)
 	}
 
+	// Populate teams for all memberships at once
+	membershipIDs := make([]string, len(result))
+	for i, membership := range result {
+		membershipIDs[i] = membership.ID
+	}
+
+	teamsByMembership, teamsErr := r.ListTeamsForMemberships(ctx, membershipIDs)
+	if teamsErr == nil {
+		for _, membership := range result {
+			if teams, ok := teamsByMembership[membership.ID]; ok {
+				membership.Teams = teams
+			}
+		}
+	}
+
 	return result, nil
 }
 
@@ -1220,6 +1587,28 @@ func (r *Repository) GetProfileMembershipByID(
 	}, nil
 }
 
+// UpdateProfileMembershipAggregateStories sets whether the member's stories are
+// listed in the profile's aggregated story list. Reports false when the
+// membership doesn't exist.
+func (r *Repository) UpdateProfileMembershipAggregateStories(
+	ctx context.Context,
+	id string,
+	aggregateStories bool,
+) (bool, error) {
+	affected, err := r.queries.UpdateProfileMembershipAggregateStories(
+		ctx,
+		UpdateProfileMembershipAggregateStoriesParams{
+			AggregateStories: aggregateStories,
+			ID:               id,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func (r *Repository) GetProfileMembershipByProfileAndMember(
 	ctx context.Context,
 	profileID string,
@@ -1361,7 +1750,7 @@ func (r *Repository) GetUserBriefInfo(
 
 	err := r.cache.Execute(
 		ctx,
-		"user_brief_info:"+userID,
+		CacheKeyUserBriefInfo+":"+userID,
 		&result,
 		func(ctx context.Context) (any, error) {
 			row, err := r.queries.GetUserBriefInfoByID(
@@ -1463,6 +1852,11 @@ func (r *Repository) GetProfileLink(
 		return nil, err
 	}
 
+	return storageProfileLinkToBusinessLink(row), nil
+}
+
+// storageProfileLinkToBusinessLink converts a full profile link row to a business profile link.
+func storageProfileLinkToBusinessLink(row *GetProfileLinkRow) *profiles.ProfileLink {
 	// Convert Icon string to *string (empty string -> nil)
 	var iconPtr *string
 	if row.Icon != "" {
@@ -1492,10 +1886,13 @@ func (r *Repository) GetProfileLink(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		SyncStatus:       nil,
+		SyncError:        nil,
+		SyncAttemptedAt:  nil,
 		CanRemove:        false,
 	}
 
-	return result, nil
+	return result
 }
 
 func (r *Repository) CreateProfileLink(
@@ -1557,6 +1954,9 @@ func (r *Repository) CreateProfileLink(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		SyncStatus:       nil,
+		SyncError:        nil,
+		SyncAttemptedAt:  nil,
 		CanRemove:        false,
 	}
 
@@ -1627,6 +2027,25 @@ func (r *Repository) GetProfilePage(
 	return result, nil
 }
 
+// parsePublishedAt converts an RFC 3339 publish timestamp into a nullable time.
+// A nil or empty value leaves the page unpublished.
+func parsePublishedAt(publishedAt *string) (sql.NullTime, error) {
+	if publishedAt == nil || *publishedAt == "" {
+		return sql.NullTime{Time: time.Time{}, Valid: false}, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, *publishedAt)
+	if err != nil {
+		return sql.NullTime{Time: time.Time{}, Valid: false}, fmt.Errorf(
+			"%w: published_at must be an RFC 3339 timestamp: %s",
+			profiles.ErrInvalidInput,
+			*publishedAt,
+		)
+	}
+
+	return sql.NullTime{Time: parsed, Valid: true}, nil
+}
+
 func (r *Repository) CreateProfilePage(
 	ctx context.Context,
 	pageID string,
@@ -1638,10 +2057,9 @@ func (r *Repository) CreateProfilePage(
 	addedByProfileID *string,
 	visibility string,
 ) (*profiles.ProfilePage, error) {
-	var publishedAtTime sql.NullTime
-	if publishedAt != nil {
-		// Convert string to time if needed
-		publishedAtTime = sql.NullTime{Time: time.Time{}, Valid: false}
+	publishedAtTime, err := parsePublishedAt(publishedAt)
+	if err != nil {
+		return nil, err
 	}
 
 	row, err := r.queries.CreateProfilePage(ctx, CreateProfilePageParams{
@@ -1705,10 +2123,9 @@ func (r *Repository) UpdateProfilePage(
 	publishedAt *string,
 	visibility string,
 ) error {
-	var publishedAtTime sql.NullTime
-	if publishedAt != nil {
-		// Convert string to time if needed
-		publishedAtTime = sql.NullTime{Time: time.Time{}, Valid: false}
+	publishedAtTime, err := parsePublishedAt(publishedAt)
+	if err != nil {
+		return err
 	}
 
 	params := UpdateProfilePageParams{
@@ -1720,7 +2137,7 @@ func (r *Repository) UpdateProfilePage(
 		Visibility:      visibility,
 	}
 
-	_, err := r.queries.UpdateProfilePage(ctx, params)
+	_, err = r.queries.UpdateProfilePage(ctx, params)
 
 	return err
 }
@@ -1790,12 +2207,73 @@ func (r *Repository) ListProfilePageTxLocales(
 	return r.queries.ListProfilePageTxLocales(ctx, params)
 }
 
-func (r *Repository) DeleteProfilePage(
+func (r *Repository) ListProfilePageTxsByProfileLocale(
 	ctx context.Context,
-	id string,
-) error {
-	_, err := r.queries.DeleteProfilePage(ctx, DeleteProfilePageParams{ID: id})
-
+	profileID string,
+	localeCode string,
+) ([]*profiles.ProfilePageTx, error) {
+	rows, err := r.queries.ListProfilePageTxsByProfileLocale(
+		ctx,
+		ListProfilePageTxsByProfileLocaleParams{
+			ProfileID:  profileID,
+			LocaleCode: localeCode,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make([]*profiles.ProfilePageTx, len(rows))
+	for i, row := range rows {
+		translations[i] = &profiles.ProfilePageTx{
+			ProfilePageID: row.ProfilePageID,
+			Slug:          row.Slug,
+			Title:         row.Title,
+			Summary:       row.Summary,
+			Content:       row.Content,
+		}
+	}
+
+	return translations, nil
+}
+
+func (r *Repository) ListProfileLinkTxsByProfileLocale(
+	ctx context.Context,
+	profileID string,
+	localeCode string,
+) ([]*profiles.ProfileLinkTx, error) {
+	rows, err := r.queries.ListProfileLinkTxsByProfileLocale(
+		ctx,
+		ListProfileLinkTxsByProfileLocaleParams{
+			ProfileID:  profileID,
+			LocaleCode: localeCode,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := make([]*profiles.ProfileLinkTx, len(rows))
+	for i, row := range rows {
+		translations[i] = &profiles.ProfileLinkTx{
+			ProfileLinkID: row.ProfileLinkID,
+			LocaleCode:    strings.TrimRight(row.LocaleCode, " "),
+			Title:         row.Title,
+			Icon:          vars.ToStringPtr(row.Icon),
+			Group:         vars.ToStringPtr(row.Group),
+			Description:   vars.ToStringPtr(row.Description),
+		}
+	}
+
+	return translations, nil
+}
+
+func (r *Repository) DeleteProfilePage(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := r.queries.DeleteProfilePage(ctx, DeleteProfilePageParams{ID: id})
+
 	return err
 }
 
@@ -1856,6 +2334,9 @@ func (r *Repository) GetProfileLinkByRemoteID(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		SyncStatus:       nil,
+		SyncError:        nil,
+		SyncAttemptedAt:  nil,
 		CanRemove:        false,
 	}
 
@@ -2033,6 +2514,9 @@ func (r *Repository) CreateOAuthProfileLink( //nolint:funlen
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		SyncStatus:       nil,
+		SyncError:        nil,
+		SyncAttemptedAt:  nil,
 		CanRemove:        false,
 	}, nil
 }
@@ -2222,7 +2706,7 @@ func (r *Repository) GetMembershipBetweenProfiles(
 
 	err := r.cache.Execute(
 		ctx,
-		"membership_kind:"+profileID+":"+memberProfileID,
+		CacheKeyMembershipKind+":"+profileID+":"+memberProfileID,
 		&result,
 		func(ctx context.Context) (any, error) {
 			kind, err := r.queries.GetMembershipBetweenProfiles(
@@ -2251,7 +2735,7 @@ func (r *Repository) InvalidateMembershipKindCache(
 	ctx context.Context,
 	profileID, memberProfileID string,
 ) error {
-	err := r.cache.Invalidate(ctx, "membership_kind:"+profileID+":"+memberProfileID)
+	err := r.cache.Invalidate(ctx, CacheKeyMembershipKind+":"+profileID+":"+memberProfileID)
 	if err != nil {
 		return fmt.Errorf("invalidating membership kind cache: %w", err)
 	}
@@ -2299,20 +2783,35 @@ func (r *Repository) ListFeaturedProfileLinksByProfileID( //nolint:dupl
 	return profileLinks, nil
 }
 
-func (r *Repository) ListAllProfileLinksByProfileID( //nolint:dupl
+// ListAllProfileLinksByProfileID lists the links of a profile page by page.
+// A nil cursor returns every link in a single page.
+func (r *Repository) ListAllProfileLinksByProfileID( //nolint:dupl,funlen
 	ctx context.Context,
 	localeCode string,
 	profileID string,
-) ([]*profiles.ProfileLinkBrief, error) {
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*profiles.ProfileLinkBrief], error) {
+	var wrappedResponse cursors.Cursored[[]*profiles.ProfileLinkBrief]
+
+	pageLimit := sql.NullInt32{Int32: 0, Valid: false}
+	pageOffset := int32(0)
+
+	if cursor != nil {
+		pageLimit = sql.NullInt32{Int32: int32(cursor.Limit), Valid: true}
+		pageOffset = parsePageOffset(cursor)
+	}
+
 	rows, err := r.queries.ListAllProfileLinksByProfileID(
 		ctx,
 		ListAllProfileLinksByProfileIDParams{
 			LocaleCode: localeCode,
 			ProfileID:  profileID,
+			PageOffset: pageOffset,
+			PageLimit:  pageLimit,
 		},
 	)
 	if err != nil {
-		return nil, err
+		return wrappedResponse, err
 	}
 
 	profileLinks := make([]*profiles.ProfileLinkBrief, len(rows))
@@ -2336,17 +2835,43 @@ func (r *Repository) ListAllProfileLinksByProfileID( //nolint:dupl
 		}
 	}
 
-	return profileLinks, nil
+	wrappedResponse.Data = profileLinks
+
+	wrappedResponse.CursorPtr = nextPageCursor(cursor, pageOffset, len(profileLinks))
+
+	return wrappedResponse, nil
 }
 
-// ListProfileLinksByProfileIDForEditing returns all profile links for editing (settings page).
-// This is an alias for ListAllProfileLinksByProfileID since we need all links for editing.
+// ListProfileLinksByProfileIDForEditing returns all profile links for editing (settings page)
+// as full records, including hidden links, in a single query.
 func (r *Repository) ListProfileLinksByProfileIDForEditing(
 	ctx context.Context,
 	localeCode string,
 	profileID string,
-) ([]*profiles.ProfileLinkBrief, error) {
-	return r.ListAllProfileLinksByProfileID(ctx, localeCode, profileID)
+) ([]*profiles.ProfileLink, error) {
+	rows, err := r.queries.ListProfileLinksByProfileIDForEditing(
+		ctx,
+		ListProfileLinksByProfileIDForEditingParams{
+			LocaleCode: localeCode,
+			ProfileID:  profileID,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]*profiles.ProfileLink, len(rows))
+	for i, row := range rows {
+		fullRow := GetProfileLinkRow(*row)
+		links[i] = storageProfileLinkToBusinessLink(&fullRow)
+
+		// Sync outcomes are only for the people editing the profile
+		links[i].SyncStatus = vars.ToStringPtr(row.SyncStatus)
+		links[i].SyncError = vars.ToStringPtr(row.SyncError)
+		links[i].SyncAttemptedAt = vars.ToTimePtr(row.SyncAttemptedAt)
+	}
+
+	return links, nil
 }
 
 func (r *Repository) ListOnlineProfileLinks(
@@ -2425,6 +2950,8 @@ func storageProfileResourceToBusinessResource(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		Order:            int(row.Order),
+		IsFeatured:       row.IsFeatured,
 		CanRemove:        false,
 		Teams:            nil,
 	}
@@ -2447,11 +2974,15 @@ func storageProfileResourceToBusinessResource(
 func (r *Repository) ListProfileResourcesByProfileID(
 	ctx context.Context,
 	profileID string,
+	filter profiles.ProfileResourceFilter,
 ) ([]*profiles.ProfileResource, error) {
 	rows, err := r.queries.ListProfileResourcesByProfileID(
 		ctx,
 		ListProfileResourcesByProfileIDParams{
-			ProfileID: profileID,
+			ProfileID:    profileID,
+			FilterKind:   vars.ToSQLNullString(filter.Kind),
+			FilterTeamID: vars.ToSQLNullString(filter.TeamID),
+			SortBy:       string(filter.Sort),
 		},
 	)
 	if err != nil {
@@ -2498,6 +3029,8 @@ func (r *Repository) GetProfileResourceByID(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		Order:            int(row.Order),
+		IsFeatured:       row.IsFeatured,
 		CanRemove:        false,
 		Teams:            nil,
 	}, nil
@@ -2538,6 +3071,8 @@ func (r *Repository) GetProfileResourceByRemoteID(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		Order:            int(row.Order),
+		IsFeatured:       row.IsFeatured,
 		CanRemove:        false,
 		Teams:            nil,
 	}, nil
@@ -2595,6 +3130,8 @@ func (r *Repository) CreateProfileResource(
 		CreatedAt:        row.CreatedAt,
 		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
 		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		Order:            int(row.Order),
+		IsFeatured:       row.IsFeatured,
 		CanRemove:        false,
 		Teams:            nil,
 	}, nil
@@ -2611,6 +3148,98 @@ func (r *Repository) SoftDeleteProfileResource(
 	return err
 }
 
+// GetDeletedProfileResourceByID returns a soft-deleted resource, or nil if no deleted resource has the ID.
+func (r *Repository) GetDeletedProfileResourceByID(
+	ctx context.Context,
+	id string,
+) (*profiles.ProfileResource, error) {
+	row, err := r.queries.GetDeletedProfileResourceByID(ctx, GetDeletedProfileResourceByIDParams{
+		ID: id,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return &profiles.ProfileResource{
+		ID:               row.ID,
+		ProfileID:        row.ProfileID,
+		Kind:             row.Kind,
+		IsManaged:        row.IsManaged,
+		RemoteID:         vars.ToStringPtr(row.RemoteID),
+		PublicID:         vars.ToStringPtr(row.PublicID),
+		URL:              vars.ToStringPtr(row.URL),
+		Title:            row.Title,
+		Description:      vars.ToStringPtr(row.Description),
+		Properties:       vars.ToObject(row.Properties),
+		AddedByProfileID: row.AddedByProfileID,
+		AddedByProfile:   nil,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        vars.ToTimePtr(row.UpdatedAt),
+		DeletedAt:        vars.ToTimePtr(row.DeletedAt),
+		Order:            int(row.Order),
+		IsFeatured:       row.IsFeatured,
+		CanRemove:        false,
+		Teams:            nil,
+	}, nil
+}
+
+func (r *Repository) RestoreProfileResource(
+	ctx context.Context,
+	id string,
+) error {
+	_, err := r.queries.RestoreProfileResource(ctx, RestoreProfileResourceParams{
+		ID: id,
+	})
+
+	return err
+}
+
+func (r *Repository) ListProfileResourceIDsByProfileID(
+	ctx context.Context,
+	profileID string,
+) ([]string, error) {
+	return r.queries.ListProfileResourceIDsByProfileID(ctx, ListProfileResourceIDsByProfileIDParams{
+		ProfileID: profileID,
+	})
+}
+
+func (r *Repository) UpdateProfileResourceOrder(
+	ctx context.Context,
+	id string,
+	order int,
+	isFeatured bool,
+) error {
+	_, err := r.queries.UpdateProfileResourceOrder(ctx, UpdateProfileResourceOrderParams{
+		ID:            id,
+		ResourceOrder: int32(order),
+		IsFeatured:    isFeatured,
+	})
+
+	return err
+}
+
+func (r *Repository) ListProfileLinkIDsByProfileID(
+	ctx context.Context,
+	profileID string,
+) ([]string, error) {
+	return r.queries.ListProfileLinkIDsByProfileID(ctx, ListProfileLinkIDsByProfileIDParams{
+		ProfileID: profileID,
+	})
+}
+
+func (r *Repository) UpdateProfileLinkOrder(ctx context.Context, id string, order int) error {
+	_, err := r.queries.UpdateProfileLinkOrder(ctx, UpdateProfileLinkOrderParams{
+		ID:        id,
+		LinkOrder: int32(order),
+	})
+
+	return err
+}
+
 func (r *Repository) UpdateProfileResourceProperties(
 	ctx context.Context,
 	resourceID string,
@@ -2681,6 +3310,33 @@ func (r *Repository) GetManagedGitHubLinkByProfileID(
 	}, nil
 }
 
+func (r *Repository) ListManagedLinkAccounts(
+	ctx context.Context,
+	profileID string,
+	kinds []string,
+) ([]*profiles.LinkedAccount, error) {
+	rows, err := r.queries.ListManagedProfileLinkAccounts(
+		ctx,
+		ListManagedProfileLinkAccountsParams{
+			ProfileID: profileID,
+			Kinds:     kinds,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*profiles.LinkedAccount, len(rows))
+	for i, row := range rows {
+		accounts[i] = &profiles.LinkedAccount{
+			Kind:     row.Kind,
+			RemoteID: row.RemoteID.String,
+		}
+	}
+
+	return accounts, nil
+}
+
 // Profile Team methods
 
 func (r *Repository) ListProfileTeamsWithMemberCount(
@@ -2699,12 +3355,14 @@ func (r *Repository) ListProfileTeamsWithMemberCount(
 
 	for _, row := range rows {
 		result = append(result, &profiles.ProfileTeam{
-			ID:            row.ID,
-			ProfileID:     row.ProfileID,
-			Name:          row.Name,
-			Description:   vars.ToStringPtr(row.Description),
-			MemberCount:   row.MemberCount,
-			ResourceCount: row.ResourceCount,
+			ID:                  row.ID,
+			ProfileID:           row.ProfileID,
+			Name:                row.Name,
+			Description:         vars.ToStringPtr(row.Description),
+			LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+			LeadMemberProfileID: vars.ToStringPtr(row.LeadMemberProfileID),
+			MemberCount:         row.MemberCount,
+			ResourceCount:       row.ResourceCount,
 		})
 	}
 
@@ -2729,12 +3387,14 @@ func (r *Repository) CreateProfileTeam(
 	}
 
 	return &profiles.ProfileTeam{
-		ID:            row.ID,
-		ProfileID:     row.ProfileID,
-		Name:          row.Name,
-		Description:   vars.ToStringPtr(row.Description),
-		MemberCount:   0,
-		ResourceCount: 0,
+		ID:                  row.ID,
+		ProfileID:           row.ProfileID,
+		Name:                row.Name,
+		Description:         vars.ToStringPtr(row.Description),
+		LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+		LeadMemberProfileID: nil,
+		MemberCount:         0,
+		ResourceCount:       0,
 	}, nil
 }
 
@@ -2786,45 +3446,216 @@ func (r *Repository) ListMembershipTeams(
 
 	for _, row := range rows {
 		result = append(result, &profiles.ProfileTeam{
-			ID:            row.ID,
-			ProfileID:     row.ProfileID,
-			Name:          row.Name,
-			Description:   vars.ToStringPtr(row.Description),
-			MemberCount:   0,
-			ResourceCount: 0,
+			ID:                  row.ID,
+			ProfileID:           row.ProfileID,
+			Name:                row.Name,
+			Description:         vars.ToStringPtr(row.Description),
+			LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+			LeadMemberProfileID: nil,
+			MemberCount:         0,
+			ResourceCount:       0,
 		})
 	}
 
 	return result, nil
 }
 
+// ListTeamsForMemberships returns the teams of every given membership in a single query,
+// keyed by membership ID. Memberships without teams are absent from the map.
+func (r *Repository) ListTeamsForMemberships(
+	ctx context.Context,
+	membershipIDs []string,
+) (map[string][]*profiles.ProfileTeam, error) {
+	result := make(map[string][]*profiles.ProfileTeam)
+
+	if len(membershipIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.queries.ListTeamsForMemberships(ctx, ListTeamsForMembershipsParams{
+		ProfileMembershipIds: membershipIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.ProfileMembershipID] = append(
+			result[row.ProfileMembershipID],
+			&profiles.ProfileTeam{
+				ID:                  row.ID,
+				ProfileID:           row.ProfileID,
+				Name:                row.Name,
+				Description:         vars.ToStringPtr(row.Description),
+				LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+				LeadMemberProfileID: nil,
+				MemberCount:         0,
+				ResourceCount:       0,
+			},
+		)
+	}
+
+	return result, nil
+}
+
 func (r *Repository) SetMembershipTeams(
 	ctx context.Context,
 	membershipID string,
 	teamIDs []string,
 	idGenerator func() string,
 ) error {
-	// Soft-delete all existing team assignments
-	_, err := r.queries.SetMembershipTeams_Delete(ctx, SetMembershipTeams_DeleteParams{
-		ProfileMembershipID: membershipID,
+	return r.withTx(ctx, func(txRepo *Repository) error {
+		// Soft-delete all existing team assignments
+		_, err := txRepo.queries.SetMembershipTeams_Delete(ctx, SetMembershipTeams_DeleteParams{
+			ProfileMembershipID: membershipID,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Insert new assignments
+		for _, teamID := range teamIDs {
+			_, err := txRepo.queries.SetMembershipTeams_Insert(ctx, SetMembershipTeams_InsertParams{
+				ID:                  idGenerator(),
+				ProfileMembershipID: membershipID,
+				ProfileTeamID:       teamID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// Teams the membership no longer belongs to lose it as their lead
+		_, err = txRepo.queries.ClearStaleProfileTeamLeads(ctx, ClearStaleProfileTeamLeadsParams{
+			ProfileMembershipIds: []string{membershipID},
+		})
+
+		return err
 	})
+}
+
+// GetProfileTeamByID returns the team with the given ID, or nil if it does not exist.
+func (r *Repository) GetProfileTeamByID(
+	ctx context.Context,
+	id string,
+) (*profiles.ProfileTeam, error) {
+	row, err := r.queries.GetProfileTeamByID(ctx, GetProfileTeamByIDParams{ID: id})
 	if err != nil {
-		return err
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
 	}
 
-	// Insert new assignments
-	for _, teamID := range teamIDs {
-		_, err := r.queries.SetMembershipTeams_Insert(ctx, SetMembershipTeams_InsertParams{
+	return &profiles.ProfileTeam{
+		ID:                  row.ID,
+		ProfileID:           row.ProfileID,
+		Name:                row.Name,
+		Description:         vars.ToStringPtr(row.Description),
+		LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+		LeadMemberProfileID: nil,
+		MemberCount:         0,
+		ResourceCount:       0,
+	}, nil
+}
+
+// CountProfileMembershipsByIDs counts how many of the given membership IDs belong to the profile.
+func (r *Repository) CountProfileMembershipsByIDs(
+	ctx context.Context,
+	profileID string,
+	membershipIDs []string,
+) (int64, error) {
+	return r.queries.CountProfileMembershipsByIDs(ctx, CountProfileMembershipsByIDsParams{
+		ProfileID: profileID,
+		Ids:       membershipIDs,
+	})
+}
+
+// AddTeamToMemberships assigns the team to every given membership. Memberships already
+// in the team are left untouched; the returned count covers new assignments only.
+func (r *Repository) AddTeamToMemberships(
+	ctx context.Context,
+	teamID string,
+	membershipIDs []string,
+	idGenerator func() string,
+) (int64, error) {
+	var added int64
+
+	for _, membershipID := range membershipIDs {
+		rows, err := r.queries.AddTeamToMembership(ctx, AddTeamToMembershipParams{
 			ID:                  idGenerator(),
 			ProfileMembershipID: membershipID,
 			ProfileTeamID:       teamID,
 		})
+		if err != nil {
+			return 0, err
+		}
+
+		added += rows
+	}
+
+	return added, nil
+}
+
+// RemoveTeamFromMemberships removes the team from every given membership
+// and returns the number of assignments removed.
+func (r *Repository) RemoveTeamFromMemberships(
+	ctx context.Context,
+	teamID string,
+	membershipIDs []string,
+) (int64, error) {
+	var removed int64
+
+	err := r.withTx(ctx, func(txRepo *Repository) error {
+		var err error
+
+		removed, err = txRepo.queries.RemoveTeamFromMemberships(ctx, RemoveTeamFromMembershipsParams{
+			ProfileTeamID:        teamID,
+			ProfileMembershipIds: membershipIDs,
+		})
 		if err != nil {
 			return err
 		}
+
+		// A removed lead no longer leads the team
+		_, err = txRepo.queries.ClearStaleProfileTeamLeads(ctx, ClearStaleProfileTeamLeadsParams{
+			ProfileMembershipIds: membershipIDs,
+		})
+
+		return err
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	return nil
+	return removed, nil
+}
+
+// IsMembershipInProfileTeam reports whether the membership currently belongs to the team.
+func (r *Repository) IsMembershipInProfileTeam(
+	ctx context.Context,
+	teamID string,
+	membershipID string,
+) (bool, error) {
+	return r.queries.IsMembershipInProfileTeam(ctx, IsMembershipInProfileTeamParams{
+		ProfileTeamID:       teamID,
+		ProfileMembershipID: membershipID,
+	})
+}
+
+// SetProfileTeamLead designates the team's lead membership. A nil membership ID clears it.
+func (r *Repository) SetProfileTeamLead(
+	ctx context.Context,
+	teamID string,
+	membershipID *string,
+) error {
+	_, err := r.queries.SetProfileTeamLead(ctx, SetProfileTeamLeadParams{
+		ID:               teamID,
+		LeadMembershipID: vars.ToSQLNullString(membershipID),
+	})
+
+	return err
 }
 
 func (r *Repository) CountProfileTeamResources(
@@ -2851,18 +3682,58 @@ func (r *Repository) ListResourceTeams(
 
 	for _, row := range rows {
 		result = append(result, &profiles.ProfileTeam{
-			ID:            row.ID,
-			ProfileID:     row.ProfileID,
-			Name:          row.Name,
-			Description:   vars.ToStringPtr(row.Description),
-			MemberCount:   0,
-			ResourceCount: 0,
+			ID:                  row.ID,
+			ProfileID:           row.ProfileID,
+			Name:                row.Name,
+			Description:         vars.ToStringPtr(row.Description),
+			LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+			LeadMemberProfileID: nil,
+			MemberCount:         0,
+			ResourceCount:       0,
 		})
 	}
 
 	return result, nil
 }
 
+// ListTeamsForResources returns the teams of every given resource in a single query,
+// keyed by resource ID. Resources without teams are absent from the map.
+func (r *Repository) ListTeamsForResources(
+	ctx context.Context,
+	resourceIDs []string,
+) (map[string][]*profiles.ProfileTeam, error) {
+	result := make(map[string][]*profiles.ProfileTeam)
+
+	if len(resourceIDs) == 0 {
+		return result, nil
+	}
+
+	rows, err := r.queries.ListTeamsForResources(ctx, ListTeamsForResourcesParams{
+		ProfileResourceIds: resourceIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.ProfileResourceID] = append(
+			result[row.ProfileResourceID],
+			&profiles.ProfileTeam{
+				ID:                  row.ID,
+				ProfileID:           row.ProfileID,
+				Name:                row.Name,
+				Description:         vars.ToStringPtr(row.Description),
+				LeadMembershipID:    vars.ToStringPtr(row.LeadMembershipID),
+				LeadMemberProfileID: nil,
+				MemberCount:         0,
+				ResourceCount:       0,
+			},
+		)
+	}
+
+	return result, nil
+}
+
 func (r *Repository) SetResourceTeams(
 	ctx context.Context,
 	resourceID string,
@@ -2891,3 +3762,38 @@ func (r *Repository) SetResourceTeams(
 
 	return nil
 }
+
+func (r *Repository) ListDueDraftProfilePages(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*profiles.DueProfilePage, error) {
+	rows, err := r.queries.ListDueDraftProfilePages(ctx, ListDueDraftProfilePagesParams{
+		Now:        now,
+		LimitCount: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*profiles.DueProfilePage, len(rows))
+	for i, row := range rows {
+		result[i] = &profiles.DueProfilePage{
+			PublishedAt: row.PublishedAt.Time,
+			ID:          row.ID,
+			ProfileID:   row.ProfileID,
+			Slug:        row.Slug,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *Repository) PublishDraftProfilePage(ctx context.Context, id string) (bool, error) {
+	rowsAffected, err := r.queries.PublishDraftProfilePage(ctx, PublishDraftProfilePageParams{ID: id})
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
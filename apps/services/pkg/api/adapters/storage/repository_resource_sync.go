@@ -193,6 +193,33 @@ func (r *Repository) GetMembershipsByProfilePairsForResourceSync(
 	return result, nil
 }
 
+// ListOrganizationGitHubLinksForSync returns the managed GitHub links of organization profiles.
+func (r *Repository) ListOrganizationGitHubLinksForSync(
+	ctx context.Context,
+	batchSize int,
+) ([]*resourcesync.GitHubOrganizationLink, error) {
+	rows, err := r.queries.ListOrganizationGitHubLinksForSync(
+		ctx,
+		ListOrganizationGitHubLinksForSyncParams{BatchSize: int32(batchSize)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*resourcesync.GitHubOrganizationLink, 0, len(rows))
+
+	for _, row := range rows {
+		result = append(result, &resourcesync.GitHubOrganizationLink{
+			LinkID:          row.LinkID,
+			ProfileID:       row.ProfileID,
+			AccountLogin:    row.AccountLogin.String,
+			AuthAccessToken: row.AuthAccessToken.String,
+		})
+	}
+
+	return result, nil
+}
+
 // resourceSyncAdapter wraps *Repository to satisfy the resourcesync.Repository interface.
 // This is needed because method signatures differ (e.g., GetProfileLinkByRemoteID
 // has no profileID parameter in the resourcesync interface).
@@ -259,3 +286,61 @@ func (a *resourceSyncAdapter) GetMembershipsByProfilePairs(
 ) (map[string]string, error) {
 	return a.repo.GetMembershipsByProfilePairsForResourceSync(ctx, profileIDs, memberProfileIDs)
 }
+
+func (a *resourceSyncAdapter) ListOrganizationGitHubLinksForSync(
+	ctx context.Context,
+	batchSize int,
+) ([]*resourcesync.GitHubOrganizationLink, error) {
+	return a.repo.ListOrganizationGitHubLinksForSync(ctx, batchSize)
+}
+
+func (a *resourceSyncAdapter) GetProfileResourceByRemoteID(
+	ctx context.Context,
+	profileID string,
+	kind string,
+	remoteID string,
+) (string, error) {
+	resource, err := a.repo.GetProfileResourceByRemoteID(ctx, profileID, kind, remoteID)
+	if err != nil || resource == nil {
+		return "", err
+	}
+
+	return resource.ID, nil
+}
+
+// CreateGitHubRepoResource stores the repository the same way the resources
+// settings page adds one, with the organization profile as its adder so the
+// organization's GitHub token is used to sync it.
+func (a *resourceSyncAdapter) CreateGitHubRepoResource(
+	ctx context.Context,
+	id string,
+	profileID string,
+	repo *resourcesync.GitHubRepository,
+) error {
+	var description *string
+	if repo.Description != "" {
+		description = &repo.Description
+	}
+
+	_, err := a.repo.CreateProfileResource(
+		ctx,
+		id,
+		profileID,
+		resourcesync.ResourceKindGitHubRepo,
+		true,
+		&repo.RemoteID,
+		&repo.FullName,
+		&repo.HTMLURL,
+		repo.FullName,
+		description,
+		map[string]any{
+			"language": repo.Language,
+			"stars":    repo.Stars,
+			"forks":    repo.Forks,
+			"private":  false,
+		},
+		profileID,
+	)
+
+	return err
+}
@@ -3,18 +3,25 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"slices"
+	"strings"
 
 	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 	"github.com/eser/aya.is/services/pkg/lib/vars"
 )
 
 // Search performs a unified search across profiles, stories, and profile pages.
-// If profileSlug is provided, search is scoped to that profile only.
+// If profileSlug is provided, search is scoped to that profile only; if kinds
+// is not empty, only those result types are searched. Results are ranked
+// across types, so each type is read up to offset+limit rows before the
+// requested page is cut from the merged ranking.
 func (r *Repository) Search(
 	ctx context.Context,
 	localeCode string,
 	query string,
 	profileSlug *string,
+	kinds []string,
+	offset int32,
 	limit int32,
 ) ([]*profiles.SearchResult, error) {
 	results := make([]*profiles.SearchResult, 0)
@@ -29,34 +36,44 @@ func (r *Repository) Search(
 		query:             query,
 		localeCode:        localeCode,
 		filterProfileSlug: filterProfileSlug,
-		limitCount:        limit,
+		limitCount:        offset + limit,
 	}
 
-	profileResults, err := r.searchProfiles(ctx, searchParams)
-	if err != nil {
-		return nil, err
+	searchers := map[string]func(
+		context.Context,
+		searchQueryParams,
+	) ([]*profiles.SearchResult, error){
+		profiles.SearchKindProfile: r.searchProfiles,
+		profiles.SearchKindStory:   r.searchStories,
+		profiles.SearchKindPage:    r.searchPages,
 	}
 
-	results = append(results, profileResults...)
-
-	storyResults, err := r.searchStories(ctx, searchParams)
-	if err != nil {
-		return nil, err
-	}
+	for _, kind := range []string{
+		profiles.SearchKindProfile,
+		profiles.SearchKindStory,
+		profiles.SearchKindPage,
+	} {
+		if len(kinds) > 0 && !slices.Contains(kinds, kind) {
+			continue
+		}
 
-	results = append(results, storyResults...)
+		kindResults, err := searchers[kind](ctx, searchParams)
+		if err != nil {
+			return nil, err
+		}
 
-	pageResults, err := r.searchPages(ctx, searchParams)
-	if err != nil {
-		return nil, err
+		results = append(results, kindResults...)
 	}
 
-	results = append(results, pageResults...)
-
 	// Sort by rank (descending)
 	sortByRank(results)
 
-	// Limit to requested count
+	// Cut the requested page
+	if int32(len(results)) <= offset {
+		return []*profiles.SearchResult{}, nil
+	}
+
+	results = results[offset:]
 	if int32(len(results)) > limit {
 		results = results[:limit]
 	}
@@ -64,6 +81,38 @@ func (r *Repository) Search(
 	return results, nil
 }
 
+// likePatternEscaper escapes the LIKE wildcards of a user-given prefix.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestProfiles returns the profiles whose slug or title starts with prefix.
+func (r *Repository) SuggestProfiles(
+	ctx context.Context,
+	localeCode string,
+	prefix string,
+	limit int32,
+) ([]*profiles.ProfileSuggestion, error) {
+	rows, err := r.queries.SuggestProfiles(ctx, SuggestProfilesParams{
+		LocaleCode: localeCode,
+		Prefix:     likePatternEscaper.Replace(prefix),
+		LimitCount: limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*profiles.ProfileSuggestion, len(rows))
+	for i, row := range rows {
+		suggestions[i] = &profiles.ProfileSuggestion{
+			ProfilePictureURI: vars.ToStringPtr(row.ProfilePictureURI),
+			Slug:              row.Slug,
+			Title:             row.Title,
+			Kind:              row.Kind,
+		}
+	}
+
+	return suggestions, nil
+}
+
 // searchQueryParams holds common parameters for search sub-queries.
 type searchQueryParams struct {
 	query             string
@@ -92,7 +141,7 @@ func (r *Repository) searchProfiles(
 	for _, profileRow := range profileRows {
 		kind := profileRow.Kind
 		results = append(results, &profiles.SearchResult{
-			Type:         "profile",
+			Type:         profiles.SearchKindProfile,
 			ID:           profileRow.ID,
 			Slug:         profileRow.Slug,
 			Title:        profileRow.Title,
@@ -128,7 +177,7 @@ func (r *Repository) searchStories(
 	for _, storyRow := range storyRows {
 		kind := storyRow.Kind
 		results = append(results, &profiles.SearchResult{
-			Type:         "story",
+			Type:         profiles.SearchKindStory,
 			ID:           storyRow.ID,
 			Slug:         storyRow.Slug,
 			Title:        storyRow.Title,
@@ -163,7 +212,7 @@ func (r *Repository) searchPages(
 
 	for _, pageRow := range pageRows {
 		results = append(results, &profiles.SearchResult{
-			Type:         "page",
+			Type:         profiles.SearchKindPage,
 			ID:           pageRow.ID,
 			Slug:         pageRow.Slug,
 			Title:        pageRow.Title,
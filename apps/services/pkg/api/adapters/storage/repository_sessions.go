@@ -40,6 +40,8 @@ func (r *Repository) GetSessionByID(
 		OAuthProvider:            vars.ToStringPtr(row.OauthProvider),
 		OAuthAccessToken:         vars.ToStringPtr(row.OauthAccessToken),
 		OAuthTokenScope:          vars.ToStringPtr(row.OauthTokenScope),
+		ImpersonatorUserID:       vars.ToStringPtr(row.ImpersonatorUserID),
+		ImpersonatorSessionID:    vars.ToStringPtr(row.ImpersonatorSessionID),
 	}
 
 	return result, nil
@@ -65,6 +67,8 @@ func (r *Repository) CreateSession(
 		OauthProvider:            vars.ToSQLNullString(session.OAuthProvider),
 		OauthAccessToken:         vars.ToSQLNullString(session.OAuthAccessToken),
 		OauthTokenScope:          vars.ToSQLNullString(session.OAuthTokenScope),
+		ImpersonatorUserID:       vars.ToSQLNullString(session.ImpersonatorUserID),
+		ImpersonatorSessionID:    vars.ToSQLNullString(session.ImpersonatorSessionID),
 	})
 	if err != nil {
 		return err
@@ -140,6 +144,8 @@ func (r *Repository) ListSessionsByUserID(
 			OAuthProvider:            vars.ToStringPtr(row.OauthProvider),
 			OAuthAccessToken:         vars.ToStringPtr(row.OauthAccessToken),
 			OAuthTokenScope:          vars.ToStringPtr(row.OauthTokenScope),
+			ImpersonatorUserID:       vars.ToStringPtr(row.ImpersonatorUserID),
+			ImpersonatorSessionID:    vars.ToStringPtr(row.ImpersonatorSessionID),
 		})
 	}
 
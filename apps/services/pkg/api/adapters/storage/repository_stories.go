@@ -23,7 +23,7 @@ func (r *Repository) GetStoryIDBySlug(ctx context.Context, slug string) (string,
 
 	err := r.cache.Execute(
 		ctx,
-		"story_id_by_slug:"+slug,
+		CacheKeyStoryIDBySlug+":"+slug,
 		&result,
 		func(ctx context.Context) (any, error) {
 			row, err := r.queries.GetStoryIDBySlug(ctx, GetStoryIDBySlugParams{Slug: slug})
@@ -200,6 +200,124 @@ func (r *Repository) ListStoriesOfPublication(
 	return wrappedResponse, nil
 }
 
+// ListFollowedStoriesFeed lists the stories of profiles the viewer follows,
+// starting after the given keyset position.
+func (r *Repository) ListFollowedStoriesFeed(
+	ctx context.Context,
+	localeCode string,
+	viewerUserID string,
+	after *stories.FeedCursor,
+	limit int,
+) ([]*stories.StoryWithChildren, error) {
+	params := ListFollowedStoriesFeedParams{
+		LocaleCode:        localeCode,
+		ViewerUserID:      viewerUserID,
+		CursorPublishedAt: sql.NullTime{},
+		CursorStoryID:     sql.NullString{},
+		LimitCount:        safeInt32(limit),
+	}
+
+	if after != nil {
+		params.CursorPublishedAt = sql.NullTime{Time: after.PublishedAt, Valid: true}
+		params.CursorStoryID = sql.NullString{String: after.StoryID, Valid: true}
+	}
+
+	rows, err := r.queries.ListFollowedStoriesFeed(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*stories.StoryWithChildren, len(rows))
+
+	for i, row := range rows {
+		storyWithChildren, err := r.parseStoryWithChildren(
+			row.Profile,
+			row.ProfileTx,
+			row.Story,
+			row.StoryTx,
+			row.Publications,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		publishedAt := row.PublishedAt
+		storyWithChildren.PublishedAt = &publishedAt
+
+		result[i] = storyWithChildren
+	}
+
+	return result, nil
+}
+
+// ListStoryAggregationMemberProfileIDs lists the individual members of a profile
+// who opted in to its aggregated story list.
+func (r *Repository) ListStoryAggregationMemberProfileIDs(
+	ctx context.Context,
+	profileID string,
+) ([]string, error) {
+	return r.queries.ListStoryAggregationMemberProfileIDs(
+		ctx,
+		ListStoryAggregationMemberProfileIDsParams{ProfileID: profileID},
+	)
+}
+
+// ListAggregatedStoriesForViewer lists the stories authored by or published to any
+// of the given profiles, starting after the given keyset position.
+func (r *Repository) ListAggregatedStoriesForViewer(
+	ctx context.Context,
+	localeCode string,
+	profileIDs []string,
+	viewerUserID *string,
+	after *stories.FeedCursor,
+	limit int,
+) ([]*stories.StoryWithChildren, error) {
+	params := ListAggregatedStoriesForViewerParams{
+		LocaleCode:        localeCode,
+		ViewerUserID:      sql.NullString{},
+		ProfileIds:        profileIDs,
+		CursorPublishedAt: sql.NullTime{},
+		CursorStoryID:     sql.NullString{},
+		LimitCount:        safeInt32(limit),
+	}
+
+	if viewerUserID != nil {
+		params.ViewerUserID = sql.NullString{String: *viewerUserID, Valid: true}
+	}
+
+	if after != nil {
+		params.CursorPublishedAt = sql.NullTime{Time: after.PublishedAt, Valid: true}
+		params.CursorStoryID = sql.NullString{String: after.StoryID, Valid: true}
+	}
+
+	rows, err := r.queries.ListAggregatedStoriesForViewer(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*stories.StoryWithChildren, len(rows))
+
+	for i, row := range rows {
+		storyWithChildren, err := r.parseStoryWithChildren(
+			row.Profile,
+			row.ProfileTx,
+			row.Story,
+			row.StoryTx,
+			row.Publications,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		publishedAt := row.PublishedAt
+		storyWithChildren.PublishedAt = &publishedAt
+
+		result[i] = storyWithChildren
+	}
+
+	return result, nil
+}
+
 func (r *Repository) ListStoriesByAuthorProfileID(
 	ctx context.Context,
 	localeCode string,
@@ -896,7 +1014,7 @@ func (r *Repository) GetUserMembershipForProfile(
 }
 
 func (r *Repository) InvalidateStorySlugCache(ctx context.Context, slug string) error {
-	err := r.cache.Invalidate(ctx, "story_id_by_slug:"+slug)
+	err := r.cache.Invalidate(ctx, CacheKeyStoryIDBySlug+":"+slug)
 	if err != nil {
 		return fmt.Errorf("invalidating story slug cache: %w", err)
 	}
@@ -177,3 +177,21 @@ func (r *Repository) CountInteractionsByKind(
 
 	return result, nil
 }
+
+func (r *Repository) IncrementViewCount(
+	ctx context.Context,
+	storyID string,
+) error {
+	return r.queries.IncrementStoryViewCount(ctx, IncrementStoryViewCountParams{
+		StoryID: storyID,
+	})
+}
+
+func (r *Repository) GetViewCount(
+	ctx context.Context,
+	storyID string,
+) (int64, error) {
+	return r.queries.GetStoryViewCount(ctx, GetStoryViewCountParams{
+		StoryID: storyID,
+	})
+}
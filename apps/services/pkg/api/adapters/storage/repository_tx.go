@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+// WithTx runs fn against a repository bound to a single database transaction.
+// The transaction is committed when fn returns nil and rolled back otherwise.
+// Calls made on the repository while a transaction is already open reuse it.
+//
+// Repository methods that open their own transaction (e.g. points transfers)
+// are not enlisted in the outer one.
+func (r *Repository) WithTx(
+	ctx context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return r.withTx(ctx, func(txRepo *Repository) error {
+		return fn(txRepo)
+	})
+}
+
+func (r *Repository) withTx(ctx context.Context, fn func(txRepo *Repository) error) error {
+	if r.tx != nil {
+		return fn(r)
+	}
+
+	dbTx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+
+	defer func() {
+		_ = dbTx.Rollback()
+	}()
+
+	afterCommit := []func(ctx context.Context){}
+
+	err = fn(r.bindTx(dbTx, &afterCommit))
+	if err != nil {
+		return err
+	}
+
+	err = dbTx.Commit()
+	if err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	for _, hook := range afterCommit {
+		hook(ctx)
+	}
+
+	return nil
+}
+
+// onCommit runs fn once the open transaction commits, or right away when no
+// transaction is open. Hooks of a rolled back transaction never run, so cache
+// invalidations registered through it cannot race the commit.
+func (r *Repository) onCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if r.tx == nil {
+		fn(ctx)
+
+		return
+	}
+
+	*r.afterCommit = append(*r.afterCommit, fn)
+}
+
+// bindTx returns a copy of the repository whose queries run on dbTx.
+func (r *Repository) bindTx(dbTx *sql.Tx, afterCommit *[]func(ctx context.Context)) *Repository {
+	return &Repository{
+		db:          r.db,
+		dbtx:        dbTx,
+		queries:     r.queries.WithTx(dbTx),
+		cache:       r.cache,
+		logger:      r.logger,
+		cacheTTL:    r.cacheTTL,
+		tx:          dbTx,
+		afterCommit: afterCommit,
+	}
+}
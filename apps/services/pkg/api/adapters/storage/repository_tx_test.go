@@ -0,0 +1,184 @@
+package storage //nolint:testpackage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+var errStepFailed = errors.New("step failed")
+
+func newTestRepository(t *testing.T) *Repository {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	_, err = db.ExecContext(t.Context(), "CREATE TABLE entries (id TEXT PRIMARY KEY)")
+	require.NoError(t, err)
+
+	return &Repository{ //nolint:exhaustruct
+		db:      db,
+		dbtx:    db,
+		queries: New(db),
+	}
+}
+
+func insertEntry(ctx context.Context, repo profiles.Repository, id string) error {
+	txRepo, _ := repo.(*Repository)
+
+	_, err := txRepo.dbtx.ExecContext(ctx, "INSERT INTO entries (id) VALUES (?)", id)
+
+	return err
+}
+
+func countEntries(t *testing.T, repo *Repository) int {
+	t.Helper()
+
+	var count int
+
+	err := repo.db.QueryRowContext(t.Context(), "SELECT COUNT(*) FROM entries").Scan(&count)
+	require.NoError(t, err)
+
+	return count
+}
+
+func TestRepository_WithTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("commits all steps on success", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestRepository(t)
+
+		err := repo.WithTx(t.Context(), func(txRepo profiles.Repository) error {
+			err := insertEntry(t.Context(), txRepo, "a")
+			if err != nil {
+				return err
+			}
+
+			return insertEntry(t.Context(), txRepo, "b")
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, countEntries(t, repo))
+	})
+
+	t.Run("failing step rolls back prior steps", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestRepository(t)
+
+		err := repo.WithTx(t.Context(), func(txRepo profiles.Repository) error {
+			err := insertEntry(t.Context(), txRepo, "a")
+			if err != nil {
+				return err
+			}
+
+			err = insertEntry(t.Context(), txRepo, "b")
+			if err != nil {
+				return err
+			}
+
+			return errStepFailed
+		})
+
+		require.ErrorIs(t, err, errStepFailed)
+		assert.Equal(t, 0, countEntries(t, repo))
+	})
+
+	t.Run("nested calls reuse the open transaction", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestRepository(t)
+
+		err := repo.WithTx(t.Context(), func(txRepo profiles.Repository) error {
+			err := insertEntry(t.Context(), txRepo, "a")
+			if err != nil {
+				return err
+			}
+
+			err = txRepo.WithTx(t.Context(), func(innerRepo profiles.Repository) error {
+				return insertEntry(t.Context(), innerRepo, "b")
+			})
+			if err != nil {
+				return err
+			}
+
+			return errStepFailed
+		})
+
+		require.ErrorIs(t, err, errStepFailed)
+		assert.Equal(t, 0, countEntries(t, repo))
+	})
+}
+
+func TestRepository_OnCommit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("runs hooks after the commit", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestRepository(t)
+		entriesSeen := -1
+
+		err := repo.WithTx(t.Context(), func(txRepo profiles.Repository) error {
+			err := insertEntry(t.Context(), txRepo, "a")
+			if err != nil {
+				return err
+			}
+
+			return txRepo.WithTx(t.Context(), func(innerRepo profiles.Repository) error {
+				innerRepo.(*Repository).onCommit(t.Context(), func(_ context.Context) { //nolint:forcetypeassert
+					entriesSeen = countEntries(t, repo)
+				})
+
+				return nil
+			})
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, entriesSeen)
+	})
+
+	t.Run("drops hooks of a rolled back transaction", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestRepository(t)
+		ran := false
+
+		err := repo.WithTx(t.Context(), func(txRepo profiles.Repository) error {
+			txRepo.(*Repository).onCommit(t.Context(), func(_ context.Context) { //nolint:forcetypeassert
+				ran = true
+			})
+
+			return errStepFailed
+		})
+
+		require.ErrorIs(t, err, errStepFailed)
+		assert.False(t, ran)
+	})
+
+	t.Run("runs hooks right away outside a transaction", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newTestRepository(t)
+		ran := false
+
+		repo.onCommit(t.Context(), func(_ context.Context) {
+			ran = true
+		})
+
+		assert.True(t, ran)
+	})
+}
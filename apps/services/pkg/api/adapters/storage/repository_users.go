@@ -218,3 +218,59 @@ func (r *Repository) SetUserIndividualProfileID(
 
 	return nil
 }
+
+func (r *Repository) ClearUserIndividualProfileID(ctx context.Context, userID string) error {
+	rowsAffected, err := r.queries.SetUserIndividualProfileID(ctx, SetUserIndividualProfileIDParams{
+		ID:                  userID,
+		IndividualProfileID: sql.NullString{String: "", Valid: false},
+	})
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *Repository) GetProfileKindForIndividualLink(
+	ctx context.Context,
+	profileID string,
+) (*string, error) {
+	kind, err := r.queries.GetProfileKindForIndividualLink(
+		ctx,
+		GetProfileKindForIndividualLinkParams{ID: profileID},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return &kind, nil
+}
+
+func (r *Repository) GetUserIDByIndividualProfileID(
+	ctx context.Context,
+	profileID string,
+) (*string, error) {
+	userID, err := r.queries.GetUserIDByIndividualProfileID(
+		ctx,
+		GetUserIDByIndividualProfileIDParams{
+			IndividualProfileID: sql.NullString{String: profileID, Valid: true},
+		},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return &userID, nil
+}
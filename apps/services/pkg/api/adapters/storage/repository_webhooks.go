@@ -0,0 +1,264 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+	"github.com/sqlc-dev/pqtype"
+)
+
+func (r *Repository) CreateDelivery(ctx context.Context, delivery *webhooks.Delivery) error {
+	return r.queries.CreateWebhookDelivery(ctx, CreateWebhookDeliveryParams{
+		ID:         delivery.ID,
+		Source:     delivery.Source,
+		EndpointID: vars.ToSQLNullString(delivery.EndpointID),
+		EventType:  delivery.EventType,
+		TargetURL:  delivery.TargetURL,
+		Payload:    pqtype.NullRawMessage{RawMessage: delivery.Payload, Valid: true},
+		Status:     string(delivery.Status),
+		CreatedAt:  delivery.CreatedAt,
+	})
+}
+
+// RecordDeliveryAttempt stores the attempt and updates its delivery in a single transaction.
+func (r *Repository) RecordDeliveryAttempt(
+	ctx context.Context,
+	attempt *webhooks.DeliveryAttempt,
+) error {
+	responseCode := intPtrToSQLNullInt32(attempt.ResponseCode)
+
+	return r.withTx(ctx, func(txRepo *Repository) error {
+		err := txRepo.queries.CreateWebhookDeliveryAttempt(ctx, CreateWebhookDeliveryAttemptParams{
+			ID:           attempt.ID,
+			DeliveryID:   attempt.DeliveryID,
+			Status:       string(attempt.Status),
+			ResponseCode: responseCode,
+			ErrorMessage: vars.ToSQLNullString(attempt.ErrorMessage),
+			DurationMs:   attempt.DurationMs,
+			AttemptedAt:  attempt.AttemptedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = txRepo.queries.UpdateWebhookDeliveryAfterAttempt(
+			ctx,
+			UpdateWebhookDeliveryAfterAttemptParams{
+				Status:           string(attempt.Status),
+				LastResponseCode: responseCode,
+				LastAttemptedAt:  sql.NullTime{Time: attempt.AttemptedAt, Valid: true},
+				ID:               attempt.DeliveryID,
+			},
+		)
+
+		return err
+	})
+}
+
+func (r *Repository) GetDeliveryByID(ctx context.Context, id string) (*webhooks.Delivery, error) {
+	row, err := r.queries.GetWebhookDeliveryByID(ctx, GetWebhookDeliveryByIDParams{ID: id})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return rowToWebhookDelivery(row), nil
+}
+
+func (r *Repository) ListDeliveryAttempts(
+	ctx context.Context,
+	deliveryID string,
+) ([]*webhooks.DeliveryAttempt, error) {
+	rows, err := r.queries.ListWebhookDeliveryAttempts(
+		ctx,
+		ListWebhookDeliveryAttemptsParams{DeliveryID: deliveryID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]*webhooks.DeliveryAttempt, len(rows))
+	for i, row := range rows {
+		attempts[i] = &webhooks.DeliveryAttempt{
+			AttemptedAt:  row.AttemptedAt,
+			ResponseCode: sqlNullInt32ToIntPtr(row.ResponseCode),
+			ErrorMessage: vars.ToStringPtr(row.ErrorMessage),
+			ID:           row.ID,
+			DeliveryID:   row.DeliveryID,
+			Status:       webhooks.DeliveryStatus(row.Status),
+			DurationMs:   row.DurationMs,
+		}
+	}
+
+	return attempts, nil
+}
+
+// ListDeliveries pages through deliveries by descending ID; the cursor offset
+// is the ID of the last delivery on the previous page.
+func (r *Repository) ListDeliveries(
+	ctx context.Context,
+	filter webhooks.DeliveryFilter,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*webhooks.Delivery], error) {
+	limit := cursor.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	var status *string
+
+	if filter.Status != nil {
+		value := string(*filter.Status)
+		status = &value
+	}
+
+	rows, err := r.queries.ListWebhookDeliveries(ctx, ListWebhookDeliveriesParams{
+		FilterSource: vars.ToSQLNullString(filter.Source),
+		FilterStatus: vars.ToSQLNullString(status),
+		BeforeID:     vars.ToSQLNullString(cursor.Offset),
+		LimitCount:   int32(limit + 1), // Fetch one extra to determine if there are more
+	})
+	if err != nil {
+		return cursors.Cursored[[]*webhooks.Delivery]{}, err
+	}
+
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	result := make([]*webhooks.Delivery, len(rows))
+	for i, row := range rows {
+		result[i] = rowToWebhookDelivery(row)
+	}
+
+	var nextCursor *string
+
+	if hasMore && len(result) > 0 {
+		lastID := result[len(result)-1].ID
+		nextCursor = &lastID
+	}
+
+	return cursors.WrapResponseWithCursor(result, nextCursor), nil
+}
+
+func (r *Repository) DeleteDeliveriesCreatedBefore(
+	ctx context.Context,
+	cutoff time.Time,
+) (int64, error) {
+	return r.queries.DeleteWebhookDeliveriesCreatedBefore(
+		ctx,
+		DeleteWebhookDeliveriesCreatedBeforeParams{Cutoff: cutoff},
+	)
+}
+
+func rowToWebhookDelivery(row *WebhookDelivery) *webhooks.Delivery {
+	return &webhooks.Delivery{
+		CreatedAt:        row.CreatedAt,
+		LastAttemptedAt:  vars.ToTimePtr(row.LastAttemptedAt),
+		LastResponseCode: sqlNullInt32ToIntPtr(row.LastResponseCode),
+		EndpointID:       vars.ToStringPtr(row.EndpointID),
+		ID:               row.ID,
+		Source:           row.Source,
+		EventType:        row.EventType,
+		TargetURL:        row.TargetURL,
+		Status:           webhooks.DeliveryStatus(row.Status),
+		Payload:          vars.ToRawMessage(row.Payload),
+		Attempts:         nil,
+		AttemptCount:     int(row.AttemptCount),
+	}
+}
+
+func intPtrToSQLNullInt32(value *int) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{Int32: 0, Valid: false}
+	}
+
+	return sql.NullInt32{Int32: int32(*value), Valid: true}
+}
+
+func sqlNullInt32ToIntPtr(value sql.NullInt32) *int {
+	if !value.Valid {
+		return nil
+	}
+
+	result := int(value.Int32)
+
+	return &result
+}
+
+func (r *Repository) CreateEndpoint(ctx context.Context, endpoint *webhooks.Endpoint) error {
+	return r.queries.CreateProfileWebhookEndpoint(ctx, CreateProfileWebhookEndpointParams{
+		ID:        endpoint.ID,
+		ProfileID: endpoint.ProfileID,
+		TargetURL: endpoint.TargetURL,
+		Secret:    endpoint.Secret,
+		CreatedAt: endpoint.CreatedAt,
+	})
+}
+
+func (r *Repository) ListProfileEndpoints(
+	ctx context.Context,
+	profileID string,
+) ([]*webhooks.Endpoint, error) {
+	rows, err := r.queries.ListProfileWebhookEndpoints(
+		ctx,
+		ListProfileWebhookEndpointsParams{ProfileID: profileID},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*webhooks.Endpoint, len(rows))
+	for i, row := range rows {
+		endpoints[i] = &webhooks.Endpoint{
+			CreatedAt: row.CreatedAt,
+			ID:        row.ID,
+			ProfileID: row.ProfileID,
+			TargetURL: row.TargetURL,
+			Secret:    "",
+		}
+	}
+
+	return endpoints, nil
+}
+
+func (r *Repository) GetEndpointSecret(ctx context.Context, endpointID string) (string, error) {
+	secret, err := r.queries.GetProfileWebhookEndpointSecret(
+		ctx,
+		GetProfileWebhookEndpointSecretParams{ID: endpointID},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return secret, nil
+}
+
+func (r *Repository) DeleteEndpoint(
+	ctx context.Context,
+	profileID string,
+	endpointID string,
+) (bool, error) {
+	affected, err := r.queries.DeleteProfileWebhookEndpoint(ctx, DeleteProfileWebhookEndpointParams{
+		ID:        endpointID,
+		ProfileID: profileID,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/eser/aya.is/services/pkg/lib/vars"
+)
+
+func (r *Repository) UpsertWebmention(
+	ctx context.Context,
+	id string,
+	profileID string,
+	sourceURL string,
+	targetURL string,
+) (*webmentions.Webmention, error) {
+	row, err := r.queries.UpsertWebmention(ctx, UpsertWebmentionParams{
+		ID:        id,
+		ProfileID: profileID,
+		SourceURL: sourceURL,
+		TargetURL: targetURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rowToWebmention(row), nil
+}
+
+func (r *Repository) GetWebmentionByID(
+	ctx context.Context,
+	id string,
+) (*webmentions.Webmention, error) {
+	row, err := r.queries.GetWebmentionByID(ctx, GetWebmentionByIDParams{ID: id})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil
+		}
+
+		return nil, err
+	}
+
+	return rowToWebmention(row), nil
+}
+
+func (r *Repository) UpdateWebmentionStatus(
+	ctx context.Context,
+	id string,
+	status webmentions.Status,
+	verifiedAt *time.Time,
+) error {
+	params := UpdateWebmentionStatusParams{
+		Status:     string(status),
+		VerifiedAt: sql.NullTime{},
+		ID:         id,
+	}
+
+	if verifiedAt != nil {
+		params.VerifiedAt = sql.NullTime{Time: *verifiedAt, Valid: true}
+	}
+
+	_, err := r.queries.UpdateWebmentionStatus(ctx, params)
+
+	return err
+}
+
+func (r *Repository) ListVerifiedWebmentions(
+	ctx context.Context,
+	profileID string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*webmentions.Webmention], error) {
+	limit := cursor.Limit
+	if limit <= 0 {
+		limit = 20
+	}
+
+	rows, err := r.queries.ListVerifiedWebmentionsByProfileID(
+		ctx,
+		ListVerifiedWebmentionsByProfileIDParams{
+			ProfileID:  profileID,
+			BeforeID:   vars.ToSQLNullString(cursor.Offset),
+			LimitCount: int32(limit + 1), // Fetch one extra to determine if there are more
+		},
+	)
+	if err != nil {
+		return cursors.Cursored[[]*webmentions.Webmention]{}, err
+	}
+
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	result := make([]*webmentions.Webmention, len(rows))
+	for i, row := range rows {
+		result[i] = rowToWebmention(row)
+	}
+
+	var nextCursor *string
+
+	if hasMore && len(result) > 0 {
+		lastID := result[len(result)-1].ID
+		nextCursor = &lastID
+	}
+
+	return cursors.WrapResponseWithCursor(result, nextCursor), nil
+}
+
+func rowToWebmention(row *Webmention) *webmentions.Webmention {
+	return &webmentions.Webmention{
+		CreatedAt:  row.CreatedAt,
+		VerifiedAt: vars.ToTimePtr(row.VerifiedAt),
+		UpdatedAt:  vars.ToTimePtr(row.UpdatedAt),
+		ID:         row.ID,
+		ProfileID:  row.ProfileID,
+		SourceURL:  row.SourceURL,
+		TargetURL:  row.TargetURL,
+		Status:     webmentions.Status(row.Status),
+	}
+}
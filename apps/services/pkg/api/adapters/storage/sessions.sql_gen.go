@@ -89,7 +89,9 @@ INSERT INTO
     updated_at,
     oauth_provider,
     oauth_access_token,
-    oauth_token_scope
+    oauth_token_scope,
+    impersonator_user_id,
+    impersonator_session_id
   )
 VALUES
   (
@@ -107,7 +109,9 @@ VALUES
     $12,
     $13,
     $14,
-    $15
+    $15,
+    $16,
+    $17
   )
 `
 
@@ -127,6 +131,8 @@ type CreateSessionParams struct {
 	OauthProvider            sql.NullString `db:"oauth_provider" json:"oauth_provider"`
 	OauthAccessToken         sql.NullString `db:"oauth_access_token" json:"oauth_access_token"`
 	OauthTokenScope          sql.NullString `db:"oauth_token_scope" json:"oauth_token_scope"`
+	ImpersonatorUserID       sql.NullString `db:"impersonator_user_id" json:"impersonator_user_id"`
+	ImpersonatorSessionID    sql.NullString `db:"impersonator_session_id" json:"impersonator_session_id"`
 }
 
 // CreateSession
@@ -147,7 +153,9 @@ type CreateSessionParams struct {
 //	    updated_at,
 //	    oauth_provider,
 //	    oauth_access_token,
-//	    oauth_token_scope
+//	    oauth_token_scope,
+//	    impersonator_user_id,
+//	    impersonator_session_id
 //	  )
 //	VALUES
 //	  (
@@ -165,7 +173,9 @@ type CreateSessionParams struct {
 //	    $12,
 //	    $13,
 //	    $14,
-//	    $15
+//	    $15,
+//	    $16,
+//	    $17
 //	  )
 func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) error {
 	_, err := q.db.ExecContext(ctx, createSession,
@@ -184,6 +194,8 @@ func (q *Queries) CreateSession(ctx context.Context, arg CreateSessionParams) er
 		arg.OauthProvider,
 		arg.OauthAccessToken,
 		arg.OauthTokenScope,
+		arg.ImpersonatorUserID,
+		arg.ImpersonatorSessionID,
 	)
 	return err
 }
@@ -247,7 +259,9 @@ SELECT
   updated_at,
   oauth_provider,
   oauth_access_token,
-  oauth_token_scope
+  oauth_token_scope,
+  impersonator_user_id,
+  impersonator_session_id
 FROM
   session
 WHERE
@@ -274,6 +288,8 @@ type GetSessionByIDRow struct {
 	OauthProvider            sql.NullString `db:"oauth_provider" json:"oauth_provider"`
 	OauthAccessToken         sql.NullString `db:"oauth_access_token" json:"oauth_access_token"`
 	OauthTokenScope          sql.NullString `db:"oauth_token_scope" json:"oauth_token_scope"`
+	ImpersonatorUserID       sql.NullString `db:"impersonator_user_id" json:"impersonator_user_id"`
+	ImpersonatorSessionID    sql.NullString `db:"impersonator_session_id" json:"impersonator_session_id"`
 }
 
 // GetSessionByID
@@ -293,7 +309,9 @@ type GetSessionByIDRow struct {
 //	  updated_at,
 //	  oauth_provider,
 //	  oauth_access_token,
-//	  oauth_token_scope
+//	  oauth_token_scope,
+//	  impersonator_user_id,
+//	  impersonator_session_id
 //	FROM
 //	  session
 //	WHERE
@@ -317,6 +335,8 @@ func (q *Queries) GetSessionByID(ctx context.Context, arg GetSessionByIDParams)
 		&i.OauthProvider,
 		&i.OauthAccessToken,
 		&i.OauthTokenScope,
+		&i.ImpersonatorUserID,
+		&i.ImpersonatorSessionID,
 	)
 	return &i, err
 }
@@ -468,7 +488,9 @@ SELECT
   updated_at,
   oauth_provider,
   oauth_access_token,
-  oauth_token_scope
+  oauth_token_scope,
+  impersonator_user_id,
+  impersonator_session_id
 FROM
   session
 WHERE
@@ -498,6 +520,8 @@ type ListSessionsByUserIDRow struct {
 	OauthProvider            sql.NullString `db:"oauth_provider" json:"oauth_provider"`
 	OauthAccessToken         sql.NullString `db:"oauth_access_token" json:"oauth_access_token"`
 	OauthTokenScope          sql.NullString `db:"oauth_token_scope" json:"oauth_token_scope"`
+	ImpersonatorUserID       sql.NullString `db:"impersonator_user_id" json:"impersonator_user_id"`
+	ImpersonatorSessionID    sql.NullString `db:"impersonator_session_id" json:"impersonator_session_id"`
 }
 
 // ListSessionsByUserID
@@ -517,7 +541,9 @@ type ListSessionsByUserIDRow struct {
 //	  updated_at,
 //	  oauth_provider,
 //	  oauth_access_token,
-//	  oauth_token_scope
+//	  oauth_token_scope,
+//	  impersonator_user_id,
+//	  impersonator_session_id
 //	FROM
 //	  session
 //	WHERE
@@ -550,6 +576,8 @@ func (q *Queries) ListSessionsByUserID(ctx context.Context, arg ListSessionsByUs
 			&i.OauthProvider,
 			&i.OauthAccessToken,
 			&i.OauthTokenScope,
+			&i.ImpersonatorUserID,
+			&i.ImpersonatorSessionID,
 		); err != nil {
 			return nil, err
 		}
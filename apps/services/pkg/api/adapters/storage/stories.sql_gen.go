@@ -10,6 +10,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/sqlc-dev/pqtype"
 )
 
@@ -1110,6 +1111,546 @@ func (q *Queries) ListActivityStories(ctx context.Context, arg ListActivityStori
 	return items, nil
 }
 
+const listAggregatedStoriesForViewer = `-- name: ListAggregatedStoriesForViewer :many
+SELECT
+  s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
+  st.story_id, st.locale_code, st.title, st.summary, st.content, st.search_vector, st.is_managed, st.summary_ai,
+  p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
+  p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
+  pb.publications,
+  fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+FROM "story" s
+  INNER JOIN LATERAL (
+    SELECT MIN(sp0.published_at) AS first_published_at
+    FROM story_publication sp0
+    WHERE sp0.story_id = s.id
+      AND sp0.published_at IS NOT NULL
+      AND sp0.deleted_at IS NULL
+  ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+  INNER JOIN "story_tx" st ON st.story_id = s.id
+  AND st.locale_code = (
+    SELECT stx.locale_code FROM "story_tx" stx
+    WHERE stx.story_id = s.id
+    ORDER BY CASE
+      WHEN stx.locale_code = $1 THEN 0
+      WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+  LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+  AND p1.approved_at IS NOT NULL
+  AND p1.deleted_at IS NULL
+  INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+  AND p1t.locale_code = (
+    SELECT ptx.locale_code FROM "profile_tx" ptx
+    WHERE ptx.profile_id = p1.id
+    ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
+    LIMIT 1
+  )
+  LEFT JOIN LATERAL (
+    SELECT JSONB_AGG(
+      JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+    ) AS "publications"
+    FROM story_publication sp
+      INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+      AND p2.approved_at IS NOT NULL
+      AND p2.deleted_at IS NULL
+      INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+      AND p2t.locale_code = (
+        SELECT ptx2.locale_code FROM "profile_tx" ptx2
+        WHERE ptx2.profile_id = p2.id
+        ORDER BY CASE WHEN ptx2.locale_code = $1 THEN 0 ELSE 1 END
+        LIMIT 1
+      )
+    WHERE sp.story_id = s.id
+      AND sp.deleted_at IS NULL
+  ) pb ON TRUE
+  LEFT JOIN "user" u ON u.id = $2::CHAR(26)
+  LEFT JOIN "profile_membership" pm ON s.author_profile_id = pm.profile_id
+    AND pm.member_profile_id = u.individual_profile_id
+    AND pm.deleted_at IS NULL
+    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+WHERE
+  pb.publications IS NOT NULL
+  AND s.visibility != 'unlisted'
+  AND (
+    s.visibility = 'public'
+    OR u.kind = 'admin'
+    OR s.author_profile_id = u.individual_profile_id
+    OR pm.kind IN ('owner', 'lead', 'maintainer')
+  )
+  AND s.deleted_at IS NULL
+  AND (
+    s.author_profile_id = ANY($3::TEXT[])
+    OR EXISTS (
+      SELECT 1 FROM story_publication sp5
+      WHERE sp5.story_id = s.id
+        AND sp5.profile_id = ANY($3::TEXT[])
+        AND sp5.deleted_at IS NULL
+    )
+  )
+  AND (
+    $4::TIMESTAMP WITH TIME ZONE IS NULL
+    OR (fp.first_published_at, s.id) < ($4::TIMESTAMP WITH TIME ZONE, $5::CHAR(26))
+  )
+ORDER BY fp.first_published_at DESC, s.id DESC
+LIMIT $6
+`
+
+type ListAggregatedStoriesForViewerParams struct {
+	LocaleCode        string         `db:"locale_code" json:"locale_code"`
+	ViewerUserID      sql.NullString `db:"viewer_user_id" json:"viewer_user_id"`
+	ProfileIds        []string       `db:"profile_ids" json:"profile_ids"`
+	CursorPublishedAt sql.NullTime   `db:"cursor_published_at" json:"cursor_published_at"`
+	CursorStoryID     sql.NullString `db:"cursor_story_id" json:"cursor_story_id"`
+	LimitCount        int32          `db:"limit_count" json:"limit_count"`
+}
+
+type ListAggregatedStoriesForViewerRow struct {
+	Story        Story                 `db:"story" json:"story"`
+	StoryTx      StoryTx               `db:"story_tx" json:"story_tx"`
+	Profile      Profile               `db:"profile" json:"profile"`
+	ProfileTx    ProfileTx             `db:"profile_tx" json:"profile_tx"`
+	Publications pqtype.NullRawMessage `db:"publications" json:"publications"`
+	PublishedAt  time.Time             `db:"published_at" json:"published_at"`
+}
+
+// Lists published stories authored by or published to any of the given profiles.
+// Visibility follows ListStoriesOfPublicationForViewer: private stories only for
+// authorized viewers, unlisted stories never.
+// Newest first, keyset-paginated on (first publication time, story id).
+//
+//	SELECT
+//	  s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
+//	  st.story_id, st.locale_code, st.title, st.summary, st.content, st.search_vector, st.is_managed, st.summary_ai,
+//	  p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
+//	  p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
+//	  pb.publications,
+//	  fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+//	FROM "story" s
+//	  INNER JOIN LATERAL (
+//	    SELECT MIN(sp0.published_at) AS first_published_at
+//	    FROM story_publication sp0
+//	    WHERE sp0.story_id = s.id
+//	      AND sp0.published_at IS NOT NULL
+//	      AND sp0.deleted_at IS NULL
+//	  ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+//	  INNER JOIN "story_tx" st ON st.story_id = s.id
+//	  AND st.locale_code = (
+//	    SELECT stx.locale_code FROM "story_tx" stx
+//	    WHERE stx.story_id = s.id
+//	    ORDER BY CASE
+//	      WHEN stx.locale_code = $1 THEN 0
+//	      WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+//	      ELSE 2
+//	    END
+//	    LIMIT 1
+//	  )
+//	  LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+//	  AND p1.approved_at IS NOT NULL
+//	  AND p1.deleted_at IS NULL
+//	  INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+//	  AND p1t.locale_code = (
+//	    SELECT ptx.locale_code FROM "profile_tx" ptx
+//	    WHERE ptx.profile_id = p1.id
+//	    ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
+//	    LIMIT 1
+//	  )
+//	  LEFT JOIN LATERAL (
+//	    SELECT JSONB_AGG(
+//	      JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+//	    ) AS "publications"
+//	    FROM story_publication sp
+//	      INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+//	      AND p2.approved_at IS NOT NULL
+//	      AND p2.deleted_at IS NULL
+//	      INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+//	      AND p2t.locale_code = (
+//	        SELECT ptx2.locale_code FROM "profile_tx" ptx2
+//	        WHERE ptx2.profile_id = p2.id
+//	        ORDER BY CASE WHEN ptx2.locale_code = $1 THEN 0 ELSE 1 END
+//	        LIMIT 1
+//	      )
+//	    WHERE sp.story_id = s.id
+//	      AND sp.deleted_at IS NULL
+//	  ) pb ON TRUE
+//	  LEFT JOIN "user" u ON u.id = $2::CHAR(26)
+//	  LEFT JOIN "profile_membership" pm ON s.author_profile_id = pm.profile_id
+//	    AND pm.member_profile_id = u.individual_profile_id
+//	    AND pm.deleted_at IS NULL
+//	    AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+//	WHERE
+//	  pb.publications IS NOT NULL
+//	  AND s.visibility != 'unlisted'
+//	  AND (
+//	    s.visibility = 'public'
+//	    OR u.kind = 'admin'
+//	    OR s.author_profile_id = u.individual_profile_id
+//	    OR pm.kind IN ('owner', 'lead', 'maintainer')
+//	  )
+//	  AND s.deleted_at IS NULL
+//	  AND (
+//	    s.author_profile_id = ANY($3::TEXT[])
+//	    OR EXISTS (
+//	      SELECT 1 FROM story_publication sp5
+//	      WHERE sp5.story_id = s.id
+//	        AND sp5.profile_id = ANY($3::TEXT[])
+//	        AND sp5.deleted_at IS NULL
+//	    )
+//	  )
+//	  AND (
+//	    $4::TIMESTAMP WITH TIME ZONE IS NULL
+//	    OR (fp.first_published_at, s.id) < ($4::TIMESTAMP WITH TIME ZONE, $5::CHAR(26))
+//	  )
+//	ORDER BY fp.first_published_at DESC, s.id DESC
+//	LIMIT $6
+func (q *Queries) ListAggregatedStoriesForViewer(ctx context.Context, arg ListAggregatedStoriesForViewerParams) ([]*ListAggregatedStoriesForViewerRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAggregatedStoriesForViewer,
+		arg.LocaleCode,
+		arg.ViewerUserID,
+		pq.Array(arg.ProfileIds),
+		arg.CursorPublishedAt,
+		arg.CursorStoryID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListAggregatedStoriesForViewerRow{}
+	for rows.Next() {
+		var i ListAggregatedStoriesForViewerRow
+		if err := rows.Scan(
+			&i.Story.ID,
+			&i.Story.AuthorProfileID,
+			&i.Story.Slug,
+			&i.Story.Kind,
+			&i.Story.StoryPictureURI,
+			&i.Story.Properties,
+			&i.Story.CreatedAt,
+			&i.Story.UpdatedAt,
+			&i.Story.DeletedAt,
+			&i.Story.IsManaged,
+			&i.Story.RemoteID,
+			&i.Story.SeriesID,
+			&i.Story.Visibility,
+			&i.Story.FeatDiscussions,
+			&i.Story.SortOrder,
+			&i.StoryTx.StoryID,
+			&i.StoryTx.LocaleCode,
+			&i.StoryTx.Title,
+			&i.StoryTx.Summary,
+			&i.StoryTx.Content,
+			&i.StoryTx.SearchVector,
+			&i.StoryTx.IsManaged,
+			&i.StoryTx.SummaryAi,
+			&i.Profile.ID,
+			&i.Profile.Slug,
+			&i.Profile.Kind,
+			&i.Profile.ProfilePictureURI,
+			&i.Profile.Pronouns,
+			&i.Profile.Properties,
+			&i.Profile.CreatedAt,
+			&i.Profile.UpdatedAt,
+			&i.Profile.DeletedAt,
+			&i.Profile.ApprovedAt,
+			&i.Profile.Points,
+			&i.Profile.FeatureRelations,
+			&i.Profile.FeatureLinks,
+			&i.Profile.DefaultLocale,
+			&i.Profile.FeatureQa,
+			&i.Profile.FeatureDiscussions,
+			&i.Profile.OptionStoryDiscussionsByDefault,
+			&i.Profile.FeatureReferrals,
+			&i.Profile.FeatureApplications,
+			&i.ProfileTx.ProfileID,
+			&i.ProfileTx.LocaleCode,
+			&i.ProfileTx.Title,
+			&i.ProfileTx.Description,
+			&i.ProfileTx.Properties,
+			&i.ProfileTx.SearchVector,
+			&i.Publications,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listFollowedStoriesFeed = `-- name: ListFollowedStoriesFeed :many
+SELECT
+  s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
+  st.story_id, st.locale_code, st.title, st.summary, st.content, st.search_vector, st.is_managed, st.summary_ai,
+  p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
+  p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
+  pb.publications,
+  fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+FROM "story" s
+  INNER JOIN LATERAL (
+    SELECT MIN(sp0.published_at) AS first_published_at
+    FROM story_publication sp0
+    WHERE sp0.story_id = s.id
+      AND sp0.published_at IS NOT NULL
+      AND sp0.deleted_at IS NULL
+  ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+  INNER JOIN "story_tx" st ON st.story_id = s.id
+  AND st.locale_code = (
+    SELECT stx.locale_code FROM "story_tx" stx
+    WHERE stx.story_id = s.id
+    ORDER BY CASE
+      WHEN stx.locale_code = $1 THEN 0
+      WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+      ELSE 2
+    END
+    LIMIT 1
+  )
+  LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+  AND p1.approved_at IS NOT NULL
+  AND p1.deleted_at IS NULL
+  INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+  AND p1t.locale_code = (
+    SELECT ptx.locale_code FROM "profile_tx" ptx
+    WHERE ptx.profile_id = p1.id
+    ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
+    LIMIT 1
+  )
+  LEFT JOIN LATERAL (
+    SELECT JSONB_AGG(
+      JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+    ) AS "publications"
+    FROM story_publication sp
+      INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+      AND p2.approved_at IS NOT NULL
+      AND p2.deleted_at IS NULL
+      INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+      AND p2t.locale_code = (
+        SELECT ptx2.locale_code FROM "profile_tx" ptx2
+        WHERE ptx2.profile_id = p2.id
+        ORDER BY CASE WHEN ptx2.locale_code = $1 THEN 0 ELSE 1 END
+        LIMIT 1
+      )
+    WHERE sp.story_id = s.id
+      AND sp.deleted_at IS NULL
+  ) pb ON TRUE
+WHERE
+  pb.publications IS NOT NULL
+  AND s.visibility = 'public'
+  AND s.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM "profile_membership" pm
+      INNER JOIN "user" u ON u.individual_profile_id = pm.member_profile_id
+    WHERE u.id = $2
+      AND pm.kind = 'follower'
+      AND pm.deleted_at IS NULL
+      AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+      AND (
+        pm.profile_id = s.author_profile_id
+        OR EXISTS (
+          SELECT 1 FROM story_publication sp5
+          WHERE sp5.story_id = s.id
+            AND sp5.profile_id = pm.profile_id
+            AND sp5.deleted_at IS NULL
+        )
+      )
+  )
+  AND (
+    $3::TIMESTAMP WITH TIME ZONE IS NULL
+    OR (fp.first_published_at, s.id) < ($3::TIMESTAMP WITH TIME ZONE, $4::CHAR(26))
+  )
+ORDER BY fp.first_published_at DESC, s.id DESC
+LIMIT $5
+`
+
+type ListFollowedStoriesFeedParams struct {
+	LocaleCode        string         `db:"locale_code" json:"locale_code"`
+	ViewerUserID      string         `db:"viewer_user_id" json:"viewer_user_id"`
+	CursorPublishedAt sql.NullTime   `db:"cursor_published_at" json:"cursor_published_at"`
+	CursorStoryID     sql.NullString `db:"cursor_story_id" json:"cursor_story_id"`
+	LimitCount        int32          `db:"limit_count" json:"limit_count"`
+}
+
+type ListFollowedStoriesFeedRow struct {
+	Story        Story                 `db:"story" json:"story"`
+	StoryTx      StoryTx               `db:"story_tx" json:"story_tx"`
+	Profile      Profile               `db:"profile" json:"profile"`
+	ProfileTx    ProfileTx             `db:"profile_tx" json:"profile_tx"`
+	Publications pqtype.NullRawMessage `db:"publications" json:"publications"`
+	PublishedAt  time.Time             `db:"published_at" json:"published_at"`
+}
+
+// Lists public, published stories authored by or published to profiles the viewer follows.
+// Newest first, keyset-paginated on (first publication time, story id).
+//
+//	SELECT
+//	  s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
+//	  st.story_id, st.locale_code, st.title, st.summary, st.content, st.search_vector, st.is_managed, st.summary_ai,
+//	  p1.id, p1.slug, p1.kind, p1.profile_picture_uri, p1.pronouns, p1.properties, p1.created_at, p1.updated_at, p1.deleted_at, p1.approved_at, p1.points, p1.feature_relations, p1.feature_links, p1.default_locale, p1.feature_qa, p1.feature_discussions, p1.option_story_discussions_by_default, p1.feature_referrals, p1.feature_applications,
+//	  p1t.profile_id, p1t.locale_code, p1t.title, p1t.description, p1t.properties, p1t.search_vector,
+//	  pb.publications,
+//	  fp.first_published_at::TIMESTAMP WITH TIME ZONE AS published_at
+//	FROM "story" s
+//	  INNER JOIN LATERAL (
+//	    SELECT MIN(sp0.published_at) AS first_published_at
+//	    FROM story_publication sp0
+//	    WHERE sp0.story_id = s.id
+//	      AND sp0.published_at IS NOT NULL
+//	      AND sp0.deleted_at IS NULL
+//	  ) fp ON fp.first_published_at IS NOT NULL AND fp.first_published_at <= NOW()
+//	  INNER JOIN "story_tx" st ON st.story_id = s.id
+//	  AND st.locale_code = (
+//	    SELECT stx.locale_code FROM "story_tx" stx
+//	    WHERE stx.story_id = s.id
+//	    ORDER BY CASE
+//	      WHEN stx.locale_code = $1 THEN 0
+//	      WHEN stx.locale_code = (SELECT p_loc.default_locale FROM "profile" p_loc WHERE p_loc.id = s.author_profile_id) THEN 1
+//	      ELSE 2
+//	    END
+//	    LIMIT 1
+//	  )
+//	  LEFT JOIN "profile" p1 ON p1.id = s.author_profile_id
+//	  AND p1.approved_at IS NOT NULL
+//	  AND p1.deleted_at IS NULL
+//	  INNER JOIN "profile_tx" p1t ON p1t.profile_id = p1.id
+//	  AND p1t.locale_code = (
+//	    SELECT ptx.locale_code FROM "profile_tx" ptx
+//	    WHERE ptx.profile_id = p1.id
+//	    ORDER BY CASE WHEN ptx.locale_code = $1 THEN 0 ELSE 1 END
+//	    LIMIT 1
+//	  )
+//	  LEFT JOIN LATERAL (
+//	    SELECT JSONB_AGG(
+//	      JSONB_BUILD_OBJECT('profile', row_to_json(p2), 'profile_tx', row_to_json(p2t))
+//	    ) AS "publications"
+//	    FROM story_publication sp
+//	      INNER JOIN "profile" p2 ON p2.id = sp.profile_id
+//	      AND p2.approved_at IS NOT NULL
+//	      AND p2.deleted_at IS NULL
+//	      INNER JOIN "profile_tx" p2t ON p2t.profile_id = p2.id
+//	      AND p2t.locale_code = (
+//	        SELECT ptx2.locale_code FROM "profile_tx" ptx2
+//	        WHERE ptx2.profile_id = p2.id
+//	        ORDER BY CASE WHEN ptx2.locale_code = $1 THEN 0 ELSE 1 END
+//	        LIMIT 1
+//	      )
+//	    WHERE sp.story_id = s.id
+//	      AND sp.deleted_at IS NULL
+//	  ) pb ON TRUE
+//	WHERE
+//	  pb.publications IS NOT NULL
+//	  AND s.visibility = 'public'
+//	  AND s.deleted_at IS NULL
+//	  AND EXISTS (
+//	    SELECT 1 FROM "profile_membership" pm
+//	      INNER JOIN "user" u ON u.individual_profile_id = pm.member_profile_id
+//	    WHERE u.id = $2
+//	      AND pm.kind = 'follower'
+//	      AND pm.deleted_at IS NULL
+//	      AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+//	      AND (
+//	        pm.profile_id = s.author_profile_id
+//	        OR EXISTS (
+//	          SELECT 1 FROM story_publication sp5
+//	          WHERE sp5.story_id = s.id
+//	            AND sp5.profile_id = pm.profile_id
+//	            AND sp5.deleted_at IS NULL
+//	        )
+//	      )
+//	  )
+//	  AND (
+//	    $3::TIMESTAMP WITH TIME ZONE IS NULL
+//	    OR (fp.first_published_at, s.id) < ($3::TIMESTAMP WITH TIME ZONE, $4::CHAR(26))
+//	  )
+//	ORDER BY fp.first_published_at DESC, s.id DESC
+//	LIMIT $5
+func (q *Queries) ListFollowedStoriesFeed(ctx context.Context, arg ListFollowedStoriesFeedParams) ([]*ListFollowedStoriesFeedRow, error) {
+	rows, err := q.db.QueryContext(ctx, listFollowedStoriesFeed,
+		arg.LocaleCode,
+		arg.ViewerUserID,
+		arg.CursorPublishedAt,
+		arg.CursorStoryID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListFollowedStoriesFeedRow{}
+	for rows.Next() {
+		var i ListFollowedStoriesFeedRow
+		if err := rows.Scan(
+			&i.Story.ID,
+			&i.Story.AuthorProfileID,
+			&i.Story.Slug,
+			&i.Story.Kind,
+			&i.Story.StoryPictureURI,
+			&i.Story.Properties,
+			&i.Story.CreatedAt,
+			&i.Story.UpdatedAt,
+			&i.Story.DeletedAt,
+			&i.Story.IsManaged,
+			&i.Story.RemoteID,
+			&i.Story.SeriesID,
+			&i.Story.Visibility,
+			&i.Story.FeatDiscussions,
+			&i.Story.SortOrder,
+			&i.StoryTx.StoryID,
+			&i.StoryTx.LocaleCode,
+			&i.StoryTx.Title,
+			&i.StoryTx.Summary,
+			&i.StoryTx.Content,
+			&i.StoryTx.SearchVector,
+			&i.StoryTx.IsManaged,
+			&i.StoryTx.SummaryAi,
+			&i.Profile.ID,
+			&i.Profile.Slug,
+			&i.Profile.Kind,
+			&i.Profile.ProfilePictureURI,
+			&i.Profile.Pronouns,
+			&i.Profile.Properties,
+			&i.Profile.CreatedAt,
+			&i.Profile.UpdatedAt,
+			&i.Profile.DeletedAt,
+			&i.Profile.ApprovedAt,
+			&i.Profile.Points,
+			&i.Profile.FeatureRelations,
+			&i.Profile.FeatureLinks,
+			&i.Profile.DefaultLocale,
+			&i.Profile.FeatureQa,
+			&i.Profile.FeatureDiscussions,
+			&i.Profile.OptionStoryDiscussionsByDefault,
+			&i.Profile.FeatureReferrals,
+			&i.Profile.FeatureApplications,
+			&i.ProfileTx.ProfileID,
+			&i.ProfileTx.LocaleCode,
+			&i.ProfileTx.Title,
+			&i.ProfileTx.Description,
+			&i.ProfileTx.Properties,
+			&i.ProfileTx.SearchVector,
+			&i.Publications,
+			&i.PublishedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStoriesByAuthorProfileID = `-- name: ListStoriesByAuthorProfileID :many
 SELECT
   s.id, s.author_profile_id, s.slug, s.kind, s.story_picture_uri, s.properties, s.created_at, s.updated_at, s.deleted_at, s.is_managed, s.remote_id, s.series_id, s.visibility, s.feat_discussions, s.sort_order,
@@ -2181,6 +2722,62 @@ func (q *Queries) ListStoriesOfPublicationForViewer(ctx context.Context, arg Lis
 	return items, nil
 }
 
+const listStoryAggregationMemberProfileIDs = `-- name: ListStoryAggregationMemberProfileIDs :many
+SELECT pm.member_profile_id::CHAR(26) AS member_profile_id
+FROM "profile_membership" pm
+  INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+  AND mp.kind = 'individual'
+  AND mp.approved_at IS NOT NULL
+  AND mp.deleted_at IS NULL
+WHERE pm.profile_id = $1
+  AND pm.aggregate_stories = TRUE
+  AND pm.kind != 'follower'
+  AND pm.deleted_at IS NULL
+  AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+ORDER BY pm.member_profile_id
+`
+
+type ListStoryAggregationMemberProfileIDsParams struct {
+	ProfileID string `db:"profile_id" json:"profile_id"`
+}
+
+// Individual members of a profile who opted in to its aggregated story list.
+//
+//	SELECT pm.member_profile_id::CHAR(26) AS member_profile_id
+//	FROM "profile_membership" pm
+//	  INNER JOIN "profile" mp ON mp.id = pm.member_profile_id
+//	  AND mp.kind = 'individual'
+//	  AND mp.approved_at IS NOT NULL
+//	  AND mp.deleted_at IS NULL
+//	WHERE pm.profile_id = $1
+//	  AND pm.aggregate_stories = TRUE
+//	  AND pm.kind != 'follower'
+//	  AND pm.deleted_at IS NULL
+//	  AND (pm.finished_at IS NULL OR pm.finished_at > NOW())
+//	ORDER BY pm.member_profile_id
+func (q *Queries) ListStoryAggregationMemberProfileIDs(ctx context.Context, arg ListStoryAggregationMemberProfileIDsParams) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listStoryAggregationMemberProfileIDs, arg.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var member_profile_id string
+		if err := rows.Scan(&member_profile_id); err != nil {
+			return nil, err
+		}
+		items = append(items, member_profile_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listStoryPublicationProfileIDs = `-- name: ListStoryPublicationProfileIDs :many
 SELECT profile_id FROM "story_publication"
 WHERE story_id = $1 AND deleted_at IS NULL
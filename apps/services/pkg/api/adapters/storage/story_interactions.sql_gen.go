@@ -96,6 +96,52 @@ func (q *Queries) GetStoryInteraction(ctx context.Context, arg GetStoryInteracti
 	return &i, err
 }
 
+const getStoryViewCount = `-- name: GetStoryViewCount :one
+SELECT COALESCE(
+  (SELECT view_count FROM "story_view_stat" WHERE story_id = $1),
+  0
+)::BIGINT AS view_count
+`
+
+type GetStoryViewCountParams struct {
+	StoryID string `db:"story_id" json:"story_id"`
+}
+
+// Returns the deduplicated view counter of a story (0 when never viewed).
+//
+//	SELECT COALESCE(
+//	  (SELECT view_count FROM "story_view_stat" WHERE story_id = $1),
+//	  0
+//	)::BIGINT AS view_count
+func (q *Queries) GetStoryViewCount(ctx context.Context, arg GetStoryViewCountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getStoryViewCount, arg.StoryID)
+	var view_count int64
+	err := row.Scan(&view_count)
+	return view_count, err
+}
+
+const incrementStoryViewCount = `-- name: IncrementStoryViewCount :exec
+INSERT INTO "story_view_stat" (story_id, view_count, updated_at)
+VALUES ($1, 1, NOW())
+ON CONFLICT (story_id)
+DO UPDATE SET view_count = "story_view_stat".view_count + 1, updated_at = NOW()
+`
+
+type IncrementStoryViewCountParams struct {
+	StoryID string `db:"story_id" json:"story_id"`
+}
+
+// Increments the deduplicated view counter of a story.
+//
+//	INSERT INTO "story_view_stat" (story_id, view_count, updated_at)
+//	VALUES ($1, 1, NOW())
+//	ON CONFLICT (story_id)
+//	DO UPDATE SET view_count = "story_view_stat".view_count + 1, updated_at = NOW()
+func (q *Queries) IncrementStoryViewCount(ctx context.Context, arg IncrementStoryViewCountParams) error {
+	_, err := q.db.ExecContext(ctx, incrementStoryViewCount, arg.StoryID)
+	return err
+}
+
 const listStoryInteractions = `-- name: ListStoryInteractions :many
 SELECT
   si.id,
@@ -77,6 +77,17 @@ type Cache struct {
 	UpdatedAt time.Time             `db:"updated_at" json:"updated_at"`
 }
 
+type ConsistencyCheck struct {
+	ID                string                `db:"id" json:"id"`
+	RequestedByUserID string                `db:"requested_by_user_id" json:"requested_by_user_id"`
+	Repair            bool                  `db:"repair" json:"repair"`
+	Status            string                `db:"status" json:"status"`
+	Findings          pqtype.NullRawMessage `db:"findings" json:"findings"`
+	ErrorMessage      sql.NullString        `db:"error_message" json:"error_message"`
+	CreatedAt         time.Time             `db:"created_at" json:"created_at"`
+	CompletedAt       sql.NullTime          `db:"completed_at" json:"completed_at"`
+}
+
 type DiscussionComment struct {
 	ID            string         `db:"id" json:"id"`
 	ThreadID      string         `db:"thread_id" json:"thread_id"`
@@ -266,6 +277,21 @@ type ProfileCustomDomain struct {
 	ExpiredAt          sql.NullTime   `db:"expired_at" json:"expired_at"`
 	WebserverSynced    bool           `db:"webserver_synced" json:"webserver_synced"`
 	WwwPrefix          bool           `db:"www_prefix" json:"www_prefix"`
+	VerificationToken  string         `db:"verification_token" json:"verification_token"`
+}
+
+type ProfileHandleReservation struct {
+	ID                 string         `db:"id" json:"id"`
+	Slug               string         `db:"slug" json:"slug"`
+	VerificationEmail  sql.NullString `db:"verification_email" json:"verification_email"`
+	VerificationDomain sql.NullString `db:"verification_domain" json:"verification_domain"`
+	Note               sql.NullString `db:"note" json:"note"`
+	ReservedByUserID   string         `db:"reserved_by_user_id" json:"reserved_by_user_id"`
+	ExpiresAt          time.Time      `db:"expires_at" json:"expires_at"`
+	ClaimedAt          sql.NullTime   `db:"claimed_at" json:"claimed_at"`
+	ClaimedByProfileID sql.NullString `db:"claimed_by_profile_id" json:"claimed_by_profile_id"`
+	ReleasedAt         sql.NullTime   `db:"released_at" json:"released_at"`
+	CreatedAt          time.Time      `db:"created_at" json:"created_at"`
 }
 
 type ProfileLink struct {
@@ -292,6 +318,16 @@ type ProfileLink struct {
 	IsFeatured                bool                  `db:"is_featured" json:"is_featured"`
 	AddedByProfileID          sql.NullString        `db:"added_by_profile_id" json:"added_by_profile_id"`
 	IsOnline                  bool                  `db:"is_online" json:"is_online"`
+	SyncStatus                sql.NullString        `db:"sync_status" json:"sync_status"`
+	SyncError                 sql.NullString        `db:"sync_error" json:"sync_error"`
+	SyncAttemptedAt           sql.NullTime          `db:"sync_attempted_at" json:"sync_attempted_at"`
+}
+
+type ProfileLinkClick struct {
+	ID            string         `db:"id" json:"id"`
+	ProfileLinkID string         `db:"profile_link_id" json:"profile_link_id"`
+	VisitorUserID sql.NullString `db:"visitor_user_id" json:"visitor_user_id"`
+	CreatedAt     time.Time      `db:"created_at" json:"created_at"`
 }
 
 type ProfileLinkImport struct {
@@ -314,14 +350,15 @@ type ProfileLinkTx struct {
 }
 
 type ProfileMembership struct {
-	ID              string                `db:"id" json:"id"`
-	ProfileID       string                `db:"profile_id" json:"profile_id"`
-	MemberProfileID sql.NullString        `db:"member_profile_id" json:"member_profile_id"`
-	Kind            string                `db:"kind" json:"kind"`
-	Properties      pqtype.NullRawMessage `db:"properties" json:"properties"`
-	StartedAt       sql.NullTime          `db:"started_at" json:"started_at"`
-	FinishedAt      sql.NullTime          `db:"finished_at" json:"finished_at"`
-	DeletedAt       sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	ID               string                `db:"id" json:"id"`
+	ProfileID        string                `db:"profile_id" json:"profile_id"`
+	MemberProfileID  sql.NullString        `db:"member_profile_id" json:"member_profile_id"`
+	Kind             string                `db:"kind" json:"kind"`
+	Properties       pqtype.NullRawMessage `db:"properties" json:"properties"`
+	StartedAt        sql.NullTime          `db:"started_at" json:"started_at"`
+	FinishedAt       sql.NullTime          `db:"finished_at" json:"finished_at"`
+	DeletedAt        sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	AggregateStories bool                  `db:"aggregate_stories" json:"aggregate_stories"`
 }
 
 type ProfileMembershipCandidate struct {
@@ -336,6 +373,7 @@ type ProfileMembershipCandidate struct {
 	DeletedAt            sql.NullTime   `db:"deleted_at" json:"deleted_at"`
 	Source               string         `db:"source" json:"source"`
 	ApplicantMessage     sql.NullString `db:"applicant_message" json:"applicant_message"`
+	VotingDeadline       sql.NullTime   `db:"voting_deadline" json:"voting_deadline"`
 }
 
 type ProfileMembershipCandidateTeam struct {
@@ -356,6 +394,18 @@ type ProfileMembershipCandidateVote struct {
 	UpdatedAt         sql.NullTime   `db:"updated_at" json:"updated_at"`
 }
 
+type ProfileMembershipInvitation struct {
+	ID               string       `db:"id" json:"id"`
+	ProfileID        string       `db:"profile_id" json:"profile_id"`
+	InviteeProfileID string       `db:"invitee_profile_id" json:"invitee_profile_id"`
+	Kind             string       `db:"kind" json:"kind"`
+	Status           string       `db:"status" json:"status"`
+	InvitedByUserID  string       `db:"invited_by_user_id" json:"invited_by_user_id"`
+	ExpiresAt        time.Time    `db:"expires_at" json:"expires_at"`
+	RespondedAt      sql.NullTime `db:"responded_at" json:"responded_at"`
+	CreatedAt        time.Time    `db:"created_at" json:"created_at"`
+}
+
 type ProfileMembershipTeam struct {
 	ID                  string       `db:"id" json:"id"`
 	ProfileMembershipID string       `db:"profile_membership_id" json:"profile_membership_id"`
@@ -364,6 +414,16 @@ type ProfileMembershipTeam struct {
 	DeletedAt           sql.NullTime `db:"deleted_at" json:"deleted_at"`
 }
 
+type ProfileMention struct {
+	ID                 string    `db:"id" json:"id"`
+	SourceKind         string    `db:"source_kind" json:"source_kind"`
+	SourceID           string    `db:"source_id" json:"source_id"`
+	SourceProfileID    string    `db:"source_profile_id" json:"source_profile_id"`
+	MentionedProfileID string    `db:"mentioned_profile_id" json:"mentioned_profile_id"`
+	LocaleCode         string    `db:"locale_code" json:"locale_code"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+}
+
 type ProfilePage struct {
 	ID               string         `db:"id" json:"id"`
 	ProfileID        string         `db:"profile_id" json:"profile_id"`
@@ -378,6 +438,23 @@ type ProfilePage struct {
 	Visibility       string         `db:"visibility" json:"visibility"`
 }
 
+type ProfilePageTranslationJob struct {
+	ID           string    `db:"id" json:"id"`
+	PageID       string    `db:"page_id" json:"page_id"`
+	UserID       string    `db:"user_id" json:"user_id"`
+	SourceLocale string    `db:"source_locale" json:"source_locale"`
+	Total        int32     `db:"total" json:"total"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+}
+
+type ProfilePageTranslationJobResult struct {
+	JobID      string         `db:"job_id" json:"job_id"`
+	LocaleCode string         `db:"locale_code" json:"locale_code"`
+	Status     string         `db:"status" json:"status"`
+	Reason     sql.NullString `db:"reason" json:"reason"`
+	CreatedAt  time.Time      `db:"created_at" json:"created_at"`
+}
+
 type ProfilePageTx struct {
 	ProfilePageID string      `db:"profile_page_id" json:"profile_page_id"`
 	LocaleCode    string      `db:"locale_code" json:"locale_code"`
@@ -411,6 +488,7 @@ type ProfilePointTransaction struct {
 	Amount          int32          `db:"amount" json:"amount"`
 	BalanceAfter    int32          `db:"balance_after" json:"balance_after"`
 	CreatedAt       time.Time      `db:"created_at" json:"created_at"`
+	AwardKey        sql.NullString `db:"award_key" json:"award_key"`
 }
 
 type ProfileQuestion struct {
@@ -429,6 +507,7 @@ type ProfileQuestion struct {
 	CreatedAt     time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt     sql.NullTime   `db:"updated_at" json:"updated_at"`
 	DeletedAt     sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	ResolvedAt    sql.NullTime   `db:"resolved_at" json:"resolved_at"`
 }
 
 type ProfileQuestionVote struct {
@@ -439,6 +518,21 @@ type ProfileQuestionVote struct {
 	CreatedAt  time.Time `db:"created_at" json:"created_at"`
 }
 
+type ProfileReport struct {
+	ID               string         `db:"id" json:"id"`
+	ProfileID        string         `db:"profile_id" json:"profile_id"`
+	TargetKind       string         `db:"target_kind" json:"target_kind"`
+	TargetID         string         `db:"target_id" json:"target_id"`
+	ReporterUserID   sql.NullString `db:"reporter_user_id" json:"reporter_user_id"`
+	ReporterKey      string         `db:"reporter_key" json:"reporter_key"`
+	Reason           string         `db:"reason" json:"reason"`
+	Text             sql.NullString `db:"text" json:"text"`
+	Status           string         `db:"status" json:"status"`
+	ResolvedByUserID sql.NullString `db:"resolved_by_user_id" json:"resolved_by_user_id"`
+	ResolvedAt       sql.NullTime   `db:"resolved_at" json:"resolved_at"`
+	CreatedAt        time.Time      `db:"created_at" json:"created_at"`
+}
+
 type ProfileResource struct {
 	ID               string                `db:"id" json:"id"`
 	ProfileID        string                `db:"profile_id" json:"profile_id"`
@@ -454,6 +548,8 @@ type ProfileResource struct {
 	CreatedAt        time.Time             `db:"created_at" json:"created_at"`
 	UpdatedAt        sql.NullTime          `db:"updated_at" json:"updated_at"`
 	DeletedAt        sql.NullTime          `db:"deleted_at" json:"deleted_at"`
+	Order            int32                 `db:"order" json:"order"`
+	IsFeatured       bool                  `db:"is_featured" json:"is_featured"`
 }
 
 type ProfileResourceTeam struct {
@@ -464,13 +560,21 @@ type ProfileResourceTeam struct {
 	DeletedAt         sql.NullTime `db:"deleted_at" json:"deleted_at"`
 }
 
+type ProfileSlugHistory struct {
+	ID        string    `db:"id" json:"id"`
+	ProfileID string    `db:"profile_id" json:"profile_id"`
+	Slug      string    `db:"slug" json:"slug"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
 type ProfileTeam struct {
-	ID          string         `db:"id" json:"id"`
-	ProfileID   string         `db:"profile_id" json:"profile_id"`
-	Name        string         `db:"name" json:"name"`
-	Description sql.NullString `db:"description" json:"description"`
-	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
-	DeletedAt   sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	ID               string         `db:"id" json:"id"`
+	ProfileID        string         `db:"profile_id" json:"profile_id"`
+	Name             string         `db:"name" json:"name"`
+	Description      sql.NullString `db:"description" json:"description"`
+	CreatedAt        time.Time      `db:"created_at" json:"created_at"`
+	DeletedAt        sql.NullTime   `db:"deleted_at" json:"deleted_at"`
+	LeadMembershipID sql.NullString `db:"lead_membership_id" json:"lead_membership_id"`
 }
 
 type ProfileTx struct {
@@ -482,6 +586,15 @@ type ProfileTx struct {
 	SearchVector any           `db:"search_vector" json:"search_vector"`
 }
 
+type ProfileWebhookEndpoint struct {
+	ID        string       `db:"id" json:"id"`
+	ProfileID string       `db:"profile_id" json:"profile_id"`
+	TargetURL string       `db:"target_url" json:"target_url"`
+	Secret    string       `db:"secret" json:"secret"`
+	CreatedAt time.Time    `db:"created_at" json:"created_at"`
+	DeletedAt sql.NullTime `db:"deleted_at" json:"deleted_at"`
+}
+
 type ProtectionPowChallenge struct {
 	ID         string    `db:"id" json:"id"`
 	Prefix     string    `db:"prefix" json:"prefix"`
@@ -538,6 +651,8 @@ type Session struct {
 	OauthProvider            sql.NullString `db:"oauth_provider" json:"oauth_provider"`
 	OauthAccessToken         sql.NullString `db:"oauth_access_token" json:"oauth_access_token"`
 	OauthTokenScope          sql.NullString `db:"oauth_token_scope" json:"oauth_token_scope"`
+	ImpersonatorUserID       sql.NullString `db:"impersonator_user_id" json:"impersonator_user_id"`
+	ImpersonatorSessionID    sql.NullString `db:"impersonator_session_id" json:"impersonator_session_id"`
 }
 
 type SessionPreference struct {
@@ -645,6 +760,12 @@ type StoryTx struct {
 	SummaryAi    sql.NullString `db:"summary_ai" json:"summary_ai"`
 }
 
+type StoryViewStat struct {
+	StoryID   string    `db:"story_id" json:"story_id"`
+	ViewCount int64     `db:"view_count" json:"view_count"`
+	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
 type User struct {
 	ID                  string         `db:"id" json:"id"`
 	Kind                string         `db:"kind" json:"kind"`
@@ -664,3 +785,50 @@ type User struct {
 	AppleRemoteID       sql.NullString `db:"apple_remote_id" json:"apple_remote_id"`
 	ProfilePictureURI   sql.NullString `db:"profile_picture_uri" json:"profile_picture_uri"`
 }
+
+type UserProfileBlock struct {
+	UserID    string    `db:"user_id" json:"user_id"`
+	ProfileID string    `db:"profile_id" json:"profile_id"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+type UserRecentProfileView struct {
+	UserID    string    `db:"user_id" json:"user_id"`
+	ProfileID string    `db:"profile_id" json:"profile_id"`
+	ViewedAt  time.Time `db:"viewed_at" json:"viewed_at"`
+}
+
+type WebhookDelivery struct {
+	ID               string                `db:"id" json:"id"`
+	Source           string                `db:"source" json:"source"`
+	EventType        string                `db:"event_type" json:"event_type"`
+	TargetURL        string                `db:"target_url" json:"target_url"`
+	Payload          pqtype.NullRawMessage `db:"payload" json:"payload"`
+	Status           string                `db:"status" json:"status"`
+	AttemptCount     int32                 `db:"attempt_count" json:"attempt_count"`
+	LastResponseCode sql.NullInt32         `db:"last_response_code" json:"last_response_code"`
+	LastAttemptedAt  sql.NullTime          `db:"last_attempted_at" json:"last_attempted_at"`
+	CreatedAt        time.Time             `db:"created_at" json:"created_at"`
+	EndpointID       sql.NullString        `db:"endpoint_id" json:"endpoint_id"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID           string         `db:"id" json:"id"`
+	DeliveryID   string         `db:"delivery_id" json:"delivery_id"`
+	Status       string         `db:"status" json:"status"`
+	ResponseCode sql.NullInt32  `db:"response_code" json:"response_code"`
+	ErrorMessage sql.NullString `db:"error_message" json:"error_message"`
+	DurationMs   int64          `db:"duration_ms" json:"duration_ms"`
+	AttemptedAt  time.Time      `db:"attempted_at" json:"attempted_at"`
+}
+
+type Webmention struct {
+	ID         string       `db:"id" json:"id"`
+	ProfileID  string       `db:"profile_id" json:"profile_id"`
+	SourceURL  string       `db:"source_url" json:"source_url"`
+	TargetURL  string       `db:"target_url" json:"target_url"`
+	Status     string       `db:"status" json:"status"`
+	VerifiedAt sql.NullTime `db:"verified_at" json:"verified_at"`
+	CreatedAt  time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt  sql.NullTime `db:"updated_at" json:"updated_at"`
+}
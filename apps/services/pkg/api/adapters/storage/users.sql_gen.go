@@ -135,6 +135,30 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 	return err
 }
 
+const getProfileKindForIndividualLink = `-- name: GetProfileKindForIndividualLink :one
+SELECT kind
+FROM "profile"
+WHERE id = $1
+  AND deleted_at IS NULL
+`
+
+type GetProfileKindForIndividualLinkParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetProfileKindForIndividualLink
+//
+//	SELECT kind
+//	FROM "profile"
+//	WHERE id = $1
+//	  AND deleted_at IS NULL
+func (q *Queries) GetProfileKindForIndividualLink(ctx context.Context, arg GetProfileKindForIndividualLinkParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getProfileKindForIndividualLink, arg.ID)
+	var kind string
+	err := row.Scan(&kind)
+	return kind, err
+}
+
 const getUserByAppleRemoteID = `-- name: GetUserByAppleRemoteID :one
 SELECT id, kind, name, email, phone, github_handle, github_remote_id, bsky_handle, bsky_remote_id, x_handle, x_remote_id, individual_profile_id, created_at, updated_at, deleted_at, apple_remote_id, profile_picture_uri
 FROM "user"
@@ -311,6 +335,32 @@ func (q *Queries) GetUserByID(ctx context.Context, arg GetUserByIDParams) (*User
 	return &i, err
 }
 
+const getUserIDByIndividualProfileID = `-- name: GetUserIDByIndividualProfileID :one
+SELECT id
+FROM "user"
+WHERE individual_profile_id = $1
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+type GetUserIDByIndividualProfileIDParams struct {
+	IndividualProfileID sql.NullString `db:"individual_profile_id" json:"individual_profile_id"`
+}
+
+// GetUserIDByIndividualProfileID
+//
+//	SELECT id
+//	FROM "user"
+//	WHERE individual_profile_id = $1
+//	  AND deleted_at IS NULL
+//	LIMIT 1
+func (q *Queries) GetUserIDByIndividualProfileID(ctx context.Context, arg GetUserIDByIndividualProfileIDParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getUserIDByIndividualProfileID, arg.IndividualProfileID)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
 const listUsers = `-- name: ListUsers :many
 SELECT id, kind, name, email, phone, github_handle, github_remote_id, bsky_handle, bsky_remote_id, x_handle, x_remote_id, individual_profile_id, created_at, updated_at, deleted_at, apple_remote_id, profile_picture_uri
 FROM "user"
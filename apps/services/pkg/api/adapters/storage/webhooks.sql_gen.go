@@ -0,0 +1,472 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.29.0
+// source: webhooks.sql
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+)
+
+const createProfileWebhookEndpoint = `-- name: CreateProfileWebhookEndpoint :exec
+INSERT INTO "profile_webhook_endpoint" (id, profile_id, target_url, secret, created_at)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+`
+
+type CreateProfileWebhookEndpointParams struct {
+	ID        string    `db:"id" json:"id"`
+	ProfileID string    `db:"profile_id" json:"profile_id"`
+	TargetURL string    `db:"target_url" json:"target_url"`
+	Secret    string    `db:"secret" json:"secret"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// CreateProfileWebhookEndpoint
+//
+//	INSERT INTO "profile_webhook_endpoint" (id, profile_id, target_url, secret, created_at)
+//	VALUES (
+//	  $1,
+//	  $2,
+//	  $3,
+//	  $4,
+//	  $5
+//	)
+func (q *Queries) CreateProfileWebhookEndpoint(ctx context.Context, arg CreateProfileWebhookEndpointParams) error {
+	_, err := q.db.ExecContext(ctx, createProfileWebhookEndpoint,
+		arg.ID,
+		arg.ProfileID,
+		arg.TargetURL,
+		arg.Secret,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWebhookDelivery = `-- name: CreateWebhookDelivery :exec
+INSERT INTO "webhook_delivery" (id, source, endpoint_id, event_type, target_url, payload, status, created_at)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8
+)
+`
+
+type CreateWebhookDeliveryParams struct {
+	ID         string                `db:"id" json:"id"`
+	Source     string                `db:"source" json:"source"`
+	EndpointID sql.NullString        `db:"endpoint_id" json:"endpoint_id"`
+	EventType  string                `db:"event_type" json:"event_type"`
+	TargetURL  string                `db:"target_url" json:"target_url"`
+	Payload    pqtype.NullRawMessage `db:"payload" json:"payload"`
+	Status     string                `db:"status" json:"status"`
+	CreatedAt  time.Time             `db:"created_at" json:"created_at"`
+}
+
+// CreateWebhookDelivery
+//
+//	INSERT INTO "webhook_delivery" (id, source, endpoint_id, event_type, target_url, payload, status, created_at)
+//	VALUES (
+//	  $1,
+//	  $2,
+//	  $3,
+//	  $4,
+//	  $5,
+//	  $6,
+//	  $7,
+//	  $8
+//	)
+func (q *Queries) CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookDelivery,
+		arg.ID,
+		arg.Source,
+		arg.EndpointID,
+		arg.EventType,
+		arg.TargetURL,
+		arg.Payload,
+		arg.Status,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWebhookDeliveryAttempt = `-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO "webhook_delivery_attempt" (id, delivery_id, status, response_code, error_message, duration_ms, attempted_at)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+`
+
+type CreateWebhookDeliveryAttemptParams struct {
+	ID           string         `db:"id" json:"id"`
+	DeliveryID   string         `db:"delivery_id" json:"delivery_id"`
+	Status       string         `db:"status" json:"status"`
+	ResponseCode sql.NullInt32  `db:"response_code" json:"response_code"`
+	ErrorMessage sql.NullString `db:"error_message" json:"error_message"`
+	DurationMs   int64          `db:"duration_ms" json:"duration_ms"`
+	AttemptedAt  time.Time      `db:"attempted_at" json:"attempted_at"`
+}
+
+// CreateWebhookDeliveryAttempt
+//
+//	INSERT INTO "webhook_delivery_attempt" (id, delivery_id, status, response_code, error_message, duration_ms, attempted_at)
+//	VALUES (
+//	  $1,
+//	  $2,
+//	  $3,
+//	  $4,
+//	  $5,
+//	  $6,
+//	  $7
+//	)
+func (q *Queries) CreateWebhookDeliveryAttempt(ctx context.Context, arg CreateWebhookDeliveryAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, createWebhookDeliveryAttempt,
+		arg.ID,
+		arg.DeliveryID,
+		arg.Status,
+		arg.ResponseCode,
+		arg.ErrorMessage,
+		arg.DurationMs,
+		arg.AttemptedAt,
+	)
+	return err
+}
+
+const deleteProfileWebhookEndpoint = `-- name: DeleteProfileWebhookEndpoint :execrows
+UPDATE "profile_webhook_endpoint"
+SET deleted_at = NOW()
+WHERE id = $1
+  AND profile_id = $2
+  AND deleted_at IS NULL
+`
+
+type DeleteProfileWebhookEndpointParams struct {
+	ID        string `db:"id" json:"id"`
+	ProfileID string `db:"profile_id" json:"profile_id"`
+}
+
+// DeleteProfileWebhookEndpoint
+//
+//	UPDATE "profile_webhook_endpoint"
+//	SET deleted_at = NOW()
+//	WHERE id = $1
+//	  AND profile_id = $2
+//	  AND deleted_at IS NULL
+func (q *Queries) DeleteProfileWebhookEndpoint(ctx context.Context, arg DeleteProfileWebhookEndpointParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProfileWebhookEndpoint, arg.ID, arg.ProfileID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteWebhookDeliveriesCreatedBefore = `-- name: DeleteWebhookDeliveriesCreatedBefore :execrows
+DELETE FROM "webhook_delivery"
+WHERE created_at < $1
+`
+
+type DeleteWebhookDeliveriesCreatedBeforeParams struct {
+	Cutoff time.Time `db:"cutoff" json:"cutoff"`
+}
+
+// DeleteWebhookDeliveriesCreatedBefore
+//
+//	DELETE FROM "webhook_delivery"
+//	WHERE created_at < $1
+func (q *Queries) DeleteWebhookDeliveriesCreatedBefore(ctx context.Context, arg DeleteWebhookDeliveriesCreatedBeforeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteWebhookDeliveriesCreatedBefore, arg.Cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getProfileWebhookEndpointSecret = `-- name: GetProfileWebhookEndpointSecret :one
+SELECT secret
+FROM "profile_webhook_endpoint"
+WHERE id = $1
+  AND deleted_at IS NULL
+LIMIT 1
+`
+
+type GetProfileWebhookEndpointSecretParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetProfileWebhookEndpointSecret
+//
+//	SELECT secret
+//	FROM "profile_webhook_endpoint"
+//	WHERE id = $1
+//	  AND deleted_at IS NULL
+//	LIMIT 1
+func (q *Queries) GetProfileWebhookEndpointSecret(ctx context.Context, arg GetProfileWebhookEndpointSecretParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, getProfileWebhookEndpointSecret, arg.ID)
+	var secret string
+	err := row.Scan(&secret)
+	return secret, err
+}
+
+const getWebhookDeliveryByID = `-- name: GetWebhookDeliveryByID :one
+SELECT id, source, event_type, target_url, payload, status, attempt_count, last_response_code, last_attempted_at, created_at, endpoint_id
+FROM "webhook_delivery"
+WHERE id = $1
+LIMIT 1
+`
+
+type GetWebhookDeliveryByIDParams struct {
+	ID string `db:"id" json:"id"`
+}
+
+// GetWebhookDeliveryByID
+//
+//	SELECT id, source, event_type, target_url, payload, status, attempt_count, last_response_code, last_attempted_at, created_at, endpoint_id
+//	FROM "webhook_delivery"
+//	WHERE id = $1
+//	LIMIT 1
+func (q *Queries) GetWebhookDeliveryByID(ctx context.Context, arg GetWebhookDeliveryByIDParams) (*WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDeliveryByID, arg.ID)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.Source,
+		&i.EventType,
+		&i.TargetURL,
+		&i.Payload,
+		&i.Status,
+		&i.AttemptCount,
+		&i.LastResponseCode,
+		&i.LastAttemptedAt,
+		&i.CreatedAt,
+		&i.EndpointID,
+	)
+	return &i, err
+}
+
+const listProfileWebhookEndpoints = `-- name: ListProfileWebhookEndpoints :many
+SELECT id, profile_id, target_url, created_at
+FROM "profile_webhook_endpoint"
+WHERE profile_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at, id
+`
+
+type ListProfileWebhookEndpointsParams struct {
+	ProfileID string `db:"profile_id" json:"profile_id"`
+}
+
+type ListProfileWebhookEndpointsRow struct {
+	ID        string    `db:"id" json:"id"`
+	ProfileID string    `db:"profile_id" json:"profile_id"`
+	TargetURL string    `db:"target_url" json:"target_url"`
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// ListProfileWebhookEndpoints
+//
+//	SELECT id, profile_id, target_url, created_at
+//	FROM "profile_webhook_endpoint"
+//	WHERE profile_id = $1
+//	  AND deleted_at IS NULL
+//	ORDER BY created_at, id
+func (q *Queries) ListProfileWebhookEndpoints(ctx context.Context, arg ListProfileWebhookEndpointsParams) ([]*ListProfileWebhookEndpointsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProfileWebhookEndpoints, arg.ProfileID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ListProfileWebhookEndpointsRow{}
+	for rows.Next() {
+		var i ListProfileWebhookEndpointsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.TargetURL,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, source, event_type, target_url, payload, status, attempt_count, last_response_code, last_attempted_at, created_at, endpoint_id
+FROM "webhook_delivery"
+WHERE ($1::TEXT IS NULL OR source = $1::TEXT)
+  AND ($2::TEXT IS NULL OR status = $2::TEXT)
+  AND ($3::TEXT IS NULL OR id < $3::TEXT)
+ORDER BY id DESC
+LIMIT $4
+`
+
+type ListWebhookDeliveriesParams struct {
+	FilterSource sql.NullString `db:"filter_source" json:"filter_source"`
+	FilterStatus sql.NullString `db:"filter_status" json:"filter_status"`
+	BeforeID     sql.NullString `db:"before_id" json:"before_id"`
+	LimitCount   int32          `db:"limit_count" json:"limit_count"`
+}
+
+// ListWebhookDeliveries
+//
+//	SELECT id, source, event_type, target_url, payload, status, attempt_count, last_response_code, last_attempted_at, created_at, endpoint_id
+//	FROM "webhook_delivery"
+//	WHERE ($1::TEXT IS NULL OR source = $1::TEXT)
+//	  AND ($2::TEXT IS NULL OR status = $2::TEXT)
+//	  AND ($3::TEXT IS NULL OR id < $3::TEXT)
+//	ORDER BY id DESC
+//	LIMIT $4
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]*WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveries,
+		arg.FilterSource,
+		arg.FilterStatus,
+		arg.BeforeID,
+		arg.LimitCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*WebhookDelivery{}
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.Source,
+			&i.EventType,
+			&i.TargetURL,
+			&i.Payload,
+			&i.Status,
+			&i.AttemptCount,
+			&i.LastResponseCode,
+			&i.LastAttemptedAt,
+			&i.CreatedAt,
+			&i.EndpointID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWebhookDeliveryAttempts = `-- name: ListWebhookDeliveryAttempts :many
+SELECT id, delivery_id, status, response_code, error_message, duration_ms, attempted_at
+FROM "webhook_delivery_attempt"
+WHERE delivery_id = $1
+ORDER BY attempted_at DESC, id DESC
+`
+
+type ListWebhookDeliveryAttemptsParams struct {
+	DeliveryID string `db:"delivery_id" json:"delivery_id"`
+}
+
+// ListWebhookDeliveryAttempts
+//
+//	SELECT id, delivery_id, status, response_code, error_message, duration_ms, attempted_at
+//	FROM "webhook_delivery_attempt"
+//	WHERE delivery_id = $1
+//	ORDER BY attempted_at DESC, id DESC
+func (q *Queries) ListWebhookDeliveryAttempts(ctx context.Context, arg ListWebhookDeliveryAttemptsParams) ([]*WebhookDeliveryAttempt, error) {
+	rows, err := q.db.QueryContext(ctx, listWebhookDeliveryAttempts, arg.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*WebhookDeliveryAttempt{}
+	for rows.Next() {
+		var i WebhookDeliveryAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeliveryID,
+			&i.Status,
+			&i.ResponseCode,
+			&i.ErrorMessage,
+			&i.DurationMs,
+			&i.AttemptedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateWebhookDeliveryAfterAttempt = `-- name: UpdateWebhookDeliveryAfterAttempt :execrows
+UPDATE "webhook_delivery"
+SET
+  status = $1,
+  attempt_count = attempt_count + 1,
+  last_response_code = $2,
+  last_attempted_at = $3
+WHERE id = $4
+`
+
+type UpdateWebhookDeliveryAfterAttemptParams struct {
+	Status           string        `db:"status" json:"status"`
+	LastResponseCode sql.NullInt32 `db:"last_response_code" json:"last_response_code"`
+	LastAttemptedAt  sql.NullTime  `db:"last_attempted_at" json:"last_attempted_at"`
+	ID               string        `db:"id" json:"id"`
+}
+
+// UpdateWebhookDeliveryAfterAttempt
+//
+//	UPDATE "webhook_delivery"
+//	SET
+//	  status = $1,
+//	  attempt_count = attempt_count + 1,
+//	  last_response_code = $2,
+//	  last_attempted_at = $3
+//	WHERE id = $4
+func (q *Queries) UpdateWebhookDeliveryAfterAttempt(ctx context.Context, arg UpdateWebhookDeliveryAfterAttemptParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateWebhookDeliveryAfterAttempt,
+		arg.Status,
+		arg.LastResponseCode,
+		arg.LastAttemptedAt,
+		arg.ID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 )
@@ -28,6 +29,13 @@ type HTTPClient interface {
 // Config holds Unsplash API configuration.
 type Config struct {
 	AccessKey string `conf:"access_key"`
+
+	// Search proxy settings: per-user request budget and the lifetime and size
+	// of the cache of popular queries.
+	SearchRateLimit       int           `conf:"search_rate_limit"        default:"30"`
+	SearchRateWindow      time.Duration `conf:"search_rate_window"       default:"1m"`
+	SearchCacheTTL        time.Duration `conf:"search_cache_ttl"         default:"1h"`
+	SearchCacheMaxEntries int           `conf:"search_cache_max_entries" default:"500"`
 }
 
 // IsConfigured returns true if the Unsplash API is configured.
@@ -44,23 +52,36 @@ type PhotoURLs struct {
 	Thumb   string `json:"thumb"`
 }
 
+// PhotoLinks contains the Unsplash page and download tracking links of a photo.
+type PhotoLinks struct {
+	HTML             string `json:"html"`
+	DownloadLocation string `json:"download_location"`
+}
+
+// PhotoUserLinks contains the Unsplash profile link of a photographer.
+type PhotoUserLinks struct {
+	HTML string `json:"html"`
+}
+
 // PhotoUser contains photographer information.
 type PhotoUser struct {
-	ID       string `json:"id"`
-	Username string `json:"username"`
-	Name     string `json:"name"`
+	Links    PhotoUserLinks `json:"links"`
+	ID       string         `json:"id"`
+	Username string         `json:"username"`
+	Name     string         `json:"name"`
 }
 
 // Photo represents an Unsplash photo.
 type Photo struct {
-	URLs        PhotoURLs `json:"urls"`
-	User        PhotoUser `json:"user"`
-	ID          string    `json:"id"`
-	Description string    `json:"description"`
-	AltDesc     string    `json:"alt_description"`
-	Color       string    `json:"color"`
-	Width       int       `json:"width"`
-	Height      int       `json:"height"`
+	URLs        PhotoURLs  `json:"urls"`
+	Links       PhotoLinks `json:"links"`
+	User        PhotoUser  `json:"user"`
+	ID          string     `json:"id"`
+	Description string     `json:"description"`
+	AltDesc     string     `json:"alt_description"`
+	Color       string     `json:"color"`
+	Width       int        `json:"width"`
+	Height      int        `json:"height"`
 }
 
 // SearchResult represents the search API response.
@@ -112,6 +133,7 @@ func (c *Client) SearchPhotos( //nolint:funlen
 	queryParams.Set("page", strconv.Itoa(page))
 	queryParams.Set("per_page", strconv.Itoa(perPage))
 	queryParams.Set("orientation", "landscape") // Better for cover images
+	queryParams.Set("content_filter", "high")   // Safe search
 
 	apiURL := url.URL{ //nolint:exhaustruct
 		Scheme:   "https",
@@ -0,0 +1,278 @@
+package unsplash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Sentinel errors.
+var (
+	ErrSearchRateLimited   = errors.New("unsplash search rate limit exceeded")
+	ErrInvalidSearchQuery  = errors.New("invalid unsplash search query")
+	ErrInvalidSearchPaging = errors.New("invalid unsplash search paging")
+)
+
+const (
+	searchQueryMaxLength = 100
+	searchMaxPerPage     = 30
+	searchMaxPage        = 50
+
+	// attributionUTM is appended to Unsplash links as required by the API guidelines.
+	attributionUTM = "utm_source=aya&utm_medium=referral"
+)
+
+// SearchPhotoURLs holds the image sizes clients use for covers and previews.
+type SearchPhotoURLs struct {
+	Regular string `json:"regular"`
+	Small   string `json:"small"`
+	Thumb   string `json:"thumb"`
+}
+
+// Attribution holds what is needed to credit a photo and report its use to Unsplash.
+type Attribution struct {
+	PhotographerName     string `json:"photographer_name"`
+	PhotographerUsername string `json:"photographer_username"`
+	PhotographerURL      string `json:"photographer_url"`
+	PhotoURL             string `json:"photo_url"`
+	DownloadLocation     string `json:"download_location"`
+}
+
+// SearchPhoto is the trimmed-down photo returned by the search proxy.
+type SearchPhoto struct {
+	URLs        SearchPhotoURLs `json:"urls"`
+	Attribution Attribution     `json:"attribution"`
+	ID          string          `json:"id"`
+	Description string          `json:"description"`
+	Color       string          `json:"color"`
+	Width       int             `json:"width"`
+	Height      int             `json:"height"`
+}
+
+// SearchPage is a page of search proxy results.
+type SearchPage struct {
+	Results    []SearchPhoto `json:"results"`
+	Total      int           `json:"total"`
+	TotalPages int           `json:"total_pages"`
+}
+
+type searchCacheEntry struct {
+	expiresAt time.Time
+	page      *SearchPage
+}
+
+// SearchProxy fronts the Unsplash search API for signed-in users. It limits how
+// often each user may search, caches results per normalized query so popular
+// searches don't spend API quota, and strips responses down to the fields and
+// attribution data clients need.
+type SearchProxy struct {
+	client   *Client
+	cache    map[string]searchCacheEntry
+	requests map[string][]time.Time
+	mu       sync.Mutex
+}
+
+// NewSearchProxy creates a new search proxy over the given client.
+func NewSearchProxy(client *Client) *SearchProxy {
+	return &SearchProxy{ //nolint:exhaustruct // mu zero value is valid
+		client:   client,
+		cache:    make(map[string]searchCacheEntry),
+		requests: make(map[string][]time.Time),
+	}
+}
+
+// NormalizeSearchQuery lowercases the query and collapses its whitespace so that
+// equivalent searches share a cache entry.
+func NormalizeSearchQuery(query string) string {
+	return strings.ToLower(strings.Join(strings.Fields(query), " "))
+}
+
+// Search returns a page of photos for the query on behalf of the user.
+// Cached pages are served without counting against the user's rate limit.
+func (p *SearchProxy) Search(
+	ctx context.Context,
+	userID string,
+	query string,
+	page int,
+	perPage int,
+) (*SearchPage, error) {
+	normalized := NormalizeSearchQuery(query)
+	if normalized == "" || utf8.RuneCountInString(normalized) > searchQueryMaxLength {
+		return nil, fmt.Errorf(
+			"%w: query must be 1-%d characters",
+			ErrInvalidSearchQuery,
+			searchQueryMaxLength,
+		)
+	}
+
+	if page < 1 || page > searchMaxPage || perPage < 1 || perPage > searchMaxPerPage {
+		return nil, fmt.Errorf(
+			"%w: page must be 1-%d and per_page 1-%d",
+			ErrInvalidSearchPaging,
+			searchMaxPage,
+			searchMaxPerPage,
+		)
+	}
+
+	key := fmt.Sprintf("%s|%d|%d", normalized, page, perPage)
+
+	cached, ok := p.cached(key)
+	if ok {
+		return cached, nil
+	}
+
+	if !p.allow(userID) {
+		return nil, ErrSearchRateLimited
+	}
+
+	result, err := p.client.SearchPhotos(ctx, normalized, page, perPage)
+	if err != nil {
+		return nil, err
+	}
+
+	searchPage := toSearchPage(result)
+	p.store(key, searchPage)
+
+	return searchPage, nil
+}
+
+// cached returns the unexpired cached page for the key.
+func (p *SearchProxy) cached(key string) (*SearchPage, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry, ok := p.cache[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !time.Now().Before(entry.expiresAt) {
+		delete(p.cache, key)
+
+		return nil, false
+	}
+
+	return entry.page, true
+}
+
+// store caches the page under the key. When the cache is full, expired entries
+// are dropped first and the whole cache is reset if that is not enough.
+func (p *SearchProxy) store(key string, page *SearchPage) {
+	config := p.client.Config()
+	if config.SearchCacheTTL <= 0 || config.SearchCacheMaxEntries <= 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+
+	if len(p.cache) >= config.SearchCacheMaxEntries {
+		for cachedKey, entry := range p.cache {
+			if !now.Before(entry.expiresAt) {
+				delete(p.cache, cachedKey)
+			}
+		}
+
+		if len(p.cache) >= config.SearchCacheMaxEntries {
+			p.cache = make(map[string]searchCacheEntry)
+		}
+	}
+
+	p.cache[key] = searchCacheEntry{expiresAt: now.Add(config.SearchCacheTTL), page: page}
+}
+
+// allow reports whether the user may make another upstream search, recording
+// the request when allowed.
+func (p *SearchProxy) allow(userID string) bool {
+	config := p.client.Config()
+	if config.SearchRateLimit <= 0 {
+		return true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	recent := make([]time.Time, 0, config.SearchRateLimit)
+
+	for _, requestedAt := range p.requests[userID] {
+		if now.Sub(requestedAt) < config.SearchRateWindow {
+			recent = append(recent, requestedAt)
+		}
+	}
+
+	if len(recent) >= config.SearchRateLimit {
+		p.requests[userID] = recent
+
+		return false
+	}
+
+	p.requests[userID] = append(recent, now)
+
+	return true
+}
+
+func toSearchPage(result *SearchResult) *SearchPage {
+	photos := make([]SearchPhoto, 0, len(result.Results))
+
+	for _, photo := range result.Results {
+		description := photo.AltDesc
+		if description == "" {
+			description = photo.Description
+		}
+
+		photos = append(photos, SearchPhoto{
+			URLs: SearchPhotoURLs{
+				Regular: photo.URLs.Regular,
+				Small:   photo.URLs.Small,
+				Thumb:   photo.URLs.Thumb,
+			},
+			Attribution: Attribution{
+				PhotographerName:     photo.User.Name,
+				PhotographerUsername: photo.User.Username,
+				PhotographerURL:      withAttributionUTM(photo.User.Links.HTML),
+				PhotoURL:             withAttributionUTM(photo.Links.HTML),
+				DownloadLocation:     photo.Links.DownloadLocation,
+			},
+			ID:          photo.ID,
+			Description: description,
+			Color:       photo.Color,
+			Width:       photo.Width,
+			Height:      photo.Height,
+		})
+	}
+
+	return &SearchPage{
+		Results:    photos,
+		Total:      result.Total,
+		TotalPages: result.TotalPages,
+	}
+}
+
+// withAttributionUTM appends the referral parameters Unsplash asks for on links
+// back to its site.
+func withAttributionUTM(link string) string {
+	if link == "" {
+		return ""
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+
+	if parsed.RawQuery == "" {
+		parsed.RawQuery = attributionUTM
+	} else {
+		parsed.RawQuery += "&" + attributionUTM
+	}
+
+	return parsed.String()
+}
@@ -0,0 +1,185 @@
+package unsplash_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/unsplash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const searchResponse = `{
+	"total": 1,
+	"total_pages": 1,
+	"results": [{
+		"id": "photo-1",
+		"description": "A mountain",
+		"alt_description": "snowy mountain at dawn",
+		"color": "#aabbcc",
+		"width": 4000,
+		"height": 3000,
+		"urls": {"raw": "raw", "full": "full", "regular": "regular", "small": "small", "thumb": "thumb"},
+		"links": {
+			"html": "https://unsplash.com/photos/photo-1",
+			"download_location": "https://api.unsplash.com/photos/photo-1/download"
+		},
+		"user": {
+			"id": "user-1",
+			"username": "jane",
+			"name": "Jane Doe",
+			"links": {"html": "https://unsplash.com/@jane"}
+		}
+	}]
+}`
+
+// countingHTTPClient answers every request with a fixed search response and
+// records the requests it receives.
+type countingHTTPClient struct {
+	requests []*http.Request
+	mu       sync.Mutex
+}
+
+func (c *countingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, req)
+	c.mu.Unlock()
+
+	return &http.Response{ //nolint:exhaustruct
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(searchResponse)),
+	}, nil
+}
+
+func (c *countingHTTPClient) count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.requests)
+}
+
+func newSearchProxy(config *unsplash.Config) (*unsplash.SearchProxy, *countingHTTPClient) {
+	httpClient := &countingHTTPClient{} //nolint:exhaustruct
+	config.AccessKey = "test-key"
+
+	client := unsplash.NewClient(config, logfx.NewLogger(), httpClient)
+
+	return unsplash.NewSearchProxy(client), httpClient
+}
+
+func TestSearchProxy_TrimsAndAttributes(t *testing.T) {
+	t.Parallel()
+
+	proxy, httpClient := newSearchProxy(&unsplash.Config{ //nolint:exhaustruct
+		SearchRateLimit:       10,
+		SearchRateWindow:      time.Minute,
+		SearchCacheTTL:        time.Hour,
+		SearchCacheMaxEntries: 10,
+	})
+
+	page, err := proxy.Search(t.Context(), "user-1", "Mountains", 1, 10)
+	require.NoError(t, err)
+	require.Len(t, page.Results, 1)
+
+	photo := page.Results[0]
+	assert.Equal(t, "snowy mountain at dawn", photo.Description)
+	assert.Equal(t, "regular", photo.URLs.Regular)
+	assert.Equal(t, "Jane Doe", photo.Attribution.PhotographerName)
+	assert.Equal(t, "https://unsplash.com/@jane?utm_source=aya&utm_medium=referral", photo.Attribution.PhotographerURL)
+	assert.Equal(t, "https://api.unsplash.com/photos/photo-1/download", photo.Attribution.DownloadLocation)
+
+	require.Equal(t, 1, httpClient.count())
+	assert.Equal(t, "high", httpClient.requests[0].URL.Query().Get("content_filter"))
+}
+
+func TestSearchProxy_CachesNormalizedQueries(t *testing.T) {
+	t.Parallel()
+
+	proxy, httpClient := newSearchProxy(&unsplash.Config{ //nolint:exhaustruct
+		SearchRateLimit:       10,
+		SearchRateWindow:      time.Minute,
+		SearchCacheTTL:        time.Hour,
+		SearchCacheMaxEntries: 10,
+	})
+
+	for _, query := range []string{"Mountains", "  mountains ", "MOUNTAINS"} {
+		_, err := proxy.Search(t.Context(), "user-1", query, 1, 10)
+		require.NoError(t, err)
+	}
+
+	// The same query by another user is served from the cache too
+	_, err := proxy.Search(t.Context(), "user-2", "mountains", 1, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, httpClient.count())
+
+	// A different page is a different cache entry
+	_, err = proxy.Search(t.Context(), "user-1", "mountains", 2, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, httpClient.count())
+}
+
+func TestSearchProxy_RateLimitsPerUser(t *testing.T) {
+	t.Parallel()
+
+	proxy, httpClient := newSearchProxy(&unsplash.Config{ //nolint:exhaustruct
+		SearchRateLimit:       2,
+		SearchRateWindow:      time.Minute,
+		SearchCacheTTL:        time.Hour,
+		SearchCacheMaxEntries: 10,
+	})
+
+	_, err := proxy.Search(t.Context(), "user-1", "one", 1, 10)
+	require.NoError(t, err)
+
+	_, err = proxy.Search(t.Context(), "user-1", "two", 1, 10)
+	require.NoError(t, err)
+
+	_, err = proxy.Search(t.Context(), "user-1", "three", 1, 10)
+	require.ErrorIs(t, err, unsplash.ErrSearchRateLimited)
+
+	// Cached queries don't count against the limit
+	_, err = proxy.Search(t.Context(), "user-1", "one", 1, 10)
+	require.NoError(t, err)
+
+	// Other users have their own budget
+	_, err = proxy.Search(t.Context(), "user-2", "three", 1, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, httpClient.count())
+}
+
+func TestSearchProxy_RejectsInvalidInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query    string
+		page     int
+		perPage  int
+		expected error
+	}{
+		"empty query":      {query: "   ", page: 1, perPage: 10, expected: unsplash.ErrInvalidSearchQuery},
+		"long query":       {query: strings.Repeat("a", 101), page: 1, perPage: 10, expected: unsplash.ErrInvalidSearchQuery},
+		"zero page":        {query: "sea", page: 0, perPage: 10, expected: unsplash.ErrInvalidSearchPaging},
+		"too many results": {query: "sea", page: 1, perPage: 31, expected: unsplash.ErrInvalidSearchPaging},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			proxy, httpClient := newSearchProxy(&unsplash.Config{}) //nolint:exhaustruct
+
+			_, err := proxy.Search(t.Context(), "user-1", tt.query, tt.page, tt.perPage)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Zero(t, httpClient.count())
+		})
+	}
+}
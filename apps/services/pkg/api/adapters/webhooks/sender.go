@@ -0,0 +1,87 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+)
+
+// maxDrainedResponseBytes bounds how much of a receiver's response is read
+// so the connection can be reused.
+const maxDrainedResponseBytes = 64 * 1024
+
+// Sender implements webhooks.Sender over plain HTTP. Retries are left to the
+// caller so that every attempt shows up in the delivery log. The dialer refuses
+// private and reserved addresses, and redirects are not followed, so a receiver
+// can't point a delivery at an internal service.
+type Sender struct {
+	httpClient *http.Client
+}
+
+// NewSender creates a new SSRF-guarded webhook HTTP sender.
+func NewSender(config *webhooks.Config) *Sender {
+	dialer := &net.Dialer{ //nolint:exhaustruct // only Control needed
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			if lib.IsPrivateIP(host) {
+				return fmt.Errorf("%w: %s", lib.ErrSSRFBlocked, host)
+			}
+
+			return nil
+		},
+	}
+
+	transport := &http.Transport{ //nolint:exhaustruct // only DialContext needed
+		DialContext: dialer.DialContext,
+	}
+
+	return &Sender{
+		httpClient: &http.Client{ //nolint:exhaustruct
+			Timeout:   config.RequestTimeout,
+			Transport: transport,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				// The redirect status is recorded as the attempt's response.
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send posts the request body to the request URL.
+func (s *Sender) Send(ctx context.Context, request *webhooks.Request) (int, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		request.URL,
+		bytes.NewReader(request.Body),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("building webhook request: %w", err)
+	}
+
+	for name, value := range request.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("sending webhook request: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedResponseBytes))
+
+	return resp.StatusCode, nil
+}
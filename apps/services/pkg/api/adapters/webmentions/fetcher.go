@@ -0,0 +1,101 @@
+package webmentions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
+)
+
+// maxRedirects bounds how many redirects are followed to reach a source.
+const maxRedirects = 5
+
+var (
+	ErrTooManyRedirects         = errors.New("too many redirects")
+	ErrUnexpectedResponseStatus = errors.New("unexpected response status")
+)
+
+// Fetcher implements webmentions.SourceFetcher over HTTP. Every URL, including
+// each redirect, is checked with lib.ValidateExternalURL, and the dialer refuses
+// private and reserved addresses so a host can't resolve to one between the
+// check and the connection.
+type Fetcher struct {
+	httpClient     *http.Client
+	maxSourceBytes int64
+}
+
+// NewFetcher creates a new SSRF-guarded source fetcher.
+func NewFetcher(config *webmentions.Config) *Fetcher {
+	dialer := &net.Dialer{ //nolint:exhaustruct // only Control needed
+		Control: func(_ string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err //nolint:wrapcheck
+			}
+
+			if lib.IsPrivateIP(host) {
+				return fmt.Errorf("%w: %s", lib.ErrSSRFBlocked, host)
+			}
+
+			return nil
+		},
+	}
+
+	transport := &http.Transport{ //nolint:exhaustruct // only DialContext needed
+		DialContext: dialer.DialContext,
+	}
+
+	return &Fetcher{
+		httpClient: &http.Client{ //nolint:exhaustruct
+			Timeout:   config.FetchTimeout,
+			Transport: transport,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return ErrTooManyRedirects
+				}
+
+				return lib.ValidateExternalURL(req.URL.String(), false) //nolint:wrapcheck
+			},
+		},
+		maxSourceBytes: config.MaxSourceBytes,
+	}
+}
+
+// Fetch returns up to the configured number of bytes of the document at url.
+func (f *Fetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	err := lib.ValidateExternalURL(url, false)
+	if err != nil {
+		return nil, fmt.Errorf("validating webmention source: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building webmention source request: %w", err)
+	}
+
+	req.Header.Set("Accept", "text/html, */*;q=0.5")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching webmention source: %w", err)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%w: %d", ErrUnexpectedResponseStatus, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.maxSourceBytes))
+	if err != nil {
+		return nil, fmt.Errorf("reading webmention source: %w", err)
+	}
+
+	return body, nil
+}
@@ -7,33 +7,45 @@ import (
 // disabledStateValue is the runtime state value that disables a worker.
 const disabledStateValue = "true"
 
+// SyncBackoffConfig holds the backoff a sync worker applies after a provider
+// rate-limits it or fails. The delay doubles on each consecutive failure, up to
+// MaxDelay, and is spread by a random Jitter ratio.
+type SyncBackoffConfig struct {
+	BaseDelay time.Duration `conf:"base_delay" default:"1m"`
+	MaxDelay  time.Duration `conf:"max_delay"  default:"6h"`
+	Jitter    float64       `conf:"jitter"     default:"0.2"`
+}
+
 // YouTubeSyncConfig holds configuration for the YouTube sync workers.
 type YouTubeSyncConfig struct {
-	FullSyncInterval        time.Duration `conf:"full_sync_interval"        default:"6h"`
-	IncrementalSyncInterval time.Duration `conf:"incremental_sync_interval" default:"15m"`
-	CheckInterval           time.Duration `conf:"check_interval"            default:"1m"`
-	BatchSize               int           `conf:"batch_size"                default:"10"`
-	StoriesPerLink          int           `conf:"stories_per_link"          default:"50"`
-	FullSyncMaxStories      int           `conf:"full_sync_max_stories"     default:"1000"`
-	TokenRefreshBuffer      time.Duration `conf:"token_refresh_buffer"      default:"5m"`
-	FullSyncEnabled         bool          `conf:"full_sync_enabled"         default:"true"`
-	IncrementalSyncEnabled  bool          `conf:"incremental_sync_enabled"  default:"true"`
+	FullSyncInterval        time.Duration     `conf:"full_sync_interval"        default:"6h"`
+	IncrementalSyncInterval time.Duration     `conf:"incremental_sync_interval" default:"15m"`
+	CheckInterval           time.Duration     `conf:"check_interval"            default:"1m"`
+	BatchSize               int               `conf:"batch_size"                default:"10"`
+	StoriesPerLink          int               `conf:"stories_per_link"          default:"50"`
+	FullSyncMaxStories      int               `conf:"full_sync_max_stories"     default:"1000"`
+	TokenRefreshBuffer      time.Duration     `conf:"token_refresh_buffer"      default:"5m"`
+	FullSyncEnabled         bool              `conf:"full_sync_enabled"         default:"true"`
+	IncrementalSyncEnabled  bool              `conf:"incremental_sync_enabled"  default:"true"`
+	Backoff                 SyncBackoffConfig `conf:"backoff"`
 }
 
 // SpeakerDeckSyncConfig holds configuration for the SpeakerDeck sync worker.
 type SpeakerDeckSyncConfig struct {
-	FullSyncEnabled  bool          `conf:"full_sync_enabled"  default:"true"`
-	FullSyncInterval time.Duration `conf:"full_sync_interval" default:"6h"`
-	CheckInterval    time.Duration `conf:"check_interval"     default:"1m"`
-	BatchSize        int           `conf:"batch_size"         default:"10"`
+	FullSyncEnabled  bool              `conf:"full_sync_enabled"  default:"true"`
+	FullSyncInterval time.Duration     `conf:"full_sync_interval" default:"6h"`
+	CheckInterval    time.Duration     `conf:"check_interval"     default:"1m"`
+	BatchSize        int               `conf:"batch_size"         default:"10"`
+	Backoff          SyncBackoffConfig `conf:"backoff"`
 }
 
 // ExternalSiteSyncConfig holds configuration for the external site sync worker.
 type ExternalSiteSyncConfig struct {
-	FullSyncEnabled  bool          `conf:"full_sync_enabled"  default:"true"`
-	FullSyncInterval time.Duration `conf:"full_sync_interval" default:"6h"`
-	CheckInterval    time.Duration `conf:"check_interval"     default:"1m"`
-	BatchSize        int           `conf:"batch_size"         default:"10"`
+	FullSyncEnabled  bool              `conf:"full_sync_enabled"  default:"true"`
+	FullSyncInterval time.Duration     `conf:"full_sync_interval" default:"6h"`
+	CheckInterval    time.Duration     `conf:"check_interval"     default:"1m"`
+	BatchSize        int               `conf:"batch_size"         default:"10"`
+	Backoff          SyncBackoffConfig `conf:"backoff"`
 }
 
 // DomainSyncConfig holds configuration for the custom domain sync worker.
@@ -66,16 +78,47 @@ type YouTubeLiveStatusConfig struct {
 	TokenRefreshBuffer time.Duration `conf:"token_refresh_buffer" default:"5m"`
 }
 
+// WebhookRetentionConfig holds configuration for the webhook delivery log purge worker.
+type WebhookRetentionConfig struct {
+	Enabled       bool          `conf:"enabled"        default:"true"`
+	CheckInterval time.Duration `conf:"check_interval" default:"1h"`
+}
+
+// PagePublisherConfig holds configuration for the scheduled page publisher worker.
+type PagePublisherConfig struct {
+	Enabled       bool          `conf:"enabled"        default:"true"`
+	CheckInterval time.Duration `conf:"check_interval" default:"1m"`
+	BatchSize     int           `conf:"batch_size"     default:"100"`
+}
+
+// CustomDomainVerifyConfig holds configuration for the custom domain verification worker.
+type CustomDomainVerifyConfig struct {
+	Enabled       bool          `conf:"enabled"        default:"true"`
+	CheckInterval time.Duration `conf:"check_interval" default:"2m"`
+	BatchSize     int           `conf:"batch_size"     default:"50"`
+}
+
+// ReferralResolverConfig holds configuration for the candidate voting deadline worker.
+type ReferralResolverConfig struct {
+	Enabled       bool          `conf:"enabled"        default:"true"`
+	CheckInterval time.Duration `conf:"check_interval" default:"5m"`
+	BatchSize     int           `conf:"batch_size"     default:"50"`
+}
+
 // Config holds all worker configurations.
 type Config struct {
-	DomainSync        DomainSyncConfig         `conf:"domain_sync"`
-	YouTubeSync       YouTubeSyncConfig        `conf:"youtube_sync"`
-	YouTubeLiveStatus YouTubeLiveStatusConfig  `conf:"youtube_live_status"`
-	GitHubSync        GitHubSyncConfig         `conf:"github_sync"`
-	SpeakerDeckSync   SpeakerDeckSyncConfig    `conf:"speakerdeck_sync"`
-	ExternalSiteSync  ExternalSiteSyncConfig   `conf:"external_site_sync"`
-	StorySummaries    StorySummariesConfig     `conf:"story_summaries"`
-	Queue             QueueWorkerConfig        `conf:"queue"`
-	TelegramBot       TelegramBotPollingConfig `conf:"telegram_bot"`
-	Bulletin          BulletinConfig           `conf:"bulletin"`
+	DomainSync         DomainSyncConfig         `conf:"domain_sync"`
+	YouTubeSync        YouTubeSyncConfig        `conf:"youtube_sync"`
+	YouTubeLiveStatus  YouTubeLiveStatusConfig  `conf:"youtube_live_status"`
+	GitHubSync         GitHubSyncConfig         `conf:"github_sync"`
+	SpeakerDeckSync    SpeakerDeckSyncConfig    `conf:"speakerdeck_sync"`
+	ExternalSiteSync   ExternalSiteSyncConfig   `conf:"external_site_sync"`
+	StorySummaries     StorySummariesConfig     `conf:"story_summaries"`
+	Queue              QueueWorkerConfig        `conf:"queue"`
+	TelegramBot        TelegramBotPollingConfig `conf:"telegram_bot"`
+	Bulletin           BulletinConfig           `conf:"bulletin"`
+	WebhookRetention   WebhookRetentionConfig   `conf:"webhook_retention"`
+	PagePublisher      PagePublisherConfig      `conf:"page_publisher"`
+	CustomDomainVerify CustomDomainVerifyConfig `conf:"custom_domain_verify"`
+	ReferralResolver   ReferralResolverConfig   `conf:"referral_resolver"`
 }
@@ -0,0 +1,74 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var ErrMissingCheckID = errors.New("consistency check item has no check_id")
+
+// ConsistencyCheckHandler runs consistency checks queued by admins.
+type ConsistencyCheckHandler struct {
+	logger             *logfx.Logger
+	consistencyService *consistency.Service
+}
+
+// NewConsistencyCheckHandler creates a new consistency check handler.
+func NewConsistencyCheckHandler(
+	logger *logfx.Logger,
+	consistencyService *consistency.Service,
+) *ConsistencyCheckHandler {
+	return &ConsistencyCheckHandler{
+		logger:             logger,
+		consistencyService: consistencyService,
+	}
+}
+
+// HandleConsistencyCheck handles the CONSISTENCY_CHECK item.
+func (h *ConsistencyCheckHandler) HandleConsistencyCheck(
+	ctx context.Context,
+	item *events.QueueItem,
+) error {
+	var payload struct {
+		CheckID string `json:"check_id"`
+	}
+
+	payloadBytes, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshalPayload, err)
+	}
+
+	err = json.Unmarshal(payloadBytes, &payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalPayload, err)
+	}
+
+	if payload.CheckID == "" {
+		return ErrMissingCheckID
+	}
+
+	h.logger.Info(
+		"Processing CONSISTENCY_CHECK item",
+		"check_id", payload.CheckID,
+		"item_id", item.ID,
+	)
+
+	err = h.consistencyService.Run(ctx, payload.CheckID)
+	if errors.Is(err, consistency.ErrCheckAlreadyFinished) {
+		// A retry after the check was stored; nothing left to do
+		return nil
+	}
+
+	return err
+}
+
+// RegisterHandlers registers the consistency check queue handler.
+func (h *ConsistencyCheckHandler) RegisterHandlers(registry *events.HandlerRegistry) {
+	registry.Register(events.QueueItemTypeConsistencyCheck, h.HandleConsistencyCheck)
+}
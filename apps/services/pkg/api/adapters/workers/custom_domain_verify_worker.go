@@ -0,0 +1,105 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+)
+
+const lockIDCustomDomainVerify int64 = 100016
+
+// CustomDomainVerifyWorker verifies pending and failed custom domains, so they
+// go live once their DNS propagates without the owner checking again.
+type CustomDomainVerifyWorker struct {
+	config        *CustomDomainVerifyConfig
+	logger        *logfx.Logger
+	service       *profiles.Service
+	runtimeStates *runtime_states.Service
+}
+
+// NewCustomDomainVerifyWorker creates a new custom domain verification worker.
+func NewCustomDomainVerifyWorker(
+	config *CustomDomainVerifyConfig,
+	logger *logfx.Logger,
+	service *profiles.Service,
+	runtimeStates *runtime_states.Service,
+) *CustomDomainVerifyWorker {
+	return &CustomDomainVerifyWorker{
+		config:        config,
+		logger:        logger,
+		service:       service,
+		runtimeStates: runtimeStates,
+	}
+}
+
+// Name returns the worker name.
+func (w *CustomDomainVerifyWorker) Name() string {
+	return "custom-domain-verify"
+}
+
+// Interval returns the check interval.
+func (w *CustomDomainVerifyWorker) Interval() time.Duration {
+	return w.config.CheckInterval
+}
+
+// Execute checks the DNS of a batch of unverified custom domains.
+func (w *CustomDomainVerifyWorker) Execute(ctx context.Context) error {
+	// Check if worker is disabled by admin
+	disabledKey := "worker." + w.Name() + ".disabled"
+
+	disabled, err := w.runtimeStates.Get(ctx, disabledKey)
+	if err == nil && disabled == disabledStateValue {
+		return workerfx.ErrWorkerSkipped
+	}
+
+	// Try advisory lock to prevent concurrent execution
+	acquired, lockErr := w.runtimeStates.TryLock(ctx, lockIDCustomDomainVerify)
+	if lockErr != nil {
+		w.logger.WarnContext(ctx, "Failed to acquire advisory lock for custom-domain-verify",
+			slog.Any("error", lockErr))
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	if !acquired {
+		w.logger.DebugContext(ctx, "Another instance is running custom-domain-verify worker")
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	defer func() {
+		releaseErr := w.runtimeStates.ReleaseLock(ctx, lockIDCustomDomainVerify)
+		if releaseErr != nil {
+			w.logger.WarnContext(ctx, "Failed to release advisory lock for custom-domain-verify",
+				slog.String("error", releaseErr.Error()))
+		}
+	}()
+
+	now := time.Now()
+
+	verified, err := w.service.VerifyUnverifiedCustomDomains(ctx, now, w.config.BatchSize)
+	if err != nil {
+		return fmt.Errorf("verifying custom domains: %w", err)
+	}
+
+	if verified > 0 {
+		w.logger.InfoContext(ctx, "Verified custom domains",
+			slog.Int("verified", verified))
+	}
+
+	lastRunKey := "profiles.custom_domain_verify.last_run_at"
+
+	setErr := w.runtimeStates.SetTime(ctx, lastRunKey, now)
+	if setErr != nil {
+		w.logger.WarnContext(ctx, "Failed to set last run time for custom-domain-verify",
+			slog.String("error", setErr.Error()))
+	}
+
+	return nil
+}
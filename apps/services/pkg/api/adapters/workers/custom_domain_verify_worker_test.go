@@ -0,0 +1,136 @@
+package workers_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// customDomainRepository holds custom domains and stores verification updates.
+type customDomainRepository struct {
+	profiles.Repository
+
+	domains map[string]*profiles.ProfileCustomDomain
+}
+
+func (r *customDomainRepository) ListAllCustomDomains(
+	_ context.Context,
+) ([]*profiles.ProfileCustomDomain, error) {
+	result := make([]*profiles.ProfileCustomDomain, 0, len(r.domains))
+	for _, domain := range r.domains {
+		copied := *domain
+		result = append(result, &copied)
+	}
+
+	return result, nil
+}
+
+func (r *customDomainRepository) UpdateCustomDomainVerification(
+	_ context.Context,
+	id string,
+	status string,
+	dnsVerifiedAt *time.Time,
+	expiredAt *time.Time,
+) error {
+	now := time.Now()
+
+	domain := r.domains[id]
+	domain.VerificationStatus = status
+	domain.DNSVerifiedAt = dnsVerifiedAt
+	domain.ExpiredAt = expiredAt
+	domain.LastDNSCheckAt = &now
+
+	return nil
+}
+
+func (r *customDomainRepository) UpdateCustomDomainWebserverSynced(
+	_ context.Context,
+	_ string,
+	_ bool,
+) error {
+	return nil
+}
+
+// cnameResolver answers CNAME lookups from a table; everything else is NXDOMAIN.
+type cnameResolver struct {
+	cnames map[string]string
+}
+
+func notFound(name string) error {
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true} //nolint:exhaustruct
+}
+
+func (r *cnameResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	return nil, notFound(host)
+}
+
+func (r *cnameResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	if cname, ok := r.cnames[host]; ok {
+		return cname, nil
+	}
+
+	return "", notFound(host)
+}
+
+func (r *cnameResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	return nil, notFound(name)
+}
+
+func TestCustomDomainVerifyWorker_VerifiesPropagatedDomains(t *testing.T) {
+	t.Parallel()
+
+	domainRepo := &customDomainRepository{
+		domains: map[string]*profiles.ProfileCustomDomain{
+			"domain-correct": { //nolint:exhaustruct
+				ID:                 "domain-correct",
+				Domain:             "blog.example.com",
+				VerificationStatus: profiles.DomainStatusPending,
+			},
+			"domain-mismatch": { //nolint:exhaustruct
+				ID:                 "domain-mismatch",
+				Domain:             "docs.example.com",
+				VerificationStatus: profiles.DomainStatusPending,
+			},
+		},
+	}
+
+	config := &profiles.Config{} //nolint:exhaustruct
+	config.DNSVerification.ExpectedCNAME = "aya.is."
+	config.DNSVerification.VerifyNowTimeout = time.Second
+
+	profileService := profiles.NewService(nil, config, domainRepo, nil)
+	profileService.SetDNSResolver(&cnameResolver{
+		cnames: map[string]string{
+			"blog.example.com": "aya.is.",
+			"docs.example.com": "elsewhere.example.net.",
+		},
+	})
+
+	stateRepo := &memoryRuntimeStateRepository{states: map[string]string{}} //nolint:exhaustruct
+	runtimeStates := runtime_states.NewService(nil, stateRepo)
+
+	worker := workers.NewCustomDomainVerifyWorker(
+		&workers.CustomDomainVerifyConfig{Enabled: true, CheckInterval: time.Minute, BatchSize: 50},
+		logfx.NewLogger(),
+		profileService,
+		runtimeStates,
+	)
+
+	require.NoError(t, worker.Execute(t.Context()))
+
+	assert.Equal(t, profiles.DomainStatusVerified, domainRepo.domains["domain-correct"].VerificationStatus)
+	assert.NotNil(t, domainRepo.domains["domain-correct"].DNSVerifiedAt)
+	assert.NotEqual(t, profiles.DomainStatusVerified, domainRepo.domains["domain-mismatch"].VerificationStatus)
+	assert.Nil(t, domainRepo.domains["domain-mismatch"].DNSVerifiedAt)
+
+	_, err := runtimeStates.GetTime(t.Context(), "profiles.custom_domain_verify.last_run_at")
+	require.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sort"
@@ -24,6 +25,7 @@ type DomainSyncWorker struct {
 	profileRepo     profiles.Repository
 	webserverSyncer profiles.WebserverSyncer
 	dnsConfig       *profiles.DNSVerificationConfig
+	dnsResolver     profiles.DNSResolver
 	runtimeStates   *runtime_states.Service
 	baseDomains     []string
 }
@@ -35,6 +37,7 @@ func NewDomainSyncWorker(
 	profileRepo profiles.Repository,
 	webserverSyncer profiles.WebserverSyncer,
 	dnsConfig *profiles.DNSVerificationConfig,
+	dnsResolver profiles.DNSResolver,
 	runtimeStates *runtime_states.Service,
 ) *DomainSyncWorker {
 	baseDomains := make([]string, 0)
@@ -54,6 +57,7 @@ func NewDomainSyncWorker(
 		profileRepo:     profileRepo,
 		webserverSyncer: webserverSyncer,
 		dnsConfig:       dnsConfig,
+		dnsResolver:     dnsResolver,
 		runtimeStates:   runtimeStates,
 		baseDomains:     baseDomains,
 	}
@@ -127,8 +131,9 @@ func (w *DomainSyncWorker) executeSync(ctx context.Context) error {
 }
 
 // verifyDNS checks DNS records for all custom domains and updates their verification status.
-//
-//nolint:funlen // sequential DNS verification steps
+// It covers both initial verification of pending domains and re-verification of
+// verified ones, so domains whose DNS no longer points to us are expired and,
+// after the grace period, dropped from the webserver on the following sync phase.
 func (w *DomainSyncWorker) verifyDNS(ctx context.Context) error {
 	domains, err := w.profileRepo.ListAllCustomDomains(ctx)
 	if err != nil {
@@ -147,97 +152,51 @@ func (w *DomainSyncWorker) verifyDNS(ctx context.Context) error {
 	now := time.Now()
 
 	for _, domain := range domains {
-		verified, reason := profiles.VerifyDomainDNS(ctx, domain.Domain, w.dnsConfig)
+		previousStatus := domain.VerificationStatus
+
+		check, recheckErr := profiles.RecheckDomainDNS(
+			ctx,
+			w.profileRepo,
+			w.dnsResolver,
+			domain,
+			w.dnsConfig,
+			0,
+			now,
+		)
 
-		w.logger.InfoContext(ctx, "DNS verification result",
-			slog.String("domain", domain.Domain),
-			slog.Bool("verified", verified),
-			slog.String("reason", reason),
-			slog.String("previous_status", domain.VerificationStatus))
-
-		newStatus, dnsVerifiedAt, expiredAt := w.computeNewStatus(domain, verified, now)
-
-		if newStatus == domain.VerificationStatus {
-			// Status unchanged, still update last_dns_check_at
-			updateErr := w.profileRepo.UpdateCustomDomainVerification(
-				ctx, domain.ID, domain.VerificationStatus, domain.DNSVerifiedAt, domain.ExpiredAt,
-			)
-			if updateErr != nil {
-				w.logger.ErrorContext(ctx, "Failed to update DNS check timestamp",
-					slog.String("domain", domain.Domain),
-					slog.Any("error", updateErr))
-			}
+		if errors.Is(recheckErr, profiles.ErrDNSLookupFailed) {
+			w.logger.WarnContext(ctx, "DNS lookup failed, keeping domain status",
+				slog.String("domain", domain.Domain),
+				slog.Any("error", recheckErr))
 
 			continue
 		}
 
-		updateErr := w.profileRepo.UpdateCustomDomainVerification(
-			ctx, domain.ID, newStatus, dnsVerifiedAt, expiredAt,
-		)
-		if updateErr != nil {
+		if recheckErr != nil {
 			w.logger.ErrorContext(ctx, "Failed to update domain verification status",
 				slog.String("domain", domain.Domain),
-				slog.Any("error", updateErr))
+				slog.Any("error", recheckErr))
 
 			continue
 		}
 
-		w.logger.WarnContext(ctx, "Domain verification status changed",
+		w.logger.InfoContext(ctx, "DNS verification result",
 			slog.String("domain", domain.Domain),
-			slog.String("old_status", domain.VerificationStatus),
-			slog.String("new_status", newStatus))
+			slog.String("outcome", check.Outcome),
+			slog.String("reason", check.Detail),
+			slog.String("previous_status", previousStatus))
 
-		// If grace period elapsed and domain was synced, mark as unsynced
-		if newStatus == profiles.DomainStatusFailed && domain.WebserverSynced {
-			syncErr := w.profileRepo.UpdateCustomDomainWebserverSynced(ctx, domain.ID, false)
-			if syncErr != nil {
-				w.logger.ErrorContext(ctx, "Failed to update webserver synced flag",
-					slog.String("domain", domain.Domain),
-					slog.Any("error", syncErr))
-			}
+		if domain.VerificationStatus != previousStatus {
+			w.logger.WarnContext(ctx, "Domain verification status changed",
+				slog.String("domain", domain.Domain),
+				slog.String("old_status", previousStatus),
+				slog.String("new_status", domain.VerificationStatus))
 		}
 	}
 
 	return nil
 }
 
-// computeNewStatus determines the new verification status based on current state and DNS result.
-func (w *DomainSyncWorker) computeNewStatus(
-	domain *profiles.ProfileCustomDomain,
-	dnsVerified bool,
-	now time.Time,
-) (string, *time.Time, *time.Time) {
-	if dnsVerified {
-		// DNS resolves correctly — set or keep verified
-		if domain.DNSVerifiedAt != nil {
-			return profiles.DomainStatusVerified, domain.DNSVerifiedAt, nil
-		}
-
-		return profiles.DomainStatusVerified, &now, nil
-	}
-
-	// DNS does not resolve
-	switch domain.VerificationStatus {
-	case profiles.DomainStatusVerified:
-		// Was verified, now enter grace period
-		return profiles.DomainStatusExpired, domain.DNSVerifiedAt, &now
-
-	case profiles.DomainStatusExpired:
-		// Already in grace period — check if grace period has elapsed
-		if domain.ExpiredAt != nil &&
-			now.Sub(*domain.ExpiredAt) >= profiles.DomainExpiredGracePeriod {
-			return profiles.DomainStatusFailed, domain.DNSVerifiedAt, domain.ExpiredAt
-		}
-
-		// Still within grace period
-		return profiles.DomainStatusExpired, domain.DNSVerifiedAt, domain.ExpiredAt
-
-	default:
-		// pending or failed — stay/become failed
-		return profiles.DomainStatusFailed, domain.DNSVerifiedAt, nil
-	}
-}
-
 // syncWebserver syncs verified domains to the webserver infrastructure.
 //
 //nolint:cyclop,funlen // sequential webserver sync steps
@@ -10,6 +10,7 @@ import (
 
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
 	"github.com/eser/aya.is/services/pkg/api/business/resourcesync"
 	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
 )
@@ -53,6 +54,12 @@ type GitHubResourceFetcher interface {
 		owner string,
 		repo string,
 	) ([]*GitHubContributorResult, error)
+	// FetchOrgRepos fetches the public repositories of the organization with the given handle.
+	FetchOrgRepos(
+		ctx context.Context,
+		accessToken string,
+		org string,
+	) ([]*GitHubRepoInfoResult, error)
 	SearchIssues(ctx context.Context, accessToken string, query string) (int, error)
 	// SearchIssueCountsBatch executes multiple search queries in a single GraphQL request.
 	// Returns map[alias]count. Falls back to per-query REST if GraphQL is unavailable.
@@ -70,6 +77,7 @@ type GitHubSyncWorker struct {
 	syncService   *resourcesync.Service
 	fetcher       GitHubResourceFetcher
 	runtimeStates *runtime_states.Service
+	backoff       *SyncBackoff
 }
 
 // NewGitHubSyncWorker creates a new GitHub resource sync worker.
@@ -80,12 +88,20 @@ func NewGitHubSyncWorker(
 	fetcher GitHubResourceFetcher,
 	runtimeStates *runtime_states.Service,
 ) *GitHubSyncWorker {
+	backoff := NewSyncBackoff(
+		&config.Backoff,
+		logger,
+		runtimeStates,
+		"github.resource_sync_worker.backoff",
+	)
+
 	return &GitHubSyncWorker{
 		config:        config,
 		logger:        logger,
 		syncService:   syncService,
 		fetcher:       fetcher,
 		runtimeStates: runtimeStates,
+		backoff:       backoff,
 	}
 }
 
@@ -109,6 +125,11 @@ func (w *GitHubSyncWorker) Execute(ctx context.Context) error {
 		return workerfx.ErrWorkerSkipped
 	}
 
+	// Let a rate-limited or failing provider recover
+	if w.backoff.Active(ctx) {
+		return workerfx.ErrWorkerSkipped
+	}
+
 	// Check if it's time to run based on persisted schedule
 	nextRunKey := w.stateKey("next_run_at")
 
@@ -152,7 +173,10 @@ func (w *GitHubSyncWorker) Execute(ctx context.Context) error {
 			slog.String("error", setErr.Error()))
 	}
 
-	return w.executeSync(ctx)
+	syncErr := w.executeSync(ctx)
+	w.backoff.Update(ctx, syncErr)
+
+	return syncErr
 }
 
 func (w *GitHubSyncWorker) stateKey(suffix string) string {
@@ -193,6 +217,13 @@ type contributorInfo struct {
 func (w *GitHubSyncWorker) executeSync(ctx context.Context) error {
 	w.logger.WarnContext(ctx, "Starting GitHub resource sync cycle")
 
+	// ── Phase 0: Import ──
+	// Add new public repos of organization profiles, so they are synced below.
+	importErr := w.importOrganizationRepos(ctx)
+	if importErr != nil {
+		return fmt.Errorf("%w: %w", ErrSyncFailed, importErr)
+	}
+
 	resources, err := w.syncService.GetGitHubResourcesForSync(ctx, w.config.BatchSize)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrSyncFailed, err)
@@ -212,7 +243,12 @@ func (w *GitHubSyncWorker) executeSync(ctx context.Context) error {
 	contributorMap := make(map[string]*contributorInfo) // key: GitHub remote ID
 
 	for _, resource := range resources {
-		w.collectResourceData(ctx, resource, contributorMap)
+		collectErr := w.collectResourceData(ctx, resource, contributorMap)
+
+		// Stop hitting a rate-limited or failing GitHub API
+		if _, ok := linksync.AsRetryableProviderError(collectErr); ok {
+			return fmt.Errorf("%w: %w", ErrSyncFailed, collectErr)
+		}
 	}
 
 	w.logger.WarnContext(ctx, "Collected contributors across all resources",
@@ -249,20 +285,91 @@ func (w *GitHubSyncWorker) executeSync(ctx context.Context) error {
 	return nil
 }
 
+// importOrganizationRepos imports the public repos of every organization profile
+// with a managed GitHub link as github_repo resources of that profile. Repos the
+// profile already has are skipped. It returns the fetch error of a rate-limited
+// or failing GitHub API so the cycle stops; other failures are logged.
+func (w *GitHubSyncWorker) importOrganizationRepos(ctx context.Context) error {
+	links, err := w.syncService.GetOrganizationGitHubLinksForSync(ctx, w.config.BatchSize)
+	if err != nil {
+		w.logger.ErrorContext(ctx, "Failed to load organization GitHub links",
+			slog.Any("error", err))
+
+		return nil
+	}
+
+	importedCount := 0
+
+	for _, link := range links {
+		repos, fetchErr := w.fetcher.FetchOrgRepos(ctx, link.AuthAccessToken, link.AccountLogin)
+		if fetchErr != nil {
+			w.logger.WarnContext(ctx, "Failed to fetch organization repos",
+				slog.String("link_id", link.LinkID),
+				slog.String("org", link.AccountLogin),
+				slog.Any("error", fetchErr))
+
+			if _, ok := linksync.AsRetryableProviderError(fetchErr); ok {
+				return fetchErr
+			}
+
+			continue
+		}
+
+		for _, repo := range repos {
+			if repo.Private {
+				continue
+			}
+
+			created, importErr := w.syncService.ImportGitHubRepository(
+				ctx,
+				link.ProfileID,
+				&resourcesync.GitHubRepository{
+					RemoteID:    strconv.FormatInt(repo.ID, 10),
+					FullName:    repo.FullName,
+					HTMLURL:     repo.HTMLURL,
+					Description: repo.Description,
+					Language:    repo.Language,
+					Stars:       repo.Stars,
+					Forks:       repo.Forks,
+				},
+			)
+			if importErr != nil {
+				w.logger.WarnContext(ctx, "Failed to import organization repo",
+					slog.String("profile_id", link.ProfileID),
+					slog.String("repo", repo.FullName),
+					slog.Any("error", importErr))
+
+				continue
+			}
+
+			if created {
+				importedCount++
+			}
+		}
+	}
+
+	w.logger.WarnContext(ctx, "Imported organization repos",
+		slog.Int("organizations", len(links)),
+		slog.Int("imported", importedCount))
+
+	return nil
+}
+
 // collectResourceData fetches repo info and contributors for a single resource,
-// updates resource properties, and collects contributor appearances.
+// updates resource properties, and collects contributor appearances. It returns
+// the fetch error, if any, so the cycle can stop when GitHub is struggling.
 func (w *GitHubSyncWorker) collectResourceData(
 	ctx context.Context,
 	resource *resourcesync.GitHubResourceForSync,
 	contributorMap map[string]*contributorInfo,
-) {
+) error {
 	owner, repo, ok := parseOwnerRepo(resource.ResourcePublicID)
 	if !ok {
 		w.logger.ErrorContext(ctx, "Invalid resource public_id format",
 			slog.String("resource_id", resource.ResourceID),
 			slog.String("public_id", resource.ResourcePublicID))
 
-		return
+		return nil
 	}
 
 	accessToken := resource.AuthAccessToken
@@ -275,7 +382,7 @@ func (w *GitHubSyncWorker) collectResourceData(
 			slog.String("public_id", resource.ResourcePublicID),
 			slog.Any("error", err))
 
-		return
+		return err
 	}
 
 	// Update resource properties
@@ -295,7 +402,7 @@ func (w *GitHubSyncWorker) collectResourceData(
 			slog.String("resource_id", resource.ResourceID),
 			slog.Any("error", err))
 
-		return
+		return err
 	}
 
 	collectContributors(
@@ -307,6 +414,8 @@ func (w *GitHubSyncWorker) collectResourceData(
 		repoInfo.Stars,
 		accessToken,
 	)
+
+	return nil
 }
 
 // collectContributors adds each contributor's appearance to the contributor map.
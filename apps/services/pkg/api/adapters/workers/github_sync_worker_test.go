@@ -0,0 +1,133 @@
+package workers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
+	"github.com/eser/aya.is/services/pkg/api/business/resourcesync"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resourceSyncRepository serves one organization GitHub link and keeps the
+// github_repo resources of its profile, keyed by remote ID.
+type resourceSyncRepository struct {
+	resourcesync.Repository
+
+	resources map[string]*resourcesync.GitHubRepository
+}
+
+func (r *resourceSyncRepository) ListOrganizationGitHubLinksForSync(
+	_ context.Context,
+	_ int,
+) ([]*resourcesync.GitHubOrganizationLink, error) {
+	return []*resourcesync.GitHubOrganizationLink{
+		{LinkID: "link-1", ProfileID: "org-1", AccountLogin: "acme", AuthAccessToken: "token"},
+	}, nil
+}
+
+func (r *resourceSyncRepository) GetProfileResourceByRemoteID(
+	_ context.Context,
+	profileID string,
+	kind string,
+	remoteID string,
+) (string, error) {
+	if profileID != "org-1" || kind != resourcesync.ResourceKindGitHubRepo {
+		return "", nil
+	}
+
+	if _, ok := r.resources[remoteID]; ok {
+		return "resource-" + remoteID, nil
+	}
+
+	return "", nil
+}
+
+func (r *resourceSyncRepository) CreateGitHubRepoResource(
+	_ context.Context,
+	_ string,
+	_ string,
+	repo *resourcesync.GitHubRepository,
+) error {
+	r.resources[repo.RemoteID] = repo
+
+	return nil
+}
+
+func (r *resourceSyncRepository) ListGitHubResourcesForSync(
+	_ context.Context,
+	_ int,
+) ([]*resourcesync.GitHubResourceForSync, error) {
+	return nil, nil
+}
+
+// orgRepoFetcher returns three public repos for the "acme" organization.
+type orgRepoFetcher struct {
+	workers.GitHubResourceFetcher
+
+	orgs []string
+}
+
+func (f *orgRepoFetcher) FetchOrgRepos(
+	_ context.Context,
+	_ string,
+	org string,
+) ([]*workers.GitHubRepoInfoResult, error) {
+	f.orgs = append(f.orgs, org)
+
+	repos := make([]*workers.GitHubRepoInfoResult, 0, 3)
+	for i := int64(1); i <= 3; i++ {
+		repos = append(repos, &workers.GitHubRepoInfoResult{ //nolint:exhaustruct
+			ID:       i,
+			FullName: fmt.Sprintf("%s/repo-%d", org, i),
+			HTMLURL:  fmt.Sprintf("https://github.com/%s/repo-%d", org, i),
+		})
+	}
+
+	return repos, nil
+}
+
+func TestGitHubSyncWorker_ImportsNewOrganizationRepos(t *testing.T) {
+	t.Parallel()
+
+	repo := &resourceSyncRepository{
+		resources: map[string]*resourcesync.GitHubRepository{
+			"2": {RemoteID: "2", FullName: "acme/repo-2"}, //nolint:exhaustruct
+		},
+	}
+	fetcher := &orgRepoFetcher{} //nolint:exhaustruct
+
+	counter := 0
+	idGenerator := func() string {
+		counter++
+
+		return fmt.Sprintf("id-%d", counter)
+	}
+
+	stateRepo := &memoryRuntimeStateRepository{states: map[string]string{}} //nolint:exhaustruct
+
+	worker := workers.NewGitHubSyncWorker(
+		&workers.GitHubSyncConfig{ //nolint:exhaustruct
+			FullSyncInterval: time.Hour,
+			BatchSize:        10,
+		},
+		logfx.NewLogger(),
+		resourcesync.NewService(logfx.NewLogger(), repo, idGenerator),
+		fetcher,
+		runtime_states.NewService(nil, stateRepo),
+	)
+
+	err := worker.Execute(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"acme"}, fetcher.orgs)
+	assert.Equal(t, 2, counter, "only the two new repos are created")
+	require.Len(t, repo.resources, 3)
+	assert.Equal(t, "acme/repo-1", repo.resources["1"].FullName)
+	assert.Equal(t, "https://github.com/acme/repo-3", repo.resources["3"].HTMLURL)
+}
@@ -0,0 +1,31 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+)
+
+// recordLinkSyncAttempt stores the outcome of syncing a link so its owner can
+// see failures on the profile settings. Attempts cut short by shutdown are not
+// recorded, as they say nothing about the link.
+func recordLinkSyncAttempt(
+	ctx context.Context,
+	logger *logfx.Logger,
+	syncService *linksync.Service,
+	linkID string,
+	syncErr error,
+) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	err := syncService.RecordSyncAttempt(ctx, linkID, syncErr)
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to record link sync status",
+			slog.String("link_id", linkID),
+			slog.Any("error", err))
+	}
+}
@@ -0,0 +1,104 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+)
+
+const lockIDPagePublisher int64 = 100015
+
+// PagePublisherWorker makes scheduled draft pages public once their published_at passes.
+type PagePublisherWorker struct {
+	config        *PagePublisherConfig
+	logger        *logfx.Logger
+	service       *profiles.Service
+	runtimeStates *runtime_states.Service
+}
+
+// NewPagePublisherWorker creates a new page publisher worker.
+func NewPagePublisherWorker(
+	config *PagePublisherConfig,
+	logger *logfx.Logger,
+	service *profiles.Service,
+	runtimeStates *runtime_states.Service,
+) *PagePublisherWorker {
+	return &PagePublisherWorker{
+		config:        config,
+		logger:        logger,
+		service:       service,
+		runtimeStates: runtimeStates,
+	}
+}
+
+// Name returns the worker name.
+func (w *PagePublisherWorker) Name() string {
+	return "page-publisher"
+}
+
+// Interval returns the check interval.
+func (w *PagePublisherWorker) Interval() time.Duration {
+	return w.config.CheckInterval
+}
+
+// Execute publishes the draft pages that are due.
+func (w *PagePublisherWorker) Execute(ctx context.Context) error {
+	// Check if worker is disabled by admin
+	disabledKey := "worker." + w.Name() + ".disabled"
+
+	disabled, err := w.runtimeStates.Get(ctx, disabledKey)
+	if err == nil && disabled == disabledStateValue {
+		return workerfx.ErrWorkerSkipped
+	}
+
+	// Try advisory lock to prevent concurrent execution
+	acquired, lockErr := w.runtimeStates.TryLock(ctx, lockIDPagePublisher)
+	if lockErr != nil {
+		w.logger.WarnContext(ctx, "Failed to acquire advisory lock for page-publisher",
+			slog.Any("error", lockErr))
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	if !acquired {
+		w.logger.DebugContext(ctx, "Another instance is running page-publisher worker")
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	defer func() {
+		releaseErr := w.runtimeStates.ReleaseLock(ctx, lockIDPagePublisher)
+		if releaseErr != nil {
+			w.logger.WarnContext(ctx, "Failed to release advisory lock for page-publisher",
+				slog.String("error", releaseErr.Error()))
+		}
+	}()
+
+	now := time.Now()
+
+	published, err := w.service.PublishDuePages(ctx, now, w.config.BatchSize)
+	if err != nil {
+		return fmt.Errorf("publishing due pages: %w", err)
+	}
+
+	if published > 0 {
+		w.logger.InfoContext(ctx, "Published scheduled profile pages",
+			slog.Int("published", published))
+	}
+
+	lastRunKey := "profiles.page_publisher.last_run_at"
+
+	setErr := w.runtimeStates.SetTime(ctx, lastRunKey, now)
+	if setErr != nil {
+		w.logger.WarnContext(ctx, "Failed to set last run time for page-publisher",
+			slog.String("error", setErr.Error()))
+	}
+
+	return nil
+}
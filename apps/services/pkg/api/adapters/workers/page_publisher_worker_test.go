@@ -0,0 +1,168 @@
+package workers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// duePageRepository holds pages and publishes them the way the conditional
+// update does: only a page that is still a draft is flipped.
+type duePageRepository struct {
+	profiles.Repository
+
+	pages       map[string]*profiles.ProfilePage
+	publishes   int
+	listedSince time.Time
+}
+
+func (r *duePageRepository) ListDueDraftProfilePages(
+	_ context.Context,
+	now time.Time,
+	_ int,
+) ([]*profiles.DueProfilePage, error) {
+	r.listedSince = now
+
+	result := make([]*profiles.DueProfilePage, 0, len(r.pages))
+
+	for _, page := range r.pages {
+		if page.Visibility == profiles.PageVisibilityDraft && !page.PublishedAt.After(now) {
+			result = append(result, &profiles.DueProfilePage{
+				PublishedAt: *page.PublishedAt,
+				ID:          page.ID,
+				ProfileID:   "profile-1",
+				Slug:        page.Slug,
+			})
+		}
+	}
+
+	return result, nil
+}
+
+func (r *duePageRepository) PublishDraftProfilePage(_ context.Context, id string) (bool, error) {
+	page := r.pages[id]
+	if page == nil || page.Visibility != profiles.PageVisibilityDraft {
+		return false, nil
+	}
+
+	page.Visibility = profiles.PageVisibilityPublic
+	r.publishes++
+
+	return true, nil
+}
+
+// memoryRuntimeStateRepository is a runtime state store whose locks always succeed.
+type memoryRuntimeStateRepository struct {
+	runtime_states.Repository
+
+	states map[string]string
+}
+
+func (r *memoryRuntimeStateRepository) GetState(
+	_ context.Context,
+	key string,
+) (*runtime_states.RuntimeState, error) {
+	value, ok := r.states[key]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &runtime_states.RuntimeState{Key: key, Value: value}, nil //nolint:exhaustruct
+}
+
+func (r *memoryRuntimeStateRepository) SetState(_ context.Context, key string, value string) error {
+	r.states[key] = value
+
+	return nil
+}
+
+func (r *memoryRuntimeStateRepository) RemoveState(_ context.Context, key string) error {
+	delete(r.states, key)
+
+	return nil
+}
+
+func (r *memoryRuntimeStateRepository) TryAdvisoryLock(_ context.Context, _ int64) (bool, error) {
+	return true, nil
+}
+
+func (r *memoryRuntimeStateRepository) ReleaseAdvisoryLock(_ context.Context, _ int64) error {
+	return nil
+}
+
+// recordingAuditRepository keeps every recorded audit entry.
+type recordingAuditRepository struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *recordingAuditRepository) InsertAudit(
+	_ context.Context,
+	_ string,
+	params events.AuditParams,
+) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+func TestPagePublisherWorker_PublishesDuePageOnce(t *testing.T) {
+	t.Parallel()
+
+	dueAt := time.Now().Add(-time.Minute)
+	scheduledAt := time.Now().Add(24 * time.Hour)
+
+	pageRepo := &duePageRepository{ //nolint:exhaustruct
+		pages: map[string]*profiles.ProfilePage{
+			"page-due": { //nolint:exhaustruct
+				ID:          "page-due",
+				Slug:        "launch",
+				Visibility:  profiles.PageVisibilityDraft,
+				PublishedAt: &dueAt,
+			},
+			"page-later": { //nolint:exhaustruct
+				ID:          "page-later",
+				Slug:        "roadmap",
+				Visibility:  profiles.PageVisibilityDraft,
+				PublishedAt: &scheduledAt,
+			},
+		},
+	}
+	auditRepo := &recordingAuditRepository{} //nolint:exhaustruct
+	auditService := events.NewAuditService(nil, auditRepo, func() string { return "audit" }, nil)
+	profileService := profiles.NewService(nil, &profiles.Config{}, pageRepo, auditService) //nolint:exhaustruct
+
+	stateRepo := &memoryRuntimeStateRepository{states: map[string]string{}} //nolint:exhaustruct
+	runtimeStates := runtime_states.NewService(nil, stateRepo)
+
+	worker := workers.NewPagePublisherWorker(
+		&workers.PagePublisherConfig{Enabled: true, CheckInterval: time.Minute, BatchSize: 100},
+		logfx.NewLogger(),
+		profileService,
+		runtimeStates,
+	)
+
+	require.NoError(t, worker.Execute(t.Context()))
+	require.NoError(t, worker.Execute(t.Context()))
+
+	assert.Equal(t, 1, pageRepo.publishes)
+	assert.Equal(t, profiles.PageVisibilityPublic, pageRepo.pages["page-due"].Visibility)
+	assert.Equal(t, profiles.PageVisibilityDraft, pageRepo.pages["page-later"].Visibility)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfilePagePublished, auditRepo.entries[0].EventType)
+	assert.Equal(t, "page-due", auditRepo.entries[0].EntityID)
+
+	lastRunAt, err := runtimeStates.GetTime(t.Context(), "profiles.page_publisher.last_run_at")
+	require.NoError(t, err)
+	assert.True(t, lastRunAt.Equal(pageRepo.listedSince))
+}
@@ -0,0 +1,76 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+)
+
+var ErrMissingTranslationTarget = errors.New("page translation item has no job_id or target_locale")
+
+// PageTranslationHandler translates pages into the locales of bulk
+// auto-translation jobs, one queue item per locale.
+type PageTranslationHandler struct {
+	logger               *logfx.Logger
+	profileService       *profiles.Service
+	translator           profiles.ContentTranslator
+	profilePointsService *profile_points.Service
+}
+
+// NewPageTranslationHandler creates a new page translation handler.
+func NewPageTranslationHandler(
+	logger *logfx.Logger,
+	profileService *profiles.Service,
+	translator profiles.ContentTranslator,
+	profilePointsService *profile_points.Service,
+) *PageTranslationHandler {
+	return &PageTranslationHandler{
+		logger:               logger,
+		profileService:       profileService,
+		translator:           translator,
+		profilePointsService: profilePointsService,
+	}
+}
+
+// HandlePageAutoTranslate handles the PAGE_AUTO_TRANSLATE item.
+func (h *PageTranslationHandler) HandlePageAutoTranslate(
+	ctx context.Context,
+	item *events.QueueItem,
+) error {
+	var task profiles.PageTranslationTask
+
+	payloadBytes, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshalPayload, err)
+	}
+
+	err = json.Unmarshal(payloadBytes, &task)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalPayload, err)
+	}
+
+	if task.JobID == "" || task.TargetLocale == "" {
+		return ErrMissingTranslationTarget
+	}
+
+	h.logger.Info(
+		"Processing PAGE_AUTO_TRANSLATE item",
+		"job_id", task.JobID,
+		"page_id", task.PageID,
+		"target_locale", task.TargetLocale,
+		"item_id", item.ID,
+	)
+
+	return h.profileService.TranslateJobLocale(ctx, task, h.translator, h.profilePointsService)
+}
+
+// RegisterHandlers registers the page translation queue handler.
+func (h *PageTranslationHandler) RegisterHandlers(registry *events.HandlerRegistry) {
+	registry.Register(events.QueueItemTypePageAutoTranslate, h.HandlePageAutoTranslate)
+}
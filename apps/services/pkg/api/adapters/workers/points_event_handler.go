@@ -15,6 +15,7 @@ var (
 	ErrMarshalPayload     = errors.New("failed to marshal payload")
 	ErrUnmarshalPayload   = errors.New("failed to unmarshal payload")
 	ErrCreatePendingAward = errors.New("failed to create pending award")
+	ErrMissingAwardKey    = errors.New("points award item has no key")
 )
 
 // PointsEventHandler handles queue items that award profile points.
@@ -89,7 +90,39 @@ func (h *PointsEventHandler) HandleNewStory(ctx context.Context, item *events.Qu
 	return nil
 }
 
+// HandleActionAward handles the POINTS_AWARD item and credits the award of an
+// audited action. Awards are credited once per key, so retries are harmless.
+func (h *PointsEventHandler) HandleActionAward(ctx context.Context, item *events.QueueItem) error {
+	var award profile_points.ActionAward
+
+	payloadBytes, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshalPayload, err)
+	}
+
+	err = json.Unmarshal(payloadBytes, &award)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalPayload, err)
+	}
+
+	if award.TargetProfileID == "" {
+		return profile_points.ErrMissingProfileID
+	}
+
+	if award.Key == "" {
+		return ErrMissingAwardKey
+	}
+
+	_, err = h.profilePointsService.AwardAction(ctx, &award)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // RegisterHandlers registers all points-related queue handlers.
 func (h *PointsEventHandler) RegisterHandlers(registry *events.HandlerRegistry) {
 	registry.Register(events.QueueItemTypeNewStory, h.HandleNewStory)
+	registry.Register(events.QueueItemTypePointsAward, h.HandleActionAward)
 }
@@ -0,0 +1,160 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/eser/aya.is/services/pkg/api/business/siteimporter"
+)
+
+var (
+	ErrMissingResyncTarget   = errors.New("profile sync item has no profile_id or provider")
+	ErrResyncProviderOff     = errors.New("sync worker of the provider is not enabled")
+	ErrProviderBackingOff    = errors.New("provider is backing off after errors")
+	ErrProfileLinkSyncFailed = errors.New("failed to sync profile links")
+)
+
+// ProfileSyncer syncs the managed links of a single profile.
+type ProfileSyncer interface {
+	SyncProfile(ctx context.Context, profileID string) error
+}
+
+// ProfileResyncHandler runs the on-demand resyncs requested by profile
+// maintainers, using the sync worker of each provider.
+type ProfileResyncHandler struct {
+	logger  *logfx.Logger
+	syncers map[string]ProfileSyncer
+}
+
+// NewProfileResyncHandler creates a new profile resync handler.
+func NewProfileResyncHandler(logger *logfx.Logger) *ProfileResyncHandler {
+	return &ProfileResyncHandler{
+		logger:  logger,
+		syncers: make(map[string]ProfileSyncer),
+	}
+}
+
+// AddSyncer makes the handler sync the provider's links with the given syncer.
+func (h *ProfileResyncHandler) AddSyncer(provider string, syncer ProfileSyncer) {
+	h.syncers[provider] = syncer
+}
+
+// HandleProfileSync handles the PROFILE_SYNC item.
+func (h *ProfileResyncHandler) HandleProfileSync(
+	ctx context.Context,
+	item *events.QueueItem,
+) error {
+	var payload struct {
+		ProfileID string `json:"profile_id"`
+		Provider  string `json:"provider"`
+	}
+
+	payloadBytes, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshalPayload, err)
+	}
+
+	err = json.Unmarshal(payloadBytes, &payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalPayload, err)
+	}
+
+	if payload.ProfileID == "" || payload.Provider == "" {
+		return ErrMissingResyncTarget
+	}
+
+	syncer, ok := h.syncers[payload.Provider]
+	if !ok {
+		return fmt.Errorf("%w(provider: %s)", ErrResyncProviderOff, payload.Provider)
+	}
+
+	h.logger.Info(
+		"Processing PROFILE_SYNC item",
+		"profile_id", payload.ProfileID,
+		"provider", payload.Provider,
+		"item_id", item.ID,
+	)
+
+	return syncer.SyncProfile(ctx, payload.ProfileID)
+}
+
+// RegisterHandlers registers the profile resync queue handler.
+func (h *ProfileResyncHandler) RegisterHandlers(registry *events.HandlerRegistry) {
+	registry.Register(events.QueueItemTypeProfileSync, h.HandleProfileSync)
+}
+
+// syncPublicProfileLinks syncs a profile's managed links of a kind that need no
+// OAuth tokens, then creates stories from what was imported. A retryable
+// provider error stops the sync and extends the provider's backoff.
+func syncPublicProfileLinks( //nolint:funlen
+	ctx context.Context,
+	logger *logfx.Logger,
+	syncService *linksync.Service,
+	siteImporterService *siteimporter.Service,
+	storyProcessor StoryProcessor,
+	backoff *SyncBackoff,
+	kind string,
+	profileID string,
+	limit int,
+) error {
+	if backoff.Active(ctx) {
+		return fmt.Errorf("%w(kind: %s)", ErrProviderBackingOff, kind)
+	}
+
+	links, err := syncService.GetProfilePublicManagedLinks(ctx, kind, profileID, limit)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrProfileLinkSyncFailed, err)
+	}
+
+	var firstErr error
+
+	for _, link := range links {
+		result, syncErr := siteImporterService.SyncPublicLink(ctx, link)
+		if syncErr == nil {
+			syncErr = result.Error
+		}
+
+		recordLinkSyncAttempt(ctx, logger, syncService, link.ID, syncErr)
+
+		if syncErr == nil {
+			continue
+		}
+
+		logger.ErrorContext(ctx, "Failed to resync profile link",
+			slog.String("kind", kind),
+			slog.String("link_id", link.ID),
+			slog.String("profile_id", profileID),
+			slog.Any("error", syncErr))
+
+		if _, ok := linksync.AsRetryableProviderError(syncErr); ok {
+			backoff.Update(ctx, syncErr)
+
+			return fmt.Errorf("%w: %w", ErrProfileLinkSyncFailed, syncErr)
+		}
+
+		if firstErr == nil {
+			firstErr = syncErr
+		}
+	}
+
+	if storyProcessor != nil {
+		err := storyProcessor.ProcessStories(ctx)
+		if err != nil {
+			logger.ErrorContext(ctx, "Failed to process stories after profile resync",
+				slog.String("kind", kind),
+				slog.Any("error", err))
+		}
+	}
+
+	if firstErr != nil {
+		return fmt.Errorf("%w: %w", ErrProfileLinkSyncFailed, firstErr)
+	}
+
+	return nil
+}
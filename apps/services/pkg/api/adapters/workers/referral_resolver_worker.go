@@ -0,0 +1,143 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	profilesadapter "github.com/eser/aya.is/services/pkg/api/adapters/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/mailbox"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+)
+
+const lockIDReferralResolver int64 = 100017
+
+// ReferralResolverWorker resolves membership candidates (referrals and
+// applications) still in voting once their voting deadline passes. Approved
+// referrals are sent their membership invitation.
+type ReferralResolverWorker struct {
+	config         *ReferralResolverConfig
+	logger         *logfx.Logger
+	service        *profiles.Service
+	mailboxService *mailbox.Service
+	runtimeStates  *runtime_states.Service
+}
+
+// NewReferralResolverWorker creates a new referral resolver worker.
+func NewReferralResolverWorker(
+	config *ReferralResolverConfig,
+	logger *logfx.Logger,
+	service *profiles.Service,
+	mailboxService *mailbox.Service,
+	runtimeStates *runtime_states.Service,
+) *ReferralResolverWorker {
+	return &ReferralResolverWorker{
+		config:         config,
+		logger:         logger,
+		service:        service,
+		mailboxService: mailboxService,
+		runtimeStates:  runtimeStates,
+	}
+}
+
+// Name returns the worker name.
+func (w *ReferralResolverWorker) Name() string {
+	return "referral-resolver"
+}
+
+// Interval returns the check interval.
+func (w *ReferralResolverWorker) Interval() time.Duration {
+	return w.config.CheckInterval
+}
+
+// Execute resolves the candidates whose voting deadline has passed.
+func (w *ReferralResolverWorker) Execute(ctx context.Context) error {
+	// Check if worker is disabled by admin
+	disabledKey := "worker." + w.Name() + ".disabled"
+
+	disabled, err := w.runtimeStates.Get(ctx, disabledKey)
+	if err == nil && disabled == disabledStateValue {
+		return workerfx.ErrWorkerSkipped
+	}
+
+	// Try advisory lock to prevent concurrent execution
+	acquired, lockErr := w.runtimeStates.TryLock(ctx, lockIDReferralResolver)
+	if lockErr != nil {
+		w.logger.WarnContext(ctx, "Failed to acquire advisory lock for referral-resolver",
+			slog.Any("error", lockErr))
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	if !acquired {
+		w.logger.DebugContext(ctx, "Another instance is running referral-resolver worker")
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	defer func() {
+		releaseErr := w.runtimeStates.ReleaseLock(ctx, lockIDReferralResolver)
+		if releaseErr != nil {
+			w.logger.WarnContext(ctx, "Failed to release advisory lock for referral-resolver",
+				slog.String("error", releaseErr.Error()))
+		}
+	}()
+
+	now := time.Now()
+
+	resolutions, err := w.service.ResolveExpiredCandidates(ctx, now, w.config.BatchSize)
+
+	// Candidates resolved before a failure still need their invitations
+	approved := 0
+
+	for _, resolution := range resolutions {
+		if !resolution.Approved() {
+			continue
+		}
+
+		approved++
+
+		if resolution.Status != profiles.CandidateStatusInvitationPendingResponse ||
+			w.mailboxService == nil {
+			continue
+		}
+
+		sendErr := profilesadapter.SendCandidateInvitation(
+			ctx,
+			w.logger,
+			w.service,
+			w.mailboxService,
+			resolution.Candidate.ProfileSlug,
+			resolution.Candidate.ID,
+		)
+		if sendErr != nil {
+			w.logger.ErrorContext(ctx, "Failed to send candidate invitation",
+				slog.String("candidate_id", resolution.Candidate.ID),
+				slog.String("error", sendErr.Error()))
+		}
+	}
+
+	if len(resolutions) > 0 {
+		w.logger.InfoContext(ctx, "Resolved candidates past their voting deadline",
+			slog.Int("resolved", len(resolutions)),
+			slog.Int("approved", approved))
+	}
+
+	if err != nil {
+		return fmt.Errorf("resolving expired candidates: %w", err)
+	}
+
+	lastRunKey := "referrals.resolver.last_run_at"
+
+	setErr := w.runtimeStates.SetTime(ctx, lastRunKey, now)
+	if setErr != nil {
+		w.logger.WarnContext(ctx, "Failed to set last run time for referral-resolver",
+			slog.String("error", setErr.Error()))
+	}
+
+	return nil
+}
@@ -24,6 +24,7 @@ type SpeakerDeckSyncWorker struct {
 	siteImporterService *siteimporter.Service
 	storyProcessor      StoryProcessor
 	runtimeStates       *runtime_states.Service
+	backoff             *SyncBackoff
 	idGenerator         func() string
 }
 
@@ -37,6 +38,13 @@ func NewSpeakerDeckSyncWorker(
 	runtimeStates *runtime_states.Service,
 	idGenerator func() string,
 ) *SpeakerDeckSyncWorker {
+	backoff := NewSyncBackoff(
+		&config.Backoff,
+		logger,
+		runtimeStates,
+		"speakerdeck.sync.full_sync_worker.backoff",
+	)
+
 	return &SpeakerDeckSyncWorker{
 		config:              config,
 		logger:              logger,
@@ -44,6 +52,7 @@ func NewSpeakerDeckSyncWorker(
 		siteImporterService: siteImporterService,
 		storyProcessor:      storyProcessor,
 		runtimeStates:       runtimeStates,
+		backoff:             backoff,
 		idGenerator:         idGenerator,
 	}
 }
@@ -68,6 +77,11 @@ func (w *SpeakerDeckSyncWorker) Execute(ctx context.Context) error {
 		return workerfx.ErrWorkerSkipped
 	}
 
+	// Let a rate-limited or failing provider recover
+	if w.backoff.Active(ctx) {
+		return workerfx.ErrWorkerSkipped
+	}
+
 	// Check if it's time to run based on persisted schedule
 	nextRunKey := "speakerdeck.sync.full_sync_worker.next_run_at"
 
@@ -106,7 +120,25 @@ func (w *SpeakerDeckSyncWorker) Execute(ctx context.Context) error {
 			slog.String("error", setErr.Error()))
 	}
 
-	return w.executeSync(ctx)
+	syncErr := w.executeSync(ctx)
+	w.backoff.Update(ctx, syncErr)
+
+	return syncErr
+}
+
+// SyncProfile syncs a single profile's SpeakerDeck links on demand.
+func (w *SpeakerDeckSyncWorker) SyncProfile(ctx context.Context, profileID string) error {
+	return syncPublicProfileLinks(
+		ctx,
+		w.logger,
+		w.syncService,
+		w.siteImporterService,
+		w.storyProcessor,
+		w.backoff,
+		"speakerdeck",
+		profileID,
+		w.config.BatchSize,
+	)
 }
 
 // executeSync runs the actual sync cycle.
@@ -132,18 +164,31 @@ func (w *SpeakerDeckSyncWorker) executeSync(ctx context.Context) error {
 	for _, link := range links {
 		result, syncErr := w.siteImporterService.SyncPublicLink(ctx, link)
 		if syncErr != nil {
+			recordLinkSyncAttempt(ctx, w.logger, w.syncService, link.ID, syncErr)
+
 			w.logger.ErrorContext(ctx, "Failed to sync SpeakerDeck link",
 				slog.String("link_id", link.ID),
 				slog.String("profile_id", link.ProfileID),
 				slog.Any("error", syncErr))
 
+			// Stop hitting a rate-limited or failing provider
+			if _, ok := linksync.AsRetryableProviderError(syncErr); ok {
+				return fmt.Errorf("%w: %w", ErrSyncFailed, syncErr)
+			}
+
 			continue
 		}
 
+		recordLinkSyncAttempt(ctx, w.logger, w.syncService, link.ID, result.Error)
+
 		if result.Error != nil {
 			w.logger.ErrorContext(ctx, "SpeakerDeck sync returned error",
 				slog.String("link_id", link.ID),
 				slog.Any("error", result.Error))
+
+			if _, ok := linksync.AsRetryableProviderError(result.Error); ok {
+				return fmt.Errorf("%w: %w", ErrSyncFailed, result.Error)
+			}
 		} else {
 			w.logger.WarnContext(ctx, "Successfully synced SpeakerDeck link",
 				slog.String("link_id", link.ID),
@@ -0,0 +1,166 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"math/rand/v2"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+)
+
+// Defaults for a zero SyncBackoffConfig.
+const (
+	DefaultSyncBackoffBaseDelay = time.Minute
+	DefaultSyncBackoffMaxDelay  = 6 * time.Hour
+)
+
+// syncBackoffState is persisted in runtime state, so a restart doesn't reset
+// an ongoing backoff.
+type syncBackoffState struct {
+	Until    time.Time `json:"until"`
+	Failures int       `json:"failures"`
+}
+
+// SyncBackoff keeps a sync worker away from a provider that rate-limits it or
+// fails. Consecutive failures double the delay with random jitter, so workers
+// across instances don't retry in lockstep; a provider's Retry-After hint is
+// honored when it asks for longer. A successful cycle resets the backoff.
+type SyncBackoff struct {
+	config        *SyncBackoffConfig
+	logger        *logfx.Logger
+	runtimeStates *runtime_states.Service
+	key           string
+}
+
+// NewSyncBackoff creates a backoff persisted under the given runtime state key.
+func NewSyncBackoff(
+	config *SyncBackoffConfig,
+	logger *logfx.Logger,
+	runtimeStates *runtime_states.Service,
+	key string,
+) *SyncBackoff {
+	return &SyncBackoff{
+		config:        config,
+		logger:        logger,
+		runtimeStates: runtimeStates,
+		key:           key,
+	}
+}
+
+// Active reports whether the worker should skip its cycle to let the provider
+// recover.
+func (b *SyncBackoff) Active(ctx context.Context) bool {
+	state := b.load(ctx)
+
+	return state != nil && time.Now().Before(state.Until)
+}
+
+// Update records the outcome of a sync cycle and returns the delay before the
+// next attempt. Retryable provider errors extend the backoff and a successful
+// cycle resets it; other errors leave it as is, as they don't mean the
+// provider is struggling.
+func (b *SyncBackoff) Update(ctx context.Context, cycleErr error) time.Duration {
+	if cycleErr == nil {
+		if b.load(ctx) != nil {
+			b.reset(ctx)
+		}
+
+		return 0
+	}
+
+	providerErr, ok := linksync.AsRetryableProviderError(cycleErr)
+	if !ok {
+		return 0
+	}
+
+	failures := 1
+	if state := b.load(ctx); state != nil {
+		failures = state.Failures + 1
+	}
+
+	delay := max(b.delay(failures, rand.Float64()), providerErr.RetryAfter) //nolint:gosec
+
+	b.save(ctx, &syncBackoffState{
+		Until:    time.Now().Add(delay),
+		Failures: failures,
+	})
+
+	b.logger.WarnContext(ctx, "Backing off sync after provider error",
+		slog.String("key", b.key),
+		slog.Int("status", providerErr.StatusCode),
+		slog.Int("failures", failures),
+		slog.Duration("delay", delay))
+
+	return delay
+}
+
+// delay computes the backoff for the given number of consecutive failures.
+// random is in [0, 1) and spreads the delay by ±Jitter.
+func (b *SyncBackoff) delay(failures int, random float64) time.Duration {
+	baseDelay := DefaultSyncBackoffBaseDelay
+	maxDelay := DefaultSyncBackoffMaxDelay
+	jitter := 0.0
+
+	if b.config != nil {
+		if b.config.BaseDelay > 0 {
+			baseDelay = b.config.BaseDelay
+		}
+
+		if b.config.MaxDelay > 0 {
+			maxDelay = b.config.MaxDelay
+		}
+
+		jitter = min(max(b.config.Jitter, 0), 1)
+	}
+
+	delay := baseDelay
+	for i := 1; i < failures && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	delay = min(delay, maxDelay)
+
+	return time.Duration(float64(delay) * (1 + jitter*(2*random-1)))
+}
+
+func (b *SyncBackoff) load(ctx context.Context) *syncBackoffState {
+	value, err := b.runtimeStates.Get(ctx, b.key)
+	if err != nil {
+		return nil
+	}
+
+	var state syncBackoffState
+
+	err = json.Unmarshal([]byte(value), &state)
+	if err != nil {
+		return nil
+	}
+
+	return &state
+}
+
+func (b *SyncBackoff) save(ctx context.Context, state *syncBackoffState) {
+	value, err := json.Marshal(state)
+	if err == nil {
+		err = b.runtimeStates.Set(ctx, b.key, string(value))
+	}
+
+	if err != nil {
+		b.logger.WarnContext(ctx, "failed to save sync backoff",
+			slog.String("key", b.key),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (b *SyncBackoff) reset(ctx context.Context) {
+	err := b.runtimeStates.Remove(ctx, b.key)
+	if err != nil {
+		b.logger.WarnContext(ctx, "failed to reset sync backoff",
+			slog.String("key", b.key),
+			slog.String("error", err.Error()))
+	}
+}
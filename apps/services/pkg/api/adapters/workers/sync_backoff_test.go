@@ -0,0 +1,123 @@
+package workers_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+	"github.com/stretchr/testify/assert"
+)
+
+var errProviderUnavailable = &linksync.ProviderError{
+	Err:        errors.New("service unavailable"),
+	StatusCode: http.StatusServiceUnavailable,
+	RetryAfter: 0,
+}
+
+func newSyncBackoff() *workers.SyncBackoff {
+	stateRepo := &memoryRuntimeStateRepository{states: map[string]string{}} //nolint:exhaustruct
+
+	return workers.NewSyncBackoff(
+		&workers.SyncBackoffConfig{
+			BaseDelay: time.Minute,
+			MaxDelay:  time.Hour,
+			Jitter:    0.2,
+		},
+		logfx.NewLogger(),
+		runtime_states.NewService(nil, stateRepo),
+		"test.sync.backoff",
+	)
+}
+
+func TestSyncBackoff_ConsecutiveFailuresIncreaseDelay(t *testing.T) {
+	t.Parallel()
+
+	backoff := newSyncBackoff()
+
+	var previous time.Duration
+
+	for i, nominal := range []time.Duration{
+		time.Minute, 2 * time.Minute, 4 * time.Minute, 8 * time.Minute,
+	} {
+		delay := backoff.Update(t.Context(), errProviderUnavailable)
+
+		assert.Greater(t, delay, previous, "failure %d", i+1)
+		assert.InDelta(t, float64(nominal), float64(delay), 0.2*float64(nominal), "failure %d", i+1)
+
+		previous = delay
+	}
+
+	assert.True(t, backoff.Active(t.Context()))
+
+	// The delay stops growing at MaxDelay.
+	for range 5 {
+		backoff.Update(t.Context(), errProviderUnavailable)
+	}
+
+	delay := backoff.Update(t.Context(), errProviderUnavailable)
+	assert.LessOrEqual(t, delay, time.Hour+12*time.Minute)
+}
+
+func TestSyncBackoff_Jitter(t *testing.T) {
+	t.Parallel()
+
+	delays := map[time.Duration]bool{}
+
+	for range 10 {
+		delays[newSyncBackoff().Update(t.Context(), errProviderUnavailable)] = true
+	}
+
+	assert.Greater(t, len(delays), 1, "workers failing together should not retry in lockstep")
+}
+
+func TestSyncBackoff_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("honors a longer Retry-After", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := newSyncBackoff()
+		header := http.Header{"Retry-After": []string{"7200"}}
+		err := linksync.NewProviderError(
+			errors.New("rate limited"),
+			http.StatusTooManyRequests,
+			header,
+		)
+
+		assert.Equal(t, 2*time.Hour, backoff.Update(t.Context(), err))
+	})
+
+	t.Run("ignores errors the provider is not to blame for", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := newSyncBackoff()
+		notFound := &linksync.ProviderError{
+			Err:        errors.New("not found"),
+			StatusCode: http.StatusNotFound,
+			RetryAfter: 0,
+		}
+
+		assert.Zero(t, backoff.Update(t.Context(), notFound))
+		assert.Zero(t, backoff.Update(t.Context(), errors.New("database is down")))
+		assert.False(t, backoff.Active(t.Context()))
+	})
+
+	t.Run("resets on success", func(t *testing.T) {
+		t.Parallel()
+
+		backoff := newSyncBackoff()
+		backoff.Update(t.Context(), errProviderUnavailable)
+		backoff.Update(t.Context(), errProviderUnavailable)
+
+		backoff.Update(t.Context(), nil)
+		assert.False(t, backoff.Active(t.Context()))
+
+		delay := backoff.Update(t.Context(), errProviderUnavailable)
+		assert.InDelta(t, float64(time.Minute), float64(delay), 0.2*float64(time.Minute))
+	})
+}
@@ -0,0 +1,76 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+)
+
+var ErrMissingMembershipEvent = errors.New("membership webhook item has no event_type or membership_id")
+
+// WebhookMembershipHandler sends audited membership events to the webhook
+// endpoints of their profile, one queue item per event.
+type WebhookMembershipHandler struct {
+	logger         *logfx.Logger
+	webhookService *webhooks.Service
+}
+
+// NewWebhookMembershipHandler creates a new membership webhook handler.
+func NewWebhookMembershipHandler(
+	logger *logfx.Logger,
+	webhookService *webhooks.Service,
+) *WebhookMembershipHandler {
+	return &WebhookMembershipHandler{
+		logger:         logger,
+		webhookService: webhookService,
+	}
+}
+
+// HandleWebhookMembership handles the WEBHOOK_MEMBERSHIP item. Deliveries that
+// still fail after their retries stay failed in the delivery log for
+// redelivery; the item is not retried for them, so endpoints that did receive
+// the event don't get it twice.
+func (h *WebhookMembershipHandler) HandleWebhookMembership(
+	ctx context.Context,
+	item *events.QueueItem,
+) error {
+	var task webhooks.MembershipEventTask
+
+	payloadBytes, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshalPayload, err)
+	}
+
+	err = json.Unmarshal(payloadBytes, &task)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalPayload, err)
+	}
+
+	if task.EventType == "" || task.MembershipID == "" {
+		return ErrMissingMembershipEvent
+	}
+
+	err = h.webhookService.DispatchMembershipEvent(ctx, task.AuditParams())
+	if errors.Is(err, webhooks.ErrDeliveryFailed) {
+		h.logger.WarnContext(ctx, "Failed to deliver membership webhook",
+			slog.String("event_type", task.EventType),
+			slog.String("membership_id", task.MembershipID),
+			slog.String("item_id", item.ID),
+			slog.String("error", err.Error()))
+
+		return nil
+	}
+
+	return err //nolint:wrapcheck
+}
+
+// RegisterHandlers registers the membership webhook queue handler.
+func (h *WebhookMembershipHandler) RegisterHandlers(registry *events.HandlerRegistry) {
+	registry.Register(events.QueueItemTypeWebhookMembership, h.HandleWebhookMembership)
+}
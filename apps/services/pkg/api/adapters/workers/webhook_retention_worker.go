@@ -0,0 +1,94 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+)
+
+const lockIDWebhookRetention int64 = 100014
+
+// WebhookRetentionWorker periodically purges webhook deliveries older than the retention period.
+type WebhookRetentionWorker struct {
+	config        *WebhookRetentionConfig
+	logger        *logfx.Logger
+	service       *webhooks.Service
+	runtimeStates *runtime_states.Service
+}
+
+// NewWebhookRetentionWorker creates a new webhook retention worker.
+func NewWebhookRetentionWorker(
+	config *WebhookRetentionConfig,
+	logger *logfx.Logger,
+	service *webhooks.Service,
+	runtimeStates *runtime_states.Service,
+) *WebhookRetentionWorker {
+	return &WebhookRetentionWorker{
+		config:        config,
+		logger:        logger,
+		service:       service,
+		runtimeStates: runtimeStates,
+	}
+}
+
+// Name returns the worker name.
+func (w *WebhookRetentionWorker) Name() string {
+	return "webhook-retention"
+}
+
+// Interval returns the check interval.
+func (w *WebhookRetentionWorker) Interval() time.Duration {
+	return w.config.CheckInterval
+}
+
+// Execute purges expired webhook deliveries.
+func (w *WebhookRetentionWorker) Execute(ctx context.Context) error {
+	// Check if worker is disabled by admin
+	disabledKey := "worker." + w.Name() + ".disabled"
+
+	disabled, err := w.runtimeStates.Get(ctx, disabledKey)
+	if err == nil && disabled == disabledStateValue {
+		return workerfx.ErrWorkerSkipped
+	}
+
+	// Try advisory lock to prevent concurrent execution
+	acquired, lockErr := w.runtimeStates.TryLock(ctx, lockIDWebhookRetention)
+	if lockErr != nil {
+		w.logger.WarnContext(ctx, "Failed to acquire advisory lock for webhook-retention",
+			slog.Any("error", lockErr))
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	if !acquired {
+		w.logger.DebugContext(ctx, "Another instance is running webhook-retention worker")
+
+		return workerfx.ErrWorkerSkipped
+	}
+
+	defer func() {
+		releaseErr := w.runtimeStates.ReleaseLock(ctx, lockIDWebhookRetention)
+		if releaseErr != nil {
+			w.logger.WarnContext(ctx, "Failed to release advisory lock for webhook-retention",
+				slog.String("error", releaseErr.Error()))
+		}
+	}()
+
+	deleted, err := w.service.PurgeExpiredDeliveries(ctx)
+	if err != nil {
+		return fmt.Errorf("purging webhook deliveries: %w", err)
+	}
+
+	if deleted > 0 {
+		w.logger.InfoContext(ctx, "Purged expired webhook deliveries",
+			slog.Int64("deleted", deleted))
+	}
+
+	return nil
+}
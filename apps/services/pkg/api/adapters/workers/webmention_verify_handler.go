@@ -0,0 +1,79 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
+)
+
+var ErrMissingWebmentionID = errors.New("webmention item has no webmention_id")
+
+// WebmentionVerifyHandler verifies received webmentions.
+type WebmentionVerifyHandler struct {
+	logger             *logfx.Logger
+	webmentionsService *webmentions.Service
+}
+
+// NewWebmentionVerifyHandler creates a new webmention verification handler.
+func NewWebmentionVerifyHandler(
+	logger *logfx.Logger,
+	webmentionsService *webmentions.Service,
+) *WebmentionVerifyHandler {
+	return &WebmentionVerifyHandler{
+		logger:             logger,
+		webmentionsService: webmentionsService,
+	}
+}
+
+// HandleWebmentionVerify handles the WEBMENTION_VERIFY item.
+func (h *WebmentionVerifyHandler) HandleWebmentionVerify(
+	ctx context.Context,
+	item *events.QueueItem,
+) error {
+	var payload struct {
+		WebmentionID string `json:"webmention_id"`
+	}
+
+	payloadBytes, err := json.Marshal(item.Payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMarshalPayload, err)
+	}
+
+	err = json.Unmarshal(payloadBytes, &payload)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrUnmarshalPayload, err)
+	}
+
+	if payload.WebmentionID == "" {
+		return ErrMissingWebmentionID
+	}
+
+	mention, err := h.webmentionsService.Verify(ctx, payload.WebmentionID)
+	if errors.Is(err, webmentions.ErrWebmentionNotFound) {
+		// Nothing left to verify
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	h.logger.Info(
+		"Verified webmention",
+		"webmention_id", mention.ID,
+		"status", string(mention.Status),
+		"item_id", item.ID,
+	)
+
+	return nil
+}
+
+// RegisterHandlers registers the webmention verification queue handler.
+func (h *WebmentionVerifyHandler) RegisterHandlers(registry *events.HandlerRegistry) {
+	registry.Register(events.QueueItemTypeWebmentionVerify, h.HandleWebmentionVerify)
+}
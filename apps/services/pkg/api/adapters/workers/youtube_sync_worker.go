@@ -2,6 +2,7 @@ package workers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -58,6 +59,7 @@ type YouTubeSyncWorker struct {
 	idGenerator    func() string
 	runtimeStates  *runtime_states.Service
 	storyProcessor StoryProcessor
+	backoff        *SyncBackoff
 	mode           SyncMode
 	syncInterval   time.Duration
 	lockID         int64
@@ -73,6 +75,13 @@ func NewYouTubeFullSyncWorker(
 	runtimeStates *runtime_states.Service,
 	storyProcessor StoryProcessor,
 ) *YouTubeSyncWorker {
+	backoff := NewSyncBackoff(
+		&config.Backoff,
+		logger,
+		runtimeStates,
+		"youtube.sync.full_sync_worker.backoff",
+	)
+
 	return &YouTubeSyncWorker{
 		config:         config,
 		logger:         logger,
@@ -81,6 +90,7 @@ func NewYouTubeFullSyncWorker(
 		idGenerator:    idGenerator,
 		runtimeStates:  runtimeStates,
 		storyProcessor: storyProcessor,
+		backoff:        backoff,
 		mode:           SyncModeFull,
 		syncInterval:   config.FullSyncInterval,
 		lockID:         lockIDYouTubeFullSync,
@@ -97,6 +107,13 @@ func NewYouTubeIncrementalSyncWorker(
 	runtimeStates *runtime_states.Service,
 	storyProcessor StoryProcessor,
 ) *YouTubeSyncWorker {
+	backoff := NewSyncBackoff(
+		&config.Backoff,
+		logger,
+		runtimeStates,
+		"youtube.sync.incremental_sync_worker.backoff",
+	)
+
 	return &YouTubeSyncWorker{
 		config:         config,
 		logger:         logger,
@@ -105,6 +122,7 @@ func NewYouTubeIncrementalSyncWorker(
 		idGenerator:    idGenerator,
 		runtimeStates:  runtimeStates,
 		storyProcessor: storyProcessor,
+		backoff:        backoff,
 		mode:           SyncModeIncremental,
 		syncInterval:   config.IncrementalSyncInterval,
 		lockID:         lockIDYouTubeIncrementalSync,
@@ -131,11 +149,17 @@ func (w *YouTubeSyncWorker) Execute(ctx context.Context) error {
 		return workerfx.ErrWorkerSkipped
 	}
 
-	// Check if it's time to run based on persisted schedule
+	// Let a rate-limited or failing provider recover
+	if w.backoff.Active(ctx) {
+		return workerfx.ErrWorkerSkipped
+	}
+
+	// Check if it's time to run based on persisted schedule. An interrupted
+	// full sync resumes right away instead of waiting for its next slot.
 	nextRunKey := w.stateKey("next_run_at")
 
 	nextRunAt, err := w.runtimeStates.GetTime(ctx, nextRunKey)
-	if err == nil && time.Now().Before(nextRunAt) {
+	if err == nil && time.Now().Before(nextRunAt) && !w.hasCheckpoint(ctx) {
 		return workerfx.ErrWorkerSkipped
 	}
 	// If ErrStateNotFound or ErrInvalidTime, proceed (first run or corrupted state)
@@ -174,15 +198,81 @@ func (w *YouTubeSyncWorker) Execute(ctx context.Context) error {
 			slog.String("error", setErr.Error()))
 	}
 
-	return w.executeSync(ctx)
+	syncErr := w.executeSync(ctx)
+	w.backoff.Update(ctx, syncErr)
+
+	return syncErr
 }
 
 func (w *YouTubeSyncWorker) stateKey(suffix string) string {
 	return "youtube.sync." + string(w.mode) + "_sync_worker." + suffix
 }
 
-// executeSync runs the actual sync cycle.
-func (w *YouTubeSyncWorker) executeSync(ctx context.Context) error {
+// youtubeSyncCheckpoint records the progress of a full sync, so a run that is
+// interrupted (e.g. by a deploy) resumes with the links it has not finished
+// instead of fetching every channel again.
+type youtubeSyncCheckpoint struct {
+	StartedAt        time.Time `json:"started_at"`
+	CompletedLinkIDs []string  `json:"completed_link_ids"`
+}
+
+// hasCheckpoint reports whether an unfinished full sync is waiting to resume.
+func (w *YouTubeSyncWorker) hasCheckpoint(ctx context.Context) bool {
+	return w.loadCheckpoint(ctx) != nil
+}
+
+// loadCheckpoint returns the checkpoint of an unfinished full sync, or nil.
+// Incremental syncs are cheap and always start over.
+func (w *YouTubeSyncWorker) loadCheckpoint(ctx context.Context) *youtubeSyncCheckpoint {
+	if w.mode != SyncModeFull {
+		return nil
+	}
+
+	value, err := w.runtimeStates.Get(ctx, w.stateKey("checkpoint"))
+	if err != nil {
+		return nil
+	}
+
+	var checkpoint youtubeSyncCheckpoint
+
+	err = json.Unmarshal([]byte(value), &checkpoint)
+	if err != nil {
+		w.logger.WarnContext(ctx, "Ignoring unreadable YouTube sync checkpoint",
+			slog.String("mode", string(w.mode)),
+			slog.Any("error", err))
+
+		return nil
+	}
+
+	return &checkpoint
+}
+
+func (w *YouTubeSyncWorker) saveCheckpoint(ctx context.Context, checkpoint *youtubeSyncCheckpoint) {
+	value, err := json.Marshal(checkpoint)
+	if err == nil {
+		err = w.runtimeStates.Set(ctx, w.stateKey("checkpoint"), string(value))
+	}
+
+	if err != nil {
+		w.logger.WarnContext(ctx, "failed to save sync checkpoint",
+			slog.String("mode", string(w.mode)),
+			slog.String("error", err.Error()))
+	}
+}
+
+func (w *YouTubeSyncWorker) clearCheckpoint(ctx context.Context) {
+	err := w.runtimeStates.Remove(ctx, w.stateKey("checkpoint"))
+	if err != nil {
+		w.logger.WarnContext(ctx, "failed to clear sync checkpoint",
+			slog.String("mode", string(w.mode)),
+			slog.String("error", err.Error()))
+	}
+}
+
+// executeSync runs the actual sync cycle. A full sync checkpoints every link it
+// finishes and clears the checkpoint once the cycle completes; links that fail
+// are not checkpointed, so a resumed run retries them.
+func (w *YouTubeSyncWorker) executeSync(ctx context.Context) error { //nolint:cyclop,funlen
 	w.logger.WarnContext(ctx, "Starting YouTube sync cycle",
 		slog.String("mode", string(w.mode)))
 
@@ -195,23 +285,63 @@ func (w *YouTubeSyncWorker) executeSync(ctx context.Context) error {
 	if len(links) == 0 {
 		w.logger.WarnContext(ctx, "No YouTube links to sync")
 
+		if w.mode == SyncModeFull {
+			w.clearCheckpoint(ctx)
+		}
+
 		return nil
 	}
 
+	checkpoint := w.loadCheckpoint(ctx)
+	completed := make(map[string]bool)
+
+	if checkpoint != nil {
+		for _, linkID := range checkpoint.CompletedLinkIDs {
+			completed[linkID] = true
+		}
+
+		w.logger.WarnContext(ctx, "Resuming YouTube sync from checkpoint",
+			slog.String("mode", string(w.mode)),
+			slog.Time("started_at", checkpoint.StartedAt),
+			slog.Int("completed", len(completed)))
+	} else if w.mode == SyncModeFull {
+		checkpoint = &youtubeSyncCheckpoint{
+			StartedAt:        time.Now(),
+			CompletedLinkIDs: []string{},
+		}
+	}
+
 	w.logger.WarnContext(ctx, "Processing YouTube links",
 		slog.String("mode", string(w.mode)),
 		slog.Int("count", len(links)))
 
 	// Process each link (isolated errors - don't fail the whole batch)
 	for _, link := range links {
+		if completed[link.ID] {
+			continue
+		}
+
+		// Stop on shutdown; the checkpoint lets the next run pick up from here
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
 		result := w.syncLink(ctx, link)
 
+		recordLinkSyncAttempt(ctx, w.logger, w.syncService, link.ID, result.Error)
+
 		if result.Error != nil {
 			w.logger.ErrorContext(ctx, "Failed to sync YouTube link",
 				slog.String("link_id", link.ID),
 				slog.String("profile_id", link.ProfileID),
 				slog.String("mode", string(w.mode)),
 				slog.Any("error", result.Error))
+
+			// Stop hitting a rate-limited or failing provider; the checkpoint
+			// keeps the finished links of a full sync for the next attempt
+			if _, ok := linksync.AsRetryableProviderError(result.Error); ok {
+				return fmt.Errorf("%w: %w", ErrSyncFailed, result.Error)
+			}
 		} else {
 			w.logger.WarnContext(ctx, "Successfully synced YouTube link",
 				slog.String("link_id", link.ID),
@@ -219,9 +349,18 @@ func (w *YouTubeSyncWorker) executeSync(ctx context.Context) error {
 				slog.Int("added", result.ItemsAdded),
 				slog.Int("updated", result.ItemsUpdated),
 				slog.Int("deleted", result.ItemsDeleted))
+
+			if checkpoint != nil {
+				checkpoint.CompletedLinkIDs = append(checkpoint.CompletedLinkIDs, link.ID)
+				w.saveCheckpoint(ctx, checkpoint)
+			}
 		}
 	}
 
+	if checkpoint != nil {
+		w.clearCheckpoint(ctx)
+	}
+
 	w.logger.WarnContext(ctx, "Completed YouTube sync cycle",
 		slog.String("mode", string(w.mode)),
 		slog.Int("links_processed", len(links)))
@@ -239,6 +378,62 @@ func (w *YouTubeSyncWorker) executeSync(ctx context.Context) error {
 	return nil
 }
 
+// SyncProfile syncs a single profile's YouTube links on demand. A retryable
+// provider error stops the sync and extends the provider's backoff.
+func (w *YouTubeSyncWorker) SyncProfile(ctx context.Context, profileID string) error {
+	if w.backoff.Active(ctx) {
+		return fmt.Errorf("%w(kind: youtube)", ErrProviderBackingOff)
+	}
+
+	links, err := w.syncService.GetProfileManagedLinks(ctx, "youtube", profileID, w.config.BatchSize)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrProfileLinkSyncFailed, err)
+	}
+
+	var firstErr error
+
+	for _, link := range links {
+		result := w.syncLink(ctx, link)
+
+		recordLinkSyncAttempt(ctx, w.logger, w.syncService, link.ID, result.Error)
+
+		if result.Error == nil {
+			continue
+		}
+
+		w.logger.ErrorContext(ctx, "Failed to resync YouTube link",
+			slog.String("link_id", link.ID),
+			slog.String("profile_id", profileID),
+			slog.String("mode", string(w.mode)),
+			slog.Any("error", result.Error))
+
+		if _, ok := linksync.AsRetryableProviderError(result.Error); ok {
+			w.backoff.Update(ctx, result.Error)
+
+			return fmt.Errorf("%w: %w", ErrProfileLinkSyncFailed, result.Error)
+		}
+
+		if firstErr == nil {
+			firstErr = result.Error
+		}
+	}
+
+	if w.storyProcessor != nil {
+		err := w.storyProcessor.ProcessStories(ctx)
+		if err != nil {
+			w.logger.ErrorContext(ctx, "Failed to process stories after profile resync",
+				slog.String("mode", string(w.mode)),
+				slog.Any("error", err))
+		}
+	}
+
+	if firstErr != nil {
+		return fmt.Errorf("%w: %w", ErrProfileLinkSyncFailed, firstErr)
+	}
+
+	return nil
+}
+
 // syncLink syncs a single YouTube link.
 func (w *YouTubeSyncWorker) syncLink( //nolint:cyclop,funlen
 	ctx context.Context,
@@ -0,0 +1,212 @@
+package workers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/ajan/workerfx"
+	"github.com/eser/aya.is/services/pkg/api/adapters/workers"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/eser/aya.is/services/pkg/api/business/runtime_states"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// managedLinkRepository serves a fixed list of managed YouTube links, accepts
+// every import and records the sync status written for each link.
+type managedLinkRepository struct {
+	linksync.Repository
+
+	links        []*linksync.ManagedLink
+	syncStatuses map[string]string
+	syncErrors   map[string]*string
+}
+
+func newManagedLinkRepository() *managedLinkRepository {
+	return &managedLinkRepository{
+		links: []*linksync.ManagedLink{
+			{ID: "link-1", RemoteID: "channel-1", AuthAccessToken: "token"}, //nolint:exhaustruct
+			{ID: "link-2", RemoteID: "channel-2", AuthAccessToken: "token"}, //nolint:exhaustruct
+			{ID: "link-3", RemoteID: "channel-3", AuthAccessToken: "token"}, //nolint:exhaustruct
+		},
+		syncStatuses: map[string]string{},
+		syncErrors:   map[string]*string{},
+	}
+}
+
+func (r *managedLinkRepository) ListManagedLinksForKind(
+	_ context.Context,
+	_ string,
+	profileID *string,
+	_ int,
+) ([]*linksync.ManagedLink, error) {
+	if profileID == nil {
+		return r.links, nil
+	}
+
+	links := make([]*linksync.ManagedLink, 0)
+
+	for _, link := range r.links {
+		if link.ProfileID == *profileID {
+			links = append(links, link)
+		}
+	}
+
+	return links, nil
+}
+
+func (r *managedLinkRepository) GetLinkImportByRemoteID(
+	_ context.Context,
+	_ string,
+	_ string,
+) (*linksync.LinkImport, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func (r *managedLinkRepository) CreateLinkImport(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ map[string]any,
+) error {
+	return nil
+}
+
+func (r *managedLinkRepository) MarkLinkImportsDeletedExcept(
+	_ context.Context,
+	_ string,
+	_ []string,
+) (int64, error) {
+	return 0, nil
+}
+
+func (r *managedLinkRepository) UpdateLinkSyncStatus(
+	_ context.Context,
+	linkID string,
+	status string,
+	syncError *string,
+) error {
+	r.syncStatuses[linkID] = status
+	r.syncErrors[linkID] = syncError
+
+	return nil
+}
+
+// channelFetcher records the channels it fetches. When interruptAt is fetched
+// it cancels the run, the way a deploy would stop the worker mid-sync; failAt
+// fails like a channel whose access was revoked.
+var errAccessRevoked = errors.New("youtube: access revoked")
+
+type channelFetcher struct {
+	workers.RemoteStoryFetcher
+
+	fetched     []string
+	interruptAt string
+	interrupt   context.CancelFunc
+	failAt      string
+}
+
+func (f *channelFetcher) FetchRemoteStories(
+	ctx context.Context,
+	_ string,
+	remoteSourceID string,
+	_ *time.Time,
+	_ int,
+) ([]*linksync.RemoteStoryItem, error) {
+	f.fetched = append(f.fetched, remoteSourceID)
+
+	if remoteSourceID == f.interruptAt && f.interrupt != nil {
+		f.interrupt()
+
+		return nil, ctx.Err()
+	}
+
+	if remoteSourceID == f.failAt {
+		return nil, errAccessRevoked
+	}
+
+	return []*linksync.RemoteStoryItem{
+		{RemoteID: remoteSourceID + "-video"}, //nolint:exhaustruct
+	}, nil
+}
+
+func newYouTubeFullSyncWorker(
+	fetcher *channelFetcher,
+	runtimeStates *runtime_states.Service,
+	linkRepo *managedLinkRepository,
+) *workers.YouTubeSyncWorker {
+	idGenerator := func() string { return "import" }
+
+	return workers.NewYouTubeFullSyncWorker(
+		&workers.YouTubeSyncConfig{ //nolint:exhaustruct
+			FullSyncInterval:   6 * time.Hour,
+			BatchSize:          10,
+			FullSyncMaxStories: 100,
+		},
+		logfx.NewLogger(),
+		linksync.NewService(logfx.NewLogger(), linkRepo, nil, idGenerator),
+		fetcher,
+		idGenerator,
+		runtimeStates,
+		nil,
+	)
+}
+
+func TestYouTubeFullSyncWorker_ResumesFromCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	stateRepo := &memoryRuntimeStateRepository{states: map[string]string{}} //nolint:exhaustruct
+	runtimeStates := runtime_states.NewService(nil, stateRepo)
+
+	// The first run is interrupted while syncing the second channel.
+	runCtx, cancel := context.WithCancel(t.Context())
+	firstFetcher := &channelFetcher{interruptAt: "channel-2", interrupt: cancel} //nolint:exhaustruct
+
+	err := newYouTubeFullSyncWorker(firstFetcher, runtimeStates, newManagedLinkRepository()).Execute(runCtx)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"channel-1", "channel-2"}, firstFetcher.fetched)
+	assert.Contains(t, stateRepo.states, "youtube.sync.full_sync_worker.checkpoint")
+
+	// A restarted worker resumes right away, though the next full sync is hours
+	// away, and only fetches the channels that were not finished.
+	secondFetcher := &channelFetcher{} //nolint:exhaustruct
+
+	err = newYouTubeFullSyncWorker(secondFetcher, runtimeStates, newManagedLinkRepository()).Execute(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"channel-2", "channel-3"}, secondFetcher.fetched)
+	assert.NotContains(t, stateRepo.states, "youtube.sync.full_sync_worker.checkpoint")
+
+	// Once completed, the worker waits for its schedule again.
+	thirdFetcher := &channelFetcher{} //nolint:exhaustruct
+
+	err = newYouTubeFullSyncWorker(thirdFetcher, runtimeStates, newManagedLinkRepository()).Execute(t.Context())
+	require.ErrorIs(t, err, workerfx.ErrWorkerSkipped)
+	assert.Empty(t, thirdFetcher.fetched)
+}
+
+func TestYouTubeFullSyncWorker_RecordsSyncStatus(t *testing.T) {
+	t.Parallel()
+
+	stateRepo := &memoryRuntimeStateRepository{states: map[string]string{}} //nolint:exhaustruct
+	linkRepo := newManagedLinkRepository()
+	fetcher := &channelFetcher{failAt: "channel-2"} //nolint:exhaustruct
+
+	worker := newYouTubeFullSyncWorker(fetcher, runtime_states.NewService(nil, stateRepo), linkRepo)
+
+	err := worker.Execute(t.Context())
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"link-1": linksync.SyncStatusSucceeded,
+		"link-2": linksync.SyncStatusFailed,
+		"link-3": linksync.SyncStatusSucceeded,
+	}, linkRepo.syncStatuses)
+
+	assert.Nil(t, linkRepo.syncErrors["link-1"])
+	require.NotNil(t, linkRepo.syncErrors["link-2"])
+	assert.Contains(t, *linkRepo.syncErrors["link-2"], errAccessRevoked.Error())
+}
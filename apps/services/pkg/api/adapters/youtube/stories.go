@@ -174,7 +174,11 @@ func (p *Provider) getUploadsPlaylistID(
 			slog.Int("status", resp.StatusCode),
 			slog.String("response", string(body)))
 
-		return "", fmt.Errorf("%w: status %d", ErrFailedToFetchVideos, resp.StatusCode)
+		return "", linksync.NewProviderError(
+			fmt.Errorf("%w: status %d", ErrFailedToFetchVideos, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	var channelResp struct {
@@ -250,7 +254,11 @@ func (p *Provider) fetchPlaylistVideos( //nolint:cyclop,funlen // paginated API
 				slog.Int("status", resp.StatusCode),
 				slog.String("response", string(body)))
 
-			return nil, fmt.Errorf("%w: status %d", ErrFailedToFetchVideos, resp.StatusCode)
+			return nil, linksync.NewProviderError(
+				fmt.Errorf("%w: status %d", ErrFailedToFetchVideos, resp.StatusCode),
+				resp.StatusCode,
+				resp.Header,
+			)
 		}
 
 		var playlistResp playlistItemsResponse
@@ -470,7 +478,11 @@ func (p *Provider) fetchVideoBatch(
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("%w: status %d", ErrFailedToFetchVideos, resp.StatusCode)
+		return nil, linksync.NewProviderError(
+			fmt.Errorf("%w: status %d", ErrFailedToFetchVideos, resp.StatusCode),
+			resp.StatusCode,
+			resp.Header,
+		)
 	}
 
 	var videosResp struct {
@@ -136,7 +136,8 @@ func (s *Service) isAllowedRedirectOrigin(ctx context.Context, origin string) bo
 			return false
 		}
 
-		domain := strings.TrimPrefix(parsed.Hostname(), "www.")
+		// The checker resolves a www host to its apex domain itself
+		domain := parsed.Hostname()
 		if domain != "" {
 			return s.customDomainChecker(ctx, domain)
 		}
@@ -359,6 +360,8 @@ func (s *Service) createSessionAndToken(
 		OAuthProvider:            &oauthProvider,
 		OAuthAccessToken:         &accountInfo.AccessToken,
 		OAuthTokenScope:          &accountInfo.Scope,
+		ImpersonatorUserID:       nil,
+		ImpersonatorSessionID:    nil,
 	}
 
 	s.logger.DebugContext(ctx, "Creating session",
@@ -457,6 +460,22 @@ func (s *Service) GenerateSessionToken(sessionID string) (string, time.Time, err
 	return tokenString, expiresAt, nil
 }
 
+// GenerateSessionTokenUntil creates a JWT token for a given session that expires
+// at the given time. Used for short-lived sessions such as admin impersonation.
+func (s *Service) GenerateSessionTokenUntil(sessionID string, expiresAt time.Time) (string, error) {
+	claims := &JWTClaims{
+		SessionID: sessionID,
+		ExpiresAt: expiresAt.Unix(),
+	}
+
+	tokenString, err := s.tokenService.GenerateToken(claims)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToGenerateToken, err)
+	}
+
+	return tokenString, nil
+}
+
 // RefreshToken validates the current JWT token and issues a new one with extended expiration.
 func (s *Service) RefreshToken( //nolint:funlen
 	ctx context.Context,
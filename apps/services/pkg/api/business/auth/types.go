@@ -57,11 +57,19 @@ type Config struct {
 	CookieName   string `conf:"cookie_name"   default:"aya_session"`
 
 	// CORS settings (comma-separated)
-	CorsAllowedOrigins string        `conf:"cors_allowed_origins" default:"https://aya.is,https://www.aya.is,http://localhost:3000,http://localhost:5173,http://localhost:4173"` //nolint:lll // struct tag
-	CorsAllowedHeaders string        `conf:"cors_allowed_headers" default:"Accept,Authorization,Content-Type,Origin,X-Requested-With,Traceparent,Tracestate"`                    //nolint:lll // struct tag
+	CorsAllowedOrigins string        `conf:"cors_allowed_origins" default:"https://aya.is,https://www.aya.is,http://localhost:3000,http://localhost:5173,http://localhost:4173"`                      //nolint:lll // struct tag
+	CorsAllowedHeaders string        `conf:"cors_allowed_headers" default:"Accept,Authorization,Content-Type,Origin,X-Requested-With,Traceparent,Tracestate,X-Impersonation-Confirm,X-Captcha-Token"` //nolint:lll // struct tag
 	CorsAllowedMethods string        `conf:"cors_allowed_methods" default:"GET,POST,PUT,DELETE,PATCH,HEAD,OPTIONS"`
 	TokenTTL           time.Duration `conf:"token_ttl"            default:"8760h"` //nolint:lll // 365 days (Go needs hours)
 
+	// Public CORS tier: origins allowed without credentials ("*" allows any origin).
+	// First-party origins above and profile custom domains are always credentialed.
+	CorsPublicOrigins   string        `conf:"cors_public_origins"    default:""`
+	CorsPreflightMaxAge time.Duration `conf:"cors_preflight_max_age" default:"10m"`
+
+	// Lifetime of an admin impersonation ("view as") session.
+	ImpersonationTTL time.Duration `conf:"impersonation_ttl" default:"30m"`
+
 	SecureCookie bool `conf:"secure_cookie" default:"true"`
 }
 
@@ -70,6 +78,11 @@ func (c *Config) GetCorsAllowedOrigins() []string {
 	return splitAndTrim(c.CorsAllowedOrigins)
 }
 
+// GetCorsPublicOrigins parses comma-separated public (non-credentialed) origins into a slice.
+func (c *Config) GetCorsPublicOrigins() []string {
+	return splitAndTrim(c.CorsPublicOrigins)
+}
+
 // GetCorsAllowedHeaders parses comma-separated headers into a slice.
 func (c *Config) GetCorsAllowedHeaders() []string {
 	return splitAndTrim(c.CorsAllowedHeaders)
@@ -0,0 +1,15 @@
+package consistency
+
+import "errors"
+
+// Sentinel errors.
+var (
+	ErrCheckNotFound        = errors.New("consistency check not found")
+	ErrCheckAlreadyFinished = errors.New("consistency check already finished")
+	ErrFailedToCreateCheck  = errors.New("failed to create consistency check")
+	ErrFailedToGetCheck     = errors.New("failed to get consistency check")
+	ErrFailedToListChecks   = errors.New("failed to list consistency checks")
+	ErrFailedToFinishCheck  = errors.New("failed to finish consistency check")
+	ErrFailedToScan         = errors.New("failed to scan for inconsistencies")
+	ErrFailedToEnqueueCheck = errors.New("failed to enqueue consistency check")
+)
@@ -0,0 +1,52 @@
+package consistency
+
+import (
+	"context"
+)
+
+// Repository defines the storage operations for consistency checks (port).
+type Repository interface {
+	// CreateConsistencyCheck stores a new pending check.
+	CreateConsistencyCheck(ctx context.Context, id string, requestedByUserID string, repair bool) error
+
+	// GetConsistencyCheckByID returns a check, or nil if it doesn't exist.
+	GetConsistencyCheckByID(ctx context.Context, id string) (*Check, error)
+
+	// ListConsistencyChecks returns the most recent checks, newest first.
+	ListConsistencyChecks(ctx context.Context, limit int) ([]*Check, error)
+
+	// FinishConsistencyCheck stores the outcome of a check.
+	FinishConsistencyCheck(
+		ctx context.Context,
+		id string,
+		status CheckStatus,
+		findings []*Finding,
+		errorMessage *string,
+	) error
+
+	// ListIndividualProfilesWithoutOwnerMembership returns individual profiles
+	// missing their self-owner membership.
+	ListIndividualProfilesWithoutOwnerMembership(
+		ctx context.Context,
+		limit int,
+	) ([]*IndividualProfileMembershipGap, error)
+
+	// ListProfilesWithoutTranslation returns the IDs of profiles without any translation.
+	ListProfilesWithoutTranslation(ctx context.Context, limit int) ([]string, error)
+
+	// ListUsersLinkedToMissingProfiles returns users whose individual profile is gone.
+	ListUsersLinkedToMissingProfiles(ctx context.Context, limit int) ([]*MissingProfileLink, error)
+
+	// CreateProfileMembership adds a membership to a profile.
+	CreateProfileMembership(
+		ctx context.Context,
+		membershipID string,
+		profileID string,
+		memberProfileID *string,
+		kind string,
+		properties map[string]any,
+	) error
+
+	// ClearUserIndividualProfileID unlinks a user from its individual profile.
+	ClearUserIndividualProfileID(ctx context.Context, userID string) error
+}
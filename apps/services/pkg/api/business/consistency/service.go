@@ -0,0 +1,293 @@
+package consistency
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+const (
+	// maxFindingsPerKind bounds how many records of each kind a single run reports.
+	maxFindingsPerKind = 500
+
+	ownerMembershipKind = "owner"
+)
+
+func DefaultIDGenerator() string {
+	return lib.IDsGenerateUnique()
+}
+
+// Service finds records left inconsistent by partially failed multi-step
+// creates and, when asked to, repairs the ones that have an unambiguous fix.
+// Checks run on the event queue; Run is the queue item's handler.
+type Service struct {
+	logger       *logfx.Logger
+	repo         Repository
+	queueService *events.QueueService
+	auditService *events.AuditService
+	idGenerator  IDGenerator
+}
+
+// NewService creates a new consistency check service.
+func NewService(
+	logger *logfx.Logger,
+	repo Repository,
+	queueService *events.QueueService,
+	auditService *events.AuditService,
+	idGenerator IDGenerator,
+) *Service {
+	return &Service{
+		logger:       logger,
+		repo:         repo,
+		queueService: queueService,
+		auditService: auditService,
+		idGenerator:  idGenerator,
+	}
+}
+
+// RequestCheck stores a pending check and queues it. With repair set, the run
+// also fixes what it safely can; otherwise it only reports.
+func (s *Service) RequestCheck(
+	ctx context.Context,
+	adminUserID string,
+	repair bool,
+) (*Check, error) {
+	checkID := s.idGenerator()
+
+	err := s.repo.CreateConsistencyCheck(ctx, checkID, adminUserID, repair)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateCheck, err)
+	}
+
+	_, err = s.queueService.Enqueue(ctx, events.QueueEnqueueParams{
+		Type:                  events.QueueItemTypeConsistencyCheck,
+		Payload:               map[string]any{"check_id": checkID},
+		ScheduledAt:           nil,
+		MaxRetries:            0,
+		VisibilityTimeoutSecs: 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToEnqueueCheck, checkID, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ConsistencyCheckRequested,
+		EntityType: "consistency_check",
+		EntityID:   checkID,
+		ActorID:    &adminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload:    map[string]any{"repair": repair},
+	})
+
+	return s.GetCheck(ctx, checkID)
+}
+
+// GetCheck returns a check with its findings.
+func (s *Service) GetCheck(ctx context.Context, id string) (*Check, error) {
+	check, err := s.repo.GetConsistencyCheckByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetCheck, id, err)
+	}
+
+	if check == nil {
+		return nil, fmt.Errorf("%w(id: %s)", ErrCheckNotFound, id)
+	}
+
+	return check, nil
+}
+
+// ListChecks returns the most recent checks, newest first.
+func (s *Service) ListChecks(ctx context.Context, limit int) ([]*Check, error) {
+	checks, err := s.repo.ListConsistencyChecks(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListChecks, err)
+	}
+
+	return checks, nil
+}
+
+// Run scans for inconsistencies and stores the findings on the check. A check
+// that has already finished is left alone, so queue retries are harmless.
+func (s *Service) Run(ctx context.Context, checkID string) error {
+	check, err := s.GetCheck(ctx, checkID)
+	if err != nil {
+		return err
+	}
+
+	if check.Status != CheckStatusPending {
+		return fmt.Errorf("%w(id: %s)", ErrCheckAlreadyFinished, checkID)
+	}
+
+	findings, err := s.scan(ctx)
+	if err != nil {
+		message := err.Error()
+
+		finishErr := s.repo.FinishConsistencyCheck(ctx, checkID, CheckStatusFailed, nil, &message)
+		if finishErr != nil {
+			return fmt.Errorf("%w(id: %s): %w", ErrFailedToFinishCheck, checkID, finishErr)
+		}
+
+		return err
+	}
+
+	if check.Repair {
+		for _, finding := range findings {
+			s.repair(ctx, check, finding)
+		}
+	}
+
+	err = s.repo.FinishConsistencyCheck(ctx, checkID, CheckStatusCompleted, findings, nil)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToFinishCheck, checkID, err)
+	}
+
+	repaired := 0
+
+	for _, finding := range findings {
+		if finding.Repaired {
+			repaired++
+		}
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ConsistencyCheckCompleted,
+		EntityType: "consistency_check",
+		EntityID:   checkID,
+		ActorID:    nil,
+		ActorKind:  events.ActorSystem,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"findings": len(findings),
+			"repaired": repaired,
+		},
+	})
+
+	return nil
+}
+
+func (s *Service) scan(ctx context.Context) ([]*Finding, error) {
+	findings := make([]*Finding, 0)
+
+	gaps, err := s.repo.ListIndividualProfilesWithoutOwnerMembership(ctx, maxFindingsPerKind)
+	if err != nil {
+		return nil, fmt.Errorf("%w(kind: %s): %w",
+			ErrFailedToScan, FindingIndividualProfileWithoutMembership, err)
+	}
+
+	for _, gap := range gaps {
+		profileID := gap.ProfileID
+
+		findings = append(findings, &Finding{
+			ProfileID:   &profileID,
+			UserID:      gap.UserID,
+			RepairError: nil,
+			Kind:        FindingIndividualProfileWithoutMembership,
+			Repaired:    false,
+		})
+	}
+
+	untranslated, err := s.repo.ListProfilesWithoutTranslation(ctx, maxFindingsPerKind)
+	if err != nil {
+		return nil, fmt.Errorf("%w(kind: %s): %w",
+			ErrFailedToScan, FindingProfileWithoutTranslation, err)
+	}
+
+	for _, profileID := range untranslated {
+		findings = append(findings, &Finding{
+			ProfileID:   &profileID,
+			UserID:      nil,
+			RepairError: nil,
+			Kind:        FindingProfileWithoutTranslation,
+			Repaired:    false,
+		})
+	}
+
+	links, err := s.repo.ListUsersLinkedToMissingProfiles(ctx, maxFindingsPerKind)
+	if err != nil {
+		return nil, fmt.Errorf("%w(kind: %s): %w",
+			ErrFailedToScan, FindingUserLinkedToMissingProfile, err)
+	}
+
+	for _, link := range links {
+		profileID := link.ProfileID
+		userID := link.UserID
+
+		findings = append(findings, &Finding{
+			ProfileID:   &profileID,
+			UserID:      &userID,
+			RepairError: nil,
+			Kind:        FindingUserLinkedToMissingProfile,
+			Repaired:    false,
+		})
+	}
+
+	return findings, nil
+}
+
+// repair applies the fix for a finding when there is exactly one sensible one:
+//   - an individual profile that a user is linked to gets its self-owner membership;
+//   - a user linked to a missing profile is unlinked, so it can create a new one.
+//
+// Profiles without translations and individual profiles no user is linked to
+// are only reported; there is no data to rebuild them from.
+func (s *Service) repair(ctx context.Context, check *Check, finding *Finding) {
+	var (
+		err        error
+		entityType string
+		entityID   string
+	)
+
+	switch finding.Kind {
+	case FindingIndividualProfileWithoutMembership:
+		if finding.UserID == nil {
+			return
+		}
+
+		entityType = "profile"
+		entityID = *finding.ProfileID
+		err = s.repo.CreateProfileMembership(
+			ctx, s.idGenerator(), entityID, finding.ProfileID, ownerMembershipKind, nil,
+		)
+	case FindingUserLinkedToMissingProfile:
+		entityType = "user"
+		entityID = *finding.UserID
+		err = s.repo.ClearUserIndividualProfileID(ctx, entityID)
+	case FindingProfileWithoutTranslation:
+		return
+	}
+
+	if err != nil {
+		message := err.Error()
+		finding.RepairError = &message
+
+		s.logger.WarnContext(ctx, "Failed to repair inconsistency",
+			slog.String("check_id", check.ID),
+			slog.String("kind", string(finding.Kind)),
+			slog.String("entity_id", entityID),
+			slog.String("error", message))
+
+		return
+	}
+
+	finding.Repaired = true
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ConsistencyRepairApplied,
+		EntityType: entityType,
+		EntityID:   entityID,
+		ActorID:    &check.RequestedByUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"check_id":   check.ID,
+			"kind":       string(finding.Kind),
+			"profile_id": finding.ProfileID,
+			"user_id":    finding.UserID,
+		},
+	})
+}
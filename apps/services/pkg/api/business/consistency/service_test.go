@@ -0,0 +1,363 @@
+package consistency_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/consistency"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMembershipConflict = errors.New("membership already exists")
+
+// seededRepository holds a small data set with one record of every
+// inconsistency and applies repairs to it.
+type seededRepository struct {
+	consistency.Repository
+
+	checks             map[string]*consistency.Check
+	userProfiles       map[string]*string // user ID -> individual profile ID
+	individualProfiles []string
+	ownerMemberships   map[string]bool // profile ID -> has self-owner membership
+	deletedProfiles    map[string]bool
+	membershipErr      error
+}
+
+func newSeededRepository() *seededRepository {
+	profileA := "p-no-membership"
+	profileB := "p-deleted"
+
+	return &seededRepository{ //nolint:exhaustruct
+		checks: map[string]*consistency.Check{},
+		userProfiles: map[string]*string{
+			"u-no-membership":   &profileA,
+			"u-deleted-profile": &profileB,
+		},
+		individualProfiles: []string{"p-no-membership", "p-unclaimed", "p-deleted"},
+		ownerMemberships:   map[string]bool{},
+		deletedProfiles:    map[string]bool{"p-deleted": true},
+	}
+}
+
+func (r *seededRepository) CreateConsistencyCheck(
+	_ context.Context,
+	id string,
+	requestedByUserID string,
+	repair bool,
+) error {
+	r.checks[id] = &consistency.Check{ //nolint:exhaustruct
+		ID:                id,
+		RequestedByUserID: requestedByUserID,
+		Status:            consistency.CheckStatusPending,
+		Repair:            repair,
+	}
+
+	return nil
+}
+
+func (r *seededRepository) GetConsistencyCheckByID(
+	_ context.Context,
+	id string,
+) (*consistency.Check, error) {
+	check, ok := r.checks[id]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	copied := *check
+
+	return &copied, nil
+}
+
+func (r *seededRepository) FinishConsistencyCheck(
+	_ context.Context,
+	id string,
+	status consistency.CheckStatus,
+	findings []*consistency.Finding,
+	errorMessage *string,
+) error {
+	now := time.Now()
+
+	r.checks[id].Status = status
+	r.checks[id].Findings = findings
+	r.checks[id].ErrorMessage = errorMessage
+	r.checks[id].CompletedAt = &now
+
+	return nil
+}
+
+func (r *seededRepository) ListIndividualProfilesWithoutOwnerMembership(
+	_ context.Context,
+	_ int,
+) ([]*consistency.IndividualProfileMembershipGap, error) {
+	gaps := make([]*consistency.IndividualProfileMembershipGap, 0)
+
+	for _, profileID := range r.individualProfiles {
+		if r.deletedProfiles[profileID] || r.ownerMemberships[profileID] {
+			continue
+		}
+
+		gap := &consistency.IndividualProfileMembershipGap{UserID: nil, ProfileID: profileID}
+
+		for userID, linked := range r.userProfiles {
+			if linked != nil && *linked == profileID {
+				gap.UserID = &userID
+			}
+		}
+
+		gaps = append(gaps, gap)
+	}
+
+	return gaps, nil
+}
+
+func (r *seededRepository) ListProfilesWithoutTranslation(
+	_ context.Context,
+	_ int,
+) ([]string, error) {
+	return []string{"p-no-tx"}, nil
+}
+
+func (r *seededRepository) ListUsersLinkedToMissingProfiles(
+	_ context.Context,
+	_ int,
+) ([]*consistency.MissingProfileLink, error) {
+	links := make([]*consistency.MissingProfileLink, 0)
+
+	for userID, profileID := range r.userProfiles {
+		if profileID != nil && r.deletedProfiles[*profileID] {
+			links = append(links, &consistency.MissingProfileLink{UserID: userID, ProfileID: *profileID})
+		}
+	}
+
+	return links, nil
+}
+
+func (r *seededRepository) CreateProfileMembership(
+	_ context.Context,
+	_ string,
+	profileID string,
+	memberProfileID *string,
+	kind string,
+	_ map[string]any,
+) error {
+	if r.membershipErr != nil {
+		return r.membershipErr
+	}
+
+	if memberProfileID != nil && *memberProfileID == profileID && kind == "owner" {
+		r.ownerMemberships[profileID] = true
+	}
+
+	return nil
+}
+
+func (r *seededRepository) ClearUserIndividualProfileID(_ context.Context, userID string) error {
+	r.userProfiles[userID] = nil
+
+	return nil
+}
+
+// recordingQueueRepository keeps every enqueued item.
+type recordingQueueRepository struct {
+	events.QueueRepository
+
+	items []map[string]any
+}
+
+func (r *recordingQueueRepository) Enqueue(
+	_ context.Context,
+	_ string,
+	_ events.QueueItemType,
+	payload map[string]any,
+	_ int,
+	_ int,
+	_ time.Time,
+) error {
+	r.items = append(r.items, payload)
+
+	return nil
+}
+
+// recordingAuditRepository keeps every recorded audit entry.
+type recordingAuditRepository struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *recordingAuditRepository) InsertAudit(
+	_ context.Context,
+	_ string,
+	params events.AuditParams,
+) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+func (r *recordingAuditRepository) countOf(eventType events.EventType) int {
+	count := 0
+
+	for _, entry := range r.entries {
+		if entry.EventType == eventType {
+			count++
+		}
+	}
+
+	return count
+}
+
+func newTestService(repo *seededRepository) (
+	*consistency.Service,
+	*recordingQueueRepository,
+	*recordingAuditRepository,
+) {
+	counter := 0
+	idGenerator := func() string {
+		counter++
+
+		return fmt.Sprintf("id-%d", counter)
+	}
+
+	queueRepo := &recordingQueueRepository{} //nolint:exhaustruct
+	auditRepo := &recordingAuditRepository{} //nolint:exhaustruct
+
+	service := consistency.NewService(
+		logfx.NewLogger(),
+		repo,
+		events.NewQueueService(nil, queueRepo, idGenerator),
+		events.NewAuditService(nil, auditRepo, idGenerator, nil),
+		idGenerator,
+	)
+
+	return service, queueRepo, auditRepo
+}
+
+func findingsByKind(check *consistency.Check) map[consistency.FindingKind][]*consistency.Finding {
+	result := make(map[consistency.FindingKind][]*consistency.Finding)
+
+	for _, finding := range check.Findings {
+		result[finding.Kind] = append(result[finding.Kind], finding)
+	}
+
+	return result
+}
+
+func TestRun_ReportOnly(t *testing.T) {
+	t.Parallel()
+
+	repo := newSeededRepository()
+	service, queueRepo, auditRepo := newTestService(repo)
+
+	check, err := service.RequestCheck(t.Context(), "admin-1", false)
+	require.NoError(t, err)
+	assert.Equal(t, consistency.CheckStatusPending, check.Status)
+	require.Len(t, queueRepo.items, 1)
+	assert.Equal(t, check.ID, queueRepo.items[0]["check_id"])
+
+	require.NoError(t, service.Run(t.Context(), check.ID))
+
+	check, err = service.GetCheck(t.Context(), check.ID)
+	require.NoError(t, err)
+	assert.Equal(t, consistency.CheckStatusCompleted, check.Status)
+
+	byKind := findingsByKind(check)
+	assert.Len(t, byKind[consistency.FindingIndividualProfileWithoutMembership], 2)
+	assert.Len(t, byKind[consistency.FindingProfileWithoutTranslation], 1)
+	assert.Len(t, byKind[consistency.FindingUserLinkedToMissingProfile], 1)
+
+	for _, finding := range check.Findings {
+		assert.False(t, finding.Repaired)
+	}
+
+	// Nothing was touched
+	assert.Empty(t, repo.ownerMemberships)
+	assert.NotNil(t, repo.userProfiles["u-deleted-profile"])
+	assert.Zero(t, auditRepo.countOf(events.ConsistencyRepairApplied))
+	assert.Equal(t, 1, auditRepo.countOf(events.ConsistencyCheckCompleted))
+}
+
+func TestRun_Repair(t *testing.T) {
+	t.Parallel()
+
+	repo := newSeededRepository()
+	service, _, auditRepo := newTestService(repo)
+
+	check, err := service.RequestCheck(t.Context(), "admin-1", true)
+	require.NoError(t, err)
+
+	require.NoError(t, service.Run(t.Context(), check.ID))
+
+	check, err = service.GetCheck(t.Context(), check.ID)
+	require.NoError(t, err)
+
+	byKind := findingsByKind(check)
+
+	// The profile a user is linked to gets its owner membership back; the
+	// unclaimed one is only reported
+	for _, finding := range byKind[consistency.FindingIndividualProfileWithoutMembership] {
+		assert.Equal(t, finding.UserID != nil, finding.Repaired, *finding.ProfileID)
+	}
+
+	assert.True(t, repo.ownerMemberships["p-no-membership"])
+	assert.False(t, repo.ownerMemberships["p-unclaimed"])
+
+	// Missing translations have nothing to rebuild from
+	assert.False(t, byKind[consistency.FindingProfileWithoutTranslation][0].Repaired)
+
+	// The user linked to a deleted profile is unlinked
+	assert.True(t, byKind[consistency.FindingUserLinkedToMissingProfile][0].Repaired)
+	assert.Nil(t, repo.userProfiles["u-deleted-profile"])
+
+	require.Equal(t, 2, auditRepo.countOf(events.ConsistencyRepairApplied))
+
+	for _, entry := range auditRepo.entries {
+		if entry.EventType == events.ConsistencyRepairApplied {
+			require.NotNil(t, entry.ActorID)
+			assert.Equal(t, "admin-1", *entry.ActorID)
+			assert.Equal(t, check.ID, entry.Payload["check_id"])
+		}
+	}
+
+	// A second run of the same queue item is a no-op
+	err = service.Run(t.Context(), check.ID)
+	require.ErrorIs(t, err, consistency.ErrCheckAlreadyFinished)
+	assert.Equal(t, 2, auditRepo.countOf(events.ConsistencyRepairApplied))
+}
+
+func TestRun_RepairFailureIsReported(t *testing.T) {
+	t.Parallel()
+
+	repo := newSeededRepository()
+	repo.membershipErr = errMembershipConflict
+	service, _, auditRepo := newTestService(repo)
+
+	check, err := service.RequestCheck(t.Context(), "admin-1", true)
+	require.NoError(t, err)
+
+	require.NoError(t, service.Run(t.Context(), check.ID))
+
+	check, err = service.GetCheck(t.Context(), check.ID)
+	require.NoError(t, err)
+	assert.Equal(t, consistency.CheckStatusCompleted, check.Status)
+
+	for _, finding := range findingsByKind(check)[consistency.FindingIndividualProfileWithoutMembership] {
+		if finding.UserID == nil {
+			continue
+		}
+
+		assert.False(t, finding.Repaired)
+		require.NotNil(t, finding.RepairError)
+		assert.Contains(t, *finding.RepairError, errMembershipConflict.Error())
+	}
+
+	// The other repair still went through
+	assert.Equal(t, 1, auditRepo.countOf(events.ConsistencyRepairApplied))
+}
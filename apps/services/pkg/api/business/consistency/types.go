@@ -0,0 +1,65 @@
+package consistency
+
+import (
+	"time"
+)
+
+// CheckStatus represents the lifecycle of a consistency check.
+type CheckStatus string
+
+const (
+	CheckStatusPending   CheckStatus = "pending"
+	CheckStatusCompleted CheckStatus = "completed"
+	CheckStatusFailed    CheckStatus = "failed"
+)
+
+// FindingKind identifies an inconsistency a check looks for.
+type FindingKind string
+
+const (
+	// FindingIndividualProfileWithoutMembership is an individual profile missing
+	// the self-owner membership created at signup.
+	FindingIndividualProfileWithoutMembership FindingKind = "individual_profile_without_membership"
+	// FindingProfileWithoutTranslation is a profile without any profile_tx row.
+	FindingProfileWithoutTranslation FindingKind = "profile_without_translation"
+	// FindingUserLinkedToMissingProfile is a user whose individual profile was
+	// deleted or no longer exists.
+	FindingUserLinkedToMissingProfile FindingKind = "user_linked_to_missing_profile"
+)
+
+// Finding is a single inconsistent record and what was done about it.
+type Finding struct {
+	ProfileID   *string     `json:"profile_id"`
+	UserID      *string     `json:"user_id"`
+	RepairError *string     `json:"repair_error,omitempty"`
+	Kind        FindingKind `json:"kind"`
+	Repaired    bool        `json:"repaired"`
+}
+
+// Check is a requested consistency check and, once run, its findings.
+type Check struct {
+	CreatedAt         time.Time   `json:"created_at"`
+	CompletedAt       *time.Time  `json:"completed_at"`
+	ErrorMessage      *string     `json:"error_message"`
+	ID                string      `json:"id"`
+	RequestedByUserID string      `json:"requested_by_user_id"`
+	Status            CheckStatus `json:"status"`
+	Findings          []*Finding  `json:"findings"`
+	Repair            bool        `json:"repair"`
+}
+
+// IndividualProfileMembershipGap is an individual profile without its
+// self-owner membership. UserID is the user linked to it, if any.
+type IndividualProfileMembershipGap struct {
+	UserID    *string
+	ProfileID string
+}
+
+// MissingProfileLink is a user pointing at an individual profile that is gone.
+type MissingProfileLink struct {
+	UserID    string
+	ProfileID string
+}
+
+// IDGenerator is a function that generates unique IDs.
+type IDGenerator func() string
@@ -5,6 +5,8 @@ import "errors"
 // Sentinel errors.
 var (
 	ErrDiscussionsNotEnabled  = errors.New("discussions are not enabled for this profile")
+	ErrProfileNotFound        = errors.New("profile not found")
+	ErrStoryNotFound          = errors.New("story not found")
 	ErrThreadNotFound         = errors.New("discussion thread not found")
 	ErrCommentNotFound        = errors.New("comment not found")
 	ErrContentTooShort        = errors.New("comment content is too short")
@@ -103,4 +103,10 @@ type Repository interface { //nolint:interfacebloat
 
 	// GetStoryAuthorProfileID returns the author profile ID of a story.
 	GetStoryAuthorProfileID(ctx context.Context, storyID string) (*string, error)
+
+	// GetStoryDiscussionSettings returns the discussion flags of a story and its author profile.
+	GetStoryDiscussionSettings(ctx context.Context, storyID string) (*StoryDiscussionSettings, error)
+
+	// GetProfileStoryIDBySlugs resolves a story slug within a profile to its ID.
+	GetProfileStoryIDBySlugs(ctx context.Context, profileSlug, storySlug string) (string, error)
 }
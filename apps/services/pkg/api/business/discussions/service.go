@@ -45,6 +45,35 @@ func (s *Service) GetOrCreateThreadByStorySlug(
 		return nil, fmt.Errorf("%w (story slug: %s): %w", ErrFailedToGetRecord, storySlug, err)
 	}
 
+	if storyID == "" {
+		return nil, ErrStoryNotFound
+	}
+
+	_, err = s.getStoryDiscussionSettings(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetOrCreateThreadByStory(ctx, storyID)
+}
+
+// GetOrCreateThreadByProfileStory resolves a story within a profile and returns its
+// thread, creating one if needed. Fails when discussions are off for the story.
+func (s *Service) GetOrCreateThreadByProfileStory(
+	ctx context.Context,
+	profileSlug string,
+	storySlug string,
+) (*Thread, error) {
+	storyID, err := s.resolveProfileStoryID(ctx, profileSlug, storySlug)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.getStoryDiscussionSettings(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+
 	return s.GetOrCreateThreadByStory(ctx, storyID)
 }
 
@@ -58,6 +87,10 @@ func (s *Service) GetOrCreateThreadByProfileSlug(
 		return nil, fmt.Errorf("%w (profile slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	return s.GetOrCreateThreadByProfile(ctx, profileID)
 }
 
@@ -218,8 +251,15 @@ func (s *Service) resolveThreadAnchor(
 	params CreateCommentParams,
 ) (*Thread, string, error) {
 	switch {
+	case params.StorySlug != nil && params.ProfileSlug != nil:
+		storyID, err := s.resolveProfileStoryID(ctx, *params.ProfileSlug, *params.StorySlug)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return s.resolveStoryThreadByID(ctx, storyID, params.UserID)
 	case params.StorySlug != nil:
-		return s.resolveStoryThread(ctx, *params.StorySlug)
+		return s.resolveStoryThread(ctx, *params.StorySlug, params.UserID)
 	case params.ProfileSlug != nil:
 		return s.resolveProfileThread(ctx, *params.ProfileSlug)
 	default:
@@ -231,6 +271,7 @@ func (s *Service) resolveThreadAnchor(
 func (s *Service) resolveStoryThread(
 	ctx context.Context,
 	storySlug string,
+	userID string,
 ) (*Thread, string, error) {
 	storyID, err := s.repo.GetStoryIDBySlug(ctx, storySlug)
 	if err != nil {
@@ -242,25 +283,98 @@ func (s *Service) resolveStoryThread(
 		)
 	}
 
-	thread, err := s.GetOrCreateThreadByStory(ctx, storyID)
+	if storyID == "" {
+		return nil, "", ErrStoryNotFound
+	}
+
+	return s.resolveStoryThreadByID(ctx, storyID, userID)
+}
+
+// resolveStoryThreadByID returns the thread of a story a user is about to post to,
+// after checking the discussion flags and the user's membership on the author profile.
+func (s *Service) resolveStoryThreadByID(
+	ctx context.Context,
+	storyID string,
+	userID string,
+) (*Thread, string, error) {
+	settings, err := s.getStoryDiscussionSettings(ctx, storyID)
 	if err != nil {
 		return nil, "", err
 	}
 
-	// Resolve owner profile for visibility check.
-	authorProfileID, aErr := s.repo.GetStoryAuthorProfileID(ctx, storyID)
-	if aErr != nil {
-		return nil, "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, aErr)
+	ownerProfileSlug := *settings.AuthorProfileSlug
+
+	hasAccess, err := s.profileService.HasUserAccessToProfile(
+		ctx,
+		userID,
+		ownerProfileSlug,
+		MinStoryPostingMembership,
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if !hasAccess {
+		return nil, "", ErrInsufficientPermission
 	}
 
-	ownerProfileSlug := ""
-	if authorProfileID != nil {
-		ownerProfileSlug = s.resolveProfileSlug(ctx, *authorProfileID)
+	thread, err := s.GetOrCreateThreadByStory(ctx, storyID)
+	if err != nil {
+		return nil, "", err
 	}
 
 	return thread, ownerProfileSlug, nil
 }
 
+// resolveProfileStoryID resolves a story slug within a profile to its ID.
+func (s *Service) resolveProfileStoryID(
+	ctx context.Context,
+	profileSlug string,
+	storySlug string,
+) (string, error) {
+	storyID, err := s.repo.GetProfileStoryIDBySlugs(ctx, profileSlug, storySlug)
+	if err != nil {
+		return "", fmt.Errorf(
+			"%w (profile slug: %s, story slug: %s): %w",
+			ErrFailedToGetRecord,
+			profileSlug,
+			storySlug,
+			err,
+		)
+	}
+
+	if storyID == "" {
+		return "", ErrStoryNotFound
+	}
+
+	return storyID, nil
+}
+
+// getStoryDiscussionSettings returns the discussion settings of a story, failing
+// unless both the author profile's discussions module and the story's own flag
+// are on.
+func (s *Service) getStoryDiscussionSettings(
+	ctx context.Context,
+	storyID string,
+) (*StoryDiscussionSettings, error) {
+	settings, err := s.repo.GetStoryDiscussionSettings(ctx, storyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if settings == nil {
+		return nil, ErrStoryNotFound
+	}
+
+	if settings.AuthorProfileSlug == nil ||
+		settings.AuthorFeatureDiscussions == string(profiles.ModuleVisibilityDisabled) ||
+		!settings.FeatDiscussions {
+		return nil, ErrDiscussionsNotEnabled
+	}
+
+	return settings, nil
+}
+
 // resolveProfileThread resolves a profile slug to its thread.
 func (s *Service) resolveProfileThread(
 	ctx context.Context,
@@ -276,6 +390,10 @@ func (s *Service) resolveProfileThread(
 		)
 	}
 
+	if profileID == "" {
+		return nil, "", ErrProfileNotFound
+	}
+
 	visibility, vErr := s.repo.GetDiscussionVisibility(ctx, profileID)
 	if vErr != nil {
 		return nil, "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, vErr)
@@ -381,7 +499,67 @@ func (s *Service) DeleteComment(ctx context.Context, params DeleteCommentParams)
 		}
 	}
 
-	err = s.repo.SoftDeleteComment(ctx, params.CommentID)
+	return s.softDeleteComment(ctx, comment, params.UserID, params.ProfileSlug)
+}
+
+// DeleteStoryComment soft-deletes a comment of a story discussion. Only the
+// comment author or a maintainer+ of the story's author profile may do so.
+func (s *Service) DeleteStoryComment(ctx context.Context, params DeleteStoryCommentParams) error {
+	storyID, err := s.resolveProfileStoryID(ctx, params.ProfileSlug, params.StorySlug)
+	if err != nil {
+		return err
+	}
+
+	thread, err := s.repo.GetThreadByStoryID(ctx, storyID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	comment, err := s.repo.GetCommentRaw(ctx, params.CommentID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCommentNotFound, err)
+	}
+
+	if thread == nil || comment == nil || comment.ThreadID != thread.ID {
+		return ErrCommentNotFound
+	}
+
+	if comment.AuthorUserID != params.UserID {
+		settings, sErr := s.repo.GetStoryDiscussionSettings(ctx, storyID)
+		if sErr != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, sErr)
+		}
+
+		if settings == nil || settings.AuthorProfileSlug == nil {
+			return ErrInsufficientPermission
+		}
+
+		hasAccess, accessErr := s.profileService.HasUserAccessToProfile(
+			ctx,
+			params.UserID,
+			*settings.AuthorProfileSlug,
+			MinStoryModerationMembership,
+		)
+		if accessErr != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, accessErr)
+		}
+
+		if !hasAccess {
+			return ErrInsufficientPermission
+		}
+	}
+
+	return s.softDeleteComment(ctx, comment, params.UserID, params.ProfileSlug)
+}
+
+// softDeleteComment removes a comment whose permissions were already checked.
+func (s *Service) softDeleteComment(
+	ctx context.Context,
+	comment *Comment,
+	userID string,
+	profileSlug string,
+) error {
+	err := s.repo.SoftDeleteComment(ctx, comment.ID)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
 	}
@@ -396,12 +574,12 @@ func (s *Service) DeleteComment(ctx context.Context, params DeleteCommentParams)
 	s.auditService.Record(ctx, events.AuditParams{
 		EventType:  events.DiscussionCommentDeleted,
 		EntityType: "discussion_comment",
-		EntityID:   params.CommentID,
-		ActorID:    &params.UserID,
+		EntityID:   comment.ID,
+		ActorID:    &userID,
 		ActorKind:  events.ActorUser,
 		SessionID:  nil,
 		Payload: map[string]any{
-			"profile_slug": params.ProfileSlug,
+			"profile_slug": profileSlug,
 		},
 	})
 
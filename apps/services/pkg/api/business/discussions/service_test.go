@@ -0,0 +1,28 @@
+package discussions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/discussions"
+	"github.com/stretchr/testify/assert"
+)
+
+// missingSlugRepository resolves every profile slug to the empty ID.
+type missingSlugRepository struct {
+	discussions.Repository
+}
+
+func (r *missingSlugRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func TestGetOrCreateThreadByProfileSlug_MissingSlug(t *testing.T) {
+	t.Parallel()
+
+	service := discussions.NewService(nil, &missingSlugRepository{}, nil, nil, nil) //nolint:exhaustruct
+
+	_, err := service.GetOrCreateThreadByProfileSlug(t.Context(), "does-not-exist")
+
+	assert.ErrorIs(t, err, discussions.ErrProfileNotFound)
+}
@@ -0,0 +1,369 @@
+package discussions_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/discussions"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// membershipRepository answers the access checks made against the story's
+// author profile. Each user's individual profile is "profile-" + user ID.
+type membershipRepository struct {
+	profiles.Repository
+
+	memberships map[string]profiles.MembershipKind // individual profile ID -> kind
+}
+
+func (r *membershipRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "author-profile", nil
+}
+
+func (r *membershipRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *membershipRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	return r.memberships[originProfileID], nil
+}
+
+// storyDiscussionRepository holds a single story of the "author" profile.
+type storyDiscussionRepository struct {
+	discussions.Repository
+
+	settings *discussions.StoryDiscussionSettings
+	threads  map[string]*discussions.Thread // story ID -> thread
+	comments map[string]*discussions.Comment
+	deleted  []string
+}
+
+func newStoryDiscussionRepository() *storyDiscussionRepository {
+	authorSlug := "author"
+
+	return &storyDiscussionRepository{ //nolint:exhaustruct
+		settings: &discussions.StoryDiscussionSettings{
+			AuthorProfileID:          nil,
+			AuthorProfileSlug:        &authorSlug,
+			AuthorFeatureDiscussions: string(profiles.ModuleVisibilityPublic),
+			FeatDiscussions:          true,
+		},
+		threads:  map[string]*discussions.Thread{},
+		comments: map[string]*discussions.Comment{},
+	}
+}
+
+func (r *storyDiscussionRepository) GetProfileStoryIDBySlugs(
+	_ context.Context,
+	profileSlug string,
+	storySlug string,
+) (string, error) {
+	if profileSlug != "author" || storySlug != "hello" {
+		return "", nil
+	}
+
+	return "story-1", nil
+}
+
+func (r *storyDiscussionRepository) GetStoryDiscussionSettings(
+	_ context.Context,
+	_ string,
+) (*discussions.StoryDiscussionSettings, error) {
+	return r.settings, nil
+}
+
+func (r *storyDiscussionRepository) GetThreadByStoryID(
+	_ context.Context,
+	storyID string,
+) (*discussions.Thread, error) {
+	return r.threads[storyID], nil
+}
+
+func (r *storyDiscussionRepository) InsertThread(
+	_ context.Context,
+	id string,
+	storyID *string,
+	_ *string,
+) (*discussions.Thread, error) {
+	thread := &discussions.Thread{ID: id, StoryID: storyID} //nolint:exhaustruct
+	r.threads[*storyID] = thread
+
+	return thread, nil
+}
+
+func (r *storyDiscussionRepository) IncrementThreadCommentCount(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *storyDiscussionRepository) DecrementThreadCommentCount(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *storyDiscussionRepository) InsertComment(
+	_ context.Context,
+	id, threadID string,
+	parentID *string,
+	authorUserID, content string,
+	depth int,
+) (*discussions.Comment, error) {
+	comment := &discussions.Comment{ //nolint:exhaustruct
+		ID:           id,
+		ThreadID:     threadID,
+		ParentID:     parentID,
+		AuthorUserID: authorUserID,
+		Content:      content,
+		Depth:        depth,
+	}
+	r.comments[id] = comment
+
+	return comment, nil
+}
+
+func (r *storyDiscussionRepository) GetCommentRaw(
+	_ context.Context,
+	id string,
+) (*discussions.Comment, error) {
+	return r.comments[id], nil
+}
+
+func (r *storyDiscussionRepository) SoftDeleteComment(_ context.Context, commentID string) error {
+	r.deleted = append(r.deleted, commentID)
+
+	return nil
+}
+
+// recordingAuditRepository keeps every recorded audit entry.
+type recordingAuditRepository struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *recordingAuditRepository) InsertAudit(
+	_ context.Context,
+	_ string,
+	params events.AuditParams,
+) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+func newStoryDiscussionService(
+	repo *storyDiscussionRepository,
+) *discussions.Service {
+	membershipRepo := &membershipRepository{ //nolint:exhaustruct
+		memberships: map[string]profiles.MembershipKind{
+			"profile-follower":   profiles.MembershipKindFollower,
+			"profile-maintainer": profiles.MembershipKindMaintainer,
+		},
+	}
+
+	counter := 0
+	idGenerator := func() string {
+		counter++
+
+		return fmt.Sprintf("id-%d", counter)
+	}
+
+	return discussions.NewService(
+		nil,
+		repo,
+		profiles.NewService(nil, nil, membershipRepo, nil),
+		events.NewAuditService(nil, &recordingAuditRepository{}, idGenerator, nil), //nolint:exhaustruct
+		idGenerator,
+	)
+}
+
+func storyCommentParams(userID string, storySlug string) discussions.CreateCommentParams {
+	profileSlug := "author"
+
+	return discussions.CreateCommentParams{
+		StorySlug:   &storySlug,
+		ProfileSlug: &profileSlug,
+		Locale:      "en",
+		UserID:      userID,
+		ParentID:    nil,
+		Content:     "Nice write-up",
+	}
+}
+
+func TestCreateComment_StoryDiscussionGating(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		featureDiscussions string
+		featDiscussions    bool
+		userID             string
+		storySlug          string
+		expected           error
+	}{
+		"follower on an open story": {
+			featureDiscussions: string(profiles.ModuleVisibilityPublic),
+			featDiscussions:    true,
+			userID:             "follower",
+			storySlug:          "hello",
+			expected:           nil,
+		},
+		"hidden module still accepts comments": {
+			featureDiscussions: string(profiles.ModuleVisibilityHidden),
+			featDiscussions:    true,
+			userID:             "follower",
+			storySlug:          "hello",
+			expected:           nil,
+		},
+		"discussions module disabled on the profile": {
+			featureDiscussions: string(profiles.ModuleVisibilityDisabled),
+			featDiscussions:    true,
+			userID:             "follower",
+			storySlug:          "hello",
+			expected:           discussions.ErrDiscussionsNotEnabled,
+		},
+		"discussions off for the story": {
+			featureDiscussions: string(profiles.ModuleVisibilityPublic),
+			featDiscussions:    false,
+			userID:             "follower",
+			storySlug:          "hello",
+			expected:           discussions.ErrDiscussionsNotEnabled,
+		},
+		"user without membership": {
+			featureDiscussions: string(profiles.ModuleVisibilityPublic),
+			featDiscussions:    true,
+			userID:             "stranger",
+			storySlug:          "hello",
+			expected:           discussions.ErrInsufficientPermission,
+		},
+		"story of another profile": {
+			featureDiscussions: string(profiles.ModuleVisibilityPublic),
+			featDiscussions:    true,
+			userID:             "follower",
+			storySlug:          "elsewhere",
+			expected:           discussions.ErrStoryNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newStoryDiscussionRepository()
+			repo.settings.AuthorFeatureDiscussions = tt.featureDiscussions
+			repo.settings.FeatDiscussions = tt.featDiscussions
+			service := newStoryDiscussionService(repo)
+
+			comment, err := service.CreateComment(
+				t.Context(),
+				storyCommentParams(tt.userID, tt.storySlug),
+			)
+
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				assert.Empty(t, repo.comments)
+				assert.Empty(t, repo.threads)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, repo.threads["story-1"].ID, comment.ThreadID)
+		})
+	}
+}
+
+func TestGetOrCreateThreadByProfileStory_Disabled(t *testing.T) {
+	t.Parallel()
+
+	repo := newStoryDiscussionRepository()
+	repo.settings.FeatDiscussions = false
+	service := newStoryDiscussionService(repo)
+
+	_, err := service.GetOrCreateThreadByProfileStory(t.Context(), "author", "hello")
+
+	require.ErrorIs(t, err, discussions.ErrDiscussionsNotEnabled)
+	assert.Empty(t, repo.threads)
+}
+
+func TestDeleteStoryComment(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		userID   string
+		expected error
+	}{
+		"comment author":      {userID: "follower", expected: nil},
+		"profile maintainer":  {userID: "maintainer", expected: nil},
+		"another user":        {userID: "stranger", expected: discussions.ErrInsufficientPermission},
+		"follower not author": {userID: "other-follower", expected: discussions.ErrInsufficientPermission},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newStoryDiscussionRepository()
+			service := newStoryDiscussionService(repo)
+
+			comment, err := service.CreateComment(t.Context(), storyCommentParams("follower", "hello"))
+			require.NoError(t, err)
+
+			err = service.DeleteStoryComment(t.Context(), discussions.DeleteStoryCommentParams{
+				CommentID:   comment.ID,
+				UserID:      tt.userID,
+				ProfileSlug: "author",
+				StorySlug:   "hello",
+			})
+
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				assert.Empty(t, repo.deleted)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{comment.ID}, repo.deleted)
+		})
+	}
+}
+
+func TestDeleteStoryComment_CommentOfAnotherThread(t *testing.T) {
+	t.Parallel()
+
+	repo := newStoryDiscussionRepository()
+	service := newStoryDiscussionService(repo)
+
+	_, err := service.CreateComment(t.Context(), storyCommentParams("follower", "hello"))
+	require.NoError(t, err)
+
+	repo.comments["foreign"] = &discussions.Comment{ //nolint:exhaustruct
+		ID:           "foreign",
+		ThreadID:     "other-thread",
+		AuthorUserID: "follower",
+	}
+
+	err = service.DeleteStoryComment(t.Context(), discussions.DeleteStoryCommentParams{
+		CommentID:   "foreign",
+		UserID:      "maintainer",
+		ProfileSlug: "author",
+		StorySlug:   "hello",
+	})
+
+	require.ErrorIs(t, err, discussions.ErrCommentNotFound)
+	assert.Empty(t, repo.deleted)
+}
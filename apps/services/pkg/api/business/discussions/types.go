@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
 )
 
 // Content length constraints.
@@ -15,6 +16,13 @@ const (
 	MaxPageLimit     = 100
 )
 
+// Membership levels required on the author profile of a story to take part
+// in its discussion.
+const (
+	MinStoryPostingMembership    = profiles.MembershipKindFollower
+	MinStoryModerationMembership = profiles.MembershipKindMaintainer
+)
+
 // SortMode defines the comment sort order.
 type SortMode string
 
@@ -84,7 +92,17 @@ type CommentVote struct {
 	Direction int       `json:"direction"`
 }
 
+// StoryDiscussionSettings holds the flags deciding whether a story accepts discussions.
+type StoryDiscussionSettings struct {
+	AuthorProfileID          *string
+	AuthorProfileSlug        *string
+	AuthorFeatureDiscussions string
+	FeatDiscussions          bool
+}
+
 // CreateCommentParams holds parameters for creating a new comment.
+// When both StorySlug and ProfileSlug are set, the comment goes to the
+// discussion of a story that belongs to that profile.
 type CreateCommentParams struct {
 	StorySlug   *string
 	ProfileSlug *string
@@ -108,6 +126,14 @@ type DeleteCommentParams struct {
 	ProfileSlug string
 }
 
+// DeleteStoryCommentParams holds parameters for deleting a comment of a story discussion.
+type DeleteStoryCommentParams struct {
+	CommentID   string
+	UserID      string
+	ProfileSlug string
+	StorySlug   string
+}
+
 // VoteParams holds parameters for voting on a comment.
 type VoteParams struct {
 	CommentID string
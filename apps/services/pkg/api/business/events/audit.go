@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -11,6 +12,13 @@ import (
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 )
 
+// ErrInvalidAuditRange is returned when an export range is empty or reversed.
+var ErrInvalidAuditRange = errors.New("invalid audit range")
+
+// auditExportBatchSize is how many entries are read from storage at a time
+// while exporting a range.
+const auditExportBatchSize = 500
+
 // ActorKind classifies who triggered the event.
 type ActorKind string
 
@@ -22,15 +30,29 @@ const (
 
 // AuditEntry is an immutable record of a business event.
 type AuditEntry struct {
-	CreatedAt  time.Time
-	ActorID    *string
-	SessionID  *string
-	Payload    map[string]any
-	ID         string
-	EventType  EventType
-	EntityType string
-	EntityID   string
-	ActorKind  ActorKind
+	CreatedAt  time.Time      `json:"created_at"`
+	ActorID    *string        `json:"actor_id"`
+	SessionID  *string        `json:"session_id"`
+	Payload    map[string]any `json:"payload"`
+	ID         string         `json:"id"`
+	EventType  EventType      `json:"event_type"`
+	EntityType string         `json:"entity_type"`
+	EntityID   string         `json:"entity_id"`
+	ActorKind  ActorKind      `json:"actor_kind"`
+}
+
+// AuditRangeFilter selects the audit entries created in [From, To), optionally
+// narrowed to a single event type.
+type AuditRangeFilter struct {
+	From      time.Time
+	To        time.Time
+	EventType *EventType
+}
+
+// AuditCursor is the position of the last entry read while paging through a range.
+type AuditCursor struct {
+	CreatedAt time.Time
+	ID        string
 }
 
 // AuditParams holds parameters for recording an audit entry.
@@ -75,6 +97,15 @@ type AuditRepository interface {
 		entityID string,
 		limit int,
 	) ([]*AuditEntry, error)
+
+	// ListInRange returns up to limit entries matching filter in (created_at, id)
+	// order, starting after the cursor when one is given.
+	ListInRange(
+		ctx context.Context,
+		filter AuditRangeFilter,
+		after *AuditCursor,
+		limit int,
+	) ([]*AuditEntry, error)
 }
 
 // IDGenerator is a function that generates unique IDs.
@@ -83,12 +114,17 @@ type IDGenerator func() string
 // SessionIDFromCtx extracts a session ID from context (injected by the adapter layer).
 type SessionIDFromCtx func(context.Context) *string
 
+// OnRecordedFunc is called after an audit entry has been persisted.
+type OnRecordedFunc func(ctx context.Context, params AuditParams)
+
 // AuditService records audit entries.
 type AuditService struct {
 	logger           *logfx.Logger
 	repo             AuditRepository
 	idGenerator      IDGenerator
 	sessionIDFromCtx SessionIDFromCtx
+
+	onRecorded []OnRecordedFunc
 }
 
 // NewAuditService creates a new audit service.
@@ -103,9 +139,18 @@ func NewAuditService(
 		repo:             repo,
 		idGenerator:      idGenerator,
 		sessionIDFromCtx: sessionIDFromCtx,
+
+		onRecorded: nil,
 	}
 }
 
+// AddOnRecorded adds a callback that runs after every entry written by Record,
+// so other modules can react to the same business events. Callbacks run in the
+// order they were added. Add them during startup.
+func (s *AuditService) AddOnRecorded(fn OnRecordedFunc) {
+	s.onRecorded = append(s.onRecorded, fn)
+}
+
 // Record persists an audit entry. Fire-and-forget: errors are logged but not propagated,
 // because audit failures must never break business operations.
 func (s *AuditService) Record(ctx context.Context, params AuditParams) {
@@ -124,6 +169,12 @@ func (s *AuditService) Record(ctx context.Context, params AuditParams) {
 			slog.String("entity_id", params.EntityID),
 			slog.Any("error", err),
 		)
+
+		return
+	}
+
+	for _, onRecorded := range s.onRecorded {
+		onRecorded(ctx, params)
 	}
 }
 
@@ -182,3 +233,40 @@ func (s *AuditService) ListByEntity(
 
 	return entries, nil
 }
+
+// ExportRange reads the audit entries matching filter in chronological order and
+// hands them to emit one by one. Entries are fetched in batches so that large
+// ranges are never held in memory at once. An error from emit stops the export.
+func (s *AuditService) ExportRange(
+	ctx context.Context,
+	filter AuditRangeFilter,
+	emit func(entry *AuditEntry) error,
+) error {
+	if !filter.To.After(filter.From) {
+		return fmt.Errorf("%w: %s is not before %s", ErrInvalidAuditRange,
+			filter.From.Format(time.RFC3339), filter.To.Format(time.RFC3339))
+	}
+
+	var after *AuditCursor
+
+	for {
+		entries, err := s.repo.ListInRange(ctx, filter, after, auditExportBatchSize)
+		if err != nil {
+			return fmt.Errorf("listing audit entries: %w", err)
+		}
+
+		for _, entry := range entries {
+			err = emit(entry)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(entries) < auditExportBatchSize {
+			return nil
+		}
+
+		last := entries[len(entries)-1]
+		after = &AuditCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
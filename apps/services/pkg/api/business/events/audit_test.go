@@ -0,0 +1,208 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errStopExport = errors.New("client went away")
+
+// rangeAuditRepository pages through in-memory entries the way the storage
+// layer does: filtered, ordered by (created_at, id) and resumed after a cursor.
+type rangeAuditRepository struct {
+	events.AuditRepository
+
+	entries []*events.AuditEntry
+	calls   int
+}
+
+func (r *rangeAuditRepository) ListInRange(
+	_ context.Context,
+	filter events.AuditRangeFilter,
+	after *events.AuditCursor,
+	limit int,
+) ([]*events.AuditEntry, error) {
+	r.calls++
+
+	result := make([]*events.AuditEntry, 0, limit)
+
+	for _, entry := range r.entries {
+		if entry.CreatedAt.Before(filter.From) || !entry.CreatedAt.Before(filter.To) {
+			continue
+		}
+
+		if filter.EventType != nil && entry.EventType != *filter.EventType {
+			continue
+		}
+
+		if after != nil && (entry.CreatedAt.Before(after.CreatedAt) ||
+			entry.CreatedAt.Equal(after.CreatedAt) && entry.ID <= after.ID) {
+			continue
+		}
+
+		result = append(result, entry)
+		if len(result) == limit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// newRangeAuditRepository creates count entries a minute apart starting at start,
+// alternating between profile created and profile updated events.
+func newRangeAuditRepository(start time.Time, count int) *rangeAuditRepository {
+	entries := make([]*events.AuditEntry, 0, count)
+
+	for i := range count {
+		eventType := events.ProfileCreated
+		if i%2 == 1 {
+			eventType = events.ProfileUpdated
+		}
+
+		entries = append(entries, &events.AuditEntry{ //nolint:exhaustruct
+			ID:         fmt.Sprintf("audit-%05d", i),
+			CreatedAt:  start.Add(time.Duration(i) * time.Minute),
+			EventType:  eventType,
+			EntityType: "profile",
+			EntityID:   "profile-1",
+			ActorKind:  events.ActorSystem,
+		})
+	}
+
+	return &rangeAuditRepository{entries: entries} //nolint:exhaustruct
+}
+
+func TestExportRange(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	updated := events.ProfileUpdated
+
+	tests := map[string]struct {
+		filter   events.AuditRangeFilter
+		expected int
+		first    string
+		last     string
+	}{
+		"whole range across batches": {
+			filter:   events.AuditRangeFilter{From: start, To: start.Add(24 * time.Hour), EventType: nil},
+			expected: 1200,
+			first:    "audit-00000",
+			last:     "audit-01199",
+		},
+		"bounds are half-open": {
+			filter: events.AuditRangeFilter{
+				From:      start.Add(10 * time.Minute),
+				To:        start.Add(20 * time.Minute),
+				EventType: nil,
+			},
+			expected: 10,
+			first:    "audit-00010",
+			last:     "audit-00019",
+		},
+		"event type filter": {
+			filter:   events.AuditRangeFilter{From: start, To: start.Add(24 * time.Hour), EventType: &updated},
+			expected: 600,
+			first:    "audit-00001",
+			last:     "audit-01199",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newRangeAuditRepository(start, 1200)
+			service := events.NewAuditService(nil, repo, func() string { return "audit" }, nil)
+
+			var ids []string
+
+			err := service.ExportRange(t.Context(), tt.filter, func(entry *events.AuditEntry) error {
+				if tt.filter.EventType != nil {
+					assert.Equal(t, *tt.filter.EventType, entry.EventType)
+				}
+
+				ids = append(ids, entry.ID)
+
+				return nil
+			})
+
+			require.NoError(t, err)
+			require.Len(t, ids, tt.expected)
+			assert.Equal(t, tt.first, ids[0])
+			assert.Equal(t, tt.last, ids[len(ids)-1])
+			assert.True(t, sort.StringsAreSorted(ids), "entries are emitted in order")
+		})
+	}
+}
+
+func TestExportRange_ReadsInBatches(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := newRangeAuditRepository(start, 1200)
+	service := events.NewAuditService(nil, repo, func() string { return "audit" }, nil)
+	filter := events.AuditRangeFilter{From: start, To: start.Add(24 * time.Hour), EventType: nil}
+
+	count := 0
+
+	err := service.ExportRange(t.Context(), filter, func(_ *events.AuditEntry) error {
+		count++
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1200, count)
+	assert.Equal(t, 3, repo.calls)
+}
+
+func TestExportRange_StopsOnEmitError(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := newRangeAuditRepository(start, 1200)
+	service := events.NewAuditService(nil, repo, func() string { return "audit" }, nil)
+	filter := events.AuditRangeFilter{From: start, To: start.Add(24 * time.Hour), EventType: nil}
+
+	count := 0
+
+	err := service.ExportRange(t.Context(), filter, func(_ *events.AuditEntry) error {
+		count++
+		if count == 3 {
+			return errStopExport
+		}
+
+		return nil
+	})
+
+	require.ErrorIs(t, err, errStopExport)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, 1, repo.calls)
+}
+
+func TestExportRange_InvalidRange(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	repo := newRangeAuditRepository(start, 10)
+	service := events.NewAuditService(nil, repo, func() string { return "audit" }, nil)
+
+	err := service.ExportRange(
+		t.Context(),
+		events.AuditRangeFilter{From: start, To: start, EventType: nil},
+		func(_ *events.AuditEntry) error { return nil },
+	)
+
+	require.ErrorIs(t, err, events.ErrInvalidAuditRange)
+	assert.Zero(t, repo.calls)
+}
@@ -14,6 +14,7 @@ var (
 	ErrFailedToClaim        = errors.New("failed to claim event queue item")
 	ErrFailedToComplete     = errors.New("failed to complete event queue item")
 	ErrFailedToFail         = errors.New("failed to mark event queue item as failed")
+	ErrFailedToGetItem      = errors.New("failed to get event queue item")
 	ErrHandlerNotRegistered = errors.New("no handler registered for item type")
 	ErrHandlerPanicked      = errors.New("event queue handler panicked")
 )
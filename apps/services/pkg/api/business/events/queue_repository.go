@@ -38,6 +38,19 @@ type QueueRepository interface {
 		backoffSeconds int,
 	) error
 
+	// GetByID returns an item by its ID.
+	// Returns nil, nil if the item does not exist.
+	GetByID(ctx context.Context, id string) (*QueueItem, error)
+
+	// GetActiveByPayload returns the latest pending or processing item of a
+	// type whose payload contains the given one.
+	// Returns nil, nil if there is none.
+	GetActiveByPayload(
+		ctx context.Context,
+		itemType QueueItemType,
+		payload map[string]any,
+	) (*QueueItem, error)
+
 	// ListByType returns items of a given type (for audit/debugging).
 	ListByType(ctx context.Context, itemType QueueItemType, limit int) ([]*QueueItem, error)
 }
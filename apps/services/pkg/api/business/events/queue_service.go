@@ -68,6 +68,31 @@ func (s *QueueService) Enqueue(ctx context.Context, params QueueEnqueueParams) (
 	return eventID, nil
 }
 
+// Get returns an item by its ID, or nil if it does not exist.
+func (s *QueueService) Get(ctx context.Context, id string) (*QueueItem, error) {
+	item, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetItem, id, err)
+	}
+
+	return item, nil
+}
+
+// GetActive returns the latest pending or processing item of a type whose
+// payload contains the given one, or nil if there is none.
+func (s *QueueService) GetActive(
+	ctx context.Context,
+	itemType QueueItemType,
+	payload map[string]any,
+) (*QueueItem, error) {
+	item, err := s.repo.GetActiveByPayload(ctx, itemType, payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w(type: %s): %w", ErrFailedToGetItem, itemType, err)
+	}
+
+	return item, nil
+}
+
 // CalculateBackoff returns the delay in seconds for exponential backoff.
 // Formula: baseSeconds * 2^retryCount (e.g., base=4: 8s, 16s, 32s, 64s...).
 func CalculateBackoff(retryCount int, baseSeconds int) int {
@@ -24,6 +24,12 @@ const (
 	QueueItemTypeStoryUpdated QueueItemType = "STORY_UPDATED"
 	QueueItemTypeProfileSync  QueueItemType = "PROFILE_SYNC"
 	QueueItemTypeNotification QueueItemType = "NOTIFICATION"
+
+	QueueItemTypeConsistencyCheck  QueueItemType = "CONSISTENCY_CHECK"
+	QueueItemTypeWebmentionVerify  QueueItemType = "WEBMENTION_VERIFY"
+	QueueItemTypePointsAward       QueueItemType = "POINTS_AWARD"
+	QueueItemTypePageAutoTranslate QueueItemType = "PAGE_AUTO_TRANSLATE"
+	QueueItemTypeWebhookMembership QueueItemType = "WEBHOOK_MEMBERSHIP"
 )
 
 // QueueItem represents an item in the event queue.
@@ -19,10 +19,20 @@ const (
 
 // Profile events.
 const (
-	ProfileCreated            EventType = "profile_created"
-	ProfileUpdated            EventType = "profile_updated"
-	ProfileTranslationUpdated EventType = "profile_translation_updated"
-	ProfileVisited            EventType = "profile_visited"
+	ProfileCreated              EventType = "profile_created"
+	ProfileUpdated              EventType = "profile_updated"
+	ProfileDeleted              EventType = "profile_deleted"
+	ProfileRestored             EventType = "profile_restored"
+	ProfileMerged               EventType = "profile_merged"
+	ProfileSlugChanged          EventType = "profile_slug_changed"
+	ProfileTranslationUpdated   EventType = "profile_translation_updated"
+	ProfileTranslationsImported EventType = "profile_translations_imported"
+	ProfileLocaleDeleted        EventType = "profile_locale_deleted"
+	ProfileDefaultLocaleChanged EventType = "profile_default_locale_changed"
+	ProfileVisited              EventType = "profile_visited"
+	ProfileMentioned            EventType = "profile_mentioned"
+	ProfileReported             EventType = "profile_reported"
+	ProfileReportResolved       EventType = "profile_report_resolved"
 )
 
 // Profile page events.
@@ -30,12 +40,27 @@ const (
 	ProfilePageCreated            EventType = "profile_page_created"
 	ProfilePageUpdated            EventType = "profile_page_updated"
 	ProfilePageDeleted            EventType = "profile_page_deleted"
+	ProfilePagePublished          EventType = "profile_page_published"
 	ProfilePageTranslationUpdated EventType = "profile_page_translation_updated"
 	ProfilePageTranslationDeleted EventType = "profile_page_translation_deleted"
 	ProfilePageAutoTranslated     EventType = "profile_page_auto_translated"
 	ProfilePageAIGenerated        EventType = "profile_page_ai_generated"
 )
 
+// Profile custom domain events.
+const (
+	ProfileCustomDomainCreated EventType = "profile_custom_domain_created"
+	ProfileCustomDomainUpdated EventType = "profile_custom_domain_updated"
+	ProfileCustomDomainDeleted EventType = "profile_custom_domain_deleted"
+)
+
+// Profile handle reservation events.
+const (
+	ProfileHandleReserved EventType = "profile_handle_reserved"
+	ProfileHandleReleased EventType = "profile_handle_released"
+	ProfileHandleClaimed  EventType = "profile_handle_claimed"
+)
+
 // Profile link events.
 const (
 	ProfileLinkCreated EventType = "profile_link_created"
@@ -49,6 +74,23 @@ const (
 	ProfileMembershipUpdated      EventType = "profile_membership_updated"
 	ProfileMembershipDeleted      EventType = "profile_membership_deleted"
 	ProfileMembershipTeamsUpdated EventType = "profile_membership_teams_updated"
+	ProfileOwnershipTransferred   EventType = "profile_ownership_transferred"
+
+	ProfileMembershipInvited            EventType = "profile_membership_invited"
+	ProfileMembershipInvitationAccepted EventType = "profile_membership_invitation_accepted"
+	ProfileMembershipInvitationDeclined EventType = "profile_membership_invitation_declined"
+)
+
+// Profile resource events.
+const (
+	ProfileResourceRestored EventType = "profile_resource_restored"
+)
+
+// Profile team events.
+const (
+	ProfileTeamMembersAdded   EventType = "profile_team_members_added"
+	ProfileTeamMembersRemoved EventType = "profile_team_members_removed"
+	ProfileTeamLeadChanged    EventType = "profile_team_lead_changed"
 )
 
 // Profile candidate events.
@@ -66,6 +108,7 @@ const (
 	ProfileQuestionAnswerEdited EventType = "profile_question_answer_edited"
 	ProfileQuestionVoted        EventType = "profile_question_voted"
 	ProfileQuestionHidden       EventType = "profile_question_hidden"
+	ProfileQuestionResolved     EventType = "profile_question_resolved"
 )
 
 // Discussion events.
@@ -128,10 +171,28 @@ const (
 	SessionTerminated EventType = "session_terminated"
 )
 
+// Impersonation events. Each carries both the admin and the impersonated user.
+const (
+	ImpersonationStarted        EventType = "impersonation_started"
+	ImpersonationEnded          EventType = "impersonation_ended"
+	ImpersonationRequest        EventType = "impersonation_request"
+	ImpersonationRequestBlocked EventType = "impersonation_request_blocked"
+)
+
 // User events.
 const (
 	UserCreated EventType = "user_created"
 	UserUpdated EventType = "user_updated"
+
+	UserIndividualProfileUnlinked EventType = "user_individual_profile_unlinked"
+	UserIndividualProfileRelinked EventType = "user_individual_profile_relinked"
+)
+
+// Consistency check events.
+const (
+	ConsistencyCheckRequested EventType = "consistency_check_requested"
+	ConsistencyCheckCompleted EventType = "consistency_check_completed"
+	ConsistencyRepairApplied  EventType = "consistency_repair_applied"
 )
 
 // OAuth events.
@@ -17,5 +17,10 @@ var (
 	ErrFailedToListImports        = errors.New("failed to list imports for story creation")
 	ErrFailedToUpdateOnlineStatus = errors.New("failed to update online status")
 	ErrFailedToClearStaleOnline   = errors.New("failed to clear stale online links")
+	ErrFailedToUpdateSyncStatus   = errors.New("failed to update sync status")
+	ErrUnsupportedResyncProvider  = errors.New("provider does not support resync")
+	ErrFailedToRequestResync      = errors.New("failed to request resync")
+	ErrFailedToGetResync          = errors.New("failed to get resync")
+	ErrResyncJobNotFound          = errors.New("resync job not found")
 	ErrNotFound                   = errors.New("not found")
 )
@@ -0,0 +1,78 @@
+package linksync
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ProviderError is returned by providers when a remote API refuses a request.
+// It keeps the status code and the provider's Retry-After hint, so workers can
+// back off instead of calling a struggling API again on their next tick.
+type ProviderError struct {
+	Err        error
+	StatusCode int
+	RetryAfter time.Duration // zero when the provider didn't send a hint
+}
+
+// NewProviderError wraps err with the status and the Retry-After header of a
+// provider response.
+func NewProviderError(err error, statusCode int, header http.Header) *ProviderError {
+	return &ProviderError{
+		Err:        err,
+		StatusCode: statusCode,
+		RetryAfter: ParseRetryAfter(header.Get("Retry-After"), time.Now()),
+	}
+}
+
+func (e *ProviderError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ProviderError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable reports whether the provider is rate limiting or failing, as
+// opposed to rejecting this particular request.
+func (e *ProviderError) IsRetryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// AsRetryableProviderError returns the provider error in err's chain when it is
+// retryable.
+func AsRetryableProviderError(err error) (*ProviderError, bool) {
+	var providerErr *ProviderError
+	if !errors.As(err, &providerErr) || !providerErr.IsRetryable() {
+		return nil, false
+	}
+
+	return providerErr, true
+}
+
+// ParseRetryAfter parses a Retry-After header value, given either in seconds or
+// as an HTTP date. It returns zero for empty, malformed or past values.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(value)
+	if err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+
+		return time.Duration(seconds) * time.Second
+	}
+
+	at, err := http.ParseTime(value)
+	if err != nil || !at.After(now) {
+		return 0
+	}
+
+	return at.Sub(now)
+}
@@ -7,8 +7,14 @@ import (
 
 // Repository defines the storage operations for link sync.
 type Repository interface { //nolint:interfacebloat
-	// ListManagedLinksForKind returns all managed, non-deleted links of a kind.
-	ListManagedLinksForKind(ctx context.Context, kind string, limit int) ([]*ManagedLink, error)
+	// ListManagedLinksForKind returns all managed, non-deleted links of a kind,
+	// optionally limited to one profile's links.
+	ListManagedLinksForKind(
+		ctx context.Context,
+		kind string,
+		profileID *string,
+		limit int,
+	) ([]*ManagedLink, error)
 
 	// GetLatestImportByLinkID returns the most recent import for a link.
 	GetLatestImportByLinkID(ctx context.Context, linkID string) (*LinkImport, error)
@@ -56,10 +62,12 @@ type Repository interface { //nolint:interfacebloat
 		limit int,
 	) ([]*LinkImportWithStory, error)
 
-	// ListManagedLinksForKindPublic returns managed, non-deleted links of a kind (no OAuth tokens required).
+	// ListManagedLinksForKindPublic returns managed, non-deleted links of a kind (no OAuth tokens required),
+	// optionally limited to one profile's links.
 	ListManagedLinksForKindPublic(
 		ctx context.Context,
 		kind string,
+		profileID *string,
 		limit int,
 	) ([]*PublicManagedLink, error)
 
@@ -78,4 +86,13 @@ type Repository interface { //nolint:interfacebloat
 		staleThreshold time.Time,
 		onlineProperties map[string]any,
 	) (int64, error)
+
+	// UpdateLinkSyncStatus records the outcome of a sync attempt for a link.
+	// syncError is nil when the attempt succeeded.
+	UpdateLinkSyncStatus(
+		ctx context.Context,
+		linkID string,
+		status string,
+		syncError *string,
+	) error
 }
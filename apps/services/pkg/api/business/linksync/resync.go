@@ -0,0 +1,115 @@
+package linksync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// Providers whose links can be resynced on demand.
+const (
+	ResyncProviderYouTube      = "youtube"
+	ResyncProviderSpeakerDeck  = "speakerdeck"
+	ResyncProviderExternalSite = "external-site"
+)
+
+// ResyncProviders lists the providers accepted by RequestProfileResync.
+var ResyncProviders = []string{ //nolint:gochecknoglobals
+	ResyncProviderYouTube,
+	ResyncProviderSpeakerDeck,
+	ResyncProviderExternalSite,
+}
+
+// ResyncJob is an on-demand sync of one profile's links of a provider, run by
+// the queue worker.
+type ResyncJob struct {
+	ErrorMessage *string                `json:"error_message"`
+	ID           string                 `json:"id"`
+	ProfileID    string                 `json:"profile_id"`
+	Provider     string                 `json:"provider"`
+	Status       events.QueueItemStatus `json:"status"`
+}
+
+// RequestProfileResync queues an immediate sync of the profile's links of the
+// given provider. While a resync of the same profile and provider is still
+// pending or running, that job is returned instead of queueing another one.
+func (s *Service) RequestProfileResync(
+	ctx context.Context,
+	profileID string,
+	provider string,
+) (*ResyncJob, error) {
+	if !slices.Contains(ResyncProviders, provider) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedResyncProvider, provider)
+	}
+
+	payload := map[string]any{
+		"profile_id": profileID,
+		"provider":   provider,
+	}
+
+	active, err := s.queueService.GetActive(ctx, events.QueueItemTypeProfileSync, payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToRequestResync, profileID, err)
+	}
+
+	if active != nil {
+		return resyncJobFromQueueItem(active), nil
+	}
+
+	jobID, err := s.queueService.Enqueue(ctx, events.QueueEnqueueParams{
+		Type:                  events.QueueItemTypeProfileSync,
+		Payload:               payload,
+		ScheduledAt:           nil,
+		MaxRetries:            0,
+		VisibilityTimeoutSecs: 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToRequestResync, profileID, err)
+	}
+
+	return &ResyncJob{
+		ErrorMessage: nil,
+		ID:           jobID,
+		ProfileID:    profileID,
+		Provider:     provider,
+		Status:       events.QueueStatusPending,
+	}, nil
+}
+
+// GetProfileResync returns a resync job of the profile.
+func (s *Service) GetProfileResync(
+	ctx context.Context,
+	profileID string,
+	jobID string,
+) (*ResyncJob, error) {
+	item, err := s.queueService.Get(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetResync, jobID, err)
+	}
+
+	if item == nil || item.Type != events.QueueItemTypeProfileSync {
+		return nil, fmt.Errorf("%w(id: %s)", ErrResyncJobNotFound, jobID)
+	}
+
+	job := resyncJobFromQueueItem(item)
+	if job.ProfileID != profileID {
+		return nil, fmt.Errorf("%w(id: %s)", ErrResyncJobNotFound, jobID)
+	}
+
+	return job, nil
+}
+
+func resyncJobFromQueueItem(item *events.QueueItem) *ResyncJob {
+	profileID, _ := item.Payload["profile_id"].(string)
+	provider, _ := item.Payload["provider"].(string)
+
+	return &ResyncJob{
+		ErrorMessage: item.ErrorMessage,
+		ID:           item.ID,
+		ProfileID:    profileID,
+		Provider:     provider,
+		Status:       item.Status,
+	}
+}
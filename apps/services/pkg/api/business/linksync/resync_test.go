@@ -0,0 +1,164 @@
+package linksync_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/linksync"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryQueueRepository keeps queued items in memory.
+type memoryQueueRepository struct {
+	events.QueueRepository
+
+	items []*events.QueueItem
+}
+
+func (r *memoryQueueRepository) Enqueue(
+	_ context.Context,
+	id string,
+	itemType events.QueueItemType,
+	payload map[string]any,
+	maxRetries int,
+	_ int,
+	visibleAt time.Time,
+) error {
+	r.items = append(r.items, &events.QueueItem{ //nolint:exhaustruct
+		ID:         id,
+		Type:       itemType,
+		Payload:    payload,
+		Status:     events.QueueStatusPending,
+		MaxRetries: maxRetries,
+		VisibleAt:  visibleAt,
+	})
+
+	return nil
+}
+
+func (r *memoryQueueRepository) GetByID(_ context.Context, id string) (*events.QueueItem, error) {
+	for _, item := range r.items {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (r *memoryQueueRepository) GetActiveByPayload(
+	_ context.Context,
+	itemType events.QueueItemType,
+	payload map[string]any,
+) (*events.QueueItem, error) {
+	for _, item := range r.items {
+		if item.Type != itemType ||
+			(item.Status != events.QueueStatusPending && item.Status != events.QueueStatusProcessing) {
+			continue
+		}
+
+		matches := true
+
+		for key, value := range payload {
+			if item.Payload[key] != value {
+				matches = false
+			}
+		}
+
+		if matches {
+			return item, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func newResyncService() (*linksync.Service, *memoryQueueRepository) {
+	counter := 0
+	idGenerator := func() string {
+		counter++
+
+		return "job-" + strconv.Itoa(counter)
+	}
+
+	queueRepo := &memoryQueueRepository{} //nolint:exhaustruct
+	queueService := events.NewQueueService(nil, queueRepo, idGenerator)
+
+	return linksync.NewService(nil, nil, queueService, idGenerator), queueRepo
+}
+
+func TestRequestProfileResync_PreventsDuplicates(t *testing.T) {
+	t.Parallel()
+
+	service, queueRepo := newResyncService()
+
+	job, err := service.RequestProfileResync(t.Context(), "profile-1", linksync.ResyncProviderYouTube)
+	require.NoError(t, err)
+	assert.Equal(t, events.QueueStatusPending, job.Status)
+
+	// A second request while the first is running returns the same job.
+	queueRepo.items[0].Status = events.QueueStatusProcessing
+
+	again, err := service.RequestProfileResync(t.Context(), "profile-1", linksync.ResyncProviderYouTube)
+	require.NoError(t, err)
+	assert.Equal(t, job.ID, again.ID)
+	assert.Equal(t, events.QueueStatusProcessing, again.Status)
+
+	// Other providers and other profiles get their own jobs.
+	other, err := service.RequestProfileResync(
+		t.Context(), "profile-1", linksync.ResyncProviderSpeakerDeck,
+	)
+	require.NoError(t, err)
+	assert.NotEqual(t, job.ID, other.ID)
+
+	other, err = service.RequestProfileResync(t.Context(), "profile-2", linksync.ResyncProviderYouTube)
+	require.NoError(t, err)
+	assert.NotEqual(t, job.ID, other.ID)
+
+	// Once the job is done, the profile can be resynced again.
+	queueRepo.items[0].Status = events.QueueStatusCompleted
+
+	next, err := service.RequestProfileResync(t.Context(), "profile-1", linksync.ResyncProviderYouTube)
+	require.NoError(t, err)
+	assert.NotEqual(t, job.ID, next.ID)
+	assert.Len(t, queueRepo.items, 4)
+}
+
+func TestRequestProfileResync_UnsupportedProvider(t *testing.T) {
+	t.Parallel()
+
+	service, queueRepo := newResyncService()
+
+	_, err := service.RequestProfileResync(t.Context(), "profile-1", "myspace")
+	require.ErrorIs(t, err, linksync.ErrUnsupportedResyncProvider)
+	assert.Empty(t, queueRepo.items)
+}
+
+func TestGetProfileResync(t *testing.T) {
+	t.Parallel()
+
+	service, queueRepo := newResyncService()
+
+	job, err := service.RequestProfileResync(t.Context(), "profile-1", linksync.ResyncProviderYouTube)
+	require.NoError(t, err)
+
+	message := "quota exceeded"
+	queueRepo.items[0].Status = events.QueueStatusFailed
+	queueRepo.items[0].ErrorMessage = &message
+
+	status, err := service.GetProfileResync(t.Context(), "profile-1", job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, events.QueueStatusFailed, status.Status)
+	assert.Equal(t, &message, status.ErrorMessage)
+
+	// Jobs of other profiles are not visible.
+	_, err = service.GetProfileResync(t.Context(), "profile-2", job.ID)
+	require.ErrorIs(t, err, linksync.ErrResyncJobNotFound)
+
+	_, err = service.GetProfileResync(t.Context(), "profile-1", "missing")
+	require.ErrorIs(t, err, linksync.ErrResyncJobNotFound)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
 )
 
 // IDGenerator is a function that generates unique IDs.
@@ -14,21 +15,24 @@ type IDGenerator func() string
 
 // Service provides link sync operations.
 type Service struct {
-	logger      *logfx.Logger
-	repo        Repository
-	idGenerator IDGenerator
+	logger       *logfx.Logger
+	repo         Repository
+	queueService *events.QueueService
+	idGenerator  IDGenerator
 }
 
 // NewService creates a new link sync service.
 func NewService(
 	logger *logfx.Logger,
 	repo Repository,
+	queueService *events.QueueService,
 	idGenerator IDGenerator,
 ) *Service {
 	return &Service{
-		logger:      logger,
-		repo:        repo,
-		idGenerator: idGenerator,
+		logger:       logger,
+		repo:         repo,
+		queueService: queueService,
+		idGenerator:  idGenerator,
 	}
 }
 
@@ -38,7 +42,7 @@ func (s *Service) GetManagedLinks(
 	kind string,
 	limit int,
 ) ([]*ManagedLink, error) {
-	links, err := s.repo.ListManagedLinksForKind(ctx, kind, limit)
+	links, err := s.repo.ListManagedLinksForKind(ctx, kind, nil, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToGetLinks, err)
 	}
@@ -137,13 +141,44 @@ func (s *Service) ListImportsWithExistingStories(
 	return imports, nil
 }
 
+// GetProfileManagedLinks returns a profile's managed links for a given kind.
+func (s *Service) GetProfileManagedLinks(
+	ctx context.Context,
+	kind string,
+	profileID string,
+	limit int,
+) ([]*ManagedLink, error) {
+	links, err := s.repo.ListManagedLinksForKind(ctx, kind, &profileID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetLinks, profileID, err)
+	}
+
+	return links, nil
+}
+
+// GetProfilePublicManagedLinks returns a profile's managed links for a given
+// kind (no OAuth tokens required).
+func (s *Service) GetProfilePublicManagedLinks(
+	ctx context.Context,
+	kind string,
+	profileID string,
+	limit int,
+) ([]*PublicManagedLink, error) {
+	links, err := s.repo.ListManagedLinksForKindPublic(ctx, kind, &profileID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetLinks, profileID, err)
+	}
+
+	return links, nil
+}
+
 // GetPublicManagedLinks returns managed links for a given kind (no OAuth tokens required).
 func (s *Service) GetPublicManagedLinks(
 	ctx context.Context,
 	kind string,
 	limit int,
 ) ([]*PublicManagedLink, error) {
-	links, err := s.repo.ListManagedLinksForKindPublic(ctx, kind, limit)
+	links, err := s.repo.ListManagedLinksForKindPublic(ctx, kind, nil, limit)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToGetLinks, err)
 	}
@@ -196,3 +231,30 @@ func (s *Service) UpdateLinkTokens(
 
 	return nil
 }
+
+// RecordSyncAttempt records the outcome of syncing a link, so the owner can see
+// when and why syncing stopped. A nil syncErr records a success and clears the
+// previous error.
+func (s *Service) RecordSyncAttempt(ctx context.Context, linkID string, syncErr error) error {
+	status := SyncStatusSucceeded
+
+	var message *string
+
+	if syncErr != nil {
+		status = SyncStatusFailed
+
+		text := syncErr.Error()
+		if runes := []rune(text); len(runes) > MaxSyncErrorLength {
+			text = string(runes[:MaxSyncErrorLength])
+		}
+
+		message = &text
+	}
+
+	err := s.repo.UpdateLinkSyncStatus(ctx, linkID, status, message)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateSyncStatus, err)
+	}
+
+	return nil
+}
@@ -27,6 +27,16 @@ type ManagedLink struct {
 	IsOnline                 bool
 }
 
+// Sync statuses recorded on a managed link after each sync attempt.
+const (
+	SyncStatusSucceeded = "succeeded"
+	SyncStatusFailed    = "failed"
+)
+
+// MaxSyncErrorLength caps the error message stored on a link, since provider
+// errors can carry whole response bodies.
+const MaxSyncErrorLength = 500
+
 // SyncResult represents the result of syncing a single link.
 type SyncResult struct {
 	Error        error
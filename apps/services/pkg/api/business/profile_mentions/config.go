@@ -0,0 +1,11 @@
+package profile_mentions
+
+// Config holds configuration for the profile mentions module.
+type Config struct {
+	// NotifyMentioned enables notifying profiles when they are newly mentioned.
+	NotifyMentioned bool `conf:"notify_mentioned" default:"true"`
+	// MaxMentionsPerContent caps how many distinct profiles a single content can mention.
+	MaxMentionsPerContent int `conf:"max_mentions_per_content" default:"20"`
+	// ListLimit is the maximum number of mentions returned per source kind.
+	ListLimit int `conf:"list_limit" default:"50"`
+}
@@ -0,0 +1,11 @@
+package profile_mentions
+
+import "errors"
+
+var (
+	ErrFailedToGetRecord    = errors.New("failed to get record")
+	ErrFailedToListRecords  = errors.New("failed to list records")
+	ErrFailedToSyncMentions = errors.New("failed to sync mentions")
+	ErrInvalidSourceKind    = errors.New("invalid mention source kind")
+	ErrProfileNotFound      = errors.New("profile not found")
+)
@@ -0,0 +1,171 @@
+package profile_mentions
+
+import (
+	"strings"
+)
+
+const (
+	mentionPrefix        = '@'
+	minMentionSlugLength = 2
+)
+
+// mentionSpan is the position of a "@slug" mention inside content.
+type mentionSpan struct {
+	slug  string
+	start int // index of the '@' character
+	end   int // index right after the slug
+}
+
+// ExtractMentionSlugs returns the distinct profile slugs mentioned as "@slug" in
+// markdown content, in order of first appearance. Mentions inside code blocks,
+// inline code, links, e-mail addresses and URLs are ignored.
+func ExtractMentionSlugs(content string) []string {
+	spans := scanMentions(content)
+	seen := make(map[string]bool, len(spans))
+	slugs := make([]string, 0, len(spans))
+
+	for _, span := range spans {
+		if seen[span.slug] {
+			continue
+		}
+
+		seen[span.slug] = true
+		slugs = append(slugs, span.slug)
+	}
+
+	return slugs
+}
+
+// LinkMentions rewrites "@slug" mentions whose slug is in linkable into markdown
+// links to the profile page. Other mentions are left as plain text.
+func LinkMentions(content string, localeCode string, linkable map[string]bool) string {
+	spans := scanMentions(content)
+	if len(spans) == 0 || len(linkable) == 0 {
+		return content
+	}
+
+	var builder strings.Builder
+
+	builder.Grow(len(content))
+
+	last := 0
+
+	for _, span := range spans {
+		if !linkable[span.slug] {
+			continue
+		}
+
+		builder.WriteString(content[last:span.start])
+		builder.WriteString("[@")
+		builder.WriteString(span.slug)
+		builder.WriteString("](/")
+		builder.WriteString(localeCode)
+		builder.WriteString("/")
+		builder.WriteString(span.slug)
+		builder.WriteString(")")
+
+		last = span.end
+	}
+
+	builder.WriteString(content[last:])
+
+	return builder.String()
+}
+
+// scanMentions finds all mention spans in content, skipping fenced code blocks.
+func scanMentions(content string) []mentionSpan {
+	var spans []mentionSpan
+
+	inFence := false
+	offset := 0
+
+	for _, line := range strings.SplitAfter(content, "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+		} else if !inFence {
+			spans = append(spans, scanLine(line, offset)...)
+		}
+
+		offset += len(line)
+	}
+
+	return spans
+}
+
+// scanLine finds mention spans in a single line, skipping inline code.
+func scanLine(line string, offset int) []mentionSpan {
+	var spans []mentionSpan
+
+	inCode := false
+
+	for i := 0; i < len(line); i++ {
+		char := line[i]
+
+		if char == '`' {
+			inCode = !inCode
+
+			continue
+		}
+
+		if inCode || char != mentionPrefix {
+			continue
+		}
+
+		if i > 0 && !isMentionBoundary(line[i-1]) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(line) && isMentionSlugChar(line[end]) {
+			end++
+		}
+
+		slug := strings.TrimRight(line[i+1:end], "-")
+		end = i + 1 + len(slug)
+
+		if len(slug) < minMentionSlugLength {
+			continue
+		}
+
+		// Reject partial matches such as "@Eser" or "@foo_bar".
+		if end < len(line) && isWordByte(line[end]) {
+			continue
+		}
+
+		spans = append(spans, mentionSpan{slug: slug, start: offset + i, end: offset + end})
+		i = end - 1
+	}
+
+	return spans
+}
+
+// isMentionBoundary reports whether a mention may follow the given byte.
+// Word characters and URL/link punctuation are not boundaries, which excludes
+// e-mail addresses, URLs and already-linked mentions.
+func isMentionBoundary(char byte) bool {
+	if isWordByte(char) {
+		return false
+	}
+
+	switch char {
+	case '.', '/', '[', '-', ':', '=', '+', '#', '&', '?':
+		return false
+	}
+
+	return true
+}
+
+func isMentionSlugChar(char byte) bool {
+	return (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-'
+}
+
+func isWordByte(char byte) bool {
+	return (char >= 'a' && char <= 'z') ||
+		(char >= 'A' && char <= 'Z') ||
+		(char >= '0' && char <= '9') ||
+		char == '_' ||
+		char == mentionPrefix ||
+		char >= 0x80
+}
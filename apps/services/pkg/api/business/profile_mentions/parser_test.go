@@ -0,0 +1,79 @@
+package profile_mentions_test
+
+import (
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractMentionSlugs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "simple mentions",
+			content:  "Thanks @eser and @aya-team for the help.",
+			expected: []string{"eser", "aya-team"},
+		},
+		{
+			name:     "deduplicates in order of appearance",
+			content:  "@eser, @jane and @eser again",
+			expected: []string{"eser", "jane"},
+		},
+		{
+			name:     "ignores e-mail addresses and urls",
+			content:  "mail me@example.com or see https://x.com/@someone",
+			expected: []string{},
+		},
+		{
+			name:     "ignores code",
+			content:  "use `@inline` here\n```\n@fenced\n```\nbut @real",
+			expected: []string{"real"},
+		},
+		{
+			name:     "ignores already linked mentions",
+			content:  "[@eser](/en/eser)",
+			expected: []string{},
+		},
+		{
+			name:     "ignores partial matches and short slugs",
+			content:  "@Eser @a @foo_bar",
+			expected: []string{},
+		},
+		{
+			name:     "trims trailing hyphens and punctuation",
+			content:  "Hello @eser-. (@jane)",
+			expected: []string{"eser", "jane"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, profile_mentions.ExtractMentionSlugs(tt.content))
+		})
+	}
+}
+
+func TestLinkMentions(t *testing.T) {
+	t.Parallel()
+
+	linkable := map[string]bool{"eser": true}
+
+	assert.Equal(
+		t,
+		"Hi [@eser](/tr/eser) and @unknown",
+		profile_mentions.LinkMentions("Hi @eser and @unknown", "tr", linkable),
+	)
+	assert.Equal(
+		t,
+		"`@eser` stays",
+		profile_mentions.LinkMentions("`@eser` stays", "tr", linkable),
+	)
+}
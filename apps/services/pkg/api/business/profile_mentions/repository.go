@@ -0,0 +1,52 @@
+package profile_mentions
+
+import "context"
+
+// Repository defines the storage operations for profile mentions (port).
+type Repository interface {
+	// GetProfileIDBySlug returns the profile ID for a slug (empty if not found).
+	GetProfileIDBySlug(ctx context.Context, slug string) (string, error)
+
+	// ListProfilesBySlugs resolves slugs to existing profiles.
+	ListProfilesBySlugs(ctx context.Context, slugs []string) ([]*MentionedProfile, error)
+
+	// GetMentionSourceProfileID returns the profile owning a story (author) or page.
+	GetMentionSourceProfileID(
+		ctx context.Context,
+		sourceKind string,
+		sourceID string,
+	) (string, error)
+
+	// ListMentionsBySource lists the profiles mentioned by a source in a locale.
+	ListMentionsBySource(
+		ctx context.Context,
+		sourceKind string,
+		sourceID string,
+		localeCode string,
+	) ([]*MentionedProfile, error)
+
+	// ReplaceMentionsBySource replaces the stored mentions of a source in a locale.
+	ReplaceMentionsBySource(
+		ctx context.Context,
+		sourceKind string,
+		sourceID string,
+		localeCode string,
+		mentions []*Mention,
+	) error
+
+	// ListStoryMentionsOfProfile lists public stories that mention a profile.
+	ListStoryMentionsOfProfile(
+		ctx context.Context,
+		localeCode string,
+		mentionedProfileID string,
+		limit int,
+	) ([]*MentionListItem, error)
+
+	// ListPageMentionsOfProfile lists public pages that mention a profile.
+	ListPageMentionsOfProfile(
+		ctx context.Context,
+		localeCode string,
+		mentionedProfileID string,
+		limit int,
+	) ([]*MentionListItem, error)
+}
@@ -0,0 +1,222 @@
+package profile_mentions
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// Service provides profile mention operations.
+type Service struct {
+	logger       *logfx.Logger
+	config       *Config
+	repo         Repository
+	auditService *events.AuditService
+	idGenerator  IDGenerator
+	onMentioned  OnMentionedFunc
+}
+
+// NewService creates a new profile mentions service.
+func NewService(
+	logger *logfx.Logger,
+	config *Config,
+	repo Repository,
+	auditService *events.AuditService,
+	idGenerator IDGenerator,
+) *Service {
+	return &Service{
+		logger:       logger,
+		config:       config,
+		repo:         repo,
+		auditService: auditService,
+		idGenerator:  idGenerator,
+		onMentioned:  nil,
+	}
+}
+
+// SetOnMentioned registers a callback invoked for each newly mentioned profile.
+func (s *Service) SetOnMentioned(fn OnMentionedFunc) {
+	s.onMentioned = fn
+}
+
+// SyncMentions parses "@slug" mentions in saved content, resolves them to profiles
+// and replaces the stored mention relations of the source for the given locale.
+// Unresolvable slugs and self-mentions are ignored. Profiles that were not
+// mentioned before are audited as mentioned by actorUserID, the user who saved
+// the content, and passed to the OnMentioned callback.
+func (s *Service) SyncMentions(
+	ctx context.Context,
+	actorUserID string,
+	sourceKind string,
+	sourceID string,
+	localeCode string,
+	content string,
+) error {
+	if !IsValidSourceKind(sourceKind) {
+		return fmt.Errorf("%w: %s", ErrInvalidSourceKind, sourceKind)
+	}
+
+	sourceProfileID, err := s.repo.GetMentionSourceProfileID(ctx, sourceKind, sourceID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToSyncMentions, err)
+	}
+
+	slugs := ExtractMentionSlugs(content)
+	if s.config.MaxMentionsPerContent > 0 && len(slugs) > s.config.MaxMentionsPerContent {
+		slugs = slugs[:s.config.MaxMentionsPerContent]
+	}
+
+	var resolved []*MentionedProfile
+
+	if len(slugs) > 0 {
+		resolved, err = s.repo.ListProfilesBySlugs(ctx, slugs)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToSyncMentions, err)
+		}
+	}
+
+	existing, err := s.repo.ListMentionsBySource(ctx, sourceKind, sourceID, localeCode)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToSyncMentions, err)
+	}
+
+	alreadyMentioned := make(map[string]bool, len(existing))
+	for _, mentioned := range existing {
+		alreadyMentioned[mentioned.ProfileID] = true
+	}
+
+	now := time.Now()
+	mentions := make([]*Mention, 0, len(resolved))
+
+	for _, profile := range resolved {
+		if profile.ProfileID == sourceProfileID {
+			continue
+		}
+
+		mentions = append(mentions, &Mention{
+			CreatedAt:          now,
+			ID:                 s.idGenerator(),
+			SourceKind:         sourceKind,
+			SourceID:           sourceID,
+			SourceProfileID:    sourceProfileID,
+			MentionedProfileID: profile.ProfileID,
+			MentionedSlug:      profile.Slug,
+			LocaleCode:         localeCode,
+		})
+	}
+
+	err = s.repo.ReplaceMentionsBySource(ctx, sourceKind, sourceID, localeCode, mentions)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToSyncMentions, err)
+	}
+
+	for _, mention := range mentions {
+		if alreadyMentioned[mention.MentionedProfileID] {
+			continue
+		}
+
+		s.auditService.Record(ctx, events.AuditParams{
+			EventType:  events.ProfileMentioned,
+			EntityType: "profile_mention",
+			EntityID:   mention.ID,
+			ActorID:    &actorUserID,
+			ActorKind:  events.ActorUser,
+			SessionID:  nil,
+			Payload: map[string]any{
+				"source_kind":          sourceKind,
+				"source_id":            sourceID,
+				"source_profile_id":    sourceProfileID,
+				"mentioned_profile_id": mention.MentionedProfileID,
+				"locale_code":          localeCode,
+			},
+		})
+
+		if s.onMentioned != nil {
+			s.onMentioned(ctx, mention)
+		}
+	}
+
+	return nil
+}
+
+// RenderMentionLinks rewrites stored, resolvable mentions in content into markdown
+// links to the mentioned profiles. Unresolvable mentions are left as plain text.
+// Failures are logged and the content is returned unchanged.
+func (s *Service) RenderMentionLinks(
+	ctx context.Context,
+	sourceKind string,
+	sourceID string,
+	localeCode string,
+	content string,
+) string {
+	mentioned, err := s.repo.ListMentionsBySource(ctx, sourceKind, sourceID, localeCode)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to list mentions for rendering",
+			slog.String("source_kind", sourceKind),
+			slog.String("source_id", sourceID),
+			slog.String("error", err.Error()))
+
+		return content
+	}
+
+	if len(mentioned) == 0 {
+		return content
+	}
+
+	linkable := make(map[string]bool, len(mentioned))
+	for _, profile := range mentioned {
+		linkable[profile.Slug] = true
+	}
+
+	return LinkMentions(content, localeCode, linkable)
+}
+
+// ListMentionsOfProfile lists the public stories and pages where a profile is mentioned,
+// most recent first.
+func (s *Service) ListMentionsOfProfile(
+	ctx context.Context,
+	localeCode string,
+	profileSlug string,
+) ([]*MentionListItem, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	storyMentions, err := s.repo.ListStoryMentionsOfProfile(
+		ctx,
+		localeCode,
+		profileID,
+		s.config.ListLimit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	pageMentions, err := s.repo.ListPageMentionsOfProfile(
+		ctx,
+		localeCode,
+		profileID,
+		s.config.ListLimit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	result := append(storyMentions, pageMentions...) //nolint:gocritic
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.After(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
@@ -0,0 +1,231 @@
+package profile_mentions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMentionStore = errors.New("mention store unavailable")
+
+// mentionRepository keeps the mentions of page "page-1", owned by profile
+// "author", in memory. "jane", "john" and "author" are existing profiles.
+type mentionRepository struct {
+	profile_mentions.Repository
+
+	mentions map[string][]*profile_mentions.Mention // locale -> mentions
+	listErr  error
+}
+
+func newMentionRepository() *mentionRepository {
+	return &mentionRepository{ //nolint:exhaustruct
+		mentions: map[string][]*profile_mentions.Mention{},
+	}
+}
+
+func (r *mentionRepository) GetMentionSourceProfileID(_ context.Context, _ string, _ string) (string, error) {
+	return "author-id", nil
+}
+
+func (r *mentionRepository) ListProfilesBySlugs(
+	_ context.Context,
+	slugs []string,
+) ([]*profile_mentions.MentionedProfile, error) {
+	known := map[string]string{"jane": "jane-id", "john": "john-id", "author": "author-id"}
+	result := []*profile_mentions.MentionedProfile{}
+
+	for _, slug := range slugs {
+		if id, ok := known[slug]; ok {
+			result = append(result, &profile_mentions.MentionedProfile{ProfileID: id, Slug: slug})
+		}
+	}
+
+	return result, nil
+}
+
+func (r *mentionRepository) ListMentionsBySource(
+	_ context.Context,
+	_ string,
+	_ string,
+	localeCode string,
+) ([]*profile_mentions.MentionedProfile, error) {
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+
+	result := []*profile_mentions.MentionedProfile{}
+	for _, mention := range r.mentions[localeCode] {
+		result = append(result, &profile_mentions.MentionedProfile{
+			ProfileID: mention.MentionedProfileID,
+			Slug:      mention.MentionedSlug,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *mentionRepository) ReplaceMentionsBySource(
+	_ context.Context,
+	_ string,
+	_ string,
+	localeCode string,
+	mentions []*profile_mentions.Mention,
+) error {
+	r.mentions[localeCode] = mentions
+
+	return nil
+}
+
+// mentionAuditLog keeps every recorded audit entry.
+type mentionAuditLog struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *mentionAuditLog) InsertAudit(_ context.Context, _ string, params events.AuditParams) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+func newMentionService(
+	repo *mentionRepository,
+) (*profile_mentions.Service, *mentionAuditLog, *[]string) {
+	auditLog := &mentionAuditLog{} //nolint:exhaustruct
+	idGenerator := func() string { return "generated" }
+	notified := &[]string{}
+
+	service := profile_mentions.NewService(
+		nil,
+		&profile_mentions.Config{MaxMentionsPerContent: 20}, //nolint:exhaustruct
+		repo,
+		events.NewAuditService(nil, auditLog, idGenerator, nil),
+		idGenerator,
+	)
+	service.SetOnMentioned(func(_ context.Context, mention *profile_mentions.Mention) {
+		*notified = append(*notified, mention.MentionedSlug)
+	})
+
+	return service, auditLog, notified
+}
+
+func mentionedSlugs(mentions []*profile_mentions.Mention) []string {
+	slugs := make([]string, len(mentions))
+	for i, mention := range mentions {
+		slugs[i] = mention.MentionedSlug
+	}
+
+	return slugs
+}
+
+func TestSyncMentions(t *testing.T) {
+	t.Parallel()
+
+	repo := newMentionRepository()
+	service, auditLog, notified := newMentionService(repo)
+
+	// Unknown slugs and the source's own profile are not stored.
+	err := service.SyncMentions(
+		t.Context(), "user-1", profile_mentions.SourceKindPage, "page-1", "en",
+		"Thanks @jane, @nobody and @author",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"jane"}, mentionedSlugs(repo.mentions["en"]))
+	assert.Equal(t, "author-id", repo.mentions["en"][0].SourceProfileID)
+	assert.Equal(t, []string{"jane"}, *notified)
+
+	require.Len(t, auditLog.entries, 1)
+	assert.Equal(t, events.ProfileMentioned, auditLog.entries[0].EventType)
+	assert.Equal(t, "user-1", *auditLog.entries[0].ActorID)
+	assert.Equal(t, events.ActorUser, auditLog.entries[0].ActorKind)
+
+	// Saving again adds new mentions, drops removed ones, and only notifies
+	// the newly mentioned profile.
+	err = service.SyncMentions(
+		t.Context(), "user-2", profile_mentions.SourceKindPage, "page-1", "en",
+		"Thanks @jane and @john",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"jane", "john"}, mentionedSlugs(repo.mentions["en"]))
+	assert.Equal(t, []string{"jane", "john"}, *notified)
+	require.Len(t, auditLog.entries, 2)
+	assert.Equal(t, "user-2", *auditLog.entries[1].ActorID)
+
+	err = service.SyncMentions(
+		t.Context(), "user-2", profile_mentions.SourceKindPage, "page-1", "en",
+		"No mentions left",
+	)
+	require.NoError(t, err)
+
+	assert.Empty(t, repo.mentions["en"])
+	assert.Len(t, auditLog.entries, 2)
+}
+
+func TestSyncMentions_RejectsUnknownSourceKind(t *testing.T) {
+	t.Parallel()
+
+	repo := newMentionRepository()
+	service, _, _ := newMentionService(repo)
+
+	err := service.SyncMentions(t.Context(), "user-1", "comment", "comment-1", "en", "@jane")
+	require.ErrorIs(t, err, profile_mentions.ErrInvalidSourceKind)
+	assert.Empty(t, repo.mentions)
+}
+
+func TestRenderMentionLinks(t *testing.T) {
+	t.Parallel()
+
+	repo := newMentionRepository()
+	service, _, _ := newMentionService(repo)
+
+	err := service.SyncMentions(
+		t.Context(), "user-1", profile_mentions.SourceKindPage, "page-1", "tr",
+		"Hi @jane and @nobody",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		"Hi [@jane](/tr/jane) and @nobody",
+		service.RenderMentionLinks(
+			t.Context(), profile_mentions.SourceKindPage, "page-1", "tr", "Hi @jane and @nobody",
+		),
+	)
+
+	// Content of a locale without stored mentions is left alone.
+	assert.Equal(
+		t,
+		"Hi @jane",
+		service.RenderMentionLinks(t.Context(), profile_mentions.SourceKindPage, "page-1", "en", "Hi @jane"),
+	)
+}
+
+func TestRenderMentionLinks_LeavesContentOnFailure(t *testing.T) {
+	t.Parallel()
+
+	repo := newMentionRepository()
+	repo.listErr = errMentionStore
+
+	service := profile_mentions.NewService(
+		logfx.NewLogger(),
+		&profile_mentions.Config{}, //nolint:exhaustruct
+		repo,
+		nil,
+		func() string { return "generated" },
+	)
+
+	assert.Equal(
+		t,
+		"Hi @jane",
+		service.RenderMentionLinks(t.Context(), profile_mentions.SourceKindPage, "page-1", "tr", "Hi @jane"),
+	)
+}
@@ -0,0 +1,61 @@
+package profile_mentions
+
+import (
+	"context"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+)
+
+// Source kinds that can contain mentions.
+const (
+	SourceKindStory = "story"
+	SourceKindPage  = "page"
+)
+
+// IsValidSourceKind checks whether the given source kind can contain mentions.
+func IsValidSourceKind(sourceKind string) bool {
+	return sourceKind == SourceKindStory || sourceKind == SourceKindPage
+}
+
+// IDGenerator is a function that generates unique IDs.
+type IDGenerator func() string
+
+// DefaultIDGenerator returns the default ULID-based ID generator.
+func DefaultIDGenerator() string {
+	return lib.IDsGenerateUnique()
+}
+
+// MentionedProfile is a profile resolved from a "@slug" mention.
+type MentionedProfile struct {
+	ProfileID string `json:"profile_id"`
+	Slug      string `json:"slug"`
+}
+
+// Mention represents a stored mention of a profile inside story or page content.
+type Mention struct {
+	CreatedAt          time.Time `json:"created_at"`
+	ID                 string    `json:"id"`
+	SourceKind         string    `json:"source_kind"`
+	SourceID           string    `json:"source_id"`
+	SourceProfileID    string    `json:"source_profile_id"`
+	MentionedProfileID string    `json:"mentioned_profile_id"`
+	MentionedSlug      string    `json:"mentioned_slug"`
+	LocaleCode         string    `json:"locale_code"`
+}
+
+// MentionListItem describes a place where a profile is mentioned.
+type MentionListItem struct {
+	CreatedAt          time.Time `json:"created_at"`
+	SourceKind         string    `json:"source_kind"`
+	SourceID           string    `json:"source_id"`
+	SourceSlug         string    `json:"source_slug"`
+	SourceTitle        string    `json:"source_title"`
+	SourceProfileSlug  string    `json:"source_profile_slug"`
+	SourceProfileTitle string    `json:"source_profile_title"`
+	LocaleCode         string    `json:"locale_code"`
+}
+
+// OnMentionedFunc is a callback invoked for each profile newly mentioned in a content.
+// Implementations must be best-effort — failures should be logged, never propagated.
+type OnMentionedFunc func(ctx context.Context, mention *Mention)
@@ -0,0 +1,196 @@
+package profile_points
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// ActionAward is the points credit an audited action earns a profile.
+type ActionAward struct {
+	Event           string `json:"event"`
+	TargetProfileID string `json:"target_profile_id"`
+	// Key identifies the rewarded action; each key is credited only once, so
+	// e.g. unfollowing and following again does not earn a second award.
+	Key         string `json:"key"`
+	Description string `json:"description"`
+}
+
+// ActionAwardFor returns the award an audit entry stands for, or nil when the
+// entry does not describe an awarded action.
+func ActionAwardFor(params events.AuditParams) *ActionAward {
+	profileID := payloadString(params.Payload, "profile_id")
+	if profileID == "" {
+		return nil
+	}
+
+	switch params.EventType { //nolint:exhaustive
+	case events.ProfilePageCreated:
+		return &ActionAward{
+			Event:           EventPageCreated,
+			TargetProfileID: profileID,
+			Key:             "page_created:" + params.EntityID,
+			Description:     "Created a page",
+		}
+	case events.StoryPublished:
+		// A story published to several profiles only earns the first one.
+		return &ActionAward{
+			Event:           EventStoryPublished,
+			TargetProfileID: profileID,
+			Key:             "story_published:" + params.EntityID,
+			Description:     "Published a story",
+		}
+	case events.ProfileMembershipCreated:
+		return membershipAward(profileID, params.Payload)
+	default:
+		return nil
+	}
+}
+
+func membershipAward(profileID string, payload map[string]any) *ActionAward {
+	memberProfileID := payloadString(payload, "member_profile_id")
+	if memberProfileID == "" || memberProfileID == profileID {
+		return nil
+	}
+
+	switch payloadString(payload, "kind") {
+	case "", "owner":
+		// Owner memberships are created with the profile itself.
+		return nil
+	case "follower":
+		return &ActionAward{
+			Event:           EventFollowerGained,
+			TargetProfileID: profileID,
+			Key:             "follower_gained:" + profileID + ":" + memberProfileID,
+			Description:     "Gained a new follower",
+		}
+	default:
+		return &ActionAward{
+			Event:           EventMemberGained,
+			TargetProfileID: profileID,
+			Key:             "member_gained:" + profileID + ":" + memberProfileID,
+			Description:     "Gained a new member",
+		}
+	}
+}
+
+func payloadString(payload map[string]any, key string) string {
+	value, ok := payload[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// AwardForAudit credits the points configured for the action an audit entry
+// records. It returns nil when the action is not awarded, its award is
+// disabled, or it was already credited.
+func (s *Service) AwardForAudit(
+	ctx context.Context,
+	params events.AuditParams,
+) (*Transaction, error) {
+	award := ActionAwardFor(params)
+	if award == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	return s.AwardAction(ctx, award)
+}
+
+// AwardAction credits the points configured for an action award. It returns
+// nil when the award is disabled or was already credited, so it is safe to
+// retry.
+func (s *Service) AwardAction(ctx context.Context, award *ActionAward) (*Transaction, error) {
+	amount := s.config.AwardAmounts()[award.Event]
+	if amount == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	transaction, err := s.repo.RecordAward(
+		ctx,
+		s.idGenerator(),
+		award.TargetProfileID,
+		award.Event,
+		award.Key,
+		award.Description,
+		amount,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w(key: %s): %w", ErrFailedToRecordTx, award.Key, err)
+	}
+
+	if transaction == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.PointsGained,
+		EntityType: "profile",
+		EntityID:   award.TargetProfileID,
+		ActorID:    nil,
+		ActorKind:  events.ActorSystem,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"amount":      amount,
+			"description": award.Description,
+			"award_key":   award.Key,
+		},
+	})
+
+	return transaction, nil
+}
+
+// HandleAuditRecorded is an events.OnRecordedFunc that credits action awards.
+// With a queue service the award is queued and credited by the queue worker,
+// which retries failures; otherwise it is credited right away. When the award
+// cannot be queued it is credited right away instead, so it is not lost; awards
+// are keyed, so it is never credited twice. Failures never affect the action
+// that was audited.
+func (s *Service) HandleAuditRecorded(ctx context.Context, params events.AuditParams) {
+	award := ActionAwardFor(params)
+	if award == nil || s.config.AwardAmounts()[award.Event] == 0 {
+		return
+	}
+
+	if s.queueService != nil {
+		err := s.enqueueAward(ctx, award)
+		if err == nil {
+			return
+		}
+
+		s.logger.WarnContext(ctx, "Failed to queue points award, crediting it now",
+			slog.String("event_type", string(params.EventType)),
+			slog.String("award_key", award.Key),
+			slog.Any("error", err))
+	}
+
+	_, err := s.AwardAction(ctx, award)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to award points for action",
+			slog.String("event_type", string(params.EventType)),
+			slog.String("entity_id", params.EntityID),
+			slog.String("award_key", award.Key),
+			slog.Any("error", err))
+	}
+}
+
+// enqueueAward queues a POINTS_AWARD item crediting the award.
+func (s *Service) enqueueAward(ctx context.Context, award *ActionAward) error {
+	_, err := s.queueService.Enqueue(ctx, events.QueueEnqueueParams{
+		Type: events.QueueItemTypePointsAward,
+		Payload: map[string]any{
+			"event":             award.Event,
+			"target_profile_id": award.TargetProfileID,
+			"key":               award.Key,
+			"description":       award.Description,
+		},
+		ScheduledAt:           nil,
+		MaxRetries:            0,
+		VisibilityTimeoutSecs: 0,
+	})
+
+	return err //nolint:wrapcheck
+}
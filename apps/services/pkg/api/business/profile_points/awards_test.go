@@ -0,0 +1,296 @@
+package profile_points_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// awardLedger keeps balances and award keys the way the storage adapter does.
+type awardLedger struct {
+	profile_points.Repository
+
+	balances map[string]uint64
+	keys     map[string]bool
+}
+
+func (r *awardLedger) RecordAward(
+	_ context.Context,
+	id string,
+	targetProfileID string,
+	triggeringEvent string,
+	awardKey string,
+	description string,
+	amount uint64,
+) (*profile_points.Transaction, error) {
+	if r.keys[awardKey] {
+		return nil, nil //nolint:nilnil
+	}
+
+	r.keys[awardKey] = true
+	r.balances[targetProfileID] += amount
+
+	return &profile_points.Transaction{ //nolint:exhaustruct
+		ID:              id,
+		TargetProfileID: targetProfileID,
+		TransactionType: profile_points.TransactionTypeGain,
+		TriggeringEvent: &triggeringEvent,
+		Description:     description,
+		Amount:          amount,
+		BalanceAfter:    r.balances[targetProfileID],
+	}, nil
+}
+
+// auditLog keeps every recorded audit entry.
+type auditLog struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *auditLog) InsertAudit(_ context.Context, _ string, params events.AuditParams) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+// newAwardingAuditService wires a points service to an audit service the way
+// the application does, so recording an action credits its award.
+func newAwardingAuditService() (*events.AuditService, *awardLedger, *auditLog) {
+	ledger := &awardLedger{ //nolint:exhaustruct
+		balances: map[string]uint64{},
+		keys:     map[string]bool{},
+	}
+	log := &auditLog{} //nolint:exhaustruct
+	idGenerator := func() string { return "generated" }
+
+	auditService := events.NewAuditService(nil, log, idGenerator, nil)
+	pointsService := profile_points.NewService(
+		nil,
+		&profile_points.Config{
+			AwardPageCreated:    5,
+			AwardStoryPublished: 10,
+			AwardFollowerGained: 1,
+			AwardMemberGained:   0,
+		},
+		ledger,
+		idGenerator,
+		auditService,
+	)
+	auditService.AddOnRecorded(pointsService.HandleAuditRecorded)
+
+	return auditService, ledger, log
+}
+
+func membershipCreated(membershipID string, memberProfileID string, kind string) events.AuditParams {
+	return events.AuditParams{ //nolint:exhaustruct
+		EventType:  events.ProfileMembershipCreated,
+		EntityType: "membership",
+		EntityID:   membershipID,
+		ActorKind:  events.ActorUser,
+		Payload: map[string]any{
+			"profile_id":        "profile-1",
+			"member_profile_id": memberProfileID,
+			"kind":              kind,
+		},
+	}
+}
+
+func TestAwardOnAction(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		action   events.AuditParams
+		expected uint64
+	}{
+		"page created": {
+			action: events.AuditParams{ //nolint:exhaustruct
+				EventType:  events.ProfilePageCreated,
+				EntityType: "profile_page",
+				EntityID:   "page-1",
+				Payload:    map[string]any{"profile_id": "profile-1", "slug": "about"},
+			},
+			expected: 5,
+		},
+		"story published": {
+			action: events.AuditParams{ //nolint:exhaustruct
+				EventType:  events.StoryPublished,
+				EntityType: "story",
+				EntityID:   "story-1",
+				Payload:    map[string]any{"publication_id": "pub-1", "profile_id": "profile-1"},
+			},
+			expected: 10,
+		},
+		"follower gained": {
+			action:   membershipCreated("membership-1", "profile-2", "follower"),
+			expected: 1,
+		},
+		"member award disabled": {
+			action:   membershipCreated("membership-1", "profile-2", "member"),
+			expected: 0,
+		},
+		"owner membership": {
+			action:   membershipCreated("membership-1", "profile-2", "owner"),
+			expected: 0,
+		},
+		"following oneself": {
+			action:   membershipCreated("membership-1", "profile-1", "follower"),
+			expected: 0,
+		},
+		"unrelated event": {
+			action: events.AuditParams{ //nolint:exhaustruct
+				EventType:  events.ProfileUpdated,
+				EntityType: "profile",
+				EntityID:   "profile-1",
+				Payload:    map[string]any{"profile_id": "profile-1"},
+			},
+			expected: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			auditService, ledger, log := newAwardingAuditService()
+
+			auditService.Record(t.Context(), tt.action)
+
+			assert.Equal(t, tt.expected, ledger.balances["profile-1"])
+
+			if tt.expected == 0 {
+				assert.Len(t, log.entries, 1)
+
+				return
+			}
+
+			require.Len(t, log.entries, 2)
+			assert.Equal(t, events.PointsGained, log.entries[1].EventType)
+			assert.Equal(t, "profile-1", log.entries[1].EntityID)
+		})
+	}
+}
+
+func TestAwardOnAction_CreditedOnce(t *testing.T) {
+	t.Parallel()
+
+	auditService, ledger, _ := newAwardingAuditService()
+
+	// Following, unfollowing and following again creates a new membership.
+	auditService.Record(t.Context(), membershipCreated("membership-1", "profile-2", "follower"))
+	auditService.Record(t.Context(), membershipCreated("membership-2", "profile-2", "follower"))
+	auditService.Record(t.Context(), membershipCreated("membership-3", "profile-3", "follower"))
+
+	assert.Equal(t, uint64(2), ledger.balances["profile-1"])
+}
+
+var errQueueUnavailable = errors.New("queue unavailable")
+
+// awardQueue keeps enqueued items in memory, or fails every enqueue when
+// unavailable is set.
+type awardQueue struct {
+	events.QueueRepository
+
+	items       []map[string]any
+	unavailable bool
+}
+
+func (r *awardQueue) Enqueue(
+	_ context.Context,
+	_ string,
+	itemType events.QueueItemType,
+	payload map[string]any,
+	_ int,
+	_ int,
+	_ time.Time,
+) error {
+	if r.unavailable {
+		return errQueueUnavailable
+	}
+
+	if itemType == events.QueueItemTypePointsAward {
+		r.items = append(r.items, payload)
+	}
+
+	return nil
+}
+
+func TestAwardOnAction_Queued(t *testing.T) {
+	t.Parallel()
+
+	ledger := &awardLedger{ //nolint:exhaustruct
+		balances: map[string]uint64{},
+		keys:     map[string]bool{},
+	}
+	queue := &awardQueue{} //nolint:exhaustruct
+	idGenerator := func() string { return "generated" }
+
+	auditService := events.NewAuditService(nil, &auditLog{}, idGenerator, nil) //nolint:exhaustruct
+	pointsService := profile_points.NewService(
+		nil,
+		&profile_points.Config{AwardFollowerGained: 1}, //nolint:exhaustruct
+		ledger,
+		idGenerator,
+		auditService,
+	)
+	pointsService.SetQueueService(events.NewQueueService(nil, queue, idGenerator))
+	auditService.AddOnRecorded(pointsService.HandleAuditRecorded)
+
+	auditService.Record(t.Context(), membershipCreated("membership-1", "profile-2", "follower"))
+	auditService.Record(t.Context(), membershipCreated("membership-2", "profile-2", "member"))
+
+	// Only the enabled award is queued, and nothing is credited until the
+	// queue worker handles it.
+	require.Len(t, queue.items, 1)
+	assert.Equal(t, "follower_gained:profile-1:profile-2", queue.items[0]["key"])
+	assert.Zero(t, ledger.balances["profile-1"])
+
+	award := &profile_points.ActionAward{
+		Event:           queue.items[0]["event"].(string),             //nolint:forcetypeassert
+		TargetProfileID: queue.items[0]["target_profile_id"].(string), //nolint:forcetypeassert
+		Key:             queue.items[0]["key"].(string),               //nolint:forcetypeassert
+		Description:     queue.items[0]["description"].(string),       //nolint:forcetypeassert
+	}
+
+	// A retried item does not credit the award twice.
+	for range 2 {
+		_, err := pointsService.AwardAction(t.Context(), award)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, uint64(1), ledger.balances["profile-1"])
+}
+
+func TestAwardOnAction_CreditedWhenQueueFails(t *testing.T) {
+	t.Parallel()
+
+	ledger := &awardLedger{ //nolint:exhaustruct
+		balances: map[string]uint64{},
+		keys:     map[string]bool{},
+	}
+	queue := &awardQueue{unavailable: true} //nolint:exhaustruct
+	idGenerator := func() string { return "generated" }
+
+	auditService := events.NewAuditService(nil, &auditLog{}, idGenerator, nil) //nolint:exhaustruct
+	pointsService := profile_points.NewService(
+		logfx.NewLogger(),
+		&profile_points.Config{AwardFollowerGained: 1}, //nolint:exhaustruct
+		ledger,
+		idGenerator,
+		auditService,
+	)
+	pointsService.SetQueueService(events.NewQueueService(nil, queue, idGenerator))
+	auditService.AddOnRecorded(pointsService.HandleAuditRecorded)
+
+	auditService.Record(t.Context(), membershipCreated("membership-1", "profile-2", "follower"))
+
+	assert.Empty(t, queue.items)
+	assert.Equal(t, uint64(1), ledger.balances["profile-1"])
+}
@@ -0,0 +1,21 @@
+package profile_points
+
+// Config holds configuration for the profile points module.
+type Config struct {
+	// Points credited for platform actions. Zero disables the award for that
+	// action.
+	AwardPageCreated    uint64 `conf:"award_page_created"    default:"5"`
+	AwardStoryPublished uint64 `conf:"award_story_published" default:"10"`
+	AwardFollowerGained uint64 `conf:"award_follower_gained" default:"1"`
+	AwardMemberGained   uint64 `conf:"award_member_gained"   default:"5"`
+}
+
+// AwardAmounts maps each awarded action to the points it is worth.
+func (c *Config) AwardAmounts() map[string]uint64 {
+	return map[string]uint64{
+		EventPageCreated:    c.AwardPageCreated,
+		EventStoryPublished: c.AwardStoryPublished,
+		EventFollowerGained: c.AwardFollowerGained,
+		EventMemberGained:   c.AwardMemberGained,
+	}
+}
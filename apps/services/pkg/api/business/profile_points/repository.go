@@ -24,6 +24,18 @@ type Repository interface { //nolint:interfacebloat
 		amount uint64,
 	) (*Transaction, error)
 
+	// RecordAward credits an action award and records it in the ledger atomically.
+	// It returns nil when an award with the same key was already recorded.
+	RecordAward(
+		ctx context.Context,
+		id string,
+		targetProfileID string,
+		triggeringEvent string,
+		awardKey string,
+		description string,
+		amount uint64,
+	) (*Transaction, error)
+
 	// ListTransactionsByProfileID returns transactions for a profile with pagination.
 	ListTransactionsByProfileID(
 		ctx context.Context,
@@ -17,26 +17,37 @@ func DefaultIDGenerator() string {
 // Service provides profile point operations.
 type Service struct {
 	logger       *logfx.Logger
+	config       *Config
 	repo         Repository
 	auditService *events.AuditService
+	queueService *events.QueueService
 	idGenerator  IDGenerator
 }
 
 // NewService creates a new profile points service.
 func NewService(
 	logger *logfx.Logger,
+	config *Config,
 	repo Repository,
 	idGenerator IDGenerator,
 	auditService *events.AuditService,
 ) *Service {
 	return &Service{
 		logger:       logger,
+		config:       config,
 		repo:         repo,
 		auditService: auditService,
+		queueService: nil,
 		idGenerator:  idGenerator,
 	}
 }
 
+// SetQueueService makes action awards go through the event queue, so a failed
+// award is retried. Without it, awards are credited when the action is audited.
+func (s *Service) SetQueueService(queueService *events.QueueService) {
+	s.queueService = queueService
+}
+
 // GetBalance returns the current point balance for a profile (public).
 func (s *Service) GetBalance(ctx context.Context, profileID string) (*Balance, error) {
 	points, err := s.repo.GetBalance(ctx, profileID)
@@ -118,11 +118,15 @@ const (
 
 // Triggering event identifiers.
 const (
-	EventStoryPublished    = "STORY_PUBLISHED"
-	EventProfileVerified   = "PROFILE_VERIFIED"
-	EventFirstContribution = "FIRST_CONTRIBUTION"
-	EventAutoTranslate     = "AUTO_TRANSLATE"
-	EventGenerateContent   = "GENERATE_CONTENT"
+	EventStoryPublished      = "STORY_PUBLISHED"
+	EventProfileVerified     = "PROFILE_VERIFIED"
+	EventFirstContribution   = "FIRST_CONTRIBUTION"
+	EventAutoTranslate       = "AUTO_TRANSLATE"
+	EventAutoTranslateRefund = "AUTO_TRANSLATE_REFUND"
+	EventGenerateContent     = "GENERATE_CONTENT"
+	EventPageCreated         = "PAGE_CREATED"
+	EventFollowerGained      = "FOLLOWER_GAINED"
+	EventMemberGained        = "MEMBER_GAINED"
 )
 
 // Award point amounts for each event type.
@@ -0,0 +1,388 @@
+package profile_questions_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// membershipRepository answers the access checks made against the asked
+// profile. Each user's individual profile is "profile-" + user ID.
+type membershipRepository struct {
+	profiles.Repository
+
+	memberships map[string]profiles.MembershipKind // individual profile ID -> kind
+}
+
+func (r *membershipRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "asked-profile", nil
+}
+
+func (r *membershipRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *membershipRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	return r.memberships[originProfileID], nil
+}
+
+// questionRepository holds the questions of the "asked" profile and one
+// question of another profile.
+type questionRepository struct {
+	profile_questions.Repository
+
+	visibility    string
+	questions     map[string]*profile_questions.Question
+	askedRecently int
+	inserted      int
+	answered      []string
+	resolved      map[string]bool
+}
+
+func newQuestionRepository() *questionRepository {
+	return &questionRepository{ //nolint:exhaustruct
+		visibility: string(profiles.ModuleVisibilityPublic),
+		questions: map[string]*profile_questions.Question{
+			"question-1": {ID: "question-1", ProfileID: "asked-profile"}, //nolint:exhaustruct
+			"question-2": {ID: "question-2", ProfileID: "asked-profile"}, //nolint:exhaustruct
+			"foreign":    {ID: "foreign", ProfileID: "another-profile"},  //nolint:exhaustruct
+		},
+		resolved: map[string]bool{},
+	}
+}
+
+func (r *questionRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "asked" {
+		return "", nil
+	}
+
+	return "asked-profile", nil
+}
+
+func (r *questionRepository) GetQAVisibility(_ context.Context, _ string) (string, error) {
+	return r.visibility, nil
+}
+
+func (r *questionRepository) GetQuestion(
+	_ context.Context,
+	id string,
+) (*profile_questions.Question, error) {
+	question, ok := r.questions[id]
+	if !ok {
+		return nil, profile_questions.ErrQuestionNotFound
+	}
+
+	return question, nil
+}
+
+func (r *questionRepository) CountQuestionsByAuthorSince(
+	_ context.Context,
+	_ string,
+	_ time.Time,
+) (int, error) {
+	return r.askedRecently, nil
+}
+
+func (r *questionRepository) InsertQuestion(
+	_ context.Context,
+	id string,
+	profileID string,
+	_ string,
+	content string,
+	isAnonymous bool,
+) (*profile_questions.Question, error) {
+	r.inserted++
+
+	return &profile_questions.Question{ //nolint:exhaustruct
+		ID:          id,
+		ProfileID:   profileID,
+		Content:     content,
+		IsAnonymous: isAnonymous,
+	}, nil
+}
+
+func (r *questionRepository) SetAnswer(
+	_ context.Context,
+	questionID string,
+	_ string,
+	_ *string,
+	_ *string,
+	_ string,
+) error {
+	r.answered = append(r.answered, questionID)
+
+	return nil
+}
+
+func (r *questionRepository) UpdateHidden(_ context.Context, _ string, _ bool) error {
+	return nil
+}
+
+func (r *questionRepository) UpdateResolved(
+	_ context.Context,
+	questionID string,
+	isResolved bool,
+) error {
+	r.resolved[questionID] = isResolved
+
+	return nil
+}
+
+func (r *questionRepository) GetQuestionAuthorProfileID(
+	_ context.Context,
+	_ string,
+) (*string, error) {
+	authorProfileID := "profile-asker"
+
+	return &authorProfileID, nil
+}
+
+// discardAuditRepository drops every audit entry.
+type discardAuditRepository struct {
+	events.AuditRepository
+}
+
+func (r *discardAuditRepository) InsertAudit(
+	_ context.Context,
+	_ string,
+	_ events.AuditParams,
+) error {
+	return nil
+}
+
+func newQuestionService(
+	repo *questionRepository,
+	config *profile_questions.Config,
+) *profile_questions.Service {
+	membershipRepo := &membershipRepository{ //nolint:exhaustruct
+		memberships: map[string]profiles.MembershipKind{
+			"profile-follower":    profiles.MembershipKindFollower,
+			"profile-contributor": profiles.MembershipKindContributor,
+			"profile-maintainer":  profiles.MembershipKindMaintainer,
+		},
+	}
+	idGenerator := func() string { return "generated" }
+
+	return profile_questions.NewService(
+		nil,
+		config,
+		repo,
+		profiles.NewService(nil, nil, membershipRepo, nil),
+		events.NewAuditService(nil, &discardAuditRepository{}, idGenerator, nil), //nolint:exhaustruct
+		idGenerator,
+	)
+}
+
+func answerParams(userID string, questionID string) profile_questions.AnswerQuestionParams {
+	return profile_questions.AnswerQuestionParams{
+		AnswerURI:         nil,
+		AnswerKind:        nil,
+		ProfileSlug:       "asked",
+		QuestionID:        questionID,
+		UserID:            userID,
+		AnswererProfileID: "profile-" + userID,
+		AnswerContent:     "Working on the new release.",
+		Locale:            "en",
+	}
+}
+
+func TestService_DisabledQAIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(ctx context.Context, service *profile_questions.Service) error{
+		"CreateQuestion": func(ctx context.Context, service *profile_questions.Service) error {
+			_, err := service.CreateQuestion(ctx, profile_questions.CreateQuestionParams{
+				ProfileSlug: "asked",
+				UserID:      "asker",
+				Content:     "What are you working on lately?",
+				IsAnonymous: false,
+			})
+
+			return err
+		},
+		"AnswerQuestion": func(ctx context.Context, service *profile_questions.Service) error {
+			return service.AnswerQuestion(ctx, answerParams("maintainer", "question-1"))
+		},
+		"HideQuestion": func(ctx context.Context, service *profile_questions.Service) error {
+			return service.HideQuestion(ctx, profile_questions.HideQuestionParams{
+				ProfileSlug: "asked",
+				QuestionID:  "question-1",
+				UserID:      "maintainer",
+				IsHidden:    true,
+			})
+		},
+		"ResolveQuestion": func(ctx context.Context, service *profile_questions.Service) error {
+			return service.ResolveQuestion(ctx, profile_questions.ResolveQuestionParams{
+				ProfileSlug: "asked",
+				QuestionID:  "question-1",
+				UserID:      "maintainer",
+				IsResolved:  true,
+			})
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newQuestionRepository()
+			repo.visibility = string(profiles.ModuleVisibilityDisabled)
+			service := newQuestionService(repo, &profile_questions.Config{}) //nolint:exhaustruct
+
+			require.ErrorIs(t, call(t.Context(), service), profile_questions.ErrQANotEnabled)
+			assert.Zero(t, repo.inserted)
+			assert.Empty(t, repo.answered)
+			assert.Empty(t, repo.resolved)
+		})
+	}
+}
+
+func TestAnswerQuestion_Permissions(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		userID     string
+		questionID string
+		expected   error
+	}{
+		"contributor answers": {
+			userID:     "contributor",
+			questionID: "question-1",
+			expected:   nil,
+		},
+		"follower cannot answer": {
+			userID:     "follower",
+			questionID: "question-1",
+			expected:   profile_questions.ErrInsufficientPermission,
+		},
+		"question of another profile": {
+			userID:     "maintainer",
+			questionID: "foreign",
+			expected:   profile_questions.ErrQuestionNotFound,
+		},
+		"unknown question": {
+			userID:     "maintainer",
+			questionID: "missing",
+			expected:   profile_questions.ErrQuestionNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newQuestionRepository()
+			service := newQuestionService(repo, &profile_questions.Config{}) //nolint:exhaustruct
+
+			err := service.AnswerQuestion(t.Context(), answerParams(tt.userID, tt.questionID))
+
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				assert.Empty(t, repo.answered)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.questionID}, repo.answered)
+		})
+	}
+}
+
+func TestResolveQuestion_RequiresMaintainer(t *testing.T) {
+	t.Parallel()
+
+	repo := newQuestionRepository()
+	service := newQuestionService(repo, &profile_questions.Config{}) //nolint:exhaustruct
+
+	params := profile_questions.ResolveQuestionParams{
+		ProfileSlug: "asked",
+		QuestionID:  "question-1",
+		UserID:      "contributor",
+		IsResolved:  true,
+	}
+
+	err := service.ResolveQuestion(t.Context(), params)
+	require.ErrorIs(t, err, profile_questions.ErrInsufficientPermission)
+	assert.Empty(t, repo.resolved)
+
+	params.UserID = "maintainer"
+
+	require.NoError(t, service.ResolveQuestion(t.Context(), params))
+	assert.Equal(t, map[string]bool{"question-1": true}, repo.resolved)
+}
+
+func TestCreateQuestion_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	repo := newQuestionRepository()
+	config := &profile_questions.Config{ //nolint:exhaustruct
+		AskRateLimit:  2,
+		AskRateWindow: time.Hour,
+	}
+	service := newQuestionService(repo, config)
+
+	params := profile_questions.CreateQuestionParams{
+		ProfileSlug: "asked",
+		UserID:      "asker",
+		Content:     "What are you working on lately?",
+		IsAnonymous: false,
+	}
+
+	repo.askedRecently = 1
+
+	_, err := service.CreateQuestion(t.Context(), params)
+	require.NoError(t, err)
+
+	repo.askedRecently = 2
+
+	_, err = service.CreateQuestion(t.Context(), params)
+	require.ErrorIs(t, err, profile_questions.ErrRateLimitExceeded)
+	assert.Equal(t, 1, repo.inserted)
+}
+
+func TestAnswerQuestion_NotifiesAsker(t *testing.T) {
+	t.Parallel()
+
+	repo := newQuestionRepository()
+	service := newQuestionService(repo, &profile_questions.Config{}) //nolint:exhaustruct
+
+	var notified []*profile_questions.AnsweredQuestion
+
+	service.SetOnAnswered(func(_ context.Context, answered *profile_questions.AnsweredQuestion) {
+		notified = append(notified, answered)
+	})
+
+	require.NoError(t, service.AnswerQuestion(t.Context(), answerParams("contributor", "question-1")))
+
+	require.Len(t, notified, 1)
+	assert.Equal(t, "question-1", notified[0].QuestionID)
+	assert.Equal(t, "asked-profile", notified[0].ProfileID)
+	assert.Equal(t, "profile-asker", notified[0].AuthorProfileID)
+	assert.Equal(t, "profile-contributor", notified[0].AnswererProfileID)
+
+	// The asker answering on behalf of the profile is not notified
+	params := answerParams("contributor", "question-2")
+	params.AnswererProfileID = "profile-asker"
+
+	require.NoError(t, service.AnswerQuestion(t.Context(), params))
+	assert.Len(t, notified, 1)
+}
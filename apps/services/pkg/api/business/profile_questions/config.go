@@ -0,0 +1,13 @@
+package profile_questions
+
+import "time"
+
+// Config holds configuration for the profile questions module.
+type Config struct {
+	// AskRateLimit is the maximum number of questions a user can ask across all
+	// profiles within AskRateWindow. Zero disables the limit.
+	AskRateLimit  int           `conf:"ask_rate_limit"  default:"5"`
+	AskRateWindow time.Duration `conf:"ask_rate_window" default:"1h"`
+	// NotifyAnswered enables notifying askers when their question is answered.
+	NotifyAnswered bool `conf:"notify_answered" default:"true"`
+}
@@ -2,6 +2,7 @@ package profile_questions
 
 import (
 	"context"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/lib/cursors"
 )
@@ -56,6 +57,15 @@ type Repository interface { //nolint:interfacebloat
 	// UpdateHidden toggles the hidden state of a question.
 	UpdateHidden(ctx context.Context, questionID string, isHidden bool) error
 
+	// UpdateResolved marks a question resolved or reopens it.
+	UpdateResolved(ctx context.Context, questionID string, isResolved bool) error
+
+	// GetQuestionAuthorProfileID returns the individual profile ID of the asker.
+	GetQuestionAuthorProfileID(ctx context.Context, questionID string) (*string, error)
+
+	// CountQuestionsByAuthorSince counts the questions a user asked since the given time.
+	CountQuestionsByAuthorSince(ctx context.Context, userID string, since time.Time) (int, error)
+
 	// InsertVote creates a new vote record.
 	InsertVote(ctx context.Context, voteID string, questionID string, userID string) (*Vote, error)
 
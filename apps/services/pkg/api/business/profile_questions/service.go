@@ -3,6 +3,8 @@ package profile_questions
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/lib"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
@@ -21,15 +23,18 @@ func DefaultIDGenerator() string {
 // Service provides profile question operations.
 type Service struct {
 	logger         *logfx.Logger
+	config         *Config
 	repo           Repository
 	profileService *profiles.Service
 	auditService   *events.AuditService
 	idGenerator    IDGenerator
+	onAnswered     OnAnsweredFunc
 }
 
 // NewService creates a new profile questions service.
 func NewService(
 	logger *logfx.Logger,
+	config *Config,
 	repo Repository,
 	profileService *profiles.Service,
 	auditService *events.AuditService,
@@ -37,13 +42,20 @@ func NewService(
 ) *Service {
 	return &Service{
 		logger:         logger,
+		config:         config,
 		repo:           repo,
 		profileService: profileService,
 		auditService:   auditService,
 		idGenerator:    idGenerator,
+		onAnswered:     nil,
 	}
 }
 
+// SetOnAnswered registers a callback invoked when a question receives its first answer.
+func (s *Service) SetOnAnswered(fn OnAnsweredFunc) {
+	s.onAnswered = fn
+}
+
 // ListQuestions returns questions for a profile, stripping anonymous author info.
 func (s *Service) ListQuestions(
 	ctx context.Context,
@@ -63,6 +75,10 @@ func (s *Service) ListQuestions(
 		)
 	}
 
+	if profileID == "" {
+		return cursors.Cursored[[]*Question]{}, ErrProfileNotFound
+	}
+
 	visibility, err := s.repo.GetQAVisibility(ctx, profileID)
 	if err != nil {
 		return cursors.Cursored[[]*Question]{}, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
@@ -115,6 +131,10 @@ func (s *Service) CreateQuestion(
 		return nil, fmt.Errorf("%w (slug: %s): %w", ErrFailedToGetRecord, params.ProfileSlug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	visibility, err := s.repo.GetQAVisibility(ctx, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
@@ -124,6 +144,11 @@ func (s *Service) CreateQuestion(
 		return nil, ErrQANotEnabled
 	}
 
+	err = s.checkAskRateLimit(ctx, params.UserID)
+	if err != nil {
+		return nil, err
+	}
+
 	questionID := s.idGenerator()
 
 	question, err := s.repo.InsertQuestion(
@@ -159,8 +184,73 @@ func (s *Service) CreateQuestion(
 	return question, nil
 }
 
+// checkAskRateLimit rejects the question when the user already asked the
+// configured number of questions within the rate window.
+func (s *Service) checkAskRateLimit(ctx context.Context, userID string) error {
+	if s.config.AskRateLimit <= 0 {
+		return nil
+	}
+
+	count, err := s.repo.CountQuestionsByAuthorSince(
+		ctx,
+		userID,
+		time.Now().Add(-s.config.AskRateWindow),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if count >= s.config.AskRateLimit {
+		return ErrRateLimitExceeded
+	}
+
+	return nil
+}
+
+// getProfileQuestion returns a question of the profile, failing when Q&A is
+// disabled on the profile or the question belongs to another profile.
+func (s *Service) getProfileQuestion(
+	ctx context.Context,
+	profileSlug string,
+	questionID string,
+) (*Question, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w (slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	visibility, err := s.repo.GetQAVisibility(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if visibility == visibilityDisabled {
+		return nil, ErrQANotEnabled
+	}
+
+	question, err := s.repo.GetQuestion(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrQuestionNotFound, err)
+	}
+
+	if question.ProfileID != profileID {
+		return nil, ErrQuestionNotFound
+	}
+
+	return question, nil
+}
+
 // AnswerQuestion adds an answer to a question (requires contributor+ access).
 func (s *Service) AnswerQuestion(ctx context.Context, params AnswerQuestionParams) error {
+	question, err := s.getProfileQuestion(ctx, params.ProfileSlug, params.QuestionID)
+	if err != nil {
+		return err
+	}
+
 	hasAccess, err := s.profileService.HasUserAccessToProfile(
 		ctx,
 		params.UserID,
@@ -175,11 +265,6 @@ func (s *Service) AnswerQuestion(ctx context.Context, params AnswerQuestionParam
 		return ErrInsufficientPermission
 	}
 
-	question, err := s.repo.GetQuestion(ctx, params.QuestionID)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrQuestionNotFound, err)
-	}
-
 	if question.AnswerContent != nil {
 		return ErrQuestionAlreadyAnswered
 	}
@@ -208,9 +293,45 @@ func (s *Service) AnswerQuestion(ctx context.Context, params AnswerQuestionParam
 		},
 	})
 
+	s.notifyAnswered(ctx, question, params)
+
 	return nil
 }
 
+// notifyAnswered hands the answered question to the registered callback.
+// Askers answering their own question are not notified.
+func (s *Service) notifyAnswered(
+	ctx context.Context,
+	question *Question,
+	params AnswerQuestionParams,
+) {
+	if s.onAnswered == nil {
+		return
+	}
+
+	authorProfileID, err := s.repo.GetQuestionAuthorProfileID(ctx, question.ID)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to resolve question author for notification",
+			slog.String("question_id", question.ID),
+			slog.String("error", err.Error()))
+
+		return
+	}
+
+	if authorProfileID == nil || *authorProfileID == params.AnswererProfileID {
+		return
+	}
+
+	s.onAnswered(ctx, &AnsweredQuestion{
+		QuestionID:        question.ID,
+		ProfileID:         question.ProfileID,
+		ProfileSlug:       params.ProfileSlug,
+		AuthorProfileID:   *authorProfileID,
+		AnswererProfileID: params.AnswererProfileID,
+		Locale:            params.Locale,
+	})
+}
+
 // EditAnswer updates an existing answer on a question.
 // Contributors can only edit answers they authored. Maintainers can edit all answers.
 func (s *Service) EditAnswer(ctx context.Context, params AnswerQuestionParams) error {
@@ -250,6 +371,11 @@ func (s *Service) validateEditAnswerAccess(
 	ctx context.Context,
 	params AnswerQuestionParams,
 ) error {
+	question, err := s.getProfileQuestion(ctx, params.ProfileSlug, params.QuestionID)
+	if err != nil {
+		return err
+	}
+
 	hasContributorAccess, err := s.profileService.HasUserAccessToProfile(
 		ctx,
 		params.UserID,
@@ -264,11 +390,6 @@ func (s *Service) validateEditAnswerAccess(
 		return ErrInsufficientPermission
 	}
 
-	question, err := s.repo.GetQuestion(ctx, params.QuestionID)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrQuestionNotFound, err)
-	}
-
 	if question.AnswerContent == nil {
 		return ErrQuestionNotAnswered
 	}
@@ -363,6 +484,10 @@ func (s *Service) validateQuestionForVoting(ctx context.Context, params VotePara
 		return fmt.Errorf("%w (slug: %s): %w", ErrFailedToGetRecord, params.ProfileSlug, err)
 	}
 
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
 	visibility, err := s.repo.GetQAVisibility(ctx, profileID)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
@@ -382,18 +507,14 @@ func (s *Service) validateQuestionForVoting(ctx context.Context, params VotePara
 
 // HideQuestion toggles the hidden state of a question (requires maintainer+ access).
 func (s *Service) HideQuestion(ctx context.Context, params HideQuestionParams) error {
-	hasAccess, err := s.profileService.HasUserAccessToProfile(
-		ctx,
-		params.UserID,
-		params.ProfileSlug,
-		profiles.MembershipKindMaintainer,
-	)
+	_, err := s.getProfileQuestion(ctx, params.ProfileSlug, params.QuestionID)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		return err
 	}
 
-	if !hasAccess {
-		return ErrInsufficientPermission
+	err = s.requireMaintainerAccess(ctx, params.UserID, params.ProfileSlug)
+	if err != nil {
+		return err
 	}
 
 	err = s.repo.UpdateHidden(ctx, params.QuestionID, params.IsHidden)
@@ -416,3 +537,36 @@ func (s *Service) HideQuestion(ctx context.Context, params HideQuestionParams) e
 
 	return nil
 }
+
+// ResolveQuestion marks a question resolved or reopens it (requires maintainer+ access).
+func (s *Service) ResolveQuestion(ctx context.Context, params ResolveQuestionParams) error {
+	_, err := s.getProfileQuestion(ctx, params.ProfileSlug, params.QuestionID)
+	if err != nil {
+		return err
+	}
+
+	err = s.requireMaintainerAccess(ctx, params.UserID, params.ProfileSlug)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpdateResolved(ctx, params.QuestionID, params.IsResolved)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileQuestionResolved,
+		EntityType: "profile_question",
+		EntityID:   params.QuestionID,
+		ActorID:    &params.UserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_slug": params.ProfileSlug,
+			"is_resolved":  params.IsResolved,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package profile_questions_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_questions"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+)
+
+// missingSlugRepository resolves every profile slug to the empty ID.
+type missingSlugRepository struct {
+	profile_questions.Repository
+}
+
+func (r *missingSlugRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func TestService_MissingSlugReturnsProfileNotFound(t *testing.T) {
+	t.Parallel()
+
+	const slug = "does-not-exist"
+
+	service := profile_questions.NewService(
+		nil,
+		&profile_questions.Config{}, //nolint:exhaustruct
+		&missingSlugRepository{},    //nolint:exhaustruct
+		nil,
+		nil,
+		nil,
+	)
+
+	tests := map[string]func(ctx context.Context) error{
+		"ListQuestions": func(ctx context.Context) error {
+			_, err := service.ListQuestions(ctx, slug, "en", nil, false, cursors.NewCursor(0, nil))
+
+			return err
+		},
+		"CreateQuestion": func(ctx context.Context) error {
+			_, err := service.CreateQuestion(ctx, profile_questions.CreateQuestionParams{
+				ProfileSlug: slug,
+				UserID:      "user-1",
+				Content:     "What are you working on lately?",
+				IsAnonymous: false,
+			})
+
+			return err
+		},
+		"ToggleVote": func(ctx context.Context) error {
+			_, err := service.ToggleVote(ctx, profile_questions.VoteParams{
+				ProfileSlug: slug,
+				QuestionID:  "question-1",
+				UserID:      "user-1",
+			})
+
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, call(t.Context()), profile_questions.ErrProfileNotFound)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package profile_questions
 
 import (
+	"context"
 	"time"
 )
 
@@ -23,6 +24,7 @@ type Question struct {
 	AnsweredByProfileID    *string    `json:"answered_by_profile_id"`
 	AnsweredByProfileSlug  *string    `json:"answered_by_profile_slug"`
 	AnsweredByProfileTitle *string    `json:"answered_by_profile_title"`
+	ResolvedAt             *time.Time `json:"resolved_at"`
 	UpdatedAt              *time.Time `json:"updated_at"`
 	ID                     string     `json:"id"`
 	ProfileID              string     `json:"profile_id"`
@@ -59,8 +61,31 @@ type AnswerQuestionParams struct {
 	UserID            string
 	AnswererProfileID string
 	AnswerContent     string
+	Locale            string
 }
 
+// ResolveQuestionParams holds parameters for resolving/reopening a question.
+type ResolveQuestionParams struct {
+	ProfileSlug string
+	QuestionID  string
+	UserID      string
+	IsResolved  bool
+}
+
+// AnsweredQuestion describes a question that has just been answered.
+type AnsweredQuestion struct {
+	QuestionID        string
+	ProfileID         string
+	ProfileSlug       string
+	AuthorProfileID   string
+	AnswererProfileID string
+	Locale            string
+}
+
+// OnAnsweredFunc is a callback invoked after a question receives its first answer.
+// Implementations must not block; errors are theirs to log.
+type OnAnsweredFunc func(ctx context.Context, answered *AnsweredQuestion)
+
 // VoteParams holds parameters for toggling a vote on a question.
 type VoteParams struct {
 	ProfileSlug string
@@ -0,0 +1,108 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// adminProfilesRepository pages through total profiles and records the limit
+// it was asked for.
+type adminProfilesRepository struct {
+	profiles.Repository
+
+	total       int
+	listedLimit int
+}
+
+func (r *adminProfilesRepository) ListAllProfilesForAdmin(
+	_ context.Context,
+	_ string,
+	_ string,
+	limit int,
+	offset int,
+) ([]*profiles.Profile, error) {
+	r.listedLimit = limit
+
+	count := max(min(limit, r.total-offset), 0)
+	result := make([]*profiles.Profile, count)
+
+	for i := range result {
+		result[i] = &profiles.Profile{} //nolint:exhaustruct
+	}
+
+	return result, nil
+}
+
+func (r *adminProfilesRepository) CountAllProfilesForAdmin(_ context.Context, _ string) (int64, error) {
+	return int64(r.total), nil
+}
+
+func TestListAllProfilesForAdmin_ClampsLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		limit    int
+		expected int
+	}{
+		"default":        {limit: 0, expected: profiles.DefaultAdminProfileListLimit},
+		"negative":       {limit: -5, expected: profiles.DefaultAdminProfileListLimit},
+		"within the cap": {limit: 150, expected: 150},
+		"over the cap":   {limit: 100_000, expected: profiles.MaxAdminProfileListLimit},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &adminProfilesRepository{total: 1000} //nolint:exhaustruct
+
+			service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+			result, err := service.ListAllProfilesForAdmin(t.Context(), "en", "", tt.limit, 0)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, repo.listedLimit)
+			assert.Equal(t, tt.expected, result.Limit)
+			assert.Len(t, result.Data, tt.expected)
+		})
+	}
+}
+
+func TestListAllProfilesForAdmin_HasMore(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		total      int
+		offset     int
+		hasMore    bool
+		totalPages int
+	}{
+		"first of three pages": {total: 25, offset: 0, hasMore: true, totalPages: 3},
+		"last partial page":    {total: 25, offset: 20, hasMore: false, totalPages: 3},
+		"page ending at total": {total: 20, offset: 10, hasMore: false, totalPages: 2},
+		"page before the last": {total: 21, offset: 10, hasMore: true, totalPages: 3},
+		"past the end":         {total: 20, offset: 40, hasMore: false, totalPages: 2},
+		"no profiles":          {total: 0, offset: 0, hasMore: false, totalPages: 0},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &adminProfilesRepository{total: tt.total} //nolint:exhaustruct
+
+			service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+			result, err := service.ListAllProfilesForAdmin(t.Context(), "en", "", 10, tt.offset)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.hasMore, result.HasMore)
+			assert.Equal(t, tt.totalPages, result.TotalPages)
+			assert.Equal(t, int64(tt.total), result.Total)
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package profiles
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultAIMaxInputChars is the character budget for content sent to an AI model
+// when the configuration doesn't set one.
+const DefaultAIMaxInputChars = 60000
+
+// aiRateLimiterMaxEntries is how many profiles the AI rate limiter tracks
+// before dropping the ones whose bucket has refilled.
+const aiRateLimiterMaxEntries = 10_000
+
+var (
+	// ErrContentTooLong is returned before invoking a model when the input exceeds the budget.
+	ErrContentTooLong = errors.New("content exceeds the AI input length limit")
+	// ErrRateLimited is returned when a profile asks for AI content faster than
+	// the configured rate.
+	ErrRateLimited = errors.New("too many AI requests, try again later")
+)
+
+func (s *Service) aiMaxInputChars() int {
+	if s.config == nil || s.config.AIMaxInputChars <= 0 {
+		return DefaultAIMaxInputChars
+	}
+
+	return s.config.AIMaxInputChars
+}
+
+// checkAIInputLength fails with ErrContentTooLong when the parts together are
+// longer than limit characters.
+func checkAIInputLength(limit int, parts ...string) error {
+	length := 0
+	for _, part := range parts {
+		length += utf8.RuneCountInString(part)
+	}
+
+	if length > limit {
+		return fmt.Errorf("%w: %d characters, limit is %d", ErrContentTooLong, length, limit)
+	}
+
+	return nil
+}
+
+// capCVGenerationInput drops trailing links and contributions until the CV input
+// fits in limit characters. The profile title, description and LinkedIn URL are
+// always sent; if they alone don't fit, ErrContentTooLong is returned.
+func capCVGenerationInput(input *cvGenerationInput, limit int) error {
+	err := checkAIInputLength(
+		limit,
+		input.profileData.Title,
+		input.profileData.Description,
+		input.linkedInURL,
+	)
+	if err != nil {
+		return err
+	}
+
+	remaining := limit -
+		utf8.RuneCountInString(input.profileData.Title) -
+		utf8.RuneCountInString(input.profileData.Description) -
+		utf8.RuneCountInString(input.linkedInURL)
+
+	for i, link := range input.links {
+		size := utf8.RuneCountInString(link.Kind) +
+			utf8.RuneCountInString(link.URI) +
+			utf8.RuneCountInString(link.Title)
+		if size > remaining {
+			input.links = input.links[:i]
+
+			break
+		}
+
+		remaining -= size
+	}
+
+	for i, membership := range input.contributions {
+		size := utf8.RuneCountInString(membership.Kind)
+		if membership.Profile != nil {
+			size += utf8.RuneCountInString(membership.Profile.Title) +
+				utf8.RuneCountInString(membership.Profile.Description)
+		}
+
+		if size > remaining {
+			input.contributions = input.contributions[:i]
+
+			break
+		}
+
+		remaining -= size
+	}
+
+	return nil
+}
+
+// allowAIRequest takes one of the profile's AI requests for the hour, failing
+// with ErrRateLimited when there are none left.
+func (s *Service) allowAIRequest(profileID string) error {
+	if s.config == nil || s.config.AIRequestsPerHour <= 0 {
+		return nil
+	}
+
+	if !s.aiRateLimiter.allow(profileID, time.Now(), s.config.AIRequestsPerHour) {
+		return fmt.Errorf("%w(profile_id: %s)", ErrRateLimited, profileID)
+	}
+
+	return nil
+}
+
+// aiRateLimiter is a token bucket per profile. Each bucket holds up to
+// perHour tokens and refills at perHour tokens an hour.
+type aiRateLimiter struct {
+	buckets map[string]*aiTokenBucket
+	mu      sync.Mutex
+}
+
+type aiTokenBucket struct {
+	updatedAt time.Time
+	tokens    float64
+}
+
+func newAIRateLimiter() *aiRateLimiter {
+	return &aiRateLimiter{ //nolint:exhaustruct // mu zero value is valid
+		buckets: make(map[string]*aiTokenBucket),
+	}
+}
+
+// allow takes a token from the profile's bucket and reports whether there was one.
+func (l *aiRateLimiter) allow(profileID string, now time.Time, perHour int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	capacity := float64(perHour)
+
+	bucket, ok := l.buckets[profileID]
+	if !ok {
+		if len(l.buckets) >= aiRateLimiterMaxEntries {
+			l.dropRefilled(now, capacity)
+		}
+
+		bucket = &aiTokenBucket{updatedAt: now, tokens: capacity}
+		l.buckets[profileID] = bucket
+	}
+
+	bucket.tokens = l.refilled(bucket, now, capacity)
+	bucket.updatedAt = now
+
+	if bucket.tokens < 1 {
+		return false
+	}
+
+	bucket.tokens--
+
+	return true
+}
+
+// refilled returns the tokens of the bucket at the given time.
+func (l *aiRateLimiter) refilled(bucket *aiTokenBucket, now time.Time, capacity float64) float64 {
+	elapsed := now.Sub(bucket.updatedAt)
+	if elapsed <= 0 {
+		return bucket.tokens
+	}
+
+	return min(capacity, bucket.tokens+elapsed.Hours()*capacity)
+}
+
+// dropRefilled removes full buckets, which behave like absent ones.
+// Must be called with the lock held.
+func (l *aiRateLimiter) dropRefilled(now time.Time, capacity float64) {
+	for profileID, bucket := range l.buckets {
+		if l.refilled(bucket, now, capacity) >= capacity {
+			delete(l.buckets, profileID)
+		}
+	}
+}
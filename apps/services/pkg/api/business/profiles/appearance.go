@@ -0,0 +1,236 @@
+package profiles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// PropertyAppearance is the profile properties key holding the appearance settings.
+const PropertyAppearance = "appearance"
+
+// AppearanceLayout is the page layout variant of a profile.
+type AppearanceLayout string
+
+const (
+	AppearanceLayoutDefault  AppearanceLayout = "default"
+	AppearanceLayoutCompact  AppearanceLayout = "compact"
+	AppearanceLayoutCentered AppearanceLayout = "centered"
+)
+
+// AppearanceColorScheme is the color scheme a profile is shown in by default.
+type AppearanceColorScheme string
+
+const (
+	AppearanceColorSchemeSystem AppearanceColorScheme = "system"
+	AppearanceColorSchemeLight  AppearanceColorScheme = "light"
+	AppearanceColorSchemeDark   AppearanceColorScheme = "dark"
+)
+
+// hexColorRegex matches #rgb and #rrggbb colors.
+var hexColorRegex = regexp.MustCompile(`^#([0-9a-f]{3}|[0-9a-f]{6})$`)
+
+// Appearance is the optional theming of a profile, kept in profile properties.
+// A nil AccentColor leaves the site's accent color in place.
+type Appearance struct {
+	AccentColor *string               `json:"accent_color"`
+	Layout      AppearanceLayout      `json:"layout"`
+	ColorScheme AppearanceColorScheme `json:"color_scheme"`
+}
+
+// DefaultAppearance returns the appearance of profiles that haven't set one.
+func DefaultAppearance() *Appearance {
+	return &Appearance{
+		AccentColor: nil,
+		Layout:      AppearanceLayoutDefault,
+		ColorScheme: AppearanceColorSchemeSystem,
+	}
+}
+
+// validateAppearance normalizes the appearance in place, filling in defaults for
+// empty values, and rejects unknown variants and malformed colors.
+func validateAppearance(appearance *Appearance) error {
+	if appearance.AccentColor != nil {
+		color := strings.ToLower(strings.TrimSpace(*appearance.AccentColor))
+
+		switch {
+		case color == "":
+			appearance.AccentColor = nil
+		case !hexColorRegex.MatchString(color):
+			return fmt.Errorf("%w: accent color must be a hex color like #1a2b3c", ErrInvalidInput)
+		default:
+			// Expand the short form so clients only deal with #rrggbb
+			if len(color) == 4 { //nolint:mnd
+				color = "#" + strings.Repeat(color[1:2], 2) +
+					strings.Repeat(color[2:3], 2) + strings.Repeat(color[3:4], 2)
+			}
+
+			appearance.AccentColor = &color
+		}
+	}
+
+	switch appearance.Layout {
+	case "":
+		appearance.Layout = AppearanceLayoutDefault
+	case AppearanceLayoutDefault, AppearanceLayoutCompact, AppearanceLayoutCentered:
+	default:
+		return fmt.Errorf("%w: unknown layout %q", ErrInvalidInput, appearance.Layout)
+	}
+
+	switch appearance.ColorScheme {
+	case "":
+		appearance.ColorScheme = AppearanceColorSchemeSystem
+	case AppearanceColorSchemeSystem, AppearanceColorSchemeLight, AppearanceColorSchemeDark:
+	default:
+		return fmt.Errorf("%w: unknown color scheme %q", ErrInvalidInput, appearance.ColorScheme)
+	}
+
+	return nil
+}
+
+// decodeAppearance strictly decodes an appearance section, rejecting unknown fields.
+func decodeAppearance(raw any) (*Appearance, error) {
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: appearance section is not valid JSON", ErrInvalidInput)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+
+	var appearance Appearance
+
+	err = decoder.Decode(&appearance)
+	if err != nil {
+		return nil, fmt.Errorf("%w: appearance section is malformed: %w", ErrInvalidInput, err)
+	}
+
+	err = validateAppearance(&appearance)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appearance, nil
+}
+
+// normalizeAppearanceProperty validates the appearance section of the given
+// properties, if there is one, and replaces it with its normalized form. A nil
+// section removes it.
+func normalizeAppearanceProperty(properties map[string]any) error {
+	raw, ok := properties[PropertyAppearance]
+	if !ok {
+		return nil
+	}
+
+	if raw == nil {
+		delete(properties, PropertyAppearance)
+
+		return nil
+	}
+
+	appearance, err := decodeAppearance(raw)
+	if err != nil {
+		return err
+	}
+
+	properties[PropertyAppearance] = appearance
+
+	return nil
+}
+
+// AppearanceFromProperties reads the appearance of a profile from its properties.
+// Missing or invalid settings fall back to the defaults.
+func AppearanceFromProperties(properties any) *Appearance {
+	values, ok := properties.(map[string]any)
+	if !ok || values[PropertyAppearance] == nil {
+		return DefaultAppearance()
+	}
+
+	appearance, err := decodeAppearance(values[PropertyAppearance])
+	if err != nil {
+		return DefaultAppearance()
+	}
+
+	return appearance
+}
+
+// UpdateAppearance validates and stores the appearance settings of a profile,
+// leaving its other properties untouched.
+func (s *Service) UpdateAppearance(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+	profileSlug string,
+	appearance *Appearance,
+) (*Appearance, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+	if accessErr != nil {
+		return nil, accessErr
+	}
+
+	err = validateAppearance(appearance)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := s.repo.GetProfileByID(ctx, localeCode, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	if profile == nil {
+		return nil, ErrProfileNotFound
+	}
+
+	properties := make(map[string]any)
+	if existing, ok := profile.Properties.(map[string]any); ok {
+		maps.Copy(properties, existing)
+	}
+
+	properties[PropertyAppearance] = appearance
+
+	err = s.repo.UpdateProfile(
+		ctx,
+		profileID,
+		nil,
+		nil,
+		properties,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToUpdateRecord, profileID, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileUpdated,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload:    map[string]any{PropertyAppearance: appearance},
+	})
+
+	return appearance, nil
+}
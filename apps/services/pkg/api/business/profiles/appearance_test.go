@@ -0,0 +1,169 @@
+package profiles_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appearanceRepository serves the calls made while updating a profile's appearance.
+type appearanceRepository struct {
+	profiles.Repository
+
+	properties map[string]any
+	updated    map[string]any
+}
+
+func (r *appearanceRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *appearanceRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *appearanceRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ID: id, Properties: r.properties}, nil //nolint:exhaustruct
+}
+
+func (r *appearanceRepository) UpdateProfile(
+	_ context.Context,
+	_ string,
+	_ *string,
+	_ *string,
+	properties map[string]any,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *bool,
+) error {
+	r.updated = properties
+
+	return nil
+}
+
+func newAppearanceService(properties map[string]any) (*profiles.Service, *appearanceRepository) {
+	repo := &appearanceRepository{properties: properties} //nolint:exhaustruct
+	auditService, _ := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo //nolint:exhaustruct
+}
+
+// roundTrip stores and reloads properties the way the storage layer does.
+func roundTrip(t *testing.T, properties map[string]any) any {
+	t.Helper()
+
+	encoded, err := json.Marshal(properties)
+	require.NoError(t, err)
+
+	var decoded any
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+
+	return decoded
+}
+
+func TestUpdateAppearance(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newAppearanceService(map[string]any{"hiring": map[string]any{"is_hiring": true}})
+	color := " #A1B "
+
+	appearance, err := service.UpdateAppearance(
+		t.Context(),
+		"en",
+		"user-1",
+		"target",
+		&profiles.Appearance{AccentColor: &color, ColorScheme: profiles.AppearanceColorSchemeDark}, //nolint:exhaustruct
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, appearance.AccentColor)
+	assert.Equal(t, "#aa11bb", *appearance.AccentColor)
+	assert.Equal(t, profiles.AppearanceLayoutDefault, appearance.Layout)
+	assert.Equal(t, profiles.AppearanceColorSchemeDark, appearance.ColorScheme)
+
+	// Other properties are kept alongside the appearance
+	assert.Contains(t, repo.updated, "hiring")
+	assert.Equal(t, appearance, profiles.AppearanceFromProperties(roundTrip(t, repo.updated)))
+}
+
+func TestUpdateAppearance_Rejected(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(value string) *string { return &value }
+
+	tests := map[string]profiles.Appearance{
+		"color name":       {AccentColor: ptr("red")},                      //nolint:exhaustruct
+		"color without #":  {AccentColor: ptr("ff0000")},                   //nolint:exhaustruct
+		"color too long":   {AccentColor: ptr("#ff00001")},                 //nolint:exhaustruct
+		"non-hex color":    {AccentColor: ptr("#gggggg")},                  //nolint:exhaustruct
+		"unknown layout":   {Layout: "magazine"},                           //nolint:exhaustruct
+		"unknown scheme":   {ColorScheme: "sepia"},                         //nolint:exhaustruct
+		"uppercase layout": {Layout: profiles.AppearanceLayout("COMPACT")}, //nolint:exhaustruct
+	}
+
+	for name, appearance := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo := newAppearanceService(nil)
+
+			_, err := service.UpdateAppearance(t.Context(), "en", "user-1", "target", &appearance)
+
+			require.ErrorIs(t, err, profiles.ErrInvalidInput)
+			assert.Nil(t, repo.updated)
+		})
+	}
+}
+
+func TestAppearanceFromProperties(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		properties any
+		expected   *profiles.Appearance
+	}{
+		"no properties": {
+			properties: nil,
+			expected:   profiles.DefaultAppearance(),
+		},
+		"no appearance": {
+			properties: map[string]any{"hiring": nil},
+			expected:   profiles.DefaultAppearance(),
+		},
+		"invalid stored appearance": {
+			properties: map[string]any{"appearance": map[string]any{"layout": "magazine"}},
+			expected:   profiles.DefaultAppearance(),
+		},
+		"stored appearance": {
+			properties: map[string]any{"appearance": map[string]any{"layout": "compact"}},
+			expected: &profiles.Appearance{
+				AccentColor: nil,
+				Layout:      profiles.AppearanceLayoutCompact,
+				ColorScheme: profiles.AppearanceColorSchemeSystem,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, profiles.AppearanceFromProperties(tt.properties))
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package profiles
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+const (
+	// PropertyAvailability is the profile properties key holding the availability status.
+	PropertyAvailability = "availability"
+
+	// FilterAvailable is the list cursor filter that narrows profiles by whether
+	// they have an availability status that hasn't expired.
+	FilterAvailable = "available"
+
+	MaxAvailabilityNoteLength = 280
+)
+
+// AvailabilityStatus is what a profile announces itself available for.
+type AvailabilityStatus string
+
+const (
+	AvailabilityOpenToWork           AvailabilityStatus = "open_to_work"
+	AvailabilityAvailableForSpeaking AvailabilityStatus = "available_for_speaking"
+)
+
+// Availability is the optional availability status kept in profile properties.
+// A status whose ExpiresAt has passed is treated as unset.
+type Availability struct {
+	ExpiresAt *time.Time         `json:"expires_at,omitempty"`
+	Note      *string            `json:"note,omitempty"`
+	Status    AvailabilityStatus `json:"status"`
+}
+
+// IsExpired reports whether the status has expired at the given time.
+func (a *Availability) IsExpired(now time.Time) bool {
+	return a.ExpiresAt != nil && !a.ExpiresAt.After(now)
+}
+
+// decodeAvailability strictly decodes an availability section, rejecting unknown fields.
+func decodeAvailability(raw any) (*Availability, error) {
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: availability section is not valid JSON", ErrInvalidInput)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+
+	var availability Availability
+
+	err = decoder.Decode(&availability)
+	if err != nil {
+		return nil, fmt.Errorf("%w: availability section is malformed: %w", ErrInvalidInput, err)
+	}
+
+	return &availability, nil
+}
+
+// validateAvailability trims the note in place and checks the status and expiry.
+func validateAvailability(availability *Availability, now time.Time) error {
+	switch availability.Status {
+	case AvailabilityOpenToWork, AvailabilityAvailableForSpeaking:
+	default:
+		return fmt.Errorf("%w: unknown availability status %q", ErrInvalidInput, availability.Status)
+	}
+
+	if availability.Note != nil {
+		note := strings.TrimSpace(*availability.Note)
+
+		switch {
+		case note == "":
+			availability.Note = nil
+		case utf8.RuneCountInString(note) > MaxAvailabilityNoteLength:
+			return fmt.Errorf("%w: availability note must be at most %d characters",
+				ErrInvalidInput, MaxAvailabilityNoteLength)
+		default:
+			availability.Note = &note
+		}
+	}
+
+	if availability.IsExpired(now) {
+		return fmt.Errorf("%w: availability expiry must be in the future", ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// normalizeAvailabilityProperty validates the availability section of the given
+// properties, if there is one, and replaces it with its normalized form. A nil
+// section removes it.
+func normalizeAvailabilityProperty(properties map[string]any, now time.Time) error {
+	raw, ok := properties[PropertyAvailability]
+	if !ok {
+		return nil
+	}
+
+	if raw == nil {
+		delete(properties, PropertyAvailability)
+
+		return nil
+	}
+
+	availability, err := decodeAvailability(raw)
+	if err != nil {
+		return err
+	}
+
+	err = validateAvailability(availability, now)
+	if err != nil {
+		return err
+	}
+
+	properties[PropertyAvailability] = availability
+
+	return nil
+}
+
+// AvailabilityFromProperties reads the availability status of a profile from its
+// properties. Missing, invalid and expired statuses read as nil.
+func AvailabilityFromProperties(properties any, now time.Time) *Availability {
+	values, ok := properties.(map[string]any)
+	if !ok || values[PropertyAvailability] == nil {
+		return nil
+	}
+
+	availability, err := decodeAvailability(values[PropertyAvailability])
+	if err != nil || availability.IsExpired(now) {
+		return nil
+	}
+
+	return availability
+}
+
+// dropUnsetAvailability removes an availability section that reads as unset
+// (expired or invalid) from the profile's properties, so it isn't served.
+// The properties are copied rather than changed in place.
+func dropUnsetAvailability(profile *Profile, now time.Time) {
+	values, ok := profile.Properties.(map[string]any)
+	if !ok {
+		return
+	}
+
+	if _, exists := values[PropertyAvailability]; !exists ||
+		AvailabilityFromProperties(values, now) != nil {
+		return
+	}
+
+	properties := maps.Clone(values)
+	delete(properties, PropertyAvailability)
+
+	profile.Properties = properties
+}
+
+// validateAvailableFilter normalizes the available list filter to "true" or "false".
+func validateAvailableFilter(cursor *cursors.Cursor) error {
+	if cursor == nil {
+		return nil
+	}
+
+	value, ok := cursor.Filters[FilterAvailable]
+	if !ok {
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("%w: available filter must be a boolean", ErrInvalidInput)
+	}
+
+	cursor.Filters[FilterAvailable] = strconv.FormatBool(parsed)
+
+	return nil
+}
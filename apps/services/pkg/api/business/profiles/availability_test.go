@@ -0,0 +1,207 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate_Availability(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newHiringService()
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	err := updateHiring(t, service, map[string]any{
+		profiles.PropertyAvailability: map[string]any{
+			"status":     "open_to_work",
+			"note":       "  Backend roles, remote ",
+			"expires_at": expiresAt.Format(time.RFC3339),
+		},
+	})
+	require.NoError(t, err)
+
+	availability, ok := repo.updatedProperties[profiles.PropertyAvailability].(*profiles.Availability)
+	require.True(t, ok)
+	assert.Equal(t, profiles.AvailabilityOpenToWork, availability.Status)
+	require.NotNil(t, availability.Note)
+	assert.Equal(t, "Backend roles, remote", *availability.Note)
+	require.NotNil(t, availability.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*availability.ExpiresAt))
+}
+
+func TestUpdate_AvailabilityValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]any{
+		"not an object":  "open_to_work",
+		"unknown status": map[string]any{"status": "on_vacation"},
+		"missing status": map[string]any{"note": "Ask me"},
+		"unknown field":  map[string]any{"status": "open_to_work", "rate": 100},
+		"note too long": map[string]any{
+			"status": "available_for_speaking",
+			"note":   string(make([]rune, profiles.MaxAvailabilityNoteLength+1)),
+		},
+		"already expired": map[string]any{
+			"status":     "open_to_work",
+			"expires_at": time.Now().Add(-time.Minute).Format(time.RFC3339),
+		},
+	}
+
+	for name, availability := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo := newHiringService()
+
+			err := updateHiring(t, service, map[string]any{
+				profiles.PropertyAvailability: availability,
+			})
+
+			require.ErrorIs(t, err, profiles.ErrInvalidInput)
+			assert.False(t, repo.updated)
+		})
+	}
+}
+
+func TestAvailabilityFromProperties_Expiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := map[string]struct {
+		availability any
+		expected     bool
+	}{
+		"no expiry": {
+			availability: map[string]any{"status": "open_to_work"},
+			expected:     true,
+		},
+		"expires later": {
+			availability: map[string]any{
+				"status":     "available_for_speaking",
+				"expires_at": now.Add(time.Hour).Format(time.RFC3339),
+			},
+			expected: true,
+		},
+		"expired": {
+			availability: map[string]any{
+				"status":     "open_to_work",
+				"expires_at": now.Add(-time.Hour).Format(time.RFC3339),
+			},
+			expected: false,
+		},
+		"unset":   {availability: nil, expected: false},
+		"invalid": {availability: "open", expected: false},
+	}
+
+	for name, tt := range tests {
+		properties := map[string]any{profiles.PropertyAvailability: tt.availability}
+
+		availability := profiles.AvailabilityFromProperties(properties, now)
+
+		assert.Equal(t, tt.expected, availability != nil, name)
+	}
+}
+
+// availabilityListRepository lists the given profiles and records the filters
+// it was asked for.
+type availabilityListRepository struct {
+	profiles.Repository
+
+	records     []*profiles.Profile
+	listFilters map[string]string
+}
+
+func (r *availabilityListRepository) ListProfiles(
+	_ context.Context,
+	_ string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*profiles.Profile], error) {
+	r.listFilters = cursor.Filters
+
+	return cursors.WrapResponseWithCursor(r.records, nil), nil
+}
+
+func TestList_AvailableFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    string
+		expected string
+	}{
+		"true":      {value: "true", expected: "true"},
+		"numeric":   {value: "1", expected: "true"},
+		"uppercase": {value: "FALSE", expected: "false"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &availabilityListRepository{} //nolint:exhaustruct
+			service := profiles.NewService(nil, nil, repo, nil)
+			cursor := cursors.NewCursor(10, nil)
+			cursor.Filters[profiles.FilterAvailable] = tt.value
+
+			_, err := service.List(t.Context(), "en", cursor)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repo.listFilters[profiles.FilterAvailable])
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &availabilityListRepository{} //nolint:exhaustruct
+		service := profiles.NewService(nil, nil, repo, nil)
+		cursor := cursors.NewCursor(10, nil)
+		cursor.Filters[profiles.FilterAvailable] = "soon"
+
+		_, err := service.List(t.Context(), "en", cursor)
+
+		require.ErrorIs(t, err, profiles.ErrInvalidInput)
+		assert.Nil(t, repo.listFilters)
+	})
+}
+
+func TestList_DropsExpiredAvailability(t *testing.T) {
+	t.Parallel()
+
+	expired := map[string]any{
+		"theme": "dark",
+		profiles.PropertyAvailability: map[string]any{
+			"status":     "open_to_work",
+			"expires_at": time.Now().Add(-time.Hour).Format(time.RFC3339),
+		},
+	}
+	current := map[string]any{
+		profiles.PropertyAvailability: map[string]any{"status": "available_for_speaking"},
+	}
+	repo := &availabilityListRepository{ //nolint:exhaustruct
+		records: []*profiles.Profile{
+			{ID: "expired", Properties: expired}, //nolint:exhaustruct
+			{ID: "current", Properties: current}, //nolint:exhaustruct
+		},
+	}
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	result, err := service.List(t.Context(), "en", cursors.NewCursor(10, nil))
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2)
+
+	expiredProperties, ok := result.Data[0].Properties.(map[string]any)
+	require.True(t, ok)
+	assert.NotContains(t, expiredProperties, profiles.PropertyAvailability)
+	assert.Equal(t, "dark", expiredProperties["theme"])
+
+	currentProperties, ok := result.Data[1].Properties.(map[string]any)
+	require.True(t, ok)
+	assert.Contains(t, currentProperties, profiles.PropertyAvailability)
+}
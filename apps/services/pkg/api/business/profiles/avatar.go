@@ -0,0 +1,249 @@
+package profiles
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Default avatar styles.
+const (
+	AvatarStyleInitials  = "initials"
+	AvatarStyleIdenticon = "identicon"
+)
+
+const (
+	identiconGridSize      = 5
+	avatarCacheMaxEntries  = 10_000
+	avatarMinSize          = 16
+	avatarMaxSize          = 1024
+	avatarHueRange         = 360
+	avatarBackgroundLight  = 92
+	avatarForegroundLight  = 45
+	avatarInitialsLight    = 40
+	avatarSaturation       = 55
+	avatarInitialsFontSize = 0.42
+)
+
+// DefaultAvatarConfig configures the avatars generated for profiles without a picture.
+type DefaultAvatarConfig struct {
+	Style       string        `conf:"style"         default:"initials"` // "initials" or "identicon"
+	Size        int           `conf:"size"          default:"128"`
+	CacheMaxAge time.Duration `conf:"cache_max_age" default:"24h"`
+}
+
+// Avatar is the result of resolving a profile's avatar.
+// Either PictureURI is set (the profile has its own picture) or SVG holds a
+// generated default avatar.
+type Avatar struct {
+	PictureURI  *string
+	ETag        string
+	SVG         []byte
+	CacheMaxAge time.Duration
+}
+
+// AvatarCache caches generated default avatars keyed by style, size, slug and title.
+type AvatarCache struct {
+	entries map[string][]byte
+	mu      sync.RWMutex
+}
+
+// NewAvatarCache creates a new, empty avatar cache.
+func NewAvatarCache() *AvatarCache {
+	return &AvatarCache{ //nolint:exhaustruct // mu zero value is valid
+		entries: make(map[string][]byte),
+	}
+}
+
+// GetOrGenerate returns the cached avatar for key or generates and stores it.
+// The cache is reset when it grows beyond avatarCacheMaxEntries.
+func (c *AvatarCache) GetOrGenerate(key string, generate func() []byte) []byte {
+	c.mu.RLock()
+	cached, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if ok {
+		return cached
+	}
+
+	generated := generate()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= avatarCacheMaxEntries {
+		c.entries = make(map[string][]byte)
+	}
+
+	c.entries[key] = generated
+
+	return generated
+}
+
+// GenerateDefaultAvatar renders a deterministic SVG avatar for a profile.
+// The same slug (and title, for the initials style) always yields the same image.
+func GenerateDefaultAvatar(style string, slug string, title string, size int) []byte {
+	size = max(avatarMinSize, min(size, avatarMaxSize))
+	hash := sha256.Sum256([]byte(slug))
+	hue := (int(hash[0])<<8 | int(hash[1])) % avatarHueRange
+
+	if style == AvatarStyleIdenticon {
+		return generateIdenticon(hash, hue, size)
+	}
+
+	return generateInitialsAvatar(avatarInitials(title, slug), hue, size)
+}
+
+// GetAvatar resolves the avatar of a profile. Profiles with a picture, or with a
+// linked account to take one from, return its URI; the rest get a generated
+// default avatar in the configured style.
+// Returns nil when the profile does not exist.
+func (s *Service) GetAvatar(ctx context.Context, localeCode string, slug string) (*Avatar, error) {
+	profile, err := s.GetBySlug(ctx, localeCode, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	err = s.applyLinkedPicture(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile.ProfilePictureURI != nil && *profile.ProfilePictureURI != "" {
+		return &Avatar{
+			PictureURI:  profile.ProfilePictureURI,
+			ETag:        "",
+			SVG:         nil,
+			CacheMaxAge: 0,
+		}, nil
+	}
+
+	avatarConfig := s.config.DefaultAvatar
+	key := fmt.Sprintf("%s:%d:%s:%s", avatarConfig.Style, avatarConfig.Size, profile.Slug, profile.Title)
+	keyHash := sha256.Sum256([]byte(key))
+
+	svg := s.avatarCache.GetOrGenerate(key, func() []byte {
+		return GenerateDefaultAvatar(
+			avatarConfig.Style,
+			profile.Slug,
+			profile.Title,
+			avatarConfig.Size,
+		)
+	})
+
+	return &Avatar{
+		PictureURI:  nil,
+		ETag:        `"` + hex.EncodeToString(keyHash[:8]) + `"`,
+		SVG:         svg,
+		CacheMaxAge: avatarConfig.CacheMaxAge,
+	}, nil
+}
+
+func generateIdenticon(hash [sha256.Size]byte, hue int, size int) []byte {
+	cell := float64(size) / identiconGridSize
+
+	var builder strings.Builder
+
+	fmt.Fprintf(
+		&builder,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		size, size, size, size,
+	)
+	fmt.Fprintf(
+		&builder,
+		`<rect width="%d" height="%d" fill="hsl(%d,%d%%,%d%%)"/>`,
+		size, size, hue, avatarSaturation, avatarBackgroundLight,
+	)
+
+	// Fill the left half (including the middle column) and mirror it.
+	half := (identiconGridSize + 1) / 2 //nolint:mnd
+
+	for row := range identiconGridSize {
+		for col := range half {
+			bit := row*half + col
+			if hash[2+bit/8]>>(bit%8)&1 == 0 {
+				continue
+			}
+
+			for _, x := range []int{col, identiconGridSize - 1 - col} {
+				fmt.Fprintf(
+					&builder,
+					`<rect x="%.2f" y="%.2f" width="%.2f" height="%.2f" fill="hsl(%d,%d%%,%d%%)"/>`,
+					float64(x)*cell, float64(row)*cell, cell, cell,
+					hue, avatarSaturation, avatarForegroundLight,
+				)
+
+				if x == identiconGridSize-1-x {
+					break
+				}
+			}
+		}
+	}
+
+	builder.WriteString(`</svg>`)
+
+	return []byte(builder.String())
+}
+
+func generateInitialsAvatar(initials string, hue int, size int) []byte {
+	var builder strings.Builder
+
+	fmt.Fprintf(
+		&builder,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		size, size, size, size,
+	)
+	fmt.Fprintf(
+		&builder,
+		`<rect width="%d" height="%d" fill="hsl(%d,%d%%,%d%%)"/>`,
+		size, size, hue, avatarSaturation, avatarInitialsLight,
+	)
+	fmt.Fprintf(
+		&builder,
+		`<text x="50%%" y="50%%" dy=".35em" text-anchor="middle" fill="#ffffff" `+
+			`font-family="sans-serif" font-weight="600" font-size="%.0f">%s</text>`,
+		float64(size)*avatarInitialsFontSize,
+		html.EscapeString(initials),
+	)
+	builder.WriteString(`</svg>`)
+
+	return []byte(builder.String())
+}
+
+// avatarInitials returns up to two uppercase initials from the title,
+// falling back to the slug when the title has no letters or digits.
+func avatarInitials(title string, slug string) string {
+	initials := make([]rune, 0, 2) //nolint:mnd
+
+	for _, source := range []string{title, strings.ReplaceAll(slug, "-", " ")} {
+		for word := range strings.FieldsSeq(source) {
+			for _, char := range word {
+				if unicode.IsLetter(char) || unicode.IsDigit(char) {
+					initials = append(initials, unicode.ToUpper(char))
+
+					break
+				}
+			}
+
+			if len(initials) == 2 { //nolint:mnd
+				return string(initials)
+			}
+		}
+
+		if len(initials) > 0 {
+			return string(initials)
+		}
+	}
+
+	return "?"
+}
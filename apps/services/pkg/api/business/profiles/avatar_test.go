@@ -0,0 +1,42 @@
+package profiles_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateDefaultAvatar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("is deterministic per slug", func(t *testing.T) {
+		t.Parallel()
+
+		for _, style := range []string{profiles.AvatarStyleInitials, profiles.AvatarStyleIdenticon} {
+			first := profiles.GenerateDefaultAvatar(style, "eser", "Eser Ozvataf", 128)
+			second := profiles.GenerateDefaultAvatar(style, "eser", "Eser Ozvataf", 128)
+
+			assert.Equal(t, first, second)
+			assert.NotEqual(t, first, profiles.GenerateDefaultAvatar(style, "aya", "Eser Ozvataf", 128))
+		}
+	})
+
+	t.Run("renders initials from the title", func(t *testing.T) {
+		t.Parallel()
+
+		svg := string(profiles.GenerateDefaultAvatar(profiles.AvatarStyleInitials, "eser", "Eser Ozvataf", 128))
+
+		assert.True(t, strings.HasPrefix(svg, "<svg"))
+		assert.Contains(t, svg, ">EO</text>")
+	})
+
+	t.Run("falls back to the slug for initials", func(t *testing.T) {
+		t.Parallel()
+
+		svg := string(profiles.GenerateDefaultAvatar(profiles.AvatarStyleInitials, "aya-team", "", 128))
+
+		assert.Contains(t, svg, ">AT</text>")
+	})
+}
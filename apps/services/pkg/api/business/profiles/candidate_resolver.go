@@ -0,0 +1,181 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// CandidateResolution is the outcome of a candidate resolved after its voting deadline.
+type CandidateResolution struct {
+	Candidate    *ExpiredVotingCandidate
+	Status       CandidateStatus
+	AverageScore float64
+	VoteCount    int
+}
+
+// Approved reports whether the candidate was approved by its vote.
+func (r *CandidateResolution) Approved() bool {
+	return r.Status != CandidateStatusReferenceRejected
+}
+
+// candidateVotingDeadline returns the voting deadline of a candidate created now,
+// or nil when voting stays open until a maintainer decides.
+func (s *Service) candidateVotingDeadline() *time.Time {
+	if s.config == nil || s.config.CandidateVotingWindow <= 0 {
+		return nil
+	}
+
+	deadline := time.Now().Add(s.config.CandidateVotingWindow)
+
+	return &deadline
+}
+
+// ResolveExpiredCandidates decides the candidates whose voting deadline is at or
+// before now from their vote breakdown, and returns the resolutions made.
+//
+// A candidate with enough votes and a high enough average score is approved the
+// way a maintainer would approve it: an application is accepted and its
+// membership is ensured, a referral moves on to the invitation. Any other
+// candidate is rejected. Resolving is conditional on the candidate still being
+// in voting, so a maintainer's decision in the meantime is kept.
+func (s *Service) ResolveExpiredCandidates(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*CandidateResolution, error) {
+	candidates, err := s.repo.ListExpiredVotingCandidates(ctx, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	resolutions := make([]*CandidateResolution, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		breakdown, err := s.repo.GetCandidateVoteBreakdown(ctx, candidate.ID)
+		if err != nil {
+			return resolutions, fmt.Errorf(
+				"%w(id: %s): %w",
+				ErrFailedToGetRecord,
+				candidate.ID,
+				err,
+			)
+		}
+
+		resolution := s.resolveCandidateVote(candidate, breakdown)
+
+		resolved, err := s.applyCandidateResolution(ctx, candidate, resolution)
+		if err != nil {
+			return resolutions, err
+		}
+
+		if !resolved {
+			continue
+		}
+
+		s.auditService.Record(ctx, events.AuditParams{
+			EventType:  events.ProfileCandidateStatusChanged,
+			EntityType: "candidate",
+			EntityID:   candidate.ID,
+			ActorID:    nil,
+			ActorKind:  events.ActorSystem,
+			SessionID:  nil,
+			Payload: map[string]any{
+				"profile_id":      candidate.ProfileID,
+				"old_status":      string(CandidateStatusVoting),
+				"new_status":      string(resolution.Status),
+				"average_score":   resolution.AverageScore,
+				"vote_count":      resolution.VoteCount,
+				"voting_deadline": candidate.VotingDeadline.Format(time.RFC3339),
+			},
+		})
+
+		resolutions = append(resolutions, resolution)
+	}
+
+	return resolutions, nil
+}
+
+// applyCandidateResolution stores the resolution of a candidate and, for an
+// accepted application, ensures its membership in the same transaction, so an
+// accepted candidate is never left without a membership. resolved is false
+// when the candidate was no longer in voting.
+func (s *Service) applyCandidateResolution(
+	ctx context.Context,
+	candidate *ExpiredVotingCandidate,
+	resolution *CandidateResolution,
+) (bool, error) {
+	resolved := false
+
+	err := s.repo.WithTx(ctx, func(txRepo Repository) error {
+		ok, err := txRepo.ResolveVotingCandidate(ctx, candidate.ID, resolution.Status)
+		if err != nil {
+			return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, candidate.ID, err)
+		}
+
+		if !ok {
+			return nil
+		}
+
+		if resolution.Status == CandidateStatusApplicationAccepted {
+			_, err = s.ensureMembershipFromCandidate(
+				ctx,
+				txRepo,
+				candidate.ProfileID,
+				candidate.ReferredProfileID,
+				candidate.ID,
+			)
+			if err != nil {
+				return fmt.Errorf("%w(id: %s): %w", ErrFailedToCreateRecord, candidate.ID, err)
+			}
+		}
+
+		resolved = true
+
+		return nil
+	})
+
+	return resolved, err
+}
+
+// resolveCandidateVote computes the outcome of a candidate from its vote
+// breakdown (score -> vote count).
+func (s *Service) resolveCandidateVote(
+	candidate *ExpiredVotingCandidate,
+	breakdown map[int]int,
+) *CandidateResolution {
+	resolution := &CandidateResolution{
+		Candidate:    candidate,
+		Status:       CandidateStatusReferenceRejected,
+		AverageScore: 0,
+		VoteCount:    0,
+	}
+
+	total := 0
+
+	for score, count := range breakdown {
+		resolution.VoteCount += count
+		total += score * count
+	}
+
+	if resolution.VoteCount == 0 {
+		return resolution
+	}
+
+	resolution.AverageScore = float64(total) / float64(resolution.VoteCount)
+
+	if resolution.VoteCount < s.config.CandidateMinVotes ||
+		resolution.AverageScore < s.config.CandidateApprovalScore {
+		return resolution
+	}
+
+	if candidate.Source == string(CandidateSourceApplication) {
+		resolution.Status = CandidateStatusApplicationAccepted
+	} else {
+		resolution.Status = CandidateStatusInvitationPendingResponse
+	}
+
+	return resolution
+}
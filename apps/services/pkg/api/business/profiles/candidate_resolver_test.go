@@ -0,0 +1,265 @@
+package profiles_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errMembershipStore = errors.New("membership store unavailable")
+
+// votingCandidateRepository holds candidates with their statuses and vote
+// breakdowns, and resolves them the way the conditional update does: only a
+// candidate still in voting is changed. Created memberships are recorded by
+// member profile ID.
+type votingCandidateRepository struct {
+	profiles.Repository
+
+	candidates      []*profiles.ExpiredVotingCandidate
+	statuses        map[string]profiles.CandidateStatus
+	breakdowns      map[string]map[int]int
+	memberships     map[string]string
+	failMemberships bool
+}
+
+// WithTx restores the statuses and memberships when fn fails.
+func (r *votingCandidateRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	statuses := maps.Clone(r.statuses)
+	memberships := maps.Clone(r.memberships)
+
+	err := fn(r)
+	if err != nil {
+		r.statuses = statuses
+		r.memberships = memberships
+	}
+
+	return err
+}
+
+func (r *votingCandidateRepository) ListExpiredVotingCandidates(
+	_ context.Context,
+	now time.Time,
+	_ int,
+) ([]*profiles.ExpiredVotingCandidate, error) {
+	result := make([]*profiles.ExpiredVotingCandidate, 0, len(r.candidates))
+
+	for _, candidate := range r.candidates {
+		if r.statuses[candidate.ID] == profiles.CandidateStatusVoting &&
+			!candidate.VotingDeadline.After(now) {
+			result = append(result, candidate)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *votingCandidateRepository) GetCandidateVoteBreakdown(
+	_ context.Context,
+	candidateID string,
+) (map[int]int, error) {
+	return r.breakdowns[candidateID], nil
+}
+
+func (r *votingCandidateRepository) ResolveVotingCandidate(
+	_ context.Context,
+	candidateID string,
+	status profiles.CandidateStatus,
+) (bool, error) {
+	if r.statuses[candidateID] != profiles.CandidateStatusVoting {
+		return false, nil
+	}
+
+	r.statuses[candidateID] = status
+
+	return true, nil
+}
+
+func (r *votingCandidateRepository) GetProfileMembershipByProfileAndMember(
+	_ context.Context,
+	_ string,
+	_ string,
+) (*profiles.ProfileMembership, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func (r *votingCandidateRepository) CreateProfileMembership(
+	_ context.Context,
+	_ string,
+	_ string,
+	memberProfileID *string,
+	kind string,
+	_ map[string]any,
+) error {
+	if r.failMemberships {
+		return errMembershipStore
+	}
+
+	r.memberships[*memberProfileID] = kind
+
+	return nil
+}
+
+func (r *votingCandidateRepository) ListCandidateTeams(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileTeam, error) {
+	return []*profiles.ProfileTeam{}, nil
+}
+
+func newCandidateResolverService(
+	repo *votingCandidateRepository,
+) (*profiles.Service, *recordingAuditRepository) {
+	auditService, auditRepo := newRecordingAuditService()
+	config := &profiles.Config{ //nolint:exhaustruct
+		CandidateApprovalScore: 2.5,
+		CandidateMinVotes:      2,
+	}
+
+	return profiles.NewService(nil, config, repo, auditService), auditRepo
+}
+
+func votingCandidate(id string, source profiles.CandidateSource) *profiles.ExpiredVotingCandidate {
+	return &profiles.ExpiredVotingCandidate{
+		VotingDeadline:    time.Now().Add(-time.Hour),
+		ID:                id,
+		ProfileID:         "acme",
+		ProfileSlug:       "acme",
+		ReferredProfileID: "profile-" + id,
+		Source:            string(source),
+	}
+}
+
+func TestResolveExpiredCandidates_ApprovalThreshold(t *testing.T) {
+	t.Parallel()
+
+	repo := &votingCandidateRepository{ //nolint:exhaustruct
+		candidates: []*profiles.ExpiredVotingCandidate{
+			votingCandidate("application", profiles.CandidateSourceApplication),
+			votingCandidate("referral", profiles.CandidateSourceReferral),
+		},
+		statuses: map[string]profiles.CandidateStatus{
+			"application": profiles.CandidateStatusVoting,
+			"referral":    profiles.CandidateStatusVoting,
+		},
+		// Averages exactly at the approval score: 2.5
+		breakdowns: map[string]map[int]int{
+			"application": {2: 1, 3: 1},
+			"referral":    {1: 1, 4: 1},
+		},
+		memberships: map[string]string{},
+	}
+	service, auditRepo := newCandidateResolverService(repo)
+
+	resolutions, err := service.ResolveExpiredCandidates(t.Context(), time.Now(), 50)
+	require.NoError(t, err)
+	require.Len(t, resolutions, 2)
+
+	for _, resolution := range resolutions {
+		assert.True(t, resolution.Approved(), resolution.Candidate.ID)
+		assert.InDelta(t, 2.5, resolution.AverageScore, 0.001)
+		assert.Equal(t, 2, resolution.VoteCount)
+	}
+
+	// An approved application becomes a membership right away
+	assert.Equal(t, profiles.CandidateStatusApplicationAccepted, repo.statuses["application"])
+	assert.Equal(t, string(profiles.MembershipKindMember), repo.memberships["profile-application"])
+
+	// An approved referral waits for the referred profile to accept its invitation
+	assert.Equal(t, profiles.CandidateStatusInvitationPendingResponse, repo.statuses["referral"])
+	assert.NotContains(t, repo.memberships, "profile-referral")
+
+	statusChanges := 0
+
+	for _, entry := range auditRepo.entries {
+		if entry.EventType == events.ProfileCandidateStatusChanged {
+			statusChanges++
+
+			assert.Equal(t, events.ActorSystem, entry.ActorKind)
+		}
+	}
+
+	assert.Equal(t, 2, statusChanges)
+}
+
+func TestResolveExpiredCandidates_Rejected(t *testing.T) {
+	t.Parallel()
+
+	repo := &votingCandidateRepository{ //nolint:exhaustruct
+		candidates: []*profiles.ExpiredVotingCandidate{
+			votingCandidate("low-score", profiles.CandidateSourceApplication),
+			votingCandidate("too-few-votes", profiles.CandidateSourceReferral),
+			votingCandidate("no-votes", profiles.CandidateSourceReferral),
+			votingCandidate("frozen", profiles.CandidateSourceReferral),
+		},
+		statuses: map[string]profiles.CandidateStatus{
+			"low-score":     profiles.CandidateStatusVoting,
+			"too-few-votes": profiles.CandidateStatusVoting,
+			"no-votes":      profiles.CandidateStatusVoting,
+			// Decided by a maintainer: left alone
+			"frozen": profiles.CandidateStatusFrozen,
+		},
+		breakdowns: map[string]map[int]int{
+			"low-score":     {2: 2, 3: 1},
+			"too-few-votes": {4: 1},
+			"frozen":        {0: 3},
+		},
+		memberships: map[string]string{},
+	}
+	service, _ := newCandidateResolverService(repo)
+
+	resolutions, err := service.ResolveExpiredCandidates(t.Context(), time.Now(), 50)
+	require.NoError(t, err)
+	require.Len(t, resolutions, 3)
+
+	for _, resolution := range resolutions {
+		assert.False(t, resolution.Approved(), resolution.Candidate.ID)
+		assert.Equal(
+			t,
+			profiles.CandidateStatusReferenceRejected,
+			repo.statuses[resolution.Candidate.ID],
+			resolution.Candidate.ID,
+		)
+	}
+
+	assert.Equal(t, profiles.CandidateStatusFrozen, repo.statuses["frozen"])
+	assert.Empty(t, repo.memberships)
+}
+
+func TestResolveExpiredCandidates_KeepsCandidateWhenMembershipFails(t *testing.T) {
+	t.Parallel()
+
+	repo := &votingCandidateRepository{ //nolint:exhaustruct
+		candidates: []*profiles.ExpiredVotingCandidate{
+			votingCandidate("application", profiles.CandidateSourceApplication),
+		},
+		statuses:        map[string]profiles.CandidateStatus{"application": profiles.CandidateStatusVoting},
+		breakdowns:      map[string]map[int]int{"application": {3: 2}},
+		memberships:     map[string]string{},
+		failMemberships: true,
+	}
+	service, _ := newCandidateResolverService(repo)
+
+	_, err := service.ResolveExpiredCandidates(t.Context(), time.Now(), 50)
+	require.ErrorIs(t, err, errMembershipStore)
+
+	// The candidate stays in voting, so the next sweep retries it.
+	assert.Equal(t, profiles.CandidateStatusVoting, repo.statuses["application"])
+
+	repo.failMemberships = false
+
+	resolutions, err := service.ResolveExpiredCandidates(t.Context(), time.Now(), 50)
+	require.NoError(t, err)
+	require.Len(t, resolutions, 1)
+	assert.Equal(t, profiles.CandidateStatusApplicationAccepted, repo.statuses["application"])
+	assert.Equal(t, string(profiles.MembershipKindMember), repo.memberships["profile-application"])
+}
@@ -0,0 +1,605 @@
+package profiles
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var (
+	ErrInvalidCustomDomain  = errors.New("invalid custom domain")
+	ErrCustomDomainTaken    = errors.New("custom domain is already in use")
+	ErrCustomDomainNotFound = errors.New("custom domain not found")
+
+	ErrCustomDomainVerifyRateLimited = errors.New("custom domain was verified too recently")
+)
+
+const (
+	maxCustomDomainLength = 253
+
+	domainVerificationTokenBytes = 16
+
+	wwwPrefix = "www."
+)
+
+// customDomainRegex matches lowercase host names with at least one dot and an alphabetic TLD.
+var customDomainRegex = regexp.MustCompile(
+	`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`,
+)
+
+// OnCustomDomainsChangedFunc is invoked after a change that affects the set of
+// domains served by the webserver, so the reconciler can run without waiting.
+type OnCustomDomainsChangedFunc func()
+
+// SetOnCustomDomainsChanged registers the webserver reconcile trigger.
+func (s *Service) SetOnCustomDomainsChanged(fn OnCustomDomainsChangedFunc) {
+	s.onCustomDomainsChanged = fn
+}
+
+// SetDNSResolver replaces the resolver used by on-demand custom domain verification.
+func (s *Service) SetDNSResolver(resolver DNSResolver) {
+	s.dnsResolver = resolver
+}
+
+// NormalizeCustomDomain lowercases a domain, strips an optional scheme, path and
+// trailing dot, and validates the result as a host name.
+func NormalizeCustomDomain(domain string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(domain))
+	normalized = strings.TrimPrefix(normalized, "https://")
+	normalized = strings.TrimPrefix(normalized, "http://")
+
+	if index := strings.IndexAny(normalized, "/?#"); index >= 0 {
+		normalized = normalized[:index]
+	}
+
+	normalized = strings.TrimSuffix(normalized, ".")
+
+	if len(normalized) > maxCustomDomainLength || !customDomainRegex.MatchString(normalized) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidCustomDomain, domain)
+	}
+
+	return normalized, nil
+}
+
+// CanonicalCustomDomain normalizes a domain and drops a leading "www.", so a
+// profile's domain is always stored in its apex form. The www variant is served
+// as an alias of the apex through the record's www prefix flag.
+func CanonicalCustomDomain(domain string) (string, error) {
+	normalized, err := NormalizeCustomDomain(domain)
+	if err != nil {
+		return "", err
+	}
+
+	apex, ok := strings.CutPrefix(normalized, wwwPrefix)
+	if ok && customDomainRegex.MatchString(apex) {
+		return apex, nil
+	}
+
+	return normalized, nil
+}
+
+// ListCustomDomains lists the custom domains of a profile with their verification state.
+// Only owners of the profile (or admins) may list them.
+func (s *Service) ListCustomDomains(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+) (*CustomDomainList, error) {
+	profileID, err := s.ensureUserCanManageCustomDomains(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	domains, err := s.repo.ListCustomDomainsByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+	}
+
+	if domains == nil {
+		domains = []*ProfileCustomDomain{}
+	}
+
+	return &CustomDomainList{
+		ExpectedDNS: s.expectedDNSTarget(),
+		Domains:     domains,
+	}, nil
+}
+
+// CreateCustomDomain adds a custom domain to a profile. New domains start as pending
+// until the DNS verification confirms they point to us.
+func (s *Service) CreateCustomDomain(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	domain string,
+	defaultLocale *string,
+) (*ProfileCustomDomain, error) {
+	profileID, err := s.ensureUserCanManageCustomDomains(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := CanonicalCustomDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ensureCustomDomainAvailable(ctx, normalized, "")
+	if err != nil {
+		return nil, err
+	}
+
+	domainID := string(s.idGenerator())
+
+	verificationToken, err := newDomainVerificationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateCustomDomain(
+		ctx,
+		domainID,
+		profileID,
+		normalized,
+		defaultLocale,
+		verificationToken,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w(domain: %s): %w", ErrFailedToCreateRecord, normalized, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileCustomDomainCreated,
+		EntityType: "profile_custom_domain",
+		EntityID:   domainID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id": profileID,
+			"domain":     normalized,
+		},
+	})
+
+	return s.getProfileCustomDomain(ctx, profileID, domainID)
+}
+
+// UpdateCustomDomain changes a custom domain's host name and default locale.
+// Changing the host name resets verification, so the new domain has to be verified again.
+func (s *Service) UpdateCustomDomain(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	domainID string,
+	domain string,
+	defaultLocale *string,
+) (*ProfileCustomDomain, error) {
+	profileID, err := s.ensureUserCanManageCustomDomains(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.getProfileCustomDomain(ctx, profileID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized, err := CanonicalCustomDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	domainChanged := normalized != existing.Domain
+
+	// A new host name gets a new ownership token; the old one may already be published.
+	var verificationToken *string
+
+	if domainChanged {
+		err = s.ensureCustomDomainAvailable(ctx, normalized, domainID)
+		if err != nil {
+			return nil, err
+		}
+
+		token, tokenErr := newDomainVerificationToken()
+		if tokenErr != nil {
+			return nil, tokenErr
+		}
+
+		verificationToken = &token
+	}
+
+	err = s.repo.UpdateCustomDomain(ctx, domainID, normalized, defaultLocale, verificationToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w(domainID: %s): %w", ErrFailedToUpdateRecord, domainID, err)
+	}
+
+	if domainChanged {
+		err = s.repo.UpdateCustomDomainVerification(ctx, domainID, DomainStatusPending, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("%w(domainID: %s): %w", ErrFailedToUpdateRecord, domainID, err)
+		}
+
+		err = s.repo.UpdateCustomDomainWebserverSynced(ctx, domainID, false)
+		if err != nil {
+			return nil, fmt.Errorf("%w(domainID: %s): %w", ErrFailedToUpdateRecord, domainID, err)
+		}
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileCustomDomainUpdated,
+		EntityType: "profile_custom_domain",
+		EntityID:   domainID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":      profileID,
+			"domain":          normalized,
+			"previous_domain": existing.Domain,
+		},
+	})
+
+	// The old host name was being served; reconcile to drop it.
+	if domainChanged && isActiveCustomDomain(existing) {
+		s.notifyCustomDomainsChanged()
+	}
+
+	return s.getProfileCustomDomain(ctx, profileID, domainID)
+}
+
+// DeleteCustomDomain removes a custom domain from a profile.
+func (s *Service) DeleteCustomDomain(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	domainID string,
+) error {
+	profileID, err := s.ensureUserCanManageCustomDomains(ctx, userID, profileSlug)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.getProfileCustomDomain(ctx, profileID, domainID)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.DeleteCustomDomain(ctx, domainID)
+	if err != nil {
+		return fmt.Errorf("%w(domainID: %s): %w", ErrFailedToDeleteRecord, domainID, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileCustomDomainDeleted,
+		EntityType: "profile_custom_domain",
+		EntityID:   domainID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id": profileID,
+			"domain":     existing.Domain,
+		},
+	})
+
+	if isActiveCustomDomain(existing) {
+		s.notifyCustomDomainsChanged()
+	}
+
+	return nil
+}
+
+// VerifyCustomDomainNow runs the owner's rate-limited "verify now" action: it
+// checks a domain's DNS against the configured targets and stores the resulting
+// verification status. The outcome tells a domain whose records are not set up
+// yet (pending) apart from one whose records point elsewhere (mismatch).
+func (s *Service) VerifyCustomDomainNow(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	domainID string,
+) (*CustomDomainVerification, error) {
+	profileID, err := s.ensureUserCanManageCustomDomains(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := s.getProfileCustomDomain(ctx, profileID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if !s.domainVerifyLimiter.allow(domainID, now, s.config.DNSVerification.VerifyNowCooldown) {
+		return nil, ErrCustomDomainVerifyRateLimited
+	}
+
+	return s.verifyCustomDomain(ctx, domain, now)
+}
+
+func (s *Service) verifyCustomDomain(
+	ctx context.Context,
+	domain *ProfileCustomDomain,
+	now time.Time,
+) (*CustomDomainVerification, error) {
+	wasActive := isActiveCustomDomain(domain)
+
+	check, err := RecheckDomainDNS(
+		ctx,
+		s.repo,
+		s.dnsResolver,
+		domain,
+		&s.config.DNSVerification,
+		s.config.DNSVerification.VerifyNowTimeout,
+		now,
+	)
+
+	lookupFailed := errors.Is(err, ErrDNSLookupFailed)
+	if err != nil && !lookupFailed {
+		return nil, err
+	}
+
+	if wasActive != isActiveCustomDomain(domain) {
+		s.notifyCustomDomainsChanged()
+	}
+
+	return &CustomDomainVerification{
+		Domain:       domain,
+		ExpectedDNS:  s.expectedDNSTarget(),
+		Outcome:      check.Outcome,
+		Detail:       check.Detail,
+		Verified:     check.Outcome == DNSCheckVerified && !lookupFailed,
+		LookupFailed: lookupFailed,
+	}, nil
+}
+
+// VerifyUnverifiedCustomDomains checks the DNS of up to limit pending or failed
+// domains, least recently checked first, so domains verify on their own once
+// their records propagate. It returns how many domains became verified. A
+// domain that cannot be checked is logged and skipped.
+func (s *Service) VerifyUnverifiedCustomDomains(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) (int, error) {
+	domains, err := s.repo.ListAllCustomDomains(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	unverified := make([]*ProfileCustomDomain, 0, len(domains))
+
+	for _, domain := range domains {
+		if domain.VerificationStatus == DomainStatusPending ||
+			domain.VerificationStatus == DomainStatusFailed {
+			unverified = append(unverified, domain)
+		}
+	}
+
+	// Never-checked domains first, then the ones checked longest ago.
+	sort.SliceStable(unverified, func(i, j int) bool {
+		left, right := unverified[i].LastDNSCheckAt, unverified[j].LastDNSCheckAt
+		if left == nil || right == nil {
+			return left == nil && right != nil
+		}
+
+		return left.Before(*right)
+	})
+
+	if limit > 0 && len(unverified) > limit {
+		unverified = unverified[:limit]
+	}
+
+	verified := 0
+
+	for _, domain := range unverified {
+		result, verifyErr := s.verifyCustomDomain(ctx, domain, now)
+		if verifyErr != nil {
+			s.logger.WarnContext(ctx, "Failed to verify custom domain",
+				slog.String("domain", domain.Domain),
+				slog.String("error", verifyErr.Error()))
+
+			continue
+		}
+
+		if result.Verified {
+			verified++
+		}
+	}
+
+	return verified, nil
+}
+
+// GetCustomDomainDNSInstructions returns the DNS records the owner has to create for
+// the domain to be verified, so they can be shown as copy-paste instructions.
+func (s *Service) GetCustomDomainDNSInstructions(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	domainID string,
+) (*CustomDomainDNSInstructions, error) {
+	profileID, err := s.ensureUserCanManageCustomDomains(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	domain, err := s.getProfileCustomDomain(ctx, profileID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CustomDomainDNSInstructions{
+		Domain:  domain,
+		Records: ExpectedDNSRecords(domain, &s.config.DNSVerification),
+	}, nil
+}
+
+// ensureUserCanManageCustomDomains resolves the profile and checks that the user owns it.
+func (s *Service) ensureUserCanManageCustomDomains(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+) (string, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return "", ErrProfileNotFound
+	}
+
+	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindOwner)
+	if err != nil {
+		return "", err
+	}
+
+	return profileID, nil
+}
+
+// ensureCustomDomainAvailable fails when the apex domain or its www variant is
+// registered by another record.
+func (s *Service) ensureCustomDomainAvailable(
+	ctx context.Context,
+	domain string,
+	excludeDomainID string,
+) error {
+	for _, host := range []string{domain, wwwPrefix + domain} {
+		existing, err := s.repo.GetCustomDomainByDomain(ctx, host)
+		if err != nil {
+			return fmt.Errorf("%w(custom_domain: %s): %w", ErrFailedToGetRecord, host, err)
+		}
+
+		if existing != nil && existing.ID != excludeDomainID {
+			return fmt.Errorf("%w: %s", ErrCustomDomainTaken, domain)
+		}
+	}
+
+	return nil
+}
+
+// resolveCustomDomain finds the custom domain record serving a host. A "www."
+// host resolves to its apex record when the record serves the www alias, and
+// otherwise only to a record registered with the www host itself.
+func (s *Service) resolveCustomDomain(
+	ctx context.Context,
+	host string,
+) (*ProfileCustomDomain, error) {
+	host = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(host)), ".")
+
+	if apex, ok := strings.CutPrefix(host, wwwPrefix); ok {
+		record, err := s.repo.GetCustomDomainByDomain(ctx, apex)
+		if err != nil {
+			return nil, fmt.Errorf("%w(custom_domain: %s): %w", ErrFailedToGetRecord, apex, err)
+		}
+
+		if record != nil && record.WwwPrefix {
+			return record, nil
+		}
+	}
+
+	record, err := s.repo.GetCustomDomainByDomain(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("%w(custom_domain: %s): %w", ErrFailedToGetRecord, host, err)
+	}
+
+	return record, nil
+}
+
+// getProfileCustomDomain returns a custom domain only if it belongs to the profile.
+func (s *Service) getProfileCustomDomain(
+	ctx context.Context,
+	profileID string,
+	domainID string,
+) (*ProfileCustomDomain, error) {
+	domains, err := s.repo.ListCustomDomainsByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+	}
+
+	for _, domain := range domains {
+		if domain.ID == domainID {
+			return domain, nil
+		}
+	}
+
+	return nil, ErrCustomDomainNotFound
+}
+
+func (s *Service) notifyCustomDomainsChanged() {
+	if s.onCustomDomainsChanged != nil {
+		s.onCustomDomainsChanged()
+	}
+}
+
+// isActiveCustomDomain reports whether the domain is part of the served domain set.
+func isActiveCustomDomain(domain *ProfileCustomDomain) bool {
+	return domain.VerificationStatus == DomainStatusVerified ||
+		domain.VerificationStatus == DomainStatusExpired
+}
+
+// expectedDNSTarget returns the configured DNS target for custom domains.
+func (s *Service) expectedDNSTarget() ExpectedDNSTarget {
+	return ExpectedDNSTarget{
+		IPv4:  s.config.DNSVerification.ExpectedIPv4,
+		IPv6:  s.config.DNSVerification.ExpectedIPv6,
+		CNAME: s.config.DNSVerification.ExpectedCNAME,
+	}
+}
+
+// newDomainVerificationToken generates the random token published in a domain's TXT record.
+func newDomainVerificationToken() (string, error) {
+	tokenBuf := make([]byte, domainVerificationTokenBytes)
+
+	_, err := rand.Read(tokenBuf)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate domain verification token: %w", err)
+	}
+
+	return hex.EncodeToString(tokenBuf), nil
+}
+
+// domainVerifyLimiter remembers the last on-demand verification per domain.
+type domainVerifyLimiter struct {
+	lastChecks map[string]time.Time
+	mu         sync.Mutex
+}
+
+func newDomainVerifyLimiter() *domainVerifyLimiter {
+	return &domainVerifyLimiter{ //nolint:exhaustruct // mu zero value is valid
+		lastChecks: make(map[string]time.Time),
+	}
+}
+
+// allow reports whether a check may run now and records it if so.
+func (l *domainVerifyLimiter) allow(domainID string, now time.Time, cooldown time.Duration) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lastCheck, ok := l.lastChecks[domainID]
+	if ok && now.Sub(lastCheck) < cooldown {
+		return false
+	}
+
+	// Drop stale entries so the map only holds domains inside their cooldown.
+	for id, checkedAt := range l.lastChecks {
+		if now.Sub(checkedAt) >= cooldown {
+			delete(l.lastChecks, id)
+		}
+	}
+
+	l.lastChecks[domainID] = now
+
+	return true
+}
@@ -0,0 +1,383 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeCustomDomain(t *testing.T) {
+	t.Parallel()
+
+	valid := map[string]string{
+		"example.com":                  "example.com",
+		"  Blog.Example.COM ":          "blog.example.com",
+		"https://example.com/path?q=1": "example.com",
+		"example.com.":                 "example.com",
+		"my-site.co.uk":                "my-site.co.uk",
+	}
+
+	for input, expected := range valid {
+		normalized, err := profiles.NormalizeCustomDomain(input)
+
+		assert.NoError(t, err, input)
+		assert.Equal(t, expected, normalized, input)
+	}
+
+	invalid := []string{"", "localhost", "-bad.com", "bad-.com", "exa mple.com", "example.123", "a..com"}
+
+	for _, input := range invalid {
+		_, err := profiles.NormalizeCustomDomain(input)
+
+		assert.ErrorIs(t, err, profiles.ErrInvalidCustomDomain, input)
+	}
+}
+
+func TestCanonicalCustomDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"example.com":              "example.com",
+		"www.example.com":          "example.com",
+		"https://WWW.Example.com/": "example.com",
+		"www.blog.example.com":     "blog.example.com",
+		"wwwexample.com":           "wwwexample.com",
+		// Nothing left to be an apex domain
+		"www.io": "www.io",
+	}
+
+	for input, expected := range tests {
+		canonical, err := profiles.CanonicalCustomDomain(input)
+
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, canonical, input)
+	}
+}
+
+// customDomainRepository serves one pending domain of the "acme" profile, owned by
+// the user "owner", and records verification updates.
+type customDomainRepository struct {
+	profiles.Repository
+
+	domain        *profiles.ProfileCustomDomain
+	status        string
+	dnsVerifiedAt *time.Time
+}
+
+func (r *customDomainRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "acme-profile", nil
+}
+
+func (r *customDomainRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *customDomainRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	if originProfileID == "profile-owner" {
+		return profiles.MembershipKindOwner, nil
+	}
+
+	return "", nil
+}
+
+func (r *customDomainRepository) ListCustomDomainsByProfileID(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileCustomDomain, error) {
+	return []*profiles.ProfileCustomDomain{r.domain}, nil
+}
+
+func (r *customDomainRepository) UpdateCustomDomainVerification(
+	_ context.Context,
+	_ string,
+	status string,
+	dnsVerifiedAt *time.Time,
+	_ *time.Time,
+) error {
+	r.status = status
+	r.dnsVerifiedAt = dnsVerifiedAt
+
+	return nil
+}
+
+func (r *customDomainRepository) UpdateCustomDomainWebserverSynced(
+	_ context.Context,
+	_ string,
+	_ bool,
+) error {
+	return nil
+}
+
+func TestVerifyCustomDomainNow(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		resolver        *stubDNSResolver
+		expectedOutcome string
+		expectedStatus  string
+	}{
+		"verified": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				cnames: map[string]string{"blog.example.com": "aya.is."},
+			},
+			expectedOutcome: profiles.DNSCheckVerified,
+			expectedStatus:  profiles.DomainStatusVerified,
+		},
+		"records not set up yet": {
+			resolver:        &stubDNSResolver{}, //nolint:exhaustruct
+			expectedOutcome: profiles.DNSCheckPending,
+			expectedStatus:  profiles.DomainStatusFailed,
+		},
+		"records point elsewhere": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				hosts: map[string][]string{"blog.example.com": {"198.51.100.7"}},
+			},
+			expectedOutcome: profiles.DNSCheckMismatch,
+			expectedStatus:  profiles.DomainStatusFailed,
+		},
+		"lookup failure keeps the status": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				failure: context.DeadlineExceeded,
+			},
+			expectedOutcome: profiles.DNSCheckPending,
+			expectedStatus:  profiles.DomainStatusPending,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &customDomainRepository{ //nolint:exhaustruct
+				domain: &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+					ID:                 "domain-1",
+					ProfileID:          "acme-profile",
+					Domain:             "blog.example.com",
+					VerificationStatus: profiles.DomainStatusPending,
+				},
+			}
+			config := &profiles.Config{} //nolint:exhaustruct
+			config.DNSVerification.ExpectedIPv4 = "192.0.2.1"
+			config.DNSVerification.ExpectedCNAME = "aya.is."
+			config.DNSVerification.VerifyNowTimeout = time.Second
+
+			service := profiles.NewService(nil, config, repo, nil)
+			service.SetDNSResolver(tt.resolver)
+
+			result, err := service.VerifyCustomDomainNow(t.Context(), "owner", "acme", "domain-1")
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedOutcome, result.Outcome, result.Detail)
+			assert.Equal(t, tt.expectedStatus, repo.status)
+			assert.Equal(t, tt.expectedOutcome == profiles.DNSCheckVerified, repo.dnsVerifiedAt != nil)
+		})
+	}
+
+	t.Run("requires an owner", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &customDomainRepository{}                                  //nolint:exhaustruct
+		service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+		_, err := service.VerifyCustomDomainNow(t.Context(), "stranger", "acme", "domain-1")
+		require.ErrorIs(t, err, profiles.ErrInsufficientAccess)
+	})
+}
+
+// domainLookupRepository holds custom domain records keyed by their stored domain.
+// Every user owns the "acme" profile the records are created on.
+type domainLookupRepository struct {
+	profiles.Repository
+
+	domains map[string]*profiles.ProfileCustomDomain
+	created string
+}
+
+func (r *domainLookupRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "acme-profile", nil
+}
+
+func (r *domainLookupRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *domainLookupRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	_ string,
+) (profiles.MembershipKind, error) {
+	return profiles.MembershipKindOwner, nil
+}
+
+func (r *domainLookupRepository) GetCustomDomainByDomain(
+	_ context.Context,
+	domain string,
+) (*profiles.ProfileCustomDomain, error) {
+	return r.domains[domain], nil
+}
+
+func (r *domainLookupRepository) CreateCustomDomain(
+	_ context.Context,
+	id string,
+	profileID string,
+	domain string,
+	_ *string,
+	_ string,
+) error {
+	r.created = domain
+	r.domains[domain] = &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+		ID:        id,
+		ProfileID: profileID,
+		Domain:    domain,
+		WwwPrefix: true,
+	}
+
+	return nil
+}
+
+func (r *domainLookupRepository) ListCustomDomainsByProfileID(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileCustomDomain, error) {
+	result := make([]*profiles.ProfileCustomDomain, 0, len(r.domains))
+	for _, domain := range r.domains {
+		result = append(result, domain)
+	}
+
+	return result, nil
+}
+
+func (r *domainLookupRepository) GetProfileByID(
+	_ context.Context,
+	localeCode string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ID: id, Slug: id, LocaleCode: localeCode}, nil //nolint:exhaustruct
+}
+
+func newDomainLookupService(
+	domains ...*profiles.ProfileCustomDomain,
+) (*profiles.Service, *domainLookupRepository) {
+	repo := &domainLookupRepository{ //nolint:exhaustruct
+		domains: make(map[string]*profiles.ProfileCustomDomain, len(domains)),
+	}
+
+	for _, domain := range domains {
+		repo.domains[domain.Domain] = domain
+	}
+
+	auditService, _ := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo //nolint:exhaustruct
+}
+
+func TestGetByCustomDomain_ApexAndWww(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newDomainLookupService(
+		&profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			ID:        "aliased",
+			Domain:    "aliased.example",
+			WwwPrefix: true,
+		},
+		&profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			ID:        "apex-only",
+			Domain:    "apex-only.example",
+			WwwPrefix: false,
+		},
+		// Registered with the www host before domains were stored in apex form
+		&profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			ID:        "legacy",
+			Domain:    "www.legacy.example",
+			WwwPrefix: false,
+		},
+	)
+
+	tests := map[string]string{
+		"aliased.example":       "aliased",
+		"www.aliased.example":   "aliased",
+		"WWW.Aliased.Example.":  "aliased",
+		"apex-only.example":     "apex-only",
+		"www.apex-only.example": "",
+		"www.legacy.example":    "legacy",
+		"legacy.example":        "",
+		"www.unknown.example":   "",
+	}
+
+	for host, expectedProfileID := range tests {
+		profile, customDomain, err := service.GetByCustomDomain(t.Context(), "en", host)
+		require.NoError(t, err, host)
+
+		if expectedProfileID == "" {
+			assert.Nil(t, profile, host)
+			assert.Nil(t, customDomain, host)
+
+			continue
+		}
+
+		require.NotNil(t, profile, host)
+		assert.Equal(t, expectedProfileID, customDomain.ID, host)
+	}
+}
+
+func TestCreateCustomDomain_StoresApex(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newDomainLookupService()
+
+	created, err := service.CreateCustomDomain(t.Context(), "owner", "acme", "www.Example.com", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "example.com", repo.created)
+	assert.Equal(t, "example.com", created.Domain)
+	assert.True(t, created.WwwPrefix)
+
+	// Both forms are served by the record just created
+	for _, host := range []string{"example.com", "www.example.com"} {
+		_, customDomain, err := service.GetByCustomDomain(t.Context(), "en", host)
+		require.NoError(t, err, host)
+		require.NotNil(t, customDomain, host)
+		assert.Equal(t, created.ID, customDomain.ID, host)
+	}
+
+	// Either form of a registered domain is taken
+	for _, domain := range []string{"example.com", "www.example.com"} {
+		_, err = service.CreateCustomDomain(t.Context(), "owner", "acme", domain, nil)
+		require.ErrorIs(t, err, profiles.ErrCustomDomainTaken, domain)
+	}
+}
+
+func TestCreateCustomDomain_LegacyWwwRecordTakesApex(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newDomainLookupService(
+		&profiles.ProfileCustomDomain{ID: "legacy", Domain: "www.legacy.example"}, //nolint:exhaustruct
+	)
+
+	_, err := service.CreateCustomDomain(t.Context(), "owner", "acme", "legacy.example", nil)
+	require.ErrorIs(t, err, profiles.ErrCustomDomainTaken)
+}
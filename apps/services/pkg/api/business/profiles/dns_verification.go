@@ -2,8 +2,24 @@ package profiles
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"strings"
+	"time"
+)
+
+// ErrDNSLookupFailed is returned when a domain could not be verified because a DNS
+// lookup failed (timeout, server failure), as opposed to the records not matching.
+var ErrDNSLookupFailed = errors.New("DNS lookup failed")
+
+// DomainVerificationTXTPrefix precedes the verification token in a domain's ownership TXT record.
+const DomainVerificationTXTPrefix = "aya-verify="
+
+// DNS record purposes reported in the DNS instructions of a custom domain.
+const (
+	DNSRecordPurposeRouting   = "routing"
+	DNSRecordPurposeOwnership = "ownership"
 )
 
 // DNSVerificationConfig holds the expected DNS targets for custom domain verification.
@@ -11,42 +27,274 @@ type DNSVerificationConfig struct {
 	ExpectedIPv4  string `conf:"expected_ipv4"  default:"104.128.190.136"`
 	ExpectedIPv6  string `conf:"expected_ipv6"  default:"2a0c:b840:2:1c::8cd4"`
 	ExpectedCNAME string `conf:"expected_cname" default:"aya.is."`
+
+	// On-demand ("verify now") checks run with a tight timeout and are rate limited per domain.
+	VerifyNowTimeout  time.Duration `conf:"verify_now_timeout"  default:"5s"`
+	VerifyNowCooldown time.Duration `conf:"verify_now_cooldown" default:"30s"`
+
+	// ExpiredGracePeriod is how long a previously verified domain keeps being served
+	// after its DNS stops matching, before it is marked failed and unsynced.
+	ExpiredGracePeriod time.Duration `conf:"expired_grace_period" default:"24h"`
+}
+
+// GetExpiredGracePeriod returns the configured grace period, falling back to
+// DomainExpiredGracePeriod when unset.
+func (c *DNSVerificationConfig) GetExpiredGracePeriod() time.Duration {
+	if c.ExpiredGracePeriod <= 0 {
+		return DomainExpiredGracePeriod
+	}
+
+	return c.ExpiredGracePeriod
+}
+
+// ExpectedDNSRecords lists the records that verify the domain: a CNAME to the configured
+// target (or A/AAAA records where a CNAME is not possible, such as the zone apex) and the
+// TXT record carrying the domain's verification token.
+func ExpectedDNSRecords(domain *ProfileCustomDomain, config *DNSVerificationConfig) []DNSRecord {
+	records := make([]DNSRecord, 0, 4) //nolint:mnd
+
+	if config.ExpectedCNAME != "" {
+		records = append(records, DNSRecord{
+			Type:    "CNAME",
+			Name:    domain.Domain,
+			Value:   config.ExpectedCNAME,
+			Purpose: DNSRecordPurposeRouting,
+		})
+	}
+
+	if config.ExpectedIPv4 != "" {
+		records = append(records, DNSRecord{
+			Type:    "A",
+			Name:    domain.Domain,
+			Value:   config.ExpectedIPv4,
+			Purpose: DNSRecordPurposeRouting,
+		})
+	}
+
+	if config.ExpectedIPv6 != "" {
+		records = append(records, DNSRecord{
+			Type:    "AAAA",
+			Name:    domain.Domain,
+			Value:   config.ExpectedIPv6,
+			Purpose: DNSRecordPurposeRouting,
+		})
+	}
+
+	if domain.VerificationToken != "" {
+		records = append(records, DNSRecord{
+			Type:    "TXT",
+			Name:    domain.Domain,
+			Value:   DomainVerificationTXTPrefix + domain.VerificationToken,
+			Purpose: DNSRecordPurposeOwnership,
+		})
+	}
+
+	return records
+}
+
+// ComputeDomainVerificationStatus determines the new verification status of a domain
+// from its current state and the latest DNS result. It returns the new status along
+// with the dns_verified_at and expired_at values to store.
+//
+// Verified domains are re-checked on every cycle as DNS can change: a verified domain
+// whose DNS stops matching enters the expired grace period (still served) and becomes
+// failed once gracePeriod has elapsed, which removes it from the served domain set.
+// If DNS matches again during the grace period the domain returns to verified.
+func ComputeDomainVerificationStatus(
+	domain *ProfileCustomDomain,
+	dnsVerified bool,
+	now time.Time,
+	gracePeriod time.Duration,
+) (string, *time.Time, *time.Time) {
+	if dnsVerified {
+		// DNS resolves correctly — set or keep verified
+		if domain.DNSVerifiedAt != nil {
+			return DomainStatusVerified, domain.DNSVerifiedAt, nil
+		}
+
+		return DomainStatusVerified, &now, nil
+	}
+
+	// DNS does not resolve
+	switch domain.VerificationStatus {
+	case DomainStatusVerified:
+		// Was verified, now enter grace period
+		return DomainStatusExpired, domain.DNSVerifiedAt, &now
+
+	case DomainStatusExpired:
+		// Already in grace period — check if grace period has elapsed
+		if domain.ExpiredAt != nil &&
+			now.Sub(*domain.ExpiredAt) >= gracePeriod {
+			return DomainStatusFailed, domain.DNSVerifiedAt, domain.ExpiredAt
+		}
+
+		// Still within grace period
+		return DomainStatusExpired, domain.DNSVerifiedAt, domain.ExpiredAt
+
+	default:
+		// pending or failed — stay/become failed
+		return DomainStatusFailed, domain.DNSVerifiedAt, nil
+	}
+}
+
+// RecheckDomainDNS checks a domain's DNS and stores the verification status it
+// leads to, updating domain to match. A positive lookupTimeout bounds the DNS
+// lookups. When the records could not be looked up, the current status is kept
+// and only the check time is recorded; the returned error then wraps
+// ErrDNSLookupFailed.
+func RecheckDomainDNS(
+	ctx context.Context,
+	repo Repository,
+	resolver DNSResolver,
+	domain *ProfileCustomDomain,
+	config *DNSVerificationConfig,
+	lookupTimeout time.Duration,
+	now time.Time,
+) (DNSCheckResult, error) {
+	lookupCtx := ctx
+
+	if lookupTimeout > 0 {
+		var cancel context.CancelFunc
+
+		lookupCtx, cancel = context.WithTimeout(ctx, lookupTimeout)
+		defer cancel()
+	}
+
+	check, lookupErr := CheckDomainDNS(lookupCtx, resolver, domain, config)
+
+	newStatus, dnsVerifiedAt, expiredAt := ComputeDomainVerificationStatus(
+		domain,
+		check.Outcome == DNSCheckVerified,
+		now,
+		config.GetExpiredGracePeriod(),
+	)
+
+	// A failed lookup says nothing about the records; keep the current status.
+	if lookupErr != nil {
+		newStatus = domain.VerificationStatus
+		dnsVerifiedAt = domain.DNSVerifiedAt
+		expiredAt = domain.ExpiredAt
+	}
+
+	err := repo.UpdateCustomDomainVerification(ctx, domain.ID, newStatus, dnsVerifiedAt, expiredAt)
+	if err != nil {
+		return check, fmt.Errorf("%w(domainID: %s): %w", ErrFailedToUpdateRecord, domain.ID, err)
+	}
+
+	// Failed domains are dropped from the webserver on the next sync.
+	if newStatus == DomainStatusFailed && domain.WebserverSynced {
+		err = repo.UpdateCustomDomainWebserverSynced(ctx, domain.ID, false)
+		if err != nil {
+			return check, fmt.Errorf("%w(domainID: %s): %w", ErrFailedToUpdateRecord, domain.ID, err)
+		}
+
+		domain.WebserverSynced = false
+	}
+
+	domain.VerificationStatus = newStatus
+	domain.DNSVerifiedAt = dnsVerifiedAt
+	domain.ExpiredAt = expiredAt
+	domain.LastDNSCheckAt = &now
+
+	return check, lookupErr
 }
 
-// VerifyDomainDNS checks whether a domain's DNS records point to the expected server.
-// Returns whether the domain is verified and a reason string for logging.
+// DNSResolver is the port for the DNS lookups made while verifying custom domains.
+// *net.Resolver satisfies it.
+type DNSResolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// DNS check outcomes reported by CheckDomainDNS.
+const (
+	// DNSCheckPending means no routing or ownership record was found yet, or the
+	// records could not be looked up.
+	DNSCheckPending = "pending"
+	// DNSCheckMismatch means records exist but none of them points to the expected
+	// targets or carries the domain's token.
+	DNSCheckMismatch = "mismatch"
+	// DNSCheckVerified means a record matched.
+	DNSCheckVerified = "verified"
+)
+
+// DNSCheckResult is the outcome of checking a domain's DNS, with a reason string
+// for logging and for the owner to fix a mismatch.
+type DNSCheckResult struct {
+	Outcome string `json:"outcome"`
+	Detail  string `json:"detail"`
+}
+
+// VerifyDomainDNS checks whether a domain's DNS records point to the expected server
+// or prove ownership through the domain's verification TXT record. Returns whether
+// the domain is verified and a reason string for logging. See CheckDomainDNS.
+func VerifyDomainDNS(
+	ctx context.Context,
+	resolver DNSResolver,
+	domain *ProfileCustomDomain,
+	config *DNSVerificationConfig,
+) (bool, string, error) {
+	result, err := CheckDomainDNS(ctx, resolver, domain, config)
+
+	return result.Outcome == DNSCheckVerified, result.Detail, err
+}
+
+// CheckDomainDNS checks whether a domain's DNS records point to the expected server
+// or prove ownership through the domain's verification TXT record. Either one is
+// enough. When nothing matched and a lookup failed for another reason than the
+// record not existing, the outcome is pending and the error wraps
+// ErrDNSLookupFailed: the records may well be correct.
 //
-// Three verification phases:
+// Verification phases:
 //  1. Direct IP match — domain resolves to the expected origin IP.
 //  2. CNAME match — domain has a CNAME pointing to the expected target.
 //  3. Resolved IP match — domain resolves to the same IPs as the CNAME target.
 //     This handles Cloudflare CNAME flattening at zone apex and proxied setups
 //     where the target itself resolves to CDN edge IPs rather than the origin.
-func VerifyDomainDNS( //nolint:cyclop
+//  4. TXT match — domain has an "aya-verify=<token>" TXT record with its token,
+//     for apex domains or DNS providers where the records above can't be set.
+func CheckDomainDNS( //nolint:cyclop,funlen
 	ctx context.Context,
-	domain string,
+	resolver DNSResolver,
+	domain *ProfileCustomDomain,
 	config *DNSVerificationConfig,
-) (bool, string) {
+) (DNSCheckResult, error) {
+	var lookupErr error
+
+	recordLookupErr := func(err error) {
+		if err != nil && lookupErr == nil && !IsDNSNotFound(err) {
+			lookupErr = err
+		}
+	}
+
+	verified := func(detail string) (DNSCheckResult, error) {
+		return DNSCheckResult{Outcome: DNSCheckVerified, Detail: detail}, nil
+	}
+
 	// Phase 1: Check A/AAAA records against expected origin IPs
-	ips, err := net.DefaultResolver.LookupHost(ctx, domain)
+	ips, err := resolver.LookupHost(ctx, domain.Domain)
+	recordLookupErr(err)
+
 	if err == nil {
 		for _, ip := range ips {
 			if ip == config.ExpectedIPv4 || ip == config.ExpectedIPv6 {
-				return true, "A/AAAA record matches expected IP: " + ip
+				return verified("A/AAAA record matches expected IP: " + ip)
 			}
 		}
 	}
 
 	// Phase 2: Check CNAME record
-	cname, err := net.DefaultResolver.LookupCNAME(ctx, domain)
-	if err == nil && cname != "" {
-		// CNAME records have a trailing dot; normalize both for comparison
-		normalizedCNAME := strings.TrimRight(cname, ".")
-		normalizedExpected := strings.TrimRight(config.ExpectedCNAME, ".")
-
-		if strings.EqualFold(normalizedCNAME, normalizedExpected) {
-			return true, "CNAME record matches: " + cname
-		}
+	cname, err := resolver.LookupCNAME(ctx, domain.Domain)
+	recordLookupErr(err)
+
+	// CNAME records have a trailing dot; normalize for comparison. A host without a
+	// CNAME reports its own name.
+	normalizedCNAME := strings.TrimRight(cname, ".")
+	hasCNAME := err == nil && normalizedCNAME != "" && !strings.EqualFold(normalizedCNAME, domain.Domain)
+
+	if hasCNAME && strings.EqualFold(normalizedCNAME, strings.TrimRight(config.ExpectedCNAME, ".")) {
+		return verified("CNAME record matches: " + cname)
 	}
 
 	// Phase 3: Resolve the CNAME target and compare IPs.
@@ -56,23 +304,101 @@ func VerifyDomainDNS( //nolint:cyclop
 	if len(ips) > 0 {
 		cnameTarget := strings.TrimRight(config.ExpectedCNAME, ".")
 
-		targetIPs, targetErr := net.DefaultResolver.LookupHost(ctx, cnameTarget)
+		targetIPs, targetErr := resolver.LookupHost(ctx, cnameTarget)
+		recordLookupErr(targetErr)
+
 		if targetErr == nil && len(targetIPs) > 0 {
 			if ipsOverlap(ips, targetIPs) {
-				return true, "IPs match CNAME target " + cnameTarget + " (CNAME flattening detected)"
+				return verified("IPs match CNAME target " + cnameTarget + " (CNAME flattening detected)")
 			}
 		}
 	}
 
+	// Phase 4: Check the ownership TXT record
+	hasVerificationTXT := false
+
+	if domain.VerificationToken != "" {
+		txtRecords, txtErr := resolver.LookupTXT(ctx, domain.Domain)
+		recordLookupErr(txtErr)
+
+		if txtErr == nil && hasVerificationTXTRecord(txtRecords, domain.VerificationToken) {
+			return verified("TXT record proves ownership")
+		}
+
+		hasVerificationTXT = txtErr == nil && hasAnyVerificationTXTRecord(txtRecords)
+	}
+
 	// None of the phases matched
+	if lookupErr != nil {
+		return DNSCheckResult{
+				Outcome: DNSCheckPending,
+				Detail:  "DNS lookup failed for " + domain.Domain + ": " + lookupErr.Error(),
+			},
+			fmt.Errorf("%w: %w", ErrDNSLookupFailed, lookupErr)
+	}
+
 	if len(ips) > 0 {
-		return false, "DNS resolves to " + strings.Join(
-			ips,
-			", ",
-		) + " but expected " + config.ExpectedIPv4 + " or " + config.ExpectedIPv6
+		return DNSCheckResult{
+			Outcome: DNSCheckMismatch,
+			Detail: "DNS resolves to " + strings.Join(
+				ips,
+				", ",
+			) + " but expected " + config.ExpectedIPv4 + " or " + config.ExpectedIPv6 +
+				" or a " + DomainVerificationTXTPrefix + " TXT record",
+		}, nil
+	}
+
+	if hasCNAME {
+		return DNSCheckResult{
+			Outcome: DNSCheckMismatch,
+			Detail:  "CNAME points to " + cname + " but expected " + config.ExpectedCNAME,
+		}, nil
+	}
+
+	if hasVerificationTXT {
+		return DNSCheckResult{
+			Outcome: DNSCheckMismatch,
+			Detail:  DomainVerificationTXTPrefix + " TXT record does not carry the domain's token",
+		}, nil
+	}
+
+	return DNSCheckResult{
+		Outcome: DNSCheckPending,
+		Detail:  "No matching DNS records found for " + domain.Domain,
+	}, nil
+}
+
+// IsDNSNotFound reports whether err is an authoritative "no such record" answer
+// rather than a failed lookup.
+func IsDNSNotFound(err error) bool {
+	var dnsErr *net.DNSError
+
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
+
+// hasVerificationTXTRecord reports whether one of the TXT records carries the token.
+func hasVerificationTXTRecord(records []string, token string) bool {
+	expected := DomainVerificationTXTPrefix + token
+
+	for _, record := range records {
+		if strings.TrimSpace(record) == expected {
+			return true
+		}
 	}
 
-	return false, "DNS lookup failed or no matching records found for " + domain
+	return false
+}
+
+// hasAnyVerificationTXTRecord reports whether one of the TXT records is a
+// verification record, whatever its token.
+func hasAnyVerificationTXTRecord(records []string) bool {
+	for _, record := range records {
+		if strings.HasPrefix(strings.TrimSpace(record), DomainVerificationTXTPrefix) {
+			return true
+		}
+	}
+
+	return false
 }
 
 // ipsOverlap returns true if at least one IP appears in both slices.
@@ -0,0 +1,351 @@
+package profiles_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGracePeriod = 24 * time.Hour
+
+// stubDNSResolver answers lookups from fixed tables; unknown names fail like NXDOMAIN,
+// or with failure when it is set.
+type stubDNSResolver struct {
+	failure error
+	hosts   map[string][]string
+	cnames  map[string]string
+	txts    map[string][]string
+}
+
+func (r *stubDNSResolver) notFound(name string) error {
+	if r.failure != nil {
+		return r.failure
+	}
+
+	return &net.DNSError{Err: "no such host", Name: name, IsNotFound: true} //nolint:exhaustruct
+}
+
+func (r *stubDNSResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if ips, ok := r.hosts[host]; ok {
+		return ips, nil
+	}
+
+	return nil, r.notFound(host)
+}
+
+func (r *stubDNSResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	if cname, ok := r.cnames[host]; ok {
+		return cname, nil
+	}
+
+	return "", r.notFound(host)
+}
+
+func (r *stubDNSResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if records, ok := r.txts[name]; ok {
+		return records, nil
+	}
+
+	return nil, r.notFound(name)
+}
+
+func TestComputeDomainVerificationStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	verifiedAt := now.Add(-72 * time.Hour)
+
+	t.Run("pending domain becomes verified", func(t *testing.T) {
+		t.Parallel()
+
+		domain := &profiles.ProfileCustomDomain{VerificationStatus: profiles.DomainStatusPending} //nolint:exhaustruct
+
+		status, dnsVerifiedAt, expiredAt := profiles.ComputeDomainVerificationStatus(
+			domain, true, now, testGracePeriod,
+		)
+
+		assert.Equal(t, profiles.DomainStatusVerified, status)
+		assert.Equal(t, &now, dnsVerifiedAt)
+		assert.Nil(t, expiredAt)
+	})
+
+	t.Run("verified domain with mismatching dns expires", func(t *testing.T) {
+		t.Parallel()
+
+		domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			VerificationStatus: profiles.DomainStatusVerified,
+			DNSVerifiedAt:      &verifiedAt,
+		}
+
+		status, dnsVerifiedAt, expiredAt := profiles.ComputeDomainVerificationStatus(
+			domain, false, now, testGracePeriod,
+		)
+
+		assert.Equal(t, profiles.DomainStatusExpired, status)
+		assert.Equal(t, &verifiedAt, dnsVerifiedAt)
+		assert.Equal(t, &now, expiredAt)
+	})
+
+	t.Run("expired domain stays expired within the grace period", func(t *testing.T) {
+		t.Parallel()
+
+		expiredSince := now.Add(-time.Hour)
+		domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			VerificationStatus: profiles.DomainStatusExpired,
+			DNSVerifiedAt:      &verifiedAt,
+			ExpiredAt:          &expiredSince,
+		}
+
+		status, _, expiredAt := profiles.ComputeDomainVerificationStatus(
+			domain, false, now, testGracePeriod,
+		)
+
+		assert.Equal(t, profiles.DomainStatusExpired, status)
+		assert.Equal(t, &expiredSince, expiredAt)
+	})
+
+	t.Run("expired domain becomes unverified after the grace period", func(t *testing.T) {
+		t.Parallel()
+
+		expiredSince := now.Add(-testGracePeriod)
+		domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			VerificationStatus: profiles.DomainStatusExpired,
+			DNSVerifiedAt:      &verifiedAt,
+			ExpiredAt:          &expiredSince,
+		}
+
+		status, _, _ := profiles.ComputeDomainVerificationStatus(
+			domain, false, now, testGracePeriod,
+		)
+
+		assert.Equal(t, profiles.DomainStatusFailed, status)
+	})
+
+	t.Run("expired domain recovers when dns matches again", func(t *testing.T) {
+		t.Parallel()
+
+		expiredSince := now.Add(-time.Hour)
+		domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+			VerificationStatus: profiles.DomainStatusExpired,
+			DNSVerifiedAt:      &verifiedAt,
+			ExpiredAt:          &expiredSince,
+		}
+
+		status, dnsVerifiedAt, expiredAt := profiles.ComputeDomainVerificationStatus(
+			domain, true, now, testGracePeriod,
+		)
+
+		assert.Equal(t, profiles.DomainStatusVerified, status)
+		assert.Equal(t, &verifiedAt, dnsVerifiedAt)
+		assert.Nil(t, expiredAt)
+	})
+}
+
+func TestExpectedDNSRecords(t *testing.T) {
+	t.Parallel()
+
+	domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+		Domain:            "blog.example.com",
+		VerificationToken: "0123abcd",
+	}
+	config := &profiles.DNSVerificationConfig{ //nolint:exhaustruct
+		ExpectedIPv4:  "192.0.2.1",
+		ExpectedCNAME: "aya.is.",
+	}
+
+	records := profiles.ExpectedDNSRecords(domain, config)
+
+	assert.Equal(t, []profiles.DNSRecord{
+		{
+			Type:    "CNAME",
+			Name:    "blog.example.com",
+			Value:   "aya.is.",
+			Purpose: profiles.DNSRecordPurposeRouting,
+		},
+		{
+			Type:    "A",
+			Name:    "blog.example.com",
+			Value:   "192.0.2.1",
+			Purpose: profiles.DNSRecordPurposeRouting,
+		},
+		{
+			Type:    "TXT",
+			Name:    "blog.example.com",
+			Value:   "aya-verify=0123abcd",
+			Purpose: profiles.DNSRecordPurposeOwnership,
+		},
+	}, records)
+}
+
+func TestVerifyDomainDNS(t *testing.T) {
+	t.Parallel()
+
+	config := &profiles.DNSVerificationConfig{ //nolint:exhaustruct
+		ExpectedIPv4:  "192.0.2.1",
+		ExpectedIPv6:  "2001:db8::1",
+		ExpectedCNAME: "aya.is.",
+	}
+
+	tests := map[string]struct {
+		resolver  *stubDNSResolver
+		verified  bool
+		lookupErr bool
+	}{
+		"cname to the expected target": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				cnames: map[string]string{"blog.example.com": "aya.is."},
+			},
+			verified: true,
+		},
+		"a record with the expected ip": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				hosts: map[string][]string{"blog.example.com": {"192.0.2.1"}},
+			},
+			verified: true,
+		},
+		"txt record with the domain token": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				hosts: map[string][]string{"blog.example.com": {"198.51.100.7"}},
+				txts: map[string][]string{
+					"blog.example.com": {"v=spf1 -all", "aya-verify=token-1"},
+				},
+			},
+			verified: true,
+		},
+		"txt record with another token": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				txts: map[string][]string{"blog.example.com": {"aya-verify=token-2"}},
+			},
+			verified: false,
+		},
+		"records point elsewhere": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				hosts:  map[string][]string{"blog.example.com": {"198.51.100.7"}},
+				cnames: map[string]string{"blog.example.com": "other.example.net."},
+			},
+			verified: false,
+		},
+		"nxdomain": {
+			resolver: &stubDNSResolver{}, //nolint:exhaustruct
+			verified: false,
+		},
+		"lookup timeout": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				failure: context.DeadlineExceeded,
+			},
+			verified:  false,
+			lookupErr: true,
+		},
+		"server failure after a mismatch": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				hosts:   map[string][]string{"blog.example.com": {"198.51.100.7"}},
+				failure: &net.DNSError{Err: "server misbehaving", IsTemporary: true}, //nolint:exhaustruct
+			},
+			verified:  false,
+			lookupErr: true,
+		},
+		"server failure does not hide a match": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				cnames:  map[string]string{"blog.example.com": "aya.is."},
+				failure: &net.DNSError{Err: "server misbehaving", IsTemporary: true}, //nolint:exhaustruct
+			},
+			verified: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+				Domain:            "blog.example.com",
+				VerificationToken: "token-1",
+			}
+
+			verified, reason, err := profiles.VerifyDomainDNS(t.Context(), tt.resolver, domain, config)
+
+			assert.Equal(t, tt.verified, verified, reason)
+
+			if tt.lookupErr {
+				require.ErrorIs(t, err, profiles.ErrDNSLookupFailed)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckDomainDNS_Outcome(t *testing.T) {
+	t.Parallel()
+
+	config := &profiles.DNSVerificationConfig{ //nolint:exhaustruct
+		ExpectedIPv4:  "192.0.2.1",
+		ExpectedCNAME: "aya.is.",
+	}
+
+	tests := map[string]struct {
+		resolver *stubDNSResolver
+		expected string
+	}{
+		"matching cname": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				cnames: map[string]string{"blog.example.com": "aya.is."},
+			},
+			expected: profiles.DNSCheckVerified,
+		},
+		"no records yet": {
+			resolver: &stubDNSResolver{}, //nolint:exhaustruct
+			expected: profiles.DNSCheckPending,
+		},
+		"host without a cname reports itself": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				cnames: map[string]string{"blog.example.com": "blog.example.com."},
+			},
+			expected: profiles.DNSCheckPending,
+		},
+		"lookup timeout": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				failure: context.DeadlineExceeded,
+			},
+			expected: profiles.DNSCheckPending,
+		},
+		"a record elsewhere": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				hosts: map[string][]string{"blog.example.com": {"198.51.100.7"}},
+			},
+			expected: profiles.DNSCheckMismatch,
+		},
+		"cname elsewhere": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				cnames: map[string]string{"blog.example.com": "other.example.net."},
+			},
+			expected: profiles.DNSCheckMismatch,
+		},
+		"txt record with another token": {
+			resolver: &stubDNSResolver{ //nolint:exhaustruct
+				txts: map[string][]string{"blog.example.com": {"aya-verify=token-2"}},
+			},
+			expected: profiles.DNSCheckMismatch,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			domain := &profiles.ProfileCustomDomain{ //nolint:exhaustruct
+				Domain:            "blog.example.com",
+				VerificationToken: "token-1",
+			}
+
+			result, _ := profiles.CheckDomainDNS(t.Context(), tt.resolver, domain, config)
+
+			assert.Equal(t, tt.expected, result.Outcome, result.Detail)
+		})
+	}
+}
@@ -41,14 +41,138 @@ type GenerateCVPageParams struct {
 	Locale              string
 }
 
-// GenerateCVPage orchestrates the full AI CV generation workflow:
-// check permissions, deduct points, gather profile data, generate via AI, and create page.
-func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
+// StreamingContentGenerator is implemented by generators that can stream
+// the generated content while it is being produced.
+type StreamingContentGenerator interface {
+	StreamCV(
+		ctx context.Context,
+		locale string,
+		profileTitle string,
+		profileDescription string,
+		linkedInURL string,
+		links []*ProfileLinkBrief,
+		contributions []*ProfileMembership,
+		onDelta func(delta string),
+	) (title, summary, content string, err error)
+}
+
+// cvGenerationInput holds the profile data a CV is generated from.
+type cvGenerationInput struct {
+	profileData   *ProfileWithChildren
+	pageSlug      string
+	linkedInURL   string
+	links         []*ProfileLinkBrief
+	contributions []*ProfileMembership
+}
+
+// GenerateCVPage orchestrates the full AI CV generation workflow: check permissions
+// and the rate limit, gather and cap profile data, deduct points, generate via AI,
+// and create page.
+func (s *Service) GenerateCVPage(
 	ctx context.Context,
 	params GenerateCVPageParams,
 	generator ContentGenerator,
 	pointsService *profile_points.Service,
 ) (*ProfilePage, error) {
+	pageSlug, err := s.prepareCVPage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.allowAIRequest(params.IndividualProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := s.gatherCVGenerationInput(ctx, params, pageSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	err = spendCVGenerationPoints(ctx, pointsService, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate CV content via AI
+	title, summary, content, genErr := generator.GenerateCV(
+		ctx,
+		params.Locale,
+		input.profileData.Title,
+		input.profileData.Description,
+		input.linkedInURL,
+		input.links,
+		input.contributions,
+	)
+	if genErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGenerateContent, genErr)
+	}
+
+	return s.createGeneratedCVPage(ctx, params, input, title, summary, content)
+}
+
+// GenerateCVPageStreaming runs the CV generation workflow while streaming the
+// generated content through onDelta. Unlike GenerateCVPage, points are only
+// deducted once generation has completed successfully; the balance is checked
+// upfront so users without enough points don't wait for a generation that
+// can't be saved.
+func (s *Service) GenerateCVPageStreaming(
+	ctx context.Context,
+	params GenerateCVPageParams,
+	generator StreamingContentGenerator,
+	pointsService *profile_points.Service,
+	onDelta func(delta string),
+) (*ProfilePage, error) {
+	pageSlug, err := s.prepareCVPage(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.allowAIRequest(params.IndividualProfileID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := pointsService.GetBalance(ctx, params.IndividualProfileID)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if balance.Points < profile_points.CostGenerateContent {
+		return nil, profile_points.ErrInsufficientPoints
+	}
+
+	input, err := s.gatherCVGenerationInput(ctx, params, pageSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stream CV content via AI
+	title, summary, content, genErr := generator.StreamCV(
+		ctx,
+		params.Locale,
+		input.profileData.Title,
+		input.profileData.Description,
+		input.linkedInURL,
+		input.links,
+		input.contributions,
+		onDelta,
+	)
+	if genErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGenerateContent, genErr)
+	}
+
+	err = spendCVGenerationPoints(ctx, pointsService, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.createGeneratedCVPage(ctx, params, input, title, summary, content)
+}
+
+// prepareCVPage checks that the user can edit the profile and picks an
+// available page slug: cv, cv-2, cv-3, ...
+func (s *Service) prepareCVPage(ctx context.Context, params GenerateCVPageParams) (string, error) {
 	// Check authorization
 	canEdit, permErr := s.HasUserAccessToProfile(
 		ctx,
@@ -57,11 +181,11 @@ func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
 		MembershipKindMaintainer,
 	)
 	if permErr != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFailedToCheckPermissions, permErr)
+		return "", fmt.Errorf("%w: %w", ErrFailedToCheckPermissions, permErr)
 	}
 
 	if !canEdit {
-		return nil, fmt.Errorf(
+		return "", fmt.Errorf(
 			"%w: user %s cannot edit profile %s",
 			ErrUnauthorized,
 			params.UserID,
@@ -69,9 +193,6 @@ func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
 		)
 	}
 
-	// Find an available slug: cv, cv-2, cv-3, ...
-	var pageSlug string
-
 	for i := 1; ; i++ {
 		candidate := "cv"
 		if i > 1 {
@@ -87,17 +208,21 @@ func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
 			false,
 		)
 		if slugErr != nil {
-			return nil, fmt.Errorf("%w: %w", ErrFailedToGetProfileData, slugErr)
+			return "", fmt.Errorf("%w: %w", ErrFailedToGetProfileData, slugErr)
 		}
 
 		if slugResult.Available || slugResult.Severity != SeverityError {
-			pageSlug = candidate
-
-			break
+			return candidate, nil
 		}
 	}
+}
 
-	// Deduct points for content generation
+// spendCVGenerationPoints deducts the points for content generation.
+func spendCVGenerationPoints(
+	ctx context.Context,
+	pointsService *profile_points.Service,
+	params GenerateCVPageParams,
+) error {
 	eventGenerateContent := profile_points.EventGenerateContent
 
 	_, spendErr := pointsService.SpendPoints(ctx, profile_points.SpendParams{
@@ -107,10 +232,17 @@ func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
 		TriggeringEvent: &eventGenerateContent,
 		Description:     "Generate CV page from profile data",
 	})
-	if spendErr != nil {
-		return nil, spendErr //nolint:wrapcheck
-	}
 
+	return spendErr //nolint:wrapcheck
+}
+
+// gatherCVGenerationInput fetches the profile data, contributions and LinkedIn URL,
+// capped to the AI input budget.
+func (s *Service) gatherCVGenerationInput(
+	ctx context.Context,
+	params GenerateCVPageParams,
+	pageSlug string,
+) (*cvGenerationInput, error) {
 	// Fetch profile data (title, description, links)
 	profileData, profileErr := s.GetBySlugEx(ctx, params.Locale, params.ProfileSlug)
 	if profileErr != nil {
@@ -143,27 +275,37 @@ func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
 		return nil, ErrNoLinkedInLinkFound
 	}
 
-	// Generate CV content via AI
-	title, summary, content, genErr := generator.GenerateCV(
-		ctx,
-		params.Locale,
-		profileData.Title,
-		profileData.Description,
-		linkedInURL,
-		profileData.Links,
-		contributions.Data,
-	)
-	if genErr != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFailedToGenerateContent, genErr)
+	input := &cvGenerationInput{
+		profileData:   profileData,
+		pageSlug:      pageSlug,
+		linkedInURL:   linkedInURL,
+		links:         profileData.Links,
+		contributions: contributions.Data,
+	}
+
+	err := capCVGenerationInput(input, s.aiMaxInputChars())
+	if err != nil {
+		return nil, err
 	}
 
-	// Create the page
+	return input, nil
+}
+
+// createGeneratedCVPage stores the generated CV as a public page and records the audit event.
+func (s *Service) createGeneratedCVPage(
+	ctx context.Context,
+	params GenerateCVPageParams,
+	input *cvGenerationInput,
+	title string,
+	summary string,
+	content string,
+) (*ProfilePage, error) {
 	page, createErr := s.CreateProfilePage(
 		ctx,
 		params.UserID,
 		params.UserKind,
 		params.ProfileSlug,
-		pageSlug,
+		input.pageSlug,
 		params.Locale,
 		title,
 		summary,
@@ -186,7 +328,7 @@ func (s *Service) GenerateCVPage( //nolint:cyclop,funlen
 		Payload: map[string]any{
 			"locale":       params.Locale,
 			"generator":    "cv_from_linkedin",
-			"linkedin_url": linkedInURL,
+			"linkedin_url": input.linkedInURL,
 		},
 	})
 
@@ -0,0 +1,289 @@
+package profiles_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cvProfileRepository holds a maintainer's individual profile with a LinkedIn
+// link, and counts the pages created on it. It is safe for concurrent use.
+type cvProfileRepository struct {
+	profiles.Repository
+
+	pageIDs map[string]string // slug -> page ID
+	mu      sync.Mutex
+	pages   int
+}
+
+func (r *cvProfileRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "profile-user", nil
+}
+
+func (r *cvProfileRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-user"
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *cvProfileRepository) GetProfileByID(
+	_ context.Context,
+	localeCode string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ //nolint:exhaustruct
+		ID:         id,
+		Slug:       "eser",
+		Kind:       profiles.ProfileKindIndividual,
+		LocaleCode: localeCode,
+		Title:      "Eser",
+	}, nil
+}
+
+func (r *cvProfileRepository) ListProfilePagesByProfileID(
+	_ context.Context,
+	_ string,
+	_ string,
+) ([]*profiles.ProfilePageBrief, error) {
+	return nil, nil
+}
+
+func (r *cvProfileRepository) ListFeaturedProfileLinksByProfileID(
+	_ context.Context,
+	_ string,
+	profileID string,
+) ([]*profiles.ProfileLinkBrief, error) {
+	return []*profiles.ProfileLinkBrief{
+		{ //nolint:exhaustruct
+			ID:         "link-linkedin",
+			Kind:       "linkedin",
+			URI:        "https://www.linkedin.com/in/eser",
+			Title:      "LinkedIn",
+			Visibility: profiles.LinkVisibilityPublic,
+		},
+	}, nil
+}
+
+func (r *cvProfileRepository) GetProfileCounts(
+	_ context.Context,
+	_ string,
+) (*profiles.ProfileCounts, error) {
+	return &profiles.ProfileCounts{}, nil //nolint:exhaustruct
+}
+
+func (r *cvProfileRepository) GetFeatureRelationsVisibility(
+	_ context.Context,
+	_ string,
+) (string, error) {
+	return string(profiles.ModuleVisibilityPublic), nil
+}
+
+func (r *cvProfileRepository) ListProfileContributions(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ []string,
+	_ *cursors.Cursor,
+) (cursors.Cursored[[]*profiles.ProfileMembership], error) {
+	return cursors.WrapResponseWithCursor([]*profiles.ProfileMembership{}, nil), nil
+}
+
+func (r *cvProfileRepository) GetProfilePageByProfileIDAndSlug(
+	_ context.Context,
+	_ string,
+	_ string,
+	slug string,
+) (*profiles.ProfilePage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id, ok := r.pageIDs[slug]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &profiles.ProfilePage{ID: id, Slug: slug}, nil //nolint:exhaustruct
+}
+
+func (r *cvProfileRepository) CreateProfilePage(
+	_ context.Context,
+	id string,
+	slug string,
+	_ string,
+	_ int,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ string,
+) (*profiles.ProfilePage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.pages++
+	r.pageIDs[slug] = id
+
+	return &profiles.ProfilePage{ID: id, Slug: slug}, nil //nolint:exhaustruct
+}
+
+func (r *cvProfileRepository) CreateProfilePageTx(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+// lockedLedgerRepository is a ledgerRepository that is safe for concurrent use.
+type lockedLedgerRepository struct {
+	ledgerRepository
+
+	mu sync.Mutex
+}
+
+func (r *lockedLedgerRepository) GetBalance(ctx context.Context, profileID string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ledgerRepository.GetBalance(ctx, profileID)
+}
+
+func (r *lockedLedgerRepository) RecordTransaction(
+	ctx context.Context,
+	id string,
+	targetProfileID string,
+	originProfileID *string,
+	transactionType profile_points.TransactionType,
+	triggeringEvent *string,
+	description string,
+	amount uint64,
+) (*profile_points.Transaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.ledgerRepository.RecordTransaction(
+		ctx,
+		id,
+		targetProfileID,
+		originProfileID,
+		transactionType,
+		triggeringEvent,
+		description,
+		amount,
+	)
+}
+
+// discardAuditRepository drops audit entries. It is safe for concurrent use.
+type discardAuditRepository struct {
+	events.AuditRepository
+}
+
+func (r *discardAuditRepository) InsertAudit(
+	_ context.Context,
+	_ string,
+	_ events.AuditParams,
+) error {
+	return nil
+}
+
+type stubCVGenerator struct{}
+
+func (g *stubCVGenerator) GenerateCV(
+	_ context.Context,
+	_ string,
+	profileTitle string,
+	_ string,
+	_ string,
+	_ []*profiles.ProfileLinkBrief,
+	_ []*profiles.ProfileMembership,
+) (string, string, string, error) {
+	return profileTitle + " CV", "Summary", "Content", nil
+}
+
+func TestGenerateCVPage_RateLimitedPerProfile(t *testing.T) {
+	t.Parallel()
+
+	const (
+		requests = 10
+		limit    = 3
+	)
+
+	auditService := events.NewAuditService(
+		nil,
+		&discardAuditRepository{}, //nolint:exhaustruct
+		func() string { return "audit" },
+		nil,
+	)
+	ledger := &lockedLedgerRepository{ //nolint:exhaustruct
+		ledgerRepository: ledgerRepository{balance: 100}, //nolint:exhaustruct
+	}
+	pointsService := profile_points.NewService(
+		nil,
+		&profile_points.Config{}, //nolint:exhaustruct
+		ledger,
+		func() string { return "transaction" },
+		auditService,
+	)
+	repo := &cvProfileRepository{pageIDs: map[string]string{}} //nolint:exhaustruct
+	service := profiles.NewService(
+		nil,
+		&profiles.Config{AIRequestsPerHour: limit}, //nolint:exhaustruct
+		repo,
+		auditService,
+	)
+
+	var (
+		wg          sync.WaitGroup
+		mu          sync.Mutex
+		successes   int
+		rateLimited int
+	)
+
+	for range requests {
+		wg.Go(func() {
+			_, err := service.GenerateCVPage(t.Context(), profiles.GenerateCVPageParams{
+				UserID:              "user",
+				UserKind:            "regular",
+				IndividualProfileID: "profile-user",
+				ProfileSlug:         "eser",
+				Locale:              "en",
+			}, &stubCVGenerator{}, pointsService)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil {
+				successes++
+
+				return
+			}
+
+			assert.ErrorIs(t, err, profiles.ErrRateLimited)
+
+			rateLimited++
+		})
+	}
+
+	wg.Wait()
+
+	require.Equal(t, limit, successes)
+	assert.Equal(t, requests-limit, rateLimited)
+	assert.Equal(t, limit, repo.pages)
+	assert.Len(t, ledger.transactions, limit)
+	assert.Equal(t, 100-uint64(limit)*profile_points.CostGenerateContent, ledger.balance)
+}
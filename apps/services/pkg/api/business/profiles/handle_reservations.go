@@ -0,0 +1,291 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var (
+	ErrHandleReserved            = errors.New("handle is reserved")
+	ErrHandleAlreadyReserved     = errors.New("handle is already reserved")
+	ErrHandleTaken               = errors.New("handle is already taken")
+	ErrHandleReservationNotFound = errors.New("handle reservation not found")
+	ErrInvalidHandleReservation  = errors.New("invalid handle reservation")
+	ErrFailedToClaimHandle       = errors.New("failed to claim handle reservation")
+)
+
+// DefaultHandleReservationTTL is how long a reservation lasts when the
+// configuration doesn't set a window.
+const DefaultHandleReservationTTL = 30 * 24 * time.Hour
+
+// handleSlugRegex matches the slugs a profile can be created with.
+var handleSlugRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// ReserveHandleParams holds the parameters for reserving a profile handle.
+// At least one of VerificationEmail and VerificationDomain must be set.
+type ReserveHandleParams struct {
+	VerificationEmail  *string
+	VerificationDomain *string
+	Note               *string
+	AdminUserID        string
+	Slug               string
+}
+
+// IsExpired reports whether the reservation no longer blocks its slug at now.
+func (r *HandleReservation) IsExpired(now time.Time) bool {
+	return !r.ExpiresAt.After(now)
+}
+
+// CanBeClaimedBy reports whether a user with the given email may take the
+// reserved slug: the email must equal the verification email or belong to the
+// verification domain.
+func (r *HandleReservation) CanBeClaimedBy(email string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	if r.VerificationEmail != nil && email == *r.VerificationEmail {
+		return true
+	}
+
+	_, domain, ok := strings.Cut(email, "@")
+
+	return ok && r.VerificationDomain != nil && domain == *r.VerificationDomain
+}
+
+func (s *Service) handleReservationTTL() time.Duration {
+	if s.config == nil || s.config.HandleReservationTTL <= 0 {
+		return DefaultHandleReservationTTL
+	}
+
+	return s.config.HandleReservationTTL
+}
+
+// getActiveHandleReservation returns the reservation currently holding slug, or
+// nil when there is none or it has expired.
+func (s *Service) getActiveHandleReservation(
+	ctx context.Context,
+	slug string,
+) (*HandleReservation, error) {
+	reservation, err := s.repo.GetActiveHandleReservationBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+	}
+
+	if reservation == nil || reservation.IsExpired(time.Now()) {
+		return nil, nil //nolint:nilnil
+	}
+
+	return reservation, nil
+}
+
+// ReserveHandle holds a slug for an organization that has not signed up yet.
+// The slug shows as reserved until a matching user claims it, an admin releases
+// it, or the configured reservation window passes.
+func (s *Service) ReserveHandle(
+	ctx context.Context,
+	params ReserveHandleParams,
+) (*HandleReservation, error) {
+	slug := strings.ToLower(strings.TrimSpace(params.Slug))
+
+	if len(slug) < minSlugLength || !handleSlugRegex.MatchString(slug) ||
+		s.config.GetForbiddenSlugs()[slug] {
+		return nil, fmt.Errorf("%w: slug %q is not allowed", ErrInvalidHandleReservation, params.Slug)
+	}
+
+	email, domain, err := normalizeHandleVerification(params.VerificationEmail, params.VerificationDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.CheckSlugExists(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return nil, fmt.Errorf("%w: %s", ErrHandleTaken, slug)
+	}
+
+	existing, err := s.getActiveHandleReservation(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing != nil {
+		return nil, fmt.Errorf("%w: %s", ErrHandleAlreadyReserved, slug)
+	}
+
+	now := time.Now()
+	reservation := &HandleReservation{
+		CreatedAt:          now,
+		ExpiresAt:          now.Add(s.handleReservationTTL()),
+		ClaimedAt:          nil,
+		ReleasedAt:         nil,
+		VerificationEmail:  email,
+		VerificationDomain: domain,
+		Note:               params.Note,
+		ClaimedByProfileID: nil,
+		ID:                 string(s.idGenerator()),
+		Slug:               slug,
+		ReservedByUserID:   params.AdminUserID,
+	}
+
+	err = s.repo.CreateHandleReservation(ctx, reservation)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToCreateRecord, slug, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileHandleReserved,
+		EntityType: "profile_handle_reservation",
+		EntityID:   reservation.ID,
+		ActorID:    &params.AdminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"slug":                slug,
+			"verification_email":  email,
+			"verification_domain": domain,
+			"expires_at":          reservation.ExpiresAt,
+		},
+	})
+
+	return reservation, nil
+}
+
+// ReleaseHandle lifts the active reservation on a slug so anyone can take it.
+func (s *Service) ReleaseHandle(ctx context.Context, adminUserID string, slug string) error {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+
+	reservation, err := s.getActiveHandleReservation(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	if reservation == nil {
+		return fmt.Errorf("%w: %s", ErrHandleReservationNotFound, slug)
+	}
+
+	affected, err := s.repo.ReleaseHandleReservation(ctx, reservation.ID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, reservation.ID, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w: %s", ErrHandleReservationNotFound, slug)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileHandleReleased,
+		EntityType: "profile_handle_reservation",
+		EntityID:   reservation.ID,
+		ActorID:    &adminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload:    map[string]any{"slug": slug},
+	})
+
+	return nil
+}
+
+// EnsureHandleClaimable checks whether a user with the given email may create a
+// profile with slug. It returns the reservation the new profile will claim, nil
+// when the slug is not reserved, or ErrHandleReserved when the user doesn't match.
+func (s *Service) EnsureHandleClaimable(
+	ctx context.Context,
+	slug string,
+	email *string,
+) (*HandleReservation, error) {
+	reservation, err := s.getActiveHandleReservation(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservation == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	if email == nil || !reservation.CanBeClaimedBy(*email) {
+		return nil, fmt.Errorf("%w: %s", ErrHandleReserved, slug)
+	}
+
+	return reservation, nil
+}
+
+// ClaimHandleReservation marks a reservation as taken by the profile created for it.
+func (s *Service) ClaimHandleReservation(
+	ctx context.Context,
+	reservation *HandleReservation,
+	userID string,
+	profileID string,
+) error {
+	affected, err := s.repo.ClaimHandleReservation(ctx, reservation.ID, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, reservation.ID, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w(id: %s): already claimed or released",
+			ErrFailedToClaimHandle, reservation.ID)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileHandleClaimed,
+		EntityType: "profile_handle_reservation",
+		EntityID:   reservation.ID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"slug":       reservation.Slug,
+			"profile_id": profileID,
+		},
+	})
+
+	return nil
+}
+
+// normalizeHandleVerification lowercases and validates the verification email
+// and domain of a reservation. At least one of them is required.
+func normalizeHandleVerification(email *string, domain *string) (*string, *string, error) {
+	var normalizedEmail, normalizedDomain *string
+
+	if email != nil && strings.TrimSpace(*email) != "" {
+		value := strings.ToLower(strings.TrimSpace(*email))
+
+		local, host, ok := strings.Cut(value, "@")
+		if !ok || local == "" || strings.Contains(host, "@") {
+			return nil, nil, fmt.Errorf("%w: invalid verification email", ErrInvalidHandleReservation)
+		}
+
+		_, err := NormalizeCustomDomain(host)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid verification email", ErrInvalidHandleReservation)
+		}
+
+		normalizedEmail = &value
+	}
+
+	if domain != nil && strings.TrimSpace(*domain) != "" {
+		value, err := NormalizeCustomDomain(*domain)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid verification domain", ErrInvalidHandleReservation)
+		}
+
+		normalizedDomain = &value
+	}
+
+	if normalizedEmail == nil && normalizedDomain == nil {
+		return nil, nil, fmt.Errorf(
+			"%w: a verification email or domain is required",
+			ErrInvalidHandleReservation,
+		)
+	}
+
+	return normalizedEmail, normalizedDomain, nil
+}
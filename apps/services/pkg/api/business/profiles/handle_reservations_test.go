@@ -0,0 +1,284 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// handleReservationRepository keeps reservations in memory. Like the SQL query,
+// GetActiveHandleReservationBySlug skips claimed and released reservations but
+// leaves the expiry check to the service.
+type handleReservationRepository struct {
+	profiles.Repository
+
+	slugs        map[string]bool
+	reservations []*profiles.HandleReservation
+}
+
+func (r *handleReservationRepository) CheckProfileSlugExists(
+	_ context.Context,
+	slug string,
+) (bool, error) {
+	return r.slugs[slug], nil
+}
+
+func (r *handleReservationRepository) GetProfileSlugHistoryProfileID(
+	_ context.Context,
+	_ string,
+) (string, error) {
+	return "", nil
+}
+
+func (r *handleReservationRepository) GetActiveHandleReservationBySlug(
+	_ context.Context,
+	slug string,
+) (*profiles.HandleReservation, error) {
+	for i := len(r.reservations) - 1; i >= 0; i-- {
+		reservation := r.reservations[i]
+		if reservation.Slug == slug && reservation.ClaimedAt == nil && reservation.ReleasedAt == nil {
+			return reservation, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (r *handleReservationRepository) CreateHandleReservation(
+	_ context.Context,
+	reservation *profiles.HandleReservation,
+) error {
+	r.reservations = append(r.reservations, reservation)
+
+	return nil
+}
+
+func (r *handleReservationRepository) ReleaseHandleReservation(
+	_ context.Context,
+	id string,
+) (int64, error) {
+	for _, reservation := range r.reservations {
+		if reservation.ID == id && reservation.ClaimedAt == nil && reservation.ReleasedAt == nil {
+			now := time.Now()
+			reservation.ReleasedAt = &now
+
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func (r *handleReservationRepository) ClaimHandleReservation(
+	_ context.Context,
+	id string,
+	profileID string,
+) (int64, error) {
+	for _, reservation := range r.reservations {
+		if reservation.ID == id && reservation.ClaimedAt == nil && reservation.ReleasedAt == nil {
+			now := time.Now()
+			reservation.ClaimedAt = &now
+			reservation.ClaimedByProfileID = &profileID
+
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func newHandleReservationService() (
+	*profiles.Service,
+	*handleReservationRepository,
+	*recordingAuditRepository,
+) {
+	repo := &handleReservationRepository{ //nolint:exhaustruct
+		slugs: map[string]bool{"taken": true},
+	}
+	auditService, auditRepo := newRecordingAuditService()
+	config := &profiles.Config{ //nolint:exhaustruct
+		ForbiddenSlugs:       "admin",
+		HandleReservationTTL: 24 * time.Hour,
+	}
+
+	return profiles.NewService(nil, config, repo, auditService), repo, auditRepo
+}
+
+func reserveAcme(t *testing.T, service *profiles.Service) *profiles.HandleReservation {
+	t.Helper()
+
+	domain := "https://Acme.com/"
+
+	reservation, err := service.ReserveHandle(t.Context(), profiles.ReserveHandleParams{ //nolint:exhaustruct
+		VerificationDomain: &domain,
+		AdminUserID:        "admin-user",
+		Slug:               " Acme ",
+	})
+	require.NoError(t, err)
+
+	return reservation
+}
+
+func TestHandleReservation_ReservedThenClaimed(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newHandleReservationService()
+
+	reservation := reserveAcme(t, service)
+	assert.Equal(t, "acme", reservation.Slug)
+	assert.Equal(t, "acme.com", *reservation.VerificationDomain)
+	assert.WithinDuration(t, time.Now().Add(24*time.Hour), reservation.ExpiresAt, time.Minute)
+
+	availability, err := service.CheckSlugAvailability(t.Context(), "acme", false)
+	require.NoError(t, err)
+	assert.False(t, availability.Available)
+	assert.Equal(t, profiles.SeverityReserved, availability.Severity)
+
+	otherEmail := "someone@example.com"
+	_, err = service.EnsureHandleClaimable(t.Context(), "acme", &otherEmail)
+	require.ErrorIs(t, err, profiles.ErrHandleReserved)
+
+	_, err = service.EnsureHandleClaimable(t.Context(), "acme", nil)
+	require.ErrorIs(t, err, profiles.ErrHandleReserved)
+
+	ownerEmail := "Founder@ACME.com"
+	claimable, err := service.EnsureHandleClaimable(t.Context(), "acme", &ownerEmail)
+	require.NoError(t, err)
+	require.NotNil(t, claimable)
+	assert.Equal(t, reservation.ID, claimable.ID)
+
+	err = service.ClaimHandleReservation(t.Context(), claimable, "founder-user", "acme-profile")
+	require.NoError(t, err)
+	assert.Equal(t, "acme-profile", *repo.reservations[0].ClaimedByProfileID)
+
+	// A claimed reservation can't be claimed again
+	err = service.ClaimHandleReservation(t.Context(), claimable, "founder-user", "other-profile")
+	require.ErrorIs(t, err, profiles.ErrFailedToClaimHandle)
+
+	// Once claimed the slug is only blocked by the new profile itself
+	repo.slugs["acme"] = true
+
+	availability, err = service.CheckSlugAvailability(t.Context(), "acme", false)
+	require.NoError(t, err)
+	assert.False(t, availability.Available)
+	assert.Equal(t, profiles.SeverityError, availability.Severity)
+
+	eventTypes := make([]events.EventType, 0, len(auditRepo.entries))
+	for _, entry := range auditRepo.entries {
+		eventTypes = append(eventTypes, entry.EventType)
+	}
+
+	assert.Equal(
+		t,
+		[]events.EventType{events.ProfileHandleReserved, events.ProfileHandleClaimed},
+		eventTypes,
+	)
+}
+
+func TestHandleReservation_ReservedThenExpired(t *testing.T) {
+	t.Parallel()
+
+	service, repo, _ := newHandleReservationService()
+
+	reservation := reserveAcme(t, service)
+
+	_, err := service.ReserveHandle(t.Context(), profiles.ReserveHandleParams{ //nolint:exhaustruct
+		VerificationDomain: reservation.VerificationDomain,
+		AdminUserID:        "admin-user",
+		Slug:               "acme",
+	})
+	require.ErrorIs(t, err, profiles.ErrHandleAlreadyReserved)
+
+	repo.reservations[0].ExpiresAt = time.Now().Add(-time.Minute)
+
+	availability, err := service.CheckSlugAvailability(t.Context(), "acme", false)
+	require.NoError(t, err)
+	assert.True(t, availability.Available)
+
+	anyEmail := "someone@example.com"
+	claimable, err := service.EnsureHandleClaimable(t.Context(), "acme", &anyEmail)
+	require.NoError(t, err)
+	assert.Nil(t, claimable)
+
+	err = service.ReleaseHandle(t.Context(), "admin-user", "acme")
+	require.ErrorIs(t, err, profiles.ErrHandleReservationNotFound)
+
+	// The slug can be reserved again after expiry
+	renewed := reserveAcme(t, service)
+	assert.NotEqual(t, reservation.ID, renewed.ID)
+	assert.Len(t, repo.reservations, 2)
+}
+
+func TestHandleReservation_Released(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newHandleReservationService()
+
+	reserveAcme(t, service)
+
+	err := service.ReleaseHandle(t.Context(), "admin-user", "ACME")
+	require.NoError(t, err)
+	require.NotNil(t, repo.reservations[0].ReleasedAt)
+
+	availability, err := service.CheckSlugAvailability(t.Context(), "acme", false)
+	require.NoError(t, err)
+	assert.True(t, availability.Available)
+
+	require.Len(t, auditRepo.entries, 2)
+	assert.Equal(t, events.ProfileHandleReleased, auditRepo.entries[1].EventType)
+}
+
+func TestReserveHandle_Rejected(t *testing.T) {
+	t.Parallel()
+
+	ptr := func(value string) *string { return &value }
+
+	tests := map[string]struct {
+		params   profiles.ReserveHandleParams
+		expected error
+	}{
+		"taken slug": {
+			params:   profiles.ReserveHandleParams{Slug: "taken", VerificationEmail: ptr("a@taken.com")}, //nolint:exhaustruct,lll
+			expected: profiles.ErrHandleTaken,
+		},
+		"forbidden slug": {
+			params:   profiles.ReserveHandleParams{Slug: "admin", VerificationEmail: ptr("a@acme.com")}, //nolint:exhaustruct,lll
+			expected: profiles.ErrInvalidHandleReservation,
+		},
+		"malformed slug": {
+			params:   profiles.ReserveHandleParams{Slug: "acme inc", VerificationEmail: ptr("a@acme.com")}, //nolint:exhaustruct,lll
+			expected: profiles.ErrInvalidHandleReservation,
+		},
+		"no verification": {
+			params:   profiles.ReserveHandleParams{Slug: "acme", VerificationEmail: ptr(" ")}, //nolint:exhaustruct
+			expected: profiles.ErrInvalidHandleReservation,
+		},
+		"invalid email": {
+			params:   profiles.ReserveHandleParams{Slug: "acme", VerificationEmail: ptr("acme.com")}, //nolint:exhaustruct
+			expected: profiles.ErrInvalidHandleReservation,
+		},
+		"invalid domain": {
+			params:   profiles.ReserveHandleParams{Slug: "acme", VerificationDomain: ptr("acme")}, //nolint:exhaustruct
+			expected: profiles.ErrInvalidHandleReservation,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newHandleReservationService()
+
+			_, err := service.ReserveHandle(t.Context(), tt.params)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, repo.reservations)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package profiles
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+const (
+	// PropertyHiring is the profile properties key holding the hiring section.
+	PropertyHiring = "hiring"
+
+	// FilterHiring is the list cursor filter that narrows profiles by their hiring flag.
+	FilterHiring = "hiring"
+
+	MaxHiringJobs              = 20
+	MaxHiringJobTitleLength    = 200
+	MaxHiringJobLocationLength = 200
+)
+
+// Hiring is the optional "we're hiring" section kept in profile properties.
+type Hiring struct {
+	Jobs     []HiringJob `json:"jobs"`
+	IsHiring bool        `json:"is_hiring"`
+}
+
+// HiringJob is a single open role linked from a hiring section.
+type HiringJob struct {
+	Location *string `json:"location,omitempty"`
+	Title    string  `json:"title"`
+	URL      string  `json:"url"`
+}
+
+// normalizeHiringProperty validates the hiring section of the given properties, if
+// there is one, and replaces it with its normalized form. A nil section removes it.
+func normalizeHiringProperty(properties map[string]any) error {
+	raw, ok := properties[PropertyHiring]
+	if !ok {
+		return nil
+	}
+
+	if raw == nil {
+		delete(properties, PropertyHiring)
+
+		return nil
+	}
+
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("%w: hiring section is not valid JSON", ErrInvalidInput)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.DisallowUnknownFields()
+
+	var hiring Hiring
+
+	err = decoder.Decode(&hiring)
+	if err != nil {
+		return fmt.Errorf("%w: hiring section is malformed: %w", ErrInvalidInput, err)
+	}
+
+	err = validateHiring(&hiring)
+	if err != nil {
+		return err
+	}
+
+	properties[PropertyHiring] = hiring
+
+	return nil
+}
+
+// validateHiring trims the job entries in place and checks their limits and URLs.
+func validateHiring(hiring *Hiring) error {
+	if len(hiring.Jobs) > MaxHiringJobs {
+		return fmt.Errorf("%w: at most %d jobs can be listed", ErrInvalidInput, MaxHiringJobs)
+	}
+
+	if hiring.Jobs == nil {
+		hiring.Jobs = []HiringJob{}
+	}
+
+	for i := range hiring.Jobs {
+		job := &hiring.Jobs[i]
+
+		job.Title = strings.TrimSpace(job.Title)
+		if job.Title == "" || utf8.RuneCountInString(job.Title) > MaxHiringJobTitleLength {
+			return fmt.Errorf("%w: job %d title must be 1-%d characters",
+				ErrInvalidInput, i+1, MaxHiringJobTitleLength)
+		}
+
+		job.URL = strings.TrimSpace(job.URL)
+
+		urlErr := validateOptionalURL(&job.URL)
+		if urlErr != nil {
+			return fmt.Errorf("%w: job %d url: %w", ErrInvalidInput, i+1, urlErr)
+		}
+
+		if job.Location != nil {
+			location := strings.TrimSpace(*job.Location)
+
+			switch {
+			case location == "":
+				job.Location = nil
+			case utf8.RuneCountInString(location) > MaxHiringJobLocationLength:
+				return fmt.Errorf("%w: job %d location must be at most %d characters",
+					ErrInvalidInput, i+1, MaxHiringJobLocationLength)
+			default:
+				job.Location = &location
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateHiringFilter normalizes the hiring list filter to "true" or "false".
+func validateHiringFilter(cursor *cursors.Cursor) error {
+	if cursor == nil {
+		return nil
+	}
+
+	value, ok := cursor.Filters[FilterHiring]
+	if !ok {
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("%w: hiring filter must be a boolean", ErrInvalidInput)
+	}
+
+	cursor.Filters[FilterHiring] = strconv.FormatBool(parsed)
+
+	return nil
+}
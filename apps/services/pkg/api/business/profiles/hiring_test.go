@@ -0,0 +1,215 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// hiringRepository serves the calls made while updating and listing profiles.
+type hiringRepository struct {
+	profiles.Repository
+
+	updatedProperties map[string]any
+	listFilters       map[string]string
+	updated           bool
+}
+
+func (r *hiringRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *hiringRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *hiringRepository) UpdateProfile(
+	_ context.Context,
+	_ string,
+	_ *string,
+	_ *string,
+	properties map[string]any,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *string,
+	_ *bool,
+) error {
+	r.updated = true
+	r.updatedProperties = properties
+
+	return nil
+}
+
+func (r *hiringRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ID: id}, nil //nolint:exhaustruct
+}
+
+func (r *hiringRepository) ListProfiles(
+	_ context.Context,
+	_ string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*profiles.Profile], error) {
+	r.listFilters = cursor.Filters
+
+	return cursors.Cursored[[]*profiles.Profile]{}, nil //nolint:exhaustruct
+}
+
+func newHiringService() (*profiles.Service, *hiringRepository) {
+	repo := &hiringRepository{} //nolint:exhaustruct
+	auditService, _ := newRecordingAuditService()
+
+	return profiles.NewService(nil, nil, repo, auditService), repo
+}
+
+func updateHiring(
+	t *testing.T,
+	service *profiles.Service,
+	properties map[string]any,
+) error {
+	t.Helper()
+
+	_, err := service.Update(
+		t.Context(), "en", "user-1", profiles.UserKindAdmin, "target",
+		nil, nil, properties, nil, nil, nil, nil, nil, nil, nil,
+	)
+
+	return err
+}
+
+func TestUpdate_Hiring(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newHiringService()
+
+	err := updateHiring(t, service, map[string]any{
+		"theme": "dark",
+		"hiring": map[string]any{
+			"is_hiring": true,
+			"jobs": []any{
+				map[string]any{
+					"title":    "  Backend Engineer ",
+					"url":      "https://jobs.example.com/backend",
+					"location": "Remote",
+				},
+				map[string]any{
+					"title":    "Designer",
+					"url":      "https://jobs.example.com/design",
+					"location": " ",
+				},
+			},
+		},
+	})
+
+	require.NoError(t, err)
+	require.True(t, repo.updated)
+	assert.Equal(t, "dark", repo.updatedProperties["theme"])
+
+	hiring, ok := repo.updatedProperties[profiles.PropertyHiring].(profiles.Hiring)
+	require.True(t, ok)
+	assert.True(t, hiring.IsHiring)
+	require.Len(t, hiring.Jobs, 2)
+	assert.Equal(t, "Backend Engineer", hiring.Jobs[0].Title)
+	require.NotNil(t, hiring.Jobs[0].Location)
+	assert.Equal(t, "Remote", *hiring.Jobs[0].Location)
+	assert.Nil(t, hiring.Jobs[1].Location)
+}
+
+func TestUpdate_HiringValidation(t *testing.T) {
+	t.Parallel()
+
+	tooManyJobs := make([]any, profiles.MaxHiringJobs+1)
+	for i := range tooManyJobs {
+		tooManyJobs[i] = map[string]any{"title": "Engineer", "url": "https://example.com/job"}
+	}
+
+	tests := map[string]any{
+		"not an object": "yes",
+		"unknown field": map[string]any{"is_hiring": true, "salary": 100},
+		"missing title": map[string]any{
+			"is_hiring": true,
+			"jobs":      []any{map[string]any{"title": " ", "url": "https://example.com/job"}},
+		},
+		"relative url": map[string]any{
+			"is_hiring": true,
+			"jobs":      []any{map[string]any{"title": "Engineer", "url": "/careers"}},
+		},
+		"non-http url": map[string]any{
+			"is_hiring": true,
+			"jobs":      []any{map[string]any{"title": "Engineer", "url": "javascript:alert(1)"}},
+		},
+		"empty url": map[string]any{
+			"is_hiring": true,
+			"jobs":      []any{map[string]any{"title": "Engineer", "url": ""}},
+		},
+		"too many jobs": map[string]any{"is_hiring": true, "jobs": tooManyJobs},
+	}
+
+	for name, hiring := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo := newHiringService()
+
+			err := updateHiring(t, service, map[string]any{profiles.PropertyHiring: hiring})
+
+			require.ErrorIs(t, err, profiles.ErrInvalidInput)
+			assert.False(t, repo.updated)
+		})
+	}
+}
+
+func TestList_HiringFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    string
+		expected string
+	}{
+		"true":      {value: "true", expected: "true"},
+		"numeric":   {value: "1", expected: "true"},
+		"false":     {value: "false", expected: "false"},
+		"uppercase": {value: "FALSE", expected: "false"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo := newHiringService()
+			cursor := cursors.NewCursor(10, nil)
+			cursor.Filters[profiles.FilterHiring] = tt.value
+
+			_, err := service.List(t.Context(), "en", cursor)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repo.listFilters[profiles.FilterHiring])
+		})
+	}
+}
+
+func TestList_HiringFilterInvalid(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newHiringService()
+	cursor := cursors.NewCursor(10, nil)
+	cursor.Filters[profiles.FilterHiring] = "maybe"
+
+	_, err := service.List(t.Context(), "en", cursor)
+
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+	assert.Nil(t, repo.listFilters)
+}
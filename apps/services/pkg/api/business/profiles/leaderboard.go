@@ -0,0 +1,97 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+// maxLeaderboardPageSize caps the page size so the cached top page stays small.
+const maxLeaderboardPageSize = 100
+
+var (
+	ErrInvalidLeaderboardCursor = errors.New("invalid leaderboard cursor")
+	ErrInvalidLeaderboardKind   = errors.New("invalid leaderboard kind")
+)
+
+// LeaderboardCursor is the keyset position in the points leaderboard: the
+// points and ID of the last profile on the previous page.
+type LeaderboardCursor struct {
+	Points    uint64
+	ProfileID string
+}
+
+// String encodes the cursor as "<points>_<profile id>".
+func (c *LeaderboardCursor) String() string {
+	return strconv.FormatUint(c.Points, 10) + "_" + c.ProfileID
+}
+
+// ParseLeaderboardCursor decodes a cursor produced by LeaderboardCursor.String.
+// An empty value means the first page and returns nil.
+func ParseLeaderboardCursor(value string) (*LeaderboardCursor, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	points, profileID, found := strings.Cut(value, "_")
+	if !found || profileID == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLeaderboardCursor, value)
+	}
+
+	parsedPoints, err := strconv.ParseUint(points, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLeaderboardCursor, value)
+	}
+
+	return &LeaderboardCursor{
+		Points:    parsedPoints,
+		ProfileID: profileID,
+	}, nil
+}
+
+// GetPointsLeaderboard returns approved profiles ordered by points, highest
+// first, optionally limited to one profile kind. Ties are broken by profile ID
+// so pages never overlap. The returned cursor is nil on the last page.
+func (s *Service) GetPointsLeaderboard(
+	ctx context.Context,
+	localeCode string,
+	kind string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*Profile], error) {
+	switch kind {
+	case "", ProfileKindIndividual, "organization", "product":
+	default:
+		return cursors.Cursored[[]*Profile]{}, fmt.Errorf("%w: %q", ErrInvalidLeaderboardKind, kind)
+	}
+
+	var offset string
+	if cursor.Offset != nil {
+		offset = *cursor.Offset
+	}
+
+	after, err := ParseLeaderboardCursor(offset)
+	if err != nil {
+		return cursors.Cursored[[]*Profile]{}, err
+	}
+
+	limit := min(cursor.Limit, maxLeaderboardPageSize)
+
+	records, err := s.repo.ListProfilesByPoints(ctx, localeCode, kind, after, limit)
+	if err != nil {
+		return cursors.Cursored[[]*Profile]{}, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	var nextCursor *string
+
+	if len(records) == limit && len(records) > 0 {
+		last := records[len(records)-1]
+		encoded := (&LeaderboardCursor{Points: last.Points, ProfileID: last.ID}).String()
+		nextCursor = &encoded
+	}
+
+	return cursors.WrapResponseWithCursor(records, nextCursor), nil
+}
@@ -0,0 +1,145 @@
+package profiles_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// leaderboardRepository applies the leaderboard query's ordering and keyset
+// condition to a fixed set of profiles.
+type leaderboardRepository struct {
+	profiles.Repository
+
+	profiles []*profiles.Profile
+}
+
+func (r *leaderboardRepository) ListProfilesByPoints(
+	_ context.Context,
+	_ string,
+	kind string,
+	after *profiles.LeaderboardCursor,
+	limit int,
+) ([]*profiles.Profile, error) {
+	sorted := make([]*profiles.Profile, 0, len(r.profiles))
+
+	for _, profile := range r.profiles {
+		if kind != "" && profile.Kind != kind {
+			continue
+		}
+
+		if after != nil &&
+			profile.Points >= after.Points &&
+			(profile.Points != after.Points || profile.ID <= after.ProfileID) {
+			continue
+		}
+
+		sorted = append(sorted, profile)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Points != sorted[j].Points {
+			return sorted[i].Points > sorted[j].Points
+		}
+
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	return sorted[:min(limit, len(sorted))], nil
+}
+
+func newLeaderboardRepository() *leaderboardRepository {
+	scores := []struct {
+		id     string
+		kind   string
+		points uint64
+	}{
+		{id: "c", kind: "individual", points: 50},
+		{id: "a", kind: "individual", points: 50},
+		{id: "e", kind: "organization", points: 80},
+		{id: "b", kind: "individual", points: 50},
+		{id: "d", kind: "individual", points: 10},
+	}
+
+	repo := &leaderboardRepository{} //nolint:exhaustruct
+	for _, score := range scores {
+		repo.profiles = append(repo.profiles, &profiles.Profile{ //nolint:exhaustruct
+			ID:     score.id,
+			Kind:   score.kind,
+			Points: score.points,
+		})
+	}
+
+	return repo
+}
+
+func profileIDsOf(records []*profiles.Profile) []string {
+	ids := make([]string, len(records))
+	for i, record := range records {
+		ids[i] = record.ID
+	}
+
+	return ids
+}
+
+func TestGetPointsLeaderboard_StableOrderAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, nil, newLeaderboardRepository(), nil)
+
+	var (
+		seen   []string
+		offset *string
+	)
+
+	// Two per page splits the three-way tie at 50 points across pages.
+	for range 4 {
+		page, err := service.GetPointsLeaderboard(t.Context(), "en", "", cursors.NewCursor(2, offset))
+		require.NoError(t, err)
+
+		seen = append(seen, profileIDsOf(page.Data)...)
+
+		offset = page.CursorPtr
+		if offset == nil {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"e", "a", "b", "c", "d"}, seen)
+}
+
+func TestGetPointsLeaderboard_FilterByKind(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, nil, newLeaderboardRepository(), nil)
+
+	page, err := service.GetPointsLeaderboard(
+		t.Context(), "en", "individual", cursors.NewCursor(10, nil),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, profileIDsOf(page.Data))
+	assert.Nil(t, page.CursorPtr)
+
+	_, err = service.GetPointsLeaderboard(t.Context(), "en", "robot", cursors.NewCursor(10, nil))
+	require.ErrorIs(t, err, profiles.ErrInvalidLeaderboardKind)
+}
+
+func TestParseLeaderboardCursor(t *testing.T) {
+	t.Parallel()
+
+	original := &profiles.LeaderboardCursor{Points: 50, ProfileID: "01JQ0000000000000000000000"}
+
+	parsed, err := profiles.ParseLeaderboardCursor(original.String())
+	require.NoError(t, err)
+	assert.Equal(t, original, parsed)
+
+	for _, value := range []string{"garbage", "50_", "-1_01JQ0000000000000000000000"} {
+		_, err := profiles.ParseLeaderboardCursor(value)
+		require.ErrorIs(t, err, profiles.ErrInvalidLeaderboardCursor, value)
+	}
+}
@@ -0,0 +1,180 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// LinkClickStatsDays is how many days of clicks GetLinkClickStats reports.
+const LinkClickStatsDays = 30
+
+var ErrLinkNotFound = errors.New("link not found")
+
+// botUserAgentMarkers are user agent fragments of crawlers, link previewers
+// and HTTP libraries. Clicks from them are not counted.
+var botUserAgentMarkers = []string{ //nolint:gochecknoglobals
+	"bot",
+	"crawl",
+	"spider",
+	"slurp",
+	"facebookexternalhit",
+	"embedly",
+	"preview",
+	"headless",
+	"curl/",
+	"wget/",
+	"python-requests",
+	"go-http-client",
+}
+
+// LinkClickDay is the number of clicks on a link during one UTC day.
+type LinkClickDay struct {
+	Day    time.Time `json:"day"`
+	Clicks int64     `json:"clicks"`
+}
+
+// LinkClickStats is the click history of a link over the last
+// LinkClickStatsDays days.
+type LinkClickStats struct {
+	LinkID string          `json:"link_id"`
+	Days   []*LinkClickDay `json:"days"`
+	Total  int64           `json:"total"`
+}
+
+// IsBotUserAgent reports whether a user agent is an obvious bot. Requests
+// without a user agent are not from browsers either.
+func IsBotUserAgent(userAgent string) bool {
+	userAgent = strings.ToLower(strings.TrimSpace(userAgent))
+	if userAgent == "" {
+		return true
+	}
+
+	for _, marker := range botUserAgentMarkers {
+		if strings.Contains(userAgent, marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetLinkRedirectURI returns the URI a public link of the profile points to.
+// Links that are not public, belong to another profile, or don't point to a
+// web page are reported as not found.
+func (s *Service) GetLinkRedirectURI(
+	ctx context.Context,
+	localeCode string,
+	profileSlug string,
+	linkID string,
+) (string, error) {
+	link, err := s.getProfileLinkBySlug(ctx, localeCode, profileSlug, linkID)
+	if err != nil {
+		return "", err
+	}
+
+	if link.Visibility != LinkVisibilityPublic || link.URI == nil {
+		return "", fmt.Errorf("%w(id: %s)", ErrLinkNotFound, linkID)
+	}
+
+	target, err := url.Parse(*link.URI)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return "", fmt.Errorf("%w(id: %s)", ErrLinkNotFound, linkID)
+	}
+
+	return target.String(), nil
+}
+
+// RecordLinkClick counts a click on a link. visitorUserID is nil for
+// anonymous visitors; clicks from obvious bots are skipped.
+func (s *Service) RecordLinkClick(
+	ctx context.Context,
+	linkID string,
+	visitorUserID *string,
+	userAgent string,
+) error {
+	if IsBotUserAgent(userAgent) {
+		return nil
+	}
+
+	err := s.repo.RecordLinkClick(ctx, string(s.idGenerator()), linkID, visitorUserID)
+	if err != nil {
+		return fmt.Errorf("%w(linkID: %s): %w", ErrFailedToCreateRecord, linkID, err)
+	}
+
+	return nil
+}
+
+// GetLinkClickStats returns the clicks on a profile link per day over the last
+// LinkClickStatsDays days. Only maintainers of the profile can see them.
+func (s *Service) GetLinkClickStats(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	linkID string,
+) (*LinkClickStats, error) {
+	hasAccess, err := s.HasUserAccessToProfile(ctx, userID, profileSlug, MembershipKindMaintainer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasAccess {
+		return nil, ErrInsufficientAccess
+	}
+
+	// Any locale will do; only the link's ownership is checked
+	_, err = s.getProfileLinkBySlug(ctx, FallbackLocaleCode, profileSlug, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -(LinkClickStatsDays - 1))
+
+	days, err := s.repo.CountLinkClicksByDay(ctx, linkID, since)
+	if err != nil {
+		return nil, fmt.Errorf("%w(linkID: %s): %w", ErrFailedToListRecords, linkID, err)
+	}
+
+	stats := &LinkClickStats{
+		LinkID: linkID,
+		Days:   days,
+		Total:  0,
+	}
+
+	for _, day := range days {
+		stats.Total += day.Clicks
+	}
+
+	return stats, nil
+}
+
+// getProfileLinkBySlug returns a link of the profile with the given slug.
+func (s *Service) getProfileLinkBySlug(
+	ctx context.Context,
+	localeCode string,
+	profileSlug string,
+	linkID string,
+) (*ProfileLink, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	link, err := s.repo.GetProfileLink(ctx, localeCode, linkID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(linkID: %s): %w", ErrFailedToGetRecord, linkID, err)
+	}
+
+	if link == nil || link.ProfileID != profileID || link.DeletedAt != nil {
+		return nil, fmt.Errorf("%w(id: %s)", ErrLinkNotFound, linkID)
+	}
+
+	return link, nil
+}
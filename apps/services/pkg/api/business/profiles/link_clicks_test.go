@@ -0,0 +1,181 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const browserUserAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_0) AppleWebKit/605.1.15"
+
+type linkClickRepository struct {
+	profiles.Repository
+
+	links       map[string]*profiles.ProfileLink
+	clicks      []*string // visitor user IDs
+	memberships map[string]profiles.MembershipKind
+}
+
+func newLinkClickRepository() *linkClickRepository {
+	linkURI := func(uri string) *string { return &uri }
+
+	return &linkClickRepository{
+		links: map[string]*profiles.ProfileLink{
+			"link-public": { //nolint:exhaustruct
+				ID:         "link-public",
+				ProfileID:  "acme-profile",
+				URI:        linkURI("https://github.com/acme"),
+				Visibility: profiles.LinkVisibilityPublic,
+			},
+			"link-hidden": { //nolint:exhaustruct
+				ID:         "link-hidden",
+				ProfileID:  "acme-profile",
+				URI:        linkURI("https://example.com/private"),
+				Visibility: profiles.LinkVisibilityMembers,
+			},
+			"link-script": { //nolint:exhaustruct
+				ID:         "link-script",
+				ProfileID:  "acme-profile",
+				URI:        linkURI("javascript:alert(1)"),
+				Visibility: profiles.LinkVisibilityPublic,
+			},
+			"link-other": { //nolint:exhaustruct
+				ID:         "link-other",
+				ProfileID:  "other-profile",
+				URI:        linkURI("https://example.com/other"),
+				Visibility: profiles.LinkVisibilityPublic,
+			},
+		},
+		memberships: map[string]profiles.MembershipKind{
+			"profile-maintainer": profiles.MembershipKindMaintainer,
+			"profile-follower":   profiles.MembershipKindFollower,
+		},
+	}
+}
+
+func (r *linkClickRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "acme" {
+		return "", nil
+	}
+
+	return "acme-profile", nil
+}
+
+func (r *linkClickRepository) GetProfileLink(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.ProfileLink, error) {
+	return r.links[id], nil
+}
+
+func (r *linkClickRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *linkClickRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	targetProfileID string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	if targetProfileID != "acme-profile" {
+		return "", nil
+	}
+
+	return r.memberships[originProfileID], nil
+}
+
+func (r *linkClickRepository) RecordLinkClick(
+	_ context.Context,
+	_ string,
+	_ string,
+	visitorUserID *string,
+) error {
+	r.clicks = append(r.clicks, visitorUserID)
+
+	return nil
+}
+
+func (r *linkClickRepository) CountLinkClicksByDay(
+	_ context.Context,
+	_ string,
+	since time.Time,
+) ([]*profiles.LinkClickDay, error) {
+	return []*profiles.LinkClickDay{
+		{Day: since, Clicks: 2},
+		{Day: since.AddDate(0, 0, 1), Clicks: int64(len(r.clicks))},
+	}, nil
+}
+
+func TestGetLinkRedirectURI(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, nil, newLinkClickRepository(), nil)
+
+	uri, err := service.GetLinkRedirectURI(t.Context(), "en", "acme", "link-public")
+	require.NoError(t, err)
+	assert.Equal(t, "https://github.com/acme", uri)
+
+	for _, linkID := range []string{"link-hidden", "link-script", "link-other", "missing"} {
+		_, err := service.GetLinkRedirectURI(t.Context(), "en", "acme", linkID)
+		require.ErrorIs(t, err, profiles.ErrLinkNotFound, linkID)
+	}
+
+	_, err = service.GetLinkRedirectURI(t.Context(), "en", "nobody", "link-public")
+	require.ErrorIs(t, err, profiles.ErrProfileNotFound)
+}
+
+func TestRecordLinkClick_SkipsBots(t *testing.T) {
+	t.Parallel()
+
+	repo := newLinkClickRepository()
+	service := profiles.NewService(nil, nil, repo, nil)
+	visitorUserID := "visitor"
+
+	require.NoError(t, service.RecordLinkClick(t.Context(), "link-public", nil, browserUserAgent))
+	require.NoError(t, service.RecordLinkClick(
+		t.Context(), "link-public", &visitorUserID, browserUserAgent,
+	))
+	require.NoError(t, service.RecordLinkClick(
+		t.Context(), "link-public", nil, "Googlebot/2.1 (+http://www.google.com/bot.html)",
+	))
+	require.NoError(t, service.RecordLinkClick(t.Context(), "link-public", nil, "curl/8.4.0"))
+	require.NoError(t, service.RecordLinkClick(t.Context(), "link-public", nil, ""))
+
+	assert.Equal(t, []*string{nil, &visitorUserID}, repo.clicks)
+}
+
+func TestGetLinkClickStats_RequiresMaintainer(t *testing.T) {
+	t.Parallel()
+
+	repo := newLinkClickRepository()
+	repo.clicks = []*string{nil, nil, nil}
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	_, err := service.GetLinkClickStats(t.Context(), "follower", "acme", "link-public")
+	require.ErrorIs(t, err, profiles.ErrInsufficientAccess)
+
+	_, err = service.GetLinkClickStats(t.Context(), "stranger", "acme", "link-public")
+	require.ErrorIs(t, err, profiles.ErrInsufficientAccess)
+
+	stats, err := service.GetLinkClickStats(t.Context(), "maintainer", "acme", "link-public")
+	require.NoError(t, err)
+	assert.Equal(t, "link-public", stats.LinkID)
+	assert.Len(t, stats.Days, 2)
+	assert.Equal(t, int64(5), stats.Total)
+
+	_, err = service.GetLinkClickStats(t.Context(), "maintainer", "acme", "link-other")
+	require.ErrorIs(t, err, profiles.ErrLinkNotFound)
+}
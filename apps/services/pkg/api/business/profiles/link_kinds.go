@@ -0,0 +1,75 @@
+package profiles
+
+// LinkKindDef describes a kind of profile link: how it is shown and which URLs it accepts.
+type LinkKindDef struct {
+	Kind        string `json:"kind"`
+	DisplayName string `json:"display_name"`
+	Icon        string `json:"icon"`
+	URLPattern  string `json:"url_pattern"`
+	ExampleURL  string `json:"example_url"`
+}
+
+// linkKindNameTranslations maps locale → link kind → display name for the kinds
+// whose names are not brand names.
+//
+//nolint:gochecknoglobals
+var linkKindNameTranslations = map[string]map[string]string{
+	"ar":    {"website": "موقع إلكتروني", "external-site": "موقع خارجي"},
+	"de":    {"website": "Webseite", "external-site": "Externe Website"},
+	"es":    {"website": "Sitio web", "external-site": "Sitio externo"},
+	"fr":    {"website": "Site web", "external-site": "Site externe"},
+	"it":    {"website": "Sito web", "external-site": "Sito esterno"},
+	"ja":    {"website": "ウェブサイト", "external-site": "外部サイト"},
+	"ko":    {"website": "웹사이트", "external-site": "외부 사이트"},
+	"nl":    {"website": "Website", "external-site": "Externe site"},
+	"pt-PT": {"website": "Website", "external-site": "Site externo"},
+	"ru":    {"website": "Веб-сайт", "external-site": "Внешний сайт"},
+	"tr":    {"website": "Web sitesi", "external-site": "Harici site"},
+	"zh-CN": {"website": "网站", "external-site": "外部站点"},
+}
+
+// linkKinds is the registry of link kinds a profile can have, in display order.
+// DisplayName holds the English name.
+//
+//nolint:gochecknoglobals,lll
+var linkKinds = []LinkKindDef{
+	{Kind: "github", DisplayName: "GitHub", Icon: "github", URLPattern: `^https://(www\.)?github\.com/.+`, ExampleURL: "https://github.com/username"},
+	{Kind: "x", DisplayName: "X (Twitter)", Icon: "x", URLPattern: `^https://(www\.)?(x|twitter)\.com/.+`, ExampleURL: "https://x.com/username"},
+	{Kind: "linkedin", DisplayName: "LinkedIn", Icon: "linkedin", URLPattern: `^https://([a-z]{2,3}\.)?linkedin\.com/.+`, ExampleURL: "https://linkedin.com/in/username"},
+	{Kind: "instagram", DisplayName: "Instagram", Icon: "instagram", URLPattern: `^https://(www\.)?instagram\.com/.+`, ExampleURL: "https://instagram.com/username"},
+	{Kind: "youtube", DisplayName: "YouTube", Icon: "youtube", URLPattern: `^https://(www\.|m\.)?(youtube\.com|youtu\.be)/.+`, ExampleURL: "https://youtube.com/@channel"},
+	{Kind: "speakerdeck", DisplayName: "SpeakerDeck", Icon: "speakerdeck", URLPattern: `^https://(www\.)?speakerdeck\.com/.+`, ExampleURL: "https://speakerdeck.com/username"},
+	{Kind: "bsky", DisplayName: "Bluesky", Icon: "bsky", URLPattern: `^https://bsky\.app/profile/.+`, ExampleURL: "https://bsky.app/profile/handle"},
+	{Kind: "discord", DisplayName: "Discord", Icon: "discord", URLPattern: `^https://(www\.)?(discord\.gg|discord\.com)/.+`, ExampleURL: "https://discord.gg/invite"},
+	{Kind: "telegram", DisplayName: "Telegram", Icon: "telegram", URLPattern: `^https://(t|telegram)\.me/.+`, ExampleURL: "https://t.me/username"},
+	{Kind: "external-site", DisplayName: "External Site", Icon: "globe", URLPattern: `^https?://.+`, ExampleURL: "https://example.com"},
+	{Kind: "website", DisplayName: "Website", Icon: "globe", URLPattern: `^https?://.+`, ExampleURL: "https://example.com"},
+}
+
+// IsValidLinkKind reports whether kind is in the link kind registry.
+func IsValidLinkKind(kind string) bool {
+	for _, def := range linkKinds {
+		if def.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListLinkKinds returns the registered link kinds with display names in the given
+// locale, falling back to English.
+func ListLinkKinds(locale string) []LinkKindDef {
+	translations := linkKindNameTranslations[locale]
+	result := make([]LinkKindDef, 0, len(linkKinds))
+
+	for _, def := range linkKinds {
+		if name, ok := translations[def.Kind]; ok {
+			def.DisplayName = name
+		}
+
+		result = append(result, def)
+	}
+
+	return result
+}
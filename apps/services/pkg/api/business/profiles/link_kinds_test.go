@@ -0,0 +1,46 @@
+package profiles_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListLinkKinds(t *testing.T) {
+	t.Parallel()
+
+	kinds := profiles.ListLinkKinds("en")
+	require.NotEmpty(t, kinds)
+
+	for _, def := range kinds {
+		assert.True(t, profiles.IsValidLinkKind(def.Kind), def.Kind)
+		assert.NotEmpty(t, def.DisplayName, def.Kind)
+		assert.NotEmpty(t, def.Icon, def.Kind)
+
+		pattern, err := regexp.Compile(def.URLPattern)
+		require.NoError(t, err, def.Kind)
+		assert.True(t, pattern.MatchString(def.ExampleURL), def.Kind)
+	}
+
+	assert.False(t, profiles.IsValidLinkKind("myspace"))
+}
+
+func TestListLinkKinds_Localized(t *testing.T) {
+	t.Parallel()
+
+	names := func(locale string) map[string]string {
+		result := make(map[string]string)
+		for _, def := range profiles.ListLinkKinds(locale) {
+			result[def.Kind] = def.DisplayName
+		}
+
+		return result
+	}
+
+	assert.Equal(t, "Web sitesi", names("tr")["website"])
+	assert.Equal(t, "GitHub", names("tr")["github"])
+	assert.Equal(t, names("en"), names("unknown"))
+}
@@ -0,0 +1,109 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type linkOrderUpdate struct {
+	id    string
+	order int
+}
+
+// linkOrderRepository serves the calls made while reordering links.
+type linkOrderRepository struct {
+	profiles.Repository
+
+	linkIDs []string
+	updates []linkOrderUpdate
+}
+
+func (r *linkOrderRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *linkOrderRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *linkOrderRepository) ListProfileLinkIDsByProfileID(
+	_ context.Context,
+	_ string,
+) ([]string, error) {
+	return r.linkIDs, nil
+}
+
+func (r *linkOrderRepository) UpdateProfileLinkOrder(
+	_ context.Context,
+	id string,
+	order int,
+) error {
+	r.updates = append(r.updates, linkOrderUpdate{id: id, order: order})
+
+	return nil
+}
+
+func TestReorderProfileLinks(t *testing.T) {
+	t.Parallel()
+
+	repo := &linkOrderRepository{linkIDs: []string{"a", "b", "c"}} //nolint:exhaustruct
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	err := service.ReorderProfileLinks(
+		t.Context(), "user-1", profiles.UserKindAdmin, "target", []string{"c", "a", "b"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, []linkOrderUpdate{
+		{id: "c", order: 1},
+		{id: "a", order: 2},
+		{id: "b", order: 3},
+	}, repo.updates)
+}
+
+func TestReorderProfileLinks_ForeignLink(t *testing.T) {
+	t.Parallel()
+
+	repo := &linkOrderRepository{linkIDs: []string{"a", "b", "c"}} //nolint:exhaustruct
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	err := service.ReorderProfileLinks(
+		t.Context(), "user-1", profiles.UserKindAdmin, "target", []string{"a", "b", "other-profile"},
+	)
+
+	require.ErrorIs(t, err, profiles.ErrUnauthorized)
+	assert.Empty(t, repo.updates)
+}
+
+func TestReorderProfileLinks_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string][]string{
+		"missing link":   {"a", "b"},
+		"duplicate link": {"a", "b", "b"},
+		"empty order":    nil,
+	}
+
+	for name, orderedIDs := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &linkOrderRepository{linkIDs: []string{"a", "b", "c"}} //nolint:exhaustruct
+			service := profiles.NewService(nil, nil, repo, nil)
+
+			err := service.ReorderProfileLinks(
+				t.Context(), "user-1", profiles.UserKindAdmin, "target", orderedIDs,
+			)
+
+			require.ErrorIs(t, err, profiles.ErrInvalidInput)
+			assert.Empty(t, repo.updates)
+		})
+	}
+}
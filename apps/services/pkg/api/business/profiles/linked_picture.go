@@ -0,0 +1,62 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// LinkedAccount is the remote account behind a managed profile link.
+type LinkedAccount struct {
+	Kind     string
+	RemoteID string
+}
+
+// linkedPictureURIs build the avatar URI of a linked account from its remote
+// ID, per link kind. The URIs are stable, so the picture follows the remote
+// avatar without being stored.
+var linkedPictureURIs = map[string]func(remoteID string) string{ //nolint:gochecknoglobals
+	"github": func(remoteID string) string {
+		return "https://avatars.githubusercontent.com/u/" + url.PathEscape(remoteID) + "?v=4"
+	},
+}
+
+// applyLinkedPicture fills in the profile picture from a managed link's account
+// when the profile has none set. The picture is not persisted.
+func (s *Service) applyLinkedPicture(ctx context.Context, record *Profile) error {
+	if record.ProfilePictureURI != nil && *record.ProfilePictureURI != "" {
+		return nil
+	}
+
+	if s.config == nil {
+		return nil
+	}
+
+	kinds := s.config.GetLinkedPictureKinds()
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	accounts, err := s.repo.ListManagedLinkAccounts(ctx, record.ID, kinds)
+	if err != nil {
+		return fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, record.ID, err)
+	}
+
+	for _, kind := range kinds {
+		pictureURI, ok := linkedPictureURIs[kind]
+		if !ok {
+			continue
+		}
+
+		for _, account := range accounts {
+			if account.Kind == kind && account.RemoteID != "" {
+				uri := pictureURI(account.RemoteID)
+				record.ProfilePictureURI = &uri
+
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package profiles_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// linkedPictureRepository holds one profile and the accounts of its managed links.
+type linkedPictureRepository struct {
+	profiles.Repository
+
+	pictureURI *string
+	accounts   []*profiles.LinkedAccount
+}
+
+func (r *linkedPictureRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "profile-1", nil
+}
+
+func (r *linkedPictureRepository) GetProfileByID(
+	_ context.Context,
+	localeCode string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ //nolint:exhaustruct
+		ID:                id,
+		Slug:              "eser",
+		Title:             "Eser",
+		LocaleCode:        localeCode,
+		ProfilePictureURI: r.pictureURI,
+	}, nil
+}
+
+func (r *linkedPictureRepository) ListManagedLinkAccounts(
+	_ context.Context,
+	_ string,
+	kinds []string,
+) ([]*profiles.LinkedAccount, error) {
+	var result []*profiles.LinkedAccount
+
+	for _, account := range r.accounts {
+		if slices.Contains(kinds, account.Kind) {
+			result = append(result, account)
+		}
+	}
+
+	return result, nil
+}
+
+func TestService_GetAvatarLinkedPicturePrecedence(t *testing.T) {
+	t.Parallel()
+
+	explicitURI := "https://objects.aya.is/eser.png"
+	githubURI := "https://avatars.githubusercontent.com/u/866715?v=4"
+	emptyURI := ""
+	github := &profiles.LinkedAccount{Kind: "github", RemoteID: "866715"}
+	youtube := &profiles.LinkedAccount{Kind: "youtube", RemoteID: "UC123"}
+
+	tests := map[string]struct {
+		pictureURI         *string
+		accounts           []*profiles.LinkedAccount
+		linkedPictureKinds string
+		expectedURI        *string
+	}{
+		"explicit picture wins over a linked account": {
+			pictureURI:         &explicitURI,
+			accounts:           []*profiles.LinkedAccount{github},
+			linkedPictureKinds: "github",
+			expectedURI:        &explicitURI,
+		},
+		"linked github account fills in a missing picture": {
+			pictureURI:         nil,
+			accounts:           []*profiles.LinkedAccount{youtube, github},
+			linkedPictureKinds: "youtube,github",
+			expectedURI:        &githubURI,
+		},
+		"empty picture counts as missing": {
+			pictureURI:         &emptyURI,
+			accounts:           []*profiles.LinkedAccount{github},
+			linkedPictureKinds: "github",
+			expectedURI:        &githubURI,
+		},
+		"fallback disabled": {
+			pictureURI:         nil,
+			accounts:           []*profiles.LinkedAccount{github},
+			linkedPictureKinds: "",
+			expectedURI:        nil,
+		},
+		"no linked account": {
+			pictureURI:         nil,
+			accounts:           nil,
+			linkedPictureKinds: "github",
+			expectedURI:        nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &linkedPictureRepository{ //nolint:exhaustruct
+				pictureURI: tt.pictureURI,
+				accounts:   tt.accounts,
+			}
+			config := &profiles.Config{ //nolint:exhaustruct
+				LinkedPictureKinds: tt.linkedPictureKinds,
+				DefaultAvatar: profiles.DefaultAvatarConfig{ //nolint:exhaustruct
+					Style: profiles.AvatarStyleInitials,
+					Size:  64,
+				},
+			}
+			service := profiles.NewService(nil, config, repo, nil)
+
+			avatar, err := service.GetAvatar(t.Context(), "en", "eser")
+			require.NoError(t, err)
+
+			if tt.expectedURI == nil {
+				assert.Nil(t, avatar.PictureURI)
+				assert.NotEmpty(t, avatar.SVG)
+
+				return
+			}
+
+			require.NotNil(t, avatar.PictureURI)
+			assert.Equal(t, *tt.expectedURI, *avatar.PictureURI)
+		})
+	}
+}
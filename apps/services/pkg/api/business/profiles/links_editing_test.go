@@ -0,0 +1,89 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// editingLinksRepository serves the calls made while listing links for editing.
+type editingLinksRepository struct {
+	profiles.Repository
+
+	links               []*profiles.ProfileLink
+	listCalls           int
+	getProfileLinkCalls int
+}
+
+func (r *editingLinksRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *editingLinksRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "user-profile"
+
+	return &profiles.UserBriefInfo{
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *editingLinksRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	_ string,
+) (profiles.MembershipKind, error) {
+	return profiles.MembershipKindMaintainer, nil
+}
+
+func (r *editingLinksRepository) ListProfileLinksByProfileIDForEditing(
+	_ context.Context,
+	_ string,
+	_ string,
+) ([]*profiles.ProfileLink, error) {
+	r.listCalls++
+
+	return r.links, nil
+}
+
+func (r *editingLinksRepository) GetProfileLink(
+	_ context.Context,
+	_ string,
+	_ string,
+) (*profiles.ProfileLink, error) {
+	r.getProfileLinkCalls++
+
+	return nil, nil //nolint:nilnil
+}
+
+func TestListProfileLinksBySlug_SingleQuery(t *testing.T) {
+	t.Parallel()
+
+	repo := &editingLinksRepository{ //nolint:exhaustruct
+		links: []*profiles.ProfileLink{
+			{ID: "link-1", Kind: "github", Title: "GitHub"},         //nolint:exhaustruct
+			{ID: "link-2", Kind: "website", Title: "Personal site"}, //nolint:exhaustruct
+			{ID: "link-3", Kind: "x", Title: "X"},                   //nolint:exhaustruct
+		},
+	}
+
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	links, err := service.ListProfileLinksBySlug(t.Context(), "en", "user-1", "regular", "target")
+
+	require.NoError(t, err)
+	require.Len(t, links, 3)
+
+	assert.Equal(t, 1, repo.listCalls)
+	assert.Zero(t, repo.getProfileLinkCalls)
+
+	for _, link := range links {
+		assert.True(t, link.CanRemove, link.ID)
+	}
+}
@@ -0,0 +1,76 @@
+package profiles
+
+import (
+	"context"
+	"slices"
+)
+
+// FallbackLocaleCode is the last locale tried when content has no translation
+// in the requested locale.
+const FallbackLocaleCode = "en"
+
+// resolveLocaleChain returns the locales to try, in order, for content
+// requested in localeCode: the locale itself, its configured fallbacks and
+// finally FallbackLocaleCode.
+func (s *Service) resolveLocaleChain(localeCode string) []string {
+	chain := []string{localeCode}
+
+	if s.config != nil {
+		for _, fallback := range s.config.GetLocaleFallbacks()[localeCode] {
+			if !slices.Contains(chain, fallback) {
+				chain = append(chain, fallback)
+			}
+		}
+	}
+
+	if !slices.Contains(chain, FallbackLocaleCode) {
+		chain = append(chain, FallbackLocaleCode)
+	}
+
+	return chain
+}
+
+// getProfileByIDInLocaleChain returns the profile in the first locale of the
+// chain it is translated into, or nil when it has no such translation.
+func (s *Service) getProfileByIDInLocaleChain(
+	ctx context.Context,
+	localeCode string,
+	id string,
+) (*Profile, error) {
+	for _, chainLocaleCode := range s.resolveLocaleChain(localeCode) {
+		record, err := s.repo.GetProfileByID(ctx, chainLocaleCode, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if record != nil {
+			return record, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+// listProfilePagesInLocaleChain returns the profile's pages in the first locale
+// of the chain that has any.
+func (s *Service) listProfilePagesInLocaleChain(
+	localeCode string,
+	listPages func(localeCode string) ([]*ProfilePageBrief, error),
+) ([]*ProfilePageBrief, error) {
+	var pages []*ProfilePageBrief
+
+	for _, chainLocaleCode := range s.resolveLocaleChain(localeCode) {
+		var err error
+
+		pages, err = listPages(chainLocaleCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pages) > 0 {
+			break
+		}
+	}
+
+	return pages, nil
+}
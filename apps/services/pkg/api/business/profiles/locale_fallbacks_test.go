@@ -0,0 +1,99 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// translatedProfileRepository holds a profile translated into the given
+// locales only and records the locales it is asked for.
+type translatedProfileRepository struct {
+	profiles.Repository
+
+	localeCodes []string
+	requested   []string
+}
+
+func (r *translatedProfileRepository) GetProfileByID(
+	_ context.Context,
+	localeCode string,
+	id string,
+) (*profiles.Profile, error) {
+	r.requested = append(r.requested, localeCode)
+
+	for _, translated := range r.localeCodes {
+		if translated == localeCode {
+			return &profiles.Profile{ID: id, LocaleCode: localeCode}, nil //nolint:exhaustruct
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func TestService_GetByIDFollowsLocaleFallbacks(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		localeFallbacks   string
+		translatedLocales []string
+		expectedLocale    string
+		expectedRequested []string
+	}{
+		"chain reaches the translated locale": {
+			localeFallbacks:   "pt-PT:es",
+			translatedLocales: []string{"es"},
+			expectedLocale:    "es",
+			expectedRequested: []string{"pt-PT", "es"},
+		},
+		"requested locale wins over the chain": {
+			localeFallbacks:   "pt-PT:es",
+			translatedLocales: []string{"pt-PT", "es"},
+			expectedLocale:    "pt-PT",
+			expectedRequested: []string{"pt-PT"},
+		},
+		"en stays the terminal fallback": {
+			localeFallbacks:   "pt-PT:es;tr:de",
+			translatedLocales: []string{"en"},
+			expectedLocale:    "en",
+			expectedRequested: []string{"pt-PT", "es", "en"},
+		},
+		"locale without a chain falls back to en": {
+			localeFallbacks:   "",
+			translatedLocales: []string{"es", "en"},
+			expectedLocale:    "en",
+			expectedRequested: []string{"pt-PT", "en"},
+		},
+		"no translation in the chain": {
+			localeFallbacks:   "pt-PT:es",
+			translatedLocales: []string{"fr"},
+			expectedLocale:    "",
+			expectedRequested: []string{"pt-PT", "es", "en"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &translatedProfileRepository{localeCodes: tt.translatedLocales} //nolint:exhaustruct
+			config := &profiles.Config{LocaleFallbacks: tt.localeFallbacks}         //nolint:exhaustruct
+			service := profiles.NewService(nil, config, repo, nil)
+
+			profile, err := service.GetByID(t.Context(), "pt-PT", "profile-1")
+			require.NoError(t, err)
+
+			if tt.expectedLocale == "" {
+				assert.Nil(t, profile)
+			} else {
+				require.NotNil(t, profile)
+				assert.Equal(t, tt.expectedLocale, profile.LocaleCode)
+			}
+
+			assert.Equal(t, tt.expectedRequested, repo.requested)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+)
+
+const managedGitHubLinkKind = "github"
+
+// ErrGitHubAccountLinkedElsewhere is returned when the GitHub account behind a
+// login is already the managed GitHub link of another profile.
+var ErrGitHubAccountLinkedElsewhere = errors.New(
+	"this GitHub account is already linked to another profile",
+)
+
+// ManagedGitHubLinkResult tells what UpsertManagedGitHubLink did.
+type ManagedGitHubLinkResult string
+
+const (
+	ManagedGitHubLinkCreated ManagedGitHubLinkResult = "created"
+	ManagedGitHubLinkUpdated ManagedGitHubLinkResult = "updated"
+	// ManagedGitHubLinkKept means the existing link was left alone because the
+	// new token has a narrower scope than the stored one.
+	ManagedGitHubLinkKept ManagedGitHubLinkResult = "kept"
+)
+
+// ManagedGitHubLinkParams holds the GitHub identity and OAuth token of a login.
+type ManagedGitHubLinkParams struct {
+	ProfileID   string
+	RemoteID    string
+	Handle      string
+	AccessToken string
+	TokenScope  string
+}
+
+// UpsertManagedGitHubLink makes sure the individual profile has exactly one
+// managed GitHub link for the account and that its token is current. It is
+// safe to call on every login: an existing link is updated in place instead of
+// creating another one, and an account already linked to a different profile is
+// refused with ErrGitHubAccountLinkedElsewhere.
+func (s *Service) UpsertManagedGitHubLink(
+	ctx context.Context,
+	params ManagedGitHubLinkParams,
+) (ManagedGitHubLinkResult, error) {
+	managedLink, err := s.repo.GetManagedGitHubLinkByProfileID(ctx, params.ProfileID)
+	if err != nil {
+		return "", fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, params.ProfileID, err)
+	}
+
+	if managedLink != nil {
+		if managedLink.AuthAccessTokenScope != nil &&
+			wouldDowngradeGitHubScope(*managedLink.AuthAccessTokenScope, params.TokenScope) {
+			return ManagedGitHubLinkKept, nil
+		}
+
+		return s.refreshManagedGitHubLink(ctx, managedLink.ID, params)
+	}
+
+	inUse, err := s.repo.IsManagedProfileLinkRemoteIDInUse(
+		ctx, managedGitHubLinkKind, params.RemoteID, params.ProfileID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if inUse {
+		return "", fmt.Errorf(
+			"%w(profileID: %s, remoteID: %s)",
+			ErrGitHubAccountLinkedElsewhere,
+			params.ProfileID,
+			params.RemoteID,
+		)
+	}
+
+	// A managed link without a token (e.g. after a disconnect) is reused as well
+	existingLink, err := s.repo.GetProfileLinkByRemoteID(
+		ctx, params.ProfileID, managedGitHubLinkKind, params.RemoteID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if existingLink != nil {
+		return s.refreshManagedGitHubLink(ctx, existingLink.ID, params)
+	}
+
+	// A manually added GitHub link may hold the same remote_id; release it so the
+	// managed link doesn't collide with it on the unique index
+	err = s.repo.ClearNonManagedProfileLinkRemoteID(
+		ctx, params.ProfileID, managedGitHubLinkKind, params.RemoteID,
+	)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
+	}
+
+	maxOrder, err := s.repo.GetMaxProfileLinkOrder(ctx, params.ProfileID)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	_, err = s.CreateOAuthProfileLink(
+		ctx, lib.IDsGenerateUnique(), managedGitHubLinkKind, params.ProfileID, maxOrder+1, "en",
+		params.RemoteID, params.Handle, gitHubProfileURI(params.Handle), "GitHub", "github",
+		params.TokenScope, params.AccessToken,
+		nil, // accessTokenExpiresAt — GitHub tokens don't expire
+		nil, // refreshToken
+		nil, // properties
+	)
+	if err != nil {
+		// A concurrent login may have created the link in the meantime
+		racedLink, lookupErr := s.repo.GetProfileLinkByRemoteID(
+			ctx, params.ProfileID, managedGitHubLinkKind, params.RemoteID,
+		)
+		if lookupErr == nil && racedLink != nil {
+			return s.refreshManagedGitHubLink(ctx, racedLink.ID, params)
+		}
+
+		return "", err
+	}
+
+	return ManagedGitHubLinkCreated, nil
+}
+
+// refreshManagedGitHubLink stores the login's handle and token on an existing link.
+func (s *Service) refreshManagedGitHubLink(
+	ctx context.Context,
+	linkID string,
+	params ManagedGitHubLinkParams,
+) (ManagedGitHubLinkResult, error) {
+	err := s.UpdateProfileLinkOAuthTokens(
+		ctx, linkID, "en", params.Handle, gitHubProfileURI(params.Handle), "GitHub",
+		params.TokenScope, params.AccessToken,
+		nil, // accessTokenExpiresAt — GitHub tokens don't expire
+		nil, // refreshToken
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return ManagedGitHubLinkUpdated, nil
+}
+
+func gitHubProfileURI(handle string) string {
+	return "https://github.com/" + handle
+}
+
+// wouldDowngradeGitHubScope returns true if replacing existingScope with newScope
+// would lose important permissions (public_repo or read:org).
+func wouldDowngradeGitHubScope(existingScope, newScope string) bool {
+	existingHasPublicRepo := strings.Contains(existingScope, "public_repo")
+	newHasPublicRepo := strings.Contains(newScope, "public_repo")
+	existingHasReadOrg := strings.Contains(existingScope, "read:org")
+	newHasReadOrg := strings.Contains(newScope, "read:org")
+
+	return (existingHasPublicRepo && !newHasPublicRepo) ||
+		(existingHasReadOrg && !newHasReadOrg)
+}
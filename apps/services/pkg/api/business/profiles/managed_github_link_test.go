@@ -0,0 +1,265 @@
+package profiles_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errUniqueViolation = errors.New("duplicate key value violates unique constraint")
+
+// githubLink is a profile link row as seen by githubLinkRepository.
+type githubLink struct {
+	id        string
+	profileID string
+	remoteID  string
+	token     string
+	scope     string
+	managed   bool
+}
+
+// githubLinkRepository keeps GitHub links in memory and enforces the
+// (profile_id, kind, remote_id) unique index on creation.
+type githubLinkRepository struct {
+	profiles.Repository
+
+	links   []*githubLink
+	creates int
+}
+
+func (r *githubLinkRepository) GetManagedGitHubLinkByProfileID(
+	_ context.Context,
+	profileID string,
+) (*profiles.ManagedGitHubLink, error) {
+	for _, link := range r.links {
+		if link.profileID == profileID && link.managed && link.token != "" {
+			scope := link.scope
+
+			return &profiles.ManagedGitHubLink{
+				ID:                   link.id,
+				ProfileID:            link.profileID,
+				AuthAccessToken:      link.token,
+				AuthAccessTokenScope: &scope,
+			}, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (r *githubLinkRepository) IsManagedProfileLinkRemoteIDInUse(
+	_ context.Context,
+	_ string,
+	remoteID string,
+	excludeProfileID string,
+) (bool, error) {
+	for _, link := range r.links {
+		if link.managed && link.remoteID == remoteID && link.profileID != excludeProfileID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *githubLinkRepository) GetProfileLinkByRemoteID(
+	_ context.Context,
+	profileID string,
+	_ string,
+	remoteID string,
+) (*profiles.ProfileLink, error) {
+	for _, link := range r.links {
+		if link.profileID == profileID && link.managed && link.remoteID == remoteID {
+			return &profiles.ProfileLink{ID: link.id}, nil //nolint:exhaustruct
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (r *githubLinkRepository) ClearNonManagedProfileLinkRemoteID(
+	_ context.Context,
+	profileID string,
+	_ string,
+	remoteID string,
+) error {
+	for _, link := range r.links {
+		if link.profileID == profileID && !link.managed && link.remoteID == remoteID {
+			link.remoteID = ""
+		}
+	}
+
+	return nil
+}
+
+func (r *githubLinkRepository) GetMaxProfileLinkOrder(_ context.Context, _ string) (int, error) {
+	return len(r.links), nil
+}
+
+func (r *githubLinkRepository) CreateOAuthProfileLink(
+	_ context.Context,
+	linkID string,
+	_ string,
+	profileID string,
+	_ int,
+	remoteID string,
+	_ string,
+	_ string,
+	_ string,
+	authScope string,
+	accessToken string,
+	_ *sql.NullTime,
+	_ *string,
+	_ map[string]any,
+) (*profiles.ProfileLink, error) {
+	for _, link := range r.links {
+		if link.profileID == profileID && link.remoteID == remoteID {
+			return nil, errUniqueViolation
+		}
+	}
+
+	r.creates++
+	r.links = append(r.links, &githubLink{
+		id:        linkID,
+		profileID: profileID,
+		remoteID:  remoteID,
+		token:     accessToken,
+		scope:     authScope,
+		managed:   true,
+	})
+
+	return &profiles.ProfileLink{ID: linkID}, nil //nolint:exhaustruct
+}
+
+func (r *githubLinkRepository) UpdateProfileLinkOAuthTokens(
+	_ context.Context,
+	id string,
+	_ string,
+	_ string,
+	authScope string,
+	accessToken string,
+	_ *sql.NullTime,
+	_ *string,
+) error {
+	for _, link := range r.links {
+		if link.id == id {
+			link.token = accessToken
+			link.scope = authScope
+		}
+	}
+
+	return nil
+}
+
+func (r *githubLinkRepository) UpsertProfileLinkTx(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ *string,
+	_ *string,
+	_ *string,
+) error {
+	return nil
+}
+
+func newGitHubLinkService(links ...*githubLink) (*profiles.Service, *githubLinkRepository) {
+	repo := &githubLinkRepository{links: links} //nolint:exhaustruct
+	auditService, _ := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo //nolint:exhaustruct
+}
+
+func githubLogin(profileID string, token string, scope string) profiles.ManagedGitHubLinkParams {
+	return profiles.ManagedGitHubLinkParams{
+		ProfileID:   profileID,
+		RemoteID:    "gh-42",
+		Handle:      "octocat",
+		AccessToken: token,
+		TokenScope:  scope,
+	}
+}
+
+func TestUpsertManagedGitHubLink_ReLogin(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newGitHubLinkService()
+
+	result, err := service.UpsertManagedGitHubLink(t.Context(), githubLogin("profile-1", "token-1", "read:user"))
+	require.NoError(t, err)
+	assert.Equal(t, profiles.ManagedGitHubLinkCreated, result)
+
+	// Logging in again refreshes the token of the same link
+	result, err = service.UpsertManagedGitHubLink(t.Context(), githubLogin("profile-1", "token-2", "read:user"))
+	require.NoError(t, err)
+	assert.Equal(t, profiles.ManagedGitHubLinkUpdated, result)
+
+	require.Len(t, repo.links, 1)
+	assert.Equal(t, 1, repo.creates)
+	assert.Equal(t, "token-2", repo.links[0].token)
+}
+
+func TestUpsertManagedGitHubLink_ReusesTokenlessLink(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newGitHubLinkService(&githubLink{
+		id: "link-1", profileID: "profile-1", remoteID: "gh-42", token: "", scope: "", managed: true,
+	})
+
+	result, err := service.UpsertManagedGitHubLink(t.Context(), githubLogin("profile-1", "token-1", "read:user"))
+	require.NoError(t, err)
+	assert.Equal(t, profiles.ManagedGitHubLinkUpdated, result)
+
+	assert.Zero(t, repo.creates)
+	assert.Equal(t, "token-1", repo.links[0].token)
+}
+
+func TestUpsertManagedGitHubLink_KeepsBroaderScope(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newGitHubLinkService(&githubLink{
+		id: "link-1", profileID: "profile-1", remoteID: "gh-42", token: "token-1",
+		scope: "read:user,public_repo,read:org", managed: true,
+	})
+
+	result, err := service.UpsertManagedGitHubLink(t.Context(), githubLogin("profile-1", "token-2", "read:user"))
+	require.NoError(t, err)
+	assert.Equal(t, profiles.ManagedGitHubLinkKept, result)
+
+	assert.Equal(t, "token-1", repo.links[0].token)
+}
+
+func TestUpsertManagedGitHubLink_ReleasesManualLink(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newGitHubLinkService(&githubLink{
+		id: "manual", profileID: "profile-1", remoteID: "gh-42", token: "", scope: "", managed: false,
+	})
+
+	result, err := service.UpsertManagedGitHubLink(t.Context(), githubLogin("profile-1", "token-1", "read:user"))
+	require.NoError(t, err)
+	assert.Equal(t, profiles.ManagedGitHubLinkCreated, result)
+
+	require.Len(t, repo.links, 2)
+	assert.Empty(t, repo.links[0].remoteID)
+}
+
+func TestUpsertManagedGitHubLink_LinkedToAnotherProfile(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newGitHubLinkService(&githubLink{
+		id: "link-1", profileID: "profile-2", remoteID: "gh-42", token: "token-1",
+		scope: "read:user", managed: true,
+	})
+
+	_, err := service.UpsertManagedGitHubLink(t.Context(), githubLogin("profile-1", "token-2", "read:user"))
+	require.ErrorIs(t, err, profiles.ErrGitHubAccountLinkedElsewhere)
+
+	assert.Zero(t, repo.creates)
+	assert.Equal(t, "token-1", repo.links[0].token, "the other profile's link is untouched")
+}
@@ -0,0 +1,307 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var (
+	ErrInvitationNotFound       = errors.New("membership invitation not found")
+	ErrInvitationExpired        = errors.New("membership invitation has expired")
+	ErrInvitationNotPending     = errors.New("membership invitation was already answered")
+	ErrInvitationAlreadyPending = errors.New("a membership invitation is already pending")
+	ErrAlreadyMember            = errors.New("profile already has this membership")
+)
+
+// Membership invitation statuses.
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusDeclined = "declined"
+)
+
+// DefaultMembershipInvitationTTL is how long an invitation can be accepted when
+// the configuration doesn't set a window.
+const DefaultMembershipInvitationTTL = 7 * 24 * time.Hour
+
+// IsExpired reports whether the invitation can no longer be accepted at now.
+func (i *MembershipInvitation) IsExpired(now time.Time) bool {
+	return !i.ExpiresAt.After(now)
+}
+
+func (s *Service) membershipInvitationTTL() time.Duration {
+	if s.config == nil || s.config.MembershipInvitationTTL <= 0 {
+		return DefaultMembershipInvitationTTL
+	}
+
+	return s.config.MembershipInvitationTTL
+}
+
+// InviteMembership invites a profile to become a member of the profile with the
+// given kind. The same checks as AddMembership apply, but the membership is
+// only created when the invitee accepts before the invitation expires.
+func (s *Service) InviteMembership( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID string,
+	userKind string,
+	userIndividualProfileID *string,
+	profileSlug string,
+	inviteeProfileID string,
+	kind string,
+) (*MembershipInvitation, error) {
+	profileID, err := s.authorizeMembershipAssignment(
+		ctx,
+		userID,
+		userKind,
+		userIndividualProfileID,
+		profileSlug,
+		kind,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if inviteeProfileID == profileID {
+		return nil, fmt.Errorf("%w: a profile cannot be invited to itself", ErrInvalidInput)
+	}
+
+	invitee, err := s.repo.GetProfileIdentifierByID(ctx, inviteeProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, inviteeProfileID, err)
+	}
+
+	if invitee == nil {
+		return nil, ErrProfileNotFound
+	}
+
+	existing, err := s.repo.GetProfileMembershipByProfileAndMember(ctx, profileID, inviteeProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if existing != nil && existing.Kind == kind {
+		return nil, ErrAlreadyMember
+	}
+
+	pending, err := s.repo.GetPendingMembershipInvitation(ctx, profileID, inviteeProfileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if pending != nil && !pending.IsExpired(time.Now()) {
+		return nil, ErrInvitationAlreadyPending
+	}
+
+	now := time.Now()
+	invitation := &MembershipInvitation{
+		CreatedAt:        now,
+		ExpiresAt:        now.Add(s.membershipInvitationTTL()),
+		RespondedAt:      nil,
+		ID:               string(s.idGenerator()),
+		ProfileID:        profileID,
+		InviteeProfileID: inviteeProfileID,
+		Kind:             kind,
+		Status:           InvitationStatusPending,
+		InvitedByUserID:  userID,
+	}
+
+	err = s.repo.CreateMembershipInvitation(ctx, invitation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileMembershipInvited,
+		EntityType: "membership_invitation",
+		EntityID:   invitation.ID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":         profileID,
+			"invitee_profile_id": inviteeProfileID,
+			"kind":               kind,
+			"expires_at":         invitation.ExpiresAt,
+		},
+	})
+
+	return invitation, nil
+}
+
+// ListPendingInvitations lists the unexpired invitations the profile has sent
+// or received. The user must be a maintainer of the profile.
+func (s *Service) ListPendingInvitations(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+) ([]*MembershipInvitation, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+	if accessErr != nil {
+		return nil, accessErr
+	}
+
+	invitations, err := s.repo.ListPendingMembershipInvitations(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+	}
+
+	return invitations, nil
+}
+
+// AcceptInvitation accepts an invitation of the profile on behalf of the
+// invitee and creates (or promotes) the membership it offers.
+func (s *Service) AcceptInvitation(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	invitationID string,
+) (*MembershipInvitation, error) {
+	return s.respondToInvitation(ctx, userID, profileSlug, invitationID, InvitationStatusAccepted)
+}
+
+// DeclineInvitation declines an invitation of the profile on behalf of the
+// invitee.
+func (s *Service) DeclineInvitation(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	invitationID string,
+) (*MembershipInvitation, error) {
+	return s.respondToInvitation(ctx, userID, profileSlug, invitationID, InvitationStatusDeclined)
+}
+
+// respondToInvitation answers a pending invitation. Only the invitee can
+// answer: the user's individual profile must be the invitee or a maintainer of
+// it. Admins get no exception, since the invitation asks for consent.
+func (s *Service) respondToInvitation( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	invitationID string,
+	status string,
+) (*MembershipInvitation, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	invitation, err := s.repo.GetMembershipInvitationByID(ctx, invitationID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(invitationID: %s): %w", ErrFailedToGetRecord, invitationID, err)
+	}
+
+	if invitation == nil || invitation.ProfileID != profileID {
+		return nil, ErrInvitationNotFound
+	}
+
+	user, err := s.repo.GetUserBriefInfo(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(userID: %s): %w", ErrFailedToGetRecord, userID, err)
+	}
+
+	if user.IndividualProfileID == nil {
+		return nil, fmt.Errorf("%w: %w", ErrInsufficientAccess, ErrNoIndividualProfile)
+	}
+
+	accessErr := s.ensureProfileCanProfileAccess(
+		ctx,
+		invitation.InviteeProfileID,
+		*user.IndividualProfileID,
+		MembershipKindMaintainer,
+	)
+	if accessErr != nil {
+		return nil, accessErr
+	}
+
+	if invitation.Status != InvitationStatusPending {
+		return nil, ErrInvitationNotPending
+	}
+
+	if status == InvitationStatusAccepted && invitation.IsExpired(time.Now()) {
+		return nil, ErrInvitationExpired
+	}
+
+	var grant *membershipGrant
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		affected, err := txRepo.RespondToMembershipInvitation(ctx, invitation.ID, status)
+		if err != nil {
+			return fmt.Errorf("%w(invitationID: %s): %w", ErrFailedToUpdateRecord, invitation.ID, err)
+		}
+
+		// Answered concurrently by another request.
+		if affected == 0 {
+			return ErrInvitationNotPending
+		}
+
+		if status != InvitationStatusAccepted {
+			return nil
+		}
+
+		grant, err = s.grantMembership(
+			ctx,
+			txRepo,
+			invitation.ProfileID,
+			invitation.InviteeProfileID,
+			invitation.Kind,
+		)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	invitation.Status = status
+	invitation.RespondedAt = &now
+
+	eventType := events.ProfileMembershipInvitationDeclined
+	if grant != nil {
+		eventType = events.ProfileMembershipInvitationAccepted
+
+		_ = s.repo.InvalidateMembershipKindCache(ctx, invitation.ProfileID, invitation.InviteeProfileID)
+
+		s.recordMembershipGranted(
+			ctx,
+			userID,
+			invitation.ProfileID,
+			invitation.InviteeProfileID,
+			invitation.Kind,
+			grant,
+		)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  eventType,
+		EntityType: "membership_invitation",
+		EntityID:   invitation.ID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":         invitation.ProfileID,
+			"invitee_profile_id": invitation.InviteeProfileID,
+			"kind":               invitation.Kind,
+		},
+	})
+
+	return invitation, nil
+}
@@ -0,0 +1,340 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// invitationRepository holds the memberships and invitations of the "acme"
+// organization. Each user's individual profile is "profile-" + user ID; the
+// user "maintainer" maintains acme.
+type invitationRepository struct {
+	profiles.Repository
+
+	memberships map[string]string // member profile ID -> kind
+	invitations map[string]*profiles.MembershipInvitation
+}
+
+func newInvitationRepository() *invitationRepository {
+	return &invitationRepository{
+		memberships: map[string]string{
+			"profile-maintainer": string(profiles.MembershipKindMaintainer),
+		},
+		invitations: map[string]*profiles.MembershipInvitation{},
+	}
+}
+
+func (r *invitationRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "acme" {
+		return "", nil
+	}
+
+	return "acme-profile", nil
+}
+
+func (r *invitationRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *invitationRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	targetProfileID string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	if targetProfileID != "acme-profile" {
+		return "", nil
+	}
+
+	return profiles.MembershipKind(r.memberships[originProfileID]), nil
+}
+
+func (r *invitationRepository) GetProfileMembershipByProfileAndMember(
+	_ context.Context,
+	profileID string,
+	memberProfileID string,
+) (*profiles.ProfileMembership, error) {
+	kind, ok := r.memberships[memberProfileID]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &profiles.ProfileMembership{ //nolint:exhaustruct
+		ID:              "membership-" + memberProfileID,
+		ProfileID:       profileID,
+		MemberProfileID: &memberProfileID,
+		Kind:            kind,
+	}, nil
+}
+
+func (r *invitationRepository) GetProfileIdentifierByID(
+	_ context.Context,
+	id string,
+) (*profiles.ProfileBrief, error) {
+	return &profiles.ProfileBrief{ID: id, Kind: profiles.ProfileKindIndividual}, nil //nolint:exhaustruct
+}
+
+func (r *invitationRepository) CreateMembershipInvitation(
+	_ context.Context,
+	invitation *profiles.MembershipInvitation,
+) error {
+	stored := *invitation
+	r.invitations[invitation.ID] = &stored
+
+	return nil
+}
+
+func (r *invitationRepository) GetMembershipInvitationByID(
+	_ context.Context,
+	id string,
+) (*profiles.MembershipInvitation, error) {
+	invitation, ok := r.invitations[id]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	copied := *invitation
+
+	return &copied, nil
+}
+
+func (r *invitationRepository) GetPendingMembershipInvitation(
+	_ context.Context,
+	profileID string,
+	inviteeProfileID string,
+) (*profiles.MembershipInvitation, error) {
+	for _, invitation := range r.invitations {
+		if invitation.ProfileID == profileID &&
+			invitation.InviteeProfileID == inviteeProfileID &&
+			invitation.Status == profiles.InvitationStatusPending {
+			return invitation, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (r *invitationRepository) RespondToMembershipInvitation(
+	_ context.Context,
+	id string,
+	status string,
+) (int64, error) {
+	invitation := r.invitations[id]
+	if invitation.Status != profiles.InvitationStatusPending {
+		return 0, nil
+	}
+
+	invitation.Status = status
+
+	return 1, nil
+}
+
+func (r *invitationRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *invitationRepository) CreateProfileMembership(
+	_ context.Context,
+	_ string,
+	_ string,
+	memberProfileID *string,
+	kind string,
+	_ map[string]any,
+) error {
+	r.memberships[*memberProfileID] = kind
+
+	return nil
+}
+
+func (r *invitationRepository) UpdateProfileMembership(
+	_ context.Context,
+	id string,
+	kind string,
+) error {
+	r.memberships[id[len("membership-"):]] = kind
+
+	return nil
+}
+
+func (r *invitationRepository) InvalidateMembershipKindCache(
+	_ context.Context,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+func newInvitationService(
+	repo *invitationRepository,
+) (*profiles.Service, *recordingAuditRepository) {
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), auditRepo //nolint:exhaustruct
+}
+
+func inviteJane(t *testing.T, service *profiles.Service) *profiles.MembershipInvitation {
+	t.Helper()
+
+	maintainerProfileID := "profile-maintainer"
+
+	invitation, err := service.InviteMembership(
+		t.Context(),
+		"maintainer",
+		"regular",
+		&maintainerProfileID,
+		"acme",
+		"profile-jane",
+		string(profiles.MembershipKindContributor),
+	)
+	require.NoError(t, err)
+
+	return invitation
+}
+
+func TestMembershipInvitation_Accept(t *testing.T) {
+	t.Parallel()
+
+	repo := newInvitationRepository()
+	service, auditRepo := newInvitationService(repo)
+
+	invitation := inviteJane(t, service)
+
+	assert.Equal(t, profiles.InvitationStatusPending, invitation.Status)
+	assert.WithinDuration(
+		t, time.Now().Add(profiles.DefaultMembershipInvitationTTL), invitation.ExpiresAt, time.Minute,
+	)
+	assert.NotContains(t, repo.memberships, "profile-jane", "inviting must not add the member")
+
+	accepted, err := service.AcceptInvitation(t.Context(), "jane", "acme", invitation.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, profiles.InvitationStatusAccepted, accepted.Status)
+	assert.Equal(t, string(profiles.MembershipKindContributor), repo.memberships["profile-jane"])
+
+	eventTypes := make([]events.EventType, len(auditRepo.entries))
+	for i, entry := range auditRepo.entries {
+		eventTypes[i] = entry.EventType
+	}
+
+	assert.Equal(t, []events.EventType{
+		events.ProfileMembershipInvited,
+		events.ProfileMembershipCreated,
+		events.ProfileMembershipInvitationAccepted,
+	}, eventTypes)
+
+	// An answered invitation cannot be answered again.
+	_, err = service.DeclineInvitation(t.Context(), "jane", "acme", invitation.ID)
+	require.ErrorIs(t, err, profiles.ErrInvitationNotPending)
+}
+
+func TestMembershipInvitation_Decline(t *testing.T) {
+	t.Parallel()
+
+	repo := newInvitationRepository()
+	service, _ := newInvitationService(repo)
+
+	invitation := inviteJane(t, service)
+
+	declined, err := service.DeclineInvitation(t.Context(), "jane", "acme", invitation.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, profiles.InvitationStatusDeclined, declined.Status)
+	assert.NotContains(t, repo.memberships, "profile-jane")
+
+	// Once declined, the profile can be invited again.
+	inviteJane(t, service)
+}
+
+func TestMembershipInvitation_ExpiredIsRejected(t *testing.T) {
+	t.Parallel()
+
+	repo := newInvitationRepository()
+	service, _ := newInvitationService(repo)
+
+	invitation := inviteJane(t, service)
+	repo.invitations[invitation.ID].ExpiresAt = time.Now().Add(-time.Minute)
+
+	_, err := service.AcceptInvitation(t.Context(), "jane", "acme", invitation.ID)
+	require.ErrorIs(t, err, profiles.ErrInvitationExpired)
+
+	assert.NotContains(t, repo.memberships, "profile-jane")
+	assert.Equal(t, profiles.InvitationStatusPending, repo.invitations[invitation.ID].Status)
+}
+
+func TestMembershipInvitation_Rejected(t *testing.T) {
+	t.Parallel()
+
+	maintainerProfileID := "profile-maintainer"
+
+	t.Run("only the invitee can answer", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newInvitationService(newInvitationRepository())
+		invitation := inviteJane(t, service)
+
+		_, err := service.AcceptInvitation(t.Context(), "maintainer", "acme", invitation.ID)
+		require.ErrorIs(t, err, profiles.ErrInsufficientAccess)
+	})
+
+	t.Run("role above the inviter", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newInvitationService(newInvitationRepository())
+
+		_, err := service.InviteMembership(
+			t.Context(), "maintainer", "regular", &maintainerProfileID,
+			"acme", "profile-jane", string(profiles.MembershipKindLead),
+		)
+		require.ErrorIs(t, err, profiles.ErrCannotAssignHigherRole)
+	})
+
+	t.Run("already pending", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newInvitationService(newInvitationRepository())
+		inviteJane(t, service)
+
+		_, err := service.InviteMembership(
+			t.Context(), "maintainer", "regular", &maintainerProfileID,
+			"acme", "profile-jane", string(profiles.MembershipKindMember),
+		)
+		require.ErrorIs(t, err, profiles.ErrInvitationAlreadyPending)
+	})
+
+	t.Run("already a member with that kind", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newInvitationService(newInvitationRepository())
+
+		_, err := service.InviteMembership(
+			t.Context(), "maintainer", "regular", &maintainerProfileID,
+			"acme", "profile-maintainer", string(profiles.MembershipKindMaintainer),
+		)
+		require.ErrorIs(t, err, profiles.ErrAlreadyMember)
+	})
+
+	t.Run("invitation of another profile", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newInvitationService(newInvitationRepository())
+
+		_, err := service.AcceptInvitation(t.Context(), "jane", "acme", "missing")
+		require.ErrorIs(t, err, profiles.ErrInvitationNotFound)
+	})
+}
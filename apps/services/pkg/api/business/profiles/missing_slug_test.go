@@ -0,0 +1,134 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+)
+
+// missingSlugRepository resolves every slug to the empty ID.
+// Any other repository method panics through the nil embedded interface,
+// so a service that queries on after a missing slug fails the test.
+type missingSlugRepository struct {
+	profiles.Repository
+}
+
+func (r *missingSlugRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func TestService_MissingSlugReturnsProfileNotFound(t *testing.T) {
+	t.Parallel()
+
+	const slug = "does-not-exist"
+
+	service := profiles.NewService(nil, nil, &missingSlugRepository{}, nil) //nolint:exhaustruct
+
+	tests := map[string]func(ctx context.Context) error{
+		"GetBySlug": func(ctx context.Context) error {
+			_, err := service.GetBySlug(ctx, "en", slug)
+
+			return err
+		},
+		"GetBySlugEx": func(ctx context.Context) error {
+			_, err := service.GetBySlugEx(ctx, "en", slug)
+
+			return err
+		},
+		"GetBySlugExWithViewerUser": func(ctx context.Context) error {
+			_, err := service.GetBySlugExWithViewerUser(ctx, "en", slug, nil)
+
+			return err
+		},
+		"ListPagesBySlug": func(ctx context.Context) error {
+			_, err := service.ListPagesBySlug(ctx, "en", slug)
+
+			return err
+		},
+		"ListPagesBySlugForViewer": func(ctx context.Context) error {
+			_, err := service.ListPagesBySlugForViewer(ctx, "en", slug, nil)
+
+			return err
+		},
+		"GetPageBySlug": func(ctx context.Context) error {
+			_, err := service.GetPageBySlug(ctx, "en", slug, "about")
+
+			return err
+		},
+		"GetPageBySlugForViewer": func(ctx context.Context) error {
+			_, err := service.GetPageBySlugForViewer(ctx, "en", slug, "about", nil)
+
+			return err
+		},
+		"CheckPageSlugAvailability": func(ctx context.Context) error {
+			_, err := service.CheckPageSlugAvailability(ctx, "en", slug, "about", nil, false)
+
+			return err
+		},
+		"ListLinksBySlug": func(ctx context.Context) error {
+			_, err := service.ListLinksBySlug(ctx, "en", slug)
+
+			return err
+		},
+		"ListFeaturedLinksBySlug": func(ctx context.Context) error {
+			_, err := service.ListFeaturedLinksBySlug(ctx, "en", slug, "")
+
+			return err
+		},
+		"ListAllLinksBySlug": func(ctx context.Context) error {
+			_, err := service.ListAllLinksBySlug(ctx, "en", slug, "", cursors.NewCursor(0, nil))
+
+			return err
+		},
+		"ListProfileContributionsBySlug": func(ctx context.Context) error {
+			_, err := service.ListProfileContributionsBySlug(ctx, "en", slug, cursors.NewCursor(0, nil))
+
+			return err
+		},
+		"ListProfileMembersBySlug": func(ctx context.Context) error {
+			_, err := service.ListProfileMembersBySlug(ctx, "en", slug, cursors.NewCursor(0, nil))
+
+			return err
+		},
+		"GetProfileTranslations": func(ctx context.Context) error {
+			_, err := service.GetProfileTranslations(ctx, slug)
+
+			return err
+		},
+		"GetProfileIDBySlug": func(ctx context.Context) error {
+			_, err := service.GetProfileIDBySlug(ctx, slug)
+
+			return err
+		},
+		"Search": func(ctx context.Context) error {
+			profileSlug := slug
+			_, err := service.Search(ctx, "en", &profiles.SearchParams{ //nolint:exhaustruct
+				Query:       "query",
+				ProfileSlug: &profileSlug,
+			})
+
+			return err
+		},
+		"ListCandidates": func(ctx context.Context) error {
+			_, err := service.ListCandidates(ctx, "en", "user-1", slug)
+
+			return err
+		},
+		"GetApplicationForm": func(ctx context.Context) error {
+			_, err := service.GetApplicationForm(ctx, slug)
+
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, call(t.Context()), profiles.ErrProfileNotFound)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var (
+	ErrCannotTransferIndividualProfile = errors.New(
+		"cannot transfer ownership of an individual profile",
+	)
+	ErrAlreadyOwner = errors.New("membership is already an owner")
+)
+
+// TransferProfileOwnership promotes a membership of the profile to owner. The
+// actor must be an owner of the profile or an admin. When demoteActor is set,
+// the actor's own owner membership becomes maintainer in the same transaction,
+// unless that would leave the profile without an owner.
+func (s *Service) TransferProfileOwnership( //nolint:cyclop,funlen
+	ctx context.Context,
+	actorUserID string,
+	profileSlug string,
+	newOwnerMembershipID string,
+	demoteActor bool,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	actor, err := s.repo.GetUserBriefInfo(ctx, actorUserID)
+	if err != nil {
+		return fmt.Errorf("%w(userID: %s): %w", ErrFailedToGetRecord, actorUserID, err)
+	}
+
+	if actor.Kind != UserKindAdmin {
+		err := s.ensureUserCanProfileAccess(ctx, profileID, actorUserID, MembershipKindOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	profile, err := s.repo.GetProfileByID(ctx, "en", profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	if profile == nil {
+		return ErrProfileNotFound
+	}
+
+	if profile.Kind == ProfileKindIndividual {
+		return ErrCannotTransferIndividualProfile
+	}
+
+	membership, err := s.repo.GetProfileMembershipByID(ctx, newOwnerMembershipID)
+	if err != nil {
+		return fmt.Errorf(
+			"%w(membershipID: %s): %w",
+			ErrFailedToGetRecord,
+			newOwnerMembershipID,
+			err,
+		)
+	}
+
+	if membership == nil || membership.ProfileID != profileID || membership.MemberProfileID == nil {
+		return ErrMembershipNotFound
+	}
+
+	if membership.Kind == string(MembershipKindOwner) {
+		return ErrAlreadyOwner
+	}
+
+	if membership.Kind == string(MembershipKindFollower) {
+		return fmt.Errorf("%w: cannot transfer ownership to a follower", ErrInvalidMembershipKind)
+	}
+
+	var actorMembership *ProfileMembership
+
+	if demoteActor && actor.IndividualProfileID != nil {
+		actorMembership, err = s.repo.GetProfileMembershipByProfileAndMember(
+			ctx,
+			profileID,
+			*actor.IndividualProfileID,
+		)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		}
+
+		// Admins acting without an owner membership have nothing to hand over.
+		if actorMembership != nil && actorMembership.Kind != string(MembershipKindOwner) {
+			actorMembership = nil
+		}
+	}
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		err := txRepo.UpdateProfileMembership(ctx, membership.ID, string(MembershipKindOwner))
+		if err != nil {
+			return fmt.Errorf("%w(membershipID: %s): %w", ErrFailedToUpdateRecord, membership.ID, err)
+		}
+
+		if actorMembership == nil {
+			return nil
+		}
+
+		ownerCount, err := txRepo.CountProfileOwners(ctx, profileID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		}
+
+		if ownerCount <= 1 {
+			return ErrCannotRemoveLastOwner
+		}
+
+		err = txRepo.UpdateProfileMembership(ctx, actorMembership.ID, string(MembershipKindMaintainer))
+		if err != nil {
+			return fmt.Errorf(
+				"%w(membershipID: %s): %w",
+				ErrFailedToUpdateRecord,
+				actorMembership.ID,
+				err,
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = s.repo.InvalidateMembershipKindCache(ctx, profileID, *membership.MemberProfileID)
+
+	if actorMembership != nil {
+		_ = s.repo.InvalidateMembershipKindCache(ctx, profileID, *actor.IndividualProfileID)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileOwnershipTransferred,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &actorUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"membership_id":     membership.ID,
+			"member_profile_id": *membership.MemberProfileID,
+			"last_properties": map[string]any{
+				"kind": membership.Kind,
+			},
+			"actor_demoted": actorMembership != nil,
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,304 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ownershipRepository holds the memberships of the "acme" organization. Each
+// user's individual profile is "profile-" + user ID; "admin" is an admin.
+type ownershipRepository struct {
+	profiles.Repository
+
+	profileKind string
+	memberships map[string]*profiles.ProfileMembership // membership ID -> membership
+	// ownerCountOverride simulates a concurrent change to the owners while the
+	// transfer runs; zero counts the memberships.
+	ownerCountOverride int64
+}
+
+func newOwnershipRepository() *ownershipRepository {
+	membership := func(id string, memberProfileID string, kind profiles.MembershipKind) *profiles.ProfileMembership {
+		return &profiles.ProfileMembership{ //nolint:exhaustruct
+			ID:              id,
+			ProfileID:       "acme-profile",
+			MemberProfileID: &memberProfileID,
+			Kind:            string(kind),
+		}
+	}
+
+	return &ownershipRepository{ //nolint:exhaustruct
+		profileKind: "organization",
+		memberships: map[string]*profiles.ProfileMembership{
+			"m-owner":      membership("m-owner", "profile-owner", profiles.MembershipKindOwner),
+			"m-maintainer": membership("m-maintainer", "profile-maintainer", profiles.MembershipKindMaintainer),
+			"m-follower":   membership("m-follower", "profile-follower", profiles.MembershipKindFollower),
+		},
+	}
+}
+
+func (r *ownershipRepository) membershipOf(memberProfileID string) *profiles.ProfileMembership {
+	for _, membership := range r.memberships {
+		if *membership.MemberProfileID == memberProfileID {
+			return membership
+		}
+	}
+
+	return nil
+}
+
+func (r *ownershipRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "acme" {
+		return "", nil
+	}
+
+	return "acme-profile", nil
+}
+
+func (r *ownershipRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+	kind := "regular"
+
+	if userID == "admin" {
+		kind = profiles.UserKindAdmin
+	}
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                kind,
+	}, nil
+}
+
+func (r *ownershipRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	membership := r.membershipOf(originProfileID)
+	if membership == nil {
+		return "", nil
+	}
+
+	return profiles.MembershipKind(membership.Kind), nil
+}
+
+func (r *ownershipRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ID: id, Kind: r.profileKind}, nil //nolint:exhaustruct
+}
+
+func (r *ownershipRepository) GetProfileMembershipByID(
+	_ context.Context,
+	id string,
+) (*profiles.ProfileMembership, error) {
+	return r.memberships[id], nil
+}
+
+func (r *ownershipRepository) GetProfileMembershipByProfileAndMember(
+	_ context.Context,
+	_ string,
+	memberProfileID string,
+) (*profiles.ProfileMembership, error) {
+	return r.membershipOf(memberProfileID), nil
+}
+
+func (r *ownershipRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *ownershipRepository) UpdateProfileMembership(
+	_ context.Context,
+	id string,
+	kind string,
+) error {
+	r.memberships[id].Kind = kind
+
+	return nil
+}
+
+func (r *ownershipRepository) CountProfileOwners(_ context.Context, _ string) (int64, error) {
+	if r.ownerCountOverride != 0 {
+		return r.ownerCountOverride, nil
+	}
+
+	var count int64
+
+	for _, membership := range r.memberships {
+		if membership.Kind == string(profiles.MembershipKindOwner) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *ownershipRepository) InvalidateMembershipKindCache(
+	_ context.Context,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+func (r *ownershipRepository) kinds() map[string]string {
+	kinds := make(map[string]string, len(r.memberships))
+	for id, membership := range r.memberships {
+		kinds[id] = membership.Kind
+	}
+
+	return kinds
+}
+
+func newOwnershipService(
+	repo *ownershipRepository,
+) (*profiles.Service, *recordingAuditRepository) {
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), auditRepo //nolint:exhaustruct
+}
+
+func TestTransferProfileOwnership(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		actorUserID string
+		demoteActor bool
+		expected    map[string]string
+	}{
+		"owner hands over and steps down": {
+			actorUserID: "owner",
+			demoteActor: true,
+			expected: map[string]string{
+				"m-owner":      "maintainer",
+				"m-maintainer": "owner",
+				"m-follower":   "follower",
+			},
+		},
+		"owner adds a co-owner": {
+			actorUserID: "owner",
+			demoteActor: false,
+			expected: map[string]string{
+				"m-owner":      "owner",
+				"m-maintainer": "owner",
+				"m-follower":   "follower",
+			},
+		},
+		"admin without membership": {
+			actorUserID: "admin",
+			demoteActor: true,
+			expected: map[string]string{
+				"m-owner":      "owner",
+				"m-maintainer": "owner",
+				"m-follower":   "follower",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newOwnershipRepository()
+			service, auditRepo := newOwnershipService(repo)
+
+			err := service.TransferProfileOwnership(
+				t.Context(), tt.actorUserID, "acme", "m-maintainer", tt.demoteActor,
+			)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repo.kinds())
+			require.Len(t, auditRepo.entries, 1)
+			assert.Equal(t, events.ProfileOwnershipTransferred, auditRepo.entries[0].EventType)
+		})
+	}
+}
+
+func TestTransferProfileOwnership_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		actorUserID  string
+		membershipID string
+		profileKind  string
+		expected     error
+	}{
+		"individual profile": {
+			actorUserID:  "owner",
+			membershipID: "m-maintainer",
+			profileKind:  profiles.ProfileKindIndividual,
+			expected:     profiles.ErrCannotTransferIndividualProfile,
+		},
+		"actor is not an owner": {
+			actorUserID:  "maintainer",
+			membershipID: "m-maintainer",
+			profileKind:  "organization",
+			expected:     profiles.ErrInsufficientAccess,
+		},
+		"target is already an owner": {
+			actorUserID:  "owner",
+			membershipID: "m-owner",
+			profileKind:  "organization",
+			expected:     profiles.ErrAlreadyOwner,
+		},
+		"target is a follower": {
+			actorUserID:  "owner",
+			membershipID: "m-follower",
+			profileKind:  "organization",
+			expected:     profiles.ErrInvalidMembershipKind,
+		},
+		"unknown membership": {
+			actorUserID:  "owner",
+			membershipID: "m-missing",
+			profileKind:  "organization",
+			expected:     profiles.ErrMembershipNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newOwnershipRepository()
+			repo.profileKind = tt.profileKind
+			before := repo.kinds()
+			service, auditRepo := newOwnershipService(repo)
+
+			err := service.TransferProfileOwnership(
+				t.Context(), tt.actorUserID, "acme", tt.membershipID, true,
+			)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, before, repo.kinds())
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
+
+func TestTransferProfileOwnership_LastOwnerGuard(t *testing.T) {
+	t.Parallel()
+
+	repo := newOwnershipRepository()
+	// The promoted owner is gone by the time the actor steps down.
+	repo.ownerCountOverride = 1
+	service, auditRepo := newOwnershipService(repo)
+
+	err := service.TransferProfileOwnership(t.Context(), "owner", "acme", "m-maintainer", true)
+
+	require.ErrorIs(t, err, profiles.ErrCannotRemoveLastOwner)
+	assert.Equal(t, "owner", repo.memberships["m-owner"].Kind)
+	assert.Empty(t, auditRepo.entries)
+}
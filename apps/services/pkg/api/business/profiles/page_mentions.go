@@ -0,0 +1,43 @@
+package profiles
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+)
+
+// SetMentionSyncer makes page saves refresh the "@slug" mentions of the saved
+// content.
+func (s *Service) SetMentionSyncer(syncer MentionSyncer) {
+	s.mentionSyncer = syncer
+}
+
+// syncPageMentions refreshes the stored mentions of a saved page translation.
+// Failures are logged and never fail the save.
+func (s *Service) syncPageMentions(
+	ctx context.Context,
+	userID string,
+	pageID string,
+	localeCode string,
+	content string,
+) {
+	if s.mentionSyncer == nil {
+		return
+	}
+
+	err := s.mentionSyncer.SyncMentions(
+		ctx,
+		userID,
+		profile_mentions.SourceKindPage,
+		pageID,
+		localeCode,
+		content,
+	)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to sync page mentions",
+			slog.String("page_id", pageID),
+			slog.String("locale", localeCode),
+			slog.String("error", err.Error()))
+	}
+}
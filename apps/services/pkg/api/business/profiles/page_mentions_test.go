@@ -0,0 +1,72 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageMentionsRepository stores translations of the "about" page of acme.
+type pageMentionsRepository struct {
+	pageValidationRepository
+}
+
+func (r *pageMentionsRepository) GetProfilePage(
+	_ context.Context,
+	id string,
+) (*profiles.ProfilePage, error) {
+	return &profiles.ProfilePage{ID: id}, nil //nolint:exhaustruct
+}
+
+func (r *pageMentionsRepository) ListProfilePageTxLocales(_ context.Context, _ string) ([]string, error) {
+	return []string{"en"}, nil
+}
+
+func (r *pageMentionsRepository) UpsertProfilePageTx(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+// recordingMentionSyncer keeps every sync request.
+type recordingMentionSyncer struct {
+	calls []string
+}
+
+func (s *recordingMentionSyncer) SyncMentions(
+	_ context.Context,
+	actorUserID string,
+	sourceKind string,
+	sourceID string,
+	localeCode string,
+	content string,
+) error {
+	s.calls = append(s.calls, actorUserID+" "+sourceKind+" "+sourceID+" "+localeCode+" "+content)
+
+	return nil
+}
+
+func TestUpdateProfilePageTranslation_SyncsMentions(t *testing.T) {
+	t.Parallel()
+
+	auditService, _ := newRecordingAuditService()
+	service := profiles.NewService(nil, &profiles.Config{}, &pageMentionsRepository{}, auditService) //nolint:exhaustruct
+
+	syncer := &recordingMentionSyncer{} //nolint:exhaustruct
+	service.SetMentionSyncer(syncer)
+
+	err := service.UpdateProfilePageTranslation(
+		t.Context(), "maintainer", "", "acme", "page-about", "en", "About", "", "Thanks @jane",
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"maintainer page page-about en Thanks @jane"}, syncer.calls)
+}
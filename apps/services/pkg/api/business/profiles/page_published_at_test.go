@@ -0,0 +1,173 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageStoreRepository keeps a single profile page in memory, converting the
+// published_at string the way the storage layer does.
+type pageStoreRepository struct {
+	profiles.Repository
+
+	page   *profiles.ProfilePage
+	writes int
+}
+
+func (r *pageStoreRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "profile-1", nil
+}
+
+func (r *pageStoreRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *pageStoreRepository) ListProfilePagesByProfileID(
+	_ context.Context,
+	_ string,
+	_ string,
+) ([]*profiles.ProfilePageBrief, error) {
+	return nil, nil
+}
+
+func (r *pageStoreRepository) GetProfilePageByProfileIDAndSlug(
+	_ context.Context,
+	_ string,
+	_ string,
+	slug string,
+) (*profiles.ProfilePage, error) {
+	if r.page == nil || r.page.Slug != slug {
+		return nil, nil //nolint:nilnil
+	}
+
+	return r.page, nil
+}
+
+func (r *pageStoreRepository) GetProfilePage(_ context.Context, _ string) (*profiles.ProfilePage, error) {
+	return r.page, nil
+}
+
+func (r *pageStoreRepository) CreateProfilePage(
+	_ context.Context,
+	pageID string,
+	slug string,
+	_ string,
+	_ int,
+	_ *string,
+	publishedAt *string,
+	_ *string,
+	visibility string,
+) (*profiles.ProfilePage, error) {
+	r.writes++
+	r.page = &profiles.ProfilePage{ //nolint:exhaustruct
+		ID:          pageID,
+		Slug:        slug,
+		Visibility:  profiles.PageVisibility(visibility),
+		PublishedAt: parseStoredTime(publishedAt),
+	}
+
+	return r.page, nil
+}
+
+func (r *pageStoreRepository) CreateProfilePageTx(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return nil
+}
+
+func (r *pageStoreRepository) UpdateProfilePage(
+	_ context.Context,
+	_ string,
+	slug string,
+	_ int,
+	_ *string,
+	publishedAt *string,
+	_ string,
+) error {
+	r.writes++
+	r.page.Slug = slug
+	r.page.PublishedAt = parseStoredTime(publishedAt)
+
+	return nil
+}
+
+func parseStoredTime(value *string) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		panic(err)
+	}
+
+	return &parsed
+}
+
+func newPageStoreService() (*profiles.Service, *pageStoreRepository) {
+	repo := &pageStoreRepository{} //nolint:exhaustruct
+	auditService, _ := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo //nolint:exhaustruct
+}
+
+func TestProfilePage_ScheduledPublishedAt(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newPageStoreService()
+	scheduledAt := time.Now().UTC().Add(7 * 24 * time.Hour).Truncate(time.Second)
+	scheduled := scheduledAt.Format(time.RFC3339)
+
+	created, err := service.CreateProfilePage(
+		t.Context(), "user-1", profiles.UserKindAdmin, "acme", "launch", "en",
+		"Launch", "", "", nil, &scheduled, "public",
+	)
+	require.NoError(t, err)
+	require.NotNil(t, created.PublishedAt)
+	assert.True(t, scheduledAt.Equal(*created.PublishedAt))
+
+	rescheduledAt := scheduledAt.Add(48 * time.Hour)
+	rescheduled := rescheduledAt.Format(time.RFC3339)
+
+	updated, err := service.UpdateProfilePage(
+		t.Context(), "user-1", profiles.UserKindAdmin, "acme", created.ID, "launch", 1,
+		nil, &rescheduled, "public",
+	)
+	require.NoError(t, err)
+	require.NotNil(t, updated.PublishedAt)
+	assert.True(t, rescheduledAt.Equal(*updated.PublishedAt))
+}
+
+func TestProfilePage_MalformedPublishedAt(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newPageStoreService()
+	malformed := "2026-13-45"
+
+	_, err := service.CreateProfilePage(
+		t.Context(), "user-1", profiles.UserKindAdmin, "acme", "launch", "en",
+		"Launch", "", "", nil, &malformed, "public",
+	)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+	assert.Zero(t, repo.writes)
+
+	_, err = service.UpdateProfilePage(
+		t.Context(), "user-1", profiles.UserKindAdmin, "acme", "page-1", "launch", 1,
+		nil, &malformed, "public",
+	)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+	assert.Zero(t, repo.writes)
+}
@@ -0,0 +1,50 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// PublishDuePages makes draft pages whose published_at is at or before now
+// public and returns how many were published. Publishing is conditional on the
+// page still being a draft, so a page is never published (or audited) twice.
+func (s *Service) PublishDuePages(ctx context.Context, now time.Time, limit int) (int, error) {
+	pages, err := s.repo.ListDueDraftProfilePages(ctx, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	published := 0
+
+	for _, page := range pages {
+		ok, err := s.repo.PublishDraftProfilePage(ctx, page.ID)
+		if err != nil {
+			return published, fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, page.ID, err)
+		}
+
+		if !ok {
+			continue
+		}
+
+		published++
+
+		s.auditService.Record(ctx, events.AuditParams{
+			EventType:  events.ProfilePagePublished,
+			EntityType: "profile_page",
+			EntityID:   page.ID,
+			ActorID:    nil,
+			ActorKind:  events.ActorSystem,
+			SessionID:  nil,
+			Payload: map[string]any{
+				"profile_id":   page.ProfileID,
+				"slug":         page.Slug,
+				"published_at": page.PublishedAt.Format(time.RFC3339),
+			},
+		})
+	}
+
+	return published, nil
+}
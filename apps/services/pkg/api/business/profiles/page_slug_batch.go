@@ -0,0 +1,163 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxPageSlugBatchSize caps how many slugs a single batch availability check
+// may contain.
+const MaxPageSlugBatchSize = 100
+
+// PageSlugUsage is a page, possibly deleted, that holds a slug.
+type PageSlugUsage struct {
+	PageID  string
+	Slug    string
+	Deleted bool
+}
+
+// PageSlugAvailability is the availability of one slug in a batch check.
+type PageSlugAvailability struct {
+	Slug string `json:"slug"`
+	SlugAvailabilityResult
+}
+
+// CheckPageSlugsAvailability checks many page slugs within a profile at once,
+// with the same rules as CheckPageSlugAvailability. Results keep the order of
+// the input; a slug repeated in the input is reported as unavailable after its
+// first occurrence, since only one page can take it.
+func (s *Service) CheckPageSlugsAvailability(
+	ctx context.Context,
+	profileSlug string,
+	pageSlugs []string,
+	excludePageID *string,
+	includeDeleted bool,
+) ([]*PageSlugAvailability, error) {
+	if len(pageSlugs) > MaxPageSlugBatchSize {
+		return nil, fmt.Errorf(
+			"%w: at most %d slugs can be checked at once, got %d",
+			ErrInvalidInput,
+			MaxPageSlugBatchSize,
+			len(pageSlugs),
+		)
+	}
+
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	candidates := make([]string, 0, len(pageSlugs))
+
+	for _, pageSlug := range pageSlugs {
+		if len(pageSlug) >= minSlugLength {
+			candidates = append(candidates, pageSlug)
+		}
+	}
+
+	usagesBySlug := make(map[string][]*PageSlugUsage, len(candidates))
+
+	if len(candidates) > 0 {
+		usages, usageErr := s.repo.ListPageSlugUsages(ctx, profileID, candidates)
+		if usageErr != nil {
+			return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, usageErr)
+		}
+
+		for _, usage := range usages {
+			usagesBySlug[usage.Slug] = append(usagesBySlug[usage.Slug], usage)
+		}
+	}
+
+	results := make([]*PageSlugAvailability, len(pageSlugs))
+	seen := make(map[string]bool, len(pageSlugs))
+
+	for i, pageSlug := range pageSlugs {
+		result := pageSlugAvailabilityFromUsages(
+			pageSlug,
+			usagesBySlug[pageSlug],
+			excludePageID,
+			includeDeleted,
+		)
+
+		if result.Available && seen[pageSlug] {
+			result = SlugAvailabilityResult{
+				Available: false,
+				Message:   "This slug appears more than once in the list",
+				Severity:  SeverityError,
+			}
+		}
+
+		seen[pageSlug] = true
+		results[i] = &PageSlugAvailability{
+			Slug:                   pageSlug,
+			SlugAvailabilityResult: result,
+		}
+	}
+
+	return results, nil
+}
+
+// pageSlugAvailabilityFromUsages decides availability from the pages holding
+// a slug, mirroring CheckPageSlugAvailability.
+func pageSlugAvailabilityFromUsages(
+	pageSlug string,
+	usages []*PageSlugUsage,
+	excludePageID *string,
+	includeDeleted bool,
+) SlugAvailabilityResult {
+	if len(pageSlug) < minSlugLength {
+		return SlugAvailabilityResult{
+			Available: false,
+			Message:   "Slug must be at least 2 characters",
+			Severity:  SeverityError,
+		}
+	}
+
+	deletedFound := false
+
+	for _, usage := range usages {
+		if usage.Deleted {
+			deletedFound = true
+
+			continue
+		}
+
+		if excludePageID != nil && usage.PageID == *excludePageID {
+			continue
+		}
+
+		return SlugAvailabilityResult{
+			Available: false,
+			Message:   "This slug is already taken",
+			Severity:  SeverityError,
+		}
+	}
+
+	if includeDeleted && deletedFound && !hasActiveUsage(usages) {
+		return SlugAvailabilityResult{
+			Available: false,
+			Message:   "This slug was previously used",
+			Severity:  SeverityError,
+		}
+	}
+
+	return SlugAvailabilityResult{
+		Available: true,
+		Message:   "",
+		Severity:  "",
+	}
+}
+
+func hasActiveUsage(usages []*PageSlugUsage) bool {
+	for _, usage := range usages {
+		if !usage.Deleted {
+			return true
+		}
+	}
+
+	return false
+}
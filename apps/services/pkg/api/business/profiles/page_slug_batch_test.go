@@ -0,0 +1,166 @@
+package profiles_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageSlugRepository holds the pages of the "acme" profile and counts the
+// slug lookups it serves.
+type pageSlugRepository struct {
+	profiles.Repository
+
+	usages  []*profiles.PageSlugUsage
+	lookups int
+}
+
+func (r *pageSlugRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "acme" {
+		return "", nil
+	}
+
+	return "acme-profile", nil
+}
+
+func (r *pageSlugRepository) ListPageSlugUsages(
+	_ context.Context,
+	_ string,
+	pageSlugs []string,
+) ([]*profiles.PageSlugUsage, error) {
+	r.lookups++
+
+	wanted := make(map[string]bool, len(pageSlugs))
+	for _, slug := range pageSlugs {
+		wanted[slug] = true
+	}
+
+	var result []*profiles.PageSlugUsage
+
+	for _, usage := range r.usages {
+		if wanted[usage.Slug] {
+			result = append(result, usage)
+		}
+	}
+
+	return result, nil
+}
+
+func newPageSlugRepository() *pageSlugRepository {
+	return &pageSlugRepository{ //nolint:exhaustruct
+		usages: []*profiles.PageSlugUsage{
+			{PageID: "page-about", Slug: "about", Deleted: false},
+			{PageID: "page-old", Slug: "archive", Deleted: true},
+			{PageID: "page-old-contact", Slug: "contact", Deleted: true},
+			{PageID: "page-contact", Slug: "contact", Deleted: false},
+		},
+	}
+}
+
+func TestCheckPageSlugsAvailability(t *testing.T) {
+	t.Parallel()
+
+	aboutID := "page-about"
+
+	tests := map[string]struct {
+		excludePageID  *string
+		includeDeleted bool
+		expected       map[string]string
+	}{
+		"active pages only": {
+			expected: map[string]string{
+				"about":   "This slug is already taken",
+				"archive": "",
+				"contact": "This slug is already taken",
+				"x":       "Slug must be at least 2 characters",
+				"fresh":   "",
+			},
+		},
+		"deleted pages included": {
+			includeDeleted: true,
+			expected: map[string]string{
+				"about":   "This slug is already taken",
+				"archive": "This slug was previously used",
+				"contact": "This slug is already taken",
+				"x":       "Slug must be at least 2 characters",
+				"fresh":   "",
+			},
+		},
+		"editing the page that holds a slug": {
+			excludePageID: &aboutID,
+			expected: map[string]string{
+				"about":   "",
+				"archive": "",
+				"contact": "This slug is already taken",
+				"x":       "Slug must be at least 2 characters",
+				"fresh":   "",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newPageSlugRepository()
+			service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+			slugs := []string{"about", "archive", "contact", "x", "fresh"}
+
+			results, err := service.CheckPageSlugsAvailability(
+				t.Context(), "acme", slugs, tt.excludePageID, tt.includeDeleted,
+			)
+			require.NoError(t, err)
+			require.Len(t, results, len(slugs))
+
+			messages := make(map[string]string, len(results))
+			for i, result := range results {
+				assert.Equal(t, slugs[i], result.Slug)
+				assert.Equal(t, result.Message == "", result.Available)
+				messages[result.Slug] = result.Message
+			}
+
+			assert.Equal(t, tt.expected, messages)
+			assert.Equal(t, 1, repo.lookups)
+		})
+	}
+}
+
+func TestCheckPageSlugsAvailability_RepeatedSlug(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, &profiles.Config{}, newPageSlugRepository(), nil) //nolint:exhaustruct
+
+	results, err := service.CheckPageSlugsAvailability(
+		t.Context(), "acme", []string{"fresh", "fresh"}, nil, false,
+	)
+	require.NoError(t, err)
+
+	assert.True(t, results[0].Available)
+	assert.False(t, results[1].Available)
+	assert.Equal(t, profiles.SeverityError, results[1].Severity)
+}
+
+func TestCheckPageSlugsAvailability_Rejected(t *testing.T) {
+	t.Parallel()
+
+	repo := newPageSlugRepository()
+	service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+	tooMany := make([]string, profiles.MaxPageSlugBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = "page-" + strconv.Itoa(i)
+	}
+
+	_, err := service.CheckPageSlugsAvailability(t.Context(), "acme", tooMany, nil, false)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+
+	_, err = service.CheckPageSlugsAvailability(t.Context(), "missing", []string{"about"}, nil, false)
+	require.ErrorIs(t, err, profiles.ErrProfileNotFound)
+
+	assert.Zero(t, repo.lookups)
+}
@@ -0,0 +1,284 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+)
+
+// Page validation issue codes.
+const (
+	PageIssueRequired          = "required"
+	PageIssueInvalidFormat     = "invalid_format"
+	PageIssueTooLong           = "too_long"
+	PageIssueTooShort          = "too_short"
+	PageIssueSlugUnavailable   = "slug_unavailable"
+	PageIssueInvalidURI        = "invalid_uri"
+	PageIssueUnresolvedMention = "unresolved_mention"
+	PageIssueTooManyMentions   = "too_many_mentions"
+)
+
+const (
+	maxPageSlugLength  = 100
+	maxPageTitleLength = 200
+
+	// maxValidatedMentions bounds the slug lookups of a single validation; it
+	// matches the default cap on mentions linked per content.
+	maxValidatedMentions = 20
+)
+
+var pageSlugRegex = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// PageValidationInput is the page content to validate before it is saved.
+// PageID is set when validating an edit of an existing page.
+type PageValidationInput struct {
+	PageID          *string `json:"page_id"`
+	CoverPictureURI *string `json:"cover_picture_uri"`
+	PublishedAt     *string `json:"published_at"`
+	Slug            string  `json:"slug"`
+	Title           string  `json:"title"`
+	Summary         string  `json:"summary"`
+	Content         string  `json:"content"`
+}
+
+// PageValidationIssue is a single validation signal. Errors would make the save
+// fail; warnings are saved but may not render as the author expects.
+type PageValidationIssue struct {
+	Field    string `json:"field"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+// PageValidationMention is a "@slug" mention found in the content.
+type PageValidationMention struct {
+	Slug     string `json:"slug"`
+	Resolved bool   `json:"resolved"`
+}
+
+// PageValidationResult is the outcome of a dry-run page validation.
+type PageValidationResult struct {
+	Issues   []PageValidationIssue   `json:"issues"`
+	Mentions []PageValidationMention `json:"mentions"`
+	Valid    bool                    `json:"valid"`
+}
+
+func (r *PageValidationResult) addIssue(issue *PageValidationIssue) {
+	r.add(issue.Field, issue.Code, issue.Severity, issue.Message)
+}
+
+func (r *PageValidationResult) add(field string, code string, severity string, message string) {
+	r.Issues = append(r.Issues, PageValidationIssue{
+		Field:    field,
+		Code:     code,
+		Message:  message,
+		Severity: severity,
+	})
+
+	if severity == SeverityError {
+		r.Valid = false
+	}
+}
+
+// CheckPageSlug returns the problem with a page slug, or nil when it is valid.
+// The slug is expected to be sanitized already.
+func CheckPageSlug(slug string) *PageValidationIssue {
+	switch {
+	case slug == "":
+		return newPageIssue("slug", PageIssueRequired, "Slug is required")
+	case len(slug) < minSlugLength:
+		return newPageIssue("slug", PageIssueTooShort, "Slug must be at least 2 characters")
+	case len(slug) > maxPageSlugLength:
+		return newPageIssue("slug", PageIssueTooLong, "Slug must be at most 100 characters")
+	case !pageSlugRegex.MatchString(slug):
+		return newPageIssue("slug", PageIssueInvalidFormat,
+			"Slug can only contain lowercase letters, numbers, and hyphens")
+	default:
+		return nil
+	}
+}
+
+// CheckPageTitle returns the problem with a page title, or nil when it is valid.
+func CheckPageTitle(title string) *PageValidationIssue {
+	title = strings.TrimSpace(title)
+
+	switch {
+	case title == "":
+		return newPageIssue("title", PageIssueRequired, "Title is required")
+	case len(title) > maxPageTitleLength:
+		return newPageIssue("title", PageIssueTooLong, "Title is too long")
+	default:
+		return nil
+	}
+}
+
+func newPageIssue(field string, code string, message string) *PageValidationIssue {
+	return &PageValidationIssue{
+		Field:    field,
+		Code:     code,
+		Message:  message,
+		Severity: SeverityError,
+	}
+}
+
+// err turns the issue into the ErrInvalidInput a page save fails with.
+func (i *PageValidationIssue) err() error {
+	return fmt.Errorf("%w: %s", ErrInvalidInput, i.Message)
+}
+
+// ValidateProfilePage runs the checks of CreateProfilePage and UpdateProfilePage
+// without saving anything, and reports every problem found instead of the first
+// one. It also resolves "@slug" mentions, which are reported as warnings.
+func (s *Service) ValidateProfilePage( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID string,
+	userKind string,
+	localeCode string,
+	profileSlug string,
+	input *PageValidationInput,
+) (*PageValidationResult, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+	if accessErr != nil {
+		return nil, accessErr
+	}
+
+	result := &PageValidationResult{
+		Issues:   []PageValidationIssue{},
+		Mentions: []PageValidationMention{},
+		Valid:    true,
+	}
+
+	slug := strings.TrimSpace(input.Slug)
+
+	if issue := CheckPageSlug(slug); issue != nil {
+		result.addIssue(issue)
+	} else {
+		slugResult, slugErr := s.CheckPageSlugAvailability(
+			ctx, localeCode, profileSlug, slug, input.PageID, false,
+		)
+		if slugErr != nil {
+			return nil, slugErr
+		}
+
+		if !slugResult.Available {
+			result.add("slug", PageIssueSlugUnavailable, slugResult.Severity, slugResult.Message)
+		}
+	}
+
+	if issue := CheckPageTitle(input.Title); issue != nil {
+		result.addIssue(issue)
+	}
+
+	if timestampErr := validateOptionalTimestamp("published_at", input.PublishedAt); timestampErr != nil {
+		result.add("published_at", PageIssueInvalidFormat, SeverityError,
+			"Published at must be an RFC 3339 timestamp")
+	}
+
+	if uriErr := validateOptionalURL(input.CoverPictureURI); uriErr != nil {
+		result.add("cover_picture_uri", PageIssueInvalidURI, SeverityError,
+			"Cover picture must be an http or https URL")
+	} else if prefixErr := s.validateCoverPicturePrefix(userKind, input.CoverPictureURI); prefixErr != nil {
+		result.add("cover_picture_uri", PageIssueInvalidURI, SeverityError,
+			"Cover picture must be uploaded to an allowed location")
+	}
+
+	if contentErr := s.validatePageContentLength(input.Content); contentErr != nil {
+		result.add("content", PageIssueTooLong, SeverityError, fmt.Sprintf(
+			"Content must be at most %d characters", s.maxPageContentLength(),
+		))
+	}
+
+	err = s.validatePageMentions(ctx, input.Content, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// validatePageMentions resolves the mentions in content and reports the ones
+// that will render as plain text.
+func (s *Service) validatePageMentions(
+	ctx context.Context,
+	content string,
+	result *PageValidationResult,
+) error {
+	slugs := profile_mentions.ExtractMentionSlugs(content)
+
+	if len(slugs) > maxValidatedMentions {
+		result.add("content", PageIssueTooManyMentions, SeverityWarning, fmt.Sprintf(
+			"Only the first %d mentioned profiles will be linked", maxValidatedMentions,
+		))
+
+		slugs = slugs[:maxValidatedMentions]
+	}
+
+	for _, slug := range slugs {
+		mentionedID, err := s.repo.GetProfileIDBySlug(ctx, slug)
+		if err != nil {
+			return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+		}
+
+		resolved := mentionedID != ""
+		result.Mentions = append(result.Mentions, PageValidationMention{
+			Slug:     slug,
+			Resolved: resolved,
+		})
+
+		if !resolved {
+			result.add("content", PageIssueUnresolvedMention, SeverityWarning,
+				"@"+slug+" does not match a profile and will not be linked")
+		}
+	}
+
+	return nil
+}
+
+// validatePageContentLength fails with ErrInvalidInput when content exceeds the
+// configured maximum. A zero maximum disables the check.
+func (s *Service) validatePageContentLength(content string) error {
+	limit := s.maxPageContentLength()
+	if limit <= 0 {
+		return nil
+	}
+
+	length := utf8.RuneCountInString(content)
+	if length > limit {
+		return fmt.Errorf("%w: content is %d characters, limit is %d", ErrInvalidInput, length, limit)
+	}
+
+	return nil
+}
+
+// maxPageContentLength returns the configured page content limit, or zero when
+// there is none.
+func (s *Service) maxPageContentLength() int {
+	if s.config == nil {
+		return 0
+	}
+
+	return s.config.MaxPageContentLength
+}
+
+// validateCoverPicturePrefix restricts non-admin users to cover pictures from
+// the allowed URI prefixes.
+func (s *Service) validateCoverPicturePrefix(userKind string, coverPictureURI *string) error {
+	if userKind == UserKindAdmin || s.config == nil {
+		return nil
+	}
+
+	return validateURIPrefixes(coverPictureURI, s.config.GetAllowedURIPrefixes())
+}
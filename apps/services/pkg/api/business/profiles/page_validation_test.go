@@ -0,0 +1,259 @@
+package profiles_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageValidationRepository knows the "acme" and "jane" profiles, an "about" page
+// on acme, and lets only the user "maintainer" edit acme.
+type pageValidationRepository struct {
+	profiles.Repository
+}
+
+func (r *pageValidationRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	switch slug {
+	case "acme":
+		return "acme-profile", nil
+	case "jane":
+		return "jane-profile", nil
+	default:
+		return "", nil
+	}
+}
+
+func (r *pageValidationRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *pageValidationRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	if originProfileID == "profile-maintainer" {
+		return profiles.MembershipKindMaintainer, nil
+	}
+
+	return "", nil
+}
+
+func (r *pageValidationRepository) GetProfilePageByProfileIDAndSlug(
+	_ context.Context,
+	_ string,
+	_ string,
+	pageSlug string,
+) (*profiles.ProfilePage, error) {
+	if pageSlug == "about" {
+		return &profiles.ProfilePage{ID: "page-about"}, nil //nolint:exhaustruct
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func newPageValidationService() *profiles.Service {
+	return profiles.NewService(
+		nil,
+		&profiles.Config{ //nolint:exhaustruct
+			AllowedURIPrefixes:   "https://objects.aya.is/",
+			MaxPageContentLength: 50,
+		},
+		&pageValidationRepository{}, //nolint:exhaustruct
+		nil,
+	)
+}
+
+func validPageInput() *profiles.PageValidationInput {
+	return &profiles.PageValidationInput{ //nolint:exhaustruct
+		Slug:    "projects",
+		Title:   "Projects",
+		Content: "Built with @jane.",
+	}
+}
+
+func issueCodes(result *profiles.PageValidationResult) []string {
+	codes := make([]string, len(result.Issues))
+	for i, issue := range result.Issues {
+		codes[i] = issue.Field + ":" + issue.Code + ":" + issue.Severity
+	}
+
+	return codes
+}
+
+func TestValidateProfilePage(t *testing.T) {
+	t.Parallel()
+
+	pageID := "page-about"
+	badCover := "ftp://example.com/cover.png"
+	foreignCover := "https://example.com/cover.png"
+	badPublishedAt := "yesterday"
+
+	tests := map[string]struct {
+		modify   func(input *profiles.PageValidationInput)
+		expected []string
+		valid    bool
+	}{
+		"valid page": {
+			modify:   func(_ *profiles.PageValidationInput) {},
+			expected: []string{},
+			valid:    true,
+		},
+		"missing slug and title": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.Slug = ""
+				input.Title = "  "
+			},
+			expected: []string{"slug:required:error", "title:required:error"},
+		},
+		"malformed slug": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.Slug = "My Page"
+			},
+			expected: []string{"slug:invalid_format:error"},
+		},
+		"slug taken by another page": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.Slug = "about"
+			},
+			expected: []string{"slug:slug_unavailable:error"},
+		},
+		"own slug while editing": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.Slug = "about"
+				input.PageID = &pageID
+			},
+			expected: []string{},
+			valid:    true,
+		},
+		"malformed published at": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.PublishedAt = &badPublishedAt
+			},
+			expected: []string{"published_at:invalid_format:error"},
+		},
+		"cover picture with another scheme": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.CoverPictureURI = &badCover
+			},
+			expected: []string{"cover_picture_uri:invalid_uri:error"},
+		},
+		"cover picture from another host": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.CoverPictureURI = &foreignCover
+			},
+			expected: []string{"cover_picture_uri:invalid_uri:error"},
+		},
+		"oversized content": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.Content = strings.Repeat("a", 51)
+			},
+			expected: []string{"content:too_long:error"},
+		},
+		"unresolved mention": {
+			modify: func(input *profiles.PageValidationInput) {
+				input.Content = "Thanks @jane and @nobody."
+			},
+			expected: []string{"content:unresolved_mention:warning"},
+			valid:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			input := validPageInput()
+			tt.modify(input)
+
+			result, err := newPageValidationService().ValidateProfilePage(
+				t.Context(), "maintainer", "regular", "en", "acme", input,
+			)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, issueCodes(result))
+			assert.Equal(t, tt.valid, result.Valid)
+		})
+	}
+}
+
+func TestValidateProfilePage_Mentions(t *testing.T) {
+	t.Parallel()
+
+	input := validPageInput()
+	input.Content = "Thanks @jane and @nobody!"
+
+	result, err := newPageValidationService().ValidateProfilePage(
+		t.Context(), "maintainer", "regular", "en", "acme", input,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []profiles.PageValidationMention{
+		{Slug: "jane", Resolved: true},
+		{Slug: "nobody", Resolved: false},
+	}, result.Mentions)
+}
+
+func TestValidateProfilePage_RequiresMaintainer(t *testing.T) {
+	t.Parallel()
+
+	_, err := newPageValidationService().ValidateProfilePage(
+		t.Context(), "stranger", "regular", "en", "acme", validPageInput(),
+	)
+	require.ErrorIs(t, err, profiles.ErrInsufficientAccess)
+
+	_, err = newPageValidationService().ValidateProfilePage(
+		t.Context(), "maintainer", "regular", "en", "missing", validPageInput(),
+	)
+	require.ErrorIs(t, err, profiles.ErrProfileNotFound)
+}
+
+func TestValidateProfilePage_WithoutConfig(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, nil, &pageValidationRepository{}, nil) //nolint:exhaustruct
+	cover := "https://example.com/cover.png"
+
+	input := validPageInput()
+	input.CoverPictureURI = &cover
+	input.Content = strings.Repeat("a", 500)
+
+	result, err := service.ValidateProfilePage(t.Context(), "maintainer", "regular", "en", "acme", input)
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+}
+
+func TestProfilePageSaves_RunValidationChecks(t *testing.T) {
+	t.Parallel()
+
+	service := newPageValidationService()
+
+	_, err := service.CreateProfilePage(
+		t.Context(), "maintainer", "regular", "acme", "My Page", "en",
+		"Projects", "", "", nil, nil, "public",
+	)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+
+	_, err = service.CreateProfilePage(
+		t.Context(), "maintainer", "regular", "acme", "projects", "en",
+		strings.Repeat("t", 201), "", "", nil, nil, "public",
+	)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+
+	_, err = service.UpdateProfilePage(
+		t.Context(), "maintainer", "regular", "acme", "page-about", "a", 1, nil, nil, "public",
+	)
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+}
@@ -0,0 +1,131 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// PostImportLinkKind is the kind of profile links whose recent posts Import
+// brings in.
+const PostImportLinkKind = "x"
+
+var ErrFailedToImportPosts = errors.New("failed to import posts")
+
+// PostImportLink is a profile link whose recent posts can be imported.
+type PostImportLink struct {
+	RemoteID        *string
+	AuthAccessToken *string
+	ID              string
+	ProfileID       string
+}
+
+// Import stores the recent posts of every X link as imports of the link, so
+// they are attributed to the link's profile. A post already imported is
+// updated instead of added again. Links without a stored access token are
+// skipped, and the import pauses between profiles to respect rate limits.
+func (s *Service) Import(ctx context.Context, fetcher RecentPostsFetcher) error {
+	links, err := s.repo.ListProfileLinksForPostImport(ctx, PostImportLinkKind)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	var (
+		firstErr    error
+		lastProfile string
+	)
+
+	for _, link := range links {
+		if link.RemoteID == nil || link.AuthAccessToken == nil || *link.AuthAccessToken == "" {
+			continue
+		}
+
+		if lastProfile != "" && lastProfile != link.ProfileID {
+			err := s.waitBetweenImports(ctx)
+			if err != nil {
+				return err
+			}
+		}
+
+		lastProfile = link.ProfileID
+
+		err := s.importLinkPosts(ctx, fetcher, link)
+		if err != nil {
+			s.logger.WarnContext(ctx, "Failed to import posts",
+				slog.String("link_id", link.ID),
+				slog.String("profile_id", link.ProfileID),
+				slog.Any("error", err))
+
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+
+	return firstErr
+}
+
+// importLinkPosts fetches the recent posts of a link and upserts them by
+// remote post ID.
+func (s *Service) importLinkPosts(
+	ctx context.Context,
+	fetcher RecentPostsFetcher,
+	link *PostImportLink,
+) error {
+	posts, err := fetcher.GetRecentPostsByUsername(ctx, *link.RemoteID, *link.AuthAccessToken)
+	if err != nil {
+		return fmt.Errorf("%w(link_id: %s): %w", ErrFailedToImportPosts, link.ID, err)
+	}
+
+	for _, post := range posts {
+		properties := map[string]any{
+			"content":   post.Content,
+			"permalink": post.Permalink,
+		}
+
+		if post.CreatedAt != nil {
+			properties["created_at"] = post.CreatedAt.Format(time.RFC3339)
+		}
+
+		importID, err := s.repo.GetProfileLinkImportIDByRemoteID(ctx, link.ID, post.ID)
+		if err != nil {
+			return fmt.Errorf("%w(link_id: %s): %w", ErrFailedToImportPosts, link.ID, err)
+		}
+
+		if importID != "" {
+			err = s.repo.UpdateProfileLinkImport(ctx, importID, properties)
+		} else {
+			err = s.repo.CreateProfileLinkImport(
+				ctx,
+				string(s.idGenerator()),
+				link.ID,
+				post.ID,
+				properties,
+			)
+		}
+
+		if err != nil {
+			return fmt.Errorf("%w(link_id: %s): %w", ErrFailedToImportPosts, link.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) waitBetweenImports(ctx context.Context) error {
+	if s.config == nil || s.config.ImportProfileDelay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(s.config.ImportProfileDelay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
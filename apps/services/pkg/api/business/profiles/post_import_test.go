@@ -0,0 +1,111 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type postImportRepository struct {
+	profiles.Repository
+
+	links   []*profiles.PostImportLink
+	imports map[string]map[string]any // link ID + remote ID -> properties
+	created int
+}
+
+func (r *postImportRepository) ListProfileLinksForPostImport(
+	_ context.Context,
+	_ string,
+) ([]*profiles.PostImportLink, error) {
+	return r.links, nil
+}
+
+func (r *postImportRepository) GetProfileLinkImportIDByRemoteID(
+	_ context.Context,
+	linkID string,
+	remoteID string,
+) (string, error) {
+	if _, ok := r.imports[linkID+"/"+remoteID]; !ok {
+		return "", nil
+	}
+
+	return linkID + "/" + remoteID, nil
+}
+
+func (r *postImportRepository) CreateProfileLinkImport(
+	_ context.Context,
+	_ string,
+	linkID string,
+	remoteID string,
+	properties map[string]any,
+) error {
+	r.imports[linkID+"/"+remoteID] = properties
+	r.created++
+
+	return nil
+}
+
+func (r *postImportRepository) UpdateProfileLinkImport(
+	_ context.Context,
+	id string,
+	properties map[string]any,
+) error {
+	r.imports[id] = properties
+
+	return nil
+}
+
+// fakePostsFetcher returns the same posts for every account it is asked for.
+type fakePostsFetcher struct {
+	posts     []*profiles.ExternalPost
+	usernames []string
+}
+
+func (f *fakePostsFetcher) GetRecentPostsByUsername(
+	_ context.Context,
+	username string,
+	_ string,
+) ([]*profiles.ExternalPost, error) {
+	f.usernames = append(f.usernames, username)
+
+	return f.posts, nil
+}
+
+func TestService_ImportDeduplicatesPosts(t *testing.T) {
+	t.Parallel()
+
+	remoteID := "eser"
+	token := "token"
+	postedAt := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	repo := &postImportRepository{ //nolint:exhaustruct
+		links: []*profiles.PostImportLink{
+			{ID: "link-1", ProfileID: "profile-1", RemoteID: &remoteID, AuthAccessToken: &token},
+			// No stored access token: skipped
+			{ID: "link-2", ProfileID: "profile-2", RemoteID: &remoteID, AuthAccessToken: nil},
+		},
+		imports: map[string]map[string]any{},
+	}
+	fetcher := &fakePostsFetcher{ //nolint:exhaustruct
+		posts: []*profiles.ExternalPost{
+			{ID: "post-1", Content: "first", Permalink: "https://x.com/eser/status/1", CreatedAt: &postedAt},
+			{ID: "post-2", Content: "second", Permalink: "https://x.com/eser/status/2", CreatedAt: nil},
+		},
+	}
+	service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+	require.NoError(t, service.Import(t.Context(), fetcher))
+	require.NoError(t, service.Import(t.Context(), fetcher))
+
+	assert.Equal(t, []string{"eser", "eser"}, fetcher.usernames)
+	assert.Equal(t, 2, repo.created)
+	assert.Len(t, repo.imports, 2)
+	assert.Equal(t, "first", repo.imports["link-1/post-1"]["content"])
+	assert.Equal(t, "2026-10-01T12:00:00Z", repo.imports["link-1/post-1"]["created_at"])
+	assert.Equal(t, "https://x.com/eser/status/2", repo.imports["link-1/post-2"]["permalink"])
+}
@@ -0,0 +1,82 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BlockedProfile is a profile the user blocked.
+type BlockedProfile struct {
+	Profile   *ProfileBrief `json:"profile"`
+	BlockedAt time.Time     `json:"blocked_at"`
+}
+
+// BlockProfile hides the content of a profile from the user: its stories are
+// left out of the user's followed feed, and the user is not suggested as a
+// member of it. Blocking a profile twice is not an error.
+func (s *Service) BlockProfile(ctx context.Context, userID string, profileSlug string) error {
+	profileID, err := s.getProfileIDForBlock(ctx, profileSlug)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.repo.GetUserBriefInfo(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%w(userID: %s): %w", ErrFailedToGetRecord, userID, err)
+	}
+
+	if user.IndividualProfileID != nil && *user.IndividualProfileID == profileID {
+		return fmt.Errorf("%w: you cannot block your own profile", ErrInvalidInput)
+	}
+
+	_, err = s.repo.InsertUserProfileBlock(ctx, userID, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToCreateRecord, profileID, err)
+	}
+
+	return nil
+}
+
+// UnblockProfile removes a profile from the user's block list. Unblocking a
+// profile that is not blocked is not an error.
+func (s *Service) UnblockProfile(ctx context.Context, userID string, profileSlug string) error {
+	profileID, err := s.getProfileIDForBlock(ctx, profileSlug)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.repo.DeleteUserProfileBlock(ctx, userID, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToDeleteRecord, profileID, err)
+	}
+
+	return nil
+}
+
+// ListBlockedProfiles returns the profiles the user blocked, most recent first.
+func (s *Service) ListBlockedProfiles(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+) ([]*BlockedProfile, error) {
+	blocks, err := s.repo.ListUserProfileBlocks(ctx, localeCode, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	return blocks, nil
+}
+
+func (s *Service) getProfileIDForBlock(ctx context.Context, profileSlug string) (string, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return "", fmt.Errorf("%w(slug: %s)", ErrProfileNotFound, profileSlug)
+	}
+
+	return profileID, nil
+}
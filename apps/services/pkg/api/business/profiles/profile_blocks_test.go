@@ -0,0 +1,105 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileBlockRepository keeps the block list of a single user, whose
+// individual profile is "me-id", in memory.
+type profileBlockRepository struct {
+	profiles.Repository
+
+	slugs   map[string]string // slug -> profile ID
+	blocked map[string]bool
+}
+
+func newProfileBlockRepository() *profileBlockRepository {
+	return &profileBlockRepository{
+		slugs:   map[string]string{"me": "me-id", "spammer": "spammer-id"},
+		blocked: map[string]bool{},
+	}
+}
+
+func (r *profileBlockRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	return r.slugs[slug], nil
+}
+
+func (r *profileBlockRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	profileID := "me-id"
+
+	return &profiles.UserBriefInfo{IndividualProfileID: &profileID, Kind: "regular"}, nil
+}
+
+func (r *profileBlockRepository) InsertUserProfileBlock(
+	_ context.Context,
+	_ string,
+	profileID string,
+) (bool, error) {
+	if r.blocked[profileID] {
+		return false, nil
+	}
+
+	r.blocked[profileID] = true
+
+	return true, nil
+}
+
+func (r *profileBlockRepository) DeleteUserProfileBlock(
+	_ context.Context,
+	_ string,
+	profileID string,
+) (bool, error) {
+	removed := r.blocked[profileID]
+	delete(r.blocked, profileID)
+
+	return removed, nil
+}
+
+func TestBlockProfile(t *testing.T) {
+	t.Parallel()
+
+	repo := newProfileBlockRepository()
+	service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+	// Blocking twice is not an error.
+	require.NoError(t, service.BlockProfile(t.Context(), "user", "spammer"))
+	require.NoError(t, service.BlockProfile(t.Context(), "user", "spammer"))
+	assert.True(t, repo.blocked["spammer-id"])
+
+	require.NoError(t, service.UnblockProfile(t.Context(), "user", "spammer"))
+	require.NoError(t, service.UnblockProfile(t.Context(), "user", "spammer"))
+	assert.Empty(t, repo.blocked)
+}
+
+func TestBlockProfile_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		slug     string
+		expected error
+	}{
+		"own profile":     {slug: "me", expected: profiles.ErrInvalidInput},
+		"unknown profile": {slug: "ghost", expected: profiles.ErrProfileNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newProfileBlockRepository()
+			service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+			err := service.BlockProfile(t.Context(), "user", tt.slug)
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, repo.blocked)
+		})
+	}
+}
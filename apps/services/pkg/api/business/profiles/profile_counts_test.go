@@ -0,0 +1,133 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileCountsRepository serves the calls made while loading a profile with its children.
+type profileCountsRepository struct {
+	profiles.Repository
+
+	profile *profiles.Profile
+}
+
+func (r *profileCountsRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return r.profile.ID, nil
+}
+
+func (r *profileCountsRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	_ string,
+) (*profiles.Profile, error) {
+	return r.profile, nil
+}
+
+func (r *profileCountsRepository) ListProfilePagesByProfileID(
+	_ context.Context,
+	_ string,
+	_ string,
+) ([]*profiles.ProfilePageBrief, error) {
+	return []*profiles.ProfilePageBrief{{}}, nil //nolint:exhaustruct
+}
+
+func (r *profileCountsRepository) ListFeaturedProfileLinksByProfileID(
+	_ context.Context,
+	_ string,
+	_ string,
+) ([]*profiles.ProfileLinkBrief, error) {
+	return nil, nil
+}
+
+func (r *profileCountsRepository) GetProfileCounts(
+	_ context.Context,
+	_ string,
+) (*profiles.ProfileCounts, error) {
+	members := int64(4)
+	contributions := int64(2)
+	links := int64(3)
+
+	return &profiles.ProfileCounts{
+		Members:       &members,
+		Contributions: &contributions,
+		Links:         &links,
+		Resources:     5,
+		Pages:         1,
+	}, nil
+}
+
+func countOf(n int64) *int64 {
+	return &n
+}
+
+func TestGetBySlugEx_Counts(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		featureRelations  profiles.ModuleVisibility
+		featureLinks      profiles.ModuleVisibility
+		wantMembers       *int64
+		wantContributions *int64
+		wantLinks         *int64
+	}{
+		"all modules enabled": {
+			featureRelations:  profiles.ModuleVisibilityPublic,
+			featureLinks:      profiles.ModuleVisibilityPublic,
+			wantMembers:       countOf(4),
+			wantContributions: countOf(2),
+			wantLinks:         countOf(3),
+		},
+		"hidden modules still count": {
+			featureRelations:  profiles.ModuleVisibilityHidden,
+			featureLinks:      profiles.ModuleVisibilityHidden,
+			wantMembers:       countOf(4),
+			wantContributions: countOf(2),
+			wantLinks:         countOf(3),
+		},
+		"relations disabled": {
+			featureRelations:  profiles.ModuleVisibilityDisabled,
+			featureLinks:      profiles.ModuleVisibilityPublic,
+			wantMembers:       nil,
+			wantContributions: nil,
+			wantLinks:         countOf(3),
+		},
+		"links disabled": {
+			featureRelations:  profiles.ModuleVisibilityPublic,
+			featureLinks:      profiles.ModuleVisibilityDisabled,
+			wantMembers:       countOf(4),
+			wantContributions: countOf(2),
+			wantLinks:         nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &profileCountsRepository{ //nolint:exhaustruct
+				profile: &profiles.Profile{ //nolint:exhaustruct
+					ID:               "profile-1",
+					FeatureRelations: string(tt.featureRelations),
+					FeatureLinks:     string(tt.featureLinks),
+				},
+			}
+			service := profiles.NewService(nil, nil, repo, nil)
+
+			result, err := service.GetBySlugEx(t.Context(), "en", "eser")
+
+			require.NoError(t, err)
+			require.NotNil(t, result.Counts)
+
+			assert.Equal(t, tt.wantMembers, result.Counts.Members)
+			assert.Equal(t, tt.wantContributions, result.Counts.Contributions)
+			assert.Equal(t, tt.wantLinks, result.Counts.Links)
+			assert.Equal(t, int64(5), result.Counts.Resources)
+			assert.Equal(t, int64(1), result.Counts.Pages)
+		})
+	}
+}
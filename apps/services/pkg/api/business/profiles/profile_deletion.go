@@ -0,0 +1,119 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var ErrProfileStillLinked = errors.New(
+	"profile is still the individual profile of a user",
+)
+
+// DeleteProfile soft-deletes a profile. It requires owner access or an admin.
+// An individual profile still linked to a user cannot be deleted; the user has
+// to be unlinked from it first.
+func (s *Service) DeleteProfile(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	profileSlug string,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	if userKind != UserKindAdmin {
+		err := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	linkedUserID, err := s.repo.GetUserIDByIndividualProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	if linkedUserID != nil {
+		return fmt.Errorf("%w(id: %s, user_id: %s)", ErrProfileStillLinked, profileID, *linkedUserID)
+	}
+
+	deleted, err := s.repo.SoftDeleteProfile(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToDeleteRecord, profileID, err)
+	}
+
+	if !deleted {
+		return ErrProfileNotFound
+	}
+
+	_ = s.repo.InvalidateProfileSlugCache(ctx, profileSlug)
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileDeleted,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"slug": profileSlug,
+		},
+	})
+
+	return nil
+}
+
+// RestoreProfile brings back a soft-deleted profile. Admin only.
+func (s *Service) RestoreProfile(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	profileSlug string,
+) error {
+	if userKind != UserKindAdmin {
+		return ErrUnauthorized
+	}
+
+	profileID, err := s.repo.GetDeletedProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	restored, err := s.repo.RestoreProfile(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, profileID, err)
+	}
+
+	if !restored {
+		return ErrProfileNotFound
+	}
+
+	_ = s.repo.InvalidateProfileSlugCache(ctx, profileSlug)
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileRestored,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"slug": profileSlug,
+		},
+	})
+
+	return nil
+}
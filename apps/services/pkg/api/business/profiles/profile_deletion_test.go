@@ -0,0 +1,213 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileDeletionRepository serves the calls made while deleting and restoring
+// the "target" profile. Each user's individual profile is "profile-" + user ID.
+type profileDeletionRepository struct {
+	profiles.Repository
+
+	memberships  map[string]profiles.MembershipKind // individual profile ID -> kind
+	linkedUserID *string
+	deleted      bool
+	invalidated  []string
+}
+
+func (r *profileDeletionRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "target" || r.deleted {
+		return "", nil
+	}
+
+	return "target-profile", nil
+}
+
+func (r *profileDeletionRepository) GetDeletedProfileIDBySlug(
+	_ context.Context,
+	slug string,
+) (string, error) {
+	if slug != "target" || !r.deleted {
+		return "", nil
+	}
+
+	return "target-profile", nil
+}
+
+func (r *profileDeletionRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-" + userID
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *profileDeletionRepository) GetMembershipBetweenProfiles(
+	_ context.Context,
+	_ string,
+	originProfileID string,
+) (profiles.MembershipKind, error) {
+	return r.memberships[originProfileID], nil
+}
+
+func (r *profileDeletionRepository) GetUserIDByIndividualProfileID(
+	_ context.Context,
+	_ string,
+) (*string, error) {
+	return r.linkedUserID, nil
+}
+
+func (r *profileDeletionRepository) SoftDeleteProfile(_ context.Context, _ string) (bool, error) {
+	if r.deleted {
+		return false, nil
+	}
+
+	r.deleted = true
+
+	return true, nil
+}
+
+func (r *profileDeletionRepository) RestoreProfile(_ context.Context, _ string) (bool, error) {
+	if !r.deleted {
+		return false, nil
+	}
+
+	r.deleted = false
+
+	return true, nil
+}
+
+func (r *profileDeletionRepository) InvalidateProfileSlugCache(
+	_ context.Context,
+	slug string,
+) error {
+	r.invalidated = append(r.invalidated, slug)
+
+	return nil
+}
+
+func newProfileDeletionService() (
+	*profiles.Service,
+	*profileDeletionRepository,
+	*recordingAuditRepository,
+) {
+	repo := &profileDeletionRepository{ //nolint:exhaustruct
+		memberships: map[string]profiles.MembershipKind{
+			"profile-owner":      profiles.MembershipKindOwner,
+			"profile-maintainer": profiles.MembershipKindMaintainer,
+		},
+	}
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo, auditRepo //nolint:exhaustruct
+}
+
+func TestDeleteProfile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		userID   string
+		userKind string
+		expected error
+	}{
+		"owner": {
+			userID:   "owner",
+			userKind: "regular",
+			expected: nil,
+		},
+		"admin": {
+			userID:   "admin",
+			userKind: profiles.UserKindAdmin,
+			expected: nil,
+		},
+		"maintainer": {
+			userID:   "maintainer",
+			userKind: "regular",
+			expected: profiles.ErrInsufficientAccess,
+		},
+		"stranger": {
+			userID:   "stranger",
+			userKind: "regular",
+			expected: profiles.ErrInsufficientAccess,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newProfileDeletionService()
+
+			err := service.DeleteProfile(t.Context(), tt.userID, tt.userKind, "target")
+
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				assert.False(t, repo.deleted)
+				assert.Empty(t, auditRepo.entries)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, repo.deleted)
+			assert.Equal(t, []string{"target"}, repo.invalidated)
+			require.Len(t, auditRepo.entries, 1)
+			assert.Equal(t, events.ProfileDeleted, auditRepo.entries[0].EventType)
+		})
+	}
+}
+
+func TestDeleteProfile_StillLinked(t *testing.T) {
+	t.Parallel()
+
+	service, repo, _ := newProfileDeletionService()
+	linkedUserID := "owner"
+	repo.linkedUserID = &linkedUserID
+
+	err := service.DeleteProfile(t.Context(), "owner", "regular", "target")
+
+	require.ErrorIs(t, err, profiles.ErrProfileStillLinked)
+	assert.False(t, repo.deleted)
+	assert.Empty(t, repo.invalidated)
+}
+
+func TestDeleteProfile_NotFound(t *testing.T) {
+	t.Parallel()
+
+	service, _, _ := newProfileDeletionService()
+
+	err := service.DeleteProfile(t.Context(), "admin", profiles.UserKindAdmin, "missing")
+
+	require.ErrorIs(t, err, profiles.ErrProfileNotFound)
+}
+
+func TestRestoreProfile(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newProfileDeletionService()
+
+	require.NoError(t, service.DeleteProfile(t.Context(), "owner", "regular", "target"))
+
+	err := service.RestoreProfile(t.Context(), "owner", "regular", "target")
+	require.ErrorIs(t, err, profiles.ErrUnauthorized)
+	assert.True(t, repo.deleted)
+
+	require.NoError(t, service.RestoreProfile(t.Context(), "admin", profiles.UserKindAdmin, "target"))
+	assert.False(t, repo.deleted)
+	assert.Equal(t, []string{"target", "target"}, repo.invalidated)
+	require.Len(t, auditRepo.entries, 2)
+	assert.Equal(t, events.ProfileRestored, auditRepo.entries[1].EventType)
+
+	err = service.RestoreProfile(t.Context(), "admin", profiles.UserKindAdmin, "target")
+	require.ErrorIs(t, err, profiles.ErrProfileNotFound)
+}
@@ -0,0 +1,113 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// featureRepository keeps one profile in memory and applies UpdateProfile the
+// way the query does: nil arguments leave the stored value unchanged.
+type featureRepository struct {
+	profiles.Repository
+
+	profile profiles.Profile
+}
+
+func (r *featureRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return r.profile.ID, nil
+}
+
+func (r *featureRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *featureRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	_ string,
+) (*profiles.Profile, error) {
+	profile := r.profile
+
+	return &profile, nil
+}
+
+func (r *featureRepository) UpdateProfile(
+	_ context.Context,
+	_ string,
+	_ *string,
+	_ *string,
+	_ map[string]any,
+	featureRelations *string,
+	featureLinks *string,
+	featureQA *string,
+	featureDiscussions *string,
+	featureReferrals *string,
+	featureApplications *string,
+	optionStoryDiscussionsByDefault *bool,
+) error {
+	assign := func(target *string, value *string) {
+		if value != nil {
+			*target = *value
+		}
+	}
+
+	assign(&r.profile.FeatureRelations, featureRelations)
+	assign(&r.profile.FeatureLinks, featureLinks)
+	assign(&r.profile.FeatureQA, featureQA)
+	assign(&r.profile.FeatureDiscussions, featureDiscussions)
+	assign(&r.profile.FeatureReferrals, featureReferrals)
+	assign(&r.profile.FeatureApplications, featureApplications)
+
+	if optionStoryDiscussionsByDefault != nil {
+		r.profile.OptionStoryDiscussionsByDefault = *optionStoryDiscussionsByDefault
+	}
+
+	return nil
+}
+
+func TestUpdate_FeatureDiscussions(t *testing.T) {
+	t.Parallel()
+
+	repo := &featureRepository{ //nolint:exhaustruct
+		profile: profiles.Profile{ //nolint:exhaustruct
+			ID:                 "profile-1",
+			FeatureDiscussions: string(profiles.ModuleVisibilityPublic),
+			FeatureLinks:       string(profiles.ModuleVisibilityPublic),
+		},
+	}
+	auditService, _ := newRecordingAuditService()
+	service := profiles.NewService(nil, &profiles.Config{}, repo, auditService) //nolint:exhaustruct
+
+	hidden := string(profiles.ModuleVisibilityHidden)
+	discussionsByDefault := true
+
+	profile, err := service.Update(
+		t.Context(), "en", "user-1", profiles.UserKindAdmin, "acme",
+		nil, nil, nil,
+		nil, nil, nil, &hidden, nil, nil, &discussionsByDefault,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, hidden, profile.FeatureDiscussions)
+	assert.True(t, profile.OptionStoryDiscussionsByDefault)
+	assert.Equal(t, string(profiles.ModuleVisibilityPublic), profile.FeatureLinks)
+
+	// An update that doesn't mention discussions leaves them as they are
+	disabled := string(profiles.ModuleVisibilityDisabled)
+
+	profile, err = service.Update(
+		t.Context(), "en", "user-1", profiles.UserKindAdmin, "acme",
+		nil, nil, nil,
+		nil, &disabled, nil, nil, nil, nil, nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, hidden, profile.FeatureDiscussions)
+	assert.True(t, profile.OptionStoryDiscussionsByDefault)
+	assert.Equal(t, disabled, profile.FeatureLinks)
+}
@@ -0,0 +1,138 @@
+package profiles_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedLinkRepository pages through a fixed list of links the way the storage
+// adapter does, using the cursor offset as an index.
+type pagedLinkRepository struct {
+	profiles.Repository
+
+	links []*profiles.ProfileLinkBrief
+}
+
+func (r *pagedLinkRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *pagedLinkRepository) GetFeatureLinksVisibility(_ context.Context, _ string) (string, error) {
+	return string(profiles.ModuleVisibilityPublic), nil
+}
+
+func (r *pagedLinkRepository) ListAllProfileLinksByProfileID(
+	_ context.Context,
+	_ string,
+	_ string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*profiles.ProfileLinkBrief], error) {
+	if cursor == nil {
+		return cursors.WrapResponseWithCursor(r.links, nil), nil
+	}
+
+	offset := 0
+	if cursor.Offset != nil && *cursor.Offset != "" {
+		offset, _ = strconv.Atoi(*cursor.Offset)
+	}
+
+	end := min(offset+cursor.Limit, len(r.links))
+	page := r.links[min(offset, end):end]
+
+	var next *string
+
+	if len(page) == cursor.Limit {
+		nextOffset := strconv.Itoa(end)
+		next = &nextOffset
+	}
+
+	return cursors.WrapResponseWithCursor(page, next), nil
+}
+
+func newPagedLinkRepository(visibilities ...profiles.LinkVisibility) *pagedLinkRepository {
+	repo := &pagedLinkRepository{} //nolint:exhaustruct
+
+	for i, visibility := range visibilities {
+		repo.links = append(repo.links, &profiles.ProfileLinkBrief{ //nolint:exhaustruct
+			ID:         "link-" + strconv.Itoa(i+1),
+			Visibility: visibility,
+		})
+	}
+
+	return repo
+}
+
+func linkIDsOf(links []*profiles.ProfileLinkBrief) []string {
+	ids := make([]string, len(links))
+	for i, link := range links {
+		ids[i] = link.ID
+	}
+
+	return ids
+}
+
+func TestListAllLinksBySlug_Pages(t *testing.T) {
+	t.Parallel()
+
+	repo := newPagedLinkRepository(
+		profiles.LinkVisibilityPublic,
+		profiles.LinkVisibilityPublic,
+		profiles.LinkVisibilityPublic,
+	)
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	firstPage, err := service.ListAllLinksBySlug(
+		t.Context(), "en", "target", "", cursors.NewCursor(2, nil),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"link-1", "link-2"}, linkIDsOf(firstPage.Data))
+	require.NotNil(t, firstPage.CursorPtr)
+
+	secondPage, err := service.ListAllLinksBySlug(
+		t.Context(), "en", "target", "", cursors.NewCursor(2, firstPage.CursorPtr),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"link-3"}, linkIDsOf(secondPage.Data))
+	assert.Nil(t, secondPage.CursorPtr)
+}
+
+func TestListAllLinksBySlug_FiltersHiddenLinksPerPage(t *testing.T) {
+	t.Parallel()
+
+	repo := newPagedLinkRepository(
+		profiles.LinkVisibilityPublic,
+		profiles.LinkVisibilityMembers,
+		profiles.LinkVisibilityPublic,
+	)
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	firstPage, err := service.ListAllLinksBySlug(
+		t.Context(), "en", "target", "", cursors.NewCursor(2, nil),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"link-1"}, linkIDsOf(firstPage.Data))
+	assert.NotNil(t, firstPage.CursorPtr)
+
+	everything, err := service.ListEveryLinkBySlug(t.Context(), "en", "target", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"link-1", "link-3"}, linkIDsOf(everything))
+}
+
+func TestListAllLinksBySlug_Empty(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, nil, newPagedLinkRepository(), nil)
+
+	page, err := service.ListAllLinksBySlug(
+		t.Context(), "en", "target", "", cursors.NewCursor(2, nil),
+	)
+	require.NoError(t, err)
+	assert.Empty(t, page.Data)
+	assert.Nil(t, page.CursorPtr)
+}
@@ -0,0 +1,238 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileLocalesRepository serves the calls made while deleting a profile locale or
+// changing its default.
+type profileLocalesRepository struct {
+	profiles.Repository
+
+	defaultLocale string
+	profileRows   int64
+	pageRows      int64
+	linkRows      int64
+
+	deletedLocales []string
+
+	translatedLocales []string
+	updatedDefault    *string
+}
+
+func (r *profileLocalesRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *profileLocalesRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *profileLocalesRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *profileLocalesRepository) GetProfileDefaultLocale(
+	_ context.Context,
+	_ string,
+) (string, error) {
+	return r.defaultLocale, nil
+}
+
+func (r *profileLocalesRepository) DeleteProfileTx(
+	_ context.Context,
+	_ string,
+	localeCode string,
+) (int64, error) {
+	r.deletedLocales = append(r.deletedLocales, localeCode)
+
+	return r.profileRows, nil
+}
+
+func (r *profileLocalesRepository) DeleteProfilePageTxsByProfileLocale(
+	_ context.Context,
+	_ string,
+	_ string,
+) (int64, error) {
+	return r.pageRows, nil
+}
+
+func (r *profileLocalesRepository) DeleteProfileLinkTxsByProfileLocale(
+	_ context.Context,
+	_ string,
+	_ string,
+) (int64, error) {
+	return r.linkRows, nil
+}
+
+func (r *profileLocalesRepository) GetProfileTxByID(
+	_ context.Context,
+	profileID string,
+) ([]*profiles.ProfileTx, error) {
+	result := make([]*profiles.ProfileTx, 0, len(r.translatedLocales))
+	for _, locale := range r.translatedLocales {
+		result = append(result, &profiles.ProfileTx{ //nolint:exhaustruct
+			ProfileID:  profileID,
+			LocaleCode: locale,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *profileLocalesRepository) UpdateProfileDefaultLocale(
+	_ context.Context,
+	_ string,
+	localeCode string,
+) error {
+	r.updatedDefault = &localeCode
+
+	return nil
+}
+
+func newProfileLocalesService(
+	repo *profileLocalesRepository,
+) (*profiles.Service, *recordingAuditRepository) {
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, nil, repo, auditService), auditRepo
+}
+
+func TestDeleteProfileLocale(t *testing.T) {
+	t.Parallel()
+
+	repo := &profileLocalesRepository{ //nolint:exhaustruct
+		defaultLocale: "en",
+		profileRows:   1,
+		pageRows:      3,
+		linkRows:      2,
+	}
+	service, auditRepo := newProfileLocalesService(repo)
+
+	deletion, err := service.DeleteProfileLocale(t.Context(), "user-1", "target", "tr")
+
+	require.NoError(t, err)
+	assert.Equal(t, &profiles.ProfileLocaleDeletion{
+		LocaleCode: "tr",
+		Profile:    1,
+		Pages:      3,
+		Links:      2,
+	}, deletion)
+	assert.Equal(t, []string{"tr"}, repo.deletedLocales)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileLocaleDeleted, auditRepo.entries[0].EventType)
+	assert.Equal(t, "target-profile", auditRepo.entries[0].EntityID)
+	assert.Equal(t, int64(3), auditRepo.entries[0].Payload["pages_deleted"])
+}
+
+func TestDeleteProfileLocale_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		localeCode  string
+		profileRows int64
+		expected    error
+	}{
+		"default locale": {
+			localeCode:  "en",
+			profileRows: 1,
+			expected:    profiles.ErrCannotDeleteDefaultLocale,
+		},
+		"unsupported locale": {
+			localeCode:  "xx",
+			profileRows: 1,
+			expected:    profiles.ErrInvalidInput,
+		},
+		"nothing to delete": {
+			localeCode:  "tr",
+			profileRows: 0,
+			expected:    profiles.ErrLocaleNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &profileLocalesRepository{ //nolint:exhaustruct
+				defaultLocale: "en",
+				profileRows:   tt.profileRows,
+			}
+			service, auditRepo := newProfileLocalesService(repo)
+
+			_, err := service.DeleteProfileLocale(t.Context(), "user-1", "target", tt.localeCode)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
+
+func TestSetDefaultLocale(t *testing.T) {
+	t.Parallel()
+
+	repo := &profileLocalesRepository{ //nolint:exhaustruct
+		defaultLocale:     "en",
+		translatedLocales: []string{"en", "tr"},
+	}
+	service, auditRepo := newProfileLocalesService(repo)
+
+	err := service.SetDefaultLocale(t.Context(), "user-1", "target", "tr")
+
+	require.NoError(t, err)
+	require.NotNil(t, repo.updatedDefault)
+	assert.Equal(t, "tr", *repo.updatedDefault)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileDefaultLocaleChanged, auditRepo.entries[0].EventType)
+	assert.Equal(t, "en", auditRepo.entries[0].Payload["previous_locale_code"])
+}
+
+func TestSetDefaultLocale_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		localeCode string
+		expected   error
+	}{
+		"no translation": {
+			localeCode: "de",
+			expected:   profiles.ErrDefaultLocaleNotTranslated,
+		},
+		"unsupported locale": {
+			localeCode: "xx",
+			expected:   profiles.ErrInvalidInput,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &profileLocalesRepository{ //nolint:exhaustruct
+				defaultLocale:     "en",
+				translatedLocales: []string{"en", "tr"},
+			}
+			service, auditRepo := newProfileLocalesService(repo)
+
+			err := service.SetDefaultLocale(t.Context(), "user-1", "target", tt.localeCode)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Nil(t, repo.updatedDefault)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
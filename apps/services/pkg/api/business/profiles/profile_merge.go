@@ -0,0 +1,189 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var (
+	ErrCannotMergeProfileIntoItself = errors.New("cannot merge a profile into itself")
+	ErrProfileKindMismatch          = errors.New("only profiles of the same kind can be merged")
+	ErrProfilesLinkedToOtherUsers   = errors.New(
+		"both profiles are the individual profile of a different user",
+	)
+)
+
+// ProfileMergeResult counts what a merge moved to the target profile.
+type ProfileMergeResult struct {
+	SourceProfileID string `json:"source_profile_id"`
+	TargetProfileID string `json:"target_profile_id"`
+	Links           int64  `json:"links"`
+	Pages           int64  `json:"pages"`
+	Resources       int64  `json:"resources"`
+	Memberships     int64  `json:"memberships"`
+	UserRepointed   bool   `json:"user_repointed"`
+}
+
+// MergeProfiles folds a duplicate profile into another one. Admin only. The
+// links, pages, resources and memberships of the source move to the target,
+// except those the target already has; a user whose individual profile is the
+// source is moved to the target; and the source is soft-deleted. Everything
+// happens in one transaction.
+func (s *Service) MergeProfiles( //nolint:cyclop,funlen
+	ctx context.Context,
+	adminUserID string,
+	sourceSlug string,
+	targetSlug string,
+) (*ProfileMergeResult, error) {
+	admin, err := s.repo.GetUserBriefInfo(ctx, adminUserID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(userID: %s): %w", ErrFailedToGetRecord, adminUserID, err)
+	}
+
+	if admin == nil || admin.Kind != UserKindAdmin {
+		return nil, ErrUnauthorized
+	}
+
+	if sourceSlug == targetSlug {
+		return nil, ErrCannotMergeProfileIntoItself
+	}
+
+	source, err := s.getProfileForMerge(ctx, sourceSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.getProfileForMerge(ctx, targetSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.ID == target.ID {
+		return nil, ErrCannotMergeProfileIntoItself
+	}
+
+	if source.Kind != target.Kind {
+		return nil, fmt.Errorf(
+			"%w(source: %s, target: %s)",
+			ErrProfileKindMismatch,
+			source.Kind,
+			target.Kind,
+		)
+	}
+
+	sourceUserID, err := s.repo.GetUserIDByIndividualProfileID(ctx, source.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, source.ID, err)
+	}
+
+	targetUserID, err := s.repo.GetUserIDByIndividualProfileID(ctx, target.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, target.ID, err)
+	}
+
+	if sourceUserID != nil && targetUserID != nil && *sourceUserID != *targetUserID {
+		return nil, ErrProfilesLinkedToOtherUsers
+	}
+
+	result := &ProfileMergeResult{ //nolint:exhaustruct
+		SourceProfileID: source.ID,
+		TargetProfileID: target.ID,
+	}
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		var txErr error
+
+		result.Links, txErr = txRepo.MoveProfileLinks(ctx, source.ID, target.ID)
+		if txErr != nil {
+			return fmt.Errorf("%w(links): %w", ErrFailedToUpdateRecord, txErr)
+		}
+
+		result.Pages, txErr = txRepo.MoveProfilePages(ctx, source.ID, target.ID)
+		if txErr != nil {
+			return fmt.Errorf("%w(pages): %w", ErrFailedToUpdateRecord, txErr)
+		}
+
+		result.Resources, txErr = txRepo.MoveProfileResources(ctx, source.ID, target.ID)
+		if txErr != nil {
+			return fmt.Errorf("%w(resources): %w", ErrFailedToUpdateRecord, txErr)
+		}
+
+		result.Memberships, txErr = txRepo.MoveProfileMemberships(ctx, source.ID, target.ID)
+		if txErr != nil {
+			return fmt.Errorf("%w(memberships): %w", ErrFailedToUpdateRecord, txErr)
+		}
+
+		if sourceUserID != nil && targetUserID == nil {
+			repointed, txErr := txRepo.RepointUserIndividualProfile(ctx, source.ID, target.ID)
+			if txErr != nil {
+				return fmt.Errorf("%w(userID: %s): %w", ErrFailedToUpdateRecord, *sourceUserID, txErr)
+			}
+
+			result.UserRepointed = repointed > 0
+		}
+
+		deleted, txErr := txRepo.SoftDeleteProfile(ctx, source.ID)
+		if txErr != nil {
+			return fmt.Errorf("%w(id: %s): %w", ErrFailedToDeleteRecord, source.ID, txErr)
+		}
+
+		if !deleted {
+			return ErrProfileNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.repo.InvalidateProfileSlugCache(ctx, sourceSlug)
+	_ = s.repo.InvalidateProfileSlugCache(ctx, targetSlug)
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileMerged,
+		EntityType: "profile",
+		EntityID:   target.ID,
+		ActorID:    &adminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"source_profile_id": source.ID,
+			"source_slug":       sourceSlug,
+			"target_slug":       targetSlug,
+			"links":             result.Links,
+			"pages":             result.Pages,
+			"resources":         result.Resources,
+			"memberships":       result.Memberships,
+			"user_repointed":    result.UserRepointed,
+		},
+	})
+
+	return result, nil
+}
+
+// getProfileForMerge returns the live profile with the given slug.
+func (s *Service) getProfileForMerge(ctx context.Context, slug string) (*Profile, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+	}
+
+	if profileID == "" {
+		return nil, fmt.Errorf("%w(slug: %s)", ErrProfileNotFound, slug)
+	}
+
+	profile, err := s.repo.GetProfileByID(ctx, "en", profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	if profile == nil {
+		return nil, fmt.Errorf("%w(slug: %s)", ErrProfileNotFound, slug)
+	}
+
+	return profile, nil
+}
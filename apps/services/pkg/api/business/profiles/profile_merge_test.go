@@ -0,0 +1,329 @@
+package profiles_test
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errResourcesUnavailable = errors.New("resources unavailable")
+
+// mergeRecord is a link or page owned by a profile, identified by a key the
+// merge compares for conflicts (remote ID for links, slug for pages).
+type mergeRecord struct {
+	profileID string
+	key       string
+}
+
+// profileMergeRepository keeps the links and pages of the "dup" and "main"
+// profiles in memory. WithTx restores them when the transaction fails.
+type profileMergeRepository struct {
+	profiles.Repository
+
+	slugs         map[string]string // slug -> profile ID
+	kinds         map[string]string // profile ID -> kind
+	linkedUsers   map[string]string // profile ID -> user ID
+	links         map[string]*mergeRecord
+	pages         map[string]*mergeRecord
+	deleted       map[string]bool
+	failResources bool
+}
+
+func newProfileMergeRepository() *profileMergeRepository {
+	return &profileMergeRepository{ //nolint:exhaustruct
+		slugs: map[string]string{"dup": "dup-id", "main": "main-id", "acme": "acme-id"},
+		kinds: map[string]string{
+			"dup-id":  profiles.ProfileKindIndividual,
+			"main-id": profiles.ProfileKindIndividual,
+			"acme-id": profiles.ProfileKindOrganization,
+		},
+		linkedUsers: map[string]string{"dup-id": "user-1"},
+		links: map[string]*mergeRecord{
+			"link-1": {profileID: "dup-id", key: "github:1"},
+			"link-2": {profileID: "dup-id", key: "x:2"},
+			"link-3": {profileID: "main-id", key: "x:2"},
+		},
+		pages: map[string]*mergeRecord{
+			"page-1": {profileID: "dup-id", key: "about"},
+			"page-2": {profileID: "dup-id", key: "talks"},
+			"page-3": {profileID: "main-id", key: "about"},
+		},
+		deleted: map[string]bool{},
+	}
+}
+
+func (r *profileMergeRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	links := cloneRecords(r.links)
+	pages := cloneRecords(r.pages)
+	linkedUsers := maps.Clone(r.linkedUsers)
+	deleted := maps.Clone(r.deleted)
+
+	err := fn(r)
+	if err != nil {
+		r.links, r.pages, r.linkedUsers, r.deleted = links, pages, linkedUsers, deleted
+	}
+
+	return err
+}
+
+func cloneRecords(records map[string]*mergeRecord) map[string]*mergeRecord {
+	result := make(map[string]*mergeRecord, len(records))
+	for id, record := range records {
+		clone := *record
+		result[id] = &clone
+	}
+
+	return result
+}
+
+func moveRecords(records map[string]*mergeRecord, sourceID string, targetID string) int64 {
+	taken := map[string]bool{}
+
+	for _, record := range records {
+		if record.profileID == targetID {
+			taken[record.key] = true
+		}
+	}
+
+	var moved int64
+
+	for _, record := range records {
+		if record.profileID == sourceID && !taken[record.key] {
+			record.profileID = targetID
+			moved++
+		}
+	}
+
+	return moved
+}
+
+func (r *profileMergeRepository) GetUserBriefInfo(
+	_ context.Context,
+	userID string,
+) (*profiles.UserBriefInfo, error) {
+	kind := "regular"
+	if userID == "admin" {
+		kind = profiles.UserKindAdmin
+	}
+
+	return &profiles.UserBriefInfo{Kind: kind}, nil //nolint:exhaustruct
+}
+
+func (r *profileMergeRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	profileID := r.slugs[slug]
+	if r.deleted[profileID] {
+		return "", nil
+	}
+
+	return profileID, nil
+}
+
+func (r *profileMergeRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ID: id, Kind: r.kinds[id]}, nil //nolint:exhaustruct
+}
+
+func (r *profileMergeRepository) GetUserIDByIndividualProfileID(
+	_ context.Context,
+	profileID string,
+) (*string, error) {
+	userID, ok := r.linkedUsers[profileID]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &userID, nil
+}
+
+func (r *profileMergeRepository) MoveProfileLinks(
+	_ context.Context,
+	sourceID string,
+	targetID string,
+) (int64, error) {
+	return moveRecords(r.links, sourceID, targetID), nil
+}
+
+func (r *profileMergeRepository) MoveProfilePages(
+	_ context.Context,
+	sourceID string,
+	targetID string,
+) (int64, error) {
+	return moveRecords(r.pages, sourceID, targetID), nil
+}
+
+func (r *profileMergeRepository) MoveProfileResources(
+	_ context.Context,
+	_ string,
+	_ string,
+) (int64, error) {
+	if r.failResources {
+		return 0, errResourcesUnavailable
+	}
+
+	return 0, nil
+}
+
+func (r *profileMergeRepository) MoveProfileMemberships(
+	_ context.Context,
+	_ string,
+	_ string,
+) (int64, error) {
+	return 0, nil
+}
+
+func (r *profileMergeRepository) RepointUserIndividualProfile(
+	_ context.Context,
+	sourceID string,
+	targetID string,
+) (int64, error) {
+	userID, ok := r.linkedUsers[sourceID]
+	if !ok {
+		return 0, nil
+	}
+
+	delete(r.linkedUsers, sourceID)
+	r.linkedUsers[targetID] = userID
+
+	return 1, nil
+}
+
+func (r *profileMergeRepository) SoftDeleteProfile(_ context.Context, id string) (bool, error) {
+	if r.deleted[id] {
+		return false, nil
+	}
+
+	r.deleted[id] = true
+
+	return true, nil
+}
+
+func (r *profileMergeRepository) InvalidateProfileSlugCache(_ context.Context, _ string) error {
+	return nil
+}
+
+func newProfileMergeService() (
+	*profiles.Service,
+	*profileMergeRepository,
+	*recordingAuditRepository,
+) {
+	repo := newProfileMergeRepository()
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo, auditRepo //nolint:exhaustruct
+}
+
+func TestMergeProfiles_MovesLinksAndPages(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newProfileMergeService()
+
+	result, err := service.MergeProfiles(t.Context(), "admin", "dup", "main")
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), result.Links)
+	assert.Equal(t, int64(1), result.Pages)
+	assert.True(t, result.UserRepointed)
+
+	// The conflicting link and page stay with the deleted duplicate.
+	assert.Equal(t, "main-id", repo.links["link-1"].profileID)
+	assert.Equal(t, "dup-id", repo.links["link-2"].profileID)
+	assert.Equal(t, "main-id", repo.pages["page-2"].profileID)
+	assert.Equal(t, "dup-id", repo.pages["page-1"].profileID)
+
+	assert.Equal(t, map[string]string{"main-id": "user-1"}, repo.linkedUsers)
+	assert.True(t, repo.deleted["dup-id"])
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileMerged, auditRepo.entries[0].EventType)
+	assert.Equal(t, "main-id", auditRepo.entries[0].EntityID)
+	assert.Equal(t, "dup-id", auditRepo.entries[0].Payload["source_profile_id"])
+}
+
+func TestMergeProfiles_RollsBackOnFailure(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newProfileMergeService()
+	repo.failResources = true
+
+	_, err := service.MergeProfiles(t.Context(), "admin", "dup", "main")
+	require.ErrorIs(t, err, errResourcesUnavailable)
+
+	assert.Equal(t, "dup-id", repo.links["link-1"].profileID)
+	assert.Equal(t, "dup-id", repo.pages["page-2"].profileID)
+	assert.False(t, repo.deleted["dup-id"])
+	assert.Empty(t, auditRepo.entries)
+}
+
+func TestMergeProfiles_Refusals(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		userID   string
+		source   string
+		target   string
+		expected error
+	}{
+		"self merge": {
+			userID:   "admin",
+			source:   "main",
+			target:   "main",
+			expected: profiles.ErrCannotMergeProfileIntoItself,
+		},
+		"not an admin": {
+			userID:   "user-1",
+			source:   "dup",
+			target:   "main",
+			expected: profiles.ErrUnauthorized,
+		},
+		"different kinds": {
+			userID:   "admin",
+			source:   "dup",
+			target:   "acme",
+			expected: profiles.ErrProfileKindMismatch,
+		},
+		"missing source": {
+			userID:   "admin",
+			source:   "ghost",
+			target:   "main",
+			expected: profiles.ErrProfileNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newProfileMergeService()
+
+			_, err := service.MergeProfiles(t.Context(), tt.userID, tt.source, tt.target)
+			require.ErrorIs(t, err, tt.expected)
+
+			assert.Equal(t, "dup-id", repo.links["link-1"].profileID)
+			assert.Empty(t, repo.deleted)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
+
+func TestMergeProfiles_BothLinkedToUsers(t *testing.T) {
+	t.Parallel()
+
+	service, repo, _ := newProfileMergeService()
+	repo.linkedUsers["main-id"] = "user-2"
+
+	_, err := service.MergeProfiles(t.Context(), "admin", "dup", "main")
+	require.ErrorIs(t, err, profiles.ErrProfilesLinkedToOtherUsers)
+	assert.False(t, repo.deleted["dup-id"])
+}
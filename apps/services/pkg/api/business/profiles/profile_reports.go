@@ -0,0 +1,364 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var (
+	ErrReportTargetNotFound  = errors.New("reported content not found")
+	ErrProfileReportNotFound = errors.New("report not found or already resolved")
+	ErrTooManyReports        = errors.New("too many reports, try again later")
+)
+
+const (
+	// maxReportTextLength caps the characters of a report's free text.
+	maxReportTextLength = 2000
+
+	// DefaultProfileReportsLimit is the number of reports listed when the
+	// caller asks for no particular limit.
+	DefaultProfileReportsLimit = 50
+	// MaxProfileReportsLimit caps the number of reports listed per page.
+	MaxProfileReportsLimit = 200
+)
+
+// ReportTargetKind is the kind of entity a report is filed against.
+type ReportTargetKind string
+
+const (
+	ReportTargetProfile ReportTargetKind = "profile"
+	ReportTargetPage    ReportTargetKind = "page"
+	ReportTargetLink    ReportTargetKind = "link"
+)
+
+// ReportReason is why an entity was reported.
+type ReportReason string
+
+const (
+	ReportReasonSpam          ReportReason = "spam"
+	ReportReasonHarassment    ReportReason = "harassment"
+	ReportReasonImpersonation ReportReason = "impersonation"
+	ReportReasonInappropriate ReportReason = "inappropriate"
+	ReportReasonOther         ReportReason = "other"
+)
+
+// IsValid reports whether the reason is one of the known report reasons.
+func (r ReportReason) IsValid() bool {
+	switch r {
+	case ReportReasonSpam,
+		ReportReasonHarassment,
+		ReportReasonImpersonation,
+		ReportReasonInappropriate,
+		ReportReasonOther:
+		return true
+	}
+
+	return false
+}
+
+// ProfileReportStatus is where a report is in the moderation queue.
+type ProfileReportStatus string
+
+const (
+	ProfileReportStatusOpen      ProfileReportStatus = "open"
+	ProfileReportStatusResolved  ProfileReportStatus = "resolved"
+	ProfileReportStatusDismissed ProfileReportStatus = "dismissed"
+)
+
+// IsValid reports whether the status is one of the known report statuses.
+func (s ProfileReportStatus) IsValid() bool {
+	switch s {
+	case ProfileReportStatusOpen, ProfileReportStatusResolved, ProfileReportStatusDismissed:
+		return true
+	}
+
+	return false
+}
+
+// ProfileReport is a report of an abusive profile, page or link. ReporterKey
+// identifies the reporter, a user or the hashed IP address of an anonymous
+// reporter, and is not sent to clients.
+type ProfileReport struct {
+	CreatedAt        time.Time           `json:"created_at"`
+	ReporterUserID   *string             `json:"reporter_user_id"`
+	Text             *string             `json:"text"`
+	ResolvedByUserID *string             `json:"resolved_by_user_id"`
+	ResolvedAt       *time.Time          `json:"resolved_at"`
+	ID               string              `json:"id"`
+	ProfileID        string              `json:"profile_id"`
+	ProfileSlug      string              `json:"profile_slug"`
+	TargetKind       ReportTargetKind    `json:"target_kind"`
+	TargetID         string              `json:"target_id"`
+	ReporterKey      string              `json:"-"`
+	Reason           ReportReason        `json:"reason"`
+	Status           ProfileReportStatus `json:"status"`
+}
+
+// ReportParams holds the parameters for reporting a profile, page or link.
+// ReporterUserID is nil for anonymous reports, which are told apart by
+// ReporterIPHash instead. TargetSlug is the page slug or link ID and is empty
+// when the profile itself is reported.
+type ReportParams struct {
+	ReporterUserID *string
+	Text           *string
+	ReporterIPHash string
+	LocaleCode     string
+	ProfileSlug    string
+	TargetKind     ReportTargetKind
+	TargetSlug     string
+	Reason         ReportReason
+}
+
+// Report files a report against a profile, one of its pages or one of its
+// links for the moderation queue. A reporter has at most one open report per
+// entity: reporting it again returns the open report. Reporters are limited
+// to ReportRateLimit reports within ReportRateWindow.
+func (s *Service) Report( //nolint:cyclop,funlen
+	ctx context.Context,
+	params ReportParams,
+) (*ProfileReport, error) {
+	if !params.Reason.IsValid() {
+		return nil, fmt.Errorf("%w: unknown report reason %q", ErrInvalidInput, params.Reason)
+	}
+
+	var text *string
+
+	if params.Text != nil {
+		trimmed := strings.TrimSpace(*params.Text)
+		if utf8.RuneCountInString(trimmed) > maxReportTextLength {
+			return nil, fmt.Errorf(
+				"%w: report text must be at most %d characters",
+				ErrInvalidInput,
+				maxReportTextLength,
+			)
+		}
+
+		if trimmed != "" {
+			text = &trimmed
+		}
+	}
+
+	reporterKey, err := profileReporterKey(params.ReporterUserID, params.ReporterIPHash)
+	if err != nil {
+		return nil, err
+	}
+
+	profileID, targetID, err := s.getReportTarget(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.GetOpenProfileReportByReporter(ctx, params.TargetKind, targetID, reporterKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w(target_id: %s): %w", ErrFailedToGetRecord, targetID, err)
+	}
+
+	if existing != nil {
+		return existing, nil
+	}
+
+	err = s.checkReportRateLimit(ctx, reporterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ProfileReport{
+		CreatedAt:        time.Now(),
+		ReporterUserID:   params.ReporterUserID,
+		Text:             text,
+		ResolvedByUserID: nil,
+		ResolvedAt:       nil,
+		ID:               string(s.idGenerator()),
+		ProfileID:        profileID,
+		ProfileSlug:      params.ProfileSlug,
+		TargetKind:       params.TargetKind,
+		TargetID:         targetID,
+		ReporterKey:      reporterKey,
+		Reason:           params.Reason,
+		Status:           ProfileReportStatusOpen,
+	}
+
+	inserted, err := s.repo.InsertProfileReport(ctx, report)
+	if err != nil {
+		return nil, fmt.Errorf("%w(target_id: %s): %w", ErrFailedToCreateRecord, targetID, err)
+	}
+
+	if !inserted {
+		// A concurrent report from the same reporter got in first.
+		existing, err = s.repo.GetOpenProfileReportByReporter(ctx, params.TargetKind, targetID, reporterKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w(target_id: %s): %w", ErrFailedToGetRecord, targetID, err)
+		}
+
+		if existing != nil {
+			return existing, nil
+		}
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileReported,
+		EntityType: "profile_report",
+		EntityID:   report.ID,
+		ActorID:    params.ReporterUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":  profileID,
+			"target_kind": params.TargetKind,
+			"target_id":   targetID,
+			"reason":      params.Reason,
+		},
+	})
+
+	return report, nil
+}
+
+// ListProfileReports returns the moderation queue: the reports in a status,
+// oldest first.
+func (s *Service) ListProfileReports(
+	ctx context.Context,
+	status ProfileReportStatus,
+	limit int,
+	offset int,
+) ([]*ProfileReport, error) {
+	if !status.IsValid() {
+		return nil, fmt.Errorf("%w: unknown report status %q", ErrInvalidInput, status)
+	}
+
+	if limit <= 0 {
+		limit = DefaultProfileReportsLimit
+	}
+
+	limit = min(limit, MaxProfileReportsLimit)
+	offset = max(offset, 0)
+
+	reports, err := s.repo.ListProfileReportsByStatus(ctx, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	return reports, nil
+}
+
+// ResolveProfileReport closes an open report as resolved or dismissed.
+func (s *Service) ResolveProfileReport(
+	ctx context.Context,
+	adminUserID string,
+	reportID string,
+	status ProfileReportStatus,
+) error {
+	if status != ProfileReportStatusResolved && status != ProfileReportStatusDismissed {
+		return fmt.Errorf("%w: status must be resolved or dismissed", ErrInvalidInput)
+	}
+
+	affected, err := s.repo.ResolveProfileReport(ctx, reportID, status, adminUserID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, reportID, err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w(id: %s)", ErrProfileReportNotFound, reportID)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileReportResolved,
+		EntityType: "profile_report",
+		EntityID:   reportID,
+		ActorID:    &adminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload:    map[string]any{"status": status},
+	})
+
+	return nil
+}
+
+// getReportTarget resolves the reported entity to its profile ID and its own ID.
+func (s *Service) getReportTarget(
+	ctx context.Context,
+	params ReportParams,
+) (string, string, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, params.ProfileSlug)
+	if err != nil {
+		return "", "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, params.ProfileSlug, err)
+	}
+
+	if profileID == "" {
+		return "", "", fmt.Errorf("%w(slug: %s)", ErrProfileNotFound, params.ProfileSlug)
+	}
+
+	switch params.TargetKind {
+	case ReportTargetProfile:
+		return profileID, profileID, nil
+	case ReportTargetPage:
+		page, err := s.repo.GetProfilePageByProfileIDAndSlug(
+			ctx,
+			params.LocaleCode,
+			profileID,
+			params.TargetSlug,
+		)
+		if err != nil {
+			return "", "", fmt.Errorf("%w(page: %s): %w", ErrFailedToGetRecord, params.TargetSlug, err)
+		}
+
+		if page == nil {
+			return "", "", fmt.Errorf("%w(page: %s)", ErrReportTargetNotFound, params.TargetSlug)
+		}
+
+		return profileID, page.ID, nil
+	case ReportTargetLink:
+		link, err := s.repo.GetProfileLink(ctx, params.LocaleCode, params.TargetSlug)
+		if err != nil {
+			return "", "", fmt.Errorf("%w(link: %s): %w", ErrFailedToGetRecord, params.TargetSlug, err)
+		}
+
+		if link == nil || link.ProfileID != profileID {
+			return "", "", fmt.Errorf("%w(link: %s)", ErrReportTargetNotFound, params.TargetSlug)
+		}
+
+		return profileID, link.ID, nil
+	}
+
+	return "", "", fmt.Errorf("%w: unknown report target %q", ErrInvalidInput, params.TargetKind)
+}
+
+// checkReportRateLimit rejects the report when the reporter already filed
+// ReportRateLimit reports within ReportRateWindow.
+func (s *Service) checkReportRateLimit(ctx context.Context, reporterKey string) error {
+	if s.config == nil || s.config.ReportRateLimit <= 0 || s.config.ReportRateWindow <= 0 {
+		return nil
+	}
+
+	since := time.Now().Add(-s.config.ReportRateWindow)
+
+	count, err := s.repo.CountProfileReportsByReporterSince(ctx, reporterKey, since)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if count >= s.config.ReportRateLimit {
+		return ErrTooManyReports
+	}
+
+	return nil
+}
+
+// profileReporterKey identifies a reporter by user, or by hashed IP address
+// when the report is anonymous.
+func profileReporterKey(userID *string, ipHash string) (string, error) {
+	if userID != nil && *userID != "" {
+		return "user:" + *userID, nil
+	}
+
+	if ipHash != "" {
+		return "ip:" + ipHash, nil
+	}
+
+	return "", fmt.Errorf("%w: the reporter is unknown", ErrInvalidInput)
+}
@@ -0,0 +1,249 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// profileReportRepository keeps reports in memory. Profile "acme" has the
+// page "about" and the link "acme-link"; "globex" has the link "globex-link".
+type profileReportRepository struct {
+	profiles.Repository
+
+	reports []*profiles.ProfileReport
+}
+
+func (r *profileReportRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	return map[string]string{"acme": "acme-id", "globex": "globex-id"}[slug], nil
+}
+
+func (r *profileReportRepository) GetProfilePageByProfileIDAndSlug(
+	_ context.Context,
+	_ string,
+	profileID string,
+	pageSlug string,
+) (*profiles.ProfilePage, error) {
+	if profileID != "acme-id" || pageSlug != "about" {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &profiles.ProfilePage{ID: "about-id"}, nil //nolint:exhaustruct
+}
+
+func (r *profileReportRepository) GetProfileLink(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.ProfileLink, error) {
+	profileID, ok := map[string]string{"acme-link": "acme-id", "globex-link": "globex-id"}[id]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &profiles.ProfileLink{ID: id, ProfileID: profileID}, nil //nolint:exhaustruct
+}
+
+func (r *profileReportRepository) GetOpenProfileReportByReporter(
+	_ context.Context,
+	targetKind profiles.ReportTargetKind,
+	targetID string,
+	reporterKey string,
+) (*profiles.ProfileReport, error) {
+	for _, report := range r.reports {
+		if report.TargetKind == targetKind && report.TargetID == targetID &&
+			report.ReporterKey == reporterKey && report.Status == profiles.ProfileReportStatusOpen {
+			return report, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func (r *profileReportRepository) CountProfileReportsByReporterSince(
+	_ context.Context,
+	reporterKey string,
+	since time.Time,
+) (int, error) {
+	count := 0
+
+	for _, report := range r.reports {
+		if report.ReporterKey == reporterKey && !report.CreatedAt.Before(since) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *profileReportRepository) InsertProfileReport(
+	_ context.Context,
+	report *profiles.ProfileReport,
+) (bool, error) {
+	r.reports = append(r.reports, report)
+
+	return true, nil
+}
+
+func (r *profileReportRepository) ResolveProfileReport(
+	_ context.Context,
+	id string,
+	status profiles.ProfileReportStatus,
+	_ string,
+) (int64, error) {
+	for _, report := range r.reports {
+		if report.ID == id && report.Status == profiles.ProfileReportStatusOpen {
+			report.Status = status
+
+			return 1, nil
+		}
+	}
+
+	return 0, nil
+}
+
+func newProfileReportService(
+	rateLimit int,
+) (*profiles.Service, *profileReportRepository, *recordingAuditRepository) {
+	repo := &profileReportRepository{} //nolint:exhaustruct
+	auditService, auditRepo := newRecordingAuditService()
+
+	config := &profiles.Config{ //nolint:exhaustruct
+		ReportRateLimit:  rateLimit,
+		ReportRateWindow: time.Hour,
+	}
+
+	return profiles.NewService(nil, config, repo, auditService), repo, auditRepo
+}
+
+func reportParams(
+	userID *string,
+	targetKind profiles.ReportTargetKind,
+	targetSlug string,
+) profiles.ReportParams {
+	return profiles.ReportParams{
+		ReporterUserID: userID,
+		Text:           nil,
+		ReporterIPHash: "ip-hash",
+		LocaleCode:     "en",
+		ProfileSlug:    "acme",
+		TargetKind:     targetKind,
+		TargetSlug:     targetSlug,
+		Reason:         profiles.ReportReasonSpam,
+	}
+}
+
+func TestReport_DeduplicatesOpenReports(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newProfileReportService(10)
+	userID := "user"
+
+	first, err := service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetPage, "about"))
+	require.NoError(t, err)
+	assert.Equal(t, "about-id", first.TargetID)
+	assert.Equal(t, "acme-id", first.ProfileID)
+
+	second, err := service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetPage, "about"))
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, second.ID)
+
+	// An anonymous reporter from the same address is a different reporter.
+	anonymous, err := service.Report(t.Context(), reportParams(nil, profiles.ReportTargetPage, "about"))
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, anonymous.ID)
+
+	assert.Len(t, repo.reports, 2)
+	require.Len(t, auditRepo.entries, 2)
+	assert.Equal(t, events.ProfileReported, auditRepo.entries[0].EventType)
+
+	// Once the report is resolved, the entity can be reported again.
+	err = service.ResolveProfileReport(t.Context(), "admin", first.ID, profiles.ProfileReportStatusDismissed)
+	require.NoError(t, err)
+
+	third, err := service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetPage, "about"))
+	require.NoError(t, err)
+	assert.NotEqual(t, first.ID, third.ID)
+}
+
+func TestReport_RateLimitsReporters(t *testing.T) {
+	t.Parallel()
+
+	service, repo, _ := newProfileReportService(2)
+	userID := "user"
+
+	_, err := service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetProfile, ""))
+	require.NoError(t, err)
+
+	_, err = service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetLink, "acme-link"))
+	require.NoError(t, err)
+
+	_, err = service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetPage, "about"))
+	require.ErrorIs(t, err, profiles.ErrTooManyReports)
+
+	// Repeating an open report does not count against the limit.
+	_, err = service.Report(t.Context(), reportParams(&userID, profiles.ReportTargetProfile, ""))
+	require.NoError(t, err)
+
+	// Other reporters have their own limit.
+	_, err = service.Report(t.Context(), reportParams(nil, profiles.ReportTargetPage, "about"))
+	require.NoError(t, err)
+
+	assert.Len(t, repo.reports, 3)
+}
+
+func TestReport_RejectsInvalidReports(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		params   func(params *profiles.ReportParams)
+		expected error
+	}{
+		"unknown reason": {
+			params:   func(params *profiles.ReportParams) { params.Reason = "boring" },
+			expected: profiles.ErrInvalidInput,
+		},
+		"unknown reporter": {
+			params:   func(params *profiles.ReportParams) { params.ReporterIPHash = "" },
+			expected: profiles.ErrInvalidInput,
+		},
+		"unknown profile": {
+			params:   func(params *profiles.ReportParams) { params.ProfileSlug = "ghost" },
+			expected: profiles.ErrProfileNotFound,
+		},
+		"unknown page": {
+			params: func(params *profiles.ReportParams) {
+				params.TargetKind = profiles.ReportTargetPage
+				params.TargetSlug = "missing"
+			},
+			expected: profiles.ErrReportTargetNotFound,
+		},
+		"link of another profile": {
+			params: func(params *profiles.ReportParams) {
+				params.TargetKind = profiles.ReportTargetLink
+				params.TargetSlug = "globex-link"
+			},
+			expected: profiles.ErrReportTargetNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, _ := newProfileReportService(10)
+
+			params := reportParams(nil, profiles.ReportTargetProfile, "")
+			tt.params(&params)
+
+			_, err := service.Report(t.Context(), params)
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, repo.reports)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultRecentViewsLimit is the number of recently viewed profiles kept per user
+// when the configuration doesn't set one.
+const DefaultRecentViewsLimit = 20
+
+// RecentProfileView is a profile the user viewed recently.
+type RecentProfileView struct {
+	Profile  *ProfileBrief `json:"profile"`
+	ViewedAt time.Time     `json:"viewed_at"`
+}
+
+func (s *Service) recentViewsLimit() int {
+	if s.config == nil || s.config.RecentViewsLimit <= 0 {
+		return DefaultRecentViewsLimit
+	}
+
+	return s.config.RecentViewsLimit
+}
+
+// RecordRecentProfileView moves the profile to the top of the user's recently
+// viewed list, dropping the oldest entries beyond the limit. Views of the user's
+// own individual profile and of profiles they own are not recorded.
+func (s *Service) RecordRecentProfileView(
+	ctx context.Context,
+	userID string,
+	profileID string,
+) error {
+	err := s.repo.RecordRecentProfileView(ctx, userID, profileID, s.recentViewsLimit())
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
+	}
+
+	return nil
+}
+
+// ListRecentlyViewedProfiles returns the user's recently viewed profiles, most recent first.
+func (s *Service) ListRecentlyViewedProfiles(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+) ([]*RecentProfileView, error) {
+	views, err := s.repo.ListRecentProfileViews(ctx, localeCode, userID, s.recentViewsLimit())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	return views, nil
+}
@@ -0,0 +1,93 @@
+package profiles_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errRecentViewsFailed = errors.New("recent views failed")
+
+type recentViewsRepository struct {
+	profiles.Repository
+
+	err       error
+	keepCount int
+	limit     int
+}
+
+func (r *recentViewsRepository) RecordRecentProfileView(
+	_ context.Context,
+	_ string,
+	_ string,
+	keepCount int,
+) error {
+	r.keepCount = keepCount
+
+	return r.err
+}
+
+func (r *recentViewsRepository) ListRecentProfileViews(
+	_ context.Context,
+	_ string,
+	_ string,
+	limit int,
+) ([]*profiles.RecentProfileView, error) {
+	r.limit = limit
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return []*profiles.RecentProfileView{
+		{Profile: &profiles.ProfileBrief{ID: "profile-1", Slug: "eser"}}, //nolint:exhaustruct
+	}, nil
+}
+
+func TestService_RecentViews_DefaultLimit(t *testing.T) {
+	t.Parallel()
+
+	repo := &recentViewsRepository{} //nolint:exhaustruct
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	require.NoError(t, service.RecordRecentProfileView(t.Context(), "user-1", "profile-1"))
+	assert.Equal(t, profiles.DefaultRecentViewsLimit, repo.keepCount)
+
+	views, err := service.ListRecentlyViewedProfiles(t.Context(), "en", "user-1")
+	require.NoError(t, err)
+	assert.Len(t, views, 1)
+	assert.Equal(t, profiles.DefaultRecentViewsLimit, repo.limit)
+}
+
+func TestService_RecentViews_ConfiguredLimit(t *testing.T) {
+	t.Parallel()
+
+	repo := &recentViewsRepository{}                //nolint:exhaustruct
+	config := &profiles.Config{RecentViewsLimit: 5} //nolint:exhaustruct
+	service := profiles.NewService(nil, config, repo, nil)
+
+	require.NoError(t, service.RecordRecentProfileView(t.Context(), "user-1", "profile-1"))
+	assert.Equal(t, 5, repo.keepCount)
+
+	_, err := service.ListRecentlyViewedProfiles(t.Context(), "en", "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, 5, repo.limit)
+}
+
+func TestService_RecentViews_WrapsErrors(t *testing.T) {
+	t.Parallel()
+
+	repo := &recentViewsRepository{err: errRecentViewsFailed} //nolint:exhaustruct
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	err := service.RecordRecentProfileView(t.Context(), "user-1", "profile-1")
+	require.ErrorIs(t, err, profiles.ErrFailedToUpdateRecord)
+	require.ErrorIs(t, err, errRecentViewsFailed)
+
+	_, err = service.ListRecentlyViewedProfiles(t.Context(), "en", "user-1")
+	require.ErrorIs(t, err, profiles.ErrFailedToListRecords)
+}
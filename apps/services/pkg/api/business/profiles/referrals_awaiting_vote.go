@@ -0,0 +1,98 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProfileReferralsAwaitingVote groups the referrals of one profile that are
+// waiting for the viewer's vote.
+type ProfileReferralsAwaitingVote struct {
+	Profile    *ProfileBrief                 `json:"profile"`
+	Candidates []*ProfileMembershipCandidate `json:"candidates"`
+}
+
+// ListReferralsAwaitingVote collects, across every profile the user is a
+// member+ of, the candidates in voting status the user hasn't voted on yet.
+// Profiles without such candidates are left out.
+func (s *Service) ListReferralsAwaitingVote(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+) ([]*ProfileReferralsAwaitingVote, error) {
+	userInfo, err := s.repo.GetUserBriefInfo(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+	}
+
+	if userInfo == nil || userInfo.IndividualProfileID == nil {
+		return []*ProfileReferralsAwaitingVote{}, nil
+	}
+
+	memberships, err := s.repo.GetProfileMembershipsByMemberProfileID(
+		ctx,
+		localeCode,
+		*userInfo.IndividualProfileID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
+	}
+
+	levels := GetMembershipKindLevel()
+	result := make([]*ProfileReferralsAwaitingVote, 0)
+
+	for _, membership := range memberships {
+		if membership.Profile == nil ||
+			levels[MembershipKind(membership.Kind)] < levels[MembershipKindMember] {
+			continue
+		}
+
+		candidates, err := s.repo.ListProfileMembershipCandidatesByProfileID(
+			ctx,
+			localeCode,
+			membership.Profile.ID,
+			&membership.ID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"%w(profile_id: %s): %w",
+				ErrFailedToListRecords,
+				membership.Profile.ID,
+				err,
+			)
+		}
+
+		awaiting := make([]*ProfileMembershipCandidate, 0, len(candidates))
+
+		for _, candidate := range candidates {
+			if candidate.Status != CandidateStatusVoting || candidate.ViewerVoteScore != nil {
+				continue
+			}
+
+			teams, teamsErr := s.repo.ListCandidateTeams(ctx, candidate.ID)
+			if teamsErr == nil {
+				candidate.Teams = teams
+			}
+
+			awaiting = append(awaiting, candidate)
+		}
+
+		if len(awaiting) == 0 {
+			continue
+		}
+
+		result = append(result, &ProfileReferralsAwaitingVote{
+			Profile: &ProfileBrief{
+				ID:                membership.Profile.ID,
+				Slug:              membership.Profile.Slug,
+				Kind:              membership.Profile.Kind,
+				ProfilePictureURI: membership.Profile.ProfilePictureURI,
+				Title:             membership.Profile.Title,
+				Description:       membership.Profile.Description,
+			},
+			Candidates: awaiting,
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// referralsRepository holds the user's memberships and the candidates of each
+// profile, keyed by profile ID. Votes are keyed by candidate ID, then by voter
+// membership ID.
+type referralsRepository struct {
+	profiles.Repository
+
+	memberships []*profiles.ProfileMembership
+	candidates  map[string][]*profiles.ProfileMembershipCandidate
+	votes       map[string]map[string]int16
+}
+
+func (r *referralsRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	individualProfileID := "profile-user"
+
+	return &profiles.UserBriefInfo{ //nolint:exhaustruct
+		IndividualProfileID: &individualProfileID,
+		Kind:                "regular",
+	}, nil
+}
+
+func (r *referralsRepository) GetProfileMembershipsByMemberProfileID(
+	_ context.Context,
+	_ string,
+	_ string,
+) ([]*profiles.ProfileMembership, error) {
+	return r.memberships, nil
+}
+
+func (r *referralsRepository) ListProfileMembershipCandidatesByProfileID(
+	_ context.Context,
+	_ string,
+	profileID string,
+	viewerMembershipID *string,
+) ([]*profiles.ProfileMembershipCandidate, error) {
+	result := make([]*profiles.ProfileMembershipCandidate, 0)
+
+	for _, candidate := range r.candidates[profileID] {
+		copied := *candidate
+
+		if score, ok := r.votes[candidate.ID][*viewerMembershipID]; ok {
+			copied.ViewerVoteScore = &score
+		}
+
+		result = append(result, &copied)
+	}
+
+	return result, nil
+}
+
+func (r *referralsRepository) ListCandidateTeams(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileTeam, error) {
+	return []*profiles.ProfileTeam{}, nil
+}
+
+func referralsMembership(
+	id string,
+	kind profiles.MembershipKind,
+	profileSlug string,
+) *profiles.ProfileMembership {
+	return &profiles.ProfileMembership{ //nolint:exhaustruct
+		ID:      id,
+		Kind:    string(kind),
+		Profile: &profiles.Profile{ID: profileSlug, Slug: profileSlug}, //nolint:exhaustruct
+	}
+}
+
+func TestService_ListReferralsAwaitingVote(t *testing.T) {
+	t.Parallel()
+
+	repo := &referralsRepository{
+		memberships: []*profiles.ProfileMembership{
+			referralsMembership("membership-acme", profiles.MembershipKindMember, "acme"),
+			referralsMembership("membership-jsmk", profiles.MembershipKindMaintainer, "jsmk"),
+			// Below member: candidates are not visible, so not listed
+			referralsMembership("membership-news", profiles.MembershipKindSponsor, "news"),
+			// Nothing left to vote on: the profile is left out
+			referralsMembership("membership-done", profiles.MembershipKindMember, "done"),
+		},
+		candidates: map[string][]*profiles.ProfileMembershipCandidate{
+			"acme": {
+				{ID: "acme-open", Status: profiles.CandidateStatusVoting},   //nolint:exhaustruct
+				{ID: "acme-voted", Status: profiles.CandidateStatusVoting},  //nolint:exhaustruct
+				{ID: "acme-frozen", Status: profiles.CandidateStatusFrozen}, //nolint:exhaustruct
+			},
+			"jsmk": {
+				{ID: "jsmk-open", Status: profiles.CandidateStatusVoting}, //nolint:exhaustruct
+				{ //nolint:exhaustruct
+					ID:     "jsmk-accepted",
+					Status: profiles.CandidateStatusInvitationAccepted,
+				},
+			},
+			"news": {
+				{ID: "news-open", Status: profiles.CandidateStatusVoting}, //nolint:exhaustruct
+			},
+			"done": {
+				{ID: "done-voted", Status: profiles.CandidateStatusVoting}, //nolint:exhaustruct
+			},
+		},
+		votes: map[string]map[string]int16{
+			"acme-voted": {"membership-acme": 3},
+			// Someone else's vote doesn't count as the user's
+			"jsmk-open":  {"membership-other": 4},
+			"done-voted": {"membership-done": 0},
+		},
+	}
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	groups, err := service.ListReferralsAwaitingVote(t.Context(), "en", "user-1")
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	assert.Equal(t, "acme", groups[0].Profile.Slug)
+	require.Len(t, groups[0].Candidates, 1)
+	assert.Equal(t, "acme-open", groups[0].Candidates[0].ID)
+
+	assert.Equal(t, "jsmk", groups[1].Profile.Slug)
+	require.Len(t, groups[1].Candidates, 1)
+	assert.Equal(t, "jsmk-open", groups[1].Candidates[0].ID)
+}
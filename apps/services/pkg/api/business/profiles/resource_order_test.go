@@ -0,0 +1,115 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resourceOrderUpdate struct {
+	id         string
+	order      int
+	isFeatured bool
+}
+
+// resourceOrderRepository serves the calls made while reordering resources.
+type resourceOrderRepository struct {
+	profiles.Repository
+
+	resourceIDs []string
+	updates     []resourceOrderUpdate
+}
+
+func (r *resourceOrderRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *resourceOrderRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *resourceOrderRepository) ListProfileResourceIDsByProfileID(
+	_ context.Context,
+	_ string,
+) ([]string, error) {
+	return r.resourceIDs, nil
+}
+
+func (r *resourceOrderRepository) UpdateProfileResourceOrder(
+	_ context.Context,
+	id string,
+	order int,
+	isFeatured bool,
+) error {
+	r.updates = append(r.updates, resourceOrderUpdate{id: id, order: order, isFeatured: isFeatured})
+
+	return nil
+}
+
+func TestReorderProfileResources(t *testing.T) {
+	t.Parallel()
+
+	repo := &resourceOrderRepository{resourceIDs: []string{"a", "b", "c"}} //nolint:exhaustruct
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	err := service.ReorderProfileResources(
+		t.Context(), "user-1", profiles.UserKindAdmin, "target",
+		[]profiles.ResourceOrderItem{
+			{ID: "c", IsFeatured: true},
+			{ID: "a", IsFeatured: false},
+			{ID: "b", IsFeatured: false},
+		},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, []resourceOrderUpdate{
+		{id: "c", order: 1, isFeatured: true},
+		{id: "a", order: 2, isFeatured: false},
+		{id: "b", order: 3, isFeatured: false},
+	}, repo.updates)
+}
+
+func TestReorderProfileResources_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string][]profiles.ResourceOrderItem{
+		"missing resource": {
+			{ID: "a", IsFeatured: false},
+			{ID: "b", IsFeatured: false},
+		},
+		"duplicate resource": {
+			{ID: "a", IsFeatured: false},
+			{ID: "b", IsFeatured: false},
+			{ID: "b", IsFeatured: false},
+		},
+		"unknown resource": {
+			{ID: "a", IsFeatured: false},
+			{ID: "b", IsFeatured: false},
+			{ID: "c", IsFeatured: false},
+			{ID: "other-profile", IsFeatured: false},
+		},
+		"empty order": nil,
+	}
+
+	for name, items := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &resourceOrderRepository{resourceIDs: []string{"a", "b", "c"}} //nolint:exhaustruct
+			service := profiles.NewService(nil, nil, repo, nil)
+
+			err := service.ReorderProfileResources(
+				t.Context(), "user-1", profiles.UserKindAdmin, "target", items,
+			)
+
+			require.ErrorIs(t, err, profiles.ErrInvalidInput)
+			assert.Empty(t, repo.updates)
+		})
+	}
+}
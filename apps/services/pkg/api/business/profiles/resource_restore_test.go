@@ -0,0 +1,123 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resourceRestoreRepository serves the calls made while restoring a resource.
+type resourceRestoreRepository struct {
+	profiles.Repository
+
+	deleted  *profiles.ProfileResource
+	active   *profiles.ProfileResource
+	restored []string
+}
+
+func (r *resourceRestoreRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *resourceRestoreRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *resourceRestoreRepository) GetDeletedProfileResourceByID(
+	_ context.Context,
+	id string,
+) (*profiles.ProfileResource, error) {
+	if r.deleted == nil || r.deleted.ID != id {
+		return nil, nil //nolint:nilnil
+	}
+
+	return r.deleted, nil
+}
+
+func (r *resourceRestoreRepository) GetProfileResourceByRemoteID(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ string,
+) (*profiles.ProfileResource, error) {
+	return r.active, nil
+}
+
+func (r *resourceRestoreRepository) RestoreProfileResource(_ context.Context, id string) error {
+	r.restored = append(r.restored, id)
+
+	return nil
+}
+
+func newResourceRestoreService(
+	active *profiles.ProfileResource,
+) (*profiles.Service, *resourceRestoreRepository, *recordingAuditRepository) {
+	remoteID := "12345"
+
+	repo := &resourceRestoreRepository{ //nolint:exhaustruct
+		deleted: &profiles.ProfileResource{ //nolint:exhaustruct
+			ID:        "resource-1",
+			ProfileID: "target-profile",
+			Kind:      "github",
+			RemoteID:  &remoteID,
+		},
+		active: active,
+	}
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, nil, repo, auditService), repo, auditRepo
+}
+
+func TestRestoreProfileResource(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newResourceRestoreService(nil)
+
+	resource, err := service.RestoreProfileResource(
+		t.Context(), "user-1", profiles.UserKindAdmin, "target", "resource-1",
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "resource-1", resource.ID)
+	assert.Nil(t, resource.DeletedAt)
+	assert.Equal(t, []string{"resource-1"}, repo.restored)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileResourceRestored, auditRepo.entries[0].EventType)
+}
+
+func TestRestoreProfileResource_RemoteIDConflict(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newResourceRestoreService(
+		&profiles.ProfileResource{ID: "resource-2", ProfileID: "target-profile"}, //nolint:exhaustruct
+	)
+
+	_, err := service.RestoreProfileResource(
+		t.Context(), "user-1", profiles.UserKindAdmin, "target", "resource-1",
+	)
+
+	require.ErrorIs(t, err, profiles.ErrDuplicateRecord)
+	assert.Empty(t, repo.restored)
+	assert.Empty(t, auditRepo.entries)
+}
+
+func TestRestoreProfileResource_NotDeleted(t *testing.T) {
+	t.Parallel()
+
+	service, repo, _ := newResourceRestoreService(nil)
+
+	_, err := service.RestoreProfileResource(
+		t.Context(), "user-1", profiles.UserKindAdmin, "target", "resource-unknown",
+	)
+
+	require.ErrorIs(t, err, profiles.ErrResourceNotFound)
+	assert.Empty(t, repo.restored)
+}
@@ -0,0 +1,185 @@
+package profiles_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resourceTeamsRepository serves the calls made while listing profile resources.
+type resourceTeamsRepository struct {
+	profiles.Repository
+
+	resources          []*profiles.ProfileResource
+	teamsByResource    map[string][]*profiles.ProfileTeam
+	batchCalls         int
+	perResourceCalls   int
+	requestedResources []string
+	requestedFilter    profiles.ProfileResourceFilter
+}
+
+func (r *resourceTeamsRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *resourceTeamsRepository) ListProfileResourcesByProfileID(
+	_ context.Context,
+	_ string,
+	filter profiles.ProfileResourceFilter,
+) ([]*profiles.ProfileResource, error) {
+	r.requestedFilter = filter
+
+	return r.resources, nil
+}
+
+func (r *resourceTeamsRepository) ListTeamsForResources(
+	_ context.Context,
+	resourceIDs []string,
+) (map[string][]*profiles.ProfileTeam, error) {
+	r.batchCalls++
+	r.requestedResources = resourceIDs
+
+	return r.teamsByResource, nil
+}
+
+func (r *resourceTeamsRepository) ListResourceTeams(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileTeam, error) {
+	r.perResourceCalls++
+
+	return nil, nil
+}
+
+func newResourceTeamsRepository(resourceCount int) *resourceTeamsRepository {
+	repo := &resourceTeamsRepository{ //nolint:exhaustruct
+		resources:       make([]*profiles.ProfileResource, resourceCount),
+		teamsByResource: map[string][]*profiles.ProfileTeam{},
+	}
+
+	for i := range resourceCount {
+		resourceID := fmt.Sprintf("resource-%d", i)
+		repo.resources[i] = &profiles.ProfileResource{ID: resourceID} //nolint:exhaustruct
+
+		// Only every other resource belongs to a team.
+		if i%2 == 0 {
+			repo.teamsByResource[resourceID] = []*profiles.ProfileTeam{
+				{ID: "team-" + resourceID, Name: "Team"}, //nolint:exhaustruct
+			}
+		}
+	}
+
+	return repo
+}
+
+func TestListProfileResources_BatchesTeamLookups(t *testing.T) {
+	t.Parallel()
+
+	for _, resourceCount := range []int{1, 10, 50} {
+		t.Run(fmt.Sprintf("%d resources", resourceCount), func(t *testing.T) {
+			t.Parallel()
+
+			repo := newResourceTeamsRepository(resourceCount)
+			service := profiles.NewService(nil, nil, repo, nil)
+
+			resources, err := service.ListProfileResources(
+				t.Context(), "en", "", "", "target", profiles.ProfileResourceFilter{}, //nolint:exhaustruct
+			)
+
+			require.NoError(t, err)
+			require.Len(t, resources, resourceCount)
+
+			assert.Equal(t, 1, repo.batchCalls)
+			assert.Zero(t, repo.perResourceCalls)
+			assert.Len(t, repo.requestedResources, resourceCount)
+
+			for i, resource := range resources {
+				if i%2 == 0 {
+					assert.Len(t, resource.Teams, 1, resource.ID)
+				} else {
+					assert.NotNil(t, resource.Teams, resource.ID)
+					assert.Empty(t, resource.Teams, resource.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestListProfileResources_Filters(t *testing.T) {
+	t.Parallel()
+
+	kind := "github"
+	teamID := "team-1"
+
+	tests := map[string]struct {
+		filter   profiles.ProfileResourceFilter
+		expected profiles.ProfileResourceFilter
+	}{
+		"no filters": {
+			filter:   profiles.ProfileResourceFilter{Kind: nil, TeamID: nil, Sort: ""},
+			expected: profiles.ProfileResourceFilter{Kind: nil, TeamID: nil, Sort: profiles.ResourceSortManual},
+		},
+		"kind only": {
+			filter:   profiles.ProfileResourceFilter{Kind: &kind, TeamID: nil, Sort: ""},
+			expected: profiles.ProfileResourceFilter{Kind: &kind, TeamID: nil, Sort: profiles.ResourceSortManual},
+		},
+		"team only": {
+			filter: profiles.ProfileResourceFilter{Kind: nil, TeamID: &teamID, Sort: ""},
+			expected: profiles.ProfileResourceFilter{
+				Kind:   nil,
+				TeamID: &teamID,
+				Sort:   profiles.ResourceSortManual,
+			},
+		},
+		"kind and team, alphabetical": {
+			filter: profiles.ProfileResourceFilter{
+				Kind:   &kind,
+				TeamID: &teamID,
+				Sort:   profiles.ResourceSortAlphabetical,
+			},
+			expected: profiles.ProfileResourceFilter{
+				Kind:   &kind,
+				TeamID: &teamID,
+				Sort:   profiles.ResourceSortAlphabetical,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newResourceTeamsRepository(2)
+			service := profiles.NewService(nil, nil, repo, nil)
+
+			resources, err := service.ListProfileResources(t.Context(), "en", "", "", "target", tt.filter)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, repo.requestedFilter)
+
+			// Team annotations still apply to filtered listings.
+			require.Len(t, resources, 2)
+			assert.Len(t, resources[0].Teams, 1)
+			assert.NotNil(t, resources[1].Teams)
+		})
+	}
+}
+
+func TestListProfileResources_RejectsUnknownSort(t *testing.T) {
+	t.Parallel()
+
+	repo := newResourceTeamsRepository(1)
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	_, err := service.ListProfileResources(
+		t.Context(), "en", "", "", "target",
+		profiles.ProfileResourceFilter{Kind: nil, TeamID: nil, Sort: "popular"},
+	)
+
+	require.ErrorIs(t, err, profiles.ErrInvalidInput)
+	assert.Zero(t, repo.batchCalls)
+}
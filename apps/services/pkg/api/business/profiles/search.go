@@ -0,0 +1,212 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+var (
+	ErrInvalidSearchKind   = errors.New("invalid search kind")
+	ErrInvalidSearchCursor = errors.New("invalid search cursor")
+	ErrSearchQueryTooLong  = errors.New("search query is too long")
+)
+
+// Search result types, usable as kind filters.
+const (
+	SearchKindProfile = "profile"
+	SearchKindStory   = "story"
+	SearchKindPage    = "page"
+)
+
+// Search limits used when the configuration doesn't set them.
+const (
+	DefaultSearchLimit          = 20
+	DefaultSearchMaxLimit       = 100
+	DefaultSearchMaxQueryLength = 200
+)
+
+// Search suggestions are asked for on every keystroke, so queries shorter than
+// MinSuggestQueryLength are answered with no suggestions without querying.
+const (
+	MinSuggestQueryLength = 2
+	DefaultSuggestLimit   = 8
+	MaxSuggestLimit       = 20
+)
+
+// SearchParams describes a full-text search. Kinds restricts the results to
+// the given result types; all types are searched when it is empty. The cursor
+// offset is the number of results already read.
+type SearchParams struct {
+	Cursor      *cursors.Cursor
+	ProfileSlug *string
+	Query       string
+	Kinds       []string
+}
+
+// SearchPage is a page of search results along with the limit it was read
+// with, after the service applied its default and maximum.
+type SearchPage struct {
+	cursors.Cursored[[]*SearchResult]
+
+	Limit int `json:"limit"`
+}
+
+func (s *Service) searchDefaultLimit() int {
+	if s.config == nil || s.config.SearchDefaultLimit <= 0 {
+		return DefaultSearchLimit
+	}
+
+	return s.config.SearchDefaultLimit
+}
+
+func (s *Service) searchMaxLimit() int {
+	if s.config == nil || s.config.SearchMaxLimit <= 0 {
+		return DefaultSearchMaxLimit
+	}
+
+	return s.config.SearchMaxLimit
+}
+
+func (s *Service) searchMaxQueryLength() int {
+	if s.config == nil || s.config.SearchMaxQueryLength <= 0 {
+		return DefaultSearchMaxQueryLength
+	}
+
+	return s.config.SearchMaxQueryLength
+}
+
+// Search performs a full-text search across profiles, stories, and profile pages.
+// If a profile slug is provided, search is scoped to that profile only. The
+// returned cursor is nil on the last page. A cursor limit of zero or less
+// means the configured default; larger limits are clamped to the configured
+// maximum.
+func (s *Service) Search(
+	ctx context.Context,
+	localeCode string,
+	params *SearchParams,
+) (SearchPage, error) {
+	cursor := params.Cursor
+	if cursor == nil {
+		cursor = cursors.NewCursor(0, nil)
+	}
+
+	limit := cursor.Limit
+	if limit <= 0 {
+		limit = s.searchDefaultLimit()
+	}
+
+	limit = min(limit, s.searchMaxLimit(), math.MaxInt32)
+
+	query := strings.TrimSpace(params.Query)
+	if query == "" {
+		return SearchPage{
+			Cursored: cursors.WrapResponseWithCursor([]*SearchResult{}, nil),
+			Limit:    limit,
+		}, nil
+	}
+
+	if utf8.RuneCountInString(query) > s.searchMaxQueryLength() {
+		return SearchPage{}, fmt.Errorf(
+			"%w: at most %d characters", ErrSearchQueryTooLong, s.searchMaxQueryLength(),
+		)
+	}
+
+	for _, kind := range params.Kinds {
+		if kind != SearchKindProfile && kind != SearchKindStory && kind != SearchKindPage {
+			return SearchPage{}, fmt.Errorf("%w: %q", ErrInvalidSearchKind, kind)
+		}
+	}
+
+	offset, err := parseSearchOffset(cursor.Offset)
+	if err != nil {
+		return SearchPage{}, err
+	}
+
+	if params.ProfileSlug != nil {
+		profileID, err := s.repo.GetProfileIDBySlug(ctx, *params.ProfileSlug)
+		if err != nil {
+			return SearchPage{}, fmt.Errorf(
+				"%w(slug: %s): %w", ErrFailedToGetRecord, *params.ProfileSlug, err,
+			)
+		}
+
+		if profileID == "" {
+			return SearchPage{}, ErrProfileNotFound
+		}
+	}
+
+	results, err := s.repo.Search(
+		ctx,
+		localeCode,
+		query,
+		params.ProfileSlug,
+		slices.Compact(slices.Sorted(slices.Values(params.Kinds))),
+		offset,
+		int32(limit), //nolint:gosec
+	)
+	if err != nil {
+		return SearchPage{}, fmt.Errorf("%w: %w", ErrSearchFailed, err)
+	}
+
+	var nextCursor *string
+
+	if len(results) == limit && len(results) > 0 {
+		next := strconv.Itoa(int(offset) + len(results))
+		nextCursor = &next
+	}
+
+	return SearchPage{
+		Cursored: cursors.WrapResponseWithCursor(results, nextCursor),
+		Limit:    limit,
+	}, nil
+}
+
+// SuggestProfiles returns the approved, non-deleted profiles whose slug or
+// title starts with the query, for autocompletion. Slug matches come first.
+// The limit is clamped to [1, MaxSuggestLimit]; zero or less means
+// DefaultSuggestLimit.
+func (s *Service) SuggestProfiles(
+	ctx context.Context,
+	localeCode string,
+	query string,
+	limit int,
+) ([]*ProfileSuggestion, error) {
+	prefix := strings.ToLower(strings.TrimSpace(query))
+	if utf8.RuneCountInString(prefix) < MinSuggestQueryLength {
+		return []*ProfileSuggestion{}, nil
+	}
+
+	if limit <= 0 {
+		limit = DefaultSuggestLimit
+	}
+
+	limit = min(limit, MaxSuggestLimit)
+
+	suggestions, err := s.repo.SuggestProfiles(ctx, localeCode, prefix, int32(limit)) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSearchFailed, err)
+	}
+
+	return suggestions, nil
+}
+
+func parseSearchOffset(value *string) (int32, error) {
+	if value == nil || *value == "" {
+		return 0, nil
+	}
+
+	offset, err := strconv.ParseInt(*value, 10, 32)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidSearchCursor, *value)
+	}
+
+	return int32(offset), nil
+}
@@ -0,0 +1,285 @@
+package profiles_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// searchRepository serves a fixed, ranked set of search results.
+type searchRepository struct {
+	profiles.Repository
+
+	results []*profiles.SearchResult
+
+	searchLimits    []int32
+	suggestPrefixes []string
+}
+
+func newSearchRepository() *searchRepository {
+	results := make([]*profiles.SearchResult, 0)
+
+	for i, kind := range []string{
+		profiles.SearchKindStory,
+		profiles.SearchKindProfile,
+		profiles.SearchKindStory,
+		profiles.SearchKindPage,
+		profiles.SearchKindStory,
+	} {
+		results = append(results, &profiles.SearchResult{ //nolint:exhaustruct
+			Type: kind,
+			ID:   kind + "-" + string(rune('a'+i)),
+			Rank: float32(10 - i),
+		})
+	}
+
+	return &searchRepository{results: results}
+}
+
+func (r *searchRepository) Search(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ *string,
+	kinds []string,
+	offset int32,
+	limit int32,
+) ([]*profiles.SearchResult, error) {
+	r.searchLimits = append(r.searchLimits, limit)
+
+	matching := make([]*profiles.SearchResult, 0)
+
+	for _, result := range r.results {
+		if len(kinds) == 0 || slices.Contains(kinds, result.Type) {
+			matching = append(matching, result)
+		}
+	}
+
+	matching = matching[min(int(offset), len(matching)):]
+
+	return matching[:min(int(limit), len(matching))], nil
+}
+
+// SuggestProfiles serves up to 50 profiles named after the prefix.
+func (r *searchRepository) SuggestProfiles(
+	_ context.Context,
+	_ string,
+	prefix string,
+	limit int32,
+) ([]*profiles.ProfileSuggestion, error) {
+	r.suggestPrefixes = append(r.suggestPrefixes, prefix)
+
+	suggestions := make([]*profiles.ProfileSuggestion, 0, limit)
+	for i := range min(int(limit), 50) {
+		suggestions = append(suggestions, &profiles.ProfileSuggestion{ //nolint:exhaustruct
+			Slug: fmt.Sprintf("%s-%d", prefix, i),
+		})
+	}
+
+	return suggestions, nil
+}
+
+func resultIDs(results []*profiles.SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, result := range results {
+		ids[i] = result.ID
+	}
+
+	return ids
+}
+
+func TestSearch_RestrictedToStories(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, &profiles.Config{}, newSearchRepository(), nil) //nolint:exhaustruct
+
+	page, err := service.Search(t.Context(), "en", &profiles.SearchParams{
+		Query:       "go",
+		ProfileSlug: nil,
+		Kinds:       []string{profiles.SearchKindStory},
+		Cursor:      cursors.NewCursor(10, nil),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"story-a", "story-c", "story-e"}, resultIDs(page.Data))
+	assert.Nil(t, page.CursorPtr, "a page that is not full is the last one")
+
+	_, err = service.Search(t.Context(), "en", &profiles.SearchParams{
+		Query:       "go",
+		ProfileSlug: nil,
+		Kinds:       []string{"user"},
+		Cursor:      nil,
+	})
+	require.ErrorIs(t, err, profiles.ErrInvalidSearchKind)
+}
+
+func TestSearch_SecondPage(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, &profiles.Config{}, newSearchRepository(), nil) //nolint:exhaustruct
+
+	search := func(offset *string) profiles.SearchPage {
+		page, err := service.Search(t.Context(), "en", &profiles.SearchParams{
+			Query:       "go",
+			ProfileSlug: nil,
+			Kinds:       nil,
+			Cursor:      cursors.NewCursor(3, offset),
+		})
+		require.NoError(t, err)
+
+		return page
+	}
+
+	first := search(nil)
+	assert.Equal(t, []string{"story-a", "profile-b", "story-c"}, resultIDs(first.Data))
+	require.NotNil(t, first.CursorPtr)
+	assert.Equal(t, "3", *first.CursorPtr)
+
+	second := search(first.CursorPtr)
+	assert.Equal(t, []string{"page-d", "story-e"}, resultIDs(second.Data))
+	assert.Nil(t, second.CursorPtr)
+
+	invalid := "next"
+	_, err := service.Search(t.Context(), "en", &profiles.SearchParams{
+		Query:       "go",
+		ProfileSlug: nil,
+		Kinds:       nil,
+		Cursor:      cursors.NewCursor(3, &invalid),
+	})
+	require.ErrorIs(t, err, profiles.ErrInvalidSearchCursor)
+}
+
+func TestSearch_ClampsLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   profiles.Config
+		limit    int
+		expected int
+	}{
+		"zero uses the default":     {limit: 0, expected: profiles.DefaultSearchLimit},
+		"negative uses the default": {limit: -5, expected: profiles.DefaultSearchLimit},
+		"at the maximum":            {limit: profiles.DefaultSearchMaxLimit, expected: profiles.DefaultSearchMaxLimit},
+		"over the maximum":          {limit: profiles.DefaultSearchMaxLimit + 1, expected: profiles.DefaultSearchMaxLimit},
+		"configured default": {
+			config:   profiles.Config{SearchDefaultLimit: 5}, //nolint:exhaustruct
+			limit:    0,
+			expected: 5,
+		},
+		"configured maximum": {
+			config:   profiles.Config{SearchMaxLimit: 10}, //nolint:exhaustruct
+			limit:    11,
+			expected: 10,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newSearchRepository()
+			service := profiles.NewService(nil, &tt.config, repo, nil)
+
+			page, err := service.Search(t.Context(), "en", &profiles.SearchParams{
+				Query:       "go",
+				ProfileSlug: nil,
+				Kinds:       nil,
+				Cursor:      &cursors.Cursor{Limit: tt.limit}, //nolint:exhaustruct
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, page.Limit)
+			assert.Equal(t, []int32{int32(tt.expected)}, repo.searchLimits) //nolint:gosec
+		})
+	}
+}
+
+func TestSearch_RejectsLongQueries(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		query   string
+		tooLong bool
+	}{
+		"at the maximum":   {query: strings.Repeat("ş", profiles.DefaultSearchMaxQueryLength)},
+		"over the maximum": {query: strings.Repeat("ş", profiles.DefaultSearchMaxQueryLength+1), tooLong: true},
+		"padded to the maximum": {
+			query: "  " + strings.Repeat("a", profiles.DefaultSearchMaxQueryLength) + "  ",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newSearchRepository()
+			service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+			_, err := service.Search(t.Context(), "en", &profiles.SearchParams{
+				Query:       tt.query,
+				ProfileSlug: nil,
+				Kinds:       nil,
+				Cursor:      nil,
+			})
+
+			if tt.tooLong {
+				require.ErrorIs(t, err, profiles.ErrSearchQueryTooLong)
+				assert.Empty(t, repo.searchLimits)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, repo.searchLimits, 1)
+		})
+	}
+}
+
+func TestSuggestProfiles_ShortQueriesSkipTheRepository(t *testing.T) {
+	t.Parallel()
+
+	repo := newSearchRepository()
+	service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+	for _, query := range []string{"", "a", "  b  ", "ş"} {
+		suggestions, err := service.SuggestProfiles(t.Context(), "en", query, 5)
+		require.NoError(t, err)
+		assert.Empty(t, suggestions, query)
+	}
+
+	assert.Empty(t, repo.suggestPrefixes)
+}
+
+func TestSuggestProfiles_RespectsLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		limit    int
+		expected int
+	}{
+		"requested":    {limit: 3, expected: 3},
+		"default":      {limit: 0, expected: profiles.DefaultSuggestLimit},
+		"over maximum": {limit: 500, expected: profiles.MaxSuggestLimit},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := newSearchRepository()
+			service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+			suggestions, err := service.SuggestProfiles(t.Context(), "en", " Ay ", tt.limit)
+			require.NoError(t, err)
+			assert.Len(t, suggestions, tt.expected)
+			assert.Equal(t, []string{"ay"}, repo.suggestPrefixes)
+		})
+	}
+}
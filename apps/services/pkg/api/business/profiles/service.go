@@ -5,6 +5,7 @@ import (
 	"database/sql" // TODO: replace sql.NullTime with *time.Time to remove database/sql dependency
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"slices"
 	"strings"
@@ -37,6 +38,12 @@ var (
 	ErrLinksNotEnabled               = errors.New("links feature is not enabled for this profile")
 	ErrCannotDeleteTeamWithMembers   = errors.New("cannot delete team that has members")
 	ErrCannotDeleteTeamWithResources = errors.New("cannot delete team that has resources")
+	ErrTeamNotFound                  = errors.New("team not found")
+	ErrResourceNotFound              = errors.New("resource not found")
+	ErrTeamLeadNotMember             = errors.New("team lead must be a member of the team")
+	ErrCannotDeleteDefaultLocale     = errors.New("cannot delete the profile's default locale")
+	ErrLocaleNotFound                = errors.New("profile has no content in this locale")
+	ErrDefaultLocaleNotTranslated    = errors.New("profile has no translation in the default locale")
 	ErrCandidateAlreadyExists        = errors.New("candidate already exists for this profile")
 	ErrCannotReferSelf               = errors.New("cannot refer yourself")
 	ErrCannotReferExistingMember     = errors.New("cannot refer someone who is already a member")
@@ -59,29 +66,18 @@ var (
 	)
 )
 
-// SupportedLocaleCodes contains all locales supported by the platform.
-var SupportedLocaleCodes = map[string]bool{ //nolint:gochecknoglobals
-	"ar": true, "de": true, "en": true, "es": true,
-	"fr": true, "it": true, "ja": true, "ko": true,
-	"nl": true, "pt-PT": true, "ru": true, "tr": true,
-	"zh-CN": true,
-}
-
-// IsValidLocale checks whether the given locale code is supported.
-func IsValidLocale(localeCode string) bool {
-	return SupportedLocaleCodes[localeCode]
-}
-
 // Severity constants for slug availability results.
 const (
-	SeverityError   = "error"
-	SeverityWarning = "warning"
+	SeverityError    = "error"
+	SeverityWarning  = "warning"
+	SeverityReserved = "reserved"
 )
 
 // String constants used across the service.
 const (
-	UserKindAdmin         = "admin"
-	ProfileKindIndividual = "individual"
+	UserKindAdmin           = "admin"
+	ProfileKindIndividual   = "individual"
+	ProfileKindOrganization = "organization"
 )
 
 // minSlugLength is the minimum allowed length for slugs.
@@ -90,7 +86,7 @@ const minSlugLength = 2
 // SlugAvailabilityResult holds the result of a slug availability check.
 type SlugAvailabilityResult struct {
 	Message   string `json:"message,omitempty"`
-	Severity  string `json:"severity,omitempty"` // "error" | "warning" | ""
+	Severity  string `json:"severity,omitempty"` // "error" | "warning" | "reserved" | ""
 	Available bool   `json:"available"`
 }
 
@@ -103,8 +99,84 @@ type Config struct {
 	// that cannot be used as profile slugs.
 	ForbiddenSlugs string `conf:"forbidden_slugs" default:"about,admin,api,auth,communities,community,config,contact,contributions,dashboard,element,elements,events,faq,feed,guide,help,home,impressum,imprint,jobs,legal,login,logout,mailbox,new,news,null,organizations,orgs,people,policies,policy,privacy,product,products,profile,profiles,projects,register,root,search,services,settings,signin,signout,signup,site,stories,story,support,tag,tags,terms,tos,undefined,user,users,verify,wiki"` //nolint:lll
 
+	// SupportedLocales is a comma-separated list of the locales the platform
+	// serves, DefaultSupportedLocales when empty. Every code must be well formed
+	// and FallbackLocaleCode must be listed.
+	SupportedLocales string `conf:"supported_locales"`
+
 	// DNSVerification holds the expected DNS targets for custom domain verification.
 	DNSVerification DNSVerificationConfig `conf:"dns_verification"`
+
+	// DefaultAvatar configures avatars generated for profiles without a picture.
+	DefaultAvatar DefaultAvatarConfig `conf:"default_avatar"`
+
+	// SearchDefaultLimit is the number of search results returned when the
+	// caller asks for no particular limit.
+	SearchDefaultLimit int `conf:"search_default_limit" default:"20"`
+
+	// SearchMaxLimit caps the number of search results returned per page.
+	SearchMaxLimit int `conf:"search_max_limit" default:"100"`
+
+	// SearchMaxQueryLength caps the characters of a search query.
+	SearchMaxQueryLength int `conf:"search_max_query_length" default:"200"`
+
+	// RecentViewsLimit is the number of recently viewed profiles kept per user.
+	RecentViewsLimit int `conf:"recent_views_limit" default:"20"`
+
+	// AIMaxInputChars is the character budget for content sent to AI translation
+	// and generation.
+	AIMaxInputChars int `conf:"ai_max_input_chars" default:"60000"`
+
+	// AIRequestsPerHour caps the AI generations and translations a profile can
+	// request in an hour. Zero disables the limit.
+	AIRequestsPerHour int `conf:"ai_requests_per_hour" default:"20"`
+
+	// MaxTranslationLocales caps the distinct locales a profile or page can be
+	// translated into. Admins are not limited.
+	MaxTranslationLocales int `conf:"max_translation_locales" default:"8"`
+
+	// HandleReservationTTL is how long an admin handle reservation blocks its
+	// slug before it expires unclaimed.
+	HandleReservationTTL time.Duration `conf:"handle_reservation_ttl" default:"720h"`
+
+	// MembershipInvitationTTL is how long a membership invitation can be
+	// accepted before it expires.
+	MembershipInvitationTTL time.Duration `conf:"membership_invitation_ttl" default:"168h"`
+
+	// MaxPageContentLength caps the characters of a page's content per locale.
+	// Zero disables the limit.
+	MaxPageContentLength int `conf:"max_page_content_length" default:"200000"`
+
+	// LocaleFallbacks lists the locales tried, in order, when content has no
+	// translation in the requested locale, as semicolon-separated
+	// "locale:fallback,fallback" entries. DefaultLocaleCode is always tried last.
+	LocaleFallbacks string `conf:"locale_fallbacks" default:"pt-PT:es"`
+
+	// LinkedPictureKinds is a comma-separated list of managed link kinds whose
+	// account avatar is shown for profiles without a picture, in order of
+	// preference. Empty disables the fallback.
+	LinkedPictureKinds string `conf:"linked_picture_kinds" default:"github"`
+
+	// ImportProfileDelay is the pause between profiles while importing recent
+	// posts, to stay within the post provider's rate limits.
+	ImportProfileDelay time.Duration `conf:"import_profile_delay" default:"2s"`
+
+	// CandidateVotingWindow is how long a new candidate stays open for votes
+	// before it is resolved. Zero leaves voting open until a maintainer decides.
+	CandidateVotingWindow time.Duration `conf:"candidate_voting_window" default:"336h"`
+
+	// CandidateApprovalScore is the average vote score (0-4) a candidate needs
+	// when its voting deadline passes to be approved.
+	CandidateApprovalScore float64 `conf:"candidate_approval_score" default:"2.5"`
+
+	// CandidateMinVotes is the number of votes a candidate needs when its voting
+	// deadline passes to be approved. Candidates with fewer votes are rejected.
+	CandidateMinVotes int `conf:"candidate_min_votes" default:"1"`
+
+	// ReportRateLimit is the maximum number of reports a user or, for anonymous
+	// reports, an IP address can file within ReportRateWindow. Zero disables the limit.
+	ReportRateLimit  int           `conf:"report_rate_limit"  default:"10"`
+	ReportRateWindow time.Duration `conf:"report_rate_window" default:"1h"`
 }
 
 // GetAllowedURIPrefixes returns the allowed URI prefixes as a slice.
@@ -145,6 +217,64 @@ func (c *Config) GetForbiddenSlugs() map[string]bool {
 	return result
 }
 
+// GetSupportedLocales returns the configured locale codes, in order, or the
+// DefaultSupportedLocales when none are configured.
+func (c *Config) GetSupportedLocales() []string {
+	if c == nil || c.SupportedLocales == "" {
+		return splitLocaleCodes(DefaultSupportedLocales)
+	}
+
+	return splitLocaleCodes(c.SupportedLocales)
+}
+
+// GetLinkedPictureKinds returns the link kinds to take a default picture from,
+// in order of preference.
+func (c *Config) GetLinkedPictureKinds() []string {
+	if c.LinkedPictureKinds == "" {
+		return nil
+	}
+
+	kinds := strings.Split(c.LinkedPictureKinds, ",")
+	result := make([]string, 0, len(kinds))
+
+	for _, kind := range kinds {
+		trimmed := strings.TrimSpace(kind)
+		if trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+
+	return result
+}
+
+// GetLocaleFallbacks returns the fallback locales configured for each locale.
+func (c *Config) GetLocaleFallbacks() map[string][]string {
+	if c.LocaleFallbacks == "" {
+		return nil
+	}
+
+	entries := strings.Split(c.LocaleFallbacks, ";")
+	result := make(map[string][]string, len(entries))
+
+	for _, entry := range entries {
+		localeCode, fallbacks, ok := strings.Cut(entry, ":")
+		localeCode = strings.TrimSpace(localeCode)
+
+		if !ok || localeCode == "" {
+			continue
+		}
+
+		for fallback := range strings.SplitSeq(fallbacks, ",") {
+			trimmed := strings.TrimSpace(fallback)
+			if trimmed != "" {
+				result[localeCode] = append(result[localeCode], trimmed)
+			}
+		}
+	}
+
+	return result
+}
+
 // validateOptionalURL validates that a URL is either nil or a valid http/https URL.
 func validateOptionalURL(uri *string) error {
 	if uri == nil {
@@ -164,6 +294,20 @@ func validateOptionalURL(uri *string) error {
 	return nil
 }
 
+// validateOptionalTimestamp validates that a timestamp is either nil, empty or RFC 3339.
+func validateOptionalTimestamp(field string, value *string) error {
+	if value == nil || *value == "" {
+		return nil
+	}
+
+	_, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return fmt.Errorf("%w: %s must be an RFC 3339 timestamp: %s", ErrInvalidInput, field, *value)
+	}
+
+	return nil
+}
+
 // validateURIPrefixes validates that a URI starts with one of the allowed prefixes.
 // This is used to restrict non-admin users to only use URIs from our upload service.
 func validateURIPrefixes(uri *string, allowedPrefixes []string) error {
@@ -193,7 +337,39 @@ type RecentPostsFetcher interface {
 }
 
 type Repository interface { //nolint:interfacebloat
+	// WithTx runs fn in a single transaction; a non-nil error rolls back every call made through txRepo.
+	WithTx(ctx context.Context, fn func(txRepo Repository) error) error
 	GetProfileIDBySlug(ctx context.Context, slug string) (string, error)
+	GetDeletedProfileIDBySlug(ctx context.Context, slug string) (string, error)
+	InvalidateProfileSlugCache(ctx context.Context, slug string) error
+	UpdateProfileSlug(ctx context.Context, id string, slug string) (bool, error)
+	InsertProfileSlugHistory(ctx context.Context, id string, profileID string, slug string) error
+	DeleteProfileSlugHistory(ctx context.Context, profileID string, slug string) (int64, error)
+	GetProfileSlugHistoryProfileID(ctx context.Context, slug string) (string, error)
+	GetCurrentSlugByPreviousSlug(ctx context.Context, slug string) (string, error)
+	SoftDeleteProfile(ctx context.Context, id string) (bool, error)
+	RestoreProfile(ctx context.Context, id string) (bool, error)
+	GetUserIDByIndividualProfileID(ctx context.Context, profileID string) (*string, error)
+	// MoveProfileLinks, MoveProfilePages, MoveProfileResources and
+	// MoveProfileMemberships move the live records of the source profile to the
+	// target, skipping the ones the target already has, and report how many moved.
+	MoveProfileLinks(ctx context.Context, sourceProfileID string, targetProfileID string) (int64, error)
+	MoveProfilePages(ctx context.Context, sourceProfileID string, targetProfileID string) (int64, error)
+	MoveProfileResources(
+		ctx context.Context,
+		sourceProfileID string,
+		targetProfileID string,
+	) (int64, error)
+	MoveProfileMemberships(
+		ctx context.Context,
+		sourceProfileID string,
+		targetProfileID string,
+	) (int64, error)
+	RepointUserIndividualProfile(
+		ctx context.Context,
+		sourceProfileID string,
+		targetProfileID string,
+	) (int64, error)
 	GetFeatureRelationsVisibility(ctx context.Context, profileID string) (string, error)
 	GetFeatureLinksVisibility(ctx context.Context, profileID string) (string, error)
 	GetCustomDomainByDomain(ctx context.Context, domain string) (*ProfileCustomDomain, error)
@@ -209,8 +385,15 @@ type Repository interface { //nolint:interfacebloat
 		profileID string,
 		domain string,
 		defaultLocale *string,
+		verificationToken string,
+	) error
+	UpdateCustomDomain(
+		ctx context.Context,
+		id string,
+		domain string,
+		defaultLocale *string,
+		verificationToken *string,
 	) error
-	UpdateCustomDomain(ctx context.Context, id string, domain string, defaultLocale *string) error
 	UpdateCustomDomainVerification(
 		ctx context.Context,
 		id string,
@@ -221,12 +404,30 @@ type Repository interface { //nolint:interfacebloat
 	UpdateCustomDomainWebserverSynced(ctx context.Context, id string, synced bool) error
 	DeleteCustomDomain(ctx context.Context, id string) error
 	CheckProfileSlugExists(ctx context.Context, slug string) (bool, error)
+	GetActiveHandleReservationBySlug(ctx context.Context, slug string) (*HandleReservation, error)
+	CreateHandleReservation(ctx context.Context, reservation *HandleReservation) error
+	ReleaseHandleReservation(ctx context.Context, id string) (int64, error)
+	ClaimHandleReservation(ctx context.Context, id string, profileID string) (int64, error)
+	CreateMembershipInvitation(ctx context.Context, invitation *MembershipInvitation) error
+	GetMembershipInvitationByID(ctx context.Context, id string) (*MembershipInvitation, error)
+	GetPendingMembershipInvitation(
+		ctx context.Context,
+		profileID string,
+		inviteeProfileID string,
+	) (*MembershipInvitation, error)
+	ListPendingMembershipInvitations(ctx context.Context, profileID string) ([]*MembershipInvitation, error)
+	RespondToMembershipInvitation(ctx context.Context, id string, status string) (int64, error)
 	CheckProfileSlugExistsIncludingDeleted(ctx context.Context, slug string) (bool, error)
 	CheckPageSlugExistsIncludingDeleted(
 		ctx context.Context,
 		profileID string,
 		pageSlug string,
 	) (bool, error)
+	ListPageSlugUsages(
+		ctx context.Context,
+		profileID string,
+		pageSlugs []string,
+	) ([]*PageSlugUsage, error)
 	GetProfileIdentifierByID(ctx context.Context, id string) (*ProfileBrief, error)
 	GetProfileByID(
 		ctx context.Context,
@@ -238,7 +439,27 @@ type Repository interface { //nolint:interfacebloat
 		localeCode string,
 		cursor *cursors.Cursor,
 	) (cursors.Cursored[[]*Profile], error)
-	// ListProfileLinksForKind(ctx context.Context, kind string) ([]*ProfileLink, error)
+	ListProfilesByPoints(
+		ctx context.Context,
+		localeCode string,
+		kind string,
+		after *LeaderboardCursor,
+		limit int,
+	) ([]*Profile, error)
+	ListProfileLinksForPostImport(ctx context.Context, kind string) ([]*PostImportLink, error)
+	GetProfileLinkImportIDByRemoteID(
+		ctx context.Context,
+		linkID string,
+		remoteID string,
+	) (string, error)
+	CreateProfileLinkImport(
+		ctx context.Context,
+		id string,
+		linkID string,
+		remoteID string,
+		properties map[string]any,
+	) error
+	UpdateProfileLinkImport(ctx context.Context, id string, properties map[string]any) error
 	ListProfilePagesByProfileID(
 		ctx context.Context,
 		localeCode string,
@@ -272,7 +493,7 @@ type Repository interface { //nolint:interfacebloat
 		ctx context.Context,
 		localeCode string,
 		profileID string,
-	) ([]*ProfileLinkBrief, error)
+	) ([]*ProfileLink, error)
 	ListProfileContributions(
 		ctx context.Context,
 		localeCode string,
@@ -402,7 +623,8 @@ type Repository interface { //nolint:interfacebloat
 		ctx context.Context,
 		localeCode string,
 		profileID string,
-	) ([]*ProfileLinkBrief, error)
+		cursor *cursors.Cursor,
+	) (cursors.Cursored[[]*ProfileLinkBrief], error)
 	ListOnlineProfileLinks(
 		ctx context.Context,
 		localeCode string,
@@ -446,6 +668,8 @@ type Repository interface { //nolint:interfacebloat
 		publishedAt *string,
 		visibility string,
 	) error
+	ListDueDraftProfilePages(ctx context.Context, now time.Time, limit int) ([]*DueProfilePage, error)
+	PublishDraftProfilePage(ctx context.Context, id string) (bool, error)
 	UpdateProfilePageTx(
 		ctx context.Context,
 		profilePageID string,
@@ -463,7 +687,30 @@ type Repository interface { //nolint:interfacebloat
 		content string,
 	) error
 	DeleteProfilePageTx(ctx context.Context, profilePageID string, localeCode string) error
+	GetProfileDefaultLocale(ctx context.Context, profileID string) (string, error)
+	UpdateProfileDefaultLocale(ctx context.Context, profileID string, localeCode string) error
+	DeleteProfileTx(ctx context.Context, profileID string, localeCode string) (int64, error)
+	DeleteProfilePageTxsByProfileLocale(
+		ctx context.Context,
+		profileID string,
+		localeCode string,
+	) (int64, error)
+	DeleteProfileLinkTxsByProfileLocale(
+		ctx context.Context,
+		profileID string,
+		localeCode string,
+	) (int64, error)
 	ListProfilePageTxLocales(ctx context.Context, profilePageID string) ([]string, error)
+	ListProfilePageTxsByProfileLocale(
+		ctx context.Context,
+		profileID string,
+		localeCode string,
+	) ([]*ProfilePageTx, error)
+	ListProfileLinkTxsByProfileLocale(
+		ctx context.Context,
+		profileID string,
+		localeCode string,
+	) ([]*ProfileLinkTx, error)
 	DeleteProfilePage(ctx context.Context, id string) error
 	// Search methods
 	Search(
@@ -471,8 +718,16 @@ type Repository interface { //nolint:interfacebloat
 		localeCode string,
 		query string,
 		profileSlug *string,
+		kinds []string,
+		offset int32,
 		limit int32,
 	) ([]*SearchResult, error)
+	SuggestProfiles(
+		ctx context.Context,
+		localeCode string,
+		prefix string,
+		limit int32,
+	) ([]*ProfileSuggestion, error)
 	// OAuth Profile Link methods
 	GetProfileLinkByRemoteID(
 		ctx context.Context,
@@ -519,6 +774,8 @@ type Repository interface { //nolint:interfacebloat
 		refreshToken *string,
 	) error
 	GetMaxProfileLinkOrder(ctx context.Context, profileID string) (int, error)
+	ListProfileLinkIDsByProfileID(ctx context.Context, profileID string) ([]string, error)
+	UpdateProfileLinkOrder(ctx context.Context, id string, order int) error
 	// Admin methods
 	ListAllProfilesForAdmin(
 		ctx context.Context,
@@ -576,10 +833,13 @@ type Repository interface { //nolint:interfacebloat
 		query string,
 	) ([]*UserSearchResult, error)
 
+	GetProfileCounts(ctx context.Context, profileID string) (*ProfileCounts, error)
+
 	// Profile resources
 	ListProfileResourcesByProfileID(
 		ctx context.Context,
 		profileID string,
+		filter ProfileResourceFilter,
 	) ([]*ProfileResource, error)
 	GetProfileResourceByID(
 		ctx context.Context,
@@ -609,6 +869,24 @@ type Repository interface { //nolint:interfacebloat
 		ctx context.Context,
 		id string,
 	) error
+	GetDeletedProfileResourceByID(
+		ctx context.Context,
+		id string,
+	) (*ProfileResource, error)
+	ListProfileResourceIDsByProfileID(
+		ctx context.Context,
+		profileID string,
+	) ([]string, error)
+	UpdateProfileResourceOrder(
+		ctx context.Context,
+		id string,
+		order int,
+		isFeatured bool,
+	) error
+	RestoreProfileResource(
+		ctx context.Context,
+		id string,
+	) error
 	UpdateProfileResourceProperties(
 		ctx context.Context,
 		id string,
@@ -619,12 +897,22 @@ type Repository interface { //nolint:interfacebloat
 		id string,
 		properties any,
 	) error
+	UpdateProfileMembershipAggregateStories(
+		ctx context.Context,
+		id string,
+		aggregateStories bool,
+	) (bool, error)
 
 	// Managed GitHub link
 	GetManagedGitHubLinkByProfileID(
 		ctx context.Context,
 		profileID string,
 	) (*ManagedGitHubLink, error)
+	ListManagedLinkAccounts(
+		ctx context.Context,
+		profileID string,
+		kinds []string,
+	) ([]*LinkedAccount, error)
 
 	// Profile Team methods
 	ListProfileTeamsWithMemberCount(
@@ -656,12 +944,46 @@ type Repository interface { //nolint:interfacebloat
 		ctx context.Context,
 		membershipID string,
 	) ([]*ProfileTeam, error)
+	ListTeamsForMemberships(
+		ctx context.Context,
+		membershipIDs []string,
+	) (map[string][]*ProfileTeam, error)
 	SetMembershipTeams(
 		ctx context.Context,
 		membershipID string,
 		teamIDs []string,
 		idGenerator func() string,
 	) error
+	GetProfileTeamByID(
+		ctx context.Context,
+		id string,
+	) (*ProfileTeam, error)
+	CountProfileMembershipsByIDs(
+		ctx context.Context,
+		profileID string,
+		membershipIDs []string,
+	) (int64, error)
+	AddTeamToMemberships(
+		ctx context.Context,
+		teamID string,
+		membershipIDs []string,
+		idGenerator func() string,
+	) (int64, error)
+	RemoveTeamFromMemberships(
+		ctx context.Context,
+		teamID string,
+		membershipIDs []string,
+	) (int64, error)
+	IsMembershipInProfileTeam(
+		ctx context.Context,
+		teamID string,
+		membershipID string,
+	) (bool, error)
+	SetProfileTeamLead(
+		ctx context.Context,
+		teamID string,
+		membershipID *string,
+	) error
 	CountProfileTeamResources(
 		ctx context.Context,
 		teamID string,
@@ -670,6 +992,71 @@ type Repository interface { //nolint:interfacebloat
 		ctx context.Context,
 		resourceID string,
 	) ([]*ProfileTeam, error)
+	ListTeamsForResources(
+		ctx context.Context,
+		resourceIDs []string,
+	) (map[string][]*ProfileTeam, error)
+	RecordLinkClick(
+		ctx context.Context,
+		id string,
+		linkID string,
+		visitorUserID *string,
+	) error
+	CountLinkClicksByDay(
+		ctx context.Context,
+		linkID string,
+		since time.Time,
+	) ([]*LinkClickDay, error)
+	RecordRecentProfileView(
+		ctx context.Context,
+		userID string,
+		profileID string,
+		keepCount int,
+	) error
+	ListRecentProfileViews(
+		ctx context.Context,
+		localeCode string,
+		userID string,
+		limit int,
+	) ([]*RecentProfileView, error)
+	InsertUserProfileBlock(ctx context.Context, userID string, profileID string) (bool, error)
+	DeleteUserProfileBlock(ctx context.Context, userID string, profileID string) (bool, error)
+	ListUserProfileBlocks(
+		ctx context.Context,
+		localeCode string,
+		userID string,
+	) ([]*BlockedProfile, error)
+	InsertProfileReport(ctx context.Context, report *ProfileReport) (bool, error)
+	GetOpenProfileReportByReporter(
+		ctx context.Context,
+		targetKind ReportTargetKind,
+		targetID string,
+		reporterKey string,
+	) (*ProfileReport, error)
+	CountProfileReportsByReporterSince(
+		ctx context.Context,
+		reporterKey string,
+		since time.Time,
+	) (int, error)
+	ListProfileReportsByStatus(
+		ctx context.Context,
+		status ProfileReportStatus,
+		limit int,
+		offset int,
+	) ([]*ProfileReport, error)
+	ResolveProfileReport(
+		ctx context.Context,
+		id string,
+		status ProfileReportStatus,
+		resolvedByUserID string,
+	) (int64, error)
+	InsertProfilePageTranslationJob(ctx context.Context, job *TranslationJob) error
+	GetProfilePageTranslationJob(ctx context.Context, id string) (*TranslationJob, error)
+	InsertProfilePageTranslationJobResult(
+		ctx context.Context,
+		jobID string,
+		result LocaleTranslationResult,
+	) (bool, error)
 	SetResourceTeams(
 		ctx context.Context,
 		resourceID string,
@@ -686,6 +1073,7 @@ type Repository interface { //nolint:interfacebloat
 		referrerMembershipID *string,
 		source string,
 		applicantMessage *string,
+		votingDeadline *time.Time,
 	) (*ProfileMembershipCandidate, error)
 	GetProfileMembershipCandidateByID(
 		ctx context.Context,
@@ -733,6 +1121,16 @@ type Repository interface { //nolint:interfacebloat
 		ctx context.Context,
 		candidateID string,
 	) (map[int]int, error)
+	ListExpiredVotingCandidates(
+		ctx context.Context,
+		now time.Time,
+		limit int,
+	) ([]*ExpiredVotingCandidate, error)
+	ResolveVotingCandidate(
+		ctx context.Context,
+		candidateID string,
+		status CandidateStatus,
+	) (bool, error)
 	UpdateCandidateStatus(
 		ctx context.Context,
 		candidateID string,
@@ -805,12 +1203,34 @@ type WebserverSyncer interface {
 	RestartApplication(ctx context.Context) error
 }
 
+// MentionSyncer refreshes the "@slug" mentions stored for saved content.
+// Implemented by profile_mentions.Service.
+type MentionSyncer interface {
+	SyncMentions(
+		ctx context.Context,
+		actorUserID string,
+		sourceKind string,
+		sourceID string,
+		localeCode string,
+		content string,
+	) error
+}
+
 type Service struct {
 	logger       *logfx.Logger
 	config       *Config
 	repo         Repository
 	auditService *events.AuditService
 	idGenerator  RecordIDGenerator
+	avatarCache  *AvatarCache
+
+	onCustomDomainsChanged OnCustomDomainsChangedFunc
+	mentionSyncer          MentionSyncer
+	domainVerifyLimiter    *domainVerifyLimiter
+	dnsResolver            DNSResolver
+	queueService           *events.QueueService
+	aiRateLimiter          *aiRateLimiter
+	supportedLocales       map[string]bool
 }
 
 func NewService(
@@ -825,6 +1245,15 @@ func NewService(
 		repo:         repo,
 		auditService: auditService,
 		idGenerator:  DefaultIDGenerator,
+		avatarCache:  NewAvatarCache(),
+
+		onCustomDomainsChanged: nil,
+		mentionSyncer:          nil,
+		domainVerifyLimiter:    newDomainVerifyLimiter(),
+		dnsResolver:            net.DefaultResolver,
+		queueService:           nil,
+		aiRateLimiter:          newAIRateLimiter(),
+		supportedLocales:       newLocaleSet(config.GetSupportedLocales()),
 	}
 }
 
@@ -916,19 +1345,12 @@ func (s *Service) GetByID(
 	localeCode string,
 	id string, //nolint:varnamelen
 ) (*Profile, error) {
-	record, err := s.repo.GetProfileByID(ctx, localeCode, id)
+	// Walk the locale fallback chain if the locale has no translation
+	record, err := s.getProfileByIDInLocaleChain(ctx, localeCode, id)
 	if err != nil {
 		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, id, err)
 	}
 
-	// Try fallback locale if primary locale has no translation
-	if record == nil && localeCode != "en" {
-		record, err = s.repo.GetProfileByID(ctx, "en", id)
-		if err != nil {
-			return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, id, err)
-		}
-	}
-
 	return record, nil
 }
 
@@ -938,6 +1360,10 @@ func (s *Service) GetBySlug(ctx context.Context, localeCode string, slug string)
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	record, err := s.repo.GetProfileByID(ctx, localeCode, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
@@ -966,38 +1392,36 @@ func (s *Service) GetBySlugExWithViewer( //nolint:cyclop
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
-	record, err := s.repo.GetProfileByID(ctx, localeCode, profileID)
-	if err != nil {
-		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
+	if profileID == "" {
+		return nil, ErrProfileNotFound
 	}
 
-	// Try fallback locale if primary locale has no translation
-	if record == nil && localeCode != "en" {
-		record, err = s.repo.GetProfileByID(ctx, "en", profileID)
-		if err != nil {
-			return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
-		}
+	// Walk the locale fallback chain if the locale has no translation
+	record, err := s.getProfileByIDInLocaleChain(ctx, localeCode, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
 
+	// A profile without any translation cannot be shown in any locale
 	if record == nil {
-		return nil, nil //nolint:nilnil
+		return nil, ErrProfileNotFound
 	}
 
-	pages, err := s.repo.ListProfilePagesByProfileID(ctx, localeCode, record.ID)
+	// Show the avatar of a linked account when no picture is set
+	err = s.applyLinkedPicture(ctx, record)
 	if err != nil {
-		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
+		return nil, err
 	}
 
-	// Try fallback locale for pages if none found
-	if len(pages) == 0 && localeCode != "en" {
-		pages, err = s.repo.ListProfilePagesByProfileID(
-			ctx,
-			"en",
-			record.ID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
-		}
+	// Walk the locale fallback chain for pages if none found
+	pages, err := s.listProfilePagesInLocaleChain(
+		localeCode,
+		func(chainLocaleCode string) ([]*ProfilePageBrief, error) {
+			return s.repo.ListProfilePagesByProfileID(ctx, chainLocaleCode, record.ID)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
 
 	// Only include featured links for the profile sidebar
@@ -1009,23 +1433,55 @@ func (s *Service) GetBySlugExWithViewer( //nolint:cyclop
 	// Filter links based on viewer's membership
 	filteredLinks := s.FilterVisibleLinks(ctx, links, profileID, viewerProfileID)
 
+	counts, err := s.getProfileCounts(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dropUnsetAvailability(record, now)
+
 	result := &ProfileWithChildren{
-		Profile: record,
-		Pages:   pages,
-		Links:   filteredLinks,
+		Profile:      record,
+		Appearance:   AppearanceFromProperties(record.Properties),
+		Availability: AvailabilityFromProperties(record.Properties, now),
+		Counts:       counts,
+		Pages:        pages,
+		Links:        filteredLinks,
 	}
 
 	return result, nil
 }
 
+// getProfileCounts returns the profile's badge totals, omitting those of disabled modules.
+func (s *Service) getProfileCounts(ctx context.Context, record *Profile) (*ProfileCounts, error) {
+	counts, err := s.repo.GetProfileCounts(ctx, record.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, record.ID, err)
+	}
+
+	if record.FeatureRelations == string(ModuleVisibilityDisabled) {
+		counts.Members = nil
+		counts.Contributions = nil
+	}
+
+	if record.FeatureLinks == string(ModuleVisibilityDisabled) {
+		counts.Links = nil
+	}
+
+	return counts, nil
+}
+
+// GetByCustomDomain returns the profile served on a host. Either the apex or the
+// www form of a custom domain resolves to the same profile.
 func (s *Service) GetByCustomDomain(
 	ctx context.Context,
 	localeCode string,
 	domain string,
 ) (*Profile, *ProfileCustomDomain, error) {
-	customDomain, err := s.repo.GetCustomDomainByDomain(ctx, domain)
+	customDomain, err := s.resolveCustomDomain(ctx, domain)
 	if err != nil {
-		return nil, nil, fmt.Errorf("%w(custom_domain: %s): %w", ErrFailedToGetRecord, domain, err)
+		return nil, nil, err
 	}
 
 	if customDomain == nil {
@@ -1049,28 +1505,69 @@ func (s *Service) GetByCustomDomain(
 	return record, customDomain, nil
 }
 
+// GetProfileIDByActiveCustomDomain returns the ID of the profile served on a
+// host, or "" when the host isn't an active custom domain of any profile.
+func (s *Service) GetProfileIDByActiveCustomDomain(ctx context.Context, host string) (string, error) {
+	customDomain, err := s.resolveCustomDomain(ctx, host)
+	if err != nil {
+		return "", err
+	}
+
+	if customDomain == nil || !isActiveCustomDomain(customDomain) {
+		return "", nil
+	}
+
+	return customDomain.ProfileID, nil
+}
+
 func (s *Service) List(
 	ctx context.Context,
 	localeCode string,
 	cursor *cursors.Cursor,
 ) (cursors.Cursored[[]*Profile], error) {
+	err := validateHiringFilter(cursor)
+	if err != nil {
+		return cursors.Cursored[[]*Profile]{}, err
+	}
+
+	err = validateAvailableFilter(cursor)
+	if err != nil {
+		return cursors.Cursored[[]*Profile]{}, err
+	}
+
 	records, err := s.repo.ListProfiles(ctx, localeCode, cursor)
 	if err != nil {
 		return cursors.Cursored[[]*Profile]{}, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
 	}
 
+	now := time.Now()
+	for _, record := range records.Data {
+		dropUnsetAvailability(record, now)
+	}
+
 	return records, nil
 }
 
+const (
+	// DefaultAdminProfileListLimit is the page size of the admin profile
+	// listing when the caller asks for no particular limit.
+	DefaultAdminProfileListLimit = 50
+	// MaxAdminProfileListLimit caps the page size of the admin profile listing.
+	MaxAdminProfileListLimit = 200
+)
+
 // AdminProfileListResult holds the result of listing profiles for admin.
 type AdminProfileListResult struct {
-	Data   []*Profile `json:"data"`
-	Total  int64      `json:"total"`
-	Limit  int        `json:"limit"`
-	Offset int        `json:"offset"`
+	Data       []*Profile `json:"data"`
+	Total      int64      `json:"total"`
+	Limit      int        `json:"limit"`
+	Offset     int        `json:"offset"`
+	TotalPages int        `json:"total_pages"`
+	HasMore    bool       `json:"has_more"`
 }
 
 // ListAllProfilesForAdmin lists all profiles for admin with pagination.
+// The limit is clamped to MaxAdminProfileListLimit.
 func (s *Service) ListAllProfilesForAdmin(
 	ctx context.Context,
 	localeCode string,
@@ -1078,6 +1575,13 @@ func (s *Service) ListAllProfilesForAdmin(
 	limit int,
 	offset int,
 ) (*AdminProfileListResult, error) {
+	if limit <= 0 {
+		limit = DefaultAdminProfileListLimit
+	}
+
+	limit = min(limit, MaxAdminProfileListLimit)
+	offset = max(offset, 0)
+
 	profiles, err := s.repo.ListAllProfilesForAdmin(ctx, localeCode, filterKind, limit, offset)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
@@ -1089,10 +1593,12 @@ func (s *Service) ListAllProfilesForAdmin(
 	}
 
 	return &AdminProfileListResult{
-		Data:   profiles,
-		Total:  total,
-		Limit:  limit,
-		Offset: offset,
+		Data:       profiles,
+		Total:      total,
+		Limit:      limit,
+		Offset:     offset,
+		TotalPages: int((total + int64(limit) - 1) / int64(limit)),
+		HasMore:    int64(offset+len(profiles)) < total,
 	}, nil
 }
 
@@ -1120,7 +1626,11 @@ func (s *Service) ListPagesBySlug(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
-	pages, err := s.repo.ListProfilePagesByProfileID(ctx, localeCode, profileID)
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	pages, err := s.repo.ListProfilePagesByProfileID(ctx, localeCode, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
@@ -1139,6 +1649,10 @@ func (s *Service) GetPageBySlug(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	page, err := s.repo.GetProfilePageByProfileIDAndSlug(
 		ctx,
 		localeCode,
@@ -1169,6 +1683,10 @@ func (s *Service) ListPagesBySlugForViewer(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	pages, err := s.repo.ListProfilePagesByProfileIDForViewer(
 		ctx,
 		localeCode,
@@ -1194,6 +1712,10 @@ func (s *Service) GetPageBySlugForViewer(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	page, err := s.repo.GetProfilePageByProfileIDAndSlugForViewer(
 		ctx,
 		localeCode,
@@ -1227,46 +1749,43 @@ func (s *Service) GetBySlugExWithViewerUser( //nolint:cyclop,funlen
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
-	record, err := s.repo.GetProfileByID(ctx, localeCode, profileID)
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	// Walk the locale fallback chain if the locale has no translation
+	record, err := s.getProfileByIDInLocaleChain(ctx, localeCode, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
 
-	// Try fallback locale if primary locale has no translation
-	if record == nil && localeCode != "en" {
-		record, err = s.repo.GetProfileByID(ctx, "en", profileID)
-		if err != nil {
-			return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
-		}
+	// A profile without any translation cannot be shown in any locale
+	if record == nil {
+		return nil, ErrProfileNotFound
 	}
 
-	if record == nil {
-		return nil, nil //nolint:nilnil
+	// Show the avatar of a linked account when no picture is set
+	err = s.applyLinkedPicture(ctx, record)
+	if err != nil {
+		return nil, err
 	}
 
-	pages, err := s.repo.ListProfilePagesByProfileIDForViewer(
-		ctx,
+	// Walk the locale fallback chain for pages if none found
+	pages, err := s.listProfilePagesInLocaleChain(
 		localeCode,
-		record.ID,
-		viewerUserID,
+		func(chainLocaleCode string) ([]*ProfilePageBrief, error) {
+			return s.repo.ListProfilePagesByProfileIDForViewer(
+				ctx,
+				chainLocaleCode,
+				record.ID,
+				viewerUserID,
+			)
+		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
 
-	// Try fallback locale for pages if none found
-	if len(pages) == 0 && localeCode != "en" {
-		pages, err = s.repo.ListProfilePagesByProfileIDForViewer(
-			ctx,
-			"en",
-			record.ID,
-			viewerUserID,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
-		}
-	}
-
 	// Only include featured links for the profile sidebar
 	links, err := s.repo.ListFeaturedProfileLinksByProfileID(ctx, localeCode, record.ID)
 	if err != nil {
@@ -1276,10 +1795,21 @@ func (s *Service) GetBySlugExWithViewerUser( //nolint:cyclop,funlen
 	// Filter links based on viewer's membership (uses empty viewerProfileID for anonymous)
 	filteredLinks := s.FilterVisibleLinks(ctx, links, profileID, "")
 
+	counts, err := s.getProfileCounts(ctx, record)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dropUnsetAvailability(record, now)
+
 	result := &ProfileWithChildren{
-		Profile: record,
-		Pages:   pages,
-		Links:   filteredLinks,
+		Profile:      record,
+		Appearance:   AppearanceFromProperties(record.Properties),
+		Availability: AvailabilityFromProperties(record.Properties, now),
+		Counts:       counts,
+		Pages:        pages,
+		Links:        filteredLinks,
 	}
 
 	return result, nil
@@ -1331,10 +1861,14 @@ func (s *Service) CheckPageSlugAvailability(
 	}
 
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
-	if err != nil || profileID == "" {
+	if err != nil {
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	page, err := s.repo.GetProfilePageByProfileIDAndSlug(
 		ctx,
 		localeCode,
@@ -1401,6 +1935,10 @@ func (s *Service) ListLinksBySlug(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	links, err := s.repo.ListProfileLinksByProfileID(ctx, localeCode, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
@@ -1420,6 +1958,10 @@ func (s *Service) resolveProfileIDWithRelationsCheck(
 		return "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return "", ErrProfileNotFound
+	}
+
 	visibility, err := s.repo.GetFeatureRelationsVisibility(ctx, profileID)
 	if err != nil {
 		return "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
@@ -1490,22 +2032,6 @@ func (s *Service) ListProfileMembersBySlug(
 	return memberships, nil
 }
 
-func (s *Service) Import(ctx context.Context, fetcher RecentPostsFetcher) error {
-	// 	links, err := s.repo.ListProfileLinksForKind(ctx, "x")
-	// 	if err != nil {
-	// 		return fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
-	// 	}
-	// 	for _, link := range links {
-	// 		s.logger.InfoContext(ctx, "importing posts", "kind", link.Kind, "title", link.Title)
-	// 		posts, err := fetcher.GetRecentPostsByUsername(ctx, link.RemoteID.String, link.AuthAccessToken)
-	// 		if err != nil {
-	// 			return fmt.Errorf("%w: %w", ErrFailedToListRecords, err)
-	// 		}
-	// 		s.logger.InfoContext(ctx, "posts imported", "kind", link.Kind, "title", link.Title, "posts", posts)
-	// 	}
-	return nil
-}
-
 func (s *Service) GetMembershipsByUserProfileID(
 	ctx context.Context,
 	localeCode string,
@@ -1574,6 +2100,34 @@ func (s *Service) CheckSlugAvailability(
 		}, nil
 	}
 
+	// Check if slug redirects to a renamed profile
+	usedByOther, err := s.isSlugInOtherProfileHistory(ctx, slug, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if usedByOther {
+		return &SlugAvailabilityResult{
+			Available: false,
+			Message:   "This slug was used by another profile",
+			Severity:  SeverityError,
+		}, nil
+	}
+
+	// Check if slug is held by a handle reservation
+	reservation, err := s.getActiveHandleReservation(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if reservation != nil {
+		return &SlugAvailabilityResult{
+			Available: false,
+			Message:   "This slug is reserved for an organization",
+			Severity:  SeverityReserved,
+		}, nil
+	}
+
 	// Check if slug was used by a deleted record (optional)
 	if includeDeleted {
 		existsDeleted, delErr := s.repo.CheckProfileSlugExistsIncludingDeleted(ctx, slug)
@@ -1609,35 +2163,53 @@ func (s *Service) Create(
 	pronouns *string,
 	properties map[string]any,
 ) (*Profile, error) {
+	// Slugs that redirect to a renamed profile can't be taken
+	usedByOther, err := s.isSlugInOtherProfileHistory(ctx, slug, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if usedByOther {
+		return nil, fmt.Errorf("%w: %s was used by another profile", ErrSlugUnavailable, slug)
+	}
+
 	// Generate new profile ID
 	profileID := s.idGenerator()
 
-	// Create the main profile record with the request locale as default
-	err := s.repo.CreateProfile(
-		ctx,
-		string(profileID),
-		slug,
-		kind,
-		localeCode,
-		profilePictureURI,
-		pronouns,
-		properties,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
-	}
+	// Create the profile and its localized data atomically
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		// Create the main profile record with the request locale as default
+		err := txRepo.CreateProfile(
+			ctx,
+			string(profileID),
+			slug,
+			kind,
+			localeCode,
+			profilePictureURI,
+			pronouns,
+			properties,
+		)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
+		}
 
-	// Create the localized profile data
-	err = s.repo.CreateProfileTx(
-		ctx,
-		string(profileID),
-		localeCode,
-		title,
-		description,
-		nil, // No additional properties for profile_tx for now
-	)
+		// Create the localized profile data
+		err = txRepo.CreateProfileTx(
+			ctx,
+			string(profileID),
+			localeCode,
+			title,
+			description,
+			nil, // No additional properties for profile_tx for now
+		)
+		if err != nil {
+			return fmt.Errorf("%w: translation: %w", ErrFailedToCreateRecord, err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("%w: translation: %w", ErrFailedToCreateRecord, err)
+		return nil, err
 	}
 
 	// Fetch and return the created profile
@@ -1927,6 +2499,21 @@ func (s *Service) Update( //nolint:cyclop,funlen
 		}
 	}
 
+	hiringErr := normalizeHiringProperty(properties)
+	if hiringErr != nil {
+		return nil, hiringErr
+	}
+
+	availabilityErr := normalizeAvailabilityProperty(properties, time.Now())
+	if availabilityErr != nil {
+		return nil, availabilityErr
+	}
+
+	appearanceErr := normalizeAppearanceProperty(properties)
+	if appearanceErr != nil {
+		return nil, appearanceErr
+	}
+
 	// Update the profile
 	err = s.repo.UpdateProfile(
 		ctx,
@@ -1976,6 +2563,11 @@ func (s *Service) UpdateTranslation(
 	description string,
 	properties map[string]any,
 ) error {
+	err := s.ValidateTranslationLocale(localeCode)
+	if err != nil {
+		return err
+	}
+
 	// Get profile ID
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
 	if err != nil {
@@ -1991,6 +2583,11 @@ func (s *Service) UpdateTranslation(
 		return accessErr
 	}
 
+	err = s.ensureProfileTranslationLocaleAllowed(ctx, userKind, profileID, localeCode)
+	if err != nil {
+		return err
+	}
+
 	// Update the translation (use upsert to handle new locales)
 	err = s.repo.UpsertProfileTx(ctx, profileID, localeCode, title, description, properties)
 	if err != nil {
@@ -2040,6 +2637,10 @@ func (s *Service) GetProfileTranslations(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	// Get all translations
 	translations, err := s.repo.GetProfileTxByID(ctx, profileID)
 	if err != nil {
@@ -2378,6 +2979,10 @@ func (s *Service) GetProfileLink(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	if link.ProfileID != profileID {
 		return nil, fmt.Errorf(
 			"%w: link %s does not belong to profile %s",
@@ -2473,31 +3078,11 @@ func (s *Service) ListProfileLinksBySlug(
 	}
 
 	// Get all links for editing (this is for the settings page)
-	briefLinks, err := s.repo.ListProfileLinksByProfileIDForEditing(ctx, localeCode, profileID)
+	links, err := s.repo.ListProfileLinksByProfileIDForEditing(ctx, localeCode, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
 	}
 
-	// For now, we need to get each link individually to get the full data
-	// This could be optimized with a dedicated repository method later
-	links := make([]*ProfileLink, 0, len(briefLinks))
-
-	for _, briefLink := range briefLinks {
-		fullLink, linkErr := s.repo.GetProfileLink(ctx, localeCode, briefLink.ID)
-		if linkErr != nil {
-			return nil, fmt.Errorf(
-				"%w(linkID: %s): %w",
-				ErrFailedToGetRecord,
-				briefLink.ID,
-				linkErr,
-			)
-		}
-
-		if fullLink != nil {
-			links = append(links, fullLink)
-		}
-	}
-
 	s.annotateLinksCanRemove(ctx, links, profileID, userID, userKind)
 
 	return links, nil
@@ -2543,6 +3128,24 @@ func (s *Service) CreateProfilePage( //nolint:cyclop,funlen
 		addedByProfileID = userInfo.IndividualProfileID
 	}
 
+	if issue := CheckPageSlug(slug); issue != nil {
+		return nil, issue.err()
+	}
+
+	if issue := CheckPageTitle(title); issue != nil {
+		return nil, issue.err()
+	}
+
+	publishedAtErr := validateOptionalTimestamp("published_at", publishedAt)
+	if publishedAtErr != nil {
+		return nil, publishedAtErr
+	}
+
+	contentErr := s.validatePageContentLength(content)
+	if contentErr != nil {
+		return nil, contentErr
+	}
+
 	// Validate cover picture URI
 	coverErr := validateOptionalURL(coverPictureURI)
 	if coverErr != nil {
@@ -2550,11 +3153,9 @@ func (s *Service) CreateProfilePage( //nolint:cyclop,funlen
 	}
 
 	// Non-admin users can only use URIs from allowed prefixes
-	if userKind != UserKindAdmin {
-		err := validateURIPrefixes(coverPictureURI, s.config.GetAllowedURIPrefixes())
-		if err != nil {
-			return nil, err
-		}
+	prefixErr := s.validateCoverPicturePrefix(userKind, coverPictureURI)
+	if prefixErr != nil {
+		return nil, prefixErr
 	}
 
 	// Validate slug availability
@@ -2611,6 +3212,8 @@ func (s *Service) CreateProfilePage( //nolint:cyclop,funlen
 		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
 	}
 
+	s.syncPageMentions(ctx, userID, string(pageID), localeCode, content)
+
 	// Return the created page with translations
 	fullPage, err := s.repo.GetProfilePageByProfileIDAndSlug(
 		ctx,
@@ -2663,6 +3266,15 @@ func (s *Service) UpdateProfilePage( //nolint:cyclop,funlen
 		return nil, accessErr
 	}
 
+	if issue := CheckPageSlug(slug); issue != nil {
+		return nil, issue.err()
+	}
+
+	publishedAtErr := validateOptionalTimestamp("published_at", publishedAt)
+	if publishedAtErr != nil {
+		return nil, publishedAtErr
+	}
+
 	// Validate cover picture URI
 	coverErr := validateOptionalURL(coverPictureURI)
 	if coverErr != nil {
@@ -2670,11 +3282,9 @@ func (s *Service) UpdateProfilePage( //nolint:cyclop,funlen
 	}
 
 	// Non-admin users can only use URIs from allowed prefixes
-	if userKind != UserKindAdmin {
-		err := validateURIPrefixes(coverPictureURI, s.config.GetAllowedURIPrefixes())
-		if err != nil {
-			return nil, err
-		}
+	prefixErr := s.validateCoverPicturePrefix(userKind, coverPictureURI)
+	if prefixErr != nil {
+		return nil, prefixErr
 	}
 
 	// Validate slug availability (exclude current page)
@@ -2742,6 +3352,11 @@ func (s *Service) UpdateProfilePageTranslation(
 	summary string,
 	content string,
 ) error {
+	err := s.ValidateTranslationLocale(localeCode)
+	if err != nil {
+		return err
+	}
+
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
 	if err != nil {
 		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
@@ -2766,6 +3381,16 @@ func (s *Service) UpdateProfilePageTranslation(
 		return fmt.Errorf("%w: page %s not found", ErrFailedToGetRecord, pageID)
 	}
 
+	err = s.ensurePageTranslationLocaleAllowed(ctx, userKind, pageID, localeCode)
+	if err != nil {
+		return err
+	}
+
+	err = s.validatePageContentLength(content)
+	if err != nil {
+		return err
+	}
+
 	// Update the translation (use upsert to handle new locales)
 	err = s.repo.UpsertProfilePageTx(ctx, pageID, localeCode, title, summary, content)
 	if err != nil {
@@ -2778,6 +3403,8 @@ func (s *Service) UpdateProfilePageTranslation(
 		)
 	}
 
+	s.syncPageMentions(ctx, userID, pageID, localeCode, content)
+
 	s.auditService.Record(ctx, events.AuditParams{
 		EventType:  events.ProfilePageTranslationUpdated,
 		EntityType: "profile_page",
@@ -2838,78 +3465,245 @@ func (s *Service) DeleteProfilePageTranslation(
 	return nil
 }
 
-// ListProfilePageTranslationLocales returns locale codes that have translations for a page.
-func (s *Service) ListProfilePageTranslationLocales(
+// SetDefaultLocale makes localeCode the profile's default locale. The profile must
+// already have a translation in that locale, since visitors fall back to it.
+func (s *Service) SetDefaultLocale(
 	ctx context.Context,
+	userID string,
 	profileSlug string,
-	pageID string,
-) ([]string, error) {
-	locales, err := s.repo.ListProfilePageTxLocales(ctx, pageID)
+	localeCode string,
+) error {
+	err := s.ValidateTranslationLocale(localeCode)
 	if err != nil {
-		return nil, fmt.Errorf("%w(pageID: %s): %w", ErrFailedToListRecords, pageID, err)
-	}
-
-	result := make([]string, 0, len(locales))
-	for _, l := range locales {
-		result = append(result, strings.TrimSpace(l))
+		return err
 	}
 
-	return result, nil
-}
-
-// GetProfilePageTranslationContent returns the translation content for a specific locale.
-func (s *Service) GetProfilePageTranslationContent(
-	ctx context.Context,
-	profileSlug string,
-	pageID string,
-	localeCode string,
-) (string, string, string, error) {
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
 	if err != nil {
-		return "", "", "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 	}
 
 	if profileID == "" {
-		return "", "", "", ErrProfileNotFound
+		return ErrProfileNotFound
 	}
 
-	page, err := s.repo.GetProfilePageByProfileIDAndSlug(ctx, localeCode, profileID, "")
-	if err != nil {
-		// Try to get by ID directly
-		pages, listErr := s.repo.ListProfilePagesByProfileID(ctx, localeCode, profileID)
-		if listErr != nil {
-			return "", "", "", fmt.Errorf(
-				"%w(pageID: %s): %w",
-				ErrFailedToGetRecord,
-				pageID,
-				listErr,
-			)
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindOwner)
+	if accessErr != nil {
+		return accessErr
+	}
+
+	var previousLocale string
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		var txErr error
+
+		previousLocale, txErr = txRepo.GetProfileDefaultLocale(ctx, profileID)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, txErr)
 		}
 
-		for _, p := range pages {
-			if p.ID == pageID {
-				return p.Title, p.Summary, "", nil
-			}
+		if previousLocale == localeCode {
+			return nil
 		}
 
-		return "", "", "", fmt.Errorf("%w(pageID: %s): %w", ErrFailedToGetRecord, pageID, err)
-	}
+		translations, txErr := txRepo.GetProfileTxByID(ctx, profileID)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, txErr)
+		}
 
-	if page == nil {
-		return "", "", "", fmt.Errorf("%w: page not found", ErrFailedToGetRecord)
+		if !slices.ContainsFunc(translations, func(tx *ProfileTx) bool {
+			return tx.LocaleCode == localeCode
+		}) {
+			return fmt.Errorf("%w: %s", ErrDefaultLocaleNotTranslated, localeCode)
+		}
+
+		txErr = txRepo.UpdateProfileDefaultLocale(ctx, profileID, localeCode)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToUpdateRecord, profileID, txErr)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	// Check if this is actual content for the requested locale
-	actualLocale := strings.TrimSpace(page.LocaleCode)
-	if actualLocale != localeCode {
-		return "", "", "", fmt.Errorf(
-			"%w: no translation for locale %s",
-			ErrFailedToGetRecord,
-			localeCode,
-		)
+	if previousLocale == localeCode {
+		return nil
 	}
 
-	return page.Title, page.Summary, page.Content, nil
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileDefaultLocaleChanged,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"previous_locale_code": previousLocale,
+			"locale_code":          localeCode,
+		},
+	})
+
+	return nil
+}
+
+// DeleteProfileLocale removes every translation of the profile, its pages and its
+// links in the given locale. The profile's default locale cannot be deleted.
+func (s *Service) DeleteProfileLocale(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	localeCode string,
+) (*ProfileLocaleDeletion, error) {
+	err := s.ValidateTranslationLocale(localeCode)
+	if err != nil {
+		return nil, err
+	}
+
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindOwner)
+	if accessErr != nil {
+		return nil, accessErr
+	}
+
+	deletion := &ProfileLocaleDeletion{ //nolint:exhaustruct
+		LocaleCode: localeCode,
+	}
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		defaultLocale, txErr := txRepo.GetProfileDefaultLocale(ctx, profileID)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, txErr)
+		}
+
+		if defaultLocale == localeCode {
+			return ErrCannotDeleteDefaultLocale
+		}
+
+		deletion.Profile, txErr = txRepo.DeleteProfileTx(ctx, profileID, localeCode)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToDeleteRecord, profileID, txErr)
+		}
+
+		deletion.Pages, txErr = txRepo.DeleteProfilePageTxsByProfileLocale(ctx, profileID, localeCode)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToDeleteRecord, profileID, txErr)
+		}
+
+		deletion.Links, txErr = txRepo.DeleteProfileLinkTxsByProfileLocale(ctx, profileID, localeCode)
+		if txErr != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToDeleteRecord, profileID, txErr)
+		}
+
+		if deletion.Profile+deletion.Pages+deletion.Links == 0 {
+			return ErrLocaleNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileLocaleDeleted,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"locale_code":     localeCode,
+			"profile_deleted": deletion.Profile,
+			"pages_deleted":   deletion.Pages,
+			"links_deleted":   deletion.Links,
+		},
+	})
+
+	return deletion, nil
+}
+
+// ListProfilePageTranslationLocales returns locale codes that have translations for a page.
+func (s *Service) ListProfilePageTranslationLocales(
+	ctx context.Context,
+	profileSlug string,
+	pageID string,
+) ([]string, error) {
+	locales, err := s.repo.ListProfilePageTxLocales(ctx, pageID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(pageID: %s): %w", ErrFailedToListRecords, pageID, err)
+	}
+
+	result := make([]string, 0, len(locales))
+	for _, l := range locales {
+		result = append(result, strings.TrimSpace(l))
+	}
+
+	return result, nil
+}
+
+// GetProfilePageTranslationContent returns the translation content for a specific locale.
+func (s *Service) GetProfilePageTranslationContent(
+	ctx context.Context,
+	profileSlug string,
+	pageID string,
+	localeCode string,
+) (string, string, string, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return "", "", "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return "", "", "", ErrProfileNotFound
+	}
+
+	page, err := s.repo.GetProfilePageByProfileIDAndSlug(ctx, localeCode, profileID, "")
+	if err != nil {
+		// Try to get by ID directly
+		pages, listErr := s.repo.ListProfilePagesByProfileID(ctx, localeCode, profileID)
+		if listErr != nil {
+			return "", "", "", fmt.Errorf(
+				"%w(pageID: %s): %w",
+				ErrFailedToGetRecord,
+				pageID,
+				listErr,
+			)
+		}
+
+		for _, p := range pages {
+			if p.ID == pageID {
+				return p.Title, p.Summary, "", nil
+			}
+		}
+
+		return "", "", "", fmt.Errorf("%w(pageID: %s): %w", ErrFailedToGetRecord, pageID, err)
+	}
+
+	if page == nil {
+		return "", "", "", fmt.Errorf("%w: page not found", ErrFailedToGetRecord)
+	}
+
+	// Check if this is actual content for the requested locale
+	actualLocale := strings.TrimSpace(page.LocaleCode)
+	if actualLocale != localeCode {
+		return "", "", "", fmt.Errorf(
+			"%w: no translation for locale %s",
+			ErrFailedToGetRecord,
+			localeCode,
+		)
+	}
+
+	return page.Title, page.Summary, page.Content, nil
 }
 
 // DeleteProfilePage soft-deletes a profile page with authorization check.
@@ -3039,27 +3833,6 @@ func (s *Service) GetProfilePage(
 // 	return record, nil
 // }
 
-// Search performs a full-text search across profiles, stories, and profile pages.
-// If profileSlug is provided, search is scoped to that profile only.
-func (s *Service) Search(
-	ctx context.Context,
-	localeCode string,
-	query string,
-	profileSlug *string,
-	limit int32,
-) ([]*SearchResult, error) {
-	if query == "" {
-		return []*SearchResult{}, nil
-	}
-
-	results, err := s.repo.Search(ctx, localeCode, query, profileSlug, limit)
-	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrSearchFailed, err)
-	}
-
-	return results, nil
-}
-
 // GetProfileIDBySlug returns the profile ID for a given slug.
 func (s *Service) GetProfileIDBySlug(ctx context.Context, slug string) (string, error) {
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, slug)
@@ -3067,6 +3840,10 @@ func (s *Service) GetProfileIDBySlug(ctx context.Context, slug string) (string,
 		return "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return "", ErrProfileNotFound
+	}
+
 	return profileID, nil
 }
 
@@ -3208,6 +3985,10 @@ func (s *Service) ListFeaturedLinksBySlug(
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	links, err := s.repo.ListFeaturedProfileLinksByProfileID(ctx, localeCode, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
@@ -3217,34 +3998,61 @@ func (s *Service) ListFeaturedLinksBySlug(
 	return s.FilterVisibleLinks(ctx, links, profileID, viewerProfileID), nil
 }
 
-// ListAllLinksBySlug returns all profile links visible to the viewer.
+// ListAllLinksBySlug returns a page of the profile links visible to the viewer.
+// Visibility filtering is applied per page, so a page may hold fewer links
+// than the cursor limit while still returning a next cursor.
 func (s *Service) ListAllLinksBySlug(
 	ctx context.Context,
 	localeCode string,
 	slug string,
 	viewerProfileID string,
-) ([]*ProfileLinkBrief, error) {
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*ProfileLinkBrief], error) {
+	var result cursors.Cursored[[]*ProfileLinkBrief]
+
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, slug)
 	if err != nil {
-		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+		return result, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+	}
+
+	if profileID == "" {
+		return result, ErrProfileNotFound
 	}
 
 	visibility, err := s.repo.GetFeatureLinksVisibility(ctx, profileID)
 	if err != nil {
-		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		return result, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
 	}
 
 	if visibility == "disabled" {
-		return nil, ErrLinksNotEnabled
+		return result, ErrLinksNotEnabled
 	}
 
-	links, err := s.repo.ListAllProfileLinksByProfileID(ctx, localeCode, profileID)
+	result, err = s.repo.ListAllProfileLinksByProfileID(ctx, localeCode, profileID, cursor)
 	if err != nil {
-		return nil, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
+		return result, fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
 
 	// Filter links based on viewer's membership
-	return s.FilterVisibleLinks(ctx, links, profileID, viewerProfileID), nil
+	result.Data = s.FilterVisibleLinks(ctx, result.Data, profileID, viewerProfileID)
+
+	return result, nil
+}
+
+// ListEveryLinkBySlug returns all profile links visible to the viewer in one
+// slice, for callers that do not page through them.
+func (s *Service) ListEveryLinkBySlug(
+	ctx context.Context,
+	localeCode string,
+	slug string,
+	viewerProfileID string,
+) ([]*ProfileLinkBrief, error) {
+	result, err := s.ListAllLinksBySlug(ctx, localeCode, slug, viewerProfileID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Data, nil
 }
 
 // ListOnlineProfileLinks returns all currently live profile links across all profiles.
@@ -3273,6 +4081,11 @@ func (s *Service) UpsertProfileLinkTranslation(
 	group *string,
 	description *string,
 ) error {
+	err := s.ValidateTranslationLocale(localeCode)
+	if err != nil {
+		return err
+	}
+
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
 	if err != nil {
 		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
@@ -3604,6 +4417,10 @@ func (s *Service) DeleteMembership( //nolint:cyclop,funlen
 		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 	}
 
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
 	profile, err := s.repo.GetProfileByID(ctx, "en", profileID)
 	if err != nil {
 		return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
@@ -3737,7 +4554,7 @@ func (s *Service) SearchUsersForMembership(
 }
 
 // AddMembership adds a new membership to a profile.
-func (s *Service) AddMembership( //nolint:cyclop,funlen
+func (s *Service) AddMembership(
 	ctx context.Context,
 	userID string,
 	userKind string,
@@ -3745,6 +4562,40 @@ func (s *Service) AddMembership( //nolint:cyclop,funlen
 	profileSlug string,
 	memberProfileID string,
 	kind string,
+) (string, error) {
+	profileID, err := s.authorizeMembershipAssignment(
+		ctx,
+		userID,
+		userKind,
+		userIndividualProfileID,
+		profileSlug,
+		kind,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	grant, err := s.grantMembership(ctx, s.repo, profileID, memberProfileID, kind)
+	if err != nil {
+		return "", err
+	}
+
+	s.recordMembershipGranted(ctx, userID, profileID, memberProfileID, kind, grant)
+
+	return grant.membershipID, nil
+}
+
+// authorizeMembershipAssignment checks that the user may give kind of
+// membership on the profile and returns the profile's ID. Non-admins must be
+// maintainers, cannot assign sponsor or follower, and cannot assign a role
+// above their own.
+func (s *Service) authorizeMembershipAssignment( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID string,
+	userKind string,
+	userIndividualProfileID *string,
+	profileSlug string,
+	kind string,
 ) (string, error) {
 	roleLevel := getMembershipRoleLevel()
 
@@ -3828,21 +4679,41 @@ func (s *Service) AddMembership( //nolint:cyclop,funlen
 		}
 	}
 
+	return profileID, nil
+}
+
+// membershipGrant is the membership a grant created or promoted. previousKind
+// is set when an existing membership was promoted.
+type membershipGrant struct {
+	previousKind *string
+	membershipID string
+}
+
+// grantMembership gives memberProfileID a membership of kind on the profile
+// through repo. An existing membership (e.g. a follower) is promoted instead of
+// creating a second one.
+func (s *Service) grantMembership(
+	ctx context.Context,
+	repo Repository,
+	profileID string,
+	memberProfileID string,
+	kind string,
+) (*membershipGrant, error) {
 	// Check if the user already has a follower/sponsor membership that should be promoted
-	existing, existingErr := s.repo.GetProfileMembershipByProfileAndMember(
+	existing, err := repo.GetProfileMembershipByProfileAndMember(
 		ctx,
 		profileID,
 		memberProfileID,
 	)
-	if existingErr != nil {
-		return "", fmt.Errorf("%w: %w", ErrFailedToGetRecord, existingErr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
 	}
 
 	if existing != nil {
 		// Existing membership found — promote it to the new kind
-		err = s.repo.UpdateProfileMembership(ctx, existing.ID, kind)
+		err = repo.UpdateProfileMembership(ctx, existing.ID, kind)
 		if err != nil {
-			return "", fmt.Errorf(
+			return nil, fmt.Errorf(
 				"%w(membershipID: %s): %w",
 				ErrFailedToUpdateRecord,
 				existing.ID,
@@ -3850,12 +4721,43 @@ func (s *Service) AddMembership( //nolint:cyclop,funlen
 			)
 		}
 
-		_ = s.repo.InvalidateMembershipKindCache(ctx, profileID, memberProfileID)
+		_ = repo.InvalidateMembershipKindCache(ctx, profileID, memberProfileID)
+
+		return &membershipGrant{previousKind: &existing.Kind, membershipID: existing.ID}, nil
+	}
+
+	// Create a new membership
+	membershipID := s.idGenerator()
 
+	err = repo.CreateProfileMembership(
+		ctx,
+		string(membershipID),
+		profileID,
+		&memberProfileID,
+		kind,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
+	}
+
+	return &membershipGrant{previousKind: nil, membershipID: string(membershipID)}, nil
+}
+
+// recordMembershipGranted audits a membership created or promoted by a grant.
+func (s *Service) recordMembershipGranted(
+	ctx context.Context,
+	userID string,
+	profileID string,
+	memberProfileID string,
+	kind string,
+	grant *membershipGrant,
+) {
+	if grant.previousKind != nil {
 		s.auditService.Record(ctx, events.AuditParams{
 			EventType:  events.ProfileMembershipUpdated,
 			EntityType: "membership",
-			EntityID:   existing.ID,
+			EntityID:   grant.membershipID,
 			ActorID:    &userID,
 			ActorKind:  events.ActorUser,
 			SessionID:  nil,
@@ -3864,33 +4766,18 @@ func (s *Service) AddMembership( //nolint:cyclop,funlen
 				"member_profile_id": memberProfileID,
 				"kind":              kind,
 				"last_properties": map[string]any{
-					"kind": existing.Kind,
+					"kind": *grant.previousKind,
 				},
 			},
 		})
 
-		return existing.ID, nil
-	}
-
-	// Create a new membership
-	membershipID := s.idGenerator()
-
-	err = s.repo.CreateProfileMembership(
-		ctx,
-		string(membershipID),
-		profileID,
-		&memberProfileID,
-		kind,
-		nil,
-	)
-	if err != nil {
-		return "", fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
+		return
 	}
 
 	s.auditService.Record(ctx, events.AuditParams{
 		EventType:  events.ProfileMembershipCreated,
 		EntityType: "membership",
-		EntityID:   string(membershipID),
+		EntityID:   grant.membershipID,
 		ActorID:    &userID,
 		ActorKind:  events.ActorUser,
 		SessionID:  nil,
@@ -3900,8 +4787,6 @@ func (s *Service) AddMembership( //nolint:cyclop,funlen
 			"kind":              kind,
 		},
 	})
-
-	return string(membershipID), nil
 }
 
 // FollowProfile creates a follower membership for the viewer on the given profile.
@@ -4090,15 +4975,25 @@ func (s *Service) canUserRemoveResource(
 	return false
 }
 
-// ListProfileResources returns all resources associated with a profile,
+// ListProfileResources returns the resources associated with a profile that match the filter,
 // annotating each with whether the current user can remove it.
+// An empty sort mode follows the profile's manual order, featured resources first.
 func (s *Service) ListProfileResources(
 	ctx context.Context,
 	locale string,
 	userID string,
 	userKind string,
 	profileSlug string,
+	filter ProfileResourceFilter,
 ) ([]*ProfileResource, error) {
+	switch filter.Sort {
+	case "":
+		filter.Sort = ResourceSortManual
+	case ResourceSortManual, ResourceSortRecent, ResourceSortAlphabetical:
+	default:
+		return nil, fmt.Errorf("%w: unknown sort mode %q", ErrInvalidInput, filter.Sort)
+	}
+
 	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
 	if err != nil {
 		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
@@ -4108,15 +5003,22 @@ func (s *Service) ListProfileResources(
 		return nil, ErrProfileNotFound
 	}
 
-	resources, err := s.repo.ListProfileResourcesByProfileID(ctx, profileID)
+	resources, err := s.repo.ListProfileResourcesByProfileID(ctx, profileID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
 	}
 
-	// Populate teams for each resource
+	// Populate teams for all resources at once
+	resourceIDs := make([]string, len(resources))
+	for i, resource := range resources {
+		resourceIDs[i] = resource.ID
+	}
+
+	teamsByResource, teamsErr := s.repo.ListTeamsForResources(ctx, resourceIDs)
+
 	for _, resource := range resources {
-		teams, teamsErr := s.repo.ListResourceTeams(ctx, resource.ID)
-		if teamsErr == nil && teams != nil {
+		teams, ok := teamsByResource[resource.ID]
+		if teamsErr == nil && ok {
 			resource.Teams = teams
 		} else {
 			resource.Teams = []*ProfileTeam{}
@@ -4195,70 +5097,318 @@ func (s *Service) CreateProfileResource( //nolint:cyclop,funlen
 		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
 	}
 
-	return resource, nil
+	return resource, nil
+}
+
+// DeleteProfileResource soft-deletes a profile resource with authorization check.
+func (s *Service) DeleteProfileResource(
+	ctx context.Context,
+	locale string,
+	userID string,
+	userKind string,
+	profileSlug string,
+	resourceID string,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	// Get the resource
+	resource, err := s.repo.GetProfileResourceByID(ctx, resourceID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, resourceID, err)
+	}
+
+	if resource == nil {
+		return ErrProfileNotFound
+	}
+
+	if !s.canManageProfileResource(ctx, profileID, userID, userKind, resource) {
+		return ErrUnauthorized
+	}
+
+	err = s.repo.SoftDeleteProfileResource(ctx, resourceID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToDeleteRecord, err)
+	}
+
+	return nil
+}
+
+// RestoreProfileResource restores a soft-deleted profile resource, with the same
+// authorization as deleting it. A resource whose remote ID has since been taken by
+// another resource of the same kind cannot be restored.
+func (s *Service) RestoreProfileResource(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	profileSlug string,
+	resourceID string,
+) (*ProfileResource, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	resource, err := s.repo.GetDeletedProfileResourceByID(ctx, resourceID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, resourceID, err)
+	}
+
+	if resource == nil || resource.ProfileID != profileID {
+		return nil, ErrResourceNotFound
+	}
+
+	if !s.canManageProfileResource(ctx, profileID, userID, userKind, resource) {
+		return nil, ErrUnauthorized
+	}
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		if resource.RemoteID != nil {
+			existing, err := txRepo.GetProfileResourceByRemoteID(
+				ctx,
+				profileID,
+				resource.Kind,
+				*resource.RemoteID,
+			)
+			if err != nil {
+				return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+			}
+
+			if existing != nil {
+				return fmt.Errorf(
+					"%w: resource %s now holds this remote ID",
+					ErrDuplicateRecord,
+					existing.ID,
+				)
+			}
+		}
+
+		err := txRepo.RestoreProfileResource(ctx, resourceID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileResourceRestored,
+		EntityType: "resource",
+		EntityID:   resourceID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id": profileID,
+			"kind":       resource.Kind,
+			"remote_id":  resource.RemoteID,
+		},
+	})
+
+	resource.DeletedAt = nil
+
+	return resource, nil
+}
+
+// ReorderProfileResources sets the manual display order of a profile's resources.
+// Items list every active resource of the profile exactly once, in display order.
+// Requires maintainer access.
+func (s *Service) ReorderProfileResources(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	profileSlug string,
+	items []ResourceOrderItem,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	if userKind != UserKindAdmin {
+		err := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.repo.WithTx(ctx, func(txRepo Repository) error {
+		resourceIDs, err := txRepo.ListProfileResourceIDsByProfileID(ctx, profileID)
+		if err != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+		}
+
+		err = validateResourceOrder(resourceIDs, items)
+		if err != nil {
+			return err
+		}
+
+		for i, item := range items {
+			err = txRepo.UpdateProfileResourceOrder(ctx, item.ID, i+1, item.IsFeatured)
+			if err != nil {
+				return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, item.ID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// ReorderProfileLinks sets the display order of a profile's links in one go.
+// orderedIDs lists every active link of the profile exactly once, in display
+// order; a link of another profile is rejected with ErrUnauthorized.
+// Requires maintainer access.
+func (s *Service) ReorderProfileLinks(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	profileSlug string,
+	orderedIDs []string,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	if userKind != UserKindAdmin {
+		err := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.repo.WithTx(ctx, func(txRepo Repository) error {
+		linkIDs, err := txRepo.ListProfileLinkIDsByProfileID(ctx, profileID)
+		if err != nil {
+			return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+		}
+
+		err = validateLinkOrder(linkIDs, orderedIDs, profileSlug)
+		if err != nil {
+			return err
+		}
+
+		for i, linkID := range orderedIDs {
+			err = txRepo.UpdateProfileLinkOrder(ctx, linkID, i+1)
+			if err != nil {
+				return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, linkID, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+// validateLinkOrder checks that orderedIDs name each of the profile's links exactly once.
+func validateLinkOrder(linkIDs []string, orderedIDs []string, profileSlug string) error {
+	pending := make(map[string]bool, len(linkIDs))
+	for _, id := range linkIDs {
+		pending[id] = true
+	}
+
+	seen := make(map[string]bool, len(orderedIDs))
+
+	for _, id := range orderedIDs {
+		if seen[id] {
+			return fmt.Errorf("%w: link %q is listed more than once", ErrInvalidInput, id)
+		}
+
+		if !pending[id] {
+			return fmt.Errorf(
+				"%w: link %s does not belong to profile %s",
+				ErrUnauthorized,
+				id,
+				profileSlug,
+			)
+		}
+
+		seen[id] = true
+
+		delete(pending, id)
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d links are missing from the order", ErrInvalidInput, len(pending))
+	}
+
+	return nil
 }
 
-// DeleteProfileResource soft-deletes a profile resource with authorization check.
-func (s *Service) DeleteProfileResource( //nolint:cyclop
-	ctx context.Context,
-	locale string,
-	userID string,
-	userKind string,
-	profileSlug string,
-	resourceID string,
-) error {
-	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
-	if err != nil {
-		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+// validateResourceOrder checks that items name each of the profile's resources exactly once.
+func validateResourceOrder(resourceIDs []string, items []ResourceOrderItem) error {
+	pending := make(map[string]bool, len(resourceIDs))
+	for _, id := range resourceIDs {
+		pending[id] = true
 	}
 
-	if profileID == "" {
-		return ErrProfileNotFound
-	}
+	for _, item := range items {
+		if !pending[item.ID] {
+			return fmt.Errorf(
+				"%w: resource %q is unknown or listed more than once",
+				ErrInvalidInput,
+				item.ID,
+			)
+		}
 
-	// Get the resource
-	resource, err := s.repo.GetProfileResourceByID(ctx, resourceID)
-	if err != nil {
-		return fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, resourceID, err)
+		delete(pending, item.ID)
 	}
 
-	if resource == nil {
-		return ErrProfileNotFound
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d resources are missing from the order", ErrInvalidInput, len(pending))
 	}
 
-	// Check authorization
-	canDelete := userKind == UserKindAdmin
+	return nil
+}
 
-	// Site admins can always delete
+// canManageProfileResource reports whether the user may delete or restore the resource:
+// site admins, the original adder, and maintainers+ of the profile.
+func (s *Service) canManageProfileResource(
+	ctx context.Context,
+	profileID string,
+	userID string,
+	userKind string,
+	resource *ProfileResource,
+) bool {
+	// Site admins can always manage
+	if userKind == UserKindAdmin {
+		return true
+	}
 
-	// The original adder can delete
-	if !canDelete && userID != "" {
+	// The original adder can manage
+	if userID != "" {
 		userInfo, upErr := s.repo.GetUserBriefInfo(ctx, userID)
 		if upErr == nil && userInfo != nil && userInfo.IndividualProfileID != nil {
 			if *userInfo.IndividualProfileID == resource.AddedByProfileID {
-				canDelete = true
+				return true
 			}
 		}
 	}
 
-	// Maintainers+ can delete
-	if !canDelete {
-		err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
-		if err == nil {
-			canDelete = true
-		}
-	}
-
-	if !canDelete {
-		return ErrUnauthorized
-	}
-
-	err = s.repo.SoftDeleteProfileResource(ctx, resourceID)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrFailedToDeleteRecord, err)
-	}
+	// Maintainers+ can manage
+	err := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
 
-	return nil
+	return err == nil
 }
 
 // GetManagedGitHubLink returns the managed GitHub link for a profile.
@@ -4465,6 +5615,239 @@ func (s *Service) SetMembershipTeams(
 	return nil
 }
 
+// AddTeamToMemberships adds a team to several memberships at once. Requires maintainer access.
+// The team and every membership must belong to the profile; nothing is changed otherwise.
+// Returns the number of memberships newly added to the team.
+func (s *Service) AddTeamToMemberships(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	teamID string,
+	membershipIDs []string,
+) (int64, error) {
+	idGen := func() string { return string(s.idGenerator()) }
+
+	return s.updateTeamMemberships(
+		ctx,
+		userID,
+		profileSlug,
+		teamID,
+		membershipIDs,
+		events.ProfileTeamMembersAdded,
+		func(txRepo Repository, membershipIDs []string) (int64, error) {
+			return txRepo.AddTeamToMemberships(ctx, teamID, membershipIDs, idGen)
+		},
+	)
+}
+
+// RemoveTeamFromMemberships removes a team from several memberships at once. Requires maintainer access.
+// The team and every membership must belong to the profile; nothing is changed otherwise.
+// Returns the number of memberships removed from the team.
+func (s *Service) RemoveTeamFromMemberships(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	teamID string,
+	membershipIDs []string,
+) (int64, error) {
+	return s.updateTeamMemberships(
+		ctx,
+		userID,
+		profileSlug,
+		teamID,
+		membershipIDs,
+		events.ProfileTeamMembersRemoved,
+		func(txRepo Repository, membershipIDs []string) (int64, error) {
+			return txRepo.RemoveTeamFromMemberships(ctx, teamID, membershipIDs)
+		},
+	)
+}
+
+// updateTeamMemberships validates a bulk team membership change and applies it in a single
+// transaction, then records one summary audit event for the whole batch.
+func (s *Service) updateTeamMemberships( //nolint:funlen
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	teamID string,
+	membershipIDs []string,
+	eventType events.EventType,
+	apply func(txRepo Repository, membershipIDs []string) (int64, error),
+) (int64, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return 0, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return 0, ErrProfileNotFound
+	}
+
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+	if accessErr != nil {
+		return 0, accessErr
+	}
+
+	membershipIDs = slices.Compact(slices.Sorted(slices.Values(membershipIDs)))
+	if len(membershipIDs) == 0 {
+		return 0, fmt.Errorf("%w: at least one membership is required", ErrInvalidInput)
+	}
+
+	var affected int64
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		team, err := txRepo.GetProfileTeamByID(ctx, teamID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		}
+
+		if team == nil || team.ProfileID != profileID {
+			return ErrTeamNotFound
+		}
+
+		count, err := txRepo.CountProfileMembershipsByIDs(ctx, profileID, membershipIDs)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		}
+
+		if count != int64(len(membershipIDs)) {
+			return fmt.Errorf(
+				"%w: %d of %d memberships do not belong to this profile",
+				ErrInvalidInput,
+				int64(len(membershipIDs))-count,
+				len(membershipIDs),
+			)
+		}
+
+		affected, err = apply(txRepo, membershipIDs)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  eventType,
+		EntityType: "team",
+		EntityID:   teamID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":     profileID,
+			"membership_ids": membershipIDs,
+			"requested":      len(membershipIDs),
+			"affected":       affected,
+		},
+	})
+
+	return affected, nil
+}
+
+// SetTeamLead designates a membership as the lead of a team. Requires maintainer access.
+// The membership must already be a member of the team.
+func (s *Service) SetTeamLead(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	teamID string,
+	membershipID string,
+) error {
+	if membershipID == "" {
+		return fmt.Errorf("%w: membership is required", ErrInvalidInput)
+	}
+
+	return s.updateTeamLead(ctx, userID, profileSlug, teamID, &membershipID)
+}
+
+// ClearTeamLead removes the lead designation of a team. Requires maintainer access.
+func (s *Service) ClearTeamLead(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	teamID string,
+) error {
+	return s.updateTeamLead(ctx, userID, profileSlug, teamID, nil)
+}
+
+func (s *Service) updateTeamLead(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	teamID string,
+	membershipID *string,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	accessErr := s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+	if accessErr != nil {
+		return accessErr
+	}
+
+	var previousLeadID *string
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		team, err := txRepo.GetProfileTeamByID(ctx, teamID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+		}
+
+		if team == nil || team.ProfileID != profileID {
+			return ErrTeamNotFound
+		}
+
+		previousLeadID = team.LeadMembershipID
+
+		if membershipID != nil {
+			isMember, err := txRepo.IsMembershipInProfileTeam(ctx, teamID, *membershipID)
+			if err != nil {
+				return fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
+			}
+
+			if !isMember {
+				return ErrTeamLeadNotMember
+			}
+		}
+
+		err = txRepo.SetProfileTeamLead(ctx, teamID, membershipID)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToUpdateRecord, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileTeamLeadChanged,
+		EntityType: "team",
+		EntityID:   teamID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":                  profileID,
+			"lead_membership_id":          membershipID,
+			"previous_lead_membership_id": previousLeadID,
+		},
+	})
+
+	return nil
+}
+
 // SetResourceTeams assigns teams to a resource. Requires maintainer access.
 func (s *Service) SetResourceTeams(
 	ctx context.Context,
@@ -4510,6 +5893,10 @@ func (s *Service) CreateCandidate( //nolint:cyclop,funlen
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMember)
 	if err != nil {
 		return nil, err
@@ -4536,6 +5923,10 @@ func (s *Service) CreateCandidate( //nolint:cyclop,funlen
 		return nil, fmt.Errorf("%w: referred profile not found: %w", ErrProfileNotFound, err)
 	}
 
+	if referredProfileID == "" {
+		return nil, fmt.Errorf("%w: referred profile not found", ErrProfileNotFound)
+	}
+
 	// Only individual profiles can be referred
 	referredBrief, err := s.repo.GetProfileIdentifierByID(ctx, referredProfileID)
 	if err != nil || referredBrief == nil {
@@ -4591,7 +5982,7 @@ func (s *Service) CreateCandidate( //nolint:cyclop,funlen
 
 	candidate, err := s.repo.CreateProfileMembershipCandidate(
 		ctx, candidateID, profileID, referredProfileID, &referrerMembership.ID,
-		string(CandidateSourceReferral), nil,
+		string(CandidateSourceReferral), nil, s.candidateVotingDeadline(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
@@ -4639,6 +6030,10 @@ func (s *Service) ListCandidates(
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMember)
 	if err != nil {
 		return nil, err
@@ -4697,6 +6092,10 @@ func (s *Service) VoteCandidate( //nolint:cyclop,funlen
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMember)
 	if err != nil {
 		return nil, err
@@ -4771,6 +6170,10 @@ func (s *Service) GetCandidateVotes(
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMember)
 	if err != nil {
 		return nil, err
@@ -4831,6 +6234,10 @@ func (s *Service) UpdateCandidateStatus(
 		return fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
 	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
 	if err != nil {
 		return err
@@ -4935,12 +6342,24 @@ func (s *Service) EnsureMembershipFromCandidateInternal(
 	memberProfileID string,
 	candidateID string,
 ) (string, error) {
-	membershipID, err := s.ensureMinMemberMembership(ctx, profileID, memberProfileID)
+	return s.ensureMembershipFromCandidate(ctx, s.repo, profileID, memberProfileID, candidateID)
+}
+
+// ensureMembershipFromCandidate is EnsureMembershipFromCandidateInternal
+// writing through repo, which may be bound to a transaction.
+func (s *Service) ensureMembershipFromCandidate(
+	ctx context.Context,
+	repo Repository,
+	profileID string,
+	memberProfileID string,
+	candidateID string,
+) (string, error) {
+	membershipID, err := s.ensureMinMemberMembership(ctx, repo, profileID, memberProfileID)
 	if err != nil {
 		return "", err
 	}
 
-	teamsErr := s.mergeCandidateTeams(ctx, membershipID, candidateID)
+	teamsErr := s.mergeCandidateTeams(ctx, repo, membershipID, candidateID)
 	if teamsErr != nil {
 		return membershipID, teamsErr
 	}
@@ -4952,20 +6371,21 @@ func (s *Service) EnsureMembershipFromCandidateInternal(
 // profileID and memberProfileID. Returns the membership ID.
 func (s *Service) ensureMinMemberMembership(
 	ctx context.Context,
+	repo Repository,
 	profileID string,
 	memberProfileID string,
 ) (string, error) {
-	existing, _ := s.repo.GetProfileMembershipByProfileAndMember(ctx, profileID, memberProfileID)
+	existing, _ := repo.GetProfileMembershipByProfileAndMember(ctx, profileID, memberProfileID)
 
 	if existing != nil {
 		levels := GetMembershipKindLevel()
 		if levels[MembershipKind(existing.Kind)] < levels[MembershipKindMember] {
-			err := s.repo.UpdateProfileMembership(ctx, existing.ID, string(MembershipKindMember))
+			err := repo.UpdateProfileMembership(ctx, existing.ID, string(MembershipKindMember))
 			if err != nil {
 				return "", fmt.Errorf("%w: upgrade membership: %w", ErrFailedToUpdateRecord, err)
 			}
 
-			_ = s.repo.InvalidateMembershipKindCache(ctx, profileID, memberProfileID)
+			_ = repo.InvalidateMembershipKindCache(ctx, profileID, memberProfileID)
 
 			s.recordSystemMembershipAudit(
 				ctx,
@@ -4983,7 +6403,7 @@ func (s *Service) ensureMinMemberMembership(
 
 	newID := string(s.idGenerator())
 
-	err := s.repo.CreateProfileMembership(
+	err := repo.CreateProfileMembership(
 		ctx,
 		newID,
 		profileID,
@@ -5006,15 +6426,16 @@ func (s *Service) ensureMinMemberMembership(
 // mergeCandidateTeams merges a candidate's suggested teams into the membership's existing teams.
 func (s *Service) mergeCandidateTeams(
 	ctx context.Context,
+	repo Repository,
 	membershipID string,
 	candidateID string,
 ) error {
-	candidateTeams, _ := s.repo.ListCandidateTeams(ctx, candidateID)
+	candidateTeams, _ := repo.ListCandidateTeams(ctx, candidateID)
 	if len(candidateTeams) == 0 {
 		return nil
 	}
 
-	existingTeams, _ := s.repo.ListMembershipTeams(ctx, membershipID)
+	existingTeams, _ := repo.ListMembershipTeams(ctx, membershipID)
 
 	existingSet := make(map[string]struct{}, len(existingTeams))
 	for _, t := range existingTeams {
@@ -5042,7 +6463,7 @@ func (s *Service) mergeCandidateTeams(
 
 	idGen := func() string { return string(s.idGenerator()) }
 
-	err := s.repo.SetMembershipTeams(ctx, membershipID, mergedIDs, idGen)
+	err := repo.SetMembershipTeams(ctx, membershipID, mergedIDs, idGen)
 	if err != nil {
 		return fmt.Errorf("%w: set teams: %w", ErrFailedToUpdateRecord, err)
 	}
@@ -5127,6 +6548,10 @@ func (s *Service) GetApplicationForm(
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	form, featureApplications, err := s.repo.GetApplicationFormByProfileID(ctx, profileID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrNoApplicationForm, err)
@@ -5155,6 +6580,10 @@ func (s *Service) UpsertApplicationForm( //nolint:cyclop,gocognit,funlen
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindLead)
 	if err != nil {
 		return nil, err
@@ -5269,6 +6698,10 @@ func (s *Service) CreateApplication( //nolint:cyclop,funlen,gocognit
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	// Try to get application form (also returns feature flag)
 	form, featureApplications, formErr := s.repo.GetApplicationFormByProfileID(ctx, profileID)
 	if formErr != nil {
@@ -5352,7 +6785,7 @@ func (s *Service) CreateApplication( //nolint:cyclop,funlen,gocognit
 
 		candidate, createErr = s.repo.CreateProfileMembershipCandidate(
 			ctx, candidateID, profileID, applicantProfileID, nil,
-			string(CandidateSourceApplication), applicantMessage,
+			string(CandidateSourceApplication), applicantMessage, s.candidateVotingDeadline(),
 		)
 		if createErr != nil {
 			return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, createErr)
@@ -5405,6 +6838,10 @@ func (s *Service) GetMyApplication(
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	userInfo, err := s.repo.GetUserBriefInfo(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrFailedToGetRecord, err)
@@ -5444,6 +6881,10 @@ func (s *Service) GetCandidateFormResponses(
 		return nil, fmt.Errorf("%w: %w", ErrProfileNotFound, err)
 	}
 
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
 	// Determine minimum access level from form's visibility setting
 	requiredLevel := MembershipKindMember
 
@@ -0,0 +1,158 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var ErrSlugUnavailable = errors.New("slug is not available")
+
+// maxProfileSlugLength is the longest slug a profile can be renamed to.
+const maxProfileSlugLength = 50
+
+// ChangeProfileSlug renames a profile. Owner only. The new slug must be well
+// formed, not reserved, and never used by another profile, deleted ones and
+// earlier slugs included. The old slug is kept in the profile's slug history so
+// links to it can be redirected; a profile can take one of its own old slugs back.
+func (s *Service) ChangeProfileSlug( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	newSlug string,
+) error {
+	slug := strings.TrimSpace(newSlug)
+
+	if len(slug) < minSlugLength || len(slug) > maxProfileSlugLength ||
+		!handleSlugRegex.MatchString(slug) {
+		return fmt.Errorf(
+			"%w: slug must be %d-%d lowercase letters, digits or hyphens",
+			ErrInvalidInput,
+			minSlugLength,
+			maxProfileSlugLength,
+		)
+	}
+
+	if slug == profileSlug {
+		return fmt.Errorf("%w: the profile already has this slug", ErrInvalidInput)
+	}
+
+	if s.config.GetForbiddenSlugs()[slug] {
+		return fmt.Errorf("%w: %s is reserved", ErrSlugUnavailable, slug)
+	}
+
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return fmt.Errorf("%w(slug: %s)", ErrProfileNotFound, profileSlug)
+	}
+
+	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindOwner)
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.repo.CheckProfileSlugExistsIncludingDeleted(ctx, slug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+	}
+
+	if exists {
+		return fmt.Errorf("%w: %s is taken", ErrSlugUnavailable, slug)
+	}
+
+	usedByOther, err := s.isSlugInOtherProfileHistory(ctx, slug, profileID)
+	if err != nil {
+		return err
+	}
+
+	if usedByOther {
+		return fmt.Errorf("%w: %s was used by another profile", ErrSlugUnavailable, slug)
+	}
+
+	reservation, err := s.getActiveHandleReservation(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	if reservation != nil {
+		return fmt.Errorf("%w: %s is reserved for an organization", ErrSlugUnavailable, slug)
+	}
+
+	err = s.repo.WithTx(ctx, func(txRepo Repository) error {
+		_, txErr := txRepo.DeleteProfileSlugHistory(ctx, profileID, slug)
+		if txErr != nil {
+			return fmt.Errorf("%w(slug: %s): %w", ErrFailedToDeleteRecord, slug, txErr)
+		}
+
+		txErr = txRepo.InsertProfileSlugHistory(ctx, string(s.idGenerator()), profileID, profileSlug)
+		if txErr != nil {
+			return fmt.Errorf("%w(slug: %s): %w", ErrFailedToCreateRecord, profileSlug, txErr)
+		}
+
+		updated, txErr := txRepo.UpdateProfileSlug(ctx, profileID, slug)
+		if txErr != nil {
+			return fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, profileID, txErr)
+		}
+
+		if !updated {
+			return fmt.Errorf("%w(slug: %s)", ErrProfileNotFound, profileSlug)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_ = s.repo.InvalidateProfileSlugCache(ctx, profileSlug)
+	_ = s.repo.InvalidateProfileSlugCache(ctx, slug)
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileSlugChanged,
+		EntityType: "profile",
+		EntityID:   profileID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"previous_slug": profileSlug,
+			"slug":          slug,
+		},
+	})
+
+	return nil
+}
+
+// isSlugInOtherProfileHistory reports whether a profile other than profileID
+// used the slug before. Such slugs redirect to that profile, so they can't be
+// taken. profileID is "" for a profile that doesn't exist yet.
+func (s *Service) isSlugInOtherProfileHistory(
+	ctx context.Context,
+	slug string,
+	profileID string,
+) (bool, error) {
+	previousOwnerID, err := s.repo.GetProfileSlugHistoryProfileID(ctx, slug)
+	if err != nil {
+		return false, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+	}
+
+	return previousOwnerID != "" && previousOwnerID != profileID, nil
+}
+
+// GetProfileSlugRedirect returns the current slug of the live profile that
+// used the given slug before it was renamed, or "" when no profile did.
+func (s *Service) GetProfileSlugRedirect(ctx context.Context, slug string) (string, error) {
+	currentSlug, err := s.repo.GetCurrentSlugByPreviousSlug(ctx, slug)
+	if err != nil {
+		return "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, slug, err)
+	}
+
+	return currentSlug, nil
+}
@@ -0,0 +1,243 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slugHistoryRepository keeps the live and deleted profile slugs and the slug
+// history in memory. "old-acme" and "old-globex" are slugs the profiles used before.
+type slugHistoryRepository struct {
+	profiles.Repository
+
+	slugs        map[string]string // live slug -> profile ID
+	deletedSlugs map[string]bool
+	history      map[string]string // previous slug -> profile ID
+}
+
+func newSlugHistoryRepository() *slugHistoryRepository {
+	return &slugHistoryRepository{
+		slugs:        map[string]string{"acme": "acme-id", "globex": "globex-id"},
+		deletedSlugs: map[string]bool{"initech": true},
+		history:      map[string]string{"old-acme": "acme-id", "old-globex": "globex-id"},
+	}
+}
+
+func (r *slugHistoryRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *slugHistoryRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *slugHistoryRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	return r.slugs[slug], nil
+}
+
+func (r *slugHistoryRepository) CheckProfileSlugExistsIncludingDeleted(
+	_ context.Context,
+	slug string,
+) (bool, error) {
+	_, live := r.slugs[slug]
+
+	return live || r.deletedSlugs[slug], nil
+}
+
+func (r *slugHistoryRepository) CheckProfileSlugExists(_ context.Context, slug string) (bool, error) {
+	_, live := r.slugs[slug]
+
+	return live, nil
+}
+
+func (r *slugHistoryRepository) GetProfileSlugHistoryProfileID(
+	_ context.Context,
+	slug string,
+) (string, error) {
+	return r.history[slug], nil
+}
+
+func (r *slugHistoryRepository) GetActiveHandleReservationBySlug(
+	_ context.Context,
+	_ string,
+) (*profiles.HandleReservation, error) {
+	return nil, nil //nolint:nilnil
+}
+
+func (r *slugHistoryRepository) DeleteProfileSlugHistory(
+	_ context.Context,
+	profileID string,
+	slug string,
+) (int64, error) {
+	if r.history[slug] != profileID {
+		return 0, nil
+	}
+
+	delete(r.history, slug)
+
+	return 1, nil
+}
+
+func (r *slugHistoryRepository) InsertProfileSlugHistory(
+	_ context.Context,
+	_ string,
+	profileID string,
+	slug string,
+) error {
+	r.history[slug] = profileID
+
+	return nil
+}
+
+func (r *slugHistoryRepository) UpdateProfileSlug(
+	_ context.Context,
+	id string,
+	slug string,
+) (bool, error) {
+	for current, profileID := range r.slugs {
+		if profileID == id {
+			delete(r.slugs, current)
+			r.slugs[slug] = id
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *slugHistoryRepository) InvalidateProfileSlugCache(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *slugHistoryRepository) GetCurrentSlugByPreviousSlug(
+	_ context.Context,
+	slug string,
+) (string, error) {
+	profileID, ok := r.history[slug]
+	if !ok {
+		return "", nil
+	}
+
+	for current, id := range r.slugs {
+		if id == profileID {
+			return current, nil
+		}
+	}
+
+	return "", nil
+}
+
+func newSlugHistoryService() (*profiles.Service, *slugHistoryRepository, *recordingAuditRepository) {
+	repo := newSlugHistoryRepository()
+	auditService, auditRepo := newRecordingAuditService()
+
+	config := &profiles.Config{ForbiddenSlugs: "admin,search"} //nolint:exhaustruct
+
+	return profiles.NewService(nil, config, repo, auditService), repo, auditRepo
+}
+
+func TestChangeProfileSlug_RejectsUnavailableSlugs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		profile  string
+		slug     string
+		expected error
+	}{
+		"too short":                  {slug: "a", expected: profiles.ErrInvalidInput},
+		"uppercase":                  {slug: "Acme-Corp", expected: profiles.ErrInvalidInput},
+		"invalid characters":         {slug: "acme_corp", expected: profiles.ErrInvalidInput},
+		"unchanged":                  {slug: "acme", expected: profiles.ErrInvalidInput},
+		"forbidden":                  {slug: "admin", expected: profiles.ErrSlugUnavailable},
+		"taken by a live profile":    {slug: "globex", expected: profiles.ErrSlugUnavailable},
+		"taken by a deleted profile": {slug: "initech", expected: profiles.ErrSlugUnavailable},
+		"used before by another one": {slug: "old-globex", expected: profiles.ErrSlugUnavailable},
+		"unknown profile":            {profile: "ghost", slug: "fresh", expected: profiles.ErrProfileNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newSlugHistoryService()
+
+			profileSlug := tt.profile
+			if profileSlug == "" {
+				profileSlug = "acme"
+			}
+
+			err := service.ChangeProfileSlug(t.Context(), "admin", profileSlug, tt.slug)
+			require.ErrorIs(t, err, tt.expected)
+
+			assert.Equal(t, "acme-id", repo.slugs["acme"])
+			assert.Len(t, repo.history, 2)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
+
+func TestChangeProfileSlug_RedirectsPreviousSlug(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newSlugHistoryService()
+
+	err := service.ChangeProfileSlug(t.Context(), "admin", "acme", " acme-corp ")
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme-id", repo.slugs["acme-corp"])
+	assert.NotContains(t, repo.slugs, "acme")
+
+	for _, previous := range []string{"acme", "old-acme"} {
+		current, err := service.GetProfileSlugRedirect(t.Context(), previous)
+		require.NoError(t, err)
+		assert.Equal(t, "acme-corp", current, previous)
+	}
+
+	current, err := service.GetProfileSlugRedirect(t.Context(), "never-used")
+	require.NoError(t, err)
+	assert.Empty(t, current)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileSlugChanged, auditRepo.entries[0].EventType)
+
+	// Taking an old slug back removes it from the history.
+	err = service.ChangeProfileSlug(t.Context(), "admin", "acme-corp", "old-acme")
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme-id", repo.slugs["old-acme"])
+	assert.NotContains(t, repo.history, "old-acme")
+	assert.Equal(t, "acme-id", repo.history["acme-corp"])
+}
+
+func TestPreviousSlugsAreUnavailableToNewProfiles(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newSlugHistoryService()
+
+	result, err := service.CheckSlugAvailability(t.Context(), "old-globex", false)
+	require.NoError(t, err)
+	assert.False(t, result.Available)
+	assert.Equal(t, profiles.SeverityError, result.Severity)
+
+	result, err = service.CheckSlugAvailability(t.Context(), "fresh", false)
+	require.NoError(t, err)
+	assert.True(t, result.Available)
+
+	_, err = service.Create(t.Context(), "user", "en", "old-globex", "individual", "Globex", "", nil, nil, nil)
+	require.ErrorIs(t, err, profiles.ErrSlugUnavailable)
+
+	assert.NotContains(t, repo.slugs, "old-globex")
+	assert.Empty(t, auditRepo.entries)
+}
@@ -0,0 +1,71 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// SetMembershipStoryAggregation opts a member in to, or out of, having their
+// stories listed in the organization's aggregated story list. Only the member
+// themselves or an admin may change it.
+func (s *Service) SetMembershipStoryAggregation(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	userIndividualProfileID *string,
+	profileSlug string,
+	membershipID string,
+	enabled bool,
+) error {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return ErrProfileNotFound
+	}
+
+	membership, err := s.repo.GetProfileMembershipByID(ctx, membershipID)
+	if err != nil {
+		return fmt.Errorf("%w(membershipID: %s): %w", ErrFailedToGetRecord, membershipID, err)
+	}
+
+	if membership == nil || membership.ProfileID != profileID {
+		return ErrMembershipNotFound
+	}
+
+	isMember := userIndividualProfileID != nil && membership.MemberProfileID != nil &&
+		*membership.MemberProfileID == *userIndividualProfileID
+
+	if !isMember && userKind != UserKindAdmin {
+		return ErrInsufficientAccess
+	}
+
+	updated, err := s.repo.UpdateProfileMembershipAggregateStories(ctx, membershipID, enabled)
+	if err != nil {
+		return fmt.Errorf("%w(membershipID: %s): %w", ErrFailedToUpdateRecord, membershipID, err)
+	}
+
+	if !updated {
+		return ErrMembershipNotFound
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ProfileMembershipUpdated,
+		EntityType: "membership",
+		EntityID:   membershipID,
+		ActorID:    &userID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"profile_id":        membership.ProfileID,
+			"member_profile_id": membership.MemberProfileID,
+			"aggregate_stories": enabled,
+		},
+	})
+
+	return nil
+}
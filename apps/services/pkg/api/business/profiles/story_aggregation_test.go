@@ -0,0 +1,144 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// storyAggregationRepository resolves every slug but "elsewhere" to a profile of
+// the same ID, serves one membership of the "member" profile in "acme", and
+// records the aggregation flag it was set to.
+type storyAggregationRepository struct {
+	profiles.Repository
+
+	aggregateStories *bool
+}
+
+func (r *storyAggregationRepository) GetProfileIDBySlug(
+	_ context.Context,
+	slug string,
+) (string, error) {
+	if slug == "elsewhere" {
+		return "", nil
+	}
+
+	return slug, nil
+}
+
+func (r *storyAggregationRepository) GetProfileMembershipByID(
+	_ context.Context,
+	id string,
+) (*profiles.ProfileMembership, error) {
+	if id != "membership" {
+		return nil, nil //nolint:nilnil
+	}
+
+	memberProfileID := "member"
+
+	return &profiles.ProfileMembership{ //nolint:exhaustruct
+		ID:              id,
+		ProfileID:       "acme",
+		MemberProfileID: &memberProfileID,
+		Kind:            string(profiles.MembershipKindMember),
+	}, nil
+}
+
+func (r *storyAggregationRepository) UpdateProfileMembershipAggregateStories(
+	_ context.Context,
+	_ string,
+	aggregateStories bool,
+) (bool, error) {
+	r.aggregateStories = &aggregateStories
+
+	return true, nil
+}
+
+func TestSetMembershipStoryAggregation(t *testing.T) {
+	t.Parallel()
+
+	member := "member"
+	other := "other"
+
+	tests := map[string]struct {
+		userKind                string
+		userIndividualProfileID *string
+		slug                    string
+		membershipID            string
+		expectedErr             error
+	}{
+		"member opts in": {userKind: "regular", userIndividualProfileID: &member},
+		"admin sets it":  {userKind: profiles.UserKindAdmin, userIndividualProfileID: &other},
+		"another user": {
+			userKind:                "regular",
+			userIndividualProfileID: &other,
+			expectedErr:             profiles.ErrInsufficientAccess,
+		},
+		"no individual profile": {
+			userKind:    "regular",
+			expectedErr: profiles.ErrInsufficientAccess,
+		},
+		"unknown membership": {
+			userKind:                "regular",
+			userIndividualProfileID: &member,
+			membershipID:            "missing",
+			expectedErr:             profiles.ErrMembershipNotFound,
+		},
+		"membership of another profile": {
+			userKind:                "regular",
+			userIndividualProfileID: &member,
+			slug:                    "other-org",
+			expectedErr:             profiles.ErrMembershipNotFound,
+		},
+		"unknown profile": {
+			userKind:                "regular",
+			userIndividualProfileID: &member,
+			slug:                    "elsewhere",
+			expectedErr:             profiles.ErrProfileNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &storyAggregationRepository{} //nolint:exhaustruct
+			auditService, _ := newRecordingAuditService()
+			service := profiles.NewService(nil, nil, repo, auditService)
+
+			slug := tt.slug
+			if slug == "" {
+				slug = "acme"
+			}
+
+			membershipID := tt.membershipID
+			if membershipID == "" {
+				membershipID = "membership"
+			}
+
+			err := service.SetMembershipStoryAggregation(
+				t.Context(),
+				"user",
+				tt.userKind,
+				tt.userIndividualProfileID,
+				slug,
+				membershipID,
+				true,
+			)
+
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, repo.aggregateStories)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, repo.aggregateStories)
+			assert.True(t, *repo.aggregateStories)
+		})
+	}
+}
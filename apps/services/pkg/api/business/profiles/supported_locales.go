@@ -0,0 +1,70 @@
+package profiles
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultSupportedLocales is the comma-separated list of locales the platform
+// serves when Config.SupportedLocales is not set.
+const DefaultSupportedLocales = "ar,de,en,es,fr,it,ja,ko,nl,pt-PT,ru,tr,zh-CN"
+
+// localeCodeRegex matches BCP 47-style codes the platform uses: a language
+// subtag optionally followed by region or script subtags, e.g. "en", "pt-PT".
+var localeCodeRegex = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// DefaultSupportedLocaleCodes returns the set of DefaultSupportedLocales.
+func DefaultSupportedLocaleCodes() map[string]bool {
+	return newLocaleSet(splitLocaleCodes(DefaultSupportedLocales))
+}
+
+// SupportedLocaleCodes returns the set of locales the service was configured
+// with. The returned map must not be modified.
+func (s *Service) SupportedLocaleCodes() map[string]bool {
+	return s.supportedLocales
+}
+
+// IsValidLocale checks whether the given locale code is supported.
+func (s *Service) IsValidLocale(localeCode string) bool {
+	return s.supportedLocales[localeCode]
+}
+
+// ValidateTranslationLocale returns ErrInvalidInput when content cannot be
+// written in the given locale because the platform does not support it.
+func (s *Service) ValidateTranslationLocale(localeCode string) error {
+	if !s.IsValidLocale(localeCode) {
+		return fmt.Errorf("%w: unsupported locale %q", ErrInvalidInput, localeCode)
+	}
+
+	return nil
+}
+
+// IsWellFormedLocaleCode checks whether a locale code has the BCP 47-style
+// shape supported locales must have.
+func IsWellFormedLocaleCode(localeCode string) bool {
+	return localeCodeRegex.MatchString(localeCode)
+}
+
+func splitLocaleCodes(codes string) []string {
+	parts := strings.Split(codes, ",")
+	result := make([]string, 0, len(parts))
+
+	for _, code := range parts {
+		trimmed := strings.TrimSpace(code)
+		if trimmed != "" {
+			result = append(result, trimmed)
+		}
+	}
+
+	return result
+}
+
+func newLocaleSet(codes []string) map[string]bool {
+	set := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		set[code] = true
+	}
+
+	return set
+}
@@ -0,0 +1,91 @@
+package profiles_test
+
+import (
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsValidLocale_FollowsConfiguredLocales(t *testing.T) {
+	t.Parallel()
+
+	defaults := profiles.NewService(nil, &profiles.Config{}, nil, nil) //nolint:exhaustruct
+
+	assert.True(t, defaults.IsValidLocale("fr"), "default locales are supported")
+	assert.Equal(t, profiles.DefaultSupportedLocaleCodes(), defaults.SupportedLocaleCodes())
+
+	config := &profiles.Config{SupportedLocales: "en, tr,de"} //nolint:exhaustruct
+	service := profiles.NewService(nil, config, nil, nil)
+
+	assert.True(t, service.IsValidLocale("en"))
+	assert.True(t, service.IsValidLocale("tr"))
+	assert.True(t, service.IsValidLocale("de"))
+	assert.False(t, service.IsValidLocale("fr"), "a locale left out of the config is rejected")
+	assert.False(t, service.IsValidLocale(""))
+}
+
+func TestIsWellFormedLocaleCode(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range []string{"en", "pt-PT", "zh-CN", "zh-Hant", "fil"} {
+		assert.True(t, profiles.IsWellFormedLocaleCode(code), code)
+	}
+
+	for _, code := range []string{"", "EN", "pt_PT", "en-", "e", "en-US-x-very-long-subtag"} {
+		assert.False(t, profiles.IsWellFormedLocaleCode(code), code)
+	}
+}
+
+// unsupportedLocaleRepository fails the test on any call: translations in an
+// unsupported locale must be rejected before the repository is touched.
+type unsupportedLocaleRepository struct {
+	profiles.Repository
+}
+
+func TestValidateTranslationLocale(t *testing.T) {
+	t.Parallel()
+
+	service := profiles.NewService(nil, &profiles.Config{}, nil, nil) //nolint:exhaustruct
+
+	require.NoError(t, service.ValidateTranslationLocale("en"))
+
+	for _, code := range []string{"xx", "", "EN", "pt_PT"} {
+		require.ErrorIs(t, service.ValidateTranslationLocale(code), profiles.ErrInvalidInput, code)
+	}
+}
+
+func TestTranslationWrites_RejectUnsupportedLocale(t *testing.T) {
+	t.Parallel()
+
+	repo := &unsupportedLocaleRepository{} //nolint:exhaustruct
+
+	service := profiles.NewService(nil, &profiles.Config{}, repo, nil) //nolint:exhaustruct
+
+	tests := map[string]func() error{
+		"profile": func() error {
+			return service.UpdateTranslation(
+				t.Context(), "user", profiles.UserKindAdmin, "acme", "xx", "Title", "", nil,
+			)
+		},
+		"link": func() error {
+			return service.UpsertProfileLinkTranslation(
+				t.Context(), "user", profiles.UserKindAdmin, "acme", "link", "xx", "Title", nil, nil, nil,
+			)
+		},
+		"page": func() error {
+			return service.UpdateProfilePageTranslation(
+				t.Context(), "user", profiles.UserKindAdmin, "acme", "page", "xx", "Title", "", "",
+			)
+		},
+	}
+
+	for name, write := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, write(), profiles.ErrInvalidInput)
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// teamMembershipsRepository serves the calls made by the team membership and lead methods.
+type teamMembershipsRepository struct {
+	profiles.Repository
+
+	teams       map[string]*profiles.ProfileTeam
+	memberships map[string]bool
+
+	added   []string
+	removed []string
+
+	teamMembers map[string]bool
+	leads       map[string]*string
+}
+
+func (r *teamMembershipsRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *teamMembershipsRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *teamMembershipsRepository) WithTx(
+	_ context.Context,
+	fn func(txRepo profiles.Repository) error,
+) error {
+	return fn(r)
+}
+
+func (r *teamMembershipsRepository) GetProfileTeamByID(
+	_ context.Context,
+	id string,
+) (*profiles.ProfileTeam, error) {
+	return r.teams[id], nil
+}
+
+func (r *teamMembershipsRepository) CountProfileMembershipsByIDs(
+	_ context.Context,
+	_ string,
+	membershipIDs []string,
+) (int64, error) {
+	var count int64
+
+	for _, id := range membershipIDs {
+		if r.memberships[id] {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *teamMembershipsRepository) AddTeamToMemberships(
+	_ context.Context,
+	_ string,
+	membershipIDs []string,
+	_ func() string,
+) (int64, error) {
+	r.added = membershipIDs
+
+	return int64(len(membershipIDs)), nil
+}
+
+func (r *teamMembershipsRepository) RemoveTeamFromMemberships(
+	_ context.Context,
+	_ string,
+	membershipIDs []string,
+) (int64, error) {
+	r.removed = membershipIDs
+
+	return int64(len(membershipIDs)), nil
+}
+
+func (r *teamMembershipsRepository) IsMembershipInProfileTeam(
+	_ context.Context,
+	_ string,
+	membershipID string,
+) (bool, error) {
+	return r.teamMembers[membershipID], nil
+}
+
+func (r *teamMembershipsRepository) SetProfileTeamLead(
+	_ context.Context,
+	teamID string,
+	membershipID *string,
+) error {
+	r.leads[teamID] = membershipID
+
+	return nil
+}
+
+func newTeamMembershipsService() (
+	*profiles.Service,
+	*teamMembershipsRepository,
+	*recordingAuditRepository,
+) {
+	repo := &teamMembershipsRepository{ //nolint:exhaustruct
+		teams: map[string]*profiles.ProfileTeam{
+			"team-1":       {ID: "team-1", ProfileID: "target-profile"}, //nolint:exhaustruct
+			"foreign-team": {ID: "foreign-team", ProfileID: "other"},    //nolint:exhaustruct
+		},
+		memberships: map[string]bool{"m-1": true, "m-2": true},
+		teamMembers: map[string]bool{"m-1": true},
+		leads:       map[string]*string{},
+	}
+	auditService, auditRepo := newRecordingAuditService()
+
+	return profiles.NewService(nil, nil, repo, auditService), repo, auditRepo
+}
+
+func TestAddTeamToMemberships(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newTeamMembershipsService()
+
+	affected, err := service.AddTeamToMemberships(
+		t.Context(), "user-1", "target", "team-1", []string{"m-2", "m-1", "m-2"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), affected)
+	assert.Equal(t, []string{"m-1", "m-2"}, repo.added)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileTeamMembersAdded, auditRepo.entries[0].EventType)
+	assert.Equal(t, "team-1", auditRepo.entries[0].EntityID)
+}
+
+func TestRemoveTeamFromMemberships(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newTeamMembershipsService()
+
+	affected, err := service.RemoveTeamFromMemberships(
+		t.Context(), "user-1", "target", "team-1", []string{"m-1"},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), affected)
+	assert.Equal(t, []string{"m-1"}, repo.removed)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileTeamMembersRemoved, auditRepo.entries[0].EventType)
+}
+
+func TestAddTeamToMemberships_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		teamID        string
+		membershipIDs []string
+		expected      error
+	}{
+		"team of another profile": {
+			teamID:        "foreign-team",
+			membershipIDs: []string{"m-1"},
+			expected:      profiles.ErrTeamNotFound,
+		},
+		"unknown team": {
+			teamID:        "missing",
+			membershipIDs: []string{"m-1"},
+			expected:      profiles.ErrTeamNotFound,
+		},
+		"membership of another profile": {
+			teamID:        "team-1",
+			membershipIDs: []string{"m-1", "foreign"},
+			expected:      profiles.ErrInvalidInput,
+		},
+		"no memberships": {
+			teamID:        "team-1",
+			membershipIDs: nil,
+			expected:      profiles.ErrInvalidInput,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newTeamMembershipsService()
+
+			_, err := service.AddTeamToMemberships(
+				t.Context(), "user-1", "target", tt.teamID, tt.membershipIDs,
+			)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Nil(t, repo.added)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
+
+func TestSetTeamLead(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newTeamMembershipsService()
+
+	err := service.SetTeamLead(t.Context(), "user-1", "target", "team-1", "m-1")
+
+	require.NoError(t, err)
+	require.NotNil(t, repo.leads["team-1"])
+	assert.Equal(t, "m-1", *repo.leads["team-1"])
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.ProfileTeamLeadChanged, auditRepo.entries[0].EventType)
+
+	err = service.ClearTeamLead(t.Context(), "user-1", "target", "team-1")
+
+	require.NoError(t, err)
+	assert.Contains(t, repo.leads, "team-1")
+	assert.Nil(t, repo.leads["team-1"])
+	assert.Len(t, auditRepo.entries, 2)
+}
+
+func TestSetTeamLead_Rejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		teamID       string
+		membershipID string
+		expected     error
+	}{
+		"not a team member": {
+			teamID:       "team-1",
+			membershipID: "m-2",
+			expected:     profiles.ErrTeamLeadNotMember,
+		},
+		"team of another profile": {
+			teamID:       "foreign-team",
+			membershipID: "m-1",
+			expected:     profiles.ErrTeamNotFound,
+		},
+		"no membership": {
+			teamID:       "team-1",
+			membershipID: "",
+			expected:     profiles.ErrInvalidInput,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newTeamMembershipsService()
+
+			err := service.SetTeamLead(t.Context(), "user-1", "target", tt.teamID, tt.membershipID)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, repo.leads)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
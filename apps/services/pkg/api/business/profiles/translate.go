@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/eser/aya.is/services/pkg/api/business/events"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
@@ -14,6 +16,7 @@ var (
 	ErrFailedToCheckPermissions      = errors.New("failed to check permissions")
 	ErrFailedToGetSourceContent      = errors.New("failed to get source content")
 	ErrFailedToSaveTranslatedContent = errors.New("failed to save translated content")
+	ErrFailedToRefundPoints          = errors.New("failed to refund points")
 )
 
 // ContentTranslator defines the interface for AI-powered content translation.
@@ -37,34 +40,311 @@ type AutoTranslatePageParams struct {
 	TargetLocale        string
 }
 
+// AutoTranslateAllMissingParams holds the parameters for translating a profile page
+// into every supported locale it has no translation for yet.
+type AutoTranslateAllMissingParams struct {
+	UserID              string
+	UserKind            string
+	IndividualProfileID string
+	ProfileSlug         string
+	PageID              string
+	SourceLocale        string
+}
+
+// LocaleTranslationStatus is the outcome of translating a page into one locale.
+type LocaleTranslationStatus string
+
+const (
+	LocaleTranslationTranslated LocaleTranslationStatus = "translated"
+	LocaleTranslationFailed     LocaleTranslationStatus = "failed"
+	LocaleTranslationSkipped    LocaleTranslationStatus = "skipped"
+)
+
+// Reasons reported for locales that were not translated.
+const (
+	translationReasonLocaleLimit        = "translation locale limit reached"
+	translationReasonCancelled          = "cancelled"
+	translationReasonInsufficientPoints = "insufficient points"
+	translationReasonFailed             = "translation failed"
+)
+
+// LocaleTranslationResult reports what happened to a single target locale.
+type LocaleTranslationResult struct {
+	Reason     *string                 `json:"reason"`
+	LocaleCode string                  `json:"locale_code"`
+	Status     LocaleTranslationStatus `json:"status"`
+}
+
+// pageTranslationSource is the content a page is translated from.
+type pageTranslationSource struct {
+	title   string
+	summary string
+	content string
+}
+
 // AutoTranslateProfilePage orchestrates the full auto-translate workflow for profile pages:
-// check permissions, deduct points, get source content, translate via AI, and save.
-func (s *Service) AutoTranslateProfilePage( //nolint:funlen
+// check permissions, get source content, check its length and the rate limit, deduct points,
+// translate via AI, and save.
+func (s *Service) AutoTranslateProfilePage(
 	ctx context.Context,
 	params AutoTranslatePageParams,
 	translator ContentTranslator,
 	pointsService *profile_points.Service,
 ) error {
-	// Check authorization
-	canEdit, permErr := s.HasUserAccessToProfile(
+	source, err := s.loadPageTranslationSource(
 		ctx,
 		params.UserID,
 		params.ProfileSlug,
+		params.PageID,
+		params.SourceLocale,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Don't charge for a translation that would be rejected when saved
+	err = s.ensurePageTranslationLocaleAllowed(ctx, params.UserKind, params.PageID, params.TargetLocale)
+	if err != nil {
+		return err
+	}
+
+	err = s.allowAIRequest(params.IndividualProfileID)
+	if err != nil {
+		return err
+	}
+
+	return s.translatePageLocale(ctx, params, source, translator, pointsService)
+}
+
+// AutoTranslateAllMissing translates a profile page from the source locale into every
+// supported locale it has no translation for yet, charging points per locale.
+// Locales that fail are refunded and reported; the remaining ones are still tried.
+// Once the translation locale limit or the points run out, the rest are skipped.
+func (s *Service) AutoTranslateAllMissing(
+	ctx context.Context,
+	params AutoTranslateAllMissingParams,
+	translator ContentTranslator,
+	pointsService *profile_points.Service,
+) ([]LocaleTranslationResult, error) {
+	plan, err := s.planAutoTranslateAllMissing(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.runAutoTranslateAllMissing(ctx, params, plan, translator, pointsService), nil
+}
+
+// autoTranslateAllMissingPlan is the checked source and target locales of a bulk
+// translation.
+type autoTranslateAllMissingPlan struct {
+	source  pageTranslationSource
+	missing []string
+	// slots is how many more locales may be added; negative means unlimited.
+	slots int
+}
+
+func (s *Service) planAutoTranslateAllMissing(
+	ctx context.Context,
+	params AutoTranslateAllMissingParams,
+) (*autoTranslateAllMissingPlan, error) {
+	source, err := s.loadPageTranslationSource(
+		ctx,
+		params.UserID,
+		params.ProfileSlug,
+		params.PageID,
+		params.SourceLocale,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.ListProfilePageTxLocales(ctx, params.PageID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(pageID: %s): %w", ErrFailedToListRecords, params.PageID, err)
+	}
+
+	for i, locale := range existing {
+		existing[i] = strings.TrimSpace(locale)
+	}
+
+	slots := -1
+	if params.UserKind != UserKindAdmin {
+		slots = max(s.maxTranslationLocales()-len(existing), 0)
+	}
+
+	return &autoTranslateAllMissingPlan{
+		source:  source,
+		missing: s.MissingTranslationLocales(existing, params.SourceLocale),
+		slots:   slots,
+	}, nil
+}
+
+func (s *Service) runAutoTranslateAllMissing(
+	ctx context.Context,
+	params AutoTranslateAllMissingParams,
+	plan *autoTranslateAllMissingPlan,
+	translator ContentTranslator,
+	pointsService *profile_points.Service,
+) []LocaleTranslationResult {
+	results := make([]LocaleTranslationResult, 0, len(plan.missing))
+	slots := plan.slots
+
+	var skipReason string
+
+	for _, locale := range plan.missing {
+		if skipReason == "" && slots == 0 {
+			skipReason = translationReasonLocaleLimit
+		}
+
+		if skipReason == "" && ctx.Err() != nil {
+			skipReason = translationReasonCancelled
+		}
+
+		if skipReason != "" {
+			results = append(results, skippedLocaleTranslation(locale, skipReason))
+
+			continue
+		}
+
+		result := s.translateMissingLocale(ctx, AutoTranslatePageParams{
+			UserID:              params.UserID,
+			UserKind:            params.UserKind,
+			IndividualProfileID: params.IndividualProfileID,
+			ProfileSlug:         params.ProfileSlug,
+			PageID:              params.PageID,
+			SourceLocale:        params.SourceLocale,
+			TargetLocale:        locale,
+		}, plan.source, translator, pointsService)
+
+		switch {
+		case result.Status == LocaleTranslationTranslated:
+			slots--
+		case *result.Reason == translationReasonInsufficientPoints:
+			skipReason = translationReasonInsufficientPoints
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// translateMissingLocale translates the page into one locale with
+// translatePageLocale and reports the outcome.
+func (s *Service) translateMissingLocale(
+	ctx context.Context,
+	params AutoTranslatePageParams,
+	source pageTranslationSource,
+	translator ContentTranslator,
+	pointsService *profile_points.Service,
+) LocaleTranslationResult {
+	err := s.translatePageLocale(ctx, params, source, translator, pointsService)
+
+	switch {
+	case err == nil:
+		return LocaleTranslationResult{
+			Reason:     nil,
+			LocaleCode: params.TargetLocale,
+			Status:     LocaleTranslationTranslated,
+		}
+	case errors.Is(err, profile_points.ErrInsufficientPoints):
+		return failedLocaleTranslation(params.TargetLocale, translationReasonInsufficientPoints)
+	default:
+		return failedLocaleTranslation(params.TargetLocale, translationReasonFailed)
+	}
+}
+
+func failedLocaleTranslation(locale string, reason string) LocaleTranslationResult {
+	return LocaleTranslationResult{
+		Reason:     &reason,
+		LocaleCode: locale,
+		Status:     LocaleTranslationFailed,
+	}
+}
+
+func skippedLocaleTranslation(locale string, reason string) LocaleTranslationResult {
+	return LocaleTranslationResult{
+		Reason:     &reason,
+		LocaleCode: locale,
+		Status:     LocaleTranslationSkipped,
+	}
+}
+
+// MissingTranslationLocales returns the supported locales, sorted, that are neither
+// among the existing ones nor the source locale.
+func (s *Service) MissingTranslationLocales(existing []string, sourceLocale string) []string {
+	supportedLocales := s.SupportedLocaleCodes()
+	missing := make([]string, 0, len(supportedLocales))
+
+	for locale := range supportedLocales {
+		if locale == sourceLocale || slices.Contains(existing, locale) {
+			continue
+		}
+
+		missing = append(missing, locale)
+	}
+
+	slices.Sort(missing)
+
+	return missing
+}
+
+// loadPageTranslationSource checks that the user can edit the profile and returns
+// the page content in the source locale, rejecting content too long for the model.
+func (s *Service) loadPageTranslationSource(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	pageID string,
+	sourceLocale string,
+) (pageTranslationSource, error) {
+	canEdit, permErr := s.HasUserAccessToProfile(
+		ctx,
+		userID,
+		profileSlug,
 		MembershipKindMaintainer,
 	)
 	if permErr != nil {
-		return fmt.Errorf("%w: %w", ErrFailedToCheckPermissions, permErr)
+		return pageTranslationSource{}, fmt.Errorf("%w: %w", ErrFailedToCheckPermissions, permErr)
 	}
 
 	if !canEdit {
-		return fmt.Errorf(
+		return pageTranslationSource{}, fmt.Errorf(
 			"%w: user %s cannot edit profile %s",
 			ErrUnauthorized,
-			params.UserID,
-			params.ProfileSlug,
+			userID,
+			profileSlug,
 		)
 	}
 
+	title, summary, content, err := s.GetProfilePageTranslationContent(
+		ctx,
+		profileSlug,
+		pageID,
+		sourceLocale,
+	)
+	if err != nil {
+		return pageTranslationSource{}, fmt.Errorf("%w: %w", ErrFailedToGetSourceContent, err)
+	}
+
+	// Reject content the model can't handle before charging for it
+	err = checkAIInputLength(s.aiMaxInputChars(), title, summary, content)
+	if err != nil {
+		return pageTranslationSource{}, err
+	}
+
+	return pageTranslationSource{title: title, summary: summary, content: content}, nil
+}
+
+// translatePageLocale deducts points, translates the source into the target locale
+// and saves it. The points are refunded when translating or saving fails.
+func (s *Service) translatePageLocale(
+	ctx context.Context,
+	params AutoTranslatePageParams,
+	source pageTranslationSource,
+	translator ContentTranslator,
+	pointsService *profile_points.Service,
+) error {
 	// Deduct points for auto-translation
 	eventAutoTranslate := profile_points.EventAutoTranslate
 
@@ -79,28 +359,17 @@ func (s *Service) AutoTranslateProfilePage( //nolint:funlen
 		return spendErr //nolint:wrapcheck
 	}
 
-	// Get source content
-	title, summary, content, err := s.GetProfilePageTranslationContent(
-		ctx,
-		params.ProfileSlug,
-		params.PageID,
-		params.SourceLocale,
-	)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrFailedToGetSourceContent, err)
-	}
-
 	// Translate via AI
 	translatedTitle, translatedSummary, translatedContent, err := translator.Translate(
 		ctx,
 		params.SourceLocale,
 		params.TargetLocale,
-		title,
-		summary,
-		content,
+		source.title,
+		source.summary,
+		source.content,
 	)
 	if err != nil {
-		return err //nolint:wrapcheck
+		return errors.Join(err, refundAutoTranslate(ctx, params, pointsService))
 	}
 
 	// Save translated content
@@ -116,7 +385,10 @@ func (s *Service) AutoTranslateProfilePage( //nolint:funlen
 		translatedContent,
 	)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrFailedToSaveTranslatedContent, err)
+		return errors.Join(
+			fmt.Errorf("%w: %w", ErrFailedToSaveTranslatedContent, err),
+			refundAutoTranslate(ctx, params, pointsService),
+		)
 	}
 
 	s.auditService.Record(ctx, events.AuditParams{
@@ -134,3 +406,26 @@ func (s *Service) AutoTranslateProfilePage( //nolint:funlen
 
 	return nil
 }
+
+// refundAutoTranslate gives back the points spent on a failed auto-translation.
+// It runs even when the request context has been cancelled.
+func refundAutoTranslate(
+	ctx context.Context,
+	params AutoTranslatePageParams,
+	pointsService *profile_points.Service,
+) error {
+	eventRefund := profile_points.EventAutoTranslateRefund
+
+	_, err := pointsService.GainPoints(context.WithoutCancel(ctx), profile_points.GainParams{
+		ActorID:         params.UserID,
+		TargetProfileID: params.IndividualProfileID,
+		Amount:          profile_points.CostAutoTranslate,
+		TriggeringEvent: &eventRefund,
+		Description:     "Refund for failed auto-translation to " + params.TargetLocale,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToRefundPoints, err)
+	}
+
+	return nil
+}
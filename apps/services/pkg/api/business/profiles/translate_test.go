@@ -0,0 +1,536 @@
+package profiles_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const translateCapChars = 100
+
+type translatePageRepository struct {
+	profiles.Repository
+
+	content string
+}
+
+func (r *translatePageRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "profile-1", nil
+}
+
+func (r *translatePageRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *translatePageRepository) GetProfilePageByProfileIDAndSlug(
+	_ context.Context,
+	localeCode string,
+	_ string,
+	_ string,
+) (*profiles.ProfilePage, error) {
+	return &profiles.ProfilePage{ //nolint:exhaustruct
+		ID:         "page-1",
+		LocaleCode: localeCode,
+		Title:      "Title",
+		Summary:    "Summary",
+		Content:    r.content,
+	}, nil
+}
+
+func (r *translatePageRepository) ListProfilePageTxLocales(
+	_ context.Context,
+	_ string,
+) ([]string, error) {
+	return []string{"en"}, nil
+}
+
+// emptyBalanceRepository has no points, so reaching it ends the workflow with
+// ErrInsufficientPoints before any model call.
+type emptyBalanceRepository struct {
+	profile_points.Repository
+
+	balanceChecks int
+}
+
+func (r *emptyBalanceRepository) GetBalance(_ context.Context, _ string) (uint64, error) {
+	r.balanceChecks++
+
+	return 0, nil
+}
+
+func autoTranslatePageWithContent(
+	t *testing.T,
+	content string,
+) (*emptyBalanceRepository, error) {
+	t.Helper()
+
+	config := &profiles.Config{AIMaxInputChars: translateCapChars} //nolint:exhaustruct
+	service := profiles.NewService(nil, config, &translatePageRepository{content: content}, nil)
+	pointsRepo := &emptyBalanceRepository{}                                                         //nolint:exhaustruct
+	pointsService := profile_points.NewService(nil, &profile_points.Config{}, pointsRepo, nil, nil) //nolint:exhaustruct
+
+	err := service.AutoTranslateProfilePage(
+		t.Context(),
+		profiles.AutoTranslatePageParams{ //nolint:exhaustruct
+			UserID:              "user-1",
+			IndividualProfileID: "profile-2",
+			ProfileSlug:         "eser",
+			PageID:              "page-1",
+			SourceLocale:        "en",
+			TargetLocale:        "tr",
+		},
+		nil,
+		pointsService,
+	)
+
+	return pointsRepo, err
+}
+
+func TestAutoTranslateProfilePage_ContentJustUnderCap(t *testing.T) {
+	t.Parallel()
+
+	// title (5) + summary (7) + content fills the cap exactly
+	content := strings.Repeat("ş", translateCapChars-len("Title")-len("Summary"))
+
+	pointsRepo, err := autoTranslatePageWithContent(t, content)
+
+	require.ErrorIs(t, err, profile_points.ErrInsufficientPoints)
+	assert.Equal(t, 1, pointsRepo.balanceChecks)
+}
+
+func TestAutoTranslateProfilePage_ContentOverCap(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Repeat("ş", translateCapChars-len("Title")-len("Summary")+1)
+
+	pointsRepo, err := autoTranslatePageWithContent(t, content)
+
+	require.ErrorIs(t, err, profiles.ErrContentTooLong)
+	assert.Zero(t, pointsRepo.balanceChecks)
+}
+
+// bulkTranslateRepository serves the calls made while translating a page into
+// every missing locale, and keeps the translation jobs. Saving fails for the
+// locales in failSave.
+type bulkTranslateRepository struct {
+	translatePageRepository
+
+	jobs     map[string]profiles.TranslationJob
+	existing []string
+	failSave map[string]bool
+	saved    []string
+}
+
+func (r *bulkTranslateRepository) ListProfilePageTxLocales(
+	_ context.Context,
+	_ string,
+) ([]string, error) {
+	return append(append([]string(nil), r.existing...), r.saved...), nil
+}
+
+func (r *bulkTranslateRepository) InsertProfilePageTranslationJob(
+	_ context.Context,
+	job *profiles.TranslationJob,
+) error {
+	if r.jobs == nil {
+		r.jobs = make(map[string]profiles.TranslationJob)
+	}
+
+	r.jobs[job.ID] = *job
+
+	return nil
+}
+
+func (r *bulkTranslateRepository) GetProfilePageTranslationJob(
+	_ context.Context,
+	id string,
+) (*profiles.TranslationJob, error) {
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	job.Results = slices.Clone(job.Results)
+	if len(job.Results) == job.Total {
+		job.Status = profiles.TranslationJobCompleted
+	}
+
+	return &job, nil
+}
+
+func (r *bulkTranslateRepository) InsertProfilePageTranslationJobResult(
+	_ context.Context,
+	jobID string,
+	result profiles.LocaleTranslationResult,
+) (bool, error) {
+	job := r.jobs[jobID]
+
+	for _, existing := range job.Results {
+		if existing.LocaleCode == result.LocaleCode {
+			return false, nil
+		}
+	}
+
+	job.Results = append(job.Results, result)
+	r.jobs[jobID] = job
+
+	return true, nil
+}
+
+func (r *bulkTranslateRepository) GetProfilePage(
+	_ context.Context,
+	id string,
+) (*profiles.ProfilePage, error) {
+	return &profiles.ProfilePage{ID: id}, nil //nolint:exhaustruct
+}
+
+func (r *bulkTranslateRepository) UpsertProfilePageTx(
+	_ context.Context,
+	_ string,
+	localeCode string,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	if r.failSave[localeCode] {
+		return errSaveFailed
+	}
+
+	r.saved = append(r.saved, localeCode)
+
+	return nil
+}
+
+// ledgerRepository keeps a points balance and the transactions recorded against it.
+type ledgerRepository struct {
+	profile_points.Repository
+
+	transactions []profile_points.Transaction
+	balance      uint64
+}
+
+func (r *ledgerRepository) GetBalance(_ context.Context, _ string) (uint64, error) {
+	return r.balance, nil
+}
+
+func (r *ledgerRepository) RecordTransaction(
+	_ context.Context,
+	id string,
+	targetProfileID string,
+	_ *string,
+	transactionType profile_points.TransactionType,
+	triggeringEvent *string,
+	description string,
+	amount uint64,
+) (*profile_points.Transaction, error) {
+	if transactionType == profile_points.TransactionTypeSpend {
+		r.balance -= amount
+	} else {
+		r.balance += amount
+	}
+
+	transaction := profile_points.Transaction{ //nolint:exhaustruct
+		ID:              id,
+		TargetProfileID: targetProfileID,
+		TransactionType: transactionType,
+		TriggeringEvent: triggeringEvent,
+		Description:     description,
+		Amount:          amount,
+		BalanceAfter:    r.balance,
+	}
+	r.transactions = append(r.transactions, transaction)
+
+	return &transaction, nil
+}
+
+// stubTranslator prefixes content with the target locale, failing for the
+// locales in fail.
+type stubTranslator struct {
+	fail map[string]bool
+}
+
+func (t *stubTranslator) Translate(
+	_ context.Context,
+	_ string,
+	targetLocale string,
+	title string,
+	summary string,
+	content string,
+) (string, string, string, error) {
+	if t.fail[targetLocale] {
+		return "", "", "", errTranslateFailed
+	}
+
+	return targetLocale + title, targetLocale + summary, targetLocale + content, nil
+}
+
+var (
+	errSaveFailed      = errors.New("save failed")
+	errTranslateFailed = errors.New("translate failed")
+)
+
+func newBulkTranslateService(
+	balance uint64,
+	failSave map[string]bool,
+) (*profiles.Service, *profile_points.Service, *bulkTranslateRepository, *ledgerRepository) {
+	repo := &bulkTranslateRepository{ //nolint:exhaustruct
+		translatePageRepository: translatePageRepository{content: "Content"}, //nolint:exhaustruct
+		existing:                []string{"en", "tr"},
+		failSave:                failSave,
+	}
+	auditService, _ := newRecordingAuditService()
+	ledger := &ledgerRepository{balance: balance} //nolint:exhaustruct
+	pointsService := profile_points.NewService(
+		nil,
+		&profile_points.Config{}, //nolint:exhaustruct
+		ledger,
+		func() string { return "transaction" },
+		auditService,
+	)
+
+	return profiles.NewService(nil, nil, repo, auditService), pointsService, repo, ledger
+}
+
+func bulkTranslateParams() profiles.AutoTranslateAllMissingParams {
+	return profiles.AutoTranslateAllMissingParams{ //nolint:exhaustruct
+		UserID:              "user-1",
+		UserKind:            profiles.UserKindAdmin,
+		IndividualProfileID: "profile-2",
+		ProfileSlug:         "eser",
+		PageID:              "page-1",
+		SourceLocale:        "en",
+	}
+}
+
+func TestAutoTranslateAllMissing(t *testing.T) {
+	t.Parallel()
+
+	service, pointsService, repo, ledger := newBulkTranslateService(
+		100,
+		map[string]bool{"ja": true},
+	)
+	translator := &stubTranslator{fail: map[string]bool{"de": true}}
+
+	results, err := service.AutoTranslateAllMissing(
+		t.Context(), bulkTranslateParams(), translator, pointsService,
+	)
+
+	require.NoError(t, err)
+
+	missing := service.MissingTranslationLocales([]string{"en", "tr"}, "en")
+	require.Len(t, results, len(missing))
+	assert.NotContains(t, missing, "tr")
+
+	statuses := make(map[string]profiles.LocaleTranslationStatus, len(results))
+	for i, result := range results {
+		assert.Equal(t, missing[i], result.LocaleCode)
+		statuses[result.LocaleCode] = result.Status
+	}
+
+	assert.Equal(t, profiles.LocaleTranslationFailed, statuses["de"])
+	assert.Equal(t, profiles.LocaleTranslationFailed, statuses["ja"])
+	assert.Equal(t, profiles.LocaleTranslationTranslated, statuses["fr"])
+	assert.Len(t, repo.saved, len(missing)-2)
+	assert.NotContains(t, repo.saved, "de")
+
+	// Every locale was charged and the two failures were refunded.
+	refunds := 0
+
+	for _, transaction := range ledger.transactions {
+		if transaction.TransactionType == profile_points.TransactionTypeGain {
+			refunds++
+
+			assert.Equal(t, profile_points.EventAutoTranslateRefund, *transaction.TriggeringEvent)
+		}
+	}
+
+	assert.Equal(t, 2, refunds)
+	assert.Len(t, ledger.transactions, len(missing)+2)
+	assert.Equal(t, 100-uint64(len(missing)-2)*profile_points.CostAutoTranslate, ledger.balance)
+}
+
+func TestAutoTranslateAllMissing_RunsOutOfPoints(t *testing.T) {
+	t.Parallel()
+
+	service, pointsService, repo, ledger := newBulkTranslateService(
+		2*profile_points.CostAutoTranslate,
+		nil,
+	)
+
+	results, err := service.AutoTranslateAllMissing(
+		t.Context(), bulkTranslateParams(), &stubTranslator{}, pointsService, //nolint:exhaustruct
+	)
+
+	require.NoError(t, err)
+	require.Greater(t, len(results), 3)
+	assert.Equal(t, profiles.LocaleTranslationTranslated, results[0].Status)
+	assert.Equal(t, profiles.LocaleTranslationTranslated, results[1].Status)
+	assert.Equal(t, profiles.LocaleTranslationFailed, results[2].Status)
+
+	for _, result := range results[3:] {
+		assert.Equal(t, profiles.LocaleTranslationSkipped, result.Status)
+	}
+
+	assert.Len(t, repo.saved, 2)
+	assert.Zero(t, ledger.balance)
+}
+
+// memoryQueueRepository keeps queued items in memory.
+type memoryQueueRepository struct {
+	events.QueueRepository
+
+	items []*events.QueueItem
+}
+
+func (r *memoryQueueRepository) Enqueue(
+	_ context.Context,
+	id string,
+	itemType events.QueueItemType,
+	payload map[string]any,
+	_ int,
+	_ int,
+	_ time.Time,
+) error {
+	r.items = append(r.items, &events.QueueItem{ //nolint:exhaustruct
+		ID:      id,
+		Type:    itemType,
+		Payload: payload,
+	})
+
+	return nil
+}
+
+// queuedTranslationTasks decodes the PAGE_AUTO_TRANSLATE items in the queue.
+func queuedTranslationTasks(t *testing.T, queueRepo *memoryQueueRepository) []profiles.PageTranslationTask {
+	t.Helper()
+
+	tasks := make([]profiles.PageTranslationTask, 0, len(queueRepo.items))
+
+	for _, item := range queueRepo.items {
+		require.Equal(t, events.QueueItemTypePageAutoTranslate, item.Type)
+
+		payload, err := json.Marshal(item.Payload)
+		require.NoError(t, err)
+
+		var task profiles.PageTranslationTask
+
+		require.NoError(t, json.Unmarshal(payload, &task))
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks
+}
+
+func TestStartAutoTranslateAllMissing(t *testing.T) {
+	t.Parallel()
+
+	service, pointsService, _, ledger := newBulkTranslateService(100, nil)
+	queueRepo := &memoryQueueRepository{} //nolint:exhaustruct
+	service.SetQueueService(events.NewQueueService(nil, queueRepo, func() string { return "item" }))
+
+	job, err := service.StartAutoTranslateAllMissing(t.Context(), bulkTranslateParams())
+
+	require.NoError(t, err)
+	assert.Equal(t, "page-1", job.PageID)
+
+	missing := service.MissingTranslationLocales([]string{"en", "tr"}, "en")
+	tasks := queuedTranslationTasks(t, queueRepo)
+	require.Len(t, tasks, len(missing))
+
+	current, err := service.GetTranslationJob(t.Context(), "user-1", job.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, profiles.TranslationJobRunning, current.Status)
+	assert.Empty(t, current.Results)
+
+	_, err = service.GetTranslationJob(t.Context(), "user-2", job.ID)
+
+	require.ErrorIs(t, err, profiles.ErrTranslationJobNotFound)
+
+	translator := &stubTranslator{fail: map[string]bool{"de": true}}
+
+	for _, task := range tasks {
+		assert.Equal(t, job.ID, task.JobID)
+		require.NoError(t, service.TranslateJobLocale(t.Context(), task, translator, pointsService))
+	}
+
+	current, err = service.GetTranslationJob(t.Context(), "user-1", job.ID)
+
+	require.NoError(t, err)
+	assert.Equal(t, profiles.TranslationJobCompleted, current.Status)
+	require.Len(t, current.Results, len(missing))
+
+	for _, result := range current.Results {
+		expected := profiles.LocaleTranslationTranslated
+		if result.LocaleCode == "de" {
+			expected = profiles.LocaleTranslationFailed
+		}
+
+		assert.Equal(t, expected, result.Status, result.LocaleCode)
+	}
+
+	// A redelivered item keeps its recorded result and is not charged again.
+	transactions := len(ledger.transactions)
+
+	require.NoError(t, service.TranslateJobLocale(t.Context(), tasks[0], translator, pointsService))
+	assert.Len(t, ledger.transactions, transactions)
+}
+
+func TestTranslateJobLocale_SkipsAfterRunningOutOfPoints(t *testing.T) {
+	t.Parallel()
+
+	service, pointsService, repo, ledger := newBulkTranslateService(
+		2*profile_points.CostAutoTranslate,
+		nil,
+	)
+	queueRepo := &memoryQueueRepository{} //nolint:exhaustruct
+	service.SetQueueService(events.NewQueueService(nil, queueRepo, func() string { return "item" }))
+
+	job, err := service.StartAutoTranslateAllMissing(t.Context(), bulkTranslateParams())
+	require.NoError(t, err)
+
+	tasks := queuedTranslationTasks(t, queueRepo)
+	require.Greater(t, len(tasks), 3)
+
+	for _, task := range tasks {
+		require.NoError(t, service.TranslateJobLocale(t.Context(), task, &stubTranslator{}, pointsService)) //nolint:exhaustruct
+	}
+
+	current, err := service.GetTranslationJob(t.Context(), "user-1", job.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, profiles.LocaleTranslationTranslated, current.Results[0].Status)
+	assert.Equal(t, profiles.LocaleTranslationTranslated, current.Results[1].Status)
+	assert.Equal(t, profiles.LocaleTranslationFailed, current.Results[2].Status)
+
+	for _, result := range current.Results[3:] {
+		assert.Equal(t, profiles.LocaleTranslationSkipped, result.Status)
+	}
+
+	assert.Len(t, repo.saved, 2)
+	assert.Zero(t, ledger.balance)
+}
+
+func TestStartAutoTranslateAllMissing_RequiresQueue(t *testing.T) {
+	t.Parallel()
+
+	service, _, _, _ := newBulkTranslateService(100, nil)
+
+	_, err := service.StartAutoTranslateAllMissing(t.Context(), bulkTranslateParams())
+
+	require.ErrorIs(t, err, profiles.ErrTranslationQueueNotSet)
+}
@@ -0,0 +1,806 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+var ErrInvalidTranslationFile = errors.New("invalid translation file")
+
+// Translation unit fields. A unit ID is "profile.<field>" for the profile
+// itself and "page.<id>.<field>" or "link.<id>.<field>" for its pages and links.
+const (
+	translationEntityProfile = "profile"
+	translationEntityPage    = "page"
+	translationEntityLink    = "link"
+
+	translationFieldTitle       = "title"
+	translationFieldDescription = "description"
+	translationFieldSummary     = "summary"
+	translationFieldContent     = "content"
+	translationFieldGroup       = "group"
+)
+
+// TranslationUnit is one translatable string of a profile, its source text and
+// its translation (empty when not translated yet).
+type TranslationUnit struct {
+	ID     string `json:"id"`
+	Note   string `json:"note,omitempty"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+}
+
+// TranslationBundle holds every translatable string of a profile for a
+// source/target locale pair. It is what translators receive and send back.
+type TranslationBundle struct {
+	ProfileSlug  string             `json:"profile_slug"`
+	SourceLocale string             `json:"source_locale"`
+	TargetLocale string             `json:"target_locale"`
+	Units        []*TranslationUnit `json:"units"`
+}
+
+// TranslationUnitStatus is the outcome of importing a single string.
+type TranslationUnitStatus string
+
+const (
+	TranslationUnitApplied TranslationUnitStatus = "applied"
+	TranslationUnitSkipped TranslationUnitStatus = "skipped"
+	TranslationUnitFailed  TranslationUnitStatus = "failed"
+)
+
+// TranslationUnitResult reports what happened to one imported string.
+type TranslationUnitResult struct {
+	Reason *string               `json:"reason"`
+	ID     string                `json:"id"`
+	Status TranslationUnitStatus `json:"status"`
+}
+
+// TranslationImportResult summarizes an import, string by string.
+type TranslationImportResult struct {
+	TargetLocale string                   `json:"target_locale"`
+	Units        []*TranslationUnitResult `json:"units"`
+	Applied      int                      `json:"applied"`
+	Skipped      int                      `json:"skipped"`
+	Failed       int                      `json:"failed"`
+}
+
+// translationUnitKey is a parsed unit ID.
+type translationUnitKey struct {
+	entity   string
+	entityID string
+	field    string
+}
+
+// translationExchangeState is the profile, page and link content of a profile
+// in the source and target locales, keyed by page and link ID.
+type translationExchangeState struct {
+	sourceProfile *ProfileTx
+	targetProfile *ProfileTx
+	sourcePages   map[string]*ProfilePageTx
+	targetPages   map[string]*ProfilePageTx
+	sourceLinks   map[string]*ProfileLinkTx
+	targetLinks   map[string]*ProfileLinkTx
+	pageOrder     []*ProfilePageTx
+	linkOrder     []*ProfileLinkTx
+}
+
+// ExportTranslations returns every translatable string of a profile, its pages
+// and its links in the source locale, along with the existing translations in
+// the target locale. An empty source locale stands for the profile's default
+// locale. Maintainer access is required.
+func (s *Service) ExportTranslations(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+	sourceLocale string,
+	targetLocale string,
+) (*TranslationBundle, error) {
+	profileID, err := s.getProfileIDForTranslationExchange(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceLocale == "" {
+		sourceLocale, err = s.repo.GetProfileDefaultLocale(ctx, profileID)
+		if err != nil {
+			return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
+		}
+	}
+
+	err = s.validateTranslationLocalePair(sourceLocale, targetLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.loadTranslationExchangeState(ctx, profileID, sourceLocale, targetLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &TranslationBundle{
+		ProfileSlug:  profileSlug,
+		SourceLocale: sourceLocale,
+		TargetLocale: targetLocale,
+		Units:        []*TranslationUnit{},
+	}
+
+	addUnit := func(key translationUnitKey, note string, source string, target string) {
+		if strings.TrimSpace(source) == "" {
+			return
+		}
+
+		bundle.Units = append(bundle.Units, &TranslationUnit{
+			ID:     key.String(),
+			Note:   note,
+			Source: source,
+			Target: target,
+		})
+	}
+
+	if state.sourceProfile != nil {
+		target := state.targetProfile
+		if target == nil {
+			target = &ProfileTx{} //nolint:exhaustruct
+		}
+
+		addUnit(profileUnitKey(translationFieldTitle), "", state.sourceProfile.Title, target.Title)
+		addUnit(
+			profileUnitKey(translationFieldDescription),
+			"",
+			state.sourceProfile.Description,
+			target.Description,
+		)
+	}
+
+	for _, page := range state.pageOrder {
+		target := state.targetPages[page.ProfilePageID]
+		if target == nil {
+			target = &ProfilePageTx{} //nolint:exhaustruct
+		}
+
+		note := "page /" + page.Slug
+		addUnit(pageUnitKey(page.ProfilePageID, translationFieldTitle), note, page.Title, target.Title)
+		addUnit(
+			pageUnitKey(page.ProfilePageID, translationFieldSummary),
+			note,
+			page.Summary,
+			target.Summary,
+		)
+		addUnit(
+			pageUnitKey(page.ProfilePageID, translationFieldContent),
+			note,
+			page.Content,
+			target.Content,
+		)
+	}
+
+	for _, link := range state.linkOrder {
+		target := state.targetLinks[link.ProfileLinkID]
+		if target == nil {
+			target = &ProfileLinkTx{} //nolint:exhaustruct
+		}
+
+		addUnit(linkUnitKey(link.ProfileLinkID, translationFieldTitle), "", link.Title, target.Title)
+		addUnit(
+			linkUnitKey(link.ProfileLinkID, translationFieldDescription),
+			"",
+			derefString(link.Description),
+			derefString(target.Description),
+		)
+		addUnit(
+			linkUnitKey(link.ProfileLinkID, translationFieldGroup),
+			"",
+			derefString(link.Group),
+			derefString(target.Group),
+		)
+	}
+
+	return bundle, nil
+}
+
+// ImportTranslations applies a translated bundle to the target locale of a
+// profile. The bundle structure is validated as a whole; after that each
+// string is applied, skipped (empty or unchanged) or failed on its own and
+// reported in the result. Maintainer access is required.
+func (s *Service) ImportTranslations( //nolint:cyclop,funlen
+	ctx context.Context,
+	userID string,
+	userKind string,
+	profileSlug string,
+	bundle *TranslationBundle,
+) (*TranslationImportResult, error) {
+	keys, err := s.validateTranslationBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	profileID, err := s.getProfileIDForTranslationExchange(ctx, userID, profileSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.loadTranslationExchangeState(
+		ctx,
+		profileID,
+		bundle.SourceLocale,
+		bundle.TargetLocale,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TranslationImportResult{ //nolint:exhaustruct
+		TargetLocale: bundle.TargetLocale,
+		Units:        make([]*TranslationUnitResult, len(bundle.Units)),
+	}
+
+	// Group the units by the profile, page or link they belong to, keeping the
+	// order in which each entity first appears in the file.
+	groups := map[string][]int{}
+	groupOrder := []string{}
+
+	for i, unit := range bundle.Units {
+		result.Units[i] = &TranslationUnitResult{Reason: nil, ID: unit.ID, Status: TranslationUnitApplied}
+
+		entityKey := keys[i].entity + "." + keys[i].entityID
+		if _, ok := groups[entityKey]; !ok {
+			groupOrder = append(groupOrder, entityKey)
+		}
+
+		groups[entityKey] = append(groups[entityKey], i)
+	}
+
+	for _, entityKey := range groupOrder {
+		indexes := groups[entityKey]
+		key := keys[indexes[0]]
+
+		var applyErr error
+
+		switch key.entity {
+		case translationEntityProfile:
+			applyErr = s.importProfileTranslation(
+				ctx, userKind, profileID, bundle, state, keys, indexes, result,
+			)
+		case translationEntityPage:
+			applyErr = s.importPageTranslation(
+				ctx, userID, userKind, bundle, state, key.entityID, keys, indexes, result,
+			)
+		case translationEntityLink:
+			applyErr = s.importLinkTranslation(
+				ctx, bundle, state, key.entityID, keys, indexes, result,
+			)
+		}
+
+		if applyErr != nil {
+			markTranslationUnits(result, indexes, TranslationUnitApplied, TranslationUnitFailed,
+				translationImportFailureReason(applyErr))
+		}
+	}
+
+	for _, unit := range result.Units {
+		switch unit.Status {
+		case TranslationUnitApplied:
+			result.Applied++
+		case TranslationUnitSkipped:
+			result.Skipped++
+		case TranslationUnitFailed:
+			result.Failed++
+		}
+	}
+
+	if result.Applied > 0 {
+		s.auditService.Record(ctx, events.AuditParams{
+			EventType:  events.ProfileTranslationsImported,
+			EntityType: "profile",
+			EntityID:   profileID,
+			ActorID:    &userID,
+			ActorKind:  events.ActorUser,
+			SessionID:  nil,
+			Payload: map[string]any{
+				"source_locale": bundle.SourceLocale,
+				"target_locale": bundle.TargetLocale,
+				"applied":       result.Applied,
+				"skipped":       result.Skipped,
+				"failed":        result.Failed,
+			},
+		})
+	}
+
+	return result, nil
+}
+
+// importProfileTranslation applies the profile title and description units.
+func (s *Service) importProfileTranslation(
+	ctx context.Context,
+	userKind string,
+	profileID string,
+	bundle *TranslationBundle,
+	state *translationExchangeState,
+	keys []translationUnitKey,
+	indexes []int,
+	result *TranslationImportResult,
+) error {
+	current := state.targetProfile
+	if current == nil {
+		current = state.sourceProfile
+	}
+
+	if current == nil {
+		return fmt.Errorf("%w: profile has no %s translation", ErrInvalidInput, bundle.SourceLocale)
+	}
+
+	updated := *current
+	if state.targetProfile == nil {
+		updated.Title, updated.Description = "", ""
+	}
+
+	changed := applyTranslationUnits(bundle, keys, indexes, result, func(field string, value string) bool {
+		switch field {
+		case translationFieldTitle:
+			return setTranslationField(&updated.Title, value)
+		case translationFieldDescription:
+			return setTranslationField(&updated.Description, value)
+		}
+
+		return false
+	})
+	if !changed {
+		return nil
+	}
+
+	if strings.TrimSpace(updated.Title) == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidInput)
+	}
+
+	err := s.ensureProfileTranslationLocaleAllowed(ctx, userKind, profileID, bundle.TargetLocale)
+	if err != nil {
+		return err
+	}
+
+	properties, _ := updated.Properties.(map[string]any)
+
+	err = s.repo.UpsertProfileTx(
+		ctx,
+		profileID,
+		bundle.TargetLocale,
+		updated.Title,
+		updated.Description,
+		properties,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"%w(profileID: %s, locale: %s): %w",
+			ErrFailedToUpdateRecord,
+			profileID,
+			bundle.TargetLocale,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// importPageTranslation applies the title, summary and content units of a page.
+func (s *Service) importPageTranslation(
+	ctx context.Context,
+	userID string,
+	userKind string,
+	bundle *TranslationBundle,
+	state *translationExchangeState,
+	pageID string,
+	keys []translationUnitKey,
+	indexes []int,
+	result *TranslationImportResult,
+) error {
+	current := state.targetPages[pageID]
+	if current == nil {
+		current = state.sourcePages[pageID]
+	}
+
+	if current == nil {
+		return fmt.Errorf("%w: unknown page %s", ErrInvalidInput, pageID)
+	}
+
+	updated := *current
+	if state.targetPages[pageID] == nil {
+		updated.Title, updated.Summary, updated.Content = "", "", ""
+	}
+
+	changed := applyTranslationUnits(bundle, keys, indexes, result, func(field string, value string) bool {
+		switch field {
+		case translationFieldTitle:
+			return setTranslationField(&updated.Title, value)
+		case translationFieldSummary:
+			return setTranslationField(&updated.Summary, value)
+		case translationFieldContent:
+			return setTranslationField(&updated.Content, value)
+		}
+
+		return false
+	})
+	if !changed {
+		return nil
+	}
+
+	if strings.TrimSpace(updated.Title) == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidInput)
+	}
+
+	err := s.validatePageContentLength(updated.Content)
+	if err != nil {
+		return err
+	}
+
+	err = s.ensurePageTranslationLocaleAllowed(ctx, userKind, pageID, bundle.TargetLocale)
+	if err != nil {
+		return err
+	}
+
+	err = s.repo.UpsertProfilePageTx(
+		ctx,
+		pageID,
+		bundle.TargetLocale,
+		updated.Title,
+		updated.Summary,
+		updated.Content,
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"%w(pageID: %s, locale: %s): %w",
+			ErrFailedToUpdateRecord,
+			pageID,
+			bundle.TargetLocale,
+			err,
+		)
+	}
+
+	s.syncPageMentions(ctx, userID, pageID, bundle.TargetLocale, updated.Content)
+
+	return nil
+}
+
+// importLinkTranslation applies the title, description and group units of a
+// link. The icon is kept from the existing translation, or the source one.
+func (s *Service) importLinkTranslation(
+	ctx context.Context,
+	bundle *TranslationBundle,
+	state *translationExchangeState,
+	linkID string,
+	keys []translationUnitKey,
+	indexes []int,
+	result *TranslationImportResult,
+) error {
+	current := state.targetLinks[linkID]
+	if current == nil {
+		current = state.sourceLinks[linkID]
+	}
+
+	if current == nil {
+		return fmt.Errorf("%w: unknown link %s", ErrInvalidInput, linkID)
+	}
+
+	updated := *current
+	if state.targetLinks[linkID] == nil {
+		updated.Title, updated.Description, updated.Group = "", nil, nil
+	}
+
+	description := derefString(updated.Description)
+	group := derefString(updated.Group)
+
+	changed := applyTranslationUnits(bundle, keys, indexes, result, func(field string, value string) bool {
+		switch field {
+		case translationFieldTitle:
+			return setTranslationField(&updated.Title, value)
+		case translationFieldDescription:
+			return setTranslationField(&description, value)
+		case translationFieldGroup:
+			return setTranslationField(&group, value)
+		}
+
+		return false
+	})
+	if !changed {
+		return nil
+	}
+
+	if strings.TrimSpace(updated.Title) == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalidInput)
+	}
+
+	err := s.repo.UpsertProfileLinkTx(
+		ctx,
+		linkID,
+		bundle.TargetLocale,
+		updated.Title,
+		updated.Icon,
+		optionalString(group),
+		optionalString(description),
+	)
+	if err != nil {
+		return fmt.Errorf(
+			"%w(linkID: %s, locale: %s): %w",
+			ErrFailedToUpdateRecord,
+			linkID,
+			bundle.TargetLocale,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// applyTranslationUnits sets the target text of each unit through set, which
+// reports whether the value changed. Units left empty or unchanged are marked
+// as skipped. Returns whether anything changed.
+func applyTranslationUnits(
+	bundle *TranslationBundle,
+	keys []translationUnitKey,
+	indexes []int,
+	result *TranslationImportResult,
+	set func(field string, value string) bool,
+) bool {
+	changed := false
+
+	for _, i := range indexes {
+		target := bundle.Units[i].Target
+
+		var reason string
+
+		switch {
+		case strings.TrimSpace(target) == "":
+			reason = "not translated"
+		case !set(keys[i].field, target):
+			reason = "unchanged"
+		default:
+			changed = true
+
+			continue
+		}
+
+		result.Units[i].Status = TranslationUnitSkipped
+		result.Units[i].Reason = &reason
+	}
+
+	return changed
+}
+
+// markTranslationUnits moves the units in the given status to another status.
+func markTranslationUnits(
+	result *TranslationImportResult,
+	indexes []int,
+	from TranslationUnitStatus,
+	to TranslationUnitStatus,
+	reason string,
+) {
+	for _, i := range indexes {
+		if result.Units[i].Status != from {
+			continue
+		}
+
+		unitReason := reason
+		result.Units[i].Status = to
+		result.Units[i].Reason = &unitReason
+	}
+}
+
+func translationImportFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrTooManyTranslationLocales):
+		return "translation locale limit reached"
+	case errors.Is(err, ErrInvalidInput):
+		return err.Error()
+	default:
+		return "failed to save translation"
+	}
+}
+
+func setTranslationField(field *string, value string) bool {
+	if *field == value {
+		return false
+	}
+
+	*field = value
+
+	return true
+}
+
+// getProfileIDForTranslationExchange resolves the profile and checks that the
+// user can maintain it.
+func (s *Service) getProfileIDForTranslationExchange(
+	ctx context.Context,
+	userID string,
+	profileSlug string,
+) (string, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return "", fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return "", ErrProfileNotFound
+	}
+
+	err = s.ensureUserCanProfileAccess(ctx, profileID, userID, MembershipKindMaintainer)
+	if err != nil {
+		return "", err
+	}
+
+	return profileID, nil
+}
+
+func (s *Service) loadTranslationExchangeState(
+	ctx context.Context,
+	profileID string,
+	sourceLocale string,
+	targetLocale string,
+) (*translationExchangeState, error) {
+	state := &translationExchangeState{ //nolint:exhaustruct
+		sourcePages: map[string]*ProfilePageTx{},
+		targetPages: map[string]*ProfilePageTx{},
+		sourceLinks: map[string]*ProfileLinkTx{},
+		targetLinks: map[string]*ProfileLinkTx{},
+	}
+
+	profileTranslations, err := s.repo.GetProfileTxByID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	for _, translation := range profileTranslations {
+		switch translation.LocaleCode {
+		case sourceLocale:
+			state.sourceProfile = translation
+		case targetLocale:
+			state.targetProfile = translation
+		}
+	}
+
+	for _, locale := range []string{sourceLocale, targetLocale} {
+		pages, err := s.repo.ListProfilePageTxsByProfileLocale(ctx, profileID, locale)
+		if err != nil {
+			return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+		}
+
+		links, err := s.repo.ListProfileLinkTxsByProfileLocale(ctx, profileID, locale)
+		if err != nil {
+			return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+		}
+
+		pagesByID, linksByID := state.targetPages, state.targetLinks
+		if locale == sourceLocale {
+			pagesByID, linksByID = state.sourcePages, state.sourceLinks
+			state.pageOrder, state.linkOrder = pages, links
+		}
+
+		for _, page := range pages {
+			pagesByID[page.ProfilePageID] = page
+		}
+
+		for _, link := range links {
+			linksByID[link.ProfileLinkID] = link
+		}
+	}
+
+	return state, nil
+}
+
+func (s *Service) validateTranslationLocalePair(sourceLocale string, targetLocale string) error {
+	if !s.IsValidLocale(sourceLocale) {
+		return fmt.Errorf("%w: unsupported source locale %q", ErrInvalidInput, sourceLocale)
+	}
+
+	if !s.IsValidLocale(targetLocale) {
+		return fmt.Errorf("%w: unsupported target locale %q", ErrInvalidInput, targetLocale)
+	}
+
+	if sourceLocale == targetLocale {
+		return fmt.Errorf("%w: source and target locales must differ", ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// validateTranslationBundle checks the structure of an imported bundle and
+// returns the parsed ID of each unit.
+func (s *Service) validateTranslationBundle(bundle *TranslationBundle) ([]translationUnitKey, error) {
+	if bundle == nil {
+		return nil, fmt.Errorf("%w: empty file", ErrInvalidTranslationFile)
+	}
+
+	err := s.validateTranslationLocalePair(bundle.SourceLocale, bundle.TargetLocale)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTranslationFile, err)
+	}
+
+	if len(bundle.Units) == 0 {
+		return nil, fmt.Errorf("%w: no translation units", ErrInvalidTranslationFile)
+	}
+
+	keys := make([]translationUnitKey, len(bundle.Units))
+	seen := make(map[string]bool, len(bundle.Units))
+
+	for i, unit := range bundle.Units {
+		if unit == nil {
+			return nil, fmt.Errorf("%w: unit %d is empty", ErrInvalidTranslationFile, i+1)
+		}
+
+		key, ok := parseTranslationUnitID(unit.ID)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid unit id %q", ErrInvalidTranslationFile, unit.ID)
+		}
+
+		if seen[unit.ID] {
+			return nil, fmt.Errorf("%w: duplicate unit id %q", ErrInvalidTranslationFile, unit.ID)
+		}
+
+		seen[unit.ID] = true
+		keys[i] = key
+	}
+
+	return keys, nil
+}
+
+func parseTranslationUnitID(id string) (translationUnitKey, bool) {
+	parts := strings.Split(id, ".")
+
+	switch {
+	case len(parts) == 2 && parts[0] == translationEntityProfile:
+		key := profileUnitKey(parts[1])
+
+		return key, key.field == translationFieldTitle || key.field == translationFieldDescription
+	case len(parts) == 3 && parts[0] == translationEntityPage && parts[1] != "":
+		key := pageUnitKey(parts[1], parts[2])
+
+		return key, key.field == translationFieldTitle ||
+			key.field == translationFieldSummary ||
+			key.field == translationFieldContent
+	case len(parts) == 3 && parts[0] == translationEntityLink && parts[1] != "":
+		key := linkUnitKey(parts[1], parts[2])
+
+		return key, key.field == translationFieldTitle ||
+			key.field == translationFieldDescription ||
+			key.field == translationFieldGroup
+	default:
+		return translationUnitKey{}, false //nolint:exhaustruct
+	}
+}
+
+func profileUnitKey(field string) translationUnitKey {
+	return translationUnitKey{entity: translationEntityProfile, entityID: "", field: field}
+}
+
+func pageUnitKey(pageID string, field string) translationUnitKey {
+	return translationUnitKey{entity: translationEntityPage, entityID: pageID, field: field}
+}
+
+func linkUnitKey(linkID string, field string) translationUnitKey {
+	return translationUnitKey{entity: translationEntityLink, entityID: linkID, field: field}
+}
+
+func (k translationUnitKey) String() string {
+	if k.entityID == "" {
+		return k.entity + "." + k.field
+	}
+
+	return k.entity + "." + k.entityID + "." + k.field
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
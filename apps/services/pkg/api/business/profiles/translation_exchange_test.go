@@ -0,0 +1,306 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// translationExchangeRepository keeps the profile, page and link translations
+// of one profile in memory, keyed by locale.
+type translationExchangeRepository struct {
+	profiles.Repository
+
+	profileTx map[string]*profiles.ProfileTx
+	pageTx    map[string][]*profiles.ProfilePageTx
+	linkTx    map[string][]*profiles.ProfileLinkTx
+}
+
+func newTranslationExchangeRepository() *translationExchangeRepository {
+	icon := "gh"
+	group := "Code"
+
+	return &translationExchangeRepository{ //nolint:exhaustruct
+		profileTx: map[string]*profiles.ProfileTx{
+			"en": {ProfileID: "p1", LocaleCode: "en", Title: "Acme", Description: "We build tools"}, //nolint:exhaustruct
+		},
+		pageTx: map[string][]*profiles.ProfilePageTx{
+			"en": {
+				{ProfilePageID: "page-1", Slug: "about", Title: "About", Summary: "Who we are", Content: "# Hello"},
+			},
+		},
+		linkTx: map[string][]*profiles.ProfileLinkTx{
+			"en": {
+				{ProfileLinkID: "link-1", LocaleCode: "en", Title: "Source code", Icon: &icon, Group: &group}, //nolint:exhaustruct
+			},
+		},
+	}
+}
+
+func (r *translationExchangeRepository) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	if slug != "acme" {
+		return "", nil
+	}
+
+	return "p1", nil
+}
+
+func (r *translationExchangeRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *translationExchangeRepository) GetProfileDefaultLocale(_ context.Context, _ string) (string, error) {
+	return "en", nil
+}
+
+func (r *translationExchangeRepository) GetProfileTxByID(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileTx, error) {
+	result := make([]*profiles.ProfileTx, 0, len(r.profileTx))
+	for _, translation := range r.profileTx {
+		result = append(result, translation)
+	}
+
+	return result, nil
+}
+
+func (r *translationExchangeRepository) ListProfilePageTxsByProfileLocale(
+	_ context.Context,
+	_ string,
+	localeCode string,
+) ([]*profiles.ProfilePageTx, error) {
+	return r.pageTx[localeCode], nil
+}
+
+func (r *translationExchangeRepository) ListProfileLinkTxsByProfileLocale(
+	_ context.Context,
+	_ string,
+	localeCode string,
+) ([]*profiles.ProfileLinkTx, error) {
+	return r.linkTx[localeCode], nil
+}
+
+func (r *translationExchangeRepository) UpsertProfileTx(
+	_ context.Context,
+	profileID string,
+	localeCode string,
+	title string,
+	description string,
+	properties map[string]any,
+) error {
+	r.profileTx[localeCode] = &profiles.ProfileTx{
+		Properties:  properties,
+		ProfileID:   profileID,
+		LocaleCode:  localeCode,
+		Title:       title,
+		Description: description,
+	}
+
+	return nil
+}
+
+func (r *translationExchangeRepository) UpsertProfilePageTx(
+	_ context.Context,
+	pageID string,
+	localeCode string,
+	title string,
+	summary string,
+	content string,
+) error {
+	r.pageTx[localeCode] = append(r.pageTx[localeCode], &profiles.ProfilePageTx{
+		ProfilePageID: pageID,
+		Slug:          "about",
+		Title:         title,
+		Summary:       summary,
+		Content:       content,
+	})
+
+	return nil
+}
+
+func (r *translationExchangeRepository) UpsertProfileLinkTx(
+	_ context.Context,
+	linkID string,
+	localeCode string,
+	title string,
+	icon *string,
+	group *string,
+	description *string,
+) error {
+	r.linkTx[localeCode] = append(r.linkTx[localeCode], &profiles.ProfileLinkTx{
+		Icon:          icon,
+		Group:         group,
+		Description:   description,
+		ProfileLinkID: linkID,
+		LocaleCode:    localeCode,
+		Title:         title,
+	})
+
+	return nil
+}
+
+func newTranslationExchangeService() (*profiles.Service, *translationExchangeRepository) {
+	repo := newTranslationExchangeRepository()
+	auditService, _ := newRecordingAuditService()
+
+	return profiles.NewService(nil, &profiles.Config{}, repo, auditService), repo //nolint:exhaustruct
+}
+
+var turkishTranslations = map[string]string{
+	"profile.title":       "Acme",
+	"profile.description": "Araçlar yapıyoruz",
+	"page.page-1.title":   "Hakkında",
+	"page.page-1.summary": "Biz kimiz",
+	"page.page-1.content": "# Merhaba & <hoş geldiniz>",
+	"link.link-1.title":   "Kaynak kodu",
+	"link.link-1.group":   "Kod",
+}
+
+func TestTranslationFiles_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []profiles.TranslationFormat{
+		profiles.TranslationFormatXLIFF,
+		profiles.TranslationFormatJSON,
+	} {
+		t.Run(string(format), func(t *testing.T) {
+			t.Parallel()
+
+			service, repo := newTranslationExchangeService()
+
+			exported, err := service.ExportTranslations(t.Context(), "admin", "acme", "", "tr")
+			require.NoError(t, err)
+			assert.Equal(t, "en", exported.SourceLocale)
+			require.Len(t, exported.Units, len(turkishTranslations))
+
+			for _, unit := range exported.Units {
+				assert.Empty(t, unit.Target, unit.ID)
+				unit.Target = turkishTranslations[unit.ID]
+			}
+
+			data, err := profiles.EncodeTranslationBundle(exported, format)
+			require.NoError(t, err)
+
+			decoded, err := profiles.DecodeTranslationBundle(data, format)
+			require.NoError(t, err)
+			assert.Equal(t, exported, decoded)
+
+			result, err := service.ImportTranslations(t.Context(), "admin", profiles.UserKindAdmin, "acme", decoded)
+			require.NoError(t, err)
+			assert.Equal(t, len(turkishTranslations), result.Applied)
+			assert.Zero(t, result.Failed)
+
+			assert.Equal(t, "Araçlar yapıyoruz", repo.profileTx["tr"].Description)
+			require.Len(t, repo.pageTx["tr"], 1)
+			assert.Equal(t, "# Merhaba & <hoş geldiniz>", repo.pageTx["tr"][0].Content)
+			require.Len(t, repo.linkTx["tr"], 1)
+			assert.Equal(t, "Kod", *repo.linkTx["tr"][0].Group)
+			assert.Equal(t, "gh", *repo.linkTx["tr"][0].Icon, "the icon is kept from the source")
+
+			reexported, err := service.ExportTranslations(t.Context(), "admin", "acme", "en", "tr")
+			require.NoError(t, err)
+
+			for _, unit := range reexported.Units {
+				assert.Equal(t, turkishTranslations[unit.ID], unit.Target, unit.ID)
+			}
+		})
+	}
+}
+
+func TestImportTranslations_ReportsEachString(t *testing.T) {
+	t.Parallel()
+
+	service, repo := newTranslationExchangeService()
+
+	result, err := service.ImportTranslations(
+		t.Context(),
+		"admin",
+		profiles.UserKindAdmin,
+		"acme",
+		&profiles.TranslationBundle{
+			ProfileSlug:  "acme",
+			SourceLocale: "en",
+			TargetLocale: "de",
+			Units: []*profiles.TranslationUnit{
+				{ID: "page.page-1.title", Source: "About", Target: "Über uns"},       //nolint:exhaustruct
+				{ID: "page.page-1.summary", Source: "Who we are", Target: ""},        //nolint:exhaustruct
+				{ID: "page.missing.title", Source: "Gone", Target: "Weg"},            //nolint:exhaustruct
+				{ID: "profile.description", Source: "We build tools", Target: "Wir"}, //nolint:exhaustruct
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	statuses := make(map[string]profiles.TranslationUnitStatus, len(result.Units))
+	for _, unit := range result.Units {
+		statuses[unit.ID] = unit.Status
+	}
+
+	assert.Equal(t, map[string]profiles.TranslationUnitStatus{
+		"page.page-1.title":   profiles.TranslationUnitApplied,
+		"page.page-1.summary": profiles.TranslationUnitSkipped,
+		"page.missing.title":  profiles.TranslationUnitFailed,
+		// A new profile translation needs a title as well.
+		"profile.description": profiles.TranslationUnitFailed,
+	}, statuses)
+	assert.Equal(t, 1, result.Applied)
+	assert.Equal(t, 1, result.Skipped)
+	assert.Equal(t, 2, result.Failed)
+
+	require.Len(t, repo.pageTx["de"], 1)
+	assert.Equal(t, "Über uns", repo.pageTx["de"][0].Title)
+	assert.Empty(t, repo.pageTx["de"][0].Summary)
+	assert.Nil(t, repo.profileTx["de"])
+}
+
+func TestImportTranslations_RejectsInvalidFiles(t *testing.T) {
+	t.Parallel()
+
+	validUnit := func() []*profiles.TranslationUnit {
+		return []*profiles.TranslationUnit{{ID: "profile.title", Source: "Acme", Target: "Acme"}} //nolint:exhaustruct
+	}
+
+	tests := map[string]*profiles.TranslationBundle{
+		"no units": {ProfileSlug: "acme", SourceLocale: "en", TargetLocale: "tr", Units: nil},
+		"same locales": {
+			ProfileSlug: "acme", SourceLocale: "en", TargetLocale: "en", Units: validUnit(),
+		},
+		"unsupported locale": {
+			ProfileSlug: "acme", SourceLocale: "en", TargetLocale: "xx", Units: validUnit(),
+		},
+		"unknown field": {
+			ProfileSlug: "acme", SourceLocale: "en", TargetLocale: "tr",
+			Units: []*profiles.TranslationUnit{{ID: "page.page-1.slug", Source: "about", Target: "hakkinda"}}, //nolint:exhaustruct
+		},
+		"duplicate id": {
+			ProfileSlug: "acme", SourceLocale: "en", TargetLocale: "tr",
+			Units: append(validUnit(), validUnit()...),
+		},
+	}
+
+	for name, bundle := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo := newTranslationExchangeService()
+
+			_, err := service.ImportTranslations(t.Context(), "admin", profiles.UserKindAdmin, "acme", bundle)
+			require.ErrorIs(t, err, profiles.ErrInvalidTranslationFile)
+			assert.Len(t, repo.profileTx, 1)
+		})
+	}
+
+	t.Run("malformed xliff", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := profiles.DecodeTranslationBundle([]byte("<xliff><file>"), profiles.TranslationFormatXLIFF)
+		require.ErrorIs(t, err, profiles.ErrInvalidTranslationFile)
+	})
+}
@@ -0,0 +1,151 @@
+package profiles
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// TranslationFormat is a file format translation bundles are exchanged in.
+type TranslationFormat string
+
+const (
+	TranslationFormatJSON  TranslationFormat = "json"
+	TranslationFormatXLIFF TranslationFormat = "xliff"
+)
+
+const xliffNamespace = "urn:oasis:names:tc:xliff:document:1.2"
+
+// ParseTranslationFormat returns the format with the given name, JSON when empty.
+func ParseTranslationFormat(name string) (TranslationFormat, error) {
+	switch TranslationFormat(name) {
+	case "", TranslationFormatJSON:
+		return TranslationFormatJSON, nil
+	case TranslationFormatXLIFF:
+		return TranslationFormatXLIFF, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported format %q", ErrInvalidInput, name)
+	}
+}
+
+// ContentType returns the media type of files in the format.
+func (f TranslationFormat) ContentType() string {
+	if f == TranslationFormatXLIFF {
+		return "application/x-xliff+xml"
+	}
+
+	return "application/json"
+}
+
+// FileExtension returns the usual extension of files in the format.
+func (f TranslationFormat) FileExtension() string {
+	if f == TranslationFormatXLIFF {
+		return "xlf"
+	}
+
+	return "json"
+}
+
+// XLIFF 1.2 document with a single file element, as produced and read by
+// common translation tools.
+type xliffDocument struct {
+	XMLName xml.Name  `xml:"urn:oasis:names:tc:xliff:document:1.2 xliff"`
+	Version string    `xml:"version,attr"`
+	File    xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string      `xml:"original,attr"`
+	SourceLanguage string      `xml:"source-language,attr"`
+	TargetLanguage string      `xml:"target-language,attr"`
+	Datatype       string      `xml:"datatype,attr"`
+	Units          []xliffUnit `xml:"body>trans-unit"`
+}
+
+type xliffUnit struct {
+	ID     string  `xml:"id,attr"`
+	Source string  `xml:"source"`
+	Target *string `xml:"target"`
+	Note   string  `xml:"note,omitempty"`
+}
+
+// EncodeTranslationBundle writes a bundle in the given format.
+func EncodeTranslationBundle(bundle *TranslationBundle, format TranslationFormat) ([]byte, error) {
+	if format != TranslationFormatXLIFF {
+		data, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("encoding translation bundle: %w", err)
+		}
+
+		return data, nil
+	}
+
+	document := xliffDocument{
+		XMLName: xml.Name{Space: xliffNamespace, Local: "xliff"},
+		Version: "1.2",
+		File: xliffFile{
+			Original:       bundle.ProfileSlug,
+			SourceLanguage: bundle.SourceLocale,
+			TargetLanguage: bundle.TargetLocale,
+			Datatype:       "plaintext",
+			Units:          make([]xliffUnit, len(bundle.Units)),
+		},
+	}
+
+	for i, unit := range bundle.Units {
+		target := unit.Target
+		document.File.Units[i] = xliffUnit{
+			ID:     unit.ID,
+			Source: unit.Source,
+			Target: &target,
+			Note:   unit.Note,
+		}
+	}
+
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding translation bundle: %w", err)
+	}
+
+	return append([]byte(xml.Header), data...), nil
+}
+
+// DecodeTranslationBundle reads a bundle in the given format. Only the file
+// syntax is checked here; ImportTranslations validates the contents.
+func DecodeTranslationBundle(data []byte, format TranslationFormat) (*TranslationBundle, error) {
+	if format != TranslationFormatXLIFF {
+		var bundle TranslationBundle
+
+		err := json.Unmarshal(data, &bundle)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidTranslationFile, err)
+		}
+
+		return &bundle, nil
+	}
+
+	var document xliffDocument
+
+	err := xml.Unmarshal(data, &document)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidTranslationFile, err)
+	}
+
+	bundle := &TranslationBundle{
+		ProfileSlug:  document.File.Original,
+		SourceLocale: document.File.SourceLanguage,
+		TargetLocale: document.File.TargetLanguage,
+		Units:        make([]*TranslationUnit, len(document.File.Units)),
+	}
+
+	for i, unit := range document.File.Units {
+		bundle.Units[i] = &TranslationUnit{
+			ID:     unit.ID,
+			Note:   unit.Note,
+			Source: unit.Source,
+			Target: derefString(unit.Target),
+		}
+	}
+
+	return bundle, nil
+}
@@ -0,0 +1,300 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+)
+
+var (
+	ErrTranslationJobNotFound          = errors.New("translation job not found")
+	ErrTranslationQueueNotSet          = errors.New("translation queue is not set")
+	ErrFailedToStartTranslationJob     = errors.New("failed to start translation job")
+	ErrFailedToGetTranslationJob       = errors.New("failed to get translation job")
+	ErrFailedToRecordTranslationResult = errors.New("failed to record translation result")
+)
+
+// TranslationJobStatus is the state of a bulk translation job.
+type TranslationJobStatus string
+
+const (
+	TranslationJobRunning   TranslationJobStatus = "running"
+	TranslationJobCompleted TranslationJobStatus = "completed"
+)
+
+// TranslationJob tracks a bulk auto-translation. Each locale is translated by
+// its own PAGE_AUTO_TRANSLATE queue item, and the job completes once every
+// locale has a result.
+type TranslationJob struct {
+	CreatedAt    time.Time                 `json:"created_at"`
+	FinishedAt   *time.Time                `json:"finished_at"`
+	ID           string                    `json:"id"`
+	PageID       string                    `json:"page_id"`
+	SourceLocale string                    `json:"source_locale"`
+	Status       TranslationJobStatus      `json:"status"`
+	Results      []LocaleTranslationResult `json:"results"`
+	Total        int                       `json:"total"`
+	UserID       string                    `json:"-"`
+}
+
+// PageTranslationTask is the payload of a PAGE_AUTO_TRANSLATE queue item,
+// translating the page of a job into one locale.
+type PageTranslationTask struct {
+	JobID               string `json:"job_id"`
+	UserID              string `json:"user_id"`
+	UserKind            string `json:"user_kind"`
+	IndividualProfileID string `json:"individual_profile_id"`
+	ProfileSlug         string `json:"profile_slug"`
+	PageID              string `json:"page_id"`
+	SourceLocale        string `json:"source_locale"`
+	TargetLocale        string `json:"target_locale"`
+}
+
+// SetQueueService makes bulk auto-translations run on the event queue.
+func (s *Service) SetQueueService(queueService *events.QueueService) {
+	s.queueService = queueService
+}
+
+// StartAutoTranslateAllMissing checks the request and source content, then
+// queues the translation of every missing locale. The returned job can be
+// polled with GetTranslationJob for per-locale progress.
+func (s *Service) StartAutoTranslateAllMissing(
+	ctx context.Context,
+	params AutoTranslateAllMissingParams,
+) (*TranslationJob, error) {
+	if s.queueService == nil {
+		return nil, ErrTranslationQueueNotSet
+	}
+
+	plan, err := s.planAutoTranslateAllMissing(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &TranslationJob{
+		CreatedAt:    time.Now(),
+		FinishedAt:   nil,
+		ID:           string(s.idGenerator()),
+		PageID:       params.PageID,
+		SourceLocale: params.SourceLocale,
+		Status:       TranslationJobRunning,
+		Results:      []LocaleTranslationResult{},
+		Total:        len(plan.missing),
+		UserID:       params.UserID,
+	}
+
+	err = s.repo.InsertProfilePageTranslationJob(ctx, job)
+	if err != nil {
+		return nil, fmt.Errorf("%w(pageID: %s): %w", ErrFailedToStartTranslationJob, params.PageID, err)
+	}
+
+	for i, locale := range plan.missing {
+		task := PageTranslationTask{
+			JobID:               job.ID,
+			UserID:              params.UserID,
+			UserKind:            params.UserKind,
+			IndividualProfileID: params.IndividualProfileID,
+			ProfileSlug:         params.ProfileSlug,
+			PageID:              params.PageID,
+			SourceLocale:        params.SourceLocale,
+			TargetLocale:        locale,
+		}
+
+		_, err = s.queueService.Enqueue(ctx, events.QueueEnqueueParams{
+			Type:                  events.QueueItemTypePageAutoTranslate,
+			Payload:               task.payload(),
+			ScheduledAt:           nil,
+			MaxRetries:            0,
+			VisibilityTimeoutSecs: 0,
+		})
+		if err != nil {
+			// Close the locales that won't run so the job still completes
+			s.failUnqueuedTranslations(ctx, job.ID, plan.missing[i:])
+
+			return nil, fmt.Errorf("%w(pageID: %s): %w", ErrFailedToStartTranslationJob, params.PageID, err)
+		}
+	}
+
+	return job, nil
+}
+
+// failUnqueuedTranslations records the locales whose queue items could not be
+// enqueued as failed.
+func (s *Service) failUnqueuedTranslations(ctx context.Context, jobID string, locales []string) {
+	for _, locale := range locales {
+		_, err := s.repo.InsertProfilePageTranslationJobResult(
+			context.WithoutCancel(ctx),
+			jobID,
+			failedLocaleTranslation(locale, translationReasonFailed),
+		)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to record unqueued translation",
+				slog.String("job_id", jobID),
+				slog.String("locale", locale),
+				slog.String("error", err.Error()))
+		}
+	}
+}
+
+// GetTranslationJob returns the current state of a bulk translation job started
+// by the user.
+func (s *Service) GetTranslationJob(
+	ctx context.Context,
+	userID string,
+	jobID string,
+) (*TranslationJob, error) {
+	job, err := s.repo.GetProfilePageTranslationJob(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetTranslationJob, jobID, err)
+	}
+
+	if job == nil || job.UserID != userID {
+		return nil, fmt.Errorf("%w(id: %s)", ErrTranslationJobNotFound, jobID)
+	}
+
+	return job, nil
+}
+
+// TranslateJobLocale runs the PAGE_AUTO_TRANSLATE item of a bulk translation
+// job and records the outcome of its locale. A locale that already has a result
+// is left alone, and one that was saved before the result could be recorded is
+// reported as translated without charging again, so retried items are safe.
+// Once the job ran out of points, the remaining locales are skipped.
+func (s *Service) TranslateJobLocale(
+	ctx context.Context,
+	task PageTranslationTask,
+	translator ContentTranslator,
+	pointsService *profile_points.Service,
+) error {
+	job, err := s.repo.GetProfilePageTranslationJob(ctx, task.JobID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToGetTranslationJob, task.JobID, err)
+	}
+
+	if job == nil {
+		return fmt.Errorf("%w(id: %s)", ErrTranslationJobNotFound, task.JobID)
+	}
+
+	result, err := s.translateJobLocale(ctx, job, task, translator, pointsService)
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	_, err = s.repo.InsertProfilePageTranslationJobResult(ctx, task.JobID, *result)
+	if err != nil {
+		return fmt.Errorf(
+			"%w(id: %s, locale: %s): %w",
+			ErrFailedToRecordTranslationResult,
+			task.JobID,
+			task.TargetLocale,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// translateJobLocale returns the outcome of the task's locale, or nil when it
+// was already recorded.
+func (s *Service) translateJobLocale(
+	ctx context.Context,
+	job *TranslationJob,
+	task PageTranslationTask,
+	translator ContentTranslator,
+	pointsService *profile_points.Service,
+) (*LocaleTranslationResult, error) {
+	outOfPoints := false
+
+	for _, result := range job.Results {
+		if result.LocaleCode == task.TargetLocale {
+			return nil, nil //nolint:nilnil
+		}
+
+		if result.Reason != nil && *result.Reason == translationReasonInsufficientPoints {
+			outOfPoints = true
+		}
+	}
+
+	if outOfPoints {
+		result := skippedLocaleTranslation(task.TargetLocale, translationReasonInsufficientPoints)
+
+		return &result, nil
+	}
+
+	existing, err := s.repo.ListProfilePageTxLocales(ctx, task.PageID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(pageID: %s): %w", ErrFailedToListRecords, task.PageID, err)
+	}
+
+	if slices.ContainsFunc(existing, func(locale string) bool {
+		return strings.TrimSpace(locale) == task.TargetLocale
+	}) {
+		result := LocaleTranslationResult{
+			Reason:     nil,
+			LocaleCode: task.TargetLocale,
+			Status:     LocaleTranslationTranslated,
+		}
+
+		return &result, nil
+	}
+
+	err = s.ensurePageTranslationLocaleAllowed(ctx, task.UserKind, task.PageID, task.TargetLocale)
+	if errors.Is(err, ErrTooManyTranslationLocales) {
+		result := skippedLocaleTranslation(task.TargetLocale, translationReasonLocaleLimit)
+
+		return &result, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := s.loadPageTranslationSource(
+		ctx,
+		task.UserID,
+		task.ProfileSlug,
+		task.PageID,
+		task.SourceLocale,
+	)
+	if err != nil {
+		result := failedLocaleTranslation(task.TargetLocale, translationReasonFailed)
+
+		return &result, nil //nolint:nilerr // reported as the locale's result
+	}
+
+	result := s.translateMissingLocale(ctx, AutoTranslatePageParams{
+		UserID:              task.UserID,
+		UserKind:            task.UserKind,
+		IndividualProfileID: task.IndividualProfileID,
+		ProfileSlug:         task.ProfileSlug,
+		PageID:              task.PageID,
+		SourceLocale:        task.SourceLocale,
+		TargetLocale:        task.TargetLocale,
+	}, source, translator, pointsService)
+
+	return &result, nil
+}
+
+func (t PageTranslationTask) payload() map[string]any {
+	return map[string]any{
+		"job_id":                t.JobID,
+		"user_id":               t.UserID,
+		"user_kind":             t.UserKind,
+		"individual_profile_id": t.IndividualProfileID,
+		"profile_slug":          t.ProfileSlug,
+		"page_id":               t.PageID,
+		"source_locale":         t.SourceLocale,
+		"target_locale":         t.TargetLocale,
+	}
+}
@@ -0,0 +1,85 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DefaultMaxTranslationLocales is the number of distinct locales a profile or page
+// may be translated into when the configuration doesn't set one.
+const DefaultMaxTranslationLocales = 8
+
+// ErrTooManyTranslationLocales is returned when adding a translation would take an
+// entity over the configured number of locales.
+var ErrTooManyTranslationLocales = errors.New("too many translation locales")
+
+func (s *Service) maxTranslationLocales() int {
+	if s.config == nil || s.config.MaxTranslationLocales <= 0 {
+		return DefaultMaxTranslationLocales
+	}
+
+	return s.config.MaxTranslationLocales
+}
+
+// checkTranslationLocaleLimit fails with ErrTooManyTranslationLocales when
+// localeCode is not among the existing locales and adding it would exceed limit.
+// Updating an already translated locale is always allowed.
+func checkTranslationLocaleLimit(limit int, existing []string, localeCode string) error {
+	if slices.Contains(existing, localeCode) || len(existing) < limit {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %d locales, limit is %d", ErrTooManyTranslationLocales, len(existing), limit)
+}
+
+// ensureProfileTranslationLocaleAllowed applies the locale limit to a profile
+// translation. Admins bypass the limit.
+func (s *Service) ensureProfileTranslationLocaleAllowed(
+	ctx context.Context,
+	userKind string,
+	profileID string,
+	localeCode string,
+) error {
+	if userKind == UserKindAdmin {
+		return nil
+	}
+
+	translations, err := s.repo.GetProfileTxByID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListRecords, profileID, err)
+	}
+
+	locales := make([]string, 0, len(translations))
+	for _, translation := range translations {
+		locales = append(locales, strings.TrimSpace(translation.LocaleCode))
+	}
+
+	return checkTranslationLocaleLimit(s.maxTranslationLocales(), locales, localeCode)
+}
+
+// ensurePageTranslationLocaleAllowed applies the locale limit to a profile page
+// translation. Admins bypass the limit.
+func (s *Service) ensurePageTranslationLocaleAllowed(
+	ctx context.Context,
+	userKind string,
+	pageID string,
+	localeCode string,
+) error {
+	if userKind == UserKindAdmin {
+		return nil
+	}
+
+	locales, err := s.repo.ListProfilePageTxLocales(ctx, pageID)
+	if err != nil {
+		return fmt.Errorf("%w(pageID: %s): %w", ErrFailedToListRecords, pageID, err)
+	}
+
+	for i, locale := range locales {
+		locales[i] = strings.TrimSpace(locale)
+	}
+
+	return checkTranslationLocaleLimit(s.maxTranslationLocales(), locales, localeCode)
+}
@@ -0,0 +1,161 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// translationLimitsRepository serves the calls made while upserting profile and
+// page translations.
+type translationLimitsRepository struct {
+	profiles.Repository
+
+	locales  []string
+	upserted []string
+}
+
+func (r *translationLimitsRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "target-profile", nil
+}
+
+func (r *translationLimitsRepository) GetUserBriefInfo(
+	_ context.Context,
+	_ string,
+) (*profiles.UserBriefInfo, error) {
+	return &profiles.UserBriefInfo{Kind: profiles.UserKindAdmin}, nil //nolint:exhaustruct
+}
+
+func (r *translationLimitsRepository) GetProfileTxByID(
+	_ context.Context,
+	profileID string,
+) ([]*profiles.ProfileTx, error) {
+	translations := make([]*profiles.ProfileTx, 0, len(r.locales))
+	for _, locale := range r.locales {
+		translations = append(translations, &profiles.ProfileTx{ //nolint:exhaustruct
+			ProfileID:  profileID,
+			LocaleCode: locale,
+		})
+	}
+
+	return translations, nil
+}
+
+func (r *translationLimitsRepository) UpsertProfileTx(
+	_ context.Context,
+	_ string,
+	localeCode string,
+	_ string,
+	_ string,
+	_ map[string]any,
+) error {
+	r.upserted = append(r.upserted, localeCode)
+
+	return nil
+}
+
+func (r *translationLimitsRepository) GetProfilePage(
+	_ context.Context,
+	id string,
+) (*profiles.ProfilePage, error) {
+	return &profiles.ProfilePage{ID: id}, nil //nolint:exhaustruct
+}
+
+func (r *translationLimitsRepository) ListProfilePageTxLocales(
+	_ context.Context,
+	_ string,
+) ([]string, error) {
+	return append([]string(nil), r.locales...), nil
+}
+
+func (r *translationLimitsRepository) UpsertProfilePageTx(
+	_ context.Context,
+	_ string,
+	localeCode string,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	r.upserted = append(r.upserted, localeCode)
+
+	return nil
+}
+
+func newTranslationLimitsService(locales ...string) (*profiles.Service, *translationLimitsRepository) {
+	repo := &translationLimitsRepository{locales: locales} //nolint:exhaustruct
+	auditService, _ := newRecordingAuditService()
+	config := &profiles.Config{MaxTranslationLocales: 2} //nolint:exhaustruct
+
+	return profiles.NewService(nil, config, repo, auditService), repo
+}
+
+func TestTranslationLocaleLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		userKind string
+		locales  []string
+		locale   string
+		wantErr  bool
+	}{
+		"below the limit": {
+			userKind: "regular",
+			locales:  []string{"en"},
+			locale:   "tr",
+		},
+		"new locale at the limit": {
+			userKind: "regular",
+			locales:  []string{"en", "tr"},
+			locale:   "de",
+			wantErr:  true,
+		},
+		"existing locale at the limit": {
+			userKind: "regular",
+			locales:  []string{"en", "tr"},
+			locale:   "tr",
+		},
+		"admin bypasses the limit": {
+			userKind: profiles.UserKindAdmin,
+			locales:  []string{"en", "tr"},
+			locale:   "de",
+		},
+	}
+
+	upserts := map[string]func(service *profiles.Service, t *testing.T, userKind, locale string) error{
+		"profile": func(service *profiles.Service, t *testing.T, userKind, locale string) error {
+			return service.UpdateTranslation(
+				t.Context(), "user-1", userKind, "target", locale, "Title", "Description", nil,
+			)
+		},
+		"page": func(service *profiles.Service, t *testing.T, userKind, locale string) error {
+			return service.UpdateProfilePageTranslation(
+				t.Context(), "user-1", userKind, "target", "page-1", locale, "Title", "Summary", "Content",
+			)
+		},
+	}
+
+	for entity, upsert := range upserts {
+		for name, tt := range tests {
+			t.Run(entity+"/"+name, func(t *testing.T) {
+				t.Parallel()
+
+				service, repo := newTranslationLimitsService(tt.locales...)
+
+				err := upsert(service, t, tt.userKind, tt.locale)
+
+				if tt.wantErr {
+					require.ErrorIs(t, err, profiles.ErrTooManyTranslationLocales)
+					assert.Empty(t, repo.upserted)
+
+					return
+				}
+
+				require.NoError(t, err)
+				assert.Equal(t, []string{tt.locale}, repo.upserted)
+			})
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ProfileTranslationStatus reports whether a profile is translated into a locale.
+//
+// A translation looks auto-generated when it repeats the default locale's title
+// and description word for word, as left by copying content over instead of
+// translating it.
+type ProfileTranslationStatus struct {
+	LocaleCode         string `json:"locale_code"`
+	IsDefault          bool   `json:"is_default"`
+	Translated         bool   `json:"translated"`
+	LooksAutoGenerated bool   `json:"looks_auto_generated"`
+}
+
+// GetProfileTranslationStatus returns the translation status of the profile's own
+// title and description in every supported locale, ordered by locale code.
+func (s *Service) GetProfileTranslationStatus(
+	ctx context.Context,
+	profileSlug string,
+) ([]*ProfileTranslationStatus, error) {
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	if profileID == "" {
+		return nil, ErrProfileNotFound
+	}
+
+	defaultLocale, err := s.repo.GetProfileDefaultLocale(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	translations, err := s.repo.GetProfileTxByID(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	byLocale := make(map[string]*ProfileTx, len(translations))
+	for _, translation := range translations {
+		byLocale[translation.LocaleCode] = translation
+	}
+
+	source := byLocale[defaultLocale]
+
+	supportedLocales := s.SupportedLocaleCodes()
+	locales := make([]string, 0, len(supportedLocales))
+	for locale := range supportedLocales {
+		locales = append(locales, locale)
+	}
+
+	slices.Sort(locales)
+
+	result := make([]*ProfileTranslationStatus, len(locales))
+
+	for i, locale := range locales {
+		translation, translated := byLocale[locale]
+
+		result[i] = &ProfileTranslationStatus{
+			LocaleCode: locale,
+			IsDefault:  locale == defaultLocale,
+			Translated: translated,
+			LooksAutoGenerated: translated && locale != defaultLocale && source != nil &&
+				sameProfileTxContent(translation, source),
+		}
+	}
+
+	return result, nil
+}
+
+func sameProfileTxContent(a *ProfileTx, b *ProfileTx) bool {
+	return strings.TrimSpace(a.Title) == strings.TrimSpace(b.Title) &&
+		strings.TrimSpace(a.Description) == strings.TrimSpace(b.Description)
+}
@@ -0,0 +1,95 @@
+package profiles_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// translationStatusRepository serves a profile with an "en" default locale and
+// the given translations.
+type translationStatusRepository struct {
+	profiles.Repository
+
+	translations []*profiles.ProfileTx
+}
+
+func (r *translationStatusRepository) GetProfileIDBySlug(
+	_ context.Context,
+	_ string,
+) (string, error) {
+	return "acme-profile", nil
+}
+
+func (r *translationStatusRepository) GetProfileDefaultLocale(
+	_ context.Context,
+	_ string,
+) (string, error) {
+	return "en", nil
+}
+
+func (r *translationStatusRepository) GetProfileTxByID(
+	_ context.Context,
+	_ string,
+) ([]*profiles.ProfileTx, error) {
+	return r.translations, nil
+}
+
+func TestGetProfileTranslationStatus(t *testing.T) {
+	t.Parallel()
+
+	repo := &translationStatusRepository{ //nolint:exhaustruct
+		translations: []*profiles.ProfileTx{
+			{LocaleCode: "en", Title: "Acme", Description: "We build things"},    //nolint:exhaustruct
+			{LocaleCode: "tr", Title: "Acme", Description: "Bir şeyler yaparız"}, //nolint:exhaustruct
+		},
+	}
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	statuses, err := service.GetProfileTranslationStatus(t.Context(), "acme")
+	require.NoError(t, err)
+	require.Len(t, statuses, len(service.SupportedLocaleCodes()))
+
+	missing := 0
+
+	for _, status := range statuses {
+		assert.False(t, status.LooksAutoGenerated, status.LocaleCode)
+
+		switch status.LocaleCode {
+		case "en":
+			assert.True(t, status.Translated)
+			assert.True(t, status.IsDefault)
+		case "tr":
+			assert.True(t, status.Translated)
+			assert.False(t, status.IsDefault)
+		default:
+			assert.False(t, status.Translated, status.LocaleCode)
+
+			missing++
+		}
+	}
+
+	assert.Equal(t, 11, missing)
+}
+
+func TestGetProfileTranslationStatus_CopiedTranslation(t *testing.T) {
+	t.Parallel()
+
+	repo := &translationStatusRepository{ //nolint:exhaustruct
+		translations: []*profiles.ProfileTx{
+			{LocaleCode: "en", Title: "Acme", Description: "We build things"},  //nolint:exhaustruct
+			{LocaleCode: "de", Title: "Acme", Description: "We build things "}, //nolint:exhaustruct
+		},
+	}
+	service := profiles.NewService(nil, nil, repo, nil)
+
+	statuses, err := service.GetProfileTranslationStatus(t.Context(), "acme")
+	require.NoError(t, err)
+
+	for _, status := range statuses {
+		assert.Equal(t, status.LocaleCode == "de", status.LooksAutoGenerated, status.LocaleCode)
+	}
+}
@@ -45,6 +45,7 @@ const (
 	PageVisibilityPublic   PageVisibility = "public"   // Listed in sidebar, visible to all
 	PageVisibilityUnlisted PageVisibility = "unlisted" // Accessible via direct link, not listed
 	PageVisibilityPrivate  PageVisibility = "private"  // Only contributors+ and admins
+	PageVisibilityDraft    PageVisibility = "draft"    // Like private until published_at passes
 )
 
 // MembershipKind represents the type of membership a profile has with another.
@@ -145,15 +146,100 @@ type ProfileCustomDomain struct {
 	ProfileID          string     `json:"profile_id"`
 	Domain             string     `json:"domain"`
 	VerificationStatus string     `json:"verification_status"`
+	VerificationToken  string     `json:"verification_token"`
 	WebserverSynced    bool       `json:"webserver_synced"`
 	WwwPrefix          bool       `json:"www_prefix"`
 }
 
+// ExpectedDNSTarget is the DNS setup a custom domain must point to in order to be verified.
+type ExpectedDNSTarget struct {
+	IPv4  string `json:"ipv4"`
+	IPv6  string `json:"ipv6"`
+	CNAME string `json:"cname"`
+}
+
+// DNSRecord is a single DNS record a custom domain owner is asked to create.
+type DNSRecord struct {
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Purpose string `json:"purpose"`
+}
+
+// CustomDomainDNSInstructions lists the DNS records that make a custom domain pass
+// verification. Any one routing record is enough; the TXT record proves ownership.
+type CustomDomainDNSInstructions struct {
+	Domain  *ProfileCustomDomain `json:"domain"`
+	Records []DNSRecord          `json:"records"`
+}
+
+// HandleReservation holds a profile slug for an organization that has not signed
+// up yet. It can be claimed by a user whose email matches VerificationEmail or
+// belongs to VerificationDomain.
+type HandleReservation struct {
+	CreatedAt          time.Time  `json:"created_at"`
+	ExpiresAt          time.Time  `json:"expires_at"`
+	ClaimedAt          *time.Time `json:"claimed_at"`
+	ReleasedAt         *time.Time `json:"released_at"`
+	VerificationEmail  *string    `json:"verification_email"`
+	VerificationDomain *string    `json:"verification_domain"`
+	Note               *string    `json:"note"`
+	ClaimedByProfileID *string    `json:"claimed_by_profile_id"`
+	ID                 string     `json:"id"`
+	Slug               string     `json:"slug"`
+	ReservedByUserID   string     `json:"reserved_by_user_id"`
+}
+
+// MembershipInvitation invites a profile to become a member of another profile
+// with the given kind. The membership is created when the invitee accepts.
+type MembershipInvitation struct {
+	CreatedAt        time.Time  `json:"created_at"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RespondedAt      *time.Time `json:"responded_at"`
+	ID               string     `json:"id"`
+	ProfileID        string     `json:"profile_id"`
+	InviteeProfileID string     `json:"invitee_profile_id"`
+	Kind             string     `json:"kind"`
+	Status           string     `json:"status"`
+	InvitedByUserID  string     `json:"invited_by_user_id"`
+}
+
+// CustomDomainList lists a profile's custom domains along with the expected DNS target.
+type CustomDomainList struct {
+	ExpectedDNS ExpectedDNSTarget      `json:"expected_dns"`
+	Domains     []*ProfileCustomDomain `json:"domains"`
+}
+
+// CustomDomainVerification is the result of an on-demand DNS verification.
+// Outcome is one of the DNSCheck* values. LookupFailed is set when DNS could not be
+// queried; the domain status is then unchanged.
+type CustomDomainVerification struct {
+	Domain       *ProfileCustomDomain `json:"domain"`
+	ExpectedDNS  ExpectedDNSTarget    `json:"expected_dns"`
+	Outcome      string               `json:"outcome"`
+	Detail       string               `json:"detail"`
+	Verified     bool                 `json:"verified"`
+	LookupFailed bool                 `json:"lookup_failed"`
+}
+
 type ProfileWithChildren struct {
 	*Profile
 
-	Pages []*ProfilePageBrief `json:"pages"`
-	Links []*ProfileLinkBrief `json:"links"`
+	Appearance   *Appearance         `json:"appearance"`
+	Availability *Availability       `json:"availability"`
+	Counts       *ProfileCounts      `json:"counts"`
+	Pages        []*ProfilePageBrief `json:"pages"`
+	Links        []*ProfileLinkBrief `json:"links"`
+}
+
+// ProfileCounts holds the totals shown as badges on a profile, as seen by an anonymous visitor.
+// Counts of disabled modules are omitted.
+type ProfileCounts struct {
+	Members       *int64 `json:"members,omitempty"`
+	Contributions *int64 `json:"contributions,omitempty"`
+	Links         *int64 `json:"links,omitempty"`
+	Resources     int64  `json:"resources"`
+	Pages         int64  `json:"pages"`
 }
 
 type ProfilePage struct {
@@ -181,6 +267,14 @@ type ProfilePageBrief struct {
 	Visibility      PageVisibility `json:"visibility"`
 }
 
+// DueProfilePage is a draft page whose published_at has passed.
+type DueProfilePage struct {
+	PublishedAt time.Time `json:"published_at"`
+	ID          string    `json:"id"`
+	ProfileID   string    `json:"profile_id"`
+	Slug        string    `json:"slug"`
+}
+
 type ProfileLink struct {
 	CreatedAt        time.Time      `json:"created_at"`
 	RemoteID         *string        `json:"remote_id"`
@@ -194,6 +288,9 @@ type ProfileLink struct {
 	AddedByProfile   *ProfileBrief  `json:"added_by_profile,omitempty"`
 	UpdatedAt        *time.Time     `json:"updated_at"`
 	DeletedAt        *time.Time     `json:"deleted_at"`
+	SyncStatus       *string        `json:"sync_status,omitempty"` // Last sync attempt, editing list only
+	SyncError        *string        `json:"sync_error,omitempty"`
+	SyncAttemptedAt  *time.Time     `json:"sync_attempted_at,omitempty"`
 	ID               string         `json:"id"`
 	Kind             string         `json:"kind"`
 	ProfileID        string         `json:"profile_id"`
@@ -307,12 +404,18 @@ type ProfileBrief struct {
 
 // ProfileTeam represents a team within a profile for organizing members.
 type ProfileTeam struct {
-	Description   *string `json:"description"`
-	ID            string  `json:"id"`
-	ProfileID     string  `json:"profile_id"`
-	Name          string  `json:"name"`
-	MemberCount   int64   `json:"member_count"`
-	ResourceCount int64   `json:"resource_count"`
+	Description *string `json:"description"`
+	// LeadMembershipID is the membership leading the team, if one is designated.
+	// The lead is always also a member of the team.
+	LeadMembershipID *string `json:"lead_membership_id"`
+	// LeadMemberProfileID is the lead's member profile, filled in team listings
+	// so clients can route join requests and contact to the lead.
+	LeadMemberProfileID *string `json:"lead_member_profile_id"`
+	ID                  string  `json:"id"`
+	ProfileID           string  `json:"profile_id"`
+	Name                string  `json:"name"`
+	MemberCount         int64   `json:"member_count"`
+	ResourceCount       int64   `json:"resource_count"`
 }
 
 // ProfileMembershipWithMember includes membership data with member profile details.
@@ -358,6 +461,16 @@ const (
 	CandidateSourceApplication CandidateSource = "application"
 )
 
+// ExpiredVotingCandidate is a candidate still in voting after its voting deadline.
+type ExpiredVotingCandidate struct {
+	VotingDeadline    time.Time `json:"voting_deadline"`
+	ID                string    `json:"id"`
+	ProfileID         string    `json:"profile_id"`
+	ProfileSlug       string    `json:"profile_slug"`
+	ReferredProfileID string    `json:"referred_profile_id"`
+	Source            string    `json:"source"`
+}
+
 // ApplicationForm represents an organization's application form configuration.
 type ApplicationForm struct {
 	CreatedAt           time.Time               `json:"created_at"`
@@ -411,6 +524,7 @@ type ProfileMembershipCandidate struct {
 	ViewerVoteComment    *string                  `json:"viewer_vote_comment"`
 	ViewerVoteScore      *int16                   `json:"viewer_vote_score"`
 	ApplicantMessage     *string                  `json:"applicant_message"`
+	VotingDeadline       *time.Time               `json:"voting_deadline"`
 	UpdatedAt            *time.Time               `json:"updated_at"`
 	ID                   string                   `json:"id"`
 	ProfileID            string                   `json:"profile_id"`
@@ -483,6 +597,14 @@ type ProfileLinkTx struct {
 	Title         string  `json:"title"`
 }
 
+type ProfilePageTx struct {
+	ProfilePageID string `json:"profile_page_id"`
+	Slug          string `json:"slug"`
+	Title         string `json:"title"`
+	Summary       string `json:"summary"`
+	Content       string `json:"content"`
+}
+
 // SpotlightItem represents an item in the spotlight section.
 type SpotlightItem struct {
 	Icon  string `json:"icon"`
@@ -504,6 +626,34 @@ type SearchResult struct {
 	Rank         float32 `json:"rank"`
 }
 
+// ProfileSuggestion is a profile offered while typing a search query.
+type ProfileSuggestion struct {
+	ProfilePictureURI *string `json:"profile_picture_uri"`
+	Slug              string  `json:"slug"`
+	Title             string  `json:"title"`
+	Kind              string  `json:"kind"`
+}
+
+// ResourceSortMode defines the profile resource listing order.
+type ResourceSortMode string
+
+const (
+	// ResourceSortManual lists featured resources first, then follows the maintainers' order.
+	ResourceSortManual ResourceSortMode = "manual"
+	// ResourceSortRecent lists the most recently added resources first.
+	ResourceSortRecent ResourceSortMode = "recent"
+	// ResourceSortAlphabetical lists resources by title, case-insensitively.
+	ResourceSortAlphabetical ResourceSortMode = "alphabetical"
+)
+
+// ProfileResourceFilter narrows and orders the profile resource listing.
+// Nil fields do not filter.
+type ProfileResourceFilter struct {
+	Kind   *string
+	TeamID *string
+	Sort   ResourceSortMode
+}
+
 // ProfileResource represents an external resource linked to a profile (e.g. GitHub repo).
 type ProfileResource struct {
 	CreatedAt        time.Time      `json:"created_at"`
@@ -521,10 +671,26 @@ type ProfileResource struct {
 	Title            string         `json:"title"`
 	AddedByProfileID string         `json:"added_by_profile_id"`
 	Teams            []*ProfileTeam `json:"teams"`
+	Order            int            `json:"order"`
 	IsManaged        bool           `json:"is_managed"`
+	IsFeatured       bool           `json:"is_featured"`
 	CanRemove        bool           `json:"can_remove"`
 }
 
+// ResourceOrderItem places a resource in a profile's manual resource order.
+type ResourceOrderItem struct {
+	ID         string `json:"id"`
+	IsFeatured bool   `json:"is_featured"`
+}
+
+// ProfileLocaleDeletion summarizes the translations removed when a profile drops a locale.
+type ProfileLocaleDeletion struct {
+	LocaleCode string `json:"locale_code"`
+	Profile    int64  `json:"profile"`
+	Pages      int64  `json:"pages"`
+	Links      int64  `json:"links"`
+}
+
 // ManagedGitHubLink holds the access token data for a managed GitHub profile link.
 type ManagedGitHubLink struct {
 	AuthAccessTokenScope *string `json:"-"` // OAuth scope granted for this link
@@ -0,0 +1,80 @@
+package protection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrCaptchaRequired    = errors.New("captcha token is required")
+	ErrCaptchaInvalid     = errors.New("captcha verification failed")
+	ErrCaptchaUnavailable = errors.New("captcha verification is unavailable")
+)
+
+// CaptchaEndpoint names a public write endpoint that can require a CAPTCHA
+// token through CaptchaConfig.Endpoints.
+type CaptchaEndpoint string
+
+const (
+	// CaptchaEndpointReport covers reporting profiles, pages and links.
+	CaptchaEndpointReport CaptchaEndpoint = "report"
+	// CaptchaEndpointApplication covers applying to join a profile.
+	CaptchaEndpointApplication CaptchaEndpoint = "application"
+	// CaptchaEndpointContact covers sending envelopes to a profile.
+	CaptchaEndpointContact CaptchaEndpoint = "contact"
+)
+
+// CaptchaVerifier is the port for checking a CAPTCHA token with its provider.
+// Verify reports whether the token is valid; an error means the provider
+// could not be asked.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token string, clientIP string) (bool, error)
+}
+
+// NoopCaptchaVerifier accepts every token. It is used until a provider is
+// plugged in with SetCaptchaVerifier.
+type NoopCaptchaVerifier struct{}
+
+func (NoopCaptchaVerifier) Verify(_ context.Context, _ string, _ string) (bool, error) {
+	return true, nil
+}
+
+// SetCaptchaVerifier replaces the verifier used for CAPTCHA tokens.
+func (s *Service) SetCaptchaVerifier(verifier CaptchaVerifier) {
+	s.captchaVerifier = verifier
+}
+
+// IsCaptchaRequired reports whether the endpoint is configured to require a
+// CAPTCHA token.
+func (s *Service) IsCaptchaRequired(endpoint CaptchaEndpoint) bool {
+	return s.config != nil && s.config.Captcha.GetEndpoints()[endpoint]
+}
+
+// VerifyCaptcha checks the CAPTCHA token sent to an endpoint. Endpoints that
+// are not configured to require a token always pass.
+func (s *Service) VerifyCaptcha(
+	ctx context.Context,
+	endpoint CaptchaEndpoint,
+	token string,
+	clientIP string,
+) error {
+	if !s.IsCaptchaRequired(endpoint) {
+		return nil
+	}
+
+	if token == "" {
+		return ErrCaptchaRequired
+	}
+
+	valid, err := s.captchaVerifier.Verify(ctx, token, clientIP)
+	if err != nil {
+		return fmt.Errorf("%w(endpoint: %s): %w", ErrCaptchaUnavailable, endpoint, err)
+	}
+
+	if !valid {
+		return fmt.Errorf("%w(endpoint: %s)", ErrCaptchaInvalid, endpoint)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package protection_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/protection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errCaptchaProviderDown = errors.New("captcha provider is down")
+
+// stubCaptchaVerifier accepts the token "valid" and fails on "down".
+type stubCaptchaVerifier struct {
+	clientIPs []string
+}
+
+func (v *stubCaptchaVerifier) Verify(_ context.Context, token string, clientIP string) (bool, error) {
+	v.clientIPs = append(v.clientIPs, clientIP)
+
+	if token == "down" {
+		return false, errCaptchaProviderDown
+	}
+
+	return token == "valid", nil
+}
+
+func newCaptchaService(endpoints string) (*protection.Service, *stubCaptchaVerifier) {
+	config := &protection.Config{ //nolint:exhaustruct
+		Captcha: protection.CaptchaConfig{Endpoints: endpoints},
+	}
+
+	service := protection.NewService(nil, config, nil, nil)
+	verifier := &stubCaptchaVerifier{} //nolint:exhaustruct
+	service.SetCaptchaVerifier(verifier)
+
+	return service, verifier
+}
+
+func TestVerifyCaptcha(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		token    string
+		expected error
+	}{
+		"valid token":          {token: "valid", expected: nil},
+		"missing token":        {token: "", expected: protection.ErrCaptchaRequired},
+		"rejected token":       {token: "forged", expected: protection.ErrCaptchaInvalid},
+		"provider unavailable": {token: "down", expected: protection.ErrCaptchaUnavailable},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, _ := newCaptchaService("report, application")
+
+			err := service.VerifyCaptcha(t.Context(), protection.CaptchaEndpointReport, tt.token, "203.0.113.7")
+			if tt.expected == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, tt.expected)
+		})
+	}
+}
+
+func TestVerifyCaptcha_OnlyConfiguredEndpoints(t *testing.T) {
+	t.Parallel()
+
+	service, verifier := newCaptchaService("report")
+
+	assert.True(t, service.IsCaptchaRequired(protection.CaptchaEndpointReport))
+	assert.False(t, service.IsCaptchaRequired(protection.CaptchaEndpointApplication))
+
+	err := service.VerifyCaptcha(t.Context(), protection.CaptchaEndpointApplication, "", "203.0.113.7")
+	require.NoError(t, err)
+	assert.Empty(t, verifier.clientIPs, "the verifier is not asked for endpoints that don't require it")
+
+	err = service.VerifyCaptcha(t.Context(), protection.CaptchaEndpointReport, "valid", "203.0.113.7")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"203.0.113.7"}, verifier.clientIPs)
+}
+
+func TestVerifyCaptcha_NoopByDefault(t *testing.T) {
+	t.Parallel()
+
+	config := &protection.Config{ //nolint:exhaustruct
+		Captcha: protection.CaptchaConfig{Endpoints: "report"},
+	}
+	service := protection.NewService(nil, config, nil, nil)
+
+	require.NoError(t, service.VerifyCaptcha(t.Context(), protection.CaptchaEndpointReport, "any", ""))
+	require.ErrorIs(
+		t,
+		service.VerifyCaptcha(t.Context(), protection.CaptchaEndpointReport, "", ""),
+		protection.ErrCaptchaRequired,
+	)
+}
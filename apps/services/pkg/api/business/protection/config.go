@@ -1,10 +1,14 @@
 package protection
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Config holds configuration for the protection module.
 type Config struct {
 	POWChallenge POWChallengeConfig `conf:"pow_challenge"`
+	Captcha      CaptchaConfig      `conf:"captcha"`
 }
 
 // POWChallengeConfig holds configuration for PoW challenges.
@@ -13,3 +17,25 @@ type POWChallengeConfig struct {
 	Difficulty int           `conf:"difficulty" default:"16"`  // Number of leading zero bits
 	Expiry     time.Duration `conf:"expiry"     default:"30s"` // Challenge validity period
 }
+
+// CaptchaConfig holds configuration for CAPTCHA verification on public writes.
+type CaptchaConfig struct {
+	// Endpoints is a comma-separated list of the endpoints that require a
+	// CAPTCHA token, out of "report", "application" and "contact". Empty
+	// requires none.
+	Endpoints string `conf:"endpoints" default:""`
+}
+
+// GetEndpoints returns the endpoints that require a CAPTCHA token as a set.
+func (c *CaptchaConfig) GetEndpoints() map[CaptchaEndpoint]bool {
+	result := make(map[CaptchaEndpoint]bool)
+
+	for endpoint := range strings.SplitSeq(c.Endpoints, ",") {
+		trimmed := strings.TrimSpace(endpoint)
+		if trimmed != "" {
+			result[CaptchaEndpoint(trimmed)] = true
+		}
+	}
+
+	return result
+}
@@ -21,10 +21,11 @@ type Repository interface {
 
 // Service handles protection-related business logic.
 type Service struct {
-	logger *logfx.Logger
-	config *Config
-	repo   Repository
-	idGen  func() string
+	logger          *logfx.Logger
+	config          *Config
+	repo            Repository
+	idGen           func() string
+	captchaVerifier CaptchaVerifier
 }
 
 // NewService creates a new protection service.
@@ -35,10 +36,11 @@ func NewService(
 	idGen func() string,
 ) *Service {
 	return &Service{
-		logger: logger,
-		config: config,
-		repo:   repo,
-		idGen:  idGen,
+		logger:          logger,
+		config:          config,
+		repo:            repo,
+		idGen:           idGen,
+		captchaVerifier: NoopCaptchaVerifier{},
 	}
 }
 
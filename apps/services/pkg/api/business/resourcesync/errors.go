@@ -11,4 +11,7 @@ var (
 	ErrFailedToGetProfileLink   = errors.New("failed to get profile link")
 	ErrFailedToGetProfileLinks  = errors.New("failed to get profile links")
 	ErrFailedToGetMemberships   = errors.New("failed to get memberships")
+	ErrFailedToGetOrgLinks      = errors.New("failed to get organization links")
+	ErrFailedToGetResource      = errors.New("failed to get resource")
+	ErrFailedToCreateResource   = errors.New("failed to create resource")
 )
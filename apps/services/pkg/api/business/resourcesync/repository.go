@@ -42,4 +42,28 @@ type Repository interface {
 		profileIDs []string,
 		memberProfileIDs []string,
 	) (map[string]string, error)
+
+	// ListOrganizationGitHubLinksForSync returns the managed GitHub links of organization profiles.
+	ListOrganizationGitHubLinksForSync(
+		ctx context.Context,
+		batchSize int,
+	) ([]*GitHubOrganizationLink, error)
+
+	// GetProfileResourceByRemoteID returns the ID of a profile's resource with the
+	// given kind and remote ID, or "" if it has none.
+	GetProfileResourceByRemoteID(
+		ctx context.Context,
+		profileID string,
+		kind string,
+		remoteID string,
+	) (string, error)
+
+	// CreateGitHubRepoResource stores a repository as a managed github_repo resource
+	// of the profile.
+	CreateGitHubRepoResource(
+		ctx context.Context,
+		id string,
+		profileID string,
+		repo *GitHubRepository,
+	) error
 }
@@ -4,20 +4,30 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
 )
 
+// IDGenerator is a function that generates unique IDs.
+type IDGenerator func() string
+
+func DefaultIDGenerator() string {
+	return lib.IDsGenerateUnique()
+}
+
 // Service handles resource synchronization business logic.
 type Service struct {
-	logger *logfx.Logger
-	repo   Repository
+	logger      *logfx.Logger
+	repo        Repository
+	idGenerator IDGenerator
 }
 
 // NewService creates a new resource sync service.
-func NewService(logger *logfx.Logger, repo Repository) *Service {
+func NewService(logger *logfx.Logger, repo Repository, idGenerator IDGenerator) *Service {
 	return &Service{
-		logger: logger,
-		repo:   repo,
+		logger:      logger,
+		repo:        repo,
+		idGenerator: idGenerator,
 	}
 }
 
@@ -117,3 +127,50 @@ func (s *Service) GetMembershipsByProfilePairs(
 
 	return result, nil
 }
+
+// GetOrganizationGitHubLinksForSync returns the managed GitHub links of organization profiles.
+func (s *Service) GetOrganizationGitHubLinksForSync(
+	ctx context.Context,
+	batchSize int,
+) ([]*GitHubOrganizationLink, error) {
+	links, err := s.repo.ListOrganizationGitHubLinksForSync(ctx, batchSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetOrgLinks, err)
+	}
+
+	return links, nil
+}
+
+// ImportGitHubRepository adds a repository to a profile as a github_repo resource
+// unless the profile already has it. It reports whether a resource was created.
+func (s *Service) ImportGitHubRepository(
+	ctx context.Context,
+	profileID string,
+	repo *GitHubRepository,
+) (bool, error) {
+	existingID, err := s.repo.GetProfileResourceByRemoteID(
+		ctx,
+		profileID,
+		ResourceKindGitHubRepo,
+		repo.RemoteID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("%w(remoteID: %s): %w", ErrFailedToGetResource, repo.RemoteID, err)
+	}
+
+	if existingID != "" {
+		return false, nil
+	}
+
+	err = s.repo.CreateGitHubRepoResource(ctx, s.idGenerator(), profileID, repo)
+	if err != nil {
+		return false, fmt.Errorf(
+			"%w(remoteID: %s): %w",
+			ErrFailedToCreateResource,
+			repo.RemoteID,
+			err,
+		)
+	}
+
+	return true, nil
+}
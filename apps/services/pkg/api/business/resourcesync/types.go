@@ -2,6 +2,9 @@ package resourcesync
 
 import "time"
 
+// ResourceKindGitHubRepo is the profile resource kind of GitHub repositories.
+const ResourceKindGitHubRepo = "github_repo"
+
 // GitHubResourceForSync represents a GitHub repo resource with its associated access token.
 type GitHubResourceForSync struct {
 	ResourceProperties       map[string]any
@@ -29,3 +32,22 @@ type GitHubContributorStats struct {
 	Commits int `json:"commits"`
 	Stars   int `json:"stars"`
 }
+
+// GitHubOrganizationLink is the managed GitHub link of an organization profile.
+type GitHubOrganizationLink struct {
+	LinkID          string
+	ProfileID       string
+	AccountLogin    string // GitHub organization handle
+	AuthAccessToken string
+}
+
+// GitHubRepository is a repository to import as a profile resource.
+type GitHubRepository struct {
+	RemoteID    string // GitHub repo ID
+	FullName    string // "owner/repo"
+	HTMLURL     string
+	Description string
+	Language    string
+	Stars       int
+	Forks       int
+}
@@ -111,6 +111,8 @@ func (s *Service) CreateSession(ctx context.Context, ipHash string) (*users.Sess
 		OAuthProvider:            nil,
 		OAuthAccessToken:         nil,
 		OAuthTokenScope:          nil,
+		ImpersonatorUserID:       nil,
+		ImpersonatorSessionID:    nil,
 		CreatedAt:                now,
 	}
 
@@ -235,6 +237,8 @@ func (s *Service) LogoutSession(ctx context.Context, oldSessionID string) (*Logo
 		OAuthProvider:            nil,
 		OAuthAccessToken:         nil,
 		OAuthTokenScope:          nil,
+		ImpersonatorUserID:       nil,
+		ImpersonatorSessionID:    nil,
 		CreatedAt:                now,
 	}
 
@@ -0,0 +1,106 @@
+package stories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+var ErrNotOrganization = errors.New("profile is not an organization")
+
+// ListAggregatedStories returns the published stories of an organization merged
+// with those of its individual members who opted in to the aggregation, newest
+// first. Visibility is applied for the viewer as in the organization's own story
+// list. The returned cursor points past the last story and is nil on the last page.
+func (s *Service) ListAggregatedStories(
+	ctx context.Context,
+	localeCode string,
+	profileSlug string,
+	cursor *cursors.Cursor,
+	viewerUserID *string,
+) (cursors.Cursored[[]*StoryWithChildren], error) {
+	var offset string
+	if cursor.Offset != nil {
+		offset = *cursor.Offset
+	}
+
+	after, err := ParseFeedCursor(offset)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, err
+	}
+
+	profileID, err := s.repo.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, fmt.Errorf(
+			"%w(slug: %s): %w",
+			ErrFailedToGetRecord,
+			profileSlug,
+			err,
+		)
+	}
+
+	if profileID == "" {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrProfileNotFound
+	}
+
+	profile, err := s.repo.GetProfileByID(ctx, localeCode, profileID)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, fmt.Errorf(
+			"%w(profileID: %s): %w",
+			ErrFailedToGetRecord,
+			profileID,
+			err,
+		)
+	}
+
+	if profile == nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrProfileNotFound
+	}
+
+	if profile.Kind != profiles.ProfileKindOrganization {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrNotOrganization
+	}
+
+	memberProfileIDs, err := s.repo.ListStoryAggregationMemberProfileIDs(ctx, profileID)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, fmt.Errorf(
+			"%w(profileID: %s): %w",
+			ErrFailedToListRecords,
+			profileID,
+			err,
+		)
+	}
+
+	profileIDs := append([]string{profileID}, memberProfileIDs...)
+
+	records, err := s.repo.ListAggregatedStoriesForViewer(
+		ctx,
+		localeCode,
+		profileIDs,
+		viewerUserID,
+		after,
+		cursor.Limit,
+	)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, fmt.Errorf(
+			"%w: %w",
+			ErrFailedToListRecords,
+			err,
+		)
+	}
+
+	var nextCursor *string
+
+	if len(records) == cursor.Limit {
+		last := records[len(records)-1]
+		if last.PublishedAt != nil {
+			encoded := (&FeedCursor{PublishedAt: *last.PublishedAt, StoryID: last.ID}).String()
+			nextCursor = &encoded
+		}
+	}
+
+	return cursors.WrapResponseWithCursor(records, nextCursor), nil
+}
@@ -0,0 +1,191 @@
+package stories_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/stories"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// aggregationRepository serves the "acme" profile with the given kind and opted-in
+// members, and lists its stories newest first the way the aggregated query does:
+// stories of the requested profiles only, private ones only for the admin viewer.
+type aggregationRepository struct {
+	stories.Repository
+
+	kind             string
+	memberProfileIDs []string
+	records          []*stories.StoryWithChildren
+	listedProfileIDs []string
+}
+
+func (r *aggregationRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "acme", nil
+}
+
+func (r *aggregationRepository) GetProfileByID(
+	_ context.Context,
+	_ string,
+	id string,
+) (*profiles.Profile, error) {
+	return &profiles.Profile{ID: id, Kind: r.kind}, nil //nolint:exhaustruct
+}
+
+func (r *aggregationRepository) ListStoryAggregationMemberProfileIDs(
+	_ context.Context,
+	_ string,
+) ([]string, error) {
+	return r.memberProfileIDs, nil
+}
+
+func (r *aggregationRepository) ListAggregatedStoriesForViewer(
+	_ context.Context,
+	_ string,
+	profileIDs []string,
+	viewerUserID *string,
+	after *stories.FeedCursor,
+	limit int,
+) ([]*stories.StoryWithChildren, error) {
+	r.listedProfileIDs = profileIDs
+
+	result := []*stories.StoryWithChildren{}
+
+	for _, record := range r.records {
+		if !slices.Contains(profileIDs, *record.AuthorProfileID) {
+			continue
+		}
+
+		if record.Visibility != "public" && (viewerUserID == nil || *viewerUserID != "admin") {
+			continue
+		}
+
+		if after != nil && !record.PublishedAt.Before(after.PublishedAt) {
+			continue
+		}
+
+		result = append(result, record)
+		if len(result) == limit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func aggregatedStory(
+	id string,
+	authorProfileID string,
+	visibility string,
+	publishedAt time.Time,
+) *stories.StoryWithChildren {
+	return &stories.StoryWithChildren{ //nolint:exhaustruct
+		Story: &stories.Story{ //nolint:exhaustruct
+			ID:              id,
+			AuthorProfileID: &authorProfileID,
+			Visibility:      visibility,
+			PublishedAt:     &publishedAt,
+		},
+	}
+}
+
+func newAggregationRepository() *aggregationRepository {
+	now := time.Now().UTC()
+
+	return &aggregationRepository{ //nolint:exhaustruct
+		kind:             "organization",
+		memberProfileIDs: []string{"opted-in"},
+		records: []*stories.StoryWithChildren{
+			aggregatedStory("org-latest", "acme", "public", now.Add(-1*time.Hour)),
+			aggregatedStory("member-private", "opted-in", "private", now.Add(-2*time.Hour)),
+			aggregatedStory("member", "opted-in", "public", now.Add(-3*time.Hour)),
+			aggregatedStory("not-opted-in", "outsider", "public", now.Add(-4*time.Hour)),
+			aggregatedStory("org-oldest", "acme", "public", now.Add(-5*time.Hour)),
+		},
+	}
+}
+
+func storyIDs(records []*stories.StoryWithChildren) []string {
+	ids := make([]string, len(records))
+	for i, record := range records {
+		ids[i] = record.ID
+	}
+
+	return ids
+}
+
+func TestListAggregatedStories_MergesOptedInMembers(t *testing.T) {
+	t.Parallel()
+
+	repo := newAggregationRepository()
+	service := stories.NewService(nil, nil, repo, nil)
+
+	result, err := service.ListAggregatedStories(
+		t.Context(), "en", "acme", cursors.NewCursor(10, nil), nil,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"acme", "opted-in"}, repo.listedProfileIDs)
+	assert.Equal(t, []string{"org-latest", "member", "org-oldest"}, storyIDs(result.Data))
+	assert.Nil(t, result.CursorPtr)
+}
+
+func TestListAggregatedStories_Visibility(t *testing.T) {
+	t.Parallel()
+
+	repo := newAggregationRepository()
+	service := stories.NewService(nil, nil, repo, nil)
+	viewerUserID := "admin"
+
+	result, err := service.ListAggregatedStories(
+		t.Context(), "en", "acme", cursors.NewCursor(10, nil), &viewerUserID,
+	)
+	require.NoError(t, err)
+
+	assert.Equal(
+		t,
+		[]string{"org-latest", "member-private", "member", "org-oldest"},
+		storyIDs(result.Data),
+	)
+}
+
+func TestListAggregatedStories_Cursor(t *testing.T) {
+	t.Parallel()
+
+	repo := newAggregationRepository()
+	service := stories.NewService(nil, nil, repo, nil)
+
+	first, err := service.ListAggregatedStories(
+		t.Context(), "en", "acme", cursors.NewCursor(2, nil), nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org-latest", "member"}, storyIDs(first.Data))
+	require.NotNil(t, first.CursorPtr)
+
+	second, err := service.ListAggregatedStories(
+		t.Context(), "en", "acme", cursors.NewCursor(2, first.CursorPtr), nil,
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"org-oldest"}, storyIDs(second.Data))
+	assert.Nil(t, second.CursorPtr)
+}
+
+func TestListAggregatedStories_RequiresOrganization(t *testing.T) {
+	t.Parallel()
+
+	repo := newAggregationRepository()
+	repo.kind = "individual"
+	service := stories.NewService(nil, nil, repo, nil)
+
+	_, err := service.ListAggregatedStories(
+		t.Context(), "en", "acme", cursors.NewCursor(10, nil), nil,
+	)
+
+	require.ErrorIs(t, err, stories.ErrNotOrganization)
+	assert.Nil(t, repo.listedProfileIDs)
+}
@@ -0,0 +1,121 @@
+package stories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+var ErrInvalidFeedCursor = errors.New("invalid feed cursor")
+
+// FeedCursor is the keyset position in a story feed: the first publication
+// time and ID of the last story on the previous page.
+type FeedCursor struct {
+	PublishedAt time.Time
+	StoryID     string
+}
+
+// String encodes the cursor as "<unix microseconds>_<story id>".
+func (c *FeedCursor) String() string {
+	return strconv.FormatInt(c.PublishedAt.UnixMicro(), 10) + "_" + c.StoryID
+}
+
+// ParseFeedCursor decodes a cursor produced by FeedCursor.String.
+// An empty value means the first page and returns nil.
+func ParseFeedCursor(value string) (*FeedCursor, error) {
+	if value == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	micros, storyID, found := strings.Cut(value, "_")
+	if !found || storyID == "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFeedCursor, value)
+	}
+
+	unixMicro, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidFeedCursor, value)
+	}
+
+	return &FeedCursor{
+		PublishedAt: time.UnixMicro(unixMicro).UTC(),
+		StoryID:     storyID,
+	}, nil
+}
+
+// GetFollowedStoriesFeed returns the public stories authored by or published to
+// the profiles the user follows, newest first. Stories authored by or published
+// to a profile the user blocked are left out, so a page can hold fewer stories
+// than the limit. The returned cursor points past the last story read and is
+// nil on the last page.
+func (s *Service) GetFollowedStoriesFeed(
+	ctx context.Context,
+	localeCode string,
+	userID string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*StoryWithChildren], error) {
+	var offset string
+	if cursor.Offset != nil {
+		offset = *cursor.Offset
+	}
+
+	after, err := ParseFeedCursor(offset)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, err
+	}
+
+	records, err := s.repo.ListFollowedStoriesFeed(ctx, localeCode, userID, after, cursor.Limit)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, fmt.Errorf(
+			"%w: %w",
+			ErrFailedToListRecords,
+			err,
+		)
+	}
+
+	var nextCursor *string
+
+	if len(records) == cursor.Limit {
+		last := records[len(records)-1]
+		if last.PublishedAt != nil {
+			encoded := (&FeedCursor{PublishedAt: *last.PublishedAt, StoryID: last.ID}).String()
+			nextCursor = &encoded
+		}
+	}
+
+	blockedProfileIDs, err := s.repo.ListBlockedProfileIDs(ctx, userID)
+	if err != nil {
+		return cursors.Cursored[[]*StoryWithChildren]{}, fmt.Errorf(
+			"%w: %w",
+			ErrFailedToListRecords,
+			err,
+		)
+	}
+
+	if len(blockedProfileIDs) > 0 {
+		records = slices.DeleteFunc(records, func(record *StoryWithChildren) bool {
+			return isFromBlockedProfile(record, blockedProfileIDs)
+		})
+	}
+
+	return cursors.WrapResponseWithCursor(records, nextCursor), nil
+}
+
+// isFromBlockedProfile reports whether the story is authored by or published
+// to one of the blocked profiles.
+func isFromBlockedProfile(record *StoryWithChildren, blockedProfileIDs []string) bool {
+	if record.AuthorProfileID != nil && slices.Contains(blockedProfileIDs, *record.AuthorProfileID) {
+		return true
+	}
+
+	return slices.ContainsFunc(record.Publications, func(profile *profiles.Profile) bool {
+		return profile != nil && slices.Contains(blockedProfileIDs, profile.ID)
+	})
+}
@@ -0,0 +1,106 @@
+package stories_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/stories"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedCursor_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	original := &stories.FeedCursor{
+		PublishedAt: time.Date(2026, 3, 14, 15, 9, 26, 535_000, time.UTC),
+		StoryID:     "01JQ0000000000000000000000",
+	}
+
+	parsed, err := stories.ParseFeedCursor(original.String())
+	require.NoError(t, err)
+	require.NotNil(t, parsed)
+
+	assert.True(t, original.PublishedAt.Equal(parsed.PublishedAt))
+	assert.Equal(t, original.StoryID, parsed.StoryID)
+}
+
+func TestParseFeedCursor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty value is the first page", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := stories.ParseFeedCursor("")
+		require.NoError(t, err)
+		assert.Nil(t, parsed)
+	})
+
+	for _, value := range []string{"garbage", "123_", "abc_01JQ0000000000000000000000"} {
+		t.Run("rejects "+value, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := stories.ParseFeedCursor(value)
+			require.ErrorIs(t, err, stories.ErrInvalidFeedCursor)
+		})
+	}
+}
+
+// blockingFeedRepository serves a fixed followed feed page and block list.
+type blockingFeedRepository struct {
+	stories.Repository
+
+	records           []*stories.StoryWithChildren
+	blockedProfileIDs []string
+}
+
+func (r *blockingFeedRepository) ListFollowedStoriesFeed(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ *stories.FeedCursor,
+	limit int,
+) ([]*stories.StoryWithChildren, error) {
+	return r.records[:min(limit, len(r.records))], nil
+}
+
+func (r *blockingFeedRepository) ListBlockedProfileIDs(_ context.Context, _ string) ([]string, error) {
+	return r.blockedProfileIDs, nil
+}
+
+func TestGetFollowedStoriesFeed_LeavesOutBlockedProfiles(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	publishedToBlocked := aggregatedStory("published-to-blocked", "friend", "public", now.Add(-2*time.Hour))
+	publishedToBlocked.Publications = []*profiles.Profile{
+		{ID: "friend"},  //nolint:exhaustruct
+		{ID: "blocked"}, //nolint:exhaustruct
+	}
+
+	repo := &blockingFeedRepository{
+		records: []*stories.StoryWithChildren{
+			aggregatedStory("kept", "friend", "public", now.Add(-1*time.Hour)),
+			publishedToBlocked,
+			aggregatedStory("authored-by-blocked", "blocked", "public", now.Add(-3*time.Hour)),
+		},
+		blockedProfileIDs: []string{"blocked"},
+	}
+	service := stories.NewService(nil, nil, repo, nil)
+
+	result, err := service.GetFollowedStoriesFeed(t.Context(), "en", "user", cursors.NewCursor(3, nil))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"kept"}, storyIDs(result.Data))
+
+	// The cursor still moves past the stories that were left out.
+	require.NotNil(t, result.CursorPtr)
+	assert.Equal(t, (&stories.FeedCursor{
+		PublishedAt: now.Add(-3 * time.Hour),
+		StoryID:     "authored-by-blocked",
+	}).String(), *result.CursorPtr)
+}
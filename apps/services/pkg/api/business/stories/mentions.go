@@ -0,0 +1,43 @@
+package stories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_mentions"
+)
+
+// SetMentionSyncer makes story saves refresh the "@slug" mentions of the saved
+// content.
+func (s *Service) SetMentionSyncer(syncer MentionSyncer) {
+	s.mentionSyncer = syncer
+}
+
+// syncStoryMentions refreshes the stored mentions of a saved story translation.
+// Failures are logged and never fail the save.
+func (s *Service) syncStoryMentions(
+	ctx context.Context,
+	userID string,
+	storyID string,
+	localeCode string,
+	content string,
+) {
+	if s.mentionSyncer == nil {
+		return
+	}
+
+	err := s.mentionSyncer.SyncMentions(
+		ctx,
+		userID,
+		profile_mentions.SourceKindStory,
+		storyID,
+		localeCode,
+		content,
+	)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to sync story mentions",
+			slog.String("story_id", storyID),
+			slog.String("locale", localeCode),
+			slog.String("error", err.Error()))
+	}
+}
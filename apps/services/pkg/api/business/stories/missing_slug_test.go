@@ -0,0 +1,62 @@
+package stories_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/stories"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+)
+
+// missingSlugRepository resolves every profile slug to the empty ID.
+type missingSlugRepository struct {
+	stories.Repository
+}
+
+func (r *missingSlugRepository) GetProfileIDBySlug(_ context.Context, _ string) (string, error) {
+	return "", nil
+}
+
+func TestService_MissingProfileSlugReturnsProfileNotFound(t *testing.T) {
+	t.Parallel()
+
+	const slug = "does-not-exist"
+
+	service := stories.NewService(nil, nil, &missingSlugRepository{}, nil) //nolint:exhaustruct
+
+	tests := map[string]func(ctx context.Context) error{
+		"ListByPublicationProfileSlug": func(ctx context.Context) error {
+			_, err := service.ListByPublicationProfileSlug(ctx, "en", slug, cursors.NewCursor(0, nil))
+
+			return err
+		},
+		"ListByAuthorProfileSlug": func(ctx context.Context) error {
+			_, err := service.ListByAuthorProfileSlug(ctx, "en", slug, cursors.NewCursor(0, nil))
+
+			return err
+		},
+		"ListByPublicationProfileSlugForViewer": func(ctx context.Context) error {
+			_, err := service.ListByPublicationProfileSlugForViewer(
+				ctx, "en", slug, cursors.NewCursor(0, nil), nil,
+			)
+
+			return err
+		},
+		"ListByAuthorProfileSlugForViewer": func(ctx context.Context) error {
+			_, err := service.ListByAuthorProfileSlugForViewer(
+				ctx, "en", slug, cursors.NewCursor(0, nil), nil,
+			)
+
+			return err
+		},
+	}
+
+	for name, call := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, call(t.Context()), stories.ErrProfileNotFound)
+		})
+	}
+}
@@ -23,6 +23,7 @@ var (
 	ErrFailedToRemoveRecord  = errors.New("failed to remove record")
 	ErrUnauthorized          = errors.New("unauthorized")
 	ErrStoryNotFound         = errors.New("story not found")
+	ErrProfileNotFound       = errors.New("profile not found")
 	ErrInvalidSlugPrefix     = errors.New("slug must start with YYYYMMDD of publish date")
 	ErrInvalidURI            = errors.New("invalid URI")
 	ErrInvalidURIPrefix      = errors.New("URI must start with allowed prefix")
@@ -45,6 +46,9 @@ var (
 type Config struct {
 	// AllowedURIPrefixes is a comma-separated list of allowed URI prefixes.
 	AllowedURIPrefixes string `conf:"allowed_uri_prefixes" default:"https://objects.aya.is/"`
+
+	// AIMaxInputChars is the character budget for content sent to AI translation.
+	AIMaxInputChars int `conf:"ai_max_input_chars" default:"60000"`
 }
 
 // GetAllowedURIPrefixes returns the allowed URI prefixes as a slice.
@@ -217,6 +221,23 @@ type Repository interface { //nolint:interfacebloat
 		cursor *cursors.Cursor,
 		viewerUserID *string,
 	) (cursors.Cursored[[]*StoryWithChildren], error)
+	ListFollowedStoriesFeed(
+		ctx context.Context,
+		localeCode string,
+		viewerUserID string,
+		after *FeedCursor,
+		limit int,
+	) ([]*StoryWithChildren, error)
+	ListBlockedProfileIDs(ctx context.Context, userID string) ([]string, error)
+	ListStoryAggregationMemberProfileIDs(ctx context.Context, profileID string) ([]string, error)
+	ListAggregatedStoriesForViewer(
+		ctx context.Context,
+		localeCode string,
+		profileIDs []string,
+		viewerUserID *string,
+		after *FeedCursor,
+		limit int,
+	) ([]*StoryWithChildren, error)
 	// Story CRUD methods
 	InsertStory(
 		ctx context.Context,
@@ -343,12 +364,26 @@ type Repository interface { //nolint:interfacebloat
 	) error
 }
 
+// MentionSyncer refreshes the "@slug" mentions stored for saved content.
+// Implemented by profile_mentions.Service.
+type MentionSyncer interface {
+	SyncMentions(
+		ctx context.Context,
+		actorUserID string,
+		sourceKind string,
+		sourceID string,
+		localeCode string,
+		content string,
+	) error
+}
+
 type Service struct {
-	logger       *logfx.Logger
-	config       *Config
-	repo         Repository
-	auditService *events.AuditService
-	idGenerator  RecordIDGenerator
+	logger        *logfx.Logger
+	config        *Config
+	repo          Repository
+	auditService  *events.AuditService
+	idGenerator   RecordIDGenerator
+	mentionSyncer MentionSyncer
 }
 
 func NewService(
@@ -358,11 +393,12 @@ func NewService(
 	auditService *events.AuditService,
 ) *Service {
 	return &Service{
-		logger:       logger,
-		config:       config,
-		repo:         repo,
-		auditService: auditService,
-		idGenerator:  DefaultIDGenerator,
+		logger:        logger,
+		config:        config,
+		repo:          repo,
+		auditService:  auditService,
+		idGenerator:   DefaultIDGenerator,
+		mentionSyncer: nil,
 	}
 }
 
@@ -632,6 +668,10 @@ func (s *Service) ListByPublicationProfileSlug(
 		)
 	}
 
+	if publicationProfileID == "" {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrProfileNotFound
+	}
+
 	cursor.Filters["publication_profile_id"] = publicationProfileID
 
 	records, err := s.repo.ListStoriesOfPublication(
@@ -666,6 +706,10 @@ func (s *Service) ListByAuthorProfileSlug(
 		)
 	}
 
+	if authorProfileID == "" {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrProfileNotFound
+	}
+
 	records, err := s.repo.ListStoriesByAuthorProfileID(
 		ctx,
 		localeCode,
@@ -700,6 +744,10 @@ func (s *Service) ListByPublicationProfileSlugForViewer(
 		)
 	}
 
+	if publicationProfileID == "" {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrProfileNotFound
+	}
+
 	cursor.Filters["publication_profile_id"] = publicationProfileID
 
 	records, err := s.repo.ListStoriesOfPublicationForViewer(
@@ -736,6 +784,10 @@ func (s *Service) ListByAuthorProfileSlugForViewer(
 		)
 	}
 
+	if authorProfileID == "" {
+		return cursors.Cursored[[]*StoryWithChildren]{}, ErrProfileNotFound
+	}
+
 	records, err := s.repo.ListStoriesByAuthorProfileIDForViewer(
 		ctx,
 		localeCode,
@@ -859,6 +911,8 @@ func (s *Service) Create(
 		return nil, err
 	}
 
+	s.syncStoryMentions(ctx, userID, storyID, localeCode, content)
+
 	publishErr := s.publishAndAuditCreate(
 		ctx, storyID, userID, slug, kind, authorProfileSlug, publishToProfileSlugs,
 	)
@@ -885,6 +939,10 @@ func (s *Service) resolveAuthorAndFeatures(
 		)
 	}
 
+	if authorProfileID == "" {
+		return "", false, ErrProfileNotFound
+	}
+
 	featDiscussions, featErr := s.resolveFeatDiscussions(
 		ctx, localeCode, authorProfileID, featDiscussionsOverride,
 	)
@@ -1067,6 +1125,10 @@ func (s *Service) createPublicationsForProfiles(
 			return fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
 		}
 
+		if profileID == "" {
+			return ErrProfileNotFound
+		}
+
 		membershipKind, err := s.repo.GetUserMembershipForProfile(ctx, userID, profileID)
 		if err != nil {
 			return fmt.Errorf(
@@ -1323,6 +1385,8 @@ func (s *Service) UpdateTranslation(
 		)
 	}
 
+	s.syncStoryMentions(ctx, userID, storyID, localeCode, content)
+
 	s.auditService.Record(ctx, events.AuditParams{
 		EventType:  events.StoryTranslationUpdated,
 		EntityType: "story",
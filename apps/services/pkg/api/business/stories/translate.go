@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/eser/aya.is/services/pkg/api/business/events"
 	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
@@ -15,8 +16,13 @@ var (
 	ErrNoIndividualProfile           = errors.New("user has no individual profile")
 	ErrFailedToGetSourceContent      = errors.New("failed to get source content")
 	ErrFailedToSaveTranslatedContent = errors.New("failed to save translated content")
+	ErrContentTooLong                = errors.New("content exceeds the AI input length limit")
 )
 
+// DefaultAIMaxInputChars is the character budget for content sent to an AI model
+// when the configuration doesn't set one.
+const DefaultAIMaxInputChars = 60000
+
 // ContentTranslator defines the interface for AI-powered content translation.
 // Implementations live in the adapter layer (e.g., HTTP adapter using aifx).
 type ContentTranslator interface {
@@ -37,7 +43,7 @@ type AutoTranslateStoryParams struct {
 }
 
 // AutoTranslateStory orchestrates the full auto-translate workflow:
-// check permissions, deduct points, get source content, translate via AI, and save.
+// check permissions, get source content, check its length, deduct points, translate via AI, and save.
 func (s *Service) AutoTranslateStory(
 	ctx context.Context,
 	params AutoTranslateStoryParams,
@@ -49,6 +55,21 @@ func (s *Service) AutoTranslateStory(
 		return authErr
 	}
 
+	title, summary, content, err := s.GetTranslationContent(
+		ctx,
+		params.StoryID,
+		params.SourceLocale,
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToGetSourceContent, err)
+	}
+
+	// Reject content the model can't handle before charging for it
+	err = s.checkAIInputLength(title, summary, content)
+	if err != nil {
+		return err
+	}
+
 	// Deduct points for auto-translation
 	spendErr := s.deductTranslationPoints(ctx, pointsService, params)
 	if spendErr != nil {
@@ -56,7 +77,27 @@ func (s *Service) AutoTranslateStory(
 	}
 
 	// Translate and save
-	return s.translateAndSave(ctx, translator, params)
+	return s.translateAndSave(ctx, translator, params, title, summary, content)
+}
+
+// checkAIInputLength fails with ErrContentTooLong when the parts together are
+// longer than the configured AI input budget.
+func (s *Service) checkAIInputLength(parts ...string) error {
+	limit := DefaultAIMaxInputChars
+	if s.config != nil && s.config.AIMaxInputChars > 0 {
+		limit = s.config.AIMaxInputChars
+	}
+
+	length := 0
+	for _, part := range parts {
+		length += utf8.RuneCountInString(part)
+	}
+
+	if length > limit {
+		return fmt.Errorf("%w: %d characters, limit is %d", ErrContentTooLong, length, limit)
+	}
+
+	return nil
 }
 
 // authorizeStoryEdit checks that a user can edit the given story.
@@ -97,21 +138,13 @@ func (s *Service) deductTranslationPoints(
 	return err //nolint:wrapcheck
 }
 
-// translateAndSave gets source content, translates via AI, and saves the result.
+// translateAndSave translates the source content via AI and saves the result.
 func (s *Service) translateAndSave(
 	ctx context.Context,
 	translator ContentTranslator,
 	params AutoTranslateStoryParams,
+	title, summary, content string,
 ) error {
-	title, summary, content, err := s.GetTranslationContent(
-		ctx,
-		params.StoryID,
-		params.SourceLocale,
-	)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrFailedToGetSourceContent, err)
-	}
-
 	translatedTitle, translatedSummary, translatedContent, err := translator.Translate(
 		ctx,
 		params.SourceLocale,
@@ -0,0 +1,106 @@
+package stories_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/profile_points"
+	"github.com/eser/aya.is/services/pkg/api/business/stories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const translateCapChars = 100
+
+type translateStoryRepository struct {
+	stories.Repository
+
+	content string
+}
+
+func (r *translateStoryRepository) GetStoryOwnershipForUser(
+	_ context.Context,
+	_ string,
+	storyID string,
+) (*stories.StoryOwnership, error) {
+	return &stories.StoryOwnership{ID: storyID, CanEdit: true}, nil //nolint:exhaustruct
+}
+
+func (r *translateStoryRepository) GetStoryForEdit(
+	_ context.Context,
+	localeCode string,
+	id string,
+) (*stories.StoryForEdit, error) {
+	return &stories.StoryForEdit{ //nolint:exhaustruct
+		ID:         id,
+		LocaleCode: localeCode,
+		Title:      "Title",
+		Summary:    "Summary",
+		Content:    r.content,
+	}, nil
+}
+
+// emptyBalanceRepository has no points, so reaching it ends the workflow with
+// ErrInsufficientPoints before any model call.
+type emptyBalanceRepository struct {
+	profile_points.Repository
+
+	balanceChecks int
+}
+
+func (r *emptyBalanceRepository) GetBalance(_ context.Context, _ string) (uint64, error) {
+	r.balanceChecks++
+
+	return 0, nil
+}
+
+func autoTranslateStoryWithContent(
+	t *testing.T,
+	content string,
+) (*emptyBalanceRepository, error) {
+	t.Helper()
+
+	config := &stories.Config{AIMaxInputChars: translateCapChars}                                   //nolint:exhaustruct
+	service := stories.NewService(nil, config, &translateStoryRepository{content: content}, nil)    //nolint:exhaustruct
+	pointsRepo := &emptyBalanceRepository{}                                                         //nolint:exhaustruct
+	pointsService := profile_points.NewService(nil, &profile_points.Config{}, pointsRepo, nil, nil) //nolint:exhaustruct
+
+	err := service.AutoTranslateStory(
+		t.Context(),
+		stories.AutoTranslateStoryParams{
+			UserID:              "user-1",
+			IndividualProfileID: "profile-1",
+			StoryID:             "story-1",
+			SourceLocale:        "en",
+			TargetLocale:        "tr",
+		},
+		nil,
+		pointsService,
+	)
+
+	return pointsRepo, err
+}
+
+func TestAutoTranslateStory_ContentJustUnderCap(t *testing.T) {
+	t.Parallel()
+
+	// title (5) + summary (7) + content fills the cap exactly
+	content := strings.Repeat("ç", translateCapChars-len("Title")-len("Summary"))
+
+	pointsRepo, err := autoTranslateStoryWithContent(t, content)
+
+	require.ErrorIs(t, err, profile_points.ErrInsufficientPoints)
+	assert.Equal(t, 1, pointsRepo.balanceChecks)
+}
+
+func TestAutoTranslateStory_ContentOverCap(t *testing.T) {
+	t.Parallel()
+
+	content := strings.Repeat("ç", translateCapChars-len("Title")-len("Summary")+1)
+
+	pointsRepo, err := autoTranslateStoryWithContent(t, content)
+
+	require.ErrorIs(t, err, stories.ErrContentTooLong)
+	assert.Zero(t, pointsRepo.balanceChecks)
+}
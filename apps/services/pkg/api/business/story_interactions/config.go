@@ -0,0 +1,14 @@
+package story_interactions
+
+import "time"
+
+// Config holds configuration for the story interactions module.
+type Config struct {
+	// ViewDedupWindow is the period during which repeated views of the same story
+	// by the same viewer (user or IP) are counted only once.
+	ViewDedupWindow time.Duration `conf:"view_dedup_window" default:"30m"`
+	// ReactionRateLimit is the maximum number of reaction toggles a profile can
+	// make on a single story within ReactionRateWindow.
+	ReactionRateLimit  int           `conf:"reaction_rate_limit"  default:"10"`
+	ReactionRateWindow time.Duration `conf:"reaction_rate_window" default:"1m"`
+}
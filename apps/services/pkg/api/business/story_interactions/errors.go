@@ -10,4 +10,6 @@ var (
 	ErrFailedToCountInteractions = errors.New("failed to count interactions")
 	ErrInteractionNotFound       = errors.New("interaction not found")
 	ErrInvalidInteractionKind    = errors.New("invalid interaction kind")
+	ErrFailedToRecordView        = errors.New("failed to record view")
+	ErrRateLimitExceeded         = errors.New("interaction rate limit exceeded")
 )
@@ -0,0 +1,151 @@
+package story_interactions
+
+import (
+	"sync"
+	"time"
+)
+
+const guardCleanupInterval = time.Minute
+
+// InteractionGuard is a short-lived in-memory store of recent interactions.
+// It deduplicates views within a window and rate limits reaction toggles
+// so that interaction counts reflect genuine engagement.
+type InteractionGuard struct {
+	views     map[string]time.Time
+	reactions map[string][]time.Time
+	config    *Config
+	done      chan struct{}
+	closeOnce sync.Once
+	mu        sync.Mutex
+}
+
+// NewInteractionGuard creates a new interaction guard with automatic cleanup.
+// Close stops the cleanup.
+func NewInteractionGuard(config *Config) *InteractionGuard {
+	guard := &InteractionGuard{ //nolint:exhaustruct // mu and closeOnce zero values are valid
+		views:     make(map[string]time.Time),
+		reactions: make(map[string][]time.Time),
+		config:    config,
+		done:      make(chan struct{}),
+	}
+
+	go guard.cleanupExpired()
+
+	return guard
+}
+
+// ShouldCountView reports whether a view of the story by the viewer should be counted.
+// A view is counted once per (viewer, story) pair within the dedup window.
+func (g *InteractionGuard) ShouldCountView(viewerKey string, storyID string, now time.Time) bool {
+	key := viewerKey + "|" + storyID
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	lastCountedAt, ok := g.views[key]
+	if ok && now.Sub(lastCountedAt) < g.config.ViewDedupWindow {
+		return false
+	}
+
+	g.views[key] = now
+
+	return true
+}
+
+// ForgetView undoes a view marked by ShouldCountView at countedAt, so the
+// viewer's next view is counted again. It is used when counting the view fails.
+func (g *InteractionGuard) ForgetView(viewerKey string, storyID string, countedAt time.Time) {
+	key := viewerKey + "|" + storyID
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if lastCountedAt, ok := g.views[key]; ok && lastCountedAt.Equal(countedAt) {
+		delete(g.views, key)
+	}
+}
+
+// Close stops the periodic cleanup of expired entries. It is safe to call
+// more than once.
+func (g *InteractionGuard) Close() {
+	g.closeOnce.Do(func() {
+		close(g.done)
+	})
+}
+
+// AllowReaction reports whether the profile may toggle a reaction on the story,
+// recording the attempt when allowed.
+func (g *InteractionGuard) AllowReaction(profileID string, storyID string, now time.Time) bool {
+	if g.config.ReactionRateLimit <= 0 {
+		return true
+	}
+
+	key := profileID + "|" + storyID
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	recent := g.recentReactions(key, now)
+	if len(recent) >= g.config.ReactionRateLimit {
+		g.reactions[key] = recent
+
+		return false
+	}
+
+	g.reactions[key] = append(recent, now)
+
+	return true
+}
+
+// recentReactions returns the reaction timestamps for the key that are still within the window.
+// Must be called with the lock held.
+func (g *InteractionGuard) recentReactions(key string, now time.Time) []time.Time {
+	timestamps := g.reactions[key]
+	recent := timestamps[:0]
+
+	for _, timestamp := range timestamps {
+		if now.Sub(timestamp) < g.config.ReactionRateWindow {
+			recent = append(recent, timestamp)
+		}
+	}
+
+	return recent
+}
+
+// cleanupExpired periodically removes expired entries.
+func (g *InteractionGuard) cleanupExpired() {
+	ticker := time.NewTicker(guardCleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-g.done:
+			return
+		case now := <-ticker.C:
+			g.cleanup(now)
+		}
+	}
+}
+
+// cleanup removes entries that are outside their windows at the given time.
+func (g *InteractionGuard) cleanup(now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for key, lastCountedAt := range g.views {
+		if now.Sub(lastCountedAt) >= g.config.ViewDedupWindow {
+			delete(g.views, key)
+		}
+	}
+
+	for key := range g.reactions {
+		recent := g.recentReactions(key, now)
+		if len(recent) == 0 {
+			delete(g.reactions, key)
+
+			continue
+		}
+
+		g.reactions[key] = recent
+	}
+}
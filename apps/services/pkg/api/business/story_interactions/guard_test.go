@@ -0,0 +1,122 @@
+package story_interactions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/story_interactions"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestGuard(t *testing.T) *story_interactions.InteractionGuard {
+	t.Helper()
+
+	guard := story_interactions.NewInteractionGuard(&story_interactions.Config{
+		ViewDedupWindow:    30 * time.Minute,
+		ReactionRateLimit:  3,
+		ReactionRateWindow: time.Minute,
+	})
+	t.Cleanup(guard.Close)
+
+	return guard
+}
+
+func TestInteractionGuard_ShouldCountView(t *testing.T) {
+	t.Parallel()
+
+	t.Run("dedups views within the window", func(t *testing.T) {
+		t.Parallel()
+
+		guard := newTestGuard(t)
+		now := time.Now()
+
+		assert.True(t, guard.ShouldCountView("ip:abc", "story-1", now))
+		assert.False(t, guard.ShouldCountView("ip:abc", "story-1", now.Add(time.Minute)))
+		assert.False(t, guard.ShouldCountView("ip:abc", "story-1", now.Add(29*time.Minute)))
+	})
+
+	t.Run("counts views again across the window", func(t *testing.T) {
+		t.Parallel()
+
+		guard := newTestGuard(t)
+		now := time.Now()
+
+		assert.True(t, guard.ShouldCountView("user:1", "story-1", now))
+		assert.True(t, guard.ShouldCountView("user:1", "story-1", now.Add(30*time.Minute)))
+		assert.False(t, guard.ShouldCountView("user:1", "story-1", now.Add(31*time.Minute)))
+	})
+
+	t.Run("tracks viewers and stories independently", func(t *testing.T) {
+		t.Parallel()
+
+		guard := newTestGuard(t)
+		now := time.Now()
+
+		assert.True(t, guard.ShouldCountView("ip:abc", "story-1", now))
+		assert.True(t, guard.ShouldCountView("ip:def", "story-1", now))
+		assert.True(t, guard.ShouldCountView("ip:abc", "story-2", now))
+	})
+
+	t.Run("counts a forgotten view again", func(t *testing.T) {
+		t.Parallel()
+
+		guard := newTestGuard(t)
+		now := time.Now()
+
+		assert.True(t, guard.ShouldCountView("ip:abc", "story-1", now))
+		guard.ForgetView("ip:abc", "story-1", now)
+		assert.True(t, guard.ShouldCountView("ip:abc", "story-1", now.Add(time.Minute)))
+
+		// Forgetting an older mark keeps the current one.
+		guard.ForgetView("ip:abc", "story-1", now)
+		assert.False(t, guard.ShouldCountView("ip:abc", "story-1", now.Add(2*time.Minute)))
+	})
+}
+
+func TestInteractionGuard_AllowReaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limits toggles within the window", func(t *testing.T) {
+		t.Parallel()
+
+		guard := newTestGuard(t)
+		now := time.Now()
+
+		assert.True(t, guard.AllowReaction("profile-1", "story-1", now))
+		assert.True(t, guard.AllowReaction("profile-1", "story-1", now.Add(time.Second)))
+		assert.True(t, guard.AllowReaction("profile-1", "story-1", now.Add(2*time.Second)))
+		assert.False(t, guard.AllowReaction("profile-1", "story-1", now.Add(3*time.Second)))
+
+		// Other stories are not affected
+		assert.True(t, guard.AllowReaction("profile-1", "story-2", now.Add(3*time.Second)))
+	})
+
+	t.Run("allows toggles again after the window", func(t *testing.T) {
+		t.Parallel()
+
+		guard := newTestGuard(t)
+		now := time.Now()
+
+		for i := range 3 {
+			assert.True(t, guard.AllowReaction("profile-1", "story-1", now.Add(time.Duration(i)*time.Second)))
+		}
+
+		assert.False(t, guard.AllowReaction("profile-1", "story-1", now.Add(30*time.Second)))
+		assert.True(t, guard.AllowReaction("profile-1", "story-1", now.Add(61*time.Second)))
+	})
+
+	t.Run("disabled when limit is zero", func(t *testing.T) {
+		t.Parallel()
+
+		guard := story_interactions.NewInteractionGuard(&story_interactions.Config{
+			ViewDedupWindow:    time.Minute,
+			ReactionRateLimit:  0,
+			ReactionRateWindow: time.Minute,
+		})
+		t.Cleanup(guard.Close)
+
+		for range 100 {
+			assert.True(t, guard.AllowReaction("profile-1", "story-1", time.Now()))
+		}
+	})
+}
@@ -58,4 +58,10 @@ type Repository interface {
 		ctx context.Context,
 		storyID string,
 	) ([]*InteractionCount, error)
+
+	// IncrementViewCount increments the view counter of a story.
+	IncrementViewCount(ctx context.Context, storyID string) error
+
+	// GetViewCount returns the view counter of a story.
+	GetViewCount(ctx context.Context, storyID string) (int64, error)
 }
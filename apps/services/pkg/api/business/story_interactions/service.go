@@ -3,6 +3,7 @@ package story_interactions
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/eser/aya.is/services/pkg/ajan/lib"
 	"github.com/eser/aya.is/services/pkg/ajan/logfx"
@@ -16,26 +17,61 @@ func DefaultIDGenerator() string {
 // Service provides story interaction operations.
 type Service struct {
 	logger       *logfx.Logger
+	config       *Config
 	repo         Repository
 	idGenerator  IDGenerator
 	auditService *events.AuditService
+	guard        *InteractionGuard
 }
 
 // NewService creates a new story interactions service.
 func NewService(
 	logger *logfx.Logger,
+	config *Config,
 	repo Repository,
 	idGenerator IDGenerator,
 	auditService *events.AuditService,
 ) *Service {
 	return &Service{
 		logger:       logger,
+		config:       config,
 		repo:         repo,
 		idGenerator:  idGenerator,
 		auditService: auditService,
+		guard:        NewInteractionGuard(config),
 	}
 }
 
+// RecordView counts a view of a story by the given viewer. The viewer key
+// identifies a user or an anonymous client (e.g. a hashed IP). Repeated views
+// by the same viewer within the dedup window are ignored.
+// Returns true if the view was counted.
+func (s *Service) RecordView(
+	ctx context.Context,
+	storyID string,
+	viewerKey string,
+) (bool, error) {
+	now := time.Now()
+
+	if !s.guard.ShouldCountView(viewerKey, storyID, now) {
+		return false, nil
+	}
+
+	err := s.repo.IncrementViewCount(ctx, storyID)
+	if err != nil {
+		s.guard.ForgetView(viewerKey, storyID, now)
+
+		return false, fmt.Errorf("%w: %w", ErrFailedToRecordView, err)
+	}
+
+	return true, nil
+}
+
+// Close releases the in-memory interaction guard.
+func (s *Service) Close() {
+	s.guard.Close()
+}
+
 // SetInteraction upserts a generic (non-RSVP) interaction.
 func (s *Service) SetInteraction(
 	ctx context.Context,
@@ -43,6 +79,14 @@ func (s *Service) SetInteraction(
 	profileID string,
 	kind string,
 ) (*StoryInteraction, error) {
+	if InteractionKind(kind) == KindView {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidInteractionKind, kind)
+	}
+
+	if !s.guard.AllowReaction(profileID, storyID, time.Now()) {
+		return nil, ErrRateLimitExceeded
+	}
+
 	id := s.idGenerator()
 
 	interaction, err := s.repo.UpsertInteraction(ctx, id, storyID, profileID, kind)
@@ -79,6 +123,10 @@ func (s *Service) SetRSVP(
 		return nil, fmt.Errorf("%w: %s", ErrInvalidInteractionKind, kind)
 	}
 
+	if !s.guard.AllowReaction(profileID, storyID, time.Now()) {
+		return nil, ErrRateLimitExceeded
+	}
+
 	// Remove existing RSVP interactions for mutual exclusivity
 	_, err := s.repo.RemoveInteractionsByKinds(ctx, storyID, profileID, RSVPKindsCSV())
 	if err != nil {
@@ -117,6 +165,10 @@ func (s *Service) RemoveInteraction(
 	profileID string,
 	kind string,
 ) error {
+	if !s.guard.AllowReaction(profileID, storyID, time.Now()) {
+		return ErrRateLimitExceeded
+	}
+
 	_, err := s.repo.RemoveInteraction(ctx, storyID, profileID, kind)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrFailedToRemoveInteraction, err)
@@ -183,7 +235,8 @@ func (s *Service) ListInteractions(
 	return interactions, nil
 }
 
-// CountInteractions returns interaction counts grouped by kind for a story.
+// CountInteractions returns interaction counts grouped by kind for a story,
+// including the deduplicated view count.
 func (s *Service) CountInteractions(
 	ctx context.Context,
 	storyID string,
@@ -193,5 +246,14 @@ func (s *Service) CountInteractions(
 		return nil, fmt.Errorf("%w: %w", ErrFailedToCountInteractions, err)
 	}
 
+	viewCount, err := s.repo.GetViewCount(ctx, storyID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCountInteractions, err)
+	}
+
+	if viewCount > 0 {
+		counts = append(counts, &InteractionCount{Kind: string(KindView), Count: viewCount})
+	}
+
 	return counts, nil
 }
@@ -0,0 +1,61 @@
+package story_interactions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/story_interactions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errViewStore = errors.New("view store unavailable")
+
+// viewCountRepository counts views and fails while failing is set.
+type viewCountRepository struct {
+	story_interactions.Repository
+
+	failing bool
+	views   int
+}
+
+func (r *viewCountRepository) IncrementViewCount(_ context.Context, _ string) error {
+	if r.failing {
+		return errViewStore
+	}
+
+	r.views++
+
+	return nil
+}
+
+func TestRecordView_CountsAgainAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	repo := &viewCountRepository{failing: true} //nolint:exhaustruct
+	service := story_interactions.NewService(
+		nil,
+		&story_interactions.Config{ViewDedupWindow: time.Hour}, //nolint:exhaustruct
+		repo,
+		func() string { return "interaction" },
+		nil,
+	)
+	t.Cleanup(service.Close)
+
+	counted, err := service.RecordView(t.Context(), "story-1", "ip:abc")
+	require.ErrorIs(t, err, story_interactions.ErrFailedToRecordView)
+	assert.False(t, counted)
+
+	repo.failing = false
+
+	counted, err = service.RecordView(t.Context(), "story-1", "ip:abc")
+	require.NoError(t, err)
+	assert.True(t, counted)
+
+	counted, err = service.RecordView(t.Context(), "story-1", "ip:abc")
+	require.NoError(t, err)
+	assert.False(t, counted)
+	assert.Equal(t, 1, repo.views)
+}
@@ -15,6 +15,10 @@ const (
 	KindNotAttending InteractionKind = "not_attending"
 )
 
+// KindView is the interaction kind used for story views. Views are counted
+// separately from profile interactions and may come from anonymous viewers.
+const KindView InteractionKind = "view"
+
 // GetRSVPKinds returns all RSVP-related kinds for mutual exclusivity enforcement.
 func GetRSVPKinds() []InteractionKind {
 	return []InteractionKind{KindAttending, KindInterested, KindNotAttending}
@@ -0,0 +1,28 @@
+package uploads
+
+import (
+	"context"
+	"io"
+)
+
+// ScanResult is the verdict of a Scanner on an uploaded file.
+type ScanResult struct {
+	// Threat names what the file was flagged for. Empty when the file is clean.
+	Threat  string
+	Flagged bool
+}
+
+// Scanner inspects uploaded files for viruses and malware before they are
+// finalized. Implementations must honor context cancellation: the service gives
+// each scan a deadline and treats a scan that runs past it as failed.
+type Scanner interface {
+	Scan(ctx context.Context, key string, contentType string, content io.Reader) (*ScanResult, error)
+}
+
+// NoopScanner accepts every file. It is used when no scanner is configured.
+type NoopScanner struct{}
+
+// Scan reports every file as clean without reading it.
+func (NoopScanner) Scan(_ context.Context, _ string, _ string, _ io.Reader) (*ScanResult, error) {
+	return &ScanResult{Threat: "", Flagged: false}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -22,6 +24,11 @@ var (
 	ErrInvalidContentType   = errors.New("invalid content type")
 	ErrFailedToGenerateURL  = errors.New("failed to generate presigned URL")
 	ErrFailedToRemoveObject = errors.New("failed to remove object")
+	ErrInvalidUploadKey     = errors.New("invalid upload key")
+	ErrUploadNotFound       = errors.New("upload not found")
+	ErrUploadTooLarge       = errors.New("upload is too large to scan")
+	ErrUploadRejected       = errors.New("upload was flagged by the scanner")
+	ErrUploadScanFailed     = errors.New("upload could not be scanned")
 )
 
 // allowedContentTypes maps purposes to their allowed MIME types.
@@ -48,14 +55,27 @@ var allowedContentTypes = map[Purpose][]string{ //nolint:gochecknoglobals
 // Service handles upload operations.
 type Service struct {
 	logger        *logfx.Logger
+	config        *Config
 	storageClient StorageClient
+	scanner       Scanner
 }
 
-// NewService creates a new upload service.
-func NewService(logger *logfx.Logger, storageClient StorageClient) *Service {
+// NewService creates a new upload service. A nil scanner accepts every upload.
+func NewService(
+	logger *logfx.Logger,
+	config *Config,
+	storageClient StorageClient,
+	scanner Scanner,
+) *Service {
+	if scanner == nil {
+		scanner = NoopScanner{}
+	}
+
 	return &Service{
 		logger:        logger,
+		config:        config,
 		storageClient: storageClient,
+		scanner:       scanner,
 	}
 }
 
@@ -113,6 +133,103 @@ func (s *Service) RemoveObject(ctx context.Context, key string) error {
 	return nil
 }
 
+// FinalizeUpload scans a file the user uploaded through a presigned URL before
+// it is referenced anywhere. Flagged files are removed from storage. Scanning is
+// bounded by the configured timeout; a scan that fails or times out rejects the
+// upload unless the service is configured to fail open.
+func (s *Service) FinalizeUpload( //nolint:funlen
+	ctx context.Context,
+	userID string,
+	key string,
+) (*FinalizedUpload, error) {
+	if !isUserKey(userID, key) {
+		return nil, ErrInvalidUploadKey
+	}
+
+	finalized := &FinalizedUpload{
+		Key:       key,
+		PublicURL: s.storageClient.GetPublicURL(key),
+	}
+
+	if _, isNoop := s.scanner.(NoopScanner); isNoop {
+		return finalized, nil
+	}
+
+	scanCtx := ctx
+	if s.config.ScanTimeout > 0 {
+		var cancel context.CancelFunc
+
+		scanCtx, cancel = context.WithTimeout(ctx, s.config.ScanTimeout)
+		defer cancel()
+	}
+
+	content, info, err := s.storageClient.GetObject(scanCtx, key)
+	if err != nil {
+		return nil, fmt.Errorf("%w(key: %s): %w", ErrUploadNotFound, key, err)
+	}
+	defer content.Close() //nolint:errcheck
+
+	if s.config.ScanMaxBytes > 0 && info.Size > s.config.ScanMaxBytes {
+		s.removeRejected(ctx, key)
+
+		return nil, fmt.Errorf("%w(key: %s): %d bytes", ErrUploadTooLarge, key, info.Size)
+	}
+
+	var reader io.Reader = content
+	if s.config.ScanMaxBytes > 0 {
+		reader = io.LimitReader(content, s.config.ScanMaxBytes)
+	}
+
+	result, err := s.scanner.Scan(scanCtx, key, info.ContentType, reader)
+	if err != nil {
+		if s.config.ScanFailOpen {
+			s.logger.WarnContext(ctx, "Upload scan failed, accepting upload",
+				slog.String("key", key),
+				slog.String("error", err.Error()))
+
+			return finalized, nil
+		}
+
+		return nil, fmt.Errorf("%w(key: %s): %w", ErrUploadScanFailed, key, err)
+	}
+
+	if result.Flagged {
+		s.logger.WarnContext(ctx, "Upload flagged by scanner",
+			slog.String("key", key),
+			slog.String("user_id", userID),
+			slog.String("threat", result.Threat))
+
+		s.removeRejected(ctx, key)
+
+		return nil, fmt.Errorf("%w(key: %s): %s", ErrUploadRejected, key, result.Threat)
+	}
+
+	return finalized, nil
+}
+
+// removeRejected deletes a rejected upload. Failures are only logged, since the
+// upload is rejected either way and is never referenced.
+func (s *Service) removeRejected(ctx context.Context, key string) {
+	err := s.storageClient.RemoveObject(ctx, key)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Failed to remove rejected upload",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+	}
+}
+
+// isUserKey reports whether the key was generated for the user's uploads.
+func isUserKey(userID string, key string) bool {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[1] != userID || parts[2] == "" { //nolint:mnd
+		return false
+	}
+
+	_, ok := allowedContentTypes[Purpose(parts[0])]
+
+	return ok
+}
+
 // generateKey creates a unique storage key for the upload.
 // Format: {purpose}/{user-id}/{timestamp}-{random}.{ext}.
 func generateKey(userID string, purpose Purpose, filename string) string {
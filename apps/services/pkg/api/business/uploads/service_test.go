@@ -0,0 +1,229 @@
+package uploads_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/uploads"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const uploadKey = "content-image/user-1/1700000000-abcdefgh.png"
+
+var errScannerDown = errors.New("scanner unavailable")
+
+// memoryStorage holds a single uploaded object in memory.
+type memoryStorage struct {
+	uploads.StorageClient
+
+	content string
+	removed []string
+	reads   int
+}
+
+func (m *memoryStorage) GetObject(
+	_ context.Context,
+	_ string,
+) (io.ReadCloser, *uploads.ObjectInfo, error) {
+	m.reads++
+
+	return io.NopCloser(strings.NewReader(m.content)), &uploads.ObjectInfo{
+		ContentType: "image/png",
+		Size:        int64(len(m.content)),
+	}, nil
+}
+
+func (m *memoryStorage) GetPublicURL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+func (m *memoryStorage) RemoveObject(_ context.Context, key string) error {
+	m.removed = append(m.removed, key)
+
+	return nil
+}
+
+// stubScanner flags files containing a marker, fails with err, or blocks until
+// the scan deadline when slow is set.
+type stubScanner struct {
+	err     error
+	scanned string
+	slow    bool
+}
+
+func (s *stubScanner) Scan(
+	ctx context.Context,
+	_ string,
+	_ string,
+	content io.Reader,
+) (*uploads.ScanResult, error) {
+	if s.slow {
+		<-ctx.Done()
+
+		return nil, ctx.Err()
+	}
+
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	s.scanned = string(data)
+
+	if strings.Contains(s.scanned, "EICAR") {
+		return &uploads.ScanResult{Threat: "EICAR-Test-File", Flagged: true}, nil
+	}
+
+	return &uploads.ScanResult{Threat: "", Flagged: false}, nil
+}
+
+func newUploadService(
+	config *uploads.Config,
+	content string,
+	scanner uploads.Scanner,
+) (*uploads.Service, *memoryStorage) {
+	storage := &memoryStorage{content: content} //nolint:exhaustruct
+
+	return uploads.NewService(logfx.NewLogger(), config, storage, scanner), storage
+}
+
+func TestFinalizeUpload(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   uploads.Config
+		content  string
+		scanner  *stubScanner
+		expected error
+		removed  bool
+	}{
+		"clean file": {
+			config:   uploads.Config{ScanTimeout: time.Second, ScanMaxBytes: 1024, ScanFailOpen: false},
+			content:  "png bytes",
+			scanner:  &stubScanner{}, //nolint:exhaustruct
+			expected: nil,
+			removed:  false,
+		},
+		"flagged file": {
+			config:   uploads.Config{ScanTimeout: time.Second, ScanMaxBytes: 1024, ScanFailOpen: false},
+			content:  "png bytes EICAR",
+			scanner:  &stubScanner{}, //nolint:exhaustruct
+			expected: uploads.ErrUploadRejected,
+			removed:  true,
+		},
+		"too large": {
+			config:   uploads.Config{ScanTimeout: time.Second, ScanMaxBytes: 4, ScanFailOpen: false},
+			content:  "png bytes",
+			scanner:  &stubScanner{}, //nolint:exhaustruct
+			expected: uploads.ErrUploadTooLarge,
+			removed:  true,
+		},
+		"scanner error": {
+			config:   uploads.Config{ScanTimeout: time.Second, ScanMaxBytes: 1024, ScanFailOpen: false},
+			content:  "png bytes",
+			scanner:  &stubScanner{err: errScannerDown}, //nolint:exhaustruct
+			expected: uploads.ErrUploadScanFailed,
+			removed:  false,
+		},
+		"scanner timeout": {
+			config:   uploads.Config{ScanTimeout: 10 * time.Millisecond, ScanMaxBytes: 1024, ScanFailOpen: false},
+			content:  "png bytes",
+			scanner:  &stubScanner{slow: true}, //nolint:exhaustruct
+			expected: uploads.ErrUploadScanFailed,
+			removed:  false,
+		},
+		"scanner error failing open": {
+			config:   uploads.Config{ScanTimeout: time.Second, ScanMaxBytes: 1024, ScanFailOpen: true},
+			content:  "png bytes",
+			scanner:  &stubScanner{err: errScannerDown}, //nolint:exhaustruct
+			expected: nil,
+			removed:  false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newUploadService(&tt.config, tt.content, tt.scanner)
+
+			finalized, err := service.FinalizeUpload(t.Context(), "user-1", uploadKey)
+
+			if tt.expected != nil {
+				require.ErrorIs(t, err, tt.expected)
+				assert.Nil(t, finalized)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "https://cdn.example.com/"+uploadKey, finalized.PublicURL)
+			}
+
+			if tt.removed {
+				assert.Equal(t, []string{uploadKey}, storage.removed)
+			} else {
+				assert.Empty(t, storage.removed)
+			}
+		})
+	}
+}
+
+func TestFinalizeUpload_ScansContent(t *testing.T) {
+	t.Parallel()
+
+	scanner := &stubScanner{} //nolint:exhaustruct
+	service, _ := newUploadService(
+		&uploads.Config{ScanTimeout: time.Second, ScanMaxBytes: 1024, ScanFailOpen: false},
+		"png bytes",
+		scanner,
+	)
+
+	_, err := service.FinalizeUpload(t.Context(), "user-1", uploadKey)
+	require.NoError(t, err)
+
+	assert.Equal(t, "png bytes", scanner.scanned)
+}
+
+func TestFinalizeUpload_NoScannerConfigured(t *testing.T) {
+	t.Parallel()
+
+	service, storage := newUploadService(&uploads.Config{}, "png bytes EICAR", nil) //nolint:exhaustruct
+
+	finalized, err := service.FinalizeUpload(t.Context(), "user-1", uploadKey)
+
+	require.NoError(t, err)
+	assert.Equal(t, uploadKey, finalized.Key)
+	assert.Zero(t, storage.reads, "the no-op scanner does not download the file")
+}
+
+func TestFinalizeUpload_RejectsForeignKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := []string{
+		"content-image/user-2/1700000000-abcdefgh.png",
+		"unknown-purpose/user-1/1700000000-abcdefgh.png",
+		"content-image/user-1/",
+		"content-image/user-1/nested/file.png",
+	}
+
+	for _, key := range keys {
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+
+			service, storage := newUploadService(&uploads.Config{}, "", &stubScanner{}) //nolint:exhaustruct
+
+			_, err := service.FinalizeUpload(t.Context(), "user-1", key)
+
+			require.ErrorIs(t, err, uploads.ErrInvalidUploadKey)
+			assert.Zero(t, storage.reads)
+		})
+	}
+}
@@ -2,9 +2,20 @@ package uploads
 
 import (
 	"context"
+	"io"
 	"time"
 )
 
+// Config holds configuration for the uploads module.
+type Config struct {
+	// ScanTimeout bounds how long finalizing an upload may wait for the scanner.
+	ScanTimeout time.Duration `conf:"scan_timeout"   default:"10s"`
+	// ScanMaxBytes is the largest upload that is read for scanning; larger files are rejected.
+	ScanMaxBytes int64 `conf:"scan_max_bytes" default:"20971520"`
+	// ScanFailOpen accepts uploads when the scanner errors or times out instead of rejecting them.
+	ScanFailOpen bool `conf:"scan_fail_open" default:"false"`
+}
+
 // StorageClient defines the interface for object storage operations.
 // This interface allows the business layer to remain decoupled from specific
 // storage implementations (e.g., S3, R2, local filesystem).
@@ -20,10 +31,19 @@ type StorageClient interface {
 	// GetPublicURL returns the public URL for a given key.
 	GetPublicURL(key string) string
 
+	// GetObject opens an object for reading, returning its size and content type.
+	GetObject(ctx context.Context, key string) (io.ReadCloser, *ObjectInfo, error)
+
 	// RemoveObject deletes an object from storage.
 	RemoveObject(ctx context.Context, key string) error
 }
 
+// ObjectInfo describes a stored object.
+type ObjectInfo struct {
+	ContentType string
+	Size        int64
+}
+
 // Purpose represents the intended use of an uploaded file.
 type Purpose string
 
@@ -47,3 +67,9 @@ type PresignedURLResponse struct {
 	Key       string    `json:"key"`
 	PublicURL string    `json:"public_url"`
 }
+
+// FinalizedUpload is the result of finalizing an upload that passed scanning.
+type FinalizedUpload struct {
+	Key       string `json:"key"`
+	PublicURL string `json:"public_url"`
+}
@@ -0,0 +1,35 @@
+package users_test
+
+import (
+	"context"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// recordingAuditRepository keeps every audit entry inserted through it.
+type recordingAuditRepository struct {
+	events.AuditRepository
+
+	entries []events.AuditParams
+}
+
+func (r *recordingAuditRepository) InsertAudit(
+	_ context.Context,
+	_ string,
+	params events.AuditParams,
+) error {
+	r.entries = append(r.entries, params)
+
+	return nil
+}
+
+// newRecordingAuditService returns an audit service that records into the
+// returned repository.
+//
+// The repository fakes of this package embed the repository interface, so any
+// method a fake does not implement panics through the nil embedded interface.
+func newRecordingAuditService() (*events.AuditService, *recordingAuditRepository) {
+	auditRepo := &recordingAuditRepository{} //nolint:exhaustruct
+
+	return events.NewAuditService(nil, auditRepo, func() string { return "audit" }, nil), auditRepo
+}
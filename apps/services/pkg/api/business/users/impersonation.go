@@ -0,0 +1,198 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+const userKindAdmin = "admin"
+
+var (
+	ErrImpersonationForbidden   = errors.New("only admins can impersonate users")
+	ErrCannotImpersonate        = errors.New("user cannot be impersonated")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrNotImpersonating         = errors.New("session is not an impersonation session")
+	ErrImpersonatorSessionEnded = errors.New("impersonator session is no longer active")
+)
+
+// StartImpersonation creates a short-lived session logged in as the target user on
+// behalf of the admin who owns adminSession. The admin session is left untouched so
+// it can be restored by EndImpersonation. Admins cannot be impersonated, and an
+// impersonation session cannot start another one.
+func (s *Service) StartImpersonation(
+	ctx context.Context,
+	adminSession *Session,
+	targetUserID string,
+	ttl time.Duration,
+) (*Session, error) {
+	if adminSession.LoggedInUserID == nil {
+		return nil, ErrImpersonationForbidden
+	}
+
+	if adminSession.IsImpersonation() {
+		return nil, fmt.Errorf("%w: already impersonating", ErrCannotImpersonate)
+	}
+
+	adminUserID := *adminSession.LoggedInUserID
+
+	admin, err := s.repo.GetUserByID(ctx, adminUserID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, adminUserID, err)
+	}
+
+	if admin == nil || admin.Kind != userKindAdmin {
+		return nil, ErrImpersonationForbidden
+	}
+
+	if targetUserID == adminUserID {
+		return nil, fmt.Errorf("%w: cannot impersonate yourself", ErrCannotImpersonate)
+	}
+
+	target, err := s.repo.GetUserByID(ctx, targetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, targetUserID, err)
+	}
+
+	if target == nil || target.DeletedAt != nil {
+		return nil, ErrUserNotFound
+	}
+
+	if target.Kind == userKindAdmin {
+		return nil, fmt.Errorf("%w: admins cannot be impersonated", ErrCannotImpersonate)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	session := &Session{
+		ID:                       string(s.idGenerator()),
+		Status:                   SessionStatusActive,
+		OauthRequestState:        "",
+		OauthRequestCodeVerifier: "",
+		OauthRedirectURI:         nil,
+		LoggedInUserID:           &target.ID,
+		LoggedInAt:               &now,
+		LastActivityAt:           &now,
+		UserAgent:                nil,
+		ExpiresAt:                &expiresAt,
+		CreatedAt:                now,
+		UpdatedAt:                nil,
+		OAuthProvider:            nil,
+		OAuthAccessToken:         nil,
+		OAuthTokenScope:          nil,
+		ImpersonatorUserID:       &admin.ID,
+		ImpersonatorSessionID:    &adminSession.ID,
+	}
+
+	err = s.repo.CreateSession(ctx, session)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateRecord, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ImpersonationStarted,
+		EntityType: "user",
+		EntityID:   target.ID,
+		ActorID:    &admin.ID,
+		ActorKind:  events.ActorUser,
+		SessionID:  &adminSession.ID,
+		Payload: map[string]any{
+			"impersonator_user_id":     admin.ID,
+			"impersonated_user_id":     target.ID,
+			"impersonation_session_id": session.ID,
+			"expires_at":               expiresAt.Format(time.RFC3339),
+		},
+	})
+
+	return session, nil
+}
+
+// EndImpersonation logs the impersonation session out and returns the admin session
+// it was started from. The impersonation session is ended even when the admin
+// session can no longer be restored; ErrImpersonatorSessionEnded is returned then.
+func (s *Service) EndImpersonation(ctx context.Context, session *Session) (*Session, error) {
+	if !session.IsImpersonation() || session.LoggedInUserID == nil {
+		return nil, ErrNotImpersonating
+	}
+
+	err := s.repo.UpdateSessionStatus(ctx, session.ID, SessionStatusLoggedOut.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, session.ID, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.ImpersonationEnded,
+		EntityType: "user",
+		EntityID:   *session.LoggedInUserID,
+		ActorID:    session.ImpersonatorUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  &session.ID,
+		Payload: map[string]any{
+			"impersonator_user_id":     *session.ImpersonatorUserID,
+			"impersonated_user_id":     *session.LoggedInUserID,
+			"impersonation_session_id": session.ID,
+		},
+	})
+
+	if session.ImpersonatorSessionID == nil {
+		return nil, ErrImpersonatorSessionEnded
+	}
+
+	adminSession, err := s.repo.GetSessionByID(ctx, *session.ImpersonatorSessionID)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"%w(id: %s): %w",
+			ErrFailedToGetRecord,
+			*session.ImpersonatorSessionID,
+			err,
+		)
+	}
+
+	if adminSession == nil ||
+		adminSession.Status != SessionStatusActive ||
+		adminSession.LoggedInUserID == nil ||
+		*adminSession.LoggedInUserID != *session.ImpersonatorUserID {
+		return nil, ErrImpersonatorSessionEnded
+	}
+
+	return adminSession, nil
+}
+
+// RecordImpersonatedRequest audits a request made with an impersonation session,
+// attributing it to the admin and recording the impersonated user alongside.
+// Blocked requests are recorded under their own event type.
+func (s *Service) RecordImpersonatedRequest(
+	ctx context.Context,
+	session *Session,
+	method string,
+	path string,
+	blocked bool,
+) {
+	if !session.IsImpersonation() || session.LoggedInUserID == nil {
+		return
+	}
+
+	eventType := events.ImpersonationRequest
+	if blocked {
+		eventType = events.ImpersonationRequestBlocked
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  eventType,
+		EntityType: "user",
+		EntityID:   *session.LoggedInUserID,
+		ActorID:    session.ImpersonatorUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  &session.ID,
+		Payload: map[string]any{
+			"impersonator_user_id": *session.ImpersonatorUserID,
+			"impersonated_user_id": *session.LoggedInUserID,
+			"method":               method,
+			"path":                 path,
+		},
+	})
+}
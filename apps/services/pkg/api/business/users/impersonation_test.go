@@ -0,0 +1,222 @@
+package users_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// impersonationRepository serves the user and session calls made by impersonation.
+type impersonationRepository struct {
+	users.Repository
+
+	users    map[string]*users.User
+	sessions map[string]*users.Session
+}
+
+func (r *impersonationRepository) GetUserByID(_ context.Context, id string) (*users.User, error) {
+	return r.users[id], nil
+}
+
+func (r *impersonationRepository) GetSessionByID(
+	_ context.Context,
+	id string,
+) (*users.Session, error) {
+	return r.sessions[id], nil
+}
+
+func (r *impersonationRepository) CreateSession(_ context.Context, session *users.Session) error {
+	r.sessions[session.ID] = session
+
+	return nil
+}
+
+func (r *impersonationRepository) UpdateSessionStatus(
+	_ context.Context,
+	id string,
+	status string,
+) error {
+	r.sessions[id].Status = users.SessionStatus(status)
+
+	return nil
+}
+
+func newImpersonationService() (
+	*users.Service,
+	*impersonationRepository,
+	*recordingAuditRepository,
+	*users.Session,
+) {
+	adminID := "admin-user"
+	adminSession := &users.Session{ //nolint:exhaustruct
+		ID:             "admin-session",
+		Status:         users.SessionStatusActive,
+		LoggedInUserID: &adminID,
+	}
+
+	repo := &impersonationRepository{ //nolint:exhaustruct
+		users: map[string]*users.User{
+			"admin-user":  {ID: "admin-user", Kind: "admin"},    //nolint:exhaustruct
+			"other-admin": {ID: "other-admin", Kind: "admin"},   //nolint:exhaustruct
+			"member-user": {ID: "member-user", Kind: "regular"}, //nolint:exhaustruct
+		},
+		sessions: map[string]*users.Session{adminSession.ID: adminSession},
+	}
+	auditService, auditRepo := newRecordingAuditService()
+
+	return users.NewService(nil, repo, auditService), repo, auditRepo, adminSession
+}
+
+func TestStartImpersonation(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo, adminSession := newImpersonationService()
+
+	session, err := service.StartImpersonation(
+		t.Context(), adminSession, "member-user", 30*time.Minute,
+	)
+
+	require.NoError(t, err)
+	require.Contains(t, repo.sessions, session.ID)
+	assert.True(t, session.IsImpersonation())
+	assert.Equal(t, "member-user", *session.LoggedInUserID)
+	assert.Equal(t, "admin-user", *session.ImpersonatorUserID)
+	assert.Equal(t, "admin-session", *session.ImpersonatorSessionID)
+	require.NotNil(t, session.ExpiresAt)
+	assert.WithinDuration(t, time.Now().Add(30*time.Minute), *session.ExpiresAt, time.Minute)
+
+	require.Len(t, auditRepo.entries, 1)
+	entry := auditRepo.entries[0]
+	assert.Equal(t, events.ImpersonationStarted, entry.EventType)
+	assert.Equal(t, "member-user", entry.EntityID)
+	assert.Equal(t, "admin-user", *entry.ActorID)
+	assert.Equal(t, "admin-user", entry.Payload["impersonator_user_id"])
+	assert.Equal(t, "member-user", entry.Payload["impersonated_user_id"])
+	assert.Equal(t, session.ID, entry.Payload["impersonation_session_id"])
+}
+
+func TestStartImpersonation_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		adminUserID string
+		target      string
+		nested      bool
+		expected    error
+	}{
+		"not an admin": {
+			adminUserID: "member-user",
+			target:      "admin-user",
+			expected:    users.ErrImpersonationForbidden,
+		},
+		"self": {
+			adminUserID: "admin-user",
+			target:      "admin-user",
+			expected:    users.ErrCannotImpersonate,
+		},
+		"another admin": {
+			adminUserID: "admin-user",
+			target:      "other-admin",
+			expected:    users.ErrCannotImpersonate,
+		},
+		"unknown user": {
+			adminUserID: "admin-user",
+			target:      "missing-user",
+			expected:    users.ErrUserNotFound,
+		},
+		"already impersonating": {
+			adminUserID: "admin-user",
+			target:      "member-user",
+			nested:      true,
+			expected:    users.ErrCannotImpersonate,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo, adminSession := newImpersonationService()
+			adminSession.LoggedInUserID = &tt.adminUserID
+
+			if tt.nested {
+				impersonator := "other-admin"
+				adminSession.ImpersonatorUserID = &impersonator
+			}
+
+			_, err := service.StartImpersonation(t.Context(), adminSession, tt.target, time.Minute)
+
+			require.ErrorIs(t, err, tt.expected)
+			assert.Len(t, repo.sessions, 1)
+			assert.Empty(t, auditRepo.entries)
+		})
+	}
+}
+
+func TestEndImpersonation(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo, adminSession := newImpersonationService()
+
+	session, err := service.StartImpersonation(t.Context(), adminSession, "member-user", time.Minute)
+	require.NoError(t, err)
+
+	service.RecordImpersonatedRequest(t.Context(), session, "GET", "/en/profiles/eser", false)
+	service.RecordImpersonatedRequest(t.Context(), session, "POST", "/en/stories", true)
+
+	restored, err := service.EndImpersonation(t.Context(), session)
+
+	require.NoError(t, err)
+	assert.Equal(t, adminSession.ID, restored.ID)
+	assert.Equal(t, users.SessionStatusLoggedOut, repo.sessions[session.ID].Status)
+	assert.Equal(t, users.SessionStatusActive, repo.sessions[adminSession.ID].Status)
+
+	eventTypes := make([]events.EventType, 0, len(auditRepo.entries))
+	for _, entry := range auditRepo.entries {
+		eventTypes = append(eventTypes, entry.EventType)
+
+		assert.Equal(t, "admin-user", *entry.ActorID)
+		assert.Equal(t, "member-user", entry.EntityID)
+		assert.Equal(t, "admin-user", entry.Payload["impersonator_user_id"])
+		assert.Equal(t, "member-user", entry.Payload["impersonated_user_id"])
+	}
+
+	assert.Equal(t, []events.EventType{
+		events.ImpersonationStarted,
+		events.ImpersonationRequest,
+		events.ImpersonationRequestBlocked,
+		events.ImpersonationEnded,
+	}, eventTypes)
+	assert.Equal(t, "POST", auditRepo.entries[2].Payload["method"])
+}
+
+func TestEndImpersonation_AdminSessionEnded(t *testing.T) {
+	t.Parallel()
+
+	service, repo, _, adminSession := newImpersonationService()
+
+	session, err := service.StartImpersonation(t.Context(), adminSession, "member-user", time.Minute)
+	require.NoError(t, err)
+
+	adminSession.Status = users.SessionStatusLoggedOut
+
+	_, err = service.EndImpersonation(t.Context(), session)
+
+	require.ErrorIs(t, err, users.ErrImpersonatorSessionEnded)
+	assert.Equal(t, users.SessionStatusLoggedOut, repo.sessions[session.ID].Status)
+}
+
+func TestEndImpersonation_NotImpersonating(t *testing.T) {
+	t.Parallel()
+
+	service, _, _, adminSession := newImpersonationService()
+
+	_, err := service.EndImpersonation(t.Context(), adminSession)
+
+	require.ErrorIs(t, err, users.ErrNotImpersonating)
+}
@@ -0,0 +1,162 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+const profileKindIndividual = "individual"
+
+var (
+	ErrIndividualProfileAdminOnly = errors.New("only admins can change individual profile links")
+	ErrInvalidIndividualProfile   = errors.New("profile is not an individual profile")
+	ErrIndividualProfileInUse     = errors.New("profile is the individual profile of another user")
+	ErrNoIndividualProfile        = errors.New("user has no individual profile")
+)
+
+// UnlinkIndividualProfile clears the individual profile of a user. It is support
+// tooling for accounts left pointing at a profile that was never fully set up,
+// so the user can go through profile creation again.
+func (s *Service) UnlinkIndividualProfile(
+	ctx context.Context,
+	adminUserID string,
+	userID string,
+) error {
+	err := s.requireAdmin(ctx, adminUserID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.getExistingUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.IndividualProfileID == nil {
+		return fmt.Errorf("%w(id: %s)", ErrNoIndividualProfile, userID)
+	}
+
+	previousProfileID := *user.IndividualProfileID
+
+	err = s.repo.ClearUserIndividualProfileID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%w(user_id: %s): %w", ErrFailedToUpdateRecord, userID, err)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.UserIndividualProfileUnlinked,
+		EntityType: "user",
+		EntityID:   userID,
+		ActorID:    &adminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"previous_profile_id": previousProfileID,
+		},
+	})
+
+	return nil
+}
+
+// RelinkIndividualProfile points a user at an existing individual profile,
+// replacing whatever was linked before. The profile must be an individual
+// profile that is not the individual profile of any other user.
+func (s *Service) RelinkIndividualProfile(
+	ctx context.Context,
+	adminUserID string,
+	userID string,
+	profileID string,
+) error {
+	err := s.requireAdmin(ctx, adminUserID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.getExistingUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var previousProfileID any
+	if user.IndividualProfileID != nil {
+		previousProfileID = *user.IndividualProfileID
+	}
+
+	kind, err := s.repo.GetProfileKindForIndividualLink(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	if kind == nil || *kind != profileKindIndividual {
+		return fmt.Errorf("%w(profile_id: %s)", ErrInvalidIndividualProfile, profileID)
+	}
+
+	linkedUserID, err := s.repo.GetUserIDByIndividualProfileID(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profile_id: %s): %w", ErrFailedToGetRecord, profileID, err)
+	}
+
+	if linkedUserID != nil && *linkedUserID != userID {
+		return fmt.Errorf(
+			"%w(profile_id: %s, user_id: %s)",
+			ErrIndividualProfileInUse,
+			profileID,
+			*linkedUserID,
+		)
+	}
+
+	err = s.repo.SetUserIndividualProfileID(ctx, userID, profileID)
+	if err != nil {
+		return fmt.Errorf(
+			"%w(user_id: %s, profile_id: %s): %w",
+			ErrFailedToUpdateRecord,
+			userID,
+			profileID,
+			err,
+		)
+	}
+
+	s.auditService.Record(ctx, events.AuditParams{
+		EventType:  events.UserIndividualProfileRelinked,
+		EntityType: "user",
+		EntityID:   userID,
+		ActorID:    &adminUserID,
+		ActorKind:  events.ActorUser,
+		SessionID:  nil,
+		Payload: map[string]any{
+			"previous_profile_id": previousProfileID,
+			"profile_id":          profileID,
+		},
+	})
+
+	return nil
+}
+
+func (s *Service) requireAdmin(ctx context.Context, adminUserID string) error {
+	admin, err := s.repo.GetUserByID(ctx, adminUserID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, adminUserID, err)
+	}
+
+	if admin == nil || admin.Kind != userKindAdmin {
+		return ErrIndividualProfileAdminOnly
+	}
+
+	return nil
+}
+
+func (s *Service) getExistingUser(ctx context.Context, userID string) (*User, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, userID, err)
+	}
+
+	if user == nil || user.DeletedAt != nil {
+		return nil, fmt.Errorf("%w(id: %s)", ErrUserNotFound, userID)
+	}
+
+	return user, nil
+}
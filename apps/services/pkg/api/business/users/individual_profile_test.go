@@ -0,0 +1,182 @@
+package users_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/users"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// individualProfileRepository keeps users and profile kinds in memory.
+type individualProfileRepository struct {
+	users.Repository
+
+	users        map[string]*users.User
+	profileKinds map[string]string
+}
+
+func (r *individualProfileRepository) GetUserByID(_ context.Context, id string) (*users.User, error) {
+	return r.users[id], nil
+}
+
+func (r *individualProfileRepository) SetUserIndividualProfileID(
+	_ context.Context,
+	userID string,
+	profileID string,
+) error {
+	r.users[userID].IndividualProfileID = &profileID
+
+	return nil
+}
+
+func (r *individualProfileRepository) ClearUserIndividualProfileID(
+	_ context.Context,
+	userID string,
+) error {
+	r.users[userID].IndividualProfileID = nil
+
+	return nil
+}
+
+func (r *individualProfileRepository) GetProfileKindForIndividualLink(
+	_ context.Context,
+	profileID string,
+) (*string, error) {
+	kind, ok := r.profileKinds[profileID]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return &kind, nil
+}
+
+func (r *individualProfileRepository) GetUserIDByIndividualProfileID(
+	_ context.Context,
+	profileID string,
+) (*string, error) {
+	for _, user := range r.users {
+		if user.IndividualProfileID != nil && *user.IndividualProfileID == profileID {
+			return &user.ID, nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
+
+func newIndividualProfileService() (
+	*users.Service,
+	*individualProfileRepository,
+	*recordingAuditRepository,
+) {
+	orphanProfileID := "profile-orphan"
+	takenProfileID := "profile-taken"
+
+	repo := &individualProfileRepository{
+		users: map[string]*users.User{
+			"admin-user": {ID: "admin-user", Kind: "admin"},                                      //nolint:exhaustruct
+			"regular":    {ID: "regular", Kind: "regular"},                                       //nolint:exhaustruct
+			"broken":     {ID: "broken", Kind: "regular", IndividualProfileID: &orphanProfileID}, //nolint:exhaustruct
+			"owner":      {ID: "owner", Kind: "regular", IndividualProfileID: &takenProfileID},   //nolint:exhaustruct
+		},
+		profileKinds: map[string]string{
+			"profile-orphan": "individual",
+			"profile-taken":  "individual",
+			"profile-fresh":  "individual",
+			"profile-org":    "organization",
+		},
+	}
+	auditService, auditRepo := newRecordingAuditService()
+
+	return users.NewService(nil, repo, auditService), repo, auditRepo
+}
+
+func TestUnlinkIndividualProfile(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newIndividualProfileService()
+
+	err := service.UnlinkIndividualProfile(t.Context(), "admin-user", "broken")
+	require.NoError(t, err)
+	assert.Nil(t, repo.users["broken"].IndividualProfileID)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.UserIndividualProfileUnlinked, auditRepo.entries[0].EventType)
+	assert.Equal(t, "profile-orphan", auditRepo.entries[0].Payload["previous_profile_id"])
+
+	err = service.UnlinkIndividualProfile(t.Context(), "admin-user", "broken")
+	require.ErrorIs(t, err, users.ErrNoIndividualProfile)
+}
+
+func TestRelinkIndividualProfile(t *testing.T) {
+	t.Parallel()
+
+	service, repo, auditRepo := newIndividualProfileService()
+
+	err := service.RelinkIndividualProfile(t.Context(), "admin-user", "broken", "profile-fresh")
+	require.NoError(t, err)
+	require.NotNil(t, repo.users["broken"].IndividualProfileID)
+	assert.Equal(t, "profile-fresh", *repo.users["broken"].IndividualProfileID)
+
+	require.Len(t, auditRepo.entries, 1)
+	assert.Equal(t, events.UserIndividualProfileRelinked, auditRepo.entries[0].EventType)
+	assert.Equal(t, "profile-orphan", auditRepo.entries[0].Payload["previous_profile_id"])
+	assert.Equal(t, "profile-fresh", auditRepo.entries[0].Payload["profile_id"])
+}
+
+func TestRelinkIndividualProfile_Rejected(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		adminUserID string
+		userID      string
+		profileID   string
+		expected    error
+	}{
+		"not an admin": {
+			adminUserID: "regular",
+			userID:      "broken",
+			profileID:   "profile-fresh",
+			expected:    users.ErrIndividualProfileAdminOnly,
+		},
+		"unknown user": {
+			adminUserID: "admin-user",
+			userID:      "missing",
+			profileID:   "profile-fresh",
+			expected:    users.ErrUserNotFound,
+		},
+		"organization profile": {
+			adminUserID: "admin-user",
+			userID:      "broken",
+			profileID:   "profile-org",
+			expected:    users.ErrInvalidIndividualProfile,
+		},
+		"missing profile": {
+			adminUserID: "admin-user",
+			userID:      "broken",
+			profileID:   "profile-missing",
+			expected:    users.ErrInvalidIndividualProfile,
+		},
+		"profile of another user": {
+			adminUserID: "admin-user",
+			userID:      "broken",
+			profileID:   "profile-taken",
+			expected:    users.ErrIndividualProfileInUse,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, auditRepo := newIndividualProfileService()
+
+			err := service.RelinkIndividualProfile(t.Context(), tt.adminUserID, tt.userID, tt.profileID)
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, auditRepo.entries)
+			assert.Equal(t, "profile-orphan", *repo.users["broken"].IndividualProfileID)
+		})
+	}
+}
@@ -30,6 +30,9 @@ type Repository interface { //nolint:interfacebloat
 	CreateUser(ctx context.Context, user *User) error
 	UpdateUser(ctx context.Context, user *User) error
 	SetUserIndividualProfileID(ctx context.Context, userID string, profileID string) error
+	ClearUserIndividualProfileID(ctx context.Context, userID string) error
+	GetProfileKindForIndividualLink(ctx context.Context, profileID string) (*string, error)
+	GetUserIDByIndividualProfileID(ctx context.Context, profileID string) (*string, error)
 
 	CreateSession(ctx context.Context, session *Session) error
 	GetSessionByID(ctx context.Context, id string) (*Session, error)
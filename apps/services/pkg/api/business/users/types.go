@@ -78,8 +78,23 @@ type Session struct {
 	OAuthProvider            *string       `json:"-"`
 	OAuthAccessToken         *string       `json:"-"`
 	OAuthTokenScope          *string       `json:"-"`
+	ImpersonatorUserID       *string       `json:"impersonator_user_id"`
+	ImpersonatorSessionID    *string       `json:"-"`
 	ID                       string        `json:"id"`
 	Status                   SessionStatus `json:"status"`
 	OauthRequestState        string        `json:"oauth_request_state"`
 	OauthRequestCodeVerifier string        `json:"oauth_request_code_verifier"`
 }
+
+// IsImpersonation reports whether the session was started by an admin viewing the
+// site as another user.
+func (s *Session) IsImpersonation() bool {
+	return s.ImpersonatorUserID != nil
+}
+
+// IsImpersonationExpired reports whether the session is an impersonation session
+// past its expiry. Impersonation sessions are short-lived regardless of the token
+// that carries them.
+func (s *Session) IsImpersonationExpired(now time.Time) bool {
+	return s.IsImpersonation() && (s.ExpiresAt == nil || !now.Before(*s.ExpiresAt))
+}
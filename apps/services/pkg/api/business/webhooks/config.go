@@ -0,0 +1,20 @@
+package webhooks
+
+import "time"
+
+// Config holds configuration for outbound webhook delivery.
+type Config struct {
+	// RequestTimeout bounds a single delivery attempt.
+	RequestTimeout time.Duration `conf:"request_timeout" default:"10s"`
+
+	// RetentionPeriod is how long delivery logs are kept before they are purged.
+	RetentionPeriod time.Duration `conf:"retention_period" default:"720h"`
+
+	// MaxAttempts is how many times a dispatched webhook is tried before it is
+	// left failed in the delivery log.
+	MaxAttempts int `conf:"max_attempts" default:"4"`
+
+	// RetryBackoff is the wait before the first retry; it doubles after every
+	// further failed attempt.
+	RetryBackoff time.Duration `conf:"retry_backoff" default:"2s"`
+}
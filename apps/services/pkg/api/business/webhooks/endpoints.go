@@ -0,0 +1,106 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+)
+
+// endpointSecretBytes is the length of a generated endpoint secret before hex encoding.
+const endpointSecretBytes = 32
+
+// CreateProfileEndpoint registers a webhook endpoint for a profile and generates
+// its signing secret. The returned endpoint is the only place the secret is shown.
+// The URL must be https and must not resolve to a private or reserved address.
+func (s *Service) CreateProfileEndpoint(
+	ctx context.Context,
+	profileID string,
+	targetURL string,
+) (*Endpoint, error) {
+	targetURL = strings.TrimSpace(targetURL)
+
+	err := lib.ValidateExternalURL(targetURL, true)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEndpointURL, err)
+	}
+
+	secret, err := newEndpointSecret()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCreateEndpoint, err)
+	}
+
+	endpoint := &Endpoint{
+		CreatedAt: time.Now().UTC(),
+		ID:        s.idGenerator(),
+		ProfileID: profileID,
+		TargetURL: targetURL,
+		Secret:    secret,
+	}
+
+	err = s.repo.CreateEndpoint(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToCreateEndpoint, profileID, err)
+	}
+
+	return endpoint, nil
+}
+
+// ListProfileEndpoints returns the webhook endpoints of a profile, without secrets.
+func (s *Service) ListProfileEndpoints(ctx context.Context, profileID string) ([]*Endpoint, error) {
+	endpoints, err := s.repo.ListProfileEndpoints(ctx, profileID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListEndpoints, profileID, err)
+	}
+
+	return endpoints, nil
+}
+
+// DeleteProfileEndpoint removes a webhook endpoint of a profile.
+func (s *Service) DeleteProfileEndpoint(
+	ctx context.Context,
+	profileID string,
+	endpointID string,
+) error {
+	deleted, err := s.repo.DeleteEndpoint(ctx, profileID, endpointID)
+	if err != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrFailedToDeleteEndpoint, endpointID, err)
+	}
+
+	if !deleted {
+		return ErrEndpointNotFound
+	}
+
+	return nil
+}
+
+// ResolveEndpointSecrets is the SecretResolver of profile endpoints. A deleted
+// endpoint fails to resolve, so nothing more is sent to it.
+func (s *Service) ResolveEndpointSecrets(ctx context.Context, endpointID string) ([]string, error) {
+	secret, err := s.repo.GetEndpointSecret(ctx, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	if secret == "" {
+		return nil, fmt.Errorf("%w(id: %s)", ErrEndpointNotFound, endpointID)
+	}
+
+	return []string{secret}, nil
+}
+
+// newEndpointSecret generates the random secret an endpoint's webhooks are signed with.
+func newEndpointSecret() (string, error) {
+	secretBuf := make([]byte, endpointSecretBytes)
+
+	_, err := rand.Read(secretBuf)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate webhook endpoint secret: %w", err)
+	}
+
+	return hex.EncodeToString(secretBuf), nil
+}
@@ -0,0 +1,81 @@
+package webhooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createdEndpointRepository records the endpoints that are created.
+type createdEndpointRepository struct {
+	*memoryRepository
+
+	created []*webhooks.Endpoint
+}
+
+func (r *createdEndpointRepository) CreateEndpoint(_ context.Context, endpoint *webhooks.Endpoint) error {
+	r.created = append(r.created, endpoint)
+
+	return nil
+}
+
+func TestCreateProfileEndpoint_RejectsUnsafeURLs(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		targetURL string
+		expected  error
+	}{
+		"cloud metadata address": {
+			targetURL: "https://169.254.169.254/latest/meta-data",
+			expected:  lib.ErrSSRFBlocked,
+		},
+		"loopback address": {
+			targetURL: "https://127.0.0.1:8080/hooks",
+			expected:  lib.ErrSSRFBlocked,
+		},
+		"private network address": {
+			targetURL: "https://10.0.0.5/hooks",
+			expected:  lib.ErrSSRFBlocked,
+		},
+		"plain http": {
+			targetURL: "http://93.184.216.34/hooks",
+			expected:  lib.ErrInsecureScheme,
+		},
+		"relative URL": {
+			targetURL: "/hooks",
+			expected:  lib.ErrInvalidURL,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := &createdEndpointRepository{memoryRepository: newMemoryRepository()} //nolint:exhaustruct
+			service := newService(repo, &scriptedSender{})                              //nolint:exhaustruct
+
+			_, err := service.CreateProfileEndpoint(t.Context(), "p1", tt.targetURL)
+			require.ErrorIs(t, err, webhooks.ErrInvalidEndpointURL)
+			require.ErrorIs(t, err, tt.expected)
+			assert.Empty(t, repo.created)
+		})
+	}
+}
+
+func TestCreateProfileEndpoint_AcceptsPublicURL(t *testing.T) {
+	t.Parallel()
+
+	repo := &createdEndpointRepository{memoryRepository: newMemoryRepository()} //nolint:exhaustruct
+	service := newService(repo, &scriptedSender{})                              //nolint:exhaustruct
+
+	endpoint, err := service.CreateProfileEndpoint(t.Context(), "p1", " https://93.184.216.34/hooks ")
+	require.NoError(t, err)
+	assert.Equal(t, "https://93.184.216.34/hooks", endpoint.TargetURL)
+	assert.NotEmpty(t, endpoint.Secret)
+	assert.Len(t, repo.created, 1)
+}
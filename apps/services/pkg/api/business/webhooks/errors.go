@@ -0,0 +1,21 @@
+package webhooks
+
+import "errors"
+
+// Sentinel errors.
+var (
+	ErrDeliveryNotFound         = errors.New("webhook delivery not found")
+	ErrDeliveryNotFailed        = errors.New("only failed webhook deliveries can be redelivered")
+	ErrDeliveryFailed           = errors.New("webhook delivery failed")
+	ErrFailedToRecordDelivery   = errors.New("failed to record webhook delivery")
+	ErrFailedToGetDelivery      = errors.New("failed to get webhook delivery")
+	ErrFailedToListDeliveries   = errors.New("failed to list webhook deliveries")
+	ErrFailedToPurgeDeliveries  = errors.New("failed to purge webhook deliveries")
+	ErrUnexpectedResponseStatus = errors.New("unexpected response status")
+	ErrFailedToResolveSecrets   = errors.New("failed to resolve webhook signing secrets")
+	ErrEndpointNotFound         = errors.New("webhook endpoint not found")
+	ErrInvalidEndpointURL       = errors.New("webhook endpoint URL must be an absolute https URL on a public host")
+	ErrFailedToCreateEndpoint   = errors.New("failed to create webhook endpoint")
+	ErrFailedToListEndpoints    = errors.New("failed to list webhook endpoints")
+	ErrFailedToDeleteEndpoint   = errors.New("failed to delete webhook endpoint")
+)
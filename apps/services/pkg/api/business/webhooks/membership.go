@@ -0,0 +1,186 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+)
+
+// SourceMembership is the delivery source of membership webhooks.
+const SourceMembership = "membership"
+
+// membershipEventTypes maps the audited membership events to the event types
+// sent to receivers.
+var membershipEventTypes = map[events.EventType]string{ //nolint:gochecknoglobals
+	events.ProfileMembershipCreated: "profile_membership.created",
+	events.ProfileMembershipUpdated: "profile_membership.updated",
+	events.ProfileMembershipDeleted: "profile_membership.deleted",
+}
+
+// MembershipEventPayload is the JSON body of a membership webhook. Data carries
+// the details of the change as recorded in the audit log.
+type MembershipEventPayload struct {
+	OccurredAt      time.Time      `json:"occurred_at"`
+	MemberProfileID *string        `json:"member_profile_id"`
+	Data            map[string]any `json:"data"`
+	Event           string         `json:"event"`
+	MembershipID    string         `json:"membership_id"`
+	ProfileID       string         `json:"profile_id"`
+	ActorKind       string         `json:"actor_kind"`
+}
+
+// MembershipEventTask is the payload of a WEBHOOK_MEMBERSHIP queue item,
+// sending one audited membership event to the endpoints of its profile.
+type MembershipEventTask struct {
+	Data         map[string]any `json:"data"`
+	EventType    string         `json:"event_type"`
+	MembershipID string         `json:"membership_id"`
+	ActorKind    string         `json:"actor_kind"`
+}
+
+// AuditParams returns the audited event the task sends.
+func (t MembershipEventTask) AuditParams() events.AuditParams {
+	return events.AuditParams{
+		ActorID:    nil,
+		SessionID:  nil,
+		Payload:    t.Data,
+		EventType:  events.EventType(t.EventType),
+		EntityType: "membership",
+		EntityID:   t.MembershipID,
+		ActorKind:  events.ActorKind(t.ActorKind),
+	}
+}
+
+// SetQueueService makes membership webhooks go through the event queue, so
+// their deliveries and retries run on the queue workers.
+func (s *Service) SetQueueService(queueService *events.QueueService) {
+	s.queueService = queueService
+}
+
+// HandleAuditRecorded is an events.OnRecordedFunc that queues membership events
+// for the endpoints of the profile they happened in. When they can't be queued,
+// each endpoint gets a single attempt right away; failed deliveries stay in the
+// delivery log for redelivery. Failures never affect the change itself.
+func (s *Service) HandleAuditRecorded(ctx context.Context, params events.AuditParams) {
+	if _, ok := membershipEventTypes[params.EventType]; !ok {
+		return
+	}
+
+	if s.queueService != nil {
+		err := s.enqueueMembershipEvent(ctx, params)
+		if err == nil {
+			return
+		}
+
+		s.logger.WarnContext(ctx, "Failed to queue membership webhook, delivering it now",
+			slog.String("event_type", string(params.EventType)),
+			slog.String("membership_id", params.EntityID),
+			slog.String("error", err.Error()))
+	}
+
+	err := s.dispatchMembershipEvent(ctx, params, s.Deliver)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to deliver membership webhook",
+			slog.String("event_type", string(params.EventType)),
+			slog.String("membership_id", params.EntityID),
+			slog.String("error", err.Error()))
+	}
+}
+
+// enqueueMembershipEvent queues a WEBHOOK_MEMBERSHIP item sending the event.
+func (s *Service) enqueueMembershipEvent(ctx context.Context, params events.AuditParams) error {
+	_, err := s.queueService.Enqueue(ctx, events.QueueEnqueueParams{
+		Type: events.QueueItemTypeWebhookMembership,
+		Payload: map[string]any{
+			"data":          params.Payload,
+			"event_type":    string(params.EventType),
+			"membership_id": params.EntityID,
+			"actor_kind":    string(params.ActorKind),
+		},
+		ScheduledAt:           nil,
+		MaxRetries:            0,
+		VisibilityTimeoutSecs: 0,
+	})
+
+	return err //nolint:wrapcheck
+}
+
+// DispatchMembershipEvent delivers a membership event to every endpoint of its
+// profile, retrying failed deliveries. Events that aren't membership events, or
+// don't name their profile, are ignored.
+func (s *Service) DispatchMembershipEvent(ctx context.Context, params events.AuditParams) error {
+	return s.dispatchMembershipEvent(ctx, params, s.DeliverWithRetries)
+}
+
+func (s *Service) dispatchMembershipEvent(
+	ctx context.Context,
+	params events.AuditParams,
+	deliver func(ctx context.Context, message Message) (*Delivery, error),
+) error {
+	eventType, ok := membershipEventTypes[params.EventType]
+	if !ok {
+		return nil
+	}
+
+	profileID, _ := params.Payload["profile_id"].(string)
+	if profileID == "" {
+		return nil
+	}
+
+	endpoints, err := s.repo.ListProfileEndpoints(ctx, profileID)
+	if err != nil {
+		return fmt.Errorf("%w(profileID: %s): %w", ErrFailedToListEndpoints, profileID, err)
+	}
+
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(MembershipEventPayload{
+		OccurredAt:      time.Now().UTC(),
+		MemberProfileID: payloadStringPtr(params.Payload, "member_profile_id"),
+		Data:            params.Payload,
+		Event:           eventType,
+		MembershipID:    params.EntityID,
+		ProfileID:       profileID,
+		ActorKind:       string(params.ActorKind),
+	})
+	if err != nil {
+		return fmt.Errorf("encoding membership webhook payload: %w", err)
+	}
+
+	var errs []error
+
+	for _, endpoint := range endpoints {
+		_, err := deliver(ctx, Message{
+			Source:     SourceMembership,
+			EndpointID: endpoint.ID,
+			EventType:  eventType,
+			TargetURL:  endpoint.TargetURL,
+			Payload:    payload,
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// payloadStringPtr reads an optional string recorded either as a string or as a
+// string pointer.
+func payloadStringPtr(payload map[string]any, key string) *string {
+	switch value := payload[key].(type) {
+	case string:
+		return &value
+	case *string:
+		return value
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,325 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/lib/webhooksig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// endpointRepository adds the endpoints of profile "p1" to the in-memory delivery log.
+type endpointRepository struct {
+	*memoryRepository
+
+	endpoints []*webhooks.Endpoint
+	secrets   map[string]string
+}
+
+func newEndpointRepository() *endpointRepository {
+	return &endpointRepository{
+		memoryRepository: newMemoryRepository(),
+		endpoints: []*webhooks.Endpoint{
+			{ID: "endpoint-1", ProfileID: "p1", TargetURL: "https://example.com/hooks"}, //nolint:exhaustruct
+		},
+		secrets: map[string]string{"endpoint-1": "secret-1"},
+	}
+}
+
+func (r *endpointRepository) ListProfileEndpoints(
+	_ context.Context,
+	profileID string,
+) ([]*webhooks.Endpoint, error) {
+	result := []*webhooks.Endpoint{}
+
+	for _, endpoint := range r.endpoints {
+		if endpoint.ProfileID == profileID {
+			result = append(result, endpoint)
+		}
+	}
+
+	return result, nil
+}
+
+func (r *endpointRepository) GetEndpointSecret(_ context.Context, endpointID string) (string, error) {
+	return r.secrets[endpointID], nil
+}
+
+// statusSender answers every send with the same status code.
+type statusSender struct {
+	requests   []*webhooks.Request
+	statusCode int
+}
+
+func (s *statusSender) Send(_ context.Context, request *webhooks.Request) (int, error) {
+	s.requests = append(s.requests, request)
+
+	return s.statusCode, nil
+}
+
+func newMembershipService(
+	repo *endpointRepository,
+	sender webhooks.Sender,
+	maxAttempts int,
+) *webhooks.Service {
+	service := webhooks.NewService(
+		nil,
+		&webhooks.Config{ //nolint:exhaustruct
+			MaxAttempts:  maxAttempts,
+			RetryBackoff: time.Millisecond,
+		},
+		repo,
+		sender,
+		webhooks.DefaultIDGenerator,
+	)
+	service.RegisterSecretResolver(webhooks.SourceMembership, service.ResolveEndpointSecrets)
+
+	return service
+}
+
+func membershipEvent(eventType events.EventType) events.AuditParams {
+	actorID := "user-1"
+	memberProfileID := "member-1"
+
+	return events.AuditParams{ //nolint:exhaustruct
+		EventType:  eventType,
+		EntityType: "membership",
+		EntityID:   "membership-1",
+		ActorID:    &actorID,
+		ActorKind:  events.ActorUser,
+		Payload: map[string]any{
+			"profile_id":        "p1",
+			"member_profile_id": &memberProfileID,
+			"kind":              "member",
+		},
+	}
+}
+
+func TestDispatchMembershipEvent_Payload(t *testing.T) {
+	t.Parallel()
+
+	repo := newEndpointRepository()
+	sender := &statusSender{statusCode: http.StatusOK} //nolint:exhaustruct
+	service := newMembershipService(repo, sender, 3)
+
+	err := service.DispatchMembershipEvent(
+		t.Context(),
+		membershipEvent(events.ProfileMembershipCreated),
+	)
+	require.NoError(t, err)
+	require.Len(t, sender.requests, 1)
+
+	request := sender.requests[0]
+	assert.Equal(t, "https://example.com/hooks", request.URL)
+	assert.Equal(t, "profile_membership.created", request.Headers[webhooks.HeaderEvent])
+	require.NoError(t, webhooksig.Verify(
+		request.Headers[webhooksig.HeaderSignature],
+		request.Body,
+		[]string{"secret-1"},
+		webhooksig.DefaultTolerance,
+		time.Now(),
+	))
+
+	var payload map[string]any
+
+	require.NoError(t, json.Unmarshal(request.Body, &payload))
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"event", "membership_id", "profile_id", "member_profile_id",
+			"actor_kind", "occurred_at", "data",
+		},
+		keys(payload),
+	)
+	assert.Equal(t, "profile_membership.created", payload["event"])
+	assert.Equal(t, "membership-1", payload["membership_id"])
+	assert.Equal(t, "p1", payload["profile_id"])
+	assert.Equal(t, "member-1", payload["member_profile_id"])
+	assert.Equal(t, "user", payload["actor_kind"])
+	assert.Equal(t, map[string]any{
+		"profile_id":        "p1",
+		"member_profile_id": "member-1",
+		"kind":              "member",
+	}, payload["data"])
+
+	_, err = time.Parse(time.RFC3339, payload["occurred_at"].(string)) //nolint:forcetypeassert
+	require.NoError(t, err)
+}
+
+func TestDispatchMembershipEvent_RetriesServerErrors(t *testing.T) {
+	t.Parallel()
+
+	repo := newEndpointRepository()
+	sender := &statusSender{statusCode: http.StatusInternalServerError} //nolint:exhaustruct
+	service := newMembershipService(repo, sender, 3)
+
+	err := service.DispatchMembershipEvent(
+		t.Context(),
+		membershipEvent(events.ProfileMembershipDeleted),
+	)
+	require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+	require.ErrorIs(t, err, webhooks.ErrUnexpectedResponseStatus)
+
+	assert.Len(t, sender.requests, 3)
+	require.Len(t, repo.deliveries, 1)
+
+	for _, delivery := range repo.deliveries {
+		assert.Equal(t, webhooks.DeliveryStatusFailed, delivery.Status)
+		assert.Equal(t, 3, delivery.AttemptCount)
+	}
+}
+
+func TestDispatchMembershipEvent_StopsRetryingOnSuccess(t *testing.T) {
+	t.Parallel()
+
+	repo := newEndpointRepository()
+	sender := &scriptedSender{ //nolint:exhaustruct
+		responses: []scriptedResponse{
+			{err: nil, statusCode: http.StatusInternalServerError},
+			{err: nil, statusCode: http.StatusAccepted},
+		},
+	}
+	service := newMembershipService(repo, sender, 5)
+
+	err := service.DispatchMembershipEvent(
+		t.Context(),
+		membershipEvent(events.ProfileMembershipUpdated),
+	)
+	require.NoError(t, err)
+
+	assert.Len(t, sender.requests, 2)
+	assert.Len(t, repo.attempts, 2)
+}
+
+func TestDispatchMembershipEvent_IgnoresOtherEvents(t *testing.T) {
+	t.Parallel()
+
+	repo := newEndpointRepository()
+	sender := &statusSender{statusCode: http.StatusOK} //nolint:exhaustruct
+	service := newMembershipService(repo, sender, 3)
+
+	unrelated := membershipEvent(events.ProfileMembershipCreated)
+	unrelated.EventType = events.StoryPublished
+
+	otherProfile := membershipEvent(events.ProfileMembershipCreated)
+	otherProfile.Payload["profile_id"] = "p2"
+
+	for _, params := range []events.AuditParams{unrelated, otherProfile} {
+		require.NoError(t, service.DispatchMembershipEvent(t.Context(), params))
+	}
+
+	assert.Empty(t, sender.requests)
+}
+
+var errQueueUnavailable = errors.New("queue unavailable")
+
+// membershipQueue keeps enqueued items in memory, or fails every enqueue when
+// unavailable is set.
+type membershipQueue struct {
+	events.QueueRepository
+
+	items       []map[string]any
+	unavailable bool
+}
+
+func (r *membershipQueue) Enqueue(
+	_ context.Context,
+	_ string,
+	itemType events.QueueItemType,
+	payload map[string]any,
+	_ int,
+	_ int,
+	_ time.Time,
+) error {
+	if r.unavailable {
+		return errQueueUnavailable
+	}
+
+	if itemType == events.QueueItemTypeWebhookMembership {
+		r.items = append(r.items, payload)
+	}
+
+	return nil
+}
+
+func TestHandleAuditRecorded_QueuesMembershipEvents(t *testing.T) {
+	t.Parallel()
+
+	repo := newEndpointRepository()
+	sender := &statusSender{statusCode: http.StatusOK} //nolint:exhaustruct
+	service := newMembershipService(repo, sender, 3)
+
+	queue := &membershipQueue{} //nolint:exhaustruct
+	service.SetQueueService(events.NewQueueService(nil, queue, func() string { return "item-1" }))
+
+	service.HandleAuditRecorded(t.Context(), membershipEvent(events.ProfileMembershipCreated))
+	service.HandleAuditRecorded(t.Context(), membershipEvent(events.StoryPublished))
+
+	assert.Empty(t, sender.requests, "deliveries run on the queue")
+	require.Len(t, queue.items, 1)
+
+	encoded, err := json.Marshal(queue.items[0])
+	require.NoError(t, err)
+
+	var task webhooks.MembershipEventTask
+
+	require.NoError(t, json.Unmarshal(encoded, &task))
+	require.NoError(t, service.DispatchMembershipEvent(t.Context(), task.AuditParams()))
+	require.Len(t, sender.requests, 1)
+
+	var payload webhooks.MembershipEventPayload
+
+	require.NoError(t, json.Unmarshal(sender.requests[0].Body, &payload))
+	assert.Equal(t, "profile_membership.created", payload.Event)
+	assert.Equal(t, "membership-1", payload.MembershipID)
+	assert.Equal(t, "p1", payload.ProfileID)
+	require.NotNil(t, payload.MemberProfileID)
+	assert.Equal(t, "member-1", *payload.MemberProfileID)
+}
+
+func TestHandleAuditRecorded_DeliversWhenQueueFails(t *testing.T) {
+	t.Parallel()
+
+	repo := newEndpointRepository()
+	sender := &statusSender{statusCode: http.StatusInternalServerError} //nolint:exhaustruct
+	service := webhooks.NewService(
+		logfx.NewLogger(),
+		&webhooks.Config{MaxAttempts: 3, RetryBackoff: time.Millisecond}, //nolint:exhaustruct
+		repo,
+		sender,
+		webhooks.DefaultIDGenerator,
+	)
+	service.RegisterSecretResolver(webhooks.SourceMembership, service.ResolveEndpointSecrets)
+	service.SetQueueService(events.NewQueueService(
+		logfx.NewLogger(),
+		&membershipQueue{unavailable: true}, //nolint:exhaustruct
+		func() string { return "item-1" },
+	))
+
+	service.HandleAuditRecorded(t.Context(), membershipEvent(events.ProfileMembershipCreated))
+
+	// A single attempt; the failed delivery is left in the log for redelivery.
+	assert.Len(t, sender.requests, 1)
+	require.Len(t, repo.deliveries, 1)
+
+	for _, delivery := range repo.deliveries {
+		assert.Equal(t, webhooks.DeliveryStatusFailed, delivery.Status)
+	}
+}
+
+func keys(values map[string]any) []string {
+	result := make([]string, 0, len(values))
+	for key := range values {
+		result = append(result, key)
+	}
+
+	return result
+}
@@ -0,0 +1,55 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+// Repository defines the storage operations for the webhook delivery log (port).
+type Repository interface {
+	// CreateDelivery stores a new delivery before its first attempt.
+	CreateDelivery(ctx context.Context, delivery *Delivery) error
+
+	// RecordDeliveryAttempt stores an attempt and updates the delivery's status,
+	// attempt count and last response code.
+	RecordDeliveryAttempt(ctx context.Context, attempt *DeliveryAttempt) error
+
+	// GetDeliveryByID returns a delivery, or nil if it doesn't exist.
+	GetDeliveryByID(ctx context.Context, id string) (*Delivery, error)
+
+	// ListDeliveryAttempts returns the attempts of a delivery, newest first.
+	ListDeliveryAttempts(ctx context.Context, deliveryID string) ([]*DeliveryAttempt, error)
+
+	// ListDeliveries returns deliveries, newest first.
+	ListDeliveries(
+		ctx context.Context,
+		filter DeliveryFilter,
+		cursor *cursors.Cursor,
+	) (cursors.Cursored[[]*Delivery], error)
+
+	// DeleteDeliveriesCreatedBefore removes deliveries (and their attempts) older than cutoff.
+	DeleteDeliveriesCreatedBefore(ctx context.Context, cutoff time.Time) (int64, error)
+
+	// CreateEndpoint stores a new profile endpoint together with its secret.
+	CreateEndpoint(ctx context.Context, endpoint *Endpoint) error
+
+	// ListProfileEndpoints returns the endpoints of a profile, oldest first,
+	// without their secrets.
+	ListProfileEndpoints(ctx context.Context, profileID string) ([]*Endpoint, error)
+
+	// GetEndpointSecret returns the secret of an endpoint, or "" if it doesn't exist.
+	GetEndpointSecret(ctx context.Context, endpointID string) (string, error)
+
+	// DeleteEndpoint removes an endpoint of a profile. Reports false when the
+	// profile has no such endpoint.
+	DeleteEndpoint(ctx context.Context, profileID string, endpointID string) (bool, error)
+}
+
+// Sender performs outbound webhook HTTP requests (port).
+type Sender interface {
+	// Send posts the request and returns the response status code. A transport
+	// failure returns an error and a zero status code.
+	Send(ctx context.Context, request *Request) (int, error)
+}
@@ -0,0 +1,285 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/eser/aya.is/services/pkg/lib/webhooksig"
+)
+
+func DefaultIDGenerator() string {
+	return lib.IDsGenerateUnique()
+}
+
+// Service delivers outbound webhooks and keeps a log of every attempt.
+// Dispatchers hand their messages to Deliver instead of posting them directly.
+type Service struct {
+	logger          *logfx.Logger
+	config          *Config
+	repo            Repository
+	sender          Sender
+	idGenerator     IDGenerator
+	secretResolvers map[string]SecretResolver
+	queueService    *events.QueueService
+}
+
+// NewService creates a new webhooks service.
+func NewService(
+	logger *logfx.Logger,
+	config *Config,
+	repo Repository,
+	sender Sender,
+	idGenerator IDGenerator,
+) *Service {
+	return &Service{
+		logger:          logger,
+		config:          config,
+		repo:            repo,
+		sender:          sender,
+		idGenerator:     idGenerator,
+		secretResolvers: make(map[string]SecretResolver),
+		queueService:    nil,
+	}
+}
+
+// RegisterSecretResolver sets how the signing secrets of a source's endpoints
+// are looked up. Secrets are resolved on every attempt, so redeliveries are
+// signed with the endpoint's current secrets. Register resolvers during startup.
+func (s *Service) RegisterSecretResolver(source string, resolver SecretResolver) {
+	s.secretResolvers[source] = resolver
+}
+
+// Deliver logs the message as a new delivery and makes the first attempt.
+// The delivery is returned even when the attempt fails; the error then wraps
+// ErrDeliveryFailed.
+func (s *Service) Deliver(ctx context.Context, message Message) (*Delivery, error) {
+	delivery := &Delivery{
+		CreatedAt:        time.Now().UTC(),
+		LastAttemptedAt:  nil,
+		LastResponseCode: nil,
+		EndpointID:       nil,
+		ID:               s.idGenerator(),
+		Source:           message.Source,
+		EventType:        message.EventType,
+		TargetURL:        message.TargetURL,
+		Status:           DeliveryStatusPending,
+		Payload:          message.Payload,
+		Attempts:         nil,
+		AttemptCount:     0,
+	}
+
+	if message.EndpointID != "" {
+		delivery.EndpointID = &message.EndpointID
+	}
+
+	err := s.repo.CreateDelivery(ctx, delivery)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToRecordDelivery, err)
+	}
+
+	return delivery, s.attempt(ctx, delivery)
+}
+
+// DeliverWithRetries delivers the message like Deliver and retries a failed
+// attempt up to the configured number of attempts, waiting RetryBackoff before
+// the first retry and doubling the wait after each. Every attempt is logged on
+// the same delivery.
+func (s *Service) DeliverWithRetries(ctx context.Context, message Message) (*Delivery, error) {
+	delivery, err := s.Deliver(ctx, message)
+	if delivery == nil {
+		return nil, err
+	}
+
+	backoff := s.config.RetryBackoff
+
+	for err != nil && delivery.AttemptCount < s.config.MaxAttempts {
+		timer := time.NewTimer(backoff)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return delivery, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		err = s.attempt(ctx, delivery)
+	}
+
+	return delivery, err
+}
+
+// Redeliver makes a new attempt at a failed delivery.
+func (s *Service) Redeliver(ctx context.Context, deliveryID string) (*Delivery, error) {
+	delivery, err := s.repo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetDelivery, deliveryID, err)
+	}
+
+	if delivery == nil {
+		return nil, ErrDeliveryNotFound
+	}
+
+	if delivery.Status != DeliveryStatusFailed {
+		return nil, ErrDeliveryNotFailed
+	}
+
+	return delivery, s.attempt(ctx, delivery)
+}
+
+// GetDelivery returns a delivery with all of its attempts.
+func (s *Service) GetDelivery(ctx context.Context, deliveryID string) (*Delivery, error) {
+	delivery, err := s.repo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetDelivery, deliveryID, err)
+	}
+
+	if delivery == nil {
+		return nil, ErrDeliveryNotFound
+	}
+
+	attempts, err := s.repo.ListDeliveryAttempts(ctx, deliveryID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetDelivery, deliveryID, err)
+	}
+
+	delivery.Attempts = attempts
+
+	return delivery, nil
+}
+
+// ListDeliveries returns the delivery log, newest first.
+func (s *Service) ListDeliveries(
+	ctx context.Context,
+	filter DeliveryFilter,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*Delivery], error) {
+	deliveries, err := s.repo.ListDeliveries(ctx, filter, cursor)
+	if err != nil {
+		return cursors.Cursored[[]*Delivery]{}, fmt.Errorf(
+			"%w: %w",
+			ErrFailedToListDeliveries,
+			err,
+		)
+	}
+
+	return deliveries, nil
+}
+
+// PurgeExpiredDeliveries removes deliveries older than the retention period.
+func (s *Service) PurgeExpiredDeliveries(ctx context.Context) (int64, error) {
+	cutoff := time.Now().UTC().Add(-s.config.RetentionPeriod)
+
+	deleted, err := s.repo.DeleteDeliveriesCreatedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFailedToPurgeDeliveries, err)
+	}
+
+	return deleted, nil
+}
+
+// attempt sends the delivery once, records the outcome and updates delivery in place.
+func (s *Service) attempt(ctx context.Context, delivery *Delivery) error {
+	attempt := &DeliveryAttempt{
+		AttemptedAt:  time.Now().UTC(),
+		ResponseCode: nil,
+		ErrorMessage: nil,
+		ID:           s.idGenerator(),
+		DeliveryID:   delivery.ID,
+		Status:       DeliveryStatusSucceeded,
+		DurationMs:   0,
+	}
+
+	var (
+		statusCode int
+		sendErr    error
+	)
+
+	headers, headersErr := s.requestHeaders(ctx, delivery, attempt.AttemptedAt)
+	if headersErr != nil {
+		sendErr = headersErr
+	} else {
+		statusCode, sendErr = s.sender.Send(ctx, &Request{
+			Headers: headers,
+			URL:     delivery.TargetURL,
+			Body:    delivery.Payload,
+		})
+	}
+
+	attempt.DurationMs = time.Since(attempt.AttemptedAt).Milliseconds()
+
+	if statusCode != 0 {
+		attempt.ResponseCode = &statusCode
+	}
+
+	var deliveryErr error
+
+	switch {
+	case sendErr != nil:
+		deliveryErr = sendErr
+	case statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices:
+		deliveryErr = fmt.Errorf("%w: %d", ErrUnexpectedResponseStatus, statusCode)
+	}
+
+	if deliveryErr != nil {
+		message := deliveryErr.Error()
+		attempt.Status = DeliveryStatusFailed
+		attempt.ErrorMessage = &message
+	}
+
+	recordErr := s.repo.RecordDeliveryAttempt(ctx, attempt)
+	if recordErr != nil {
+		s.logger.WarnContext(ctx, "Failed to record webhook delivery attempt",
+			slog.String("delivery_id", delivery.ID),
+			slog.String("error", recordErr.Error()))
+	}
+
+	delivery.Status = attempt.Status
+	delivery.AttemptCount++
+	delivery.LastResponseCode = attempt.ResponseCode
+	delivery.LastAttemptedAt = &attempt.AttemptedAt
+
+	if deliveryErr != nil {
+		return fmt.Errorf("%w(id: %s): %w", ErrDeliveryFailed, delivery.ID, deliveryErr)
+	}
+
+	return nil
+}
+
+// requestHeaders builds the headers of an attempt, signing the payload when
+// the delivery's endpoint has secrets.
+func (s *Service) requestHeaders(
+	ctx context.Context,
+	delivery *Delivery,
+	timestamp time.Time,
+) (map[string]string, error) {
+	headers := map[string]string{
+		"Content-Type": "application/json",
+		HeaderEvent:    delivery.EventType,
+		HeaderDelivery: delivery.ID,
+	}
+
+	resolver, ok := s.secretResolvers[delivery.Source]
+	if !ok || delivery.EndpointID == nil {
+		return headers, nil
+	}
+
+	secrets, err := resolver(ctx, *delivery.EndpointID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToResolveSecrets, err)
+	}
+
+	if len(secrets) > 0 {
+		headers[webhooksig.HeaderSignature] = webhooksig.Sign(delivery.Payload, timestamp, secrets...)
+	}
+
+	return headers, nil
+}
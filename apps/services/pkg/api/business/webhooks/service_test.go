@@ -0,0 +1,343 @@
+package webhooks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/api/business/webhooks"
+	"github.com/eser/aya.is/services/pkg/lib/webhooksig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errConnectionRefused = errors.New("connection refused")
+	errSecretStore       = errors.New("secret store unavailable")
+)
+
+// memoryRepository keeps the delivery log in memory.
+type memoryRepository struct {
+	webhooks.Repository
+
+	deliveries map[string]*webhooks.Delivery
+	attempts   []*webhooks.DeliveryAttempt
+	cutoff     time.Time
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{ //nolint:exhaustruct
+		deliveries: make(map[string]*webhooks.Delivery),
+	}
+}
+
+func (r *memoryRepository) CreateDelivery(_ context.Context, delivery *webhooks.Delivery) error {
+	stored := *delivery
+	r.deliveries[delivery.ID] = &stored
+
+	return nil
+}
+
+func (r *memoryRepository) RecordDeliveryAttempt(
+	_ context.Context,
+	attempt *webhooks.DeliveryAttempt,
+) error {
+	r.attempts = append(r.attempts, attempt)
+
+	delivery := r.deliveries[attempt.DeliveryID]
+	delivery.Status = attempt.Status
+	delivery.AttemptCount++
+	delivery.LastResponseCode = attempt.ResponseCode
+	delivery.LastAttemptedAt = &attempt.AttemptedAt
+
+	return nil
+}
+
+func (r *memoryRepository) GetDeliveryByID(
+	_ context.Context,
+	id string,
+) (*webhooks.Delivery, error) {
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	stored := *delivery
+
+	return &stored, nil
+}
+
+func (r *memoryRepository) DeleteDeliveriesCreatedBefore(
+	_ context.Context,
+	cutoff time.Time,
+) (int64, error) {
+	r.cutoff = cutoff
+
+	return 0, nil
+}
+
+// scriptedSender answers each send with the next scripted response.
+type scriptedSender struct {
+	requests  []*webhooks.Request
+	responses []scriptedResponse
+}
+
+type scriptedResponse struct {
+	err        error
+	statusCode int
+}
+
+func (s *scriptedSender) Send(_ context.Context, request *webhooks.Request) (int, error) {
+	response := s.responses[len(s.requests)]
+	s.requests = append(s.requests, request)
+
+	return response.statusCode, response.err
+}
+
+func newService(
+	repo webhooks.Repository,
+	sender webhooks.Sender,
+) *webhooks.Service {
+	counter := 0
+
+	return webhooks.NewService(
+		nil,
+		&webhooks.Config{RequestTimeout: time.Second, RetentionPeriod: 24 * time.Hour},
+		repo,
+		sender,
+		func() string {
+			counter++
+
+			return fmt.Sprintf("id-%02d", counter)
+		},
+	)
+}
+
+func newMessage() webhooks.Message {
+	return webhooks.Message{
+		Source:    "membership",
+		EventType: "profile_membership.created",
+		TargetURL: "https://example.com/hooks",
+		Payload:   []byte(`{"profile_id":"p1"}`),
+	}
+}
+
+func TestService_Deliver_LogsSuccess(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemoryRepository()
+	sender := &scriptedSender{ //nolint:exhaustruct
+		responses: []scriptedResponse{{err: nil, statusCode: http.StatusNoContent}},
+	}
+
+	delivery, err := newService(repo, sender).Deliver(t.Context(), newMessage())
+	require.NoError(t, err)
+
+	assert.Equal(t, webhooks.DeliveryStatusSucceeded, delivery.Status)
+	assert.Equal(t, 1, delivery.AttemptCount)
+
+	require.Len(t, repo.attempts, 1)
+	attempt := repo.attempts[0]
+	assert.Equal(t, delivery.ID, attempt.DeliveryID)
+	assert.Equal(t, webhooks.DeliveryStatusSucceeded, attempt.Status)
+	require.NotNil(t, attempt.ResponseCode)
+	assert.Equal(t, http.StatusNoContent, *attempt.ResponseCode)
+	assert.Nil(t, attempt.ErrorMessage)
+	assert.False(t, attempt.AttemptedAt.IsZero())
+
+	require.Len(t, sender.requests, 1)
+	request := sender.requests[0]
+	assert.Equal(t, "https://example.com/hooks", request.URL)
+	assert.Equal(t, "profile_membership.created", request.Headers[webhooks.HeaderEvent])
+	assert.Equal(t, delivery.ID, request.Headers[webhooks.HeaderDelivery])
+	assert.JSONEq(t, `{"profile_id":"p1"}`, string(request.Body))
+
+	assert.Equal(t, webhooks.DeliveryStatusSucceeded, repo.deliveries[delivery.ID].Status)
+}
+
+func TestService_Deliver_LogsFailure(t *testing.T) {
+	t.Parallel()
+
+	t.Run("non-2xx response", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newMemoryRepository()
+		sender := &scriptedSender{ //nolint:exhaustruct
+			responses: []scriptedResponse{{err: nil, statusCode: http.StatusInternalServerError}},
+		}
+
+		delivery, err := newService(repo, sender).Deliver(t.Context(), newMessage())
+		require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+		require.ErrorIs(t, err, webhooks.ErrUnexpectedResponseStatus)
+		require.NotNil(t, delivery)
+
+		assert.Equal(t, webhooks.DeliveryStatusFailed, delivery.Status)
+
+		require.Len(t, repo.attempts, 1)
+		attempt := repo.attempts[0]
+		assert.Equal(t, webhooks.DeliveryStatusFailed, attempt.Status)
+		require.NotNil(t, attempt.ResponseCode)
+		assert.Equal(t, http.StatusInternalServerError, *attempt.ResponseCode)
+		require.NotNil(t, attempt.ErrorMessage)
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newMemoryRepository()
+		sender := &scriptedSender{ //nolint:exhaustruct
+			responses: []scriptedResponse{{err: errConnectionRefused, statusCode: 0}},
+		}
+
+		delivery, err := newService(repo, sender).Deliver(t.Context(), newMessage())
+		require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+		require.ErrorIs(t, err, errConnectionRefused)
+
+		assert.Equal(t, webhooks.DeliveryStatusFailed, delivery.Status)
+		assert.Nil(t, delivery.LastResponseCode)
+
+		require.Len(t, repo.attempts, 1)
+		assert.Nil(t, repo.attempts[0].ResponseCode)
+		require.NotNil(t, repo.attempts[0].ErrorMessage)
+		assert.Contains(t, *repo.attempts[0].ErrorMessage, "connection refused")
+	})
+}
+
+func TestService_Redeliver(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemoryRepository()
+	sender := &scriptedSender{ //nolint:exhaustruct
+		responses: []scriptedResponse{
+			{err: nil, statusCode: http.StatusBadGateway},
+			{err: nil, statusCode: http.StatusOK},
+		},
+	}
+	service := newService(repo, sender)
+
+	failed, err := service.Deliver(t.Context(), newMessage())
+	require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+
+	redelivered, err := service.Redeliver(t.Context(), failed.ID)
+	require.NoError(t, err)
+
+	assert.Equal(t, failed.ID, redelivered.ID)
+	assert.Equal(t, webhooks.DeliveryStatusSucceeded, redelivered.Status)
+	assert.Equal(t, 2, redelivered.AttemptCount)
+	assert.Len(t, repo.attempts, 2)
+
+	_, err = service.Redeliver(t.Context(), failed.ID)
+	require.ErrorIs(t, err, webhooks.ErrDeliveryNotFailed)
+
+	_, err = service.Redeliver(t.Context(), "missing")
+	require.ErrorIs(t, err, webhooks.ErrDeliveryNotFound)
+}
+
+func TestService_PurgeExpiredDeliveries(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemoryRepository()
+	service := newService(repo, &scriptedSender{}) //nolint:exhaustruct
+
+	before := time.Now().UTC().Add(-24 * time.Hour)
+
+	_, err := service.PurgeExpiredDeliveries(t.Context())
+	require.NoError(t, err)
+
+	assert.WithinDuration(t, before, repo.cutoff, time.Minute)
+}
+
+func TestService_Deliver_SignsWithEndpointSecrets(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemoryRepository()
+	sender := &scriptedSender{ //nolint:exhaustruct
+		responses: []scriptedResponse{
+			{err: nil, statusCode: http.StatusServiceUnavailable},
+			{err: nil, statusCode: http.StatusOK},
+		},
+	}
+	service := newService(repo, sender)
+
+	secrets := []string{"secret-1"}
+	service.RegisterSecretResolver("membership", func(_ context.Context, endpointID string) ([]string, error) {
+		assert.Equal(t, "endpoint-1", endpointID)
+
+		return secrets, nil
+	})
+
+	message := newMessage()
+	message.EndpointID = "endpoint-1"
+
+	delivery, err := service.Deliver(t.Context(), message)
+	require.ErrorIs(t, err, webhooks.ErrDeliveryFailed)
+	require.NotNil(t, delivery.EndpointID)
+
+	first := sender.requests[0]
+	require.NoError(t, webhooksig.Verify(
+		first.Headers[webhooksig.HeaderSignature],
+		first.Body,
+		[]string{"secret-1"},
+		webhooksig.DefaultTolerance,
+		time.Now(),
+	))
+
+	// The endpoint rotates its secret before the delivery is retried.
+	secrets = []string{"secret-2", "secret-1"}
+
+	_, err = service.Redeliver(t.Context(), delivery.ID)
+	require.NoError(t, err)
+
+	second := sender.requests[1]
+	for _, secret := range secrets {
+		require.NoError(t, webhooksig.Verify(
+			second.Headers[webhooksig.HeaderSignature],
+			second.Body,
+			[]string{secret},
+			webhooksig.DefaultTolerance,
+			time.Now(),
+		))
+	}
+}
+
+func TestService_Deliver_UnsignedWithoutEndpoint(t *testing.T) {
+	t.Parallel()
+
+	sender := &scriptedSender{ //nolint:exhaustruct
+		responses: []scriptedResponse{{err: nil, statusCode: http.StatusOK}},
+	}
+	service := newService(newMemoryRepository(), sender)
+	service.RegisterSecretResolver("membership", func(_ context.Context, _ string) ([]string, error) {
+		return []string{"secret-1"}, nil
+	})
+
+	_, err := service.Deliver(t.Context(), newMessage())
+	require.NoError(t, err)
+
+	assert.NotContains(t, sender.requests[0].Headers, webhooksig.HeaderSignature)
+}
+
+func TestService_Deliver_SecretResolverFailureIsLogged(t *testing.T) {
+	t.Parallel()
+
+	repo := newMemoryRepository()
+	sender := &scriptedSender{} //nolint:exhaustruct
+	service := newService(repo, sender)
+	service.RegisterSecretResolver("membership", func(_ context.Context, _ string) ([]string, error) {
+		return nil, errSecretStore
+	})
+
+	message := newMessage()
+	message.EndpointID = "endpoint-1"
+
+	delivery, err := service.Deliver(t.Context(), message)
+	require.ErrorIs(t, err, webhooks.ErrFailedToResolveSecrets)
+
+	assert.Empty(t, sender.requests)
+	assert.Equal(t, webhooks.DeliveryStatusFailed, delivery.Status)
+	require.Len(t, repo.attempts, 1)
+	assert.Nil(t, repo.attempts[0].ResponseCode)
+}
@@ -0,0 +1,91 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Headers sent with every webhook request.
+const (
+	HeaderEvent    = "X-Aya-Event"
+	HeaderDelivery = "X-Aya-Delivery"
+)
+
+// DeliveryStatus represents the outcome of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusFailed    DeliveryStatus = "failed"
+)
+
+// Message is a webhook a dispatcher wants delivered.
+type Message struct {
+	// Source names the dispatcher, e.g. "membership" or "story_publish".
+	Source string
+	// EndpointID identifies the receiving endpoint to the dispatcher's
+	// SecretResolver. Leave it empty to send the webhook unsigned.
+	EndpointID string
+	EventType  string
+	TargetURL  string
+	Payload    json.RawMessage
+}
+
+// Delivery is the log entry of a webhook and the state of its latest attempt.
+type Delivery struct {
+	CreatedAt        time.Time          `json:"created_at"`
+	LastAttemptedAt  *time.Time         `json:"last_attempted_at"`
+	LastResponseCode *int               `json:"last_response_code"`
+	EndpointID       *string            `json:"endpoint_id"`
+	ID               string             `json:"id"`
+	Source           string             `json:"source"`
+	EventType        string             `json:"event_type"`
+	TargetURL        string             `json:"target_url"`
+	Status           DeliveryStatus     `json:"status"`
+	Payload          json.RawMessage    `json:"payload"`
+	Attempts         []*DeliveryAttempt `json:"attempts,omitempty"`
+	AttemptCount     int                `json:"attempt_count"`
+}
+
+// DeliveryAttempt records a single try at delivering a webhook.
+type DeliveryAttempt struct {
+	AttemptedAt  time.Time      `json:"attempted_at"`
+	ResponseCode *int           `json:"response_code"`
+	ErrorMessage *string        `json:"error_message"`
+	ID           string         `json:"id"`
+	DeliveryID   string         `json:"delivery_id"`
+	Status       DeliveryStatus `json:"status"`
+	DurationMs   int64          `json:"duration_ms"`
+}
+
+// DeliveryFilter narrows the delivery log listing.
+type DeliveryFilter struct {
+	Source *string
+	Status *DeliveryStatus
+}
+
+// Endpoint is a URL a profile registered to receive its webhooks. The secret is
+// only filled in when the endpoint is created.
+type Endpoint struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+	ProfileID string    `json:"profile_id"`
+	TargetURL string    `json:"target_url"`
+	Secret    string    `json:"secret,omitempty"`
+}
+
+// Request is an outbound HTTP request carrying a webhook.
+type Request struct {
+	Headers map[string]string
+	URL     string
+	Body    []byte
+}
+
+// SecretResolver returns the signing secrets of an endpoint, newest first.
+// During a rotation it returns both the new and the old secret.
+type SecretResolver func(ctx context.Context, endpointID string) ([]string, error)
+
+// IDGenerator is a function that generates unique IDs.
+type IDGenerator func() string
@@ -0,0 +1,16 @@
+package webmentions
+
+import "time"
+
+// Config holds configuration for receiving webmentions.
+type Config struct {
+	// RateLimitPerMinute bounds how many webmentions a client may send per minute.
+	RateLimitPerMinute int `conf:"rate_limit_per_minute" default:"10"`
+
+	// FetchTimeout bounds fetching a mention's source while verifying it.
+	FetchTimeout time.Duration `conf:"fetch_timeout" default:"10s"`
+
+	// MaxSourceBytes is how much of a mention's source is read while looking
+	// for the link to its target.
+	MaxSourceBytes int64 `conf:"max_source_bytes" default:"1048576"`
+}
@@ -0,0 +1,19 @@
+package webmentions
+
+import "errors"
+
+// Sentinel errors.
+var (
+	ErrInvalidSource         = errors.New("source must be an absolute http(s) URL")
+	ErrInvalidTarget         = errors.New("target must be an absolute http(s) URL")
+	ErrSameSourceAndTarget   = errors.New("source and target must differ")
+	ErrProfileNotFound       = errors.New("profile not found")
+	ErrTargetNotOwned        = errors.New("target is not served for this profile")
+	ErrWebmentionNotFound    = errors.New("webmention not found")
+	ErrSourceUnavailable     = errors.New("webmention source could not be fetched")
+	ErrFailedToGetRecord     = errors.New("failed to get record")
+	ErrFailedToListRecords   = errors.New("failed to list records")
+	ErrFailedToStoreRecord   = errors.New("failed to store webmention")
+	ErrFailedToUpdateRecord  = errors.New("failed to update webmention")
+	ErrFailedToEnqueueVerify = errors.New("failed to queue webmention verification")
+)
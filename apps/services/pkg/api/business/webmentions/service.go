@@ -0,0 +1,261 @@
+package webmentions
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/lib"
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"golang.org/x/net/html"
+)
+
+func DefaultIDGenerator() string {
+	return lib.IDsGenerateUnique()
+}
+
+// Service receives webmentions for profiles on custom domains. A received
+// mention is stored as pending and verified on the event queue by fetching its
+// source and looking for a link to its target; Verify is the queue item's handler.
+type Service struct {
+	logger       *logfx.Logger
+	repo         Repository
+	profiles     ProfileResolver
+	fetcher      SourceFetcher
+	queueService *events.QueueService
+	idGenerator  IDGenerator
+}
+
+// NewService creates a new webmentions service.
+func NewService(
+	logger *logfx.Logger,
+	repo Repository,
+	profileResolver ProfileResolver,
+	fetcher SourceFetcher,
+	queueService *events.QueueService,
+	idGenerator IDGenerator,
+) *Service {
+	return &Service{
+		logger:       logger,
+		repo:         repo,
+		profiles:     profileResolver,
+		fetcher:      fetcher,
+		queueService: queueService,
+		idGenerator:  idGenerator,
+	}
+}
+
+// Receive accepts a mention of target by source for the profile. The target
+// must be a page on one of the profile's active custom domains. The mention is
+// stored as pending and its verification queued.
+func (s *Service) Receive(
+	ctx context.Context,
+	profileSlug string,
+	source string,
+	target string,
+) (*Webmention, error) {
+	sourceURL, ok := parseMentionURL(source)
+	if !ok {
+		return nil, ErrInvalidSource
+	}
+
+	targetURL, ok := parseMentionURL(target)
+	if !ok {
+		return nil, ErrInvalidTarget
+	}
+
+	if normalizeURL(sourceURL) == normalizeURL(targetURL) {
+		return nil, ErrSameSourceAndTarget
+	}
+
+	profileID, err := s.profiles.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		if errors.Is(err, profiles.ErrProfileNotFound) {
+			return nil, ErrProfileNotFound
+		}
+
+		return nil, fmt.Errorf("%w(slug: %s): %w", ErrFailedToGetRecord, profileSlug, err)
+	}
+
+	targetProfileID, err := s.profiles.GetProfileIDByActiveCustomDomain(ctx, targetURL.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("%w(host: %s): %w", ErrFailedToGetRecord, targetURL.Hostname(), err)
+	}
+
+	if targetProfileID == "" || targetProfileID != profileID {
+		return nil, ErrTargetNotOwned
+	}
+
+	mention, err := s.repo.UpsertWebmention(
+		ctx,
+		s.idGenerator(),
+		profileID,
+		sourceURL.String(),
+		targetURL.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToStoreRecord, err)
+	}
+
+	_, err = s.queueService.Enqueue(ctx, events.QueueEnqueueParams{
+		Type:                  events.QueueItemTypeWebmentionVerify,
+		Payload:               map[string]any{"webmention_id": mention.ID},
+		ScheduledAt:           nil,
+		MaxRetries:            0,
+		VisibilityTimeoutSecs: 0,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToEnqueueVerify, mention.ID, err)
+	}
+
+	return mention, nil
+}
+
+// Verify fetches the source of a pending mention and marks the mention verified
+// when the source links to the target, or rejected when it doesn't. A source that
+// can't be fetched returns an error so the queue retries. Mentions that are no
+// longer pending are left alone, so queue retries are harmless.
+func (s *Service) Verify(ctx context.Context, webmentionID string) (*Webmention, error) {
+	mention, err := s.repo.GetWebmentionByID(ctx, webmentionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToGetRecord, webmentionID, err)
+	}
+
+	if mention == nil {
+		return nil, fmt.Errorf("%w(id: %s)", ErrWebmentionNotFound, webmentionID)
+	}
+
+	if mention.Status != StatusPending {
+		return mention, nil
+	}
+
+	body, err := s.fetcher.Fetch(ctx, mention.SourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrSourceUnavailable, webmentionID, err)
+	}
+
+	status := StatusRejected
+
+	var verifiedAt *time.Time
+
+	if linksTo(body, mention.SourceURL, mention.TargetURL) {
+		now := time.Now().UTC()
+		status = StatusVerified
+		verifiedAt = &now
+	}
+
+	err = s.repo.UpdateWebmentionStatus(ctx, mention.ID, status, verifiedAt)
+	if err != nil {
+		return nil, fmt.Errorf("%w(id: %s): %w", ErrFailedToUpdateRecord, webmentionID, err)
+	}
+
+	mention.Status = status
+	mention.VerifiedAt = verifiedAt
+
+	return mention, nil
+}
+
+// ListVerified returns the verified mentions of a profile, newest first.
+func (s *Service) ListVerified(
+	ctx context.Context,
+	profileSlug string,
+	cursor *cursors.Cursor,
+) (cursors.Cursored[[]*Webmention], error) {
+	profileID, err := s.profiles.GetProfileIDBySlug(ctx, profileSlug)
+	if err != nil {
+		if errors.Is(err, profiles.ErrProfileNotFound) {
+			return cursors.Cursored[[]*Webmention]{}, ErrProfileNotFound
+		}
+
+		return cursors.Cursored[[]*Webmention]{}, fmt.Errorf(
+			"%w(slug: %s): %w",
+			ErrFailedToGetRecord,
+			profileSlug,
+			err,
+		)
+	}
+
+	mentions, err := s.repo.ListVerifiedWebmentions(ctx, profileID, cursor)
+	if err != nil {
+		return cursors.Cursored[[]*Webmention]{}, fmt.Errorf(
+			"%w(profileID: %s): %w",
+			ErrFailedToListRecords,
+			profileID,
+			err,
+		)
+	}
+
+	return mentions, nil
+}
+
+// parseMentionURL accepts absolute http and https URLs only.
+func parseMentionURL(raw string) (*url.URL, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, false
+	}
+
+	return parsed, true
+}
+
+// normalizeURL reduces a URL to the form links are compared in: lower-case
+// scheme and host, no fragment and no trailing slash.
+func normalizeURL(value *url.URL) string {
+	normalized := *value
+	normalized.Scheme = strings.ToLower(normalized.Scheme)
+	normalized.Host = strings.ToLower(normalized.Host)
+	normalized.Fragment = ""
+	normalized.RawFragment = ""
+	normalized.Path = strings.TrimSuffix(normalized.Path, "/")
+	normalized.RawPath = ""
+
+	return normalized.String()
+}
+
+// linksTo reports whether the HTML document links to target from an href or
+// src attribute. Relative links are resolved against the source URL.
+func linksTo(body []byte, source string, target string) bool {
+	base, err := url.Parse(source)
+	if err != nil {
+		return false
+	}
+
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+
+	want := normalizeURL(targetURL)
+	tokenizer := html.NewTokenizer(bytes.NewReader(body))
+
+	for {
+		switch tokenizer.Next() {
+		case html.ErrorToken:
+			return false // io.EOF or a malformed document; either way no link found
+		case html.StartTagToken, html.SelfClosingTagToken:
+			_, hasAttr := tokenizer.TagName()
+
+			for hasAttr {
+				var key, value []byte
+
+				key, value, hasAttr = tokenizer.TagAttr()
+
+				attr := string(key)
+				if attr == "href" || attr == "src" {
+					link, err := base.Parse(strings.TrimSpace(string(value)))
+					if err == nil && normalizeURL(link) == want {
+						return true
+					}
+				}
+			}
+		case html.TextToken, html.EndTagToken, html.CommentToken, html.DoctypeToken:
+		}
+	}
+}
@@ -0,0 +1,352 @@
+package webmentions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/ajan/logfx"
+	"github.com/eser/aya.is/services/pkg/api/business/events"
+	"github.com/eser/aya.is/services/pkg/api/business/profiles"
+	"github.com/eser/aya.is/services/pkg/api/business/webmentions"
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errFetchFailed = errors.New("connection refused")
+
+// memoryRepository keeps webmentions in memory.
+type memoryRepository struct {
+	mentions map[string]*webmentions.Webmention
+}
+
+func (r *memoryRepository) UpsertWebmention(
+	_ context.Context,
+	id string,
+	profileID string,
+	sourceURL string,
+	targetURL string,
+) (*webmentions.Webmention, error) {
+	for _, mention := range r.mentions {
+		if mention.SourceURL == sourceURL && mention.TargetURL == targetURL {
+			mention.Status = webmentions.StatusPending
+			mention.VerifiedAt = nil
+
+			return mention, nil
+		}
+	}
+
+	mention := &webmentions.Webmention{ //nolint:exhaustruct
+		CreatedAt: time.Now(),
+		ID:        id,
+		ProfileID: profileID,
+		SourceURL: sourceURL,
+		TargetURL: targetURL,
+		Status:    webmentions.StatusPending,
+	}
+	r.mentions[id] = mention
+
+	return mention, nil
+}
+
+func (r *memoryRepository) GetWebmentionByID(
+	_ context.Context,
+	id string,
+) (*webmentions.Webmention, error) {
+	return r.mentions[id], nil
+}
+
+func (r *memoryRepository) UpdateWebmentionStatus(
+	_ context.Context,
+	id string,
+	status webmentions.Status,
+	verifiedAt *time.Time,
+) error {
+	r.mentions[id].Status = status
+	r.mentions[id].VerifiedAt = verifiedAt
+
+	return nil
+}
+
+func (r *memoryRepository) ListVerifiedWebmentions(
+	_ context.Context,
+	profileID string,
+	_ *cursors.Cursor,
+) (cursors.Cursored[[]*webmentions.Webmention], error) {
+	result := []*webmentions.Webmention{}
+
+	for _, mention := range r.mentions {
+		if mention.ProfileID == profileID && mention.Status == webmentions.StatusVerified {
+			result = append(result, mention)
+		}
+	}
+
+	return cursors.WrapResponseWithCursor(result, nil), nil
+}
+
+// fakeProfiles serves profile "p1" on example.com and "p2" on other.example.
+type fakeProfiles struct{}
+
+func (fakeProfiles) GetProfileIDBySlug(_ context.Context, slug string) (string, error) {
+	switch slug {
+	case "alice":
+		return "p1", nil
+	case "bob":
+		return "p2", nil
+	default:
+		return "", fmt.Errorf("%w(slug: %s)", profiles.ErrProfileNotFound, slug)
+	}
+}
+
+func (fakeProfiles) GetProfileIDByActiveCustomDomain(_ context.Context, host string) (string, error) {
+	switch host {
+	case "example.com":
+		return "p1", nil
+	case "other.example":
+		return "p2", nil
+	default:
+		return "", nil
+	}
+}
+
+// fakeFetcher serves fixed documents by URL.
+type fakeFetcher struct {
+	documents map[string]string
+	err       error
+}
+
+func (f *fakeFetcher) Fetch(_ context.Context, url string) ([]byte, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return []byte(f.documents[url]), nil
+}
+
+// recordingQueueRepository keeps every enqueued item.
+type recordingQueueRepository struct {
+	events.QueueRepository
+
+	items []map[string]any
+}
+
+func (r *recordingQueueRepository) Enqueue(
+	_ context.Context,
+	_ string,
+	_ events.QueueItemType,
+	payload map[string]any,
+	_ int,
+	_ int,
+	_ time.Time,
+) error {
+	r.items = append(r.items, payload)
+
+	return nil
+}
+
+func newTestService(
+	fetcher *fakeFetcher,
+) (*webmentions.Service, *memoryRepository, *recordingQueueRepository) {
+	counter := 0
+	idGenerator := func() string {
+		counter++
+
+		return fmt.Sprintf("id-%d", counter)
+	}
+
+	repo := &memoryRepository{mentions: map[string]*webmentions.Webmention{}}
+	queueRepo := &recordingQueueRepository{} //nolint:exhaustruct
+
+	service := webmentions.NewService(
+		logfx.NewLogger(),
+		repo,
+		fakeProfiles{},
+		fetcher,
+		events.NewQueueService(nil, queueRepo, idGenerator),
+		idGenerator,
+	)
+
+	return service, repo, queueRepo
+}
+
+func TestReceive_VerifiesMentionLinkingToTarget(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeFetcher{ //nolint:exhaustruct
+		documents: map[string]string{
+			"https://blog.example.net/post": `<html><body>
+				<p>Read <a href="https://example.com/stories/hello/">this story</a>.</p>
+			</body></html>`,
+		},
+	}
+	service, repo, queueRepo := newTestService(fetcher)
+
+	mention, err := service.Receive(
+		t.Context(),
+		"alice",
+		"https://blog.example.net/post",
+		"https://example.com/stories/hello",
+	)
+	require.NoError(t, err)
+	assert.Equal(t, webmentions.StatusPending, mention.Status)
+	assert.Equal(t, "p1", mention.ProfileID)
+
+	require.Len(t, queueRepo.items, 1)
+	assert.Equal(t, mention.ID, queueRepo.items[0]["webmention_id"])
+
+	verified, err := service.Verify(t.Context(), mention.ID)
+	require.NoError(t, err)
+	assert.Equal(t, webmentions.StatusVerified, verified.Status)
+	assert.NotNil(t, verified.VerifiedAt)
+
+	listed, err := service.ListVerified(t.Context(), "alice", nil)
+	require.NoError(t, err)
+	require.Len(t, listed.Data, 1)
+	assert.Equal(t, mention.ID, listed.Data[0].ID)
+	assert.Equal(t, webmentions.StatusVerified, repo.mentions[mention.ID].Status)
+}
+
+func TestReceive_RelativeLinkCounts(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeFetcher{ //nolint:exhaustruct
+		documents: map[string]string{
+			"https://example.com/stories/reply": `<a href="/stories/hello#comments">hello</a>`,
+		},
+	}
+	service, _, _ := newTestService(fetcher)
+
+	mention, err := service.Receive(
+		t.Context(),
+		"alice",
+		"https://example.com/stories/reply",
+		"https://example.com/stories/hello",
+	)
+	require.NoError(t, err)
+
+	verified, err := service.Verify(t.Context(), mention.ID)
+	require.NoError(t, err)
+	assert.Equal(t, webmentions.StatusVerified, verified.Status)
+}
+
+func TestVerify_RejectsSpoofedMention(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeFetcher{ //nolint:exhaustruct
+		documents: map[string]string{
+			"https://spam.example.net/": `<html><body>
+				<!-- <a href="https://example.com/stories/hello">hidden</a> -->
+				<p>https://example.com/stories/hello</p>
+				<a href="https://example.com/stories/other">other</a>
+			</body></html>`,
+		},
+	}
+	service, _, _ := newTestService(fetcher)
+
+	mention, err := service.Receive(
+		t.Context(),
+		"alice",
+		"https://spam.example.net/",
+		"https://example.com/stories/hello",
+	)
+	require.NoError(t, err)
+
+	rejected, err := service.Verify(t.Context(), mention.ID)
+	require.NoError(t, err)
+	assert.Equal(t, webmentions.StatusRejected, rejected.Status)
+	assert.Nil(t, rejected.VerifiedAt)
+
+	listed, err := service.ListVerified(t.Context(), "alice", nil)
+	require.NoError(t, err)
+	assert.Empty(t, listed.Data)
+}
+
+func TestVerify_SourceUnavailableKeepsPending(t *testing.T) {
+	t.Parallel()
+
+	fetcher := &fakeFetcher{documents: nil, err: errFetchFailed}
+	service, repo, _ := newTestService(fetcher)
+
+	mention, err := service.Receive(
+		t.Context(),
+		"alice",
+		"https://blog.example.net/post",
+		"https://example.com/stories/hello",
+	)
+	require.NoError(t, err)
+
+	_, err = service.Verify(t.Context(), mention.ID)
+	require.ErrorIs(t, err, webmentions.ErrSourceUnavailable)
+	assert.Equal(t, webmentions.StatusPending, repo.mentions[mention.ID].Status)
+}
+
+func TestReceive_RejectsInvalidMentions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		slug    string
+		source  string
+		target  string
+		wantErr error
+	}{
+		{
+			name:    "target on another profile's domain",
+			slug:    "alice",
+			source:  "https://blog.example.net/post",
+			target:  "https://other.example/stories/hello",
+			wantErr: webmentions.ErrTargetNotOwned,
+		},
+		{
+			name:    "target on an unknown domain",
+			slug:    "alice",
+			source:  "https://blog.example.net/post",
+			target:  "https://unknown.example/page",
+			wantErr: webmentions.ErrTargetNotOwned,
+		},
+		{
+			name:    "unknown profile",
+			slug:    "nobody",
+			source:  "https://blog.example.net/post",
+			target:  "https://example.com/stories/hello",
+			wantErr: webmentions.ErrProfileNotFound,
+		},
+		{
+			name:    "non-http source",
+			slug:    "alice",
+			source:  "file:///etc/passwd",
+			target:  "https://example.com/stories/hello",
+			wantErr: webmentions.ErrInvalidSource,
+		},
+		{
+			name:    "relative target",
+			slug:    "alice",
+			source:  "https://blog.example.net/post",
+			target:  "/stories/hello",
+			wantErr: webmentions.ErrInvalidTarget,
+		},
+		{
+			name:    "source is the target",
+			slug:    "alice",
+			source:  "https://example.com/stories/hello/",
+			target:  "https://example.com/stories/hello",
+			wantErr: webmentions.ErrSameSourceAndTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, repo, queueRepo := newTestService(&fakeFetcher{}) //nolint:exhaustruct
+
+			_, err := service.Receive(t.Context(), tt.slug, tt.source, tt.target)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, repo.mentions)
+			assert.Empty(t, queueRepo.items)
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package webmentions
+
+import (
+	"context"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/lib/cursors"
+)
+
+// Status is the verification state of a webmention.
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusVerified Status = "verified"
+	StatusRejected Status = "rejected"
+)
+
+// Webmention is a notification that Source links to Target, a page of the profile.
+type Webmention struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+	ID         string     `json:"id"`
+	ProfileID  string     `json:"profile_id"`
+	SourceURL  string     `json:"source_url"`
+	TargetURL  string     `json:"target_url"`
+	Status     Status     `json:"status"`
+}
+
+// Repository defines the storage operations for webmentions (port).
+type Repository interface {
+	// UpsertWebmention stores a pending mention, or puts a known (source,
+	// target) pair back to pending, and returns the stored record.
+	UpsertWebmention(
+		ctx context.Context,
+		id string,
+		profileID string,
+		sourceURL string,
+		targetURL string,
+	) (*Webmention, error)
+
+	// GetWebmentionByID returns a mention, or nil if it doesn't exist.
+	GetWebmentionByID(ctx context.Context, id string) (*Webmention, error)
+
+	UpdateWebmentionStatus(
+		ctx context.Context,
+		id string,
+		status Status,
+		verifiedAt *time.Time,
+	) error
+
+	// ListVerifiedWebmentions returns the verified mentions of a profile, newest first.
+	ListVerifiedWebmentions(
+		ctx context.Context,
+		profileID string,
+		cursor *cursors.Cursor,
+	) (cursors.Cursored[[]*Webmention], error)
+}
+
+// ProfileResolver looks up the profiles mentions are sent to (port).
+type ProfileResolver interface {
+	// GetProfileIDBySlug returns the ID of a profile or an error wrapping
+	// profiles.ErrProfileNotFound.
+	GetProfileIDBySlug(ctx context.Context, slug string) (string, error)
+
+	// GetProfileIDByActiveCustomDomain returns the ID of the profile a host is
+	// served for, or "" if no profile is served on it.
+	GetProfileIDByActiveCustomDomain(ctx context.Context, host string) (string, error)
+}
+
+// SourceFetcher fetches the source document of a mention (port). Implementations
+// must refuse to reach private or reserved addresses.
+type SourceFetcher interface {
+	Fetch(ctx context.Context, url string) ([]byte, error)
+}
+
+// IDGenerator is a function that generates unique IDs.
+type IDGenerator func() string
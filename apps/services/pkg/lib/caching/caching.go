@@ -2,10 +2,12 @@ package caching
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
 	"github.com/eser/aya.is/services/pkg/lib/vars"
+	"golang.org/x/sync/singleflight"
 )
 
 var (
@@ -16,20 +18,34 @@ var (
 )
 
 type Cache struct {
-	getter  func(ctx context.Context, key string, target any) (bool, error)
-	setter  func(ctx context.Context, key string, value any) error
-	remover func(ctx context.Context, key string) error
+	getter        func(ctx context.Context, key string, target any) (bool, error)
+	setter        func(ctx context.Context, key string, value any) error
+	remover       func(ctx context.Context, key string) error
+	prefixRemover func(ctx context.Context, prefix string) error
+
+	// flights makes concurrent misses of the same key share one computation.
+	flights singleflight.Group
+}
+
+// flightResult is the outcome of a computation shared by concurrent misses.
+// Callers other than the one that ran it decode their own copy from encoded,
+// so no two requests share the same value.
+type flightResult struct {
+	value   any
+	encoded []byte
 }
 
 func NewCache(
 	getter func(ctx context.Context, key string, target any) (bool, error),
 	setter func(ctx context.Context, key string, value any) error,
 	remover func(ctx context.Context, key string) error,
+	prefixRemover func(ctx context.Context, prefix string) error,
 ) *Cache {
-	return &Cache{
-		getter:  getter,
-		setter:  setter,
-		remover: remover,
+	return &Cache{ //nolint:exhaustruct // flights zero value is valid
+		getter:        getter,
+		setter:        setter,
+		remover:       remover,
+		prefixRemover: prefixRemover,
 	}
 }
 
@@ -65,6 +81,19 @@ func (c *Cache) Invalidate(ctx context.Context, key string) error {
 	return nil
 }
 
+// InvalidatePrefix removes every key starting with prefix, e.g. all pages of a
+// cached list.
+func (c *Cache) InvalidatePrefix(ctx context.Context, prefix string) error {
+	err := c.prefixRemover(ctx, prefix)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotInvalidateFromCache, err)
+	}
+
+	return nil
+}
+
+// Execute reads key into target, computing and storing it with fn on a miss.
+// Concurrent misses of the same key run fn only once and share its result.
 func (c *Cache) Execute(
 	ctx context.Context,
 	key string,
@@ -80,17 +109,39 @@ func (c *Cache) Execute(
 		return nil
 	}
 
-	value, err := fn(ctx)
+	result, err, shared := c.flights.Do(key, func() (any, error) {
+		// Callers waiting on this flight must not fail because the first one left
+		flightCtx := context.WithoutCancel(ctx)
+
+		value, fnErr := fn(flightCtx)
+		if fnErr != nil {
+			return nil, fnErr
+		}
+
+		encoded, encodeErr := json.Marshal(value)
+		if encodeErr != nil {
+			return nil, encodeErr //nolint:wrapcheck
+		}
+
+		setErr := c.Set(flightCtx, key, value)
+		if setErr != nil {
+			return nil, setErr
+		}
+
+		return &flightResult{value: value, encoded: encoded}, nil
+	})
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrCannotExecuteCachingFn, err)
 	}
 
-	err = vars.SetValue(target, value)
-	if err != nil {
-		return fmt.Errorf("%w: %w", ErrCannotExecuteCachingFn, err)
+	flight, _ := result.(*flightResult)
+
+	if shared {
+		err = json.Unmarshal(flight.encoded, target)
+	} else {
+		err = vars.SetValue(target, flight.value)
 	}
 
-	err = c.Set(ctx, key, value)
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrCannotExecuteCachingFn, err)
 	}
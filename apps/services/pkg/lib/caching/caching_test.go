@@ -0,0 +1,141 @@
+package caching_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/lib/caching"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore is a concurrency-safe in-memory backend for a Cache that counts
+// the lookups that missed.
+type memoryStore struct {
+	values map[string][]string
+	misses atomic.Int32
+	mu     sync.Mutex
+}
+
+func newMemoryCache() (*caching.Cache, *memoryStore) {
+	store := &memoryStore{values: map[string][]string{}} //nolint:exhaustruct
+
+	cache := caching.NewCache(
+		func(_ context.Context, key string, target any) (bool, error) {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+
+			value, ok := store.values[key]
+			if !ok {
+				store.misses.Add(1)
+
+				return false, nil
+			}
+
+			*target.(*[]string) = append([]string(nil), value...) //nolint:forcetypeassert
+
+			return true, nil
+		},
+		func(_ context.Context, key string, value any) error {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+
+			store.values[key] = value.([]string) //nolint:forcetypeassert
+
+			return nil
+		},
+		func(_ context.Context, key string) error {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+
+			delete(store.values, key)
+
+			return nil
+		},
+		func(_ context.Context, prefix string) error {
+			store.mu.Lock()
+			defer store.mu.Unlock()
+
+			for key := range store.values {
+				if strings.HasPrefix(key, prefix) {
+					delete(store.values, key)
+				}
+			}
+
+			return nil
+		},
+	)
+
+	return cache, store
+}
+
+func TestCache_ExecuteSharesConcurrentMisses(t *testing.T) {
+	t.Parallel()
+
+	const callers = 20
+
+	cache, store := newMemoryCache()
+
+	var (
+		queries atomic.Int32
+		wg      sync.WaitGroup
+	)
+
+	release := make(chan struct{})
+	results := make([][]string, callers)
+	errs := make([]error, callers)
+
+	query := func(_ context.Context) (any, error) {
+		queries.Add(1)
+
+		// Hold the query open until every caller has missed
+		<-release
+
+		return []string{"eser", "aya"}, nil
+	}
+
+	for i := range callers {
+		wg.Go(func() {
+			errs[i] = cache.Execute(t.Context(), "profile_list:en", &results[i], query)
+		})
+	}
+
+	require.Eventually(t, func() bool {
+		return store.misses.Load() == callers
+	}, time.Second, time.Millisecond)
+
+	// Give the last caller time to go from its miss to the shared query
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), queries.Load())
+
+	for i := range callers {
+		require.NoError(t, errs[i])
+		assert.Equal(t, []string{"eser", "aya"}, results[i])
+	}
+
+	// Callers get their own copies of the shared result
+	results[1][0] = "changed"
+	assert.Equal(t, "eser", results[2][0])
+}
+
+func TestCache_InvalidatePrefix(t *testing.T) {
+	t.Parallel()
+
+	cache, store := newMemoryCache()
+
+	for _, key := range []string{"profile_list:en:0", "profile_list:tr:20", "profile_counts:p1"} {
+		require.NoError(t, cache.Set(t.Context(), key, []string{key}))
+	}
+
+	require.NoError(t, cache.InvalidatePrefix(t.Context(), "profile_list:"))
+
+	assert.Len(t, store.values, 1)
+	assert.Contains(t, store.values, "profile_counts:p1")
+}
@@ -0,0 +1,43 @@
+package caching
+
+import (
+	"strings"
+	"time"
+)
+
+// KeyClassSeparator separates a cache key's class from its identifier,
+// e.g. "user_brief_info:<user id>".
+const KeyClassSeparator = ":"
+
+// TTLPolicy resolves the time-to-live of a cache key by its class.
+type TTLPolicy struct {
+	// ClassTTLs maps key classes to their TTLs.
+	ClassTTLs map[string]time.Duration
+	// Default applies to keys whose class has no entry.
+	Default time.Duration
+}
+
+// NewTTLPolicy creates a new TTLPolicy.
+func NewTTLPolicy(defaultTTL time.Duration, classTTLs map[string]time.Duration) *TTLPolicy {
+	return &TTLPolicy{
+		ClassTTLs: classTTLs,
+		Default:   defaultTTL,
+	}
+}
+
+// KeyClass returns the class of a cache key: the part before the first separator.
+// Keys without a separator are their own class.
+func KeyClass(key string) string {
+	class, _, _ := strings.Cut(key, KeyClassSeparator)
+
+	return class
+}
+
+// TTLFor returns the TTL that applies to the given cache key.
+func (p *TTLPolicy) TTLFor(key string) time.Duration {
+	if ttl, ok := p.ClassTTLs[KeyClass(key)]; ok {
+		return ttl
+	}
+
+	return p.Default
+}
@@ -0,0 +1,32 @@
+package caching_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/lib/caching"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "user_brief_info", caching.KeyClass("user_brief_info:abc"))
+	assert.Equal(t, "membership_kind", caching.KeyClass("membership_kind:p1:p2"))
+	assert.Equal(t, "plain", caching.KeyClass("plain"))
+	assert.Empty(t, caching.KeyClass(":orphan"))
+}
+
+func TestTTLPolicy_TTLFor(t *testing.T) {
+	t.Parallel()
+
+	policy := caching.NewTTLPolicy(2*time.Minute, map[string]time.Duration{
+		"user_brief_info":    30 * time.Second,
+		"profile_id_by_slug": 10 * time.Minute,
+	})
+
+	assert.Equal(t, 30*time.Second, policy.TTLFor("user_brief_info:u1"))
+	assert.Equal(t, 10*time.Minute, policy.TTLFor("profile_id_by_slug:eser"))
+	assert.Equal(t, 2*time.Minute, policy.TTLFor("story_id_by_slug:hello"))
+	assert.Equal(t, 2*time.Minute, policy.TTLFor("user_brief_info_extra:u1"))
+}
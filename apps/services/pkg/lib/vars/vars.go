@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	"github.com/sqlc-dev/pqtype"
@@ -136,6 +137,19 @@ func MapValueToNullString(m map[string]string, key string) sql.NullString {
 	}
 }
 
+func MapValueToNullBool(m map[string]string, key string) sql.NullBool {
+	if v, ok := m[key]; ok {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return sql.NullBool{Bool: b, Valid: true}
+		}
+	}
+
+	return sql.NullBool{
+		Bool:  false,
+		Valid: false,
+	}
+}
+
 func ToSQLNullRawMessage(m map[string]any) pqtype.NullRawMessage {
 	if m != nil {
 		bytes, err := json.Marshal(m)
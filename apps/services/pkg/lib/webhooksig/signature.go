@@ -0,0 +1,180 @@
+// Package webhooksig signs outbound webhooks and verifies their signatures.
+//
+// Every webhook carries an X-Aya-Signature header of the form
+//
+//	t=<unix timestamp>,v1=<hex signature>[,v1=<hex signature>...]
+//
+// where each v1 signature is the HMAC-SHA256 of "<timestamp>.<request body>"
+// keyed with one of the endpoint's secrets. While a secret is being rotated the
+// header carries one signature per active secret, so receivers keep verifying
+// with either the old or the new secret.
+//
+// Receivers verify a request with:
+//
+//	body, _ := io.ReadAll(r.Body)
+//
+//	err := webhooksig.Verify(
+//		r.Header.Get(webhooksig.HeaderSignature),
+//		body,
+//		[]string{currentSecret, previousSecret},
+//		webhooksig.DefaultTolerance,
+//		time.Now(),
+//	)
+//	if err != nil {
+//		http.Error(w, "invalid signature", http.StatusUnauthorized)
+//		return
+//	}
+package webhooksig
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// HeaderSignature is the request header carrying the signature.
+	HeaderSignature = "X-Aya-Signature"
+
+	// SchemeVersion is the current signing scheme version, used as the
+	// signature key in the header.
+	SchemeVersion = "v1"
+
+	// DefaultTolerance is the maximum accepted age of a signature timestamp.
+	DefaultTolerance = 5 * time.Minute
+
+	timestampKey = "t"
+)
+
+var (
+	ErrMissingSignature       = errors.New("missing webhook signature")
+	ErrInvalidSignatureHeader = errors.New("invalid webhook signature header")
+	ErrSignatureExpired       = errors.New("webhook signature timestamp is outside the tolerance")
+	ErrSignatureMismatch      = errors.New("webhook signature does not match")
+	ErrNoSecrets              = errors.New("no webhook secrets to verify with")
+)
+
+// Scheme describes the signing scheme to integrators.
+type Scheme struct {
+	Version          string `json:"version"`
+	Header           string `json:"header"`
+	Algorithm        string `json:"algorithm"`
+	SignedPayload    string `json:"signed_payload"`
+	HeaderFormat     string `json:"header_format"`
+	ToleranceSeconds int    `json:"tolerance_seconds"`
+}
+
+// CurrentScheme returns the description of the signing scheme in use.
+func CurrentScheme() Scheme {
+	return Scheme{
+		Version:          SchemeVersion,
+		Header:           HeaderSignature,
+		Algorithm:        "HMAC-SHA256",
+		SignedPayload:    "{timestamp}.{body}",
+		HeaderFormat:     "t={timestamp},v1={hex signature}[,v1={hex signature}]",
+		ToleranceSeconds: int(DefaultTolerance.Seconds()),
+	}
+}
+
+// Sign returns the signature header value for body at timestamp, with one
+// signature per secret. Pass the new secret first while rotating.
+func Sign(body []byte, timestamp time.Time, secrets ...string) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+
+	parts := make([]string, 0, len(secrets)+1)
+	parts = append(parts, timestampKey+"="+unix)
+
+	for _, secret := range secrets {
+		parts = append(parts, SchemeVersion+"="+compute(secret, unix, body))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// Verify checks that header holds a valid signature of body made with any of
+// secrets, and that its timestamp is no further than tolerance from now.
+func Verify(
+	header string,
+	body []byte,
+	secrets []string,
+	tolerance time.Duration,
+	now time.Time,
+) error {
+	if header == "" {
+		return ErrMissingSignature
+	}
+
+	if len(secrets) == 0 {
+		return ErrNoSecrets
+	}
+
+	unix, signatures, err := parseHeader(header)
+	if err != nil {
+		return err
+	}
+
+	seconds, err := strconv.ParseInt(unix, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: timestamp %q", ErrInvalidSignatureHeader, unix)
+	}
+
+	age := now.Sub(time.Unix(seconds, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrSignatureExpired
+	}
+
+	for _, secret := range secrets {
+		expected := compute(secret, unix, body)
+
+		for _, signature := range signatures {
+			if hmac.Equal([]byte(expected), []byte(signature)) {
+				return nil
+			}
+		}
+	}
+
+	return ErrSignatureMismatch
+}
+
+func compute(secret string, unix string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// parseHeader splits the header into its timestamp and the signatures of the
+// current scheme version. Signatures of other versions are ignored.
+func parseHeader(header string) (string, []string, error) {
+	var (
+		unix       string
+		signatures []string
+	)
+
+	for part := range strings.SplitSeq(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return "", nil, fmt.Errorf("%w: %q", ErrInvalidSignatureHeader, part)
+		}
+
+		switch key {
+		case timestampKey:
+			unix = value
+		case SchemeVersion:
+			signatures = append(signatures, value)
+		}
+	}
+
+	if unix == "" || len(signatures) == 0 {
+		return "", nil, ErrInvalidSignatureHeader
+	}
+
+	return unix, signatures, nil
+}
@@ -0,0 +1,192 @@
+package webhooksig_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eser/aya.is/services/pkg/lib/webhooksig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	body = []byte(`{"event":"profile_membership.created"}`) //nolint:gochecknoglobals
+	now  = time.Unix(1_760_000_000, 0)                      //nolint:gochecknoglobals
+)
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	header := webhooksig.Sign(body, now, "secret")
+
+	parts := strings.Split(header, ",")
+	require.Len(t, parts, 2)
+	assert.Equal(t, "t="+strconv.FormatInt(now.Unix(), 10), parts[0])
+	assert.True(t, strings.HasPrefix(parts[1], "v1="))
+	assert.Len(t, strings.TrimPrefix(parts[1], "v1="), 64)
+
+	assert.Equal(t, header, webhooksig.Sign(body, now, "secret"))
+	assert.NotEqual(t, header, webhooksig.Sign(body, now, "other"))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	header := webhooksig.Sign(body, now, "secret")
+
+	tests := map[string]struct {
+		expected error
+		header   string
+		body     []byte
+		secrets  []string
+		now      time.Time
+	}{
+		"valid": {
+			expected: nil,
+			header:   header,
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now,
+		},
+		"valid within tolerance": {
+			expected: nil,
+			header:   header,
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now.Add(webhooksig.DefaultTolerance),
+		},
+		"expired": {
+			expected: webhooksig.ErrSignatureExpired,
+			header:   header,
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now.Add(webhooksig.DefaultTolerance + time.Second),
+		},
+		"from the future": {
+			expected: webhooksig.ErrSignatureExpired,
+			header:   header,
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now.Add(-webhooksig.DefaultTolerance - time.Second),
+		},
+		"tampered body": {
+			expected: webhooksig.ErrSignatureMismatch,
+			header:   header,
+			body:     []byte(`{"event":"profile_membership.deleted"}`),
+			secrets:  []string{"secret"},
+			now:      now,
+		},
+		"wrong secret": {
+			expected: webhooksig.ErrSignatureMismatch,
+			header:   header,
+			body:     body,
+			secrets:  []string{"other"},
+			now:      now,
+		},
+		"tampered timestamp": {
+			expected: webhooksig.ErrSignatureMismatch,
+			header:   strings.Replace(header, "t="+strconv.FormatInt(now.Unix(), 10), "t="+strconv.FormatInt(now.Unix()+1, 10), 1),
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now,
+		},
+		"missing header": {
+			expected: webhooksig.ErrMissingSignature,
+			header:   "",
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now,
+		},
+		"malformed header": {
+			expected: webhooksig.ErrInvalidSignatureHeader,
+			header:   "garbage",
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now,
+		},
+		"no signature of the current version": {
+			expected: webhooksig.ErrInvalidSignatureHeader,
+			header:   "t=" + strconv.FormatInt(now.Unix(), 10) + ",v0=abc",
+			body:     body,
+			secrets:  []string{"secret"},
+			now:      now,
+		},
+		"no secrets": {
+			expected: webhooksig.ErrNoSecrets,
+			header:   header,
+			body:     body,
+			secrets:  nil,
+			now:      now,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := webhooksig.Verify(
+				test.header,
+				test.body,
+				test.secrets,
+				webhooksig.DefaultTolerance,
+				test.now,
+			)
+
+			if test.expected == nil {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorIs(t, err, test.expected)
+		})
+	}
+}
+
+func TestVerify_Rotation(t *testing.T) {
+	t.Parallel()
+
+	// During rotation the sender signs with the new and the old secret.
+	header := webhooksig.Sign(body, now, "new-secret", "old-secret")
+
+	t.Run("receiver still on the old secret", func(t *testing.T) {
+		t.Parallel()
+
+		err := webhooksig.Verify(header, body, []string{"old-secret"}, webhooksig.DefaultTolerance, now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("receiver already on the new secret", func(t *testing.T) {
+		t.Parallel()
+
+		err := webhooksig.Verify(header, body, []string{"new-secret"}, webhooksig.DefaultTolerance, now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("receiver accepting both while rotating", func(t *testing.T) {
+		t.Parallel()
+
+		// After rotation completes the sender only signs with the new secret.
+		rotated := webhooksig.Sign(body, now, "new-secret")
+
+		err := webhooksig.Verify(
+			rotated,
+			body,
+			[]string{"old-secret", "new-secret"},
+			webhooksig.DefaultTolerance,
+			now,
+		)
+		assert.NoError(t, err)
+	})
+
+	t.Run("retired secret is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		rotated := webhooksig.Sign(body, now, "new-secret")
+
+		err := webhooksig.Verify(rotated, body, []string{"old-secret"}, webhooksig.DefaultTolerance, now)
+		assert.ErrorIs(t, err, webhooksig.ErrSignatureMismatch)
+	})
+}
@@ -200,6 +200,7 @@ export interface ProfileMembershipCandidate {
   source: string;
   applicant_message: string | null;
   vote_count: number;
+  voting_deadline?: string | null;
   created_at: string;
   updated_at?: string | null;
   referrer_profile: ProfileBrief;